@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 // autoscalingTagSchema returns the schema to use for the tag element.
@@ -252,6 +253,10 @@ func autoscalingTagFromMap(attr map[string]interface{}, resourceID string) (*aut
 func autoscalingTagDescriptionsToSlice(ts []*autoscaling.TagDescription) []map[string]interface{} {
 	tags := make([]map[string]interface{}, 0, len(ts))
 	for _, t := range ts {
+		if tagIgnoredAutoscaling(&autoscaling.Tag{Key: t.Key, Value: t.Value}) {
+			continue
+		}
+
 		tags = append(tags, map[string]interface{}{
 			"key":                 *t.Key,
 			"value":               *t.Value,
@@ -273,7 +278,7 @@ func setToMapByKey(s *schema.Set) map[string]interface{} {
 }
 
 // compare a tag against a list of strings and checks if it should
-// be ignored or not
+// be ignored or not, including the tags matching the provider ignore_tags
 func tagIgnoredAutoscaling(t *autoscaling.Tag) bool {
 	filter := []string{"^aws:"}
 	for _, v := range filter {
@@ -284,5 +289,9 @@ func tagIgnoredAutoscaling(t *autoscaling.Tag) bool {
 			return true
 		}
 	}
+	if len(keyvaluetags.New([]string{*t.Key}).IgnoreConfig(configuredIgnoreTagsConfig())) == 0 {
+		log.Printf("[DEBUG] Found tag %s matching the provider ignore_tags, ignoring.\n", *t.Key)
+		return true
+	}
 	return false
 }
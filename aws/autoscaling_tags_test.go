@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func TestDiffAutoscalingTags(t *testing.T) {
@@ -157,6 +158,30 @@ func TestIgnoringTagsAutoscaling(t *testing.T) {
 	}
 }
 
+func TestIgnoringTagsAutoscaling_ignoreConfig(t *testing.T) {
+	setConfiguredIgnoreTagsConfig(&keyvaluetags.IgnoreConfig{
+		KeyPrefixes: keyvaluetags.New([]string{"compliance:"}),
+	})
+	defer setConfiguredIgnoreTagsConfig(nil)
+
+	if !tagIgnoredAutoscaling(&autoscaling.Tag{Key: aws.String("compliance:owner"), Value: aws.String("security")}) {
+		t.Fatal("tag matching the provider ignore_tags not ignored, but should be!")
+	}
+
+	if tagIgnoredAutoscaling(&autoscaling.Tag{Key: aws.String("Name"), Value: aws.String("test")}) {
+		t.Fatal("tag not matching the provider ignore_tags ignored, but should not be!")
+	}
+
+	tags := autoscalingTagDescriptionsToSlice([]*autoscaling.TagDescription{
+		{Key: aws.String("Name"), Value: aws.String("test"), PropagateAtLaunch: aws.Bool(true)},
+		{Key: aws.String("compliance:owner"), Value: aws.String("security"), PropagateAtLaunch: aws.Bool(false)},
+	})
+
+	if len(tags) != 1 || tags[0]["key"] != "Name" {
+		t.Fatalf("bad tags: %#v", tags)
+	}
+}
+
 // autoscalingTagsToMap turns the list of tags into a map.
 func autoscalingTagsToMap(ts []*autoscaling.Tag) map[string]interface{} {
 	tags := make(map[string]interface{})
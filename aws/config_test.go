@@ -1,9 +1,11 @@
 package aws
 
 import (
+	"fmt"
 	"reflect"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	awsbase "github.com/hashicorp/aws-sdk-go-base"
 )
@@ -40,6 +42,174 @@ func TestGetSupportedEC2Platforms(t *testing.T) {
 	}
 }
 
+func TestGetAssumeRoleChainSession(t *testing.T) {
+	stsEndpoints := []*awsbase.MockEndpoint{
+		{
+			Request: &awsbase.MockRequest{
+				Method: "POST",
+				Uri:    "/",
+				Body:   "Action=AssumeRole&DurationSeconds=900&RoleArn=arn%3Aaws%3Aiam%3A%3A111111111111%3Arole%2Fsecurity&RoleSessionName=ci&Version=2011-06-15",
+			},
+			Response: &awsbase.MockResponse{
+				StatusCode:  200,
+				Body:        fmt.Sprintf(test_sts_assumeRole_response, "111111111111", "security", "ASIAHOP1"),
+				ContentType: "text/xml",
+			},
+		},
+		{
+			Request: &awsbase.MockRequest{
+				Method: "POST",
+				Uri:    "/",
+				Body:   "Action=AssumeRole&DurationSeconds=3600&ExternalId=deploy&RoleArn=arn%3Aaws%3Aiam%3A%3A222222222222%3Arole%2Fdeploy&RoleSessionName=ci&Version=2011-06-15",
+			},
+			Response: &awsbase.MockResponse{
+				StatusCode:  200,
+				Body:        fmt.Sprintf(test_sts_assumeRole_response, "222222222222", "deploy", "ASIAHOP2"),
+				ContentType: "text/xml",
+			},
+		},
+	}
+	closeFunc, sess, err := awsbase.GetMockedAwsApiSession("STS", stsEndpoints)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closeFunc()
+
+	assumeRoles := []*AssumeRole{
+		{
+			RoleARN:     "arn:aws:iam::111111111111:role/security",
+			SessionName: "ci",
+		},
+		{
+			RoleARN:         "arn:aws:iam::222222222222:role/deploy",
+			SessionName:     "ci",
+			ExternalID:      "deploy",
+			DurationSeconds: 3600,
+		},
+	}
+
+	sess, err = getAssumeRoleChainSession(sess, assumeRoles, aws.StringValue(sess.Config.Endpoint))
+	if err != nil {
+		t.Fatalf("Expected no error, received: %s", err)
+	}
+
+	creds, err := sess.Config.Credentials.Get()
+	if err != nil {
+		t.Fatalf("Expected no error, received: %s", err)
+	}
+
+	if creds.AccessKeyID != "ASIAHOP2" {
+		t.Fatalf("Received access key ID: %q\nExpected: %q\n", creds.AccessKeyID, "ASIAHOP2")
+	}
+}
+
+func TestGetAssumeRoleChainSession_tokenCode(t *testing.T) {
+	stsEndpoints := []*awsbase.MockEndpoint{
+		{
+			Request: &awsbase.MockRequest{
+				Method: "POST",
+				Uri:    "/",
+				Body:   "Action=AssumeRole&DurationSeconds=900&RoleArn=arn%3Aaws%3Aiam%3A%3A111111111111%3Arole%2Fsecurity&RoleSessionName=ci&SerialNumber=arn%3Aaws%3Aiam%3A%3A111111111111%3Amfa%2Fci&TokenCode=123456&Version=2011-06-15",
+			},
+			Response: &awsbase.MockResponse{
+				StatusCode:  200,
+				Body:        fmt.Sprintf(test_sts_assumeRole_response, "111111111111", "security", "ASIAHOP1"),
+				ContentType: "text/xml",
+			},
+		},
+	}
+	closeFunc, sess, err := awsbase.GetMockedAwsApiSession("STS", stsEndpoints)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closeFunc()
+
+	assumeRoles := []*AssumeRole{
+		{
+			RoleARN:      "arn:aws:iam::111111111111:role/security",
+			SessionName:  "ci",
+			SerialNumber: "arn:aws:iam::111111111111:mfa/ci",
+			TokenCode:    "123456",
+		},
+	}
+
+	sess, err = getAssumeRoleChainSession(sess, assumeRoles, aws.StringValue(sess.Config.Endpoint))
+	if err != nil {
+		t.Fatalf("Expected no error, received: %s", err)
+	}
+
+	creds, err := sess.Config.Credentials.Get()
+	if err != nil {
+		t.Fatalf("Expected no error, received: %s", err)
+	}
+
+	if creds.AccessKeyID != "ASIAHOP1" {
+		t.Fatalf("Received access key ID: %q\nExpected: %q\n", creds.AccessKeyID, "ASIAHOP1")
+	}
+}
+
+func TestGetAssumeRoleChainSession_missingTokenCode(t *testing.T) {
+	closeFunc, sess, err := awsbase.GetMockedAwsApiSession("STS", []*awsbase.MockEndpoint{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closeFunc()
+
+	assumeRoles := []*AssumeRole{
+		{
+			RoleARN:      "arn:aws:iam::111111111111:role/security",
+			SerialNumber: "arn:aws:iam::111111111111:mfa/ci",
+		},
+	}
+
+	_, err = getAssumeRoleChainSession(sess, assumeRoles, aws.StringValue(sess.Config.Endpoint))
+	if err == nil {
+		t.Fatal("Expected error when token_code is missing")
+	}
+}
+
+func TestGetAssumeRoleChainSession_chainedDuration(t *testing.T) {
+	closeFunc, sess, err := awsbase.GetMockedAwsApiSession("STS", []*awsbase.MockEndpoint{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closeFunc()
+
+	assumeRoles := []*AssumeRole{
+		{
+			RoleARN:         "arn:aws:iam::111111111111:role/security",
+			DurationSeconds: 43200,
+		},
+		{
+			RoleARN:         "arn:aws:iam::222222222222:role/deploy",
+			DurationSeconds: 7200,
+		},
+	}
+
+	_, err = getAssumeRoleChainSession(sess, assumeRoles, aws.StringValue(sess.Config.Endpoint))
+	if err == nil {
+		t.Fatal("Expected error when duration_seconds of a chained role exceeds one hour")
+	}
+}
+
+var test_sts_assumeRole_response = `<AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleResult>
+    <AssumedRoleUser>
+      <Arn>arn:aws:sts::%[1]s:assumed-role/%[2]s/ci</Arn>
+      <AssumedRoleId>AROAEXAMPLE:ci</AssumedRoleId>
+    </AssumedRoleUser>
+    <Credentials>
+      <AccessKeyId>%[3]s</AccessKeyId>
+      <SecretAccessKey>secretKey</SecretAccessKey>
+      <SessionToken>sessionToken</SessionToken>
+      <Expiration>2099-01-01T00:00:00Z</Expiration>
+    </Credentials>
+  </AssumeRoleResult>
+  <ResponseMetadata>
+    <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+  </ResponseMetadata>
+</AssumeRoleResponse>`
+
 var test_ec2_describeAccountAttributes_response = `<DescribeAccountAttributesResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
   <requestId>7a62c49f-347e-4fc4-9331-6e8eEXAMPLE</requestId>
   <accountAttributeSet>
@@ -0,0 +1,908 @@
+package aws
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/aws-sdk-go/service/acmpca"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go/service/appmesh"
+	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/aws/aws-sdk-go/service/athena"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/backup"
+	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/aws/aws-sdk-go/service/budgets"
+	"github.com/aws/aws-sdk-go/service/cloud9"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/aws/aws-sdk-go/service/cloudhsmv2"
+	"github.com/aws/aws-sdk-go/service/cloudsearch"
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/codebuild"
+	"github.com/aws/aws-sdk-go/service/codecommit"
+	"github.com/aws/aws-sdk-go/service/codedeploy"
+	"github.com/aws/aws-sdk-go/service/codepipeline"
+	"github.com/aws/aws-sdk-go/service/cognitoidentity"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/aws/aws-sdk-go/service/costandusagereportservice"
+	"github.com/aws/aws-sdk-go/service/databasemigrationservice"
+	"github.com/aws/aws-sdk-go/service/datapipeline"
+	"github.com/aws/aws-sdk-go/service/datasync"
+	"github.com/aws/aws-sdk-go/service/dax"
+	"github.com/aws/aws-sdk-go/service/devicefarm"
+	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/aws/aws-sdk-go/service/dlm"
+	"github.com/aws/aws-sdk-go/service/docdb"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	elasticsearch "github.com/aws/aws-sdk-go/service/elasticsearchservice"
+	"github.com/aws/aws-sdk-go/service/elastictranscoder"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/emr"
+	"github.com/aws/aws-sdk-go/service/firehose"
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/aws/aws-sdk-go/service/fsx"
+	"github.com/aws/aws-sdk-go/service/gamelift"
+	"github.com/aws/aws-sdk-go/service/glacier"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/inspector"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/aws/aws-sdk-go/service/kafka"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/aws/aws-sdk-go/service/kinesisanalytics"
+	"github.com/aws/aws-sdk-go/service/kinesisanalyticsv2"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/aws/aws-sdk-go/service/licensemanager"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/aws/aws-sdk-go/service/macie"
+	"github.com/aws/aws-sdk-go/service/mediaconnect"
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/aws/aws-sdk-go/service/mediapackage"
+	"github.com/aws/aws-sdk-go/service/mediastore"
+	"github.com/aws/aws-sdk-go/service/mediastoredata"
+	"github.com/aws/aws-sdk-go/service/mq"
+	"github.com/aws/aws-sdk-go/service/neptune"
+	"github.com/aws/aws-sdk-go/service/opsworks"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/aws/aws-sdk-go/service/pricing"
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/aws/aws-sdk-go/service/resourcegroups"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/route53resolver"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/aws/aws-sdk-go/service/serverlessapplicationrepository"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/aws/aws-sdk-go/service/simpledb"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/storagegateway"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/swf"
+	"github.com/aws/aws-sdk-go/service/transfer"
+	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/aws/aws-sdk-go/service/wafregional"
+	"github.com/aws/aws-sdk-go/service/worklink"
+	"github.com/aws/aws-sdk-go/service/workspaces"
+)
+
+// conn returns the service client stored under name, creating it with
+// newConn on first use. It is safe for concurrent use.
+func (client *AWSClient) conn(name string, newConn func() interface{}) interface{} {
+	client.connsMutex.Lock()
+	defer client.connsMutex.Unlock()
+
+	if conn, ok := client.conns[name]; ok {
+		return conn
+	}
+
+	if client.conns == nil {
+		client.conns = make(map[string]interface{})
+	}
+
+	conn := newConn()
+	client.conns[name] = conn
+
+	return conn
+}
+
+func (client *AWSClient) acmconn() *acm.ACM {
+	return client.conn("acmconn", func() interface{} {
+		return acm.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["acm"])}))
+	}).(*acm.ACM)
+}
+
+func (client *AWSClient) acmpcaconn() *acmpca.ACMPCA {
+	return client.conn("acmpcaconn", func() interface{} {
+		return acmpca.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["acmpca"])}))
+	}).(*acmpca.ACMPCA)
+}
+
+func (client *AWSClient) apigateway() *apigateway.APIGateway {
+	return client.conn("apigateway", func() interface{} {
+		return apigateway.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["apigateway"])}))
+	}).(*apigateway.APIGateway)
+}
+
+func (client *AWSClient) apigatewayv2conn() *apigatewayv2.ApiGatewayV2 {
+	return client.conn("apigatewayv2conn", func() interface{} {
+		return apigatewayv2.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["apigatewayv2"])}))
+	}).(*apigatewayv2.ApiGatewayV2)
+}
+
+func (client *AWSClient) appautoscalingconn() *applicationautoscaling.ApplicationAutoScaling {
+	return client.conn("appautoscalingconn", func() interface{} {
+		conn := applicationautoscaling.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["applicationautoscaling"])}))
+
+		// Workaround for https://github.com/aws/aws-sdk-go/issues/1472
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if !strings.HasPrefix(r.Operation.Name, "Describe") && !strings.HasPrefix(r.Operation.Name, "List") {
+				return
+			}
+			err, ok := r.Error.(awserr.Error)
+			if !ok || err == nil {
+				return
+			}
+			if err.Code() == applicationautoscaling.ErrCodeFailedResourceAccessException {
+				r.Retryable = aws.Bool(true)
+			}
+		})
+
+		return conn
+	}).(*applicationautoscaling.ApplicationAutoScaling)
+}
+
+func (client *AWSClient) appmeshconn() *appmesh.AppMesh {
+	return client.conn("appmeshconn", func() interface{} {
+		return appmesh.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["appmesh"])}))
+	}).(*appmesh.AppMesh)
+}
+
+func (client *AWSClient) appsyncconn() *appsync.AppSync {
+	return client.conn("appsyncconn", func() interface{} {
+		return appsync.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["appsync"])}))
+	}).(*appsync.AppSync)
+}
+
+func (client *AWSClient) athenaconn() *athena.Athena {
+	return client.conn("athenaconn", func() interface{} {
+		return athena.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["athena"])}))
+	}).(*athena.Athena)
+}
+
+func (client *AWSClient) autoscalingconn() *autoscaling.AutoScaling {
+	return client.conn("autoscalingconn", func() interface{} {
+		return autoscaling.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["autoscaling"])}))
+	}).(*autoscaling.AutoScaling)
+}
+
+func (client *AWSClient) backupconn() *backup.Backup {
+	return client.conn("backupconn", func() interface{} {
+		return backup.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["backup"])}))
+	}).(*backup.Backup)
+}
+
+func (client *AWSClient) batchconn() *batch.Batch {
+	return client.conn("batchconn", func() interface{} {
+		return batch.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["batch"])}))
+	}).(*batch.Batch)
+}
+
+func (client *AWSClient) budgetconn() *budgets.Budgets {
+	return client.conn("budgetconn", func() interface{} {
+		return budgets.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["budgets"])}))
+	}).(*budgets.Budgets)
+}
+
+func (client *AWSClient) cfconn() *cloudformation.CloudFormation {
+	return client.conn("cfconn", func() interface{} {
+		return cloudformation.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["cloudformation"])}))
+	}).(*cloudformation.CloudFormation)
+}
+
+func (client *AWSClient) cloud9conn() *cloud9.Cloud9 {
+	return client.conn("cloud9conn", func() interface{} {
+		return cloud9.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["cloud9"])}))
+	}).(*cloud9.Cloud9)
+}
+
+func (client *AWSClient) cloudfrontconn() *cloudfront.CloudFront {
+	return client.conn("cloudfrontconn", func() interface{} {
+		return cloudfront.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["cloudfront"])}))
+	}).(*cloudfront.CloudFront)
+}
+
+func (client *AWSClient) cloudhsmv2conn() *cloudhsmv2.CloudHSMV2 {
+	return client.conn("cloudhsmv2conn", func() interface{} {
+		return cloudhsmv2.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["cloudhsm"])}))
+	}).(*cloudhsmv2.CloudHSMV2)
+}
+
+func (client *AWSClient) cloudsearchconn() *cloudsearch.CloudSearch {
+	return client.conn("cloudsearchconn", func() interface{} {
+		return cloudsearch.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["cloudsearch"])}))
+	}).(*cloudsearch.CloudSearch)
+}
+
+func (client *AWSClient) cloudtrailconn() *cloudtrail.CloudTrail {
+	return client.conn("cloudtrailconn", func() interface{} {
+		return cloudtrail.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["cloudtrail"])}))
+	}).(*cloudtrail.CloudTrail)
+}
+
+func (client *AWSClient) cloudwatchconn() *cloudwatch.CloudWatch {
+	return client.conn("cloudwatchconn", func() interface{} {
+		return cloudwatch.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["cloudwatch"])}))
+	}).(*cloudwatch.CloudWatch)
+}
+
+func (client *AWSClient) cloudwatcheventsconn() *cloudwatchevents.CloudWatchEvents {
+	return client.conn("cloudwatcheventsconn", func() interface{} {
+		return cloudwatchevents.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["cloudwatchevents"])}))
+	}).(*cloudwatchevents.CloudWatchEvents)
+}
+
+func (client *AWSClient) cloudwatchlogsconn() *cloudwatchlogs.CloudWatchLogs {
+	return client.conn("cloudwatchlogsconn", func() interface{} {
+		return cloudwatchlogs.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["cloudwatchlogs"])}))
+	}).(*cloudwatchlogs.CloudWatchLogs)
+}
+
+func (client *AWSClient) codebuildconn() *codebuild.CodeBuild {
+	return client.conn("codebuildconn", func() interface{} {
+		return codebuild.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["codebuild"])}))
+	}).(*codebuild.CodeBuild)
+}
+
+func (client *AWSClient) codecommitconn() *codecommit.CodeCommit {
+	return client.conn("codecommitconn", func() interface{} {
+		return codecommit.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["codecommit"])}))
+	}).(*codecommit.CodeCommit)
+}
+
+func (client *AWSClient) codedeployconn() *codedeploy.CodeDeploy {
+	return client.conn("codedeployconn", func() interface{} {
+		return codedeploy.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["codedeploy"])}))
+	}).(*codedeploy.CodeDeploy)
+}
+
+func (client *AWSClient) codepipelineconn() *codepipeline.CodePipeline {
+	return client.conn("codepipelineconn", func() interface{} {
+		return codepipeline.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["codepipeline"])}))
+	}).(*codepipeline.CodePipeline)
+}
+
+func (client *AWSClient) cognitoconn() *cognitoidentity.CognitoIdentity {
+	return client.conn("cognitoconn", func() interface{} {
+		return cognitoidentity.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["cognitoidentity"])}))
+	}).(*cognitoidentity.CognitoIdentity)
+}
+
+func (client *AWSClient) cognitoidpconn() *cognitoidentityprovider.CognitoIdentityProvider {
+	return client.conn("cognitoidpconn", func() interface{} {
+		return cognitoidentityprovider.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["cognitoidp"])}))
+	}).(*cognitoidentityprovider.CognitoIdentityProvider)
+}
+
+func (client *AWSClient) configconn() *configservice.ConfigService {
+	return client.conn("configconn", func() interface{} {
+		return configservice.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["configservice"])}))
+	}).(*configservice.ConfigService)
+}
+
+func (client *AWSClient) costandusagereportconn() *costandusagereportservice.CostandUsageReportService {
+	return client.conn("costandusagereportconn", func() interface{} {
+		return costandusagereportservice.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["cur"])}))
+	}).(*costandusagereportservice.CostandUsageReportService)
+}
+
+func (client *AWSClient) datapipelineconn() *datapipeline.DataPipeline {
+	return client.conn("datapipelineconn", func() interface{} {
+		return datapipeline.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["datapipeline"])}))
+	}).(*datapipeline.DataPipeline)
+}
+
+func (client *AWSClient) datasyncconn() *datasync.DataSync {
+	return client.conn("datasyncconn", func() interface{} {
+		return datasync.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["datasync"])}))
+	}).(*datasync.DataSync)
+}
+
+func (client *AWSClient) daxconn() *dax.DAX {
+	return client.conn("daxconn", func() interface{} {
+		return dax.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["dax"])}))
+	}).(*dax.DAX)
+}
+
+func (client *AWSClient) devicefarmconn() *devicefarm.DeviceFarm {
+	return client.conn("devicefarmconn", func() interface{} {
+		return devicefarm.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["devicefarm"])}))
+	}).(*devicefarm.DeviceFarm)
+}
+
+func (client *AWSClient) dlmconn() *dlm.DLM {
+	return client.conn("dlmconn", func() interface{} {
+		return dlm.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["dlm"])}))
+	}).(*dlm.DLM)
+}
+
+func (client *AWSClient) dmsconn() *databasemigrationservice.DatabaseMigrationService {
+	return client.conn("dmsconn", func() interface{} {
+		return databasemigrationservice.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["dms"])}))
+	}).(*databasemigrationservice.DatabaseMigrationService)
+}
+
+func (client *AWSClient) docdbconn() *docdb.DocDB {
+	return client.conn("docdbconn", func() interface{} {
+		return docdb.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["docdb"])}))
+	}).(*docdb.DocDB)
+}
+
+func (client *AWSClient) dsconn() *directoryservice.DirectoryService {
+	return client.conn("dsconn", func() interface{} {
+		return directoryservice.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["ds"])}))
+	}).(*directoryservice.DirectoryService)
+}
+
+func (client *AWSClient) dxconn() *directconnect.DirectConnect {
+	return client.conn("dxconn", func() interface{} {
+		return directconnect.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["directconnect"])}))
+	}).(*directconnect.DirectConnect)
+}
+
+func (client *AWSClient) dynamodbconn() *dynamodb.DynamoDB {
+	return client.conn("dynamodbconn", func() interface{} {
+		conn := dynamodb.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["dynamodb"])}))
+
+		// See https://github.com/aws/aws-sdk-go/pull/1276
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if r.Operation.Name != "PutItem" && r.Operation.Name != "UpdateItem" && r.Operation.Name != "DeleteItem" {
+				return
+			}
+			if isAWSErr(r.Error, dynamodb.ErrCodeLimitExceededException, "Subscriber limit exceeded:") {
+				r.Retryable = aws.Bool(true)
+			}
+		})
+
+		return conn
+	}).(*dynamodb.DynamoDB)
+}
+
+func (client *AWSClient) ec2conn() *ec2.EC2 {
+	return client.conn("ec2conn", func() interface{} {
+		return ec2.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["ec2"])}))
+	}).(*ec2.EC2)
+}
+
+func (client *AWSClient) ecrconn() *ecr.ECR {
+	return client.conn("ecrconn", func() interface{} {
+		return ecr.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["ecr"])}))
+	}).(*ecr.ECR)
+}
+
+func (client *AWSClient) ecsconn() *ecs.ECS {
+	return client.conn("ecsconn", func() interface{} {
+		return ecs.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["ecs"])}))
+	}).(*ecs.ECS)
+}
+
+func (client *AWSClient) efsconn() *efs.EFS {
+	return client.conn("efsconn", func() interface{} {
+		return efs.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["efs"])}))
+	}).(*efs.EFS)
+}
+
+func (client *AWSClient) eksconn() *eks.EKS {
+	return client.conn("eksconn", func() interface{} {
+		return eks.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["eks"])}))
+	}).(*eks.EKS)
+}
+
+func (client *AWSClient) elasticacheconn() *elasticache.ElastiCache {
+	return client.conn("elasticacheconn", func() interface{} {
+		return elasticache.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["elasticache"])}))
+	}).(*elasticache.ElastiCache)
+}
+
+func (client *AWSClient) elasticbeanstalkconn() *elasticbeanstalk.ElasticBeanstalk {
+	return client.conn("elasticbeanstalkconn", func() interface{} {
+		return elasticbeanstalk.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["elasticbeanstalk"])}))
+	}).(*elasticbeanstalk.ElasticBeanstalk)
+}
+
+func (client *AWSClient) elastictranscoderconn() *elastictranscoder.ElasticTranscoder {
+	return client.conn("elastictranscoderconn", func() interface{} {
+		return elastictranscoder.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["elastictranscoder"])}))
+	}).(*elastictranscoder.ElasticTranscoder)
+}
+
+func (client *AWSClient) elbconn() *elb.ELB {
+	return client.conn("elbconn", func() interface{} {
+		return elb.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["elb"])}))
+	}).(*elb.ELB)
+}
+
+func (client *AWSClient) elbv2conn() *elbv2.ELBV2 {
+	return client.conn("elbv2conn", func() interface{} {
+		return elbv2.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["elb"])}))
+	}).(*elbv2.ELBV2)
+}
+
+func (client *AWSClient) emrconn() *emr.EMR {
+	return client.conn("emrconn", func() interface{} {
+		return emr.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["emr"])}))
+	}).(*emr.EMR)
+}
+
+func (client *AWSClient) esconn() *elasticsearch.ElasticsearchService {
+	return client.conn("esconn", func() interface{} {
+		return elasticsearch.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["es"])}))
+	}).(*elasticsearch.ElasticsearchService)
+}
+
+func (client *AWSClient) firehoseconn() *firehose.Firehose {
+	return client.conn("firehoseconn", func() interface{} {
+		return firehose.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["firehose"])}))
+	}).(*firehose.Firehose)
+}
+
+func (client *AWSClient) fmsconn() *fms.FMS {
+	return client.conn("fmsconn", func() interface{} {
+		return fms.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["fms"])}))
+	}).(*fms.FMS)
+}
+
+func (client *AWSClient) fsxconn() *fsx.FSx {
+	return client.conn("fsxconn", func() interface{} {
+		return fsx.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["fsx"])}))
+	}).(*fsx.FSx)
+}
+
+func (client *AWSClient) gameliftconn() *gamelift.GameLift {
+	return client.conn("gameliftconn", func() interface{} {
+		return gamelift.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["gamelift"])}))
+	}).(*gamelift.GameLift)
+}
+
+func (client *AWSClient) glacierconn() *glacier.Glacier {
+	return client.conn("glacierconn", func() interface{} {
+		return glacier.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["glacier"])}))
+	}).(*glacier.Glacier)
+}
+
+func (client *AWSClient) globalacceleratorconn() *globalaccelerator.GlobalAccelerator {
+	return client.conn("globalacceleratorconn", func() interface{} {
+		return globalaccelerator.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["globalaccelerator"])}))
+	}).(*globalaccelerator.GlobalAccelerator)
+}
+
+func (client *AWSClient) glueconn() *glue.Glue {
+	return client.conn("glueconn", func() interface{} {
+		return glue.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["glue"])}))
+	}).(*glue.Glue)
+}
+
+func (client *AWSClient) guarddutyconn() *guardduty.GuardDuty {
+	return client.conn("guarddutyconn", func() interface{} {
+		return guardduty.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["guardduty"])}))
+	}).(*guardduty.GuardDuty)
+}
+
+func (client *AWSClient) iamconn() *iam.IAM {
+	return client.conn("iamconn", func() interface{} {
+		return iam.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["iam"])}))
+	}).(*iam.IAM)
+}
+
+func (client *AWSClient) inspectorconn() *inspector.Inspector {
+	return client.conn("inspectorconn", func() interface{} {
+		return inspector.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["inspector"])}))
+	}).(*inspector.Inspector)
+}
+
+func (client *AWSClient) iotconn() *iot.IoT {
+	return client.conn("iotconn", func() interface{} {
+		return iot.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["iot"])}))
+	}).(*iot.IoT)
+}
+
+func (client *AWSClient) kafkaconn() *kafka.Kafka {
+	return client.conn("kafkaconn", func() interface{} {
+		return kafka.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["kafka"])}))
+	}).(*kafka.Kafka)
+}
+
+func (client *AWSClient) kinesisanalyticsconn() *kinesisanalytics.KinesisAnalytics {
+	return client.conn("kinesisanalyticsconn", func() interface{} {
+		return kinesisanalytics.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["kinesis_analytics"])}))
+	}).(*kinesisanalytics.KinesisAnalytics)
+}
+
+func (client *AWSClient) kinesisanalyticsv2conn() *kinesisanalyticsv2.KinesisAnalyticsV2 {
+	return client.conn("kinesisanalyticsv2conn", func() interface{} {
+		return kinesisanalyticsv2.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["kinesisanalyticsv2"])}))
+	}).(*kinesisanalyticsv2.KinesisAnalyticsV2)
+}
+
+func (client *AWSClient) kinesisconn() *kinesis.Kinesis {
+	return client.conn("kinesisconn", func() interface{} {
+		conn := kinesis.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["kinesis"])}))
+
+		// Workaround for https://github.com/aws/aws-sdk-go/issues/1376
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if !strings.HasPrefix(r.Operation.Name, "Describe") && !strings.HasPrefix(r.Operation.Name, "List") {
+				return
+			}
+			err, ok := r.Error.(awserr.Error)
+			if !ok || err == nil {
+				return
+			}
+			if err.Code() == kinesis.ErrCodeLimitExceededException {
+				r.Retryable = aws.Bool(true)
+			}
+		})
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if r.Operation.Name == "CreateStream" {
+				if isAWSErr(r.Error, kinesis.ErrCodeLimitExceededException, "simultaneously be in CREATING or DELETING") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+			if r.Operation.Name == "CreateStream" || r.Operation.Name == "DeleteStream" {
+				if isAWSErr(r.Error, kinesis.ErrCodeLimitExceededException, "Rate exceeded for stream") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+		})
+
+		return conn
+	}).(*kinesis.Kinesis)
+}
+
+func (client *AWSClient) kmsconn() *kms.KMS {
+	return client.conn("kmsconn", func() interface{} {
+		return kms.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["kms"])}))
+	}).(*kms.KMS)
+}
+
+func (client *AWSClient) lambdaconn() *lambda.Lambda {
+	return client.conn("lambdaconn", func() interface{} {
+		return lambda.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["lambda"])}))
+	}).(*lambda.Lambda)
+}
+
+func (client *AWSClient) lexmodelconn() *lexmodelbuildingservice.LexModelBuildingService {
+	return client.conn("lexmodelconn", func() interface{} {
+		return lexmodelbuildingservice.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["lexmodels"])}))
+	}).(*lexmodelbuildingservice.LexModelBuildingService)
+}
+
+func (client *AWSClient) licensemanagerconn() *licensemanager.LicenseManager {
+	return client.conn("licensemanagerconn", func() interface{} {
+		return licensemanager.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["licensemanager"])}))
+	}).(*licensemanager.LicenseManager)
+}
+
+func (client *AWSClient) lightsailconn() *lightsail.Lightsail {
+	return client.conn("lightsailconn", func() interface{} {
+		return lightsail.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["lightsail"])}))
+	}).(*lightsail.Lightsail)
+}
+
+func (client *AWSClient) macieconn() *macie.Macie {
+	return client.conn("macieconn", func() interface{} {
+		return macie.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["macie"])}))
+	}).(*macie.Macie)
+}
+
+func (client *AWSClient) mediaconnectconn() *mediaconnect.MediaConnect {
+	return client.conn("mediaconnectconn", func() interface{} {
+		return mediaconnect.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["mediaconnect"])}))
+	}).(*mediaconnect.MediaConnect)
+}
+
+func (client *AWSClient) mediaconvertconn() *mediaconvert.MediaConvert {
+	return client.conn("mediaconvertconn", func() interface{} {
+		return mediaconvert.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["mediaconvert"])}))
+	}).(*mediaconvert.MediaConvert)
+}
+
+func (client *AWSClient) medialiveconn() *medialive.MediaLive {
+	return client.conn("medialiveconn", func() interface{} {
+		return medialive.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["medialive"])}))
+	}).(*medialive.MediaLive)
+}
+
+func (client *AWSClient) mediapackageconn() *mediapackage.MediaPackage {
+	return client.conn("mediapackageconn", func() interface{} {
+		return mediapackage.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["mediapackage"])}))
+	}).(*mediapackage.MediaPackage)
+}
+
+func (client *AWSClient) mediastoreconn() *mediastore.MediaStore {
+	return client.conn("mediastoreconn", func() interface{} {
+		return mediastore.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["mediastore"])}))
+	}).(*mediastore.MediaStore)
+}
+
+func (client *AWSClient) mediastoredataconn() *mediastoredata.MediaStoreData {
+	return client.conn("mediastoredataconn", func() interface{} {
+		return mediastoredata.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["mediastoredata"])}))
+	}).(*mediastoredata.MediaStoreData)
+}
+
+func (client *AWSClient) mqconn() *mq.MQ {
+	return client.conn("mqconn", func() interface{} {
+		return mq.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["mq"])}))
+	}).(*mq.MQ)
+}
+
+func (client *AWSClient) neptuneconn() *neptune.Neptune {
+	return client.conn("neptuneconn", func() interface{} {
+		return neptune.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["neptune"])}))
+	}).(*neptune.Neptune)
+}
+
+func (client *AWSClient) opsworksconn() *opsworks.OpsWorks {
+	return client.conn("opsworksconn", func() interface{} {
+		return opsworks.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["opsworks"])}))
+	}).(*opsworks.OpsWorks)
+}
+
+func (client *AWSClient) organizationsconn() *organizations.Organizations {
+	return client.conn("organizationsconn", func() interface{} {
+		return organizations.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["organizations"])}))
+	}).(*organizations.Organizations)
+}
+
+func (client *AWSClient) pinpointconn() *pinpoint.Pinpoint {
+	return client.conn("pinpointconn", func() interface{} {
+		return pinpoint.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["pinpoint"])}))
+	}).(*pinpoint.Pinpoint)
+}
+
+func (client *AWSClient) pricingconn() *pricing.Pricing {
+	return client.conn("pricingconn", func() interface{} {
+		return pricing.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["pricing"])}))
+	}).(*pricing.Pricing)
+}
+
+func (client *AWSClient) r53conn() *route53.Route53 {
+	return client.conn("r53conn", func() interface{} {
+		return route53.New(client.session.Copy(&aws.Config{Region: aws.String("us-east-1"), Endpoint: aws.String(client.endpoints["r53"])}))
+	}).(*route53.Route53)
+}
+
+func (client *AWSClient) ramconn() *ram.RAM {
+	return client.conn("ramconn", func() interface{} {
+		return ram.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["ram"])}))
+	}).(*ram.RAM)
+}
+
+func (client *AWSClient) rdsconn() *rds.RDS {
+	return client.conn("rdsconn", func() interface{} {
+		return rds.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["rds"])}))
+	}).(*rds.RDS)
+}
+
+func (client *AWSClient) redshiftconn() *redshift.Redshift {
+	return client.conn("redshiftconn", func() interface{} {
+		return redshift.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["redshift"])}))
+	}).(*redshift.Redshift)
+}
+
+func (client *AWSClient) resourcegroupsconn() *resourcegroups.ResourceGroups {
+	return client.conn("resourcegroupsconn", func() interface{} {
+		return resourcegroups.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["resourcegroups"])}))
+	}).(*resourcegroups.ResourceGroups)
+}
+
+func (client *AWSClient) route53resolverconn() *route53resolver.Route53Resolver {
+	return client.conn("route53resolverconn", func() interface{} {
+		return route53resolver.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["route53resolver"])}))
+	}).(*route53resolver.Route53Resolver)
+}
+
+func (client *AWSClient) s3conn() *s3.S3 {
+	return client.conn("s3conn", func() interface{} {
+		return s3.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["s3"]), S3ForcePathStyle: aws.Bool(client.s3ForcePathStyle)}))
+	}).(*s3.S3)
+}
+
+func (client *AWSClient) s3controlconn() *s3control.S3Control {
+	return client.conn("s3controlconn", func() interface{} {
+		return s3control.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["s3control"])}))
+	}).(*s3control.S3Control)
+}
+
+func (client *AWSClient) sagemakerconn() *sagemaker.SageMaker {
+	return client.conn("sagemakerconn", func() interface{} {
+		return sagemaker.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["sagemaker"])}))
+	}).(*sagemaker.SageMaker)
+}
+
+func (client *AWSClient) scconn() *servicecatalog.ServiceCatalog {
+	return client.conn("scconn", func() interface{} {
+		return servicecatalog.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["servicecatalog"])}))
+	}).(*servicecatalog.ServiceCatalog)
+}
+
+func (client *AWSClient) sdconn() *servicediscovery.ServiceDiscovery {
+	return client.conn("sdconn", func() interface{} {
+		return servicediscovery.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["servicediscovery"])}))
+	}).(*servicediscovery.ServiceDiscovery)
+}
+
+func (client *AWSClient) secretsmanagerconn() *secretsmanager.SecretsManager {
+	return client.conn("secretsmanagerconn", func() interface{} {
+		return secretsmanager.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["secretsmanager"])}))
+	}).(*secretsmanager.SecretsManager)
+}
+
+func (client *AWSClient) securityhubconn() *securityhub.SecurityHub {
+	return client.conn("securityhubconn", func() interface{} {
+		return securityhub.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["securityhub"])}))
+	}).(*securityhub.SecurityHub)
+}
+
+func (client *AWSClient) serverlessapplicationrepositoryconn() *serverlessapplicationrepository.ServerlessApplicationRepository {
+	return client.conn("serverlessapplicationrepositoryconn", func() interface{} {
+		return serverlessapplicationrepository.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["serverlessrepo"])}))
+	}).(*serverlessapplicationrepository.ServerlessApplicationRepository)
+}
+
+func (client *AWSClient) sesConn() *ses.SES {
+	return client.conn("sesConn", func() interface{} {
+		return ses.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["ses"])}))
+	}).(*ses.SES)
+}
+
+func (client *AWSClient) sfnconn() *sfn.SFN {
+	return client.conn("sfnconn", func() interface{} {
+		return sfn.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["stepfunctions"])}))
+	}).(*sfn.SFN)
+}
+
+func (client *AWSClient) shieldconn() *shield.Shield {
+	return client.conn("shieldconn", func() interface{} {
+		return shield.New(client.session.Copy(&aws.Config{Region: aws.String(client.shieldRegion()), Endpoint: aws.String(client.endpoints["shield"])}))
+	}).(*shield.Shield)
+}
+
+// shieldRegion returns the single region of the Shield Advanced API in the
+// partition of the provider region, or the provider region in partitions
+// without a known Shield region.
+func (client *AWSClient) shieldRegion() string {
+	partition := client.partition
+	if partition == "" {
+		if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), client.region); ok {
+			partition = p.ID()
+		}
+	}
+
+	switch partition {
+	case endpoints.AwsPartitionID:
+		return endpoints.UsEast1RegionID
+	case endpoints.AwsUsGovPartitionID:
+		return endpoints.UsGovWest1RegionID
+	default:
+		return client.region
+	}
+}
+
+func (client *AWSClient) simpledbconn() *simpledb.SimpleDB {
+	return client.conn("simpledbconn", func() interface{} {
+		return simpledb.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["sdb"])}))
+	}).(*simpledb.SimpleDB)
+}
+
+func (client *AWSClient) snsconn() *sns.SNS {
+	return client.conn("snsconn", func() interface{} {
+		return sns.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["sns"])}))
+	}).(*sns.SNS)
+}
+
+func (client *AWSClient) sqsconn() *sqs.SQS {
+	return client.conn("sqsconn", func() interface{} {
+		return sqs.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["sqs"])}))
+	}).(*sqs.SQS)
+}
+
+func (client *AWSClient) ssmconn() *ssm.SSM {
+	return client.conn("ssmconn", func() interface{} {
+		return ssm.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["ssm"])}))
+	}).(*ssm.SSM)
+}
+
+func (client *AWSClient) storagegatewayconn() *storagegateway.StorageGateway {
+	return client.conn("storagegatewayconn", func() interface{} {
+		conn := storagegateway.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["storagegateway"])}))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			// InvalidGatewayRequestException: The specified gateway proxy network connection is busy.
+			if isAWSErr(r.Error, storagegateway.ErrCodeInvalidGatewayRequestException, "The specified gateway proxy network connection is busy") {
+				r.Retryable = aws.Bool(true)
+			}
+		})
+
+		return conn
+	}).(*storagegateway.StorageGateway)
+}
+
+func (client *AWSClient) stsconn() *sts.STS {
+	return client.conn("stsconn", func() interface{} {
+		return sts.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["sts"])}))
+	}).(*sts.STS)
+}
+
+func (client *AWSClient) swfconn() *swf.SWF {
+	return client.conn("swfconn", func() interface{} {
+		return swf.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["swf"])}))
+	}).(*swf.SWF)
+}
+
+func (client *AWSClient) transferconn() *transfer.Transfer {
+	return client.conn("transferconn", func() interface{} {
+		return transfer.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["transfer"])}))
+	}).(*transfer.Transfer)
+}
+
+func (client *AWSClient) wafconn() *waf.WAF {
+	return client.conn("wafconn", func() interface{} {
+		return waf.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["waf"])}))
+	}).(*waf.WAF)
+}
+
+func (client *AWSClient) wafregionalconn() *wafregional.WAFRegional {
+	return client.conn("wafregionalconn", func() interface{} {
+		return wafregional.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["wafregional"])}))
+	}).(*wafregional.WAFRegional)
+}
+
+func (client *AWSClient) worklinkconn() *worklink.WorkLink {
+	return client.conn("worklinkconn", func() interface{} {
+		return worklink.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["worklink"])}))
+	}).(*worklink.WorkLink)
+}
+
+func (client *AWSClient) workspacesconn() *workspaces.WorkSpaces {
+	return client.conn("workspacesconn", func() interface{} {
+		return workspaces.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["workspaces"])}))
+	}).(*workspaces.WorkSpaces)
+}
@@ -0,0 +1,118 @@
+package aws
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+)
+
+func testAWSClientLazyConns(t *testing.T) *AWSClient {
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("accessKey", "secretKey", ""),
+		Region:      aws.String("us-west-2"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &AWSClient{
+		endpoints: map[string]string{
+			"ec2": "http://localhost:4597",
+		},
+		region:  "us-west-2",
+		session: sess,
+	}
+}
+
+func TestAWSClientConn(t *testing.T) {
+	client := testAWSClientLazyConns(t)
+
+	if len(client.conns) != 0 {
+		t.Fatalf("expected no service clients before first use, got %d", len(client.conns))
+	}
+
+	var wg sync.WaitGroup
+	conns := make([]*ec2.EC2, 20)
+
+	for i := range conns {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			conns[i] = client.ec2conn()
+		}(i)
+	}
+
+	wg.Wait()
+
+	for i, conn := range conns {
+		if conn != conns[0] {
+			t.Fatalf("%d: expected the same EC2 client on each use", i)
+		}
+	}
+
+	if len(client.conns) != 1 {
+		t.Fatalf("expected only the EC2 client to be created, got %d", len(client.conns))
+	}
+
+	if got, expected := conns[0].Endpoint, "http://localhost:4597"; got != expected {
+		t.Fatalf("bad EC2 endpoint: %q, expected: %q", got, expected)
+	}
+
+	if got, expected := client.r53conn().SigningRegion, "us-east-1"; got != expected {
+		t.Fatalf("bad Route 53 signing region: %q, expected: %q", got, expected)
+	}
+}
+
+func TestAWSClientConn_retryHandlers(t *testing.T) {
+	client := testAWSClientLazyConns(t)
+	defaultConn := kinesis.New(client.session)
+
+	// The kinesis client is created with two additional retry handlers
+	if got, expected := client.kinesisconn().Handlers.Retry.Len(), defaultConn.Handlers.Retry.Len()+2; got != expected {
+		t.Fatalf("bad number of Kinesis retry handlers: %d, expected: %d", got, expected)
+	}
+}
+
+func TestAWSClientShieldRegion(t *testing.T) {
+	cases := []struct {
+		Partition string
+		Region    string
+		Expected  string
+	}{
+		{
+			Partition: "aws",
+			Region:    "eu-west-1",
+			Expected:  "us-east-1",
+		},
+		{
+			Partition: "aws-us-gov",
+			Region:    "us-gov-east-1",
+			Expected:  "us-gov-west-1",
+		},
+		{
+			Region:   "us-gov-east-1",
+			Expected: "us-gov-west-1",
+		},
+		{
+			Partition: "aws-cn",
+			Region:    "cn-northwest-1",
+			Expected:  "cn-northwest-1",
+		},
+	}
+
+	for _, tc := range cases {
+		client := &AWSClient{
+			partition: tc.Partition,
+			region:    tc.Region,
+		}
+
+		if got := client.shieldRegion(); got != tc.Expected {
+			t.Errorf("%s (%s): expected %q, received %q", tc.Region, tc.Partition, tc.Expected, got)
+		}
+	}
+}
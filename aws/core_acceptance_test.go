@@ -10,7 +10,7 @@ import (
 func TestAccAWSVpc_coreMismatchedDiffs(t *testing.T) {
 	var vpc ec2.Vpc
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccCassetteParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckVpcDestroy,
@@ -42,7 +42,7 @@ func dataSourceAwsAcmCertificate() *schema.Resource {
 }
 
 func dataSourceAwsAcmCertificateRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).acmconn
+	conn := meta.(*AWSClient).acmconn()
 
 	params := &acm.ListCertificatesInput{}
 	target := d.Get("domain")
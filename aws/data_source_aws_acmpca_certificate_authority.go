@@ -92,7 +92,7 @@ func dataSourceAwsAcmpcaCertificateAuthority() *schema.Resource {
 }
 
 func dataSourceAwsAcmpcaCertificateAuthorityRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).acmpcaconn
+	conn := meta.(*AWSClient).acmpcaconn()
 	certificateAuthorityArn := d.Get("arn").(string)
 
 	describeCertificateAuthorityInput := &acmpca.DescribeCertificateAuthorityInput{
@@ -56,7 +56,7 @@ func dataSourceAwsAmiIds() *schema.Resource {
 }
 
 func dataSourceAwsAmiIdsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	params := &ec2.DescribeImagesInput{
 		Owners: expandStringList(d.Get("owners").([]interface{})),
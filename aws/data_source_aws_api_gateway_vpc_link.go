@@ -28,7 +28,7 @@ func dataSourceAwsApiGatewayVpcLink() *schema.Resource {
 }
 
 func dataSourceAwsApiGatewayVpcLinkRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).apigateway
+	conn := meta.(*AWSClient).apigateway()
 	params := &apigateway.GetVpcLinksInput{}
 
 	target := d.Get("name")
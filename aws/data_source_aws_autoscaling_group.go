@@ -105,7 +105,7 @@ func dataSourceAwsAutoscalingGroup() *schema.Resource {
 }
 
 func dataSourceAwsAutoscalingGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).autoscalingconn
+	conn := meta.(*AWSClient).autoscalingconn()
 	d.SetId(time.Now().UTC().String())
 
 	groupName := d.Get("name").(string)
@@ -49,7 +49,7 @@ func dataSourceAwsAutoscalingGroups() *schema.Resource {
 }
 
 func dataSourceAwsAutoscalingGroupsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).autoscalingconn
+	conn := meta.(*AWSClient).autoscalingconn()
 
 	log.Printf("[DEBUG] Reading Autoscaling Groups.")
 	d.SetId(time.Now().UTC().String())
@@ -42,7 +42,7 @@ func dataSourceAwsAvailabilityZones() *schema.Resource {
 }
 
 func dataSourceAwsAvailabilityZonesRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	log.Printf("[DEBUG] Reading Availability Zones.")
 	d.SetId(time.Now().UTC().String())
@@ -0,0 +1,65 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/backup"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsBackupPlan() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsBackupPlanRead,
+
+		Schema: map[string]*schema.Schema{
+			"plan_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchemaComputed(),
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsBackupPlanRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).backupconn()
+	id := d.Get("plan_id").(string)
+
+	resp, err := conn.GetBackupPlan(&backup.GetBackupPlanInput{
+		BackupPlanId: aws.String(id),
+	})
+	if err != nil {
+		return fmt.Errorf("error getting Backup Plan (%s): %s", id, err)
+	}
+
+	d.SetId(aws.StringValue(resp.BackupPlanId))
+	d.Set("arn", resp.BackupPlanArn)
+	d.Set("name", resp.BackupPlan.BackupPlanName)
+	d.Set("version", resp.VersionId)
+
+	tagsOutput, err := conn.ListTags(&backup.ListTagsInput{
+		ResourceArn: resp.BackupPlanArn,
+	})
+	if err != nil {
+		return fmt.Errorf("error listing tags for Backup Plan (%s): %s", id, err)
+	}
+
+	if err := d.Set("tags", tagsToMapGeneric(tagsOutput.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/backup"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsBackupVault() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsBackupVaultRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kms_key_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"recovery_points": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"tags": tagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceAwsBackupVaultRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).backupconn()
+	name := d.Get("name").(string)
+
+	resp, err := conn.DescribeBackupVault(&backup.DescribeBackupVaultInput{
+		BackupVaultName: aws.String(name),
+	})
+	if err != nil {
+		return fmt.Errorf("error getting Backup Vault (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(resp.BackupVaultName))
+	d.Set("arn", resp.BackupVaultArn)
+	d.Set("kms_key_arn", resp.EncryptionKeyArn)
+	d.Set("name", resp.BackupVaultName)
+	d.Set("recovery_points", resp.NumberOfRecoveryPoints)
+
+	tagsOutput, err := conn.ListTags(&backup.ListTagsInput{
+		ResourceArn: resp.BackupVaultArn,
+	})
+	if err != nil {
+		return fmt.Errorf("error listing tags for Backup Vault (%s): %s", name, err)
+	}
+
+	if err := d.Set("tags", tagsToMapGeneric(tagsOutput.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
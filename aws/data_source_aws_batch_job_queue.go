@@ -66,7 +66,7 @@ func dataSourceAwsBatchJobQueue() *schema.Resource {
 }
 
 func dataSourceAwsBatchJobQueueRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).batchconn
+	conn := meta.(*AWSClient).batchconn()
 
 	params := &batch.DescribeJobQueuesInput{
 		JobQueues: []*string{aws.String(d.Get("name").(string))},
@@ -33,7 +33,7 @@ func dataSourceAwsCallerIdentity() *schema.Resource {
 }
 
 func dataSourceAwsCallerIdentityRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).stsconn
+	client := meta.(*AWSClient).stsconn()
 
 	log.Printf("[DEBUG] Reading Caller Identity")
 	res, err := client.GetCallerIdentity(&sts.GetCallerIdentityInput{})
@@ -30,7 +30,7 @@ func dataSourceAwsCloudFormationExport() *schema.Resource {
 }
 
 func dataSourceAwsCloudFormationExportRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cfconn
+	conn := meta.(*AWSClient).cfconn()
 	var value string
 	name := d.Get("name").(string)
 	region := meta.(*AWSClient).region
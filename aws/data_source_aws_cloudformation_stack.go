@@ -71,7 +71,7 @@ func dataSourceAwsCloudFormationStack() *schema.Resource {
 }
 
 func dataSourceAwsCloudFormationStackRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cfconn
+	conn := meta.(*AWSClient).cfconn()
 	name := d.Get("name").(string)
 	input := &cloudformation.DescribeStacksInput{
 		StackName: aws.String(name),
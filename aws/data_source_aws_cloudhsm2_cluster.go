@@ -75,7 +75,7 @@ func dataSourceCloudHsm2Cluster() *schema.Resource {
 }
 
 func dataSourceCloudHsm2ClusterRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudhsmv2conn
+	conn := meta.(*AWSClient).cloudhsmv2conn()
 
 	clusterId := d.Get("cluster_id").(string)
 	filters := []*string{&clusterId}
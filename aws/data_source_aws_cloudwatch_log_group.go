@@ -29,7 +29,7 @@ func dataSourceAwsCloudwatchLogGroup() *schema.Resource {
 
 func dataSourceAwsCloudwatchLogGroupRead(d *schema.ResourceData, meta interface{}) error {
 	name := d.Get("name").(string)
-	conn := meta.(*AWSClient).cloudwatchlogsconn
+	conn := meta.(*AWSClient).cloudwatchlogsconn()
 
 	logGroup, err := lookupCloudWatchLogGroup(conn, name)
 	if err != nil {
@@ -46,7 +46,7 @@ func dataSourceAwsCodeCommitRepository() *schema.Resource {
 }
 
 func dataSourceAwsCodeCommitRepositoryRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).codecommitconn
+	conn := meta.(*AWSClient).codecommitconn()
 
 	repositoryName := d.Get("repository_name").(string)
 	input := &codecommit.GetRepositoryInput{
@@ -109,7 +109,7 @@ func dataSourceAwsDbClusterSnapshot() *schema.Resource {
 }
 
 func dataSourceAwsDbClusterSnapshotRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).rdsconn
+	conn := meta.(*AWSClient).rdsconn()
 
 	clusterIdentifier, clusterIdentifierOk := d.GetOk("db_cluster_identifier")
 	snapshotIdentifier, snapshotIdentifierOk := d.GetOk("db_cluster_snapshot_identifier")
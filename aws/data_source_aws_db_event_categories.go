@@ -30,7 +30,7 @@ func dataSourceAwsDbEventCategories() *schema.Resource {
 }
 
 func dataSourceAwsDbEventCategoriesRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).rdsconn
+	conn := meta.(*AWSClient).rdsconn()
 
 	req := &rds.DescribeEventCategoriesInput{}
 
@@ -209,7 +209,7 @@ func dataSourceAwsDbInstance() *schema.Resource {
 }
 
 func dataSourceAwsDbInstanceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).rdsconn
+	conn := meta.(*AWSClient).rdsconn()
 
 	opts := &rds.DescribeDBInstancesInput{
 		DBInstanceIdentifier: aws.String(d.Get("db_instance_identifier").(string)),
@@ -129,7 +129,7 @@ func dataSourceAwsDbSnapshot() *schema.Resource {
 }
 
 func dataSourceAwsDbSnapshotRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).rdsconn
+	conn := meta.(*AWSClient).rdsconn()
 
 	instanceIdentifier, instanceIdentifierOk := d.GetOk("db_instance_identifier")
 	snapshotIdentifier, snapshotIdentifierOk := d.GetOk("db_snapshot_identifier")
@@ -203,7 +203,7 @@ func dataSourceAwsDynamoDbTable() *schema.Resource {
 }
 
 func dataSourceAwsDynamoDbTableRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dynamodbconn
+	conn := meta.(*AWSClient).dynamodbconn()
 
 	result, err := conn.DescribeTable(&dynamodb.DescribeTableInput{
 		TableName: aws.String(d.Get("name").(string)),
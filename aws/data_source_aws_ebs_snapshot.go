@@ -88,7 +88,7 @@ func dataSourceAwsEbsSnapshot() *schema.Resource {
 }
 
 func dataSourceAwsEbsSnapshotRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	restorableUsers, restorableUsersOk := d.GetOk("restorable_by_user_ids")
 	filters, filtersOk := d.GetOk("filter")
@@ -70,7 +70,7 @@ func dataSourceAwsEc2TransitGateway() *schema.Resource {
 }
 
 func dataSourceAwsEc2TransitGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	input := &ec2.DescribeTransitGatewaysInput{}
 
@@ -38,7 +38,7 @@ func dataSourceAwsEc2TransitGatewayRouteTable() *schema.Resource {
 }
 
 func dataSourceAwsEc2TransitGatewayRouteTableRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	input := &ec2.DescribeTransitGatewayRouteTablesInput{}
 
@@ -51,7 +51,7 @@ func dataSourceAwsEc2TransitGatewayVpcAttachment() *schema.Resource {
 }
 
 func dataSourceAwsEc2TransitGatewayVpcAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	input := &ec2.DescribeTransitGatewayVpcAttachmentsInput{}
 
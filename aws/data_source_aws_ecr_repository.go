@@ -37,7 +37,7 @@ func dataSourceAwsEcrRepository() *schema.Resource {
 }
 
 func dataSourceAwsEcrRepositoryRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ecrconn
+	conn := meta.(*AWSClient).ecrconn()
 
 	params := &ecr.DescribeRepositoriesInput{
 		RepositoryNames: aws.StringSlice([]string{d.Get("name").(string)}),
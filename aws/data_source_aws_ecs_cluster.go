@@ -49,7 +49,7 @@ func dataSourceAwsEcsCluster() *schema.Resource {
 }
 
 func dataSourceAwsEcsClusterRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ecsconn
+	conn := meta.(*AWSClient).ecsconn()
 
 	params := &ecs.DescribeClustersInput{
 		Clusters: []*string{aws.String(d.Get("cluster_name").(string))},
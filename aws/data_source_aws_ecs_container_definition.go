@@ -65,7 +65,7 @@ func dataSourceAwsEcsContainerDefinition() *schema.Resource {
 }
 
 func dataSourceAwsEcsContainerDefinitionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ecsconn
+	conn := meta.(*AWSClient).ecsconn()
 
 	params := &ecs.DescribeTaskDefinitionInput{
 		TaskDefinition: aws.String(d.Get("task_definition").(string)),
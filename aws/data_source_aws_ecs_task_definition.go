@@ -45,7 +45,7 @@ func dataSourceAwsEcsTaskDefinition() *schema.Resource {
 }
 
 func dataSourceAwsEcsTaskDefinitionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ecsconn
+	conn := meta.(*AWSClient).ecsconn()
 
 	params := &ecs.DescribeTaskDefinitionInput{
 		TaskDefinition: aws.String(d.Get("task_definition").(string)),
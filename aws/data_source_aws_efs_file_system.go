@@ -55,7 +55,7 @@ func dataSourceAwsEfsFileSystem() *schema.Resource {
 }
 
 func dataSourceAwsEfsFileSystemRead(d *schema.ResourceData, meta interface{}) error {
-	efsconn := meta.(*AWSClient).efsconn
+	efsconn := meta.(*AWSClient).efsconn()
 
 	describeEfsOpts := &efs.DescribeFileSystemsInput{}
 
@@ -68,7 +68,7 @@ func dataSourceAwsEip() *schema.Resource {
 }
 
 func dataSourceAwsEipRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	req := &ec2.DescribeAddressesInput{}
 
@@ -94,7 +94,7 @@ func dataSourceAwsEksCluster() *schema.Resource {
 }
 
 func dataSourceAwsEksClusterRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).eksconn
+	conn := meta.(*AWSClient).eksconn()
 	name := d.Get("name").(string)
 
 	input := &eks.DescribeClusterInput{
@@ -30,7 +30,7 @@ func dataSourceAwsEksClusterAuth() *schema.Resource {
 }
 
 func dataSourceAwsEksClusterAuthRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).stsconn
+	conn := meta.(*AWSClient).stsconn()
 	name := d.Get("name").(string)
 	generator, err := token.NewGenerator(false)
 	if err != nil {
@@ -56,7 +56,7 @@ func dataSourceAwsElasticBeanstalkApplication() *schema.Resource {
 }
 
 func dataSourceAwsElasticBeanstalkApplicationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elasticbeanstalkconn
+	conn := meta.(*AWSClient).elasticbeanstalkconn()
 
 	// Get the name and description
 	name := d.Get("name").(string)
@@ -151,7 +151,7 @@ func dataSourceAwsElastiCacheCluster() *schema.Resource {
 }
 
 func dataSourceAwsElastiCacheClusterRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elasticacheconn
+	conn := meta.(*AWSClient).elasticacheconn()
 
 	req := &elasticache.DescribeCacheClustersInput{
 		CacheClusterId:    aws.String(d.Get("cluster_id").(string)),
@@ -191,7 +191,7 @@ func dataSourceAwsElb() *schema.Resource {
 }
 
 func dataSourceAwsElbRead(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbconn
+	elbconn := meta.(*AWSClient).elbconn()
 	lbName := d.Get("name").(string)
 
 	input := &elb.DescribeLoadBalancersInput{
@@ -208,5 +208,5 @@ func dataSourceAwsElbRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.SetId(*resp.LoadBalancerDescriptions[0].LoadBalancerName)
 
-	return flattenAwsELbResource(d, meta.(*AWSClient).ec2conn, elbconn, resp.LoadBalancerDescriptions[0])
+	return flattenAwsELbResource(d, meta.(*AWSClient).ec2conn(), elbconn, resp.LoadBalancerDescriptions[0])
 }
@@ -35,7 +35,7 @@ func dataSourceAwsIAMGroup() *schema.Resource {
 }
 
 func dataSourceAwsIAMGroupRead(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 
 	groupName := d.Get("group_name").(string)
 
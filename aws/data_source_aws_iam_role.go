@@ -71,7 +71,7 @@ func dataSourceAwsIAMRole() *schema.Resource {
 }
 
 func dataSourceAwsIAMRoleRead(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 	name := d.Get("name").(string)
 
 	input := &iam.GetRoleInput{
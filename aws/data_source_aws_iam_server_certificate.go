@@ -97,7 +97,7 @@ func (m certificateByExpiration) Less(i, j int) bool {
 }
 
 func dataSourceAwsIAMServerCertificateRead(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 
 	var matcher = func(cert *iam.ServerCertificateMetadata) bool {
 		return strings.HasPrefix(aws.StringValue(cert.ServerCertificateName), d.Get("name_prefix").(string))
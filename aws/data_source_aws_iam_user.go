@@ -39,7 +39,7 @@ func dataSourceAwsIAMUser() *schema.Resource {
 }
 
 func dataSourceAwsIAMUserRead(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 	userName := d.Get("user_name").(string)
 	req := &iam.GetUserInput{
 		UserName: aws.String(userName),
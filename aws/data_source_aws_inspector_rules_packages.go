@@ -26,7 +26,7 @@ func dataSourceAwsInspectorRulesPackages() *schema.Resource {
 }
 
 func dataSourceAwsInspectorRulesPackagesRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).inspectorconn
+	conn := meta.(*AWSClient).inspectorconn()
 
 	log.Printf("[DEBUG] Reading Rules Packages.")
 	d.SetId(time.Now().UTC().String())
@@ -9,7 +9,7 @@ import (
 )
 
 func TestAccAWSInstancesDataSource_basic(t *testing.T) {
-	resource.ParallelTest(t, resource.TestCase{
+	testAccCassetteParallelTest(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
 		Providers: testAccProviders,
 		Steps: []resource.TestStep{
@@ -28,7 +28,7 @@ func TestAccAWSInstancesDataSource_basic(t *testing.T) {
 
 func TestAccAWSInstancesDataSource_tags(t *testing.T) {
 	rInt := acctest.RandInt()
-	resource.ParallelTest(t, resource.TestCase{
+	testAccCassetteParallelTest(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
 		Providers: testAccProviders,
 		Steps: []resource.TestStep{
@@ -44,7 +44,7 @@ func TestAccAWSInstancesDataSource_tags(t *testing.T) {
 
 func TestAccAWSInstancesDataSource_instance_state_names(t *testing.T) {
 	rInt := acctest.RandInt()
-	resource.ParallelTest(t, resource.TestCase{
+	testAccCassetteParallelTest(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
 		Providers: testAccProviders,
 		Steps: []resource.TestStep{
@@ -45,7 +45,7 @@ func dataSourceAwsInternetGateway() *schema.Resource {
 }
 
 func dataSourceAwsInternetGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	req := &ec2.DescribeInternetGatewaysInput{}
 	internetGatewayId, internetGatewayIdOk := d.GetOk("internet_gateway_id")
 	tags, tagsOk := d.GetOk("tags")
@@ -32,7 +32,7 @@ func dataSourceAwsIotEndpoint() *schema.Resource {
 }
 
 func dataSourceAwsIotEndpointRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).iotconn()
 	input := &iot.DescribeEndpointInput{}
 
 	if v, ok := d.GetOk("endpoint_type"); ok {
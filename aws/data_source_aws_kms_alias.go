@@ -36,7 +36,7 @@ func dataSourceAwsKmsAlias() *schema.Resource {
 }
 
 func dataSourceAwsKmsAliasRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kmsconn
+	conn := meta.(*AWSClient).kmsconn()
 	params := &kms.ListAliasesInput{}
 
 	target := d.Get("name")
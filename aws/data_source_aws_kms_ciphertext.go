@@ -40,7 +40,7 @@ func dataSourceAwsKmsCiphertext() *schema.Resource {
 }
 
 func dataSourceAwsKmsCiphertextRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kmsconn
+	conn := meta.(*AWSClient).kmsconn()
 
 	d.SetId(time.Now().UTC().String())
 
@@ -76,7 +76,7 @@ func dataSourceAwsKmsKey() *schema.Resource {
 }
 
 func dataSourceAwsKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kmsconn
+	conn := meta.(*AWSClient).kmsconn()
 	keyId := d.Get("key_id")
 	var grantTokens []*string
 	if v, ok := d.GetOk("grant_tokens"); ok {
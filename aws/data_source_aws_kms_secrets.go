@@ -56,7 +56,7 @@ func dataSourceAwsKmsSecrets() *schema.Resource {
 }
 
 func dataSourceAwsKmsSecretsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kmsconn
+	conn := meta.(*AWSClient).kmsconn()
 
 	secrets := d.Get("secret").(*schema.Set)
 	plaintext := make(map[string]string, len(secrets.List()))
@@ -163,7 +163,7 @@ func dataSourceAwsLambdaFunction() *schema.Resource {
 }
 
 func dataSourceAwsLambdaFunctionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lambdaconn
+	conn := meta.(*AWSClient).lambdaconn()
 	functionName := d.Get("function_name").(string)
 
 	input := &lambda.GetFunctionInput{
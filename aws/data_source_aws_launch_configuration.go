@@ -179,8 +179,8 @@ func dataSourceAwsLaunchConfiguration() *schema.Resource {
 }
 
 func dataSourceAwsLaunchConfigurationRead(d *schema.ResourceData, meta interface{}) error {
-	autoscalingconn := meta.(*AWSClient).autoscalingconn
-	ec2conn := meta.(*AWSClient).ec2conn
+	autoscalingconn := meta.(*AWSClient).autoscalingconn()
+	ec2conn := meta.(*AWSClient).ec2conn()
 
 	if v, ok := d.GetOk("name"); ok {
 		d.SetId(v.(string))
@@ -341,7 +341,7 @@ func dataSourceAwsLaunchTemplate() *schema.Resource {
 }
 
 func dataSourceAwsLaunchTemplateRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	log.Printf("[DEBUG] Reading launch template %s", d.Get("name"))
 
@@ -124,7 +124,7 @@ func dataSourceAwsLb() *schema.Resource {
 }
 
 func dataSourceAwsLbRead(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbv2conn
+	elbconn := meta.(*AWSClient).elbv2conn()
 	lbArn := d.Get("arn").(string)
 	lbName := d.Get("name").(string)
 
@@ -225,7 +225,7 @@ func dataSourceAwsLbListenerRead(d *schema.ResourceData, meta interface{}) error
 		return resourceAwsLbListenerRead(d, meta)
 	}
 
-	conn := meta.(*AWSClient).elbv2conn
+	conn := meta.(*AWSClient).elbv2conn()
 	lbArn, lbOk := d.GetOk("load_balancer_arn")
 	port, portOk := d.GetOk("port")
 	if !lbOk || !portOk {
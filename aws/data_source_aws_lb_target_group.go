@@ -136,7 +136,7 @@ func dataSourceAwsLbTargetGroup() *schema.Resource {
 }
 
 func dataSourceAwsLbTargetGroupRead(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbv2conn
+	elbconn := meta.(*AWSClient).elbv2conn()
 	tgArn := d.Get("arn").(string)
 	tgName := d.Get("name").(string)
 
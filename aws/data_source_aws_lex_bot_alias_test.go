@@ -0,0 +1,43 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAwsLexBotAlias_basic(t *testing.T) {
+	rName := acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)
+	dataSourceName := "data.aws_lex_bot_alias.test"
+	resourceName := "aws_lex_bot_alias.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPreCheckAWSLex(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsLexBotAliasConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "bot_name", resourceName, "bot_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "bot_version", resourceName, "bot_version"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "checksum", resourceName, "checksum"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "created_date", resourceName, "created_date"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "last_updated_date", resourceName, "last_updated_date"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsLexBotAliasConfig(rName string) string {
+	return testAccAWSLexBotAliasConfig_basic(rName, "Testing Lex Bot Alias") + `
+data "aws_lex_bot_alias" "test" {
+  bot_name = "${aws_lex_bot_alias.test.bot_name}"
+  name     = "${aws_lex_bot_alias.test.name}"
+}
+`
+}
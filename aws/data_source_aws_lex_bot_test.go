@@ -0,0 +1,43 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAwsLexBot_basic(t *testing.T) {
+	rName := acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)
+	dataSourceName := "data.aws_lex_bot.test"
+	resourceName := "aws_lex_bot.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPreCheckAWSLex(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsLexBotConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "checksum", resourceName, "checksum"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "child_directed", resourceName, "child_directed"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "idle_session_ttl_in_seconds", resourceName, "idle_session_ttl_in_seconds"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "locale", resourceName, "locale"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "status", resourceName, "status"),
+					resource.TestCheckResourceAttr(dataSourceName, "version", lexVersionLatest),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsLexBotConfig(rName string) string {
+	return testAccAWSLexBotConfig_basic(rName) + `
+data "aws_lex_bot" "test" {
+  name = "${aws_lex_bot.test.name}"
+}
+`
+}
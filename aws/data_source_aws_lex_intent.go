@@ -0,0 +1,85 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceAwsLexIntent() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsLexIntentRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_updated_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 100),
+					validation.StringMatch(regexp.MustCompile(`^([A-Za-z]_?)+$`), "must contain only letters separated by single underscores"),
+				),
+			},
+			"parent_intent_signature": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      lexVersionLatest,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+		},
+	}
+}
+
+func dataSourceAwsLexIntentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn()
+	name := d.Get("name").(string)
+
+	output, err := conn.GetIntent(&lexmodelbuildingservice.GetIntentInput{
+		Name:    aws.String(name),
+		Version: aws.String(d.Get("version").(string)),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading Lex Intent (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+	d.Set("arn", lexIntentArn(meta.(*AWSClient), name))
+	d.Set("checksum", output.Checksum)
+	d.Set("created_date", aws.TimeValue(output.CreatedDate).Format(time.RFC3339))
+	d.Set("description", output.Description)
+	d.Set("last_updated_date", aws.TimeValue(output.LastUpdatedDate).Format(time.RFC3339))
+	d.Set("name", output.Name)
+	d.Set("parent_intent_signature", output.ParentIntentSignature)
+	d.Set("version", output.Version)
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAwsLexIntent_basic(t *testing.T) {
+	rName := acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)
+	dataSourceName := "data.aws_lex_intent.test"
+	resourceName := "aws_lex_intent.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPreCheckAWSLex(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsLexIntentConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "checksum", resourceName, "checksum"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "created_date", resourceName, "created_date"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "last_updated_date", resourceName, "last_updated_date"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "version", lexVersionLatest),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsLexIntentConfig(rName string) string {
+	return testAccAWSLexIntentConfig_basic(rName) + `
+data "aws_lex_intent" "test" {
+  name = "${aws_lex_intent.test.name}"
+}
+`
+}
@@ -0,0 +1,103 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceAwsLexSlotType() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsLexSlotTypeRead,
+
+		Schema: map[string]*schema.Schema{
+			"checksum": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"enumeration_value": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"synonyms": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"last_updated_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 100),
+					validation.StringMatch(regexp.MustCompile(`^([A-Za-z]_?)+$`), "must contain only letters separated by single underscores"),
+				),
+			},
+			"value_selection_strategy": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      lexVersionLatest,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+		},
+	}
+}
+
+func dataSourceAwsLexSlotTypeRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn()
+	name := d.Get("name").(string)
+
+	output, err := conn.GetSlotType(&lexmodelbuildingservice.GetSlotTypeInput{
+		Name:    aws.String(name),
+		Version: aws.String(d.Get("version").(string)),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading Lex Slot Type (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+	d.Set("checksum", output.Checksum)
+	d.Set("created_date", aws.TimeValue(output.CreatedDate).Format(time.RFC3339))
+	d.Set("description", output.Description)
+
+	if err := d.Set("enumeration_value", flattenLexEnumerationValues(output.EnumerationValues)); err != nil {
+		return fmt.Errorf("error setting enumeration_value: %s", err)
+	}
+
+	d.Set("last_updated_date", aws.TimeValue(output.LastUpdatedDate).Format(time.RFC3339))
+	d.Set("name", output.Name)
+	d.Set("value_selection_strategy", output.ValueSelectionStrategy)
+	d.Set("version", output.Version)
+
+	return nil
+}
@@ -179,7 +179,7 @@ func dataSourceAwsmQBrokerRead(d *schema.ResourceData, meta interface{}) error {
 	if brokerId, ok := d.GetOk("broker_id"); ok {
 		d.SetId(brokerId.(string))
 	} else {
-		conn := meta.(*AWSClient).mqconn
+		conn := meta.(*AWSClient).mqconn()
 		brokerName := d.Get("broker_name").(string)
 		var nextToken string
 		for {
@@ -0,0 +1,111 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kafka"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceAwsMskCluster() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsMskClusterRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bootstrap_brokers": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"kafka_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"number_of_broker_nodes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"zookeeper_connect_string": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsMskClusterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kafkaconn()
+
+	clusterName := d.Get("cluster_name").(string)
+	input := &kafka.ListClustersInput{
+		ClusterNameFilter: aws.String(clusterName),
+	}
+	var clusters []*kafka.ClusterInfo
+
+	// The name filter matches on prefix, so only keep exact matches.
+	for {
+		output, err := conn.ListClusters(input)
+
+		if err != nil {
+			return fmt.Errorf("error listing MSK Clusters: %s", err)
+		}
+
+		if output == nil {
+			break
+		}
+
+		for _, cluster := range output.ClusterInfoList {
+			if aws.StringValue(cluster.ClusterName) == clusterName {
+				clusters = append(clusters, cluster)
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	if len(clusters) == 0 {
+		return fmt.Errorf("error reading MSK Cluster (%s): no results found", clusterName)
+	}
+
+	if len(clusters) > 1 {
+		return fmt.Errorf("error reading MSK Cluster (%s): multiple results found, try adjusting search criteria", clusterName)
+	}
+
+	cluster := clusters[0]
+
+	bootstrapBrokersOutput, err := conn.GetBootstrapBrokers(&kafka.GetBootstrapBrokersInput{
+		ClusterArn: cluster.ClusterArn,
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading MSK Cluster (%s) bootstrap brokers: %s", aws.StringValue(cluster.ClusterArn), err)
+	}
+
+	d.SetId(aws.StringValue(cluster.ClusterArn))
+	d.Set("arn", cluster.ClusterArn)
+	d.Set("bootstrap_brokers", bootstrapBrokersOutput.BootstrapBrokerString)
+	d.Set("cluster_name", cluster.ClusterName)
+
+	if cluster.CurrentBrokerSoftwareInfo != nil {
+		d.Set("kafka_version", cluster.CurrentBrokerSoftwareInfo.KafkaVersion)
+	}
+
+	d.Set("number_of_broker_nodes", cluster.NumberOfBrokerNodes)
+	d.Set("zookeeper_connect_string", cluster.ZookeeperConnectString)
+
+	return nil
+}
@@ -57,7 +57,7 @@ func dataSourceAwsNatGateway() *schema.Resource {
 }
 
 func dataSourceAwsNatGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	req := &ec2.DescribeNatGatewaysInput{}
 
@@ -132,7 +132,7 @@ func dataSourceAwsNetworkInterface() *schema.Resource {
 }
 
 func dataSourceAwsNetworkInterfaceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	input := &ec2.DescribeNetworkInterfacesInput{}
 	if v, ok := d.GetOk("id"); ok {
@@ -31,7 +31,7 @@ func dataSourceAwsNetworkInterfaces() *schema.Resource {
 }
 
 func dataSourceAwsNetworkInterfacesRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	req := &ec2.DescribeNetworkInterfacesInput{}
 
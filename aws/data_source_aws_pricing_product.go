@@ -46,7 +46,7 @@ func dataSourceAwsPricingProduct() *schema.Resource {
 }
 
 func dataSourceAwsPricingProductRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).pricingconn
+	conn := meta.(*AWSClient).pricingconn()
 
 	params := &pricing.GetProductsInput{
 		ServiceCode: aws.String(d.Get("service_code").(string)),
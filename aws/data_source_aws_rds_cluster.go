@@ -160,7 +160,7 @@ func dataSourceAwsRdsCluster() *schema.Resource {
 }
 
 func dataSourceAwsRdsClusterRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).rdsconn
+	conn := meta.(*AWSClient).rdsconn()
 
 	dbClusterIdentifier := d.Get("cluster_identifier").(string)
 
@@ -169,7 +169,7 @@ func dataSourceAwsRedshiftCluster() *schema.Resource {
 }
 
 func dataSourceAwsRedshiftClusterRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).redshiftconn
+	conn := meta.(*AWSClient).redshiftconn()
 
 	cluster := d.Get("cluster_identifier").(string)
 
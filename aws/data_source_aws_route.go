@@ -67,7 +67,7 @@ func dataSourceAwsRoute() *schema.Resource {
 }
 
 func dataSourceAwsRouteRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	req := &ec2.DescribeRouteTablesInput{}
 	rtbId := d.Get("route_table_id")
 	cidr := d.Get("destination_cidr_block")
@@ -52,7 +52,7 @@ func dataSourceAwsS3Bucket() *schema.Resource {
 }
 
 func dataSourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3conn
+	conn := meta.(*AWSClient).s3conn()
 
 	bucket := d.Get("bucket").(string)
 
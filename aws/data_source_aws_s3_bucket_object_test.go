@@ -178,7 +178,7 @@ func testAccCheckAwsS3ObjectDataSourceExists(n string, obj *s3.GetObjectOutput)
 			return fmt.Errorf("S3 object data source ID not set")
 		}
 
-		s3conn := testAccProvider.Meta().(*AWSClient).s3conn
+		s3conn := testAccProvider.Meta().(*AWSClient).s3conn()
 		out, err := s3conn.GetObject(
 			&s3.GetObjectInput{
 				Bucket: aws.String(rs.Primary.Attributes["bucket"]),
@@ -0,0 +1,144 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// s3ListObjectsV2PageSize is the maximum number of keys returned by a single
+// ListObjectsV2 request.
+const s3ListObjectsV2PageSize = 1000
+
+func dataSourceAwsS3BucketObjects() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsS3BucketObjectsRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"delimiter": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"start_after": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"max_keys": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1000,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"fetch_owner": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"keys": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"common_prefixes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"owners": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceAwsS3BucketObjectsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn()
+
+	bucket := d.Get("bucket").(string)
+	maxKeys := d.Get("max_keys").(int)
+
+	params := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+
+	if v, ok := d.GetOk("prefix"); ok {
+		params.Prefix = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("delimiter"); ok {
+		params.Delimiter = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("start_after"); ok {
+		params.StartAfter = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("fetch_owner"); ok {
+		params.FetchOwner = aws.Bool(v.(bool))
+	}
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(fmt.Sprintf("%s%d", params.String(), maxKeys))))
+
+	keys := make([]string, 0)
+	commonPrefixes := make([]string, 0)
+	owners := make([]string, 0)
+
+	// max_keys is the total number of keys returned, across all pages.
+	for remaining := maxKeys; remaining > 0; {
+		pageSize := remaining
+		if pageSize > s3ListObjectsV2PageSize {
+			pageSize = s3ListObjectsV2PageSize
+		}
+		params.MaxKeys = aws.Int64(int64(pageSize))
+
+		log.Printf("[DEBUG] Listing S3 Bucket Objects: %s", params)
+		resp, err := conn.ListObjectsV2(params)
+		if err != nil {
+			return fmt.Errorf("error listing S3 Bucket (%s) Objects: %s", bucket, err)
+		}
+
+		for _, commonPrefix := range resp.CommonPrefixes {
+			commonPrefixes = append(commonPrefixes, aws.StringValue(commonPrefix.Prefix))
+		}
+
+		for _, object := range resp.Contents {
+			keys = append(keys, aws.StringValue(object.Key))
+
+			if object.Owner != nil {
+				owners = append(owners, aws.StringValue(object.Owner.ID))
+			}
+		}
+
+		remaining -= len(resp.CommonPrefixes) + len(resp.Contents)
+
+		if !aws.BoolValue(resp.IsTruncated) {
+			break
+		}
+		params.ContinuationToken = resp.NextContinuationToken
+	}
+
+	if err := d.Set("keys", keys); err != nil {
+		return fmt.Errorf("error setting keys: %s", err)
+	}
+
+	if err := d.Set("common_prefixes", commonPrefixes); err != nil {
+		return fmt.Errorf("error setting common_prefixes: %s", err)
+	}
+
+	if err := d.Set("owners", owners); err != nil {
+		return fmt.Errorf("error setting owners: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,239 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/fakeaws"
+)
+
+func TestAccDataSourceAWSS3BucketObjects_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                  func() { testAccPreCheck(t) },
+		Providers:                 testAccProviders,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSourceS3ObjectsConfigResources(rInt), // NOTE: contains no data source
+				// Does not need Check
+			},
+			{
+				Config: testAccAWSDataSourceS3ObjectsConfigBasic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsS3ObjectsDataSourceExists("data.aws_s3_bucket_objects.yesh"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "keys.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "keys.0", "arch/navajo/north_window"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "keys.1", "arch/navajo/sand_dune"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAWSS3BucketObjects_all(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                  func() { testAccPreCheck(t) },
+		Providers:                 testAccProviders,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSourceS3ObjectsConfigResources(rInt), // NOTE: contains no data source
+				// Does not need Check
+			},
+			{
+				Config: testAccAWSDataSourceS3ObjectsConfigAll(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsS3ObjectsDataSourceExists("data.aws_s3_bucket_objects.yesh"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "keys.#", "7"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "keys.0", "arch/courthouse_towers/landscape"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "keys.6", "arch/rubicon"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "owners.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAWSS3BucketObjects_prefixes(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                  func() { testAccPreCheck(t) },
+		Providers:                 testAccProviders,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSourceS3ObjectsConfigResources(rInt), // NOTE: contains no data source
+				// Does not need Check
+			},
+			{
+				Config: testAccAWSDataSourceS3ObjectsConfigPrefixes(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsS3ObjectsDataSourceExists("data.aws_s3_bucket_objects.yesh"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "keys.#", "1"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "keys.0", "arch/rubicon"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "common_prefixes.#", "4"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "common_prefixes.0", "arch/courthouse_towers/"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "common_prefixes.1", "arch/navajo/"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "common_prefixes.2", "arch/partition/"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "common_prefixes.3", "arch/three_gossips/"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceAwsS3BucketObjects_fakeAws(t *testing.T) {
+	server := fakeaws.New()
+	defer server.Close()
+
+	rInt := acctest.RandInt()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:                 testAccProviders,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFakeAwsProviderConfig(server.URL) + testAccAWSDataSourceS3ObjectsConfigResources(rInt),
+			},
+			{
+				Config: testAccFakeAwsProviderConfig(server.URL) + testAccAWSDataSourceS3ObjectsConfigPrefixes(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "keys.#", "1"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "keys.0", "arch/rubicon"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "common_prefixes.#", "4"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "common_prefixes.0", "arch/courthouse_towers/"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "common_prefixes.3", "arch/three_gossips/"),
+				),
+			},
+			{
+				Config: testAccFakeAwsProviderConfig(server.URL) + testAccAWSDataSourceS3ObjectsConfigMaxKeys(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "keys.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "keys.0", "arch/navajo/north_window"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "keys.1", "arch/navajo/sand_dune"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "owners.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsS3ObjectsDataSourceExists(addr string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[addr]
+		if !ok {
+			return fmt.Errorf("Can't find S3 objects data source: %s", addr)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("S3 objects data source ID not set")
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSDataSourceS3ObjectsConfigResources(randInt int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "objects_bucket" {
+  bucket = "tf-objects-test-bucket-%d"
+}
+
+resource "aws_s3_bucket_object" "object1" {
+  bucket  = "${aws_s3_bucket.objects_bucket.id}"
+  key     = "arch/three_gossips/turret"
+  content = "Delicate"
+}
+
+resource "aws_s3_bucket_object" "object2" {
+  bucket  = "${aws_s3_bucket.objects_bucket.id}"
+  key     = "arch/three_gossips/broken"
+  content = "Dark Angel"
+}
+
+resource "aws_s3_bucket_object" "object3" {
+  bucket  = "${aws_s3_bucket.objects_bucket.id}"
+  key     = "arch/navajo/north_window"
+  content = "Balanced Rock"
+}
+
+resource "aws_s3_bucket_object" "object4" {
+  bucket  = "${aws_s3_bucket.objects_bucket.id}"
+  key     = "arch/navajo/sand_dune"
+  content = "Queen Victoria Rock"
+}
+
+resource "aws_s3_bucket_object" "object5" {
+  bucket  = "${aws_s3_bucket.objects_bucket.id}"
+  key     = "arch/partition/park_avenue"
+  content = "Double-O"
+}
+
+resource "aws_s3_bucket_object" "object6" {
+  bucket  = "${aws_s3_bucket.objects_bucket.id}"
+  key     = "arch/courthouse_towers/landscape"
+  content = "Fiery Furnace"
+}
+
+resource "aws_s3_bucket_object" "object7" {
+  bucket  = "${aws_s3_bucket.objects_bucket.id}"
+  key     = "arch/rubicon"
+  content = "Devils Garden"
+}
+`, randInt)
+}
+
+func testAccAWSDataSourceS3ObjectsConfigBasic(randInt int) string {
+	return fmt.Sprintf(`
+%s
+
+data "aws_s3_bucket_objects" "yesh" {
+  bucket    = "${aws_s3_bucket.objects_bucket.id}"
+  prefix    = "arch/navajo/"
+  delimiter = "/"
+}
+`, testAccAWSDataSourceS3ObjectsConfigResources(randInt))
+}
+
+func testAccAWSDataSourceS3ObjectsConfigAll(randInt int) string {
+	return fmt.Sprintf(`
+%s
+
+data "aws_s3_bucket_objects" "yesh" {
+  bucket = "${aws_s3_bucket.objects_bucket.id}"
+}
+`, testAccAWSDataSourceS3ObjectsConfigResources(randInt))
+}
+
+func testAccAWSDataSourceS3ObjectsConfigPrefixes(randInt int) string {
+	return fmt.Sprintf(`
+%s
+
+data "aws_s3_bucket_objects" "yesh" {
+  bucket    = "${aws_s3_bucket.objects_bucket.id}"
+  prefix    = "arch/"
+  delimiter = "/"
+}
+`, testAccAWSDataSourceS3ObjectsConfigResources(randInt))
+}
+
+func testAccAWSDataSourceS3ObjectsConfigMaxKeys(randInt int) string {
+	return fmt.Sprintf(`
+%s
+
+data "aws_s3_bucket_objects" "yesh" {
+  bucket      = "${aws_s3_bucket.objects_bucket.id}"
+  start_after = "arch/courthouse_towers/landscape"
+  max_keys    = 2
+  fetch_owner = true
+}
+`, testAccAWSDataSourceS3ObjectsConfigResources(randInt))
+}
@@ -68,7 +68,7 @@ func dataSourceAwsSecretsManagerSecret() *schema.Resource {
 }
 
 func dataSourceAwsSecretsManagerSecretRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).secretsmanagerconn
+	conn := meta.(*AWSClient).secretsmanagerconn()
 	var secretID string
 	if v, ok := d.GetOk("arn"); ok {
 		secretID = v.(string)
@@ -53,7 +53,7 @@ func dataSourceAwsSecretsManagerSecretVersion() *schema.Resource {
 }
 
 func dataSourceAwsSecretsManagerSecretVersionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).secretsmanagerconn
+	conn := meta.(*AWSClient).secretsmanagerconn()
 	secretID := d.Get("secret_id").(string)
 	var version string
 
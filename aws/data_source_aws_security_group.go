@@ -49,7 +49,7 @@ func dataSourceAwsSecurityGroup() *schema.Resource {
 }
 
 func dataSourceAwsSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	req := &ec2.DescribeSecurityGroupsInput{}
 
 	if id, ok := d.GetOk("id"); ok {
@@ -0,0 +1,103 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsShieldProtections() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsShieldProtectionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"protections": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsShieldProtectionsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).shieldconn()
+
+	input := &shield.ListProtectionsInput{}
+	var protections []*shield.Protection
+
+	log.Printf("[DEBUG] Reading Shield Protections: %s", input)
+	for {
+		output, err := conn.ListProtections(input)
+
+		// An account without any protections returns a not found error.
+		if isAWSErr(err, shield.ErrCodeResourceNotFoundException, "") {
+			break
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing Shield Protections: %s", err)
+		}
+
+		protections = append(protections, output.Protections...)
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	sort.Slice(protections, func(i, j int) bool {
+		return aws.StringValue(protections[i].Id) < aws.StringValue(protections[j].Id)
+	})
+
+	ids := make([]string, 0, len(protections))
+	tfList := make([]interface{}, 0, len(protections))
+
+	for _, protection := range protections {
+		ids = append(ids, aws.StringValue(protection.Id))
+		tfList = append(tfList, map[string]interface{}{
+			"id":           aws.StringValue(protection.Id),
+			"name":         aws.StringValue(protection.Name),
+			"resource_arn": aws.StringValue(protection.ResourceArn),
+		})
+	}
+
+	d.SetId(resource.UniqueId())
+
+	if err := d.Set("ids", ids); err != nil {
+		return fmt.Errorf("error setting ids: %s", err)
+	}
+
+	if err := d.Set("protections", tfList); err != nil {
+		return fmt.Errorf("error setting protections: %s", err)
+	}
+
+	return nil
+}
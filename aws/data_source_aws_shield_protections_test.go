@@ -0,0 +1,76 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccDataSourceAWSShieldProtections_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_shield_protections.test"
+	resourceName := "aws_shield_protection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSShield(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSShieldProtectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAWSShieldProtectionsConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSShieldProtectionsContains(dataSourceName, resourceName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSShieldProtectionsContains(dataSourceName, resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		ds, ok := s.RootModule().Resources[dataSourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", dataSourceName)
+		}
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		for k, v := range ds.Primary.Attributes {
+			if v != rs.Primary.ID {
+				continue
+			}
+
+			var i int
+			if _, err := fmt.Sscanf(k, "protections.%d.id", &i); err != nil {
+				continue
+			}
+
+			prefix := fmt.Sprintf("protections.%d.", i)
+			if ds.Primary.Attributes[prefix+"name"] != rs.Primary.Attributes["name"] {
+				return fmt.Errorf("%s: expected name %q, got %q", dataSourceName, rs.Primary.Attributes["name"], ds.Primary.Attributes[prefix+"name"])
+			}
+
+			if ds.Primary.Attributes[prefix+"resource_arn"] != rs.Primary.Attributes["resource_arn"] {
+				return fmt.Errorf("%s: expected resource_arn %q, got %q", dataSourceName, rs.Primary.Attributes["resource_arn"], ds.Primary.Attributes[prefix+"resource_arn"])
+			}
+
+			return nil
+		}
+
+		return fmt.Errorf("%s: Shield Protection (%s) not found", dataSourceName, rs.Primary.ID)
+	}
+}
+
+func testAccDataSourceAWSShieldProtectionsConfig(rName string) string {
+	return testAccShieldProtectionConfig(rName) + `
+data "aws_shield_protections" "test" {
+  depends_on = ["aws_shield_protection.test"]
+}
+`
+}
@@ -29,7 +29,7 @@ func dataSourceAwsSqsQueue() *schema.Resource {
 }
 
 func dataSourceAwsSqsQueueRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sqsconn
+	conn := meta.(*AWSClient).sqsconn()
 	name := d.Get("name").(string)
 
 	urlOutput, err := conn.GetQueueUrl(&sqs.GetQueueUrlInput{
@@ -49,7 +49,7 @@ func dataSourceAwsSsmDocument() *schema.Resource {
 }
 
 func dataAwsSsmDocumentRead(d *schema.ResourceData, meta interface{}) error {
-	ssmconn := meta.(*AWSClient).ssmconn
+	ssmconn := meta.(*AWSClient).ssmconn()
 
 	name := d.Get("name").(string)
 
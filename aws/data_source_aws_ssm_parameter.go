@@ -42,7 +42,7 @@ func dataSourceAwsSsmParameter() *schema.Resource {
 }
 
 func dataAwsSsmParameterRead(d *schema.ResourceData, meta interface{}) error {
-	ssmconn := meta.(*AWSClient).ssmconn
+	ssmconn := meta.(*AWSClient).ssmconn()
 
 	name := d.Get("name").(string)
 
@@ -95,7 +95,7 @@ func dataSourceAwsSubnet() *schema.Resource {
 }
 
 func dataSourceAwsSubnetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	req := &ec2.DescribeSubnetsInput{}
 
@@ -32,7 +32,7 @@ func dataSourceAwsSubnetIDs() *schema.Resource {
 }
 
 func dataSourceAwsSubnetIDsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	req := &ec2.DescribeSubnetsInput{}
 
@@ -46,7 +46,7 @@ func dataSourceAwsTransferServer() *schema.Resource {
 }
 
 func dataSourceAwsTransferServerRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).transferconn
+	conn := meta.(*AWSClient).transferconn()
 
 	serverID := d.Get("server_id").(string)
 	input := &transfer.DescribeServerInput{
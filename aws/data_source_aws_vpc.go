@@ -114,7 +114,7 @@ func dataSourceAwsVpc() *schema.Resource {
 }
 
 func dataSourceAwsVpcRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	req := &ec2.DescribeVpcsInput{}
 
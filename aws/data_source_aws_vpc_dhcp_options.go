@@ -55,7 +55,7 @@ func dataSourceAwsVpcDhcpOptions() *schema.Resource {
 }
 
 func dataSourceAwsVpcDhcpOptionsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	input := &ec2.DescribeDhcpOptionsInput{}
 
@@ -100,7 +100,7 @@ func dataSourceAwsVpcEndpoint() *schema.Resource {
 }
 
 func dataSourceAwsVpcEndpointRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	req := &ec2.DescribeVpcEndpointsInput{}
 
@@ -64,7 +64,7 @@ func dataSourceAwsVpcEndpointService() *schema.Resource {
 }
 
 func dataSourceAwsVpcEndpointServiceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	var serviceName string
 	if v, ok := d.GetOk("service_name"); ok {
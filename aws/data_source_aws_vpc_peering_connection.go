@@ -81,7 +81,7 @@ func dataSourceAwsVpcPeeringConnection() *schema.Resource {
 }
 
 func dataSourceAwsVpcPeeringConnectionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	log.Printf("[DEBUG] Reading VPC Peering Connections.")
 
@@ -47,7 +47,7 @@ func dataSourceAwsVpnGateway() *schema.Resource {
 }
 
 func dataSourceAwsVpnGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	req := &ec2.DescribeVpnGatewaysInput{}
 
@@ -70,7 +70,7 @@ func dataSourceAwsWorkspaceBundle() *schema.Resource {
 }
 
 func dataSourceAwsWorkspaceBundleRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).workspacesconn
+	conn := meta.(*AWSClient).workspacesconn()
 
 	bundleID := d.Get("bundle_id").(string)
 	input := &workspaces.DescribeWorkspaceBundlesInput{
@@ -0,0 +1,275 @@
+package aws
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// debugLoggingRedactedValue replaces the value of sensitive fields in logged payloads.
+const debugLoggingRedactedValue = "***"
+
+// debugLoggingSensitiveFields lists the payload fields whose value must never
+// be logged, per service name. The fields under the empty service name are
+// sensitive for all services. Field names are compared case-insensitively,
+// and any field name ending with "password" is always sensitive.
+var debugLoggingSensitiveFields = map[string][]string{
+	"": {
+		"AuthToken",
+		"Plaintext",
+		"PrivateKey",
+		"SecretAccessKey",
+		"SecretBinary",
+		"SecretString",
+		"SessionToken",
+	},
+	"ssm": {
+		"Value",
+	},
+}
+
+// debugLoggingHandler logs the operation name, request ID, latency and retry
+// count of each request once it completes, along with its request and response
+// payloads with the sensitive fields redacted.
+var debugLoggingHandler = request.NamedHandler{
+	Name: "terraform.DebugLoggingHandler",
+	Fn:   debugLogRequest,
+}
+
+func debugLogRequest(r *request.Request) {
+	requestBody := debugLoggingRequestBody(r)
+	responseBody := bytes.NewBuffer(nil)
+
+	// The Send handlers of the request are already running, tee the response
+	// body right after it is received instead
+	r.Handlers.ValidateResponse.SetFrontNamed(request.NamedHandler{
+		Name: "terraform.DebugLoggingHandler.Response",
+		Fn: func(r *request.Request) {
+			responseBody.Reset()
+
+			if r.HTTPResponse == nil || r.HTTPResponse.Body == nil || debugLoggingHasStreamingPayload(r.Data) {
+				return
+			}
+
+			r.HTTPResponse.Body = &debugLoggingTeeReadCloser{
+				Reader: io.TeeReader(r.HTTPResponse.Body, responseBody),
+				Source: r.HTTPResponse.Body,
+			}
+		},
+	})
+
+	r.Handlers.Complete.SetBackNamed(request.NamedHandler{
+		Name: "terraform.DebugLoggingHandler.Complete",
+		Fn: func(r *request.Request) {
+			var statusCode int
+			var contentType string
+			if r.HTTPResponse != nil {
+				statusCode = r.HTTPResponse.StatusCode
+				contentType = r.HTTPResponse.Header.Get("Content-Type")
+			}
+
+			msg := fmt.Sprintf("%s/%s (RequestId: %s) completed in %s with status %d after %d retries",
+				r.ClientInfo.ServiceName, r.Operation.Name, r.RequestID, time.Since(r.Time), statusCode, r.RetryCount)
+
+			if r.Error != nil {
+				msg += fmt.Sprintf(", error: %s", r.Error)
+			}
+
+			msg += fmt.Sprintf("\nRequest body: %s", requestBody)
+
+			if debugLoggingHasStreamingPayload(r.Data) {
+				msg += "\nResponse body: <streaming payload omitted>"
+			} else {
+				msg += fmt.Sprintf("\nResponse body: %s", redactDebugLoggingPayload(r.ClientInfo.ServiceName, contentType, responseBody.Bytes()))
+			}
+
+			log.Printf("[DEBUG] [aws-sdk-go] %s", msg)
+		},
+	})
+}
+
+// debugLoggingRequestBody returns the redacted payload of the request,
+// leaving the request body ready to be sent.
+func debugLoggingRequestBody(r *request.Request) string {
+	if r.Body == nil || debugLoggingHasStreamingPayload(r.Params) {
+		return "<streaming payload omitted>"
+	}
+
+	if _, err := r.Body.Seek(r.BodyStart, io.SeekStart); err != nil {
+		return fmt.Sprintf("<error reading payload: %s>", err)
+	}
+
+	b, err := ioutil.ReadAll(r.Body)
+
+	if _, seekErr := r.Body.Seek(r.BodyStart, io.SeekStart); seekErr != nil && err == nil {
+		err = seekErr
+	}
+
+	if err != nil {
+		return fmt.Sprintf("<error reading payload: %s>", err)
+	}
+
+	var contentType string
+	if r.HTTPRequest != nil {
+		contentType = r.HTTPRequest.Header.Get("Content-Type")
+	}
+
+	return redactDebugLoggingPayload(r.ClientInfo.ServiceName, contentType, b)
+}
+
+// debugLoggingHasStreamingPayload returns whether an operation input or output
+// carries a streaming payload, e.g. an S3 object body, which must not be read
+// for logging purposes.
+func debugLoggingHasStreamingPayload(v interface{}) bool {
+	rv := reflect.ValueOf(v)
+
+	for rv.Kind() == reflect.Ptr || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return false
+		}
+		rv = rv.Elem()
+	}
+
+	if rv.Kind() != reflect.Struct {
+		return false
+	}
+
+	readerType := reflect.TypeOf((*io.Reader)(nil)).Elem()
+
+	for i := 0; i < rv.NumField(); i++ {
+		fieldType := rv.Type().Field(i).Type
+
+		if fieldType.Kind() == reflect.Interface && fieldType.Implements(readerType) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// redactDebugLoggingPayload returns the payload with the value of the
+// sensitive fields of the service replaced. Payloads in an unknown format are
+// not logged.
+func redactDebugLoggingPayload(serviceName, contentType string, payload []byte) string {
+	trimmed := bytes.TrimSpace(payload)
+
+	if len(trimmed) == 0 {
+		return ""
+	}
+
+	switch {
+	case strings.Contains(contentType, "json") || trimmed[0] == '{' || trimmed[0] == '[':
+		return redactDebugLoggingJSONPayload(serviceName, trimmed)
+	case strings.Contains(contentType, "xml") || trimmed[0] == '<':
+		return redactDebugLoggingXMLPayload(serviceName, trimmed)
+	case strings.Contains(contentType, "x-www-form-urlencoded"):
+		return redactDebugLoggingQueryPayload(serviceName, trimmed)
+	}
+
+	return fmt.Sprintf("<%d bytes payload omitted>", len(payload))
+}
+
+func redactDebugLoggingJSONPayload(serviceName string, payload []byte) string {
+	var v interface{}
+
+	if err := json.Unmarshal(payload, &v); err != nil {
+		return fmt.Sprintf("<%d bytes invalid JSON payload omitted>", len(payload))
+	}
+
+	b, err := json.Marshal(redactDebugLoggingJSONValue(serviceName, v))
+
+	if err != nil {
+		return fmt.Sprintf("<%d bytes JSON payload omitted>", len(payload))
+	}
+
+	return string(b)
+}
+
+func redactDebugLoggingJSONValue(serviceName string, v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			if _, ok := e.(string); ok && isDebugLoggingSensitiveField(serviceName, k) {
+				v[k] = debugLoggingRedactedValue
+				continue
+			}
+
+			v[k] = redactDebugLoggingJSONValue(serviceName, e)
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = redactDebugLoggingJSONValue(serviceName, e)
+		}
+	}
+
+	return v
+}
+
+func redactDebugLoggingQueryPayload(serviceName string, payload []byte) string {
+	values, err := url.ParseQuery(string(payload))
+
+	if err != nil {
+		return fmt.Sprintf("<%d bytes invalid query payload omitted>", len(payload))
+	}
+
+	for k := range values {
+		// Only the last segment of flattened keys is the field name,
+		// e.g. Parameters.member.1.ParameterValue
+		name := k[strings.LastIndex(k, ".")+1:]
+
+		if isDebugLoggingSensitiveField(serviceName, name) {
+			values.Set(k, debugLoggingRedactedValue)
+		}
+	}
+
+	return values.Encode()
+}
+
+var debugLoggingXMLElementRegexp = regexp.MustCompile(`<([\w.-]+:)?([\w.-]+)(\s[^<>]*)?>([^<]*)</`)
+
+func redactDebugLoggingXMLPayload(serviceName string, payload []byte) string {
+	return debugLoggingXMLElementRegexp.ReplaceAllStringFunc(string(payload), func(element string) string {
+		submatches := debugLoggingXMLElementRegexp.FindStringSubmatch(element)
+
+		if !isDebugLoggingSensitiveField(serviceName, submatches[2]) || submatches[4] == "" {
+			return element
+		}
+
+		return fmt.Sprintf("<%s%s%s>%s</", submatches[1], submatches[2], submatches[3], debugLoggingRedactedValue)
+	})
+}
+
+func isDebugLoggingSensitiveField(serviceName, name string) bool {
+	if strings.HasSuffix(strings.ToLower(name), "password") {
+		return true
+	}
+
+	for _, fields := range [][]string{debugLoggingSensitiveFields[""], debugLoggingSensitiveFields[serviceName]} {
+		for _, field := range fields {
+			if strings.EqualFold(field, name) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+type debugLoggingTeeReadCloser struct {
+	io.Reader
+	Source io.ReadCloser
+}
+
+func (r *debugLoggingTeeReadCloser) Close() error {
+	return r.Source.Close()
+}
@@ -24,7 +24,7 @@ func dxVirtualInterfaceRead(id string, conn *directconnect.DirectConnect) (*dire
 }
 
 func dxVirtualInterfaceUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).dxconn()
 
 	req := &directconnect.UpdateVirtualInterfaceAttributesInput{
 		VirtualInterfaceId: aws.String(d.Id()),
@@ -52,7 +52,7 @@ func dxVirtualInterfaceUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func dxVirtualInterfaceDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).dxconn()
 
 	log.Printf("[DEBUG] Deleting Direct Connect virtual interface: %s", d.Id())
 	_, err := conn.DeleteVirtualInterface(&directconnect.DeleteVirtualInterfaceInput{
@@ -10,7 +10,7 @@ import (
 
 // Direct Connect Gateway import also imports all assocations
 func resourceAwsDxGatewayImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).dxconn()
 
 	id := d.Id()
 	resp, err := conn.DescribeDirectConnectGateways(&directconnect.DescribeDirectConnectGatewaysInput{
@@ -19,7 +19,7 @@ func TestAccAWSRouteTable_importBasic(t *testing.T) {
 		return nil
 	}
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccCassetteParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckRouteTableDestroy,
@@ -48,7 +48,7 @@ func TestAccAWSRouteTable_importWithCreate(t *testing.T) {
 		return nil
 	}
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccCassetteParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckRouteTableDestroy,
@@ -83,7 +83,7 @@ func TestAccAWSRouteTable_importComplex(t *testing.T) {
 		return nil
 	}
 
-	resource.Test(t, resource.TestCase{
+	testAccCassetteTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckRouteTableDestroy,
@@ -16,7 +16,7 @@ func resourceAwsS3BucketImportState(
 	results := make([]*schema.ResourceData, 1)
 	results[0] = d
 
-	conn := meta.(*AWSClient).s3conn
+	conn := meta.(*AWSClient).s3conn()
 	pol, err := conn.GetBucketPolicy(&s3.GetBucketPolicyInput{
 		Bucket: aws.String(d.Id()),
 	})
@@ -13,7 +13,7 @@ import (
 func resourceAwsSecurityGroupImportState(
 	d *schema.ResourceData,
 	meta interface{}) ([]*schema.ResourceData, error) {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	// First query the security group
 	sgRaw, _, err := SGStateRefreshFunc(conn, d.Id())()
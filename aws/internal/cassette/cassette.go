@@ -0,0 +1,368 @@
+// Package cassette records the AWS API traffic of tests to files, called
+// cassettes, and replays it later without network access or credentials.
+//
+// In recording mode, requests are sent to the real services and each request
+// and its response are saved to the cassette of the test. In replaying mode,
+// requests are matched against the interactions of the cassette and served the
+// recorded response.
+//
+// Matching tolerates the random names generated by tests, e.g. with
+// acctest.RandString: when a request differs from a recorded one only by
+// random looking tokens, the cassette learns that the new tokens stand for
+// the recorded ones. Later requests are matched with the recorded tokens and
+// responses are served with the new ones.
+package cassette
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// ErrNotFound is returned when replaying a cassette that was never recorded.
+var ErrNotFound = errors.New("cassette not found")
+
+// Cassette holds the interactions recorded for one test.
+type Cassette struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Interaction is a request and the response it received.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request is a recorded request. Only the headers needed to tell operations
+// apart are kept, in particular the signature and credentials never are.
+type Request struct {
+	Service   string      `json:"service"`
+	Operation string      `json:"operation"`
+	Method    string      `json:"method"`
+	URL       string      `json:"url"`
+	Header    http.Header `json:"header,omitempty"`
+	Body      Body        `json:"body,omitempty"`
+}
+
+// Response is a recorded response.
+type Response struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       Body        `json:"body,omitempty"`
+}
+
+// Body is a request or response payload. It is saved as a JSON string when it
+// is valid UTF-8 text, and base64 encoded otherwise.
+type Body []byte
+
+// MarshalJSON implements json.Marshaler.
+func (b Body) MarshalJSON() ([]byte, error) {
+	if utf8.Valid(b) {
+		return json.Marshal(string(b))
+	}
+
+	return json.Marshal(map[string]string{"base64": base64.StdEncoding.EncodeToString(b)})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *Body) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*b = Body(s)
+		return nil
+	}
+
+	var encoded map[string]string
+	if err := json.Unmarshal(data, &encoded); err != nil {
+		return err
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(encoded["base64"])
+	if err != nil {
+		return err
+	}
+
+	*b = decoded
+
+	return nil
+}
+
+// Load reads the cassette at the given path.
+func Load(path string) (*Cassette, error) {
+	b, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Cassette{}
+	if err := json.Unmarshal(b, c); err != nil {
+		return nil, fmt.Errorf("error reading cassette %s: %s", path, err)
+	}
+
+	return c, nil
+}
+
+// Save writes the cassette to the given path, creating its directory.
+func (c *Cassette) Save(path string) error {
+	b, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, append(b, '\n'), 0644)
+}
+
+// player serves the interactions of a cassette.
+type player struct {
+	cassette *Cassette
+	used     []bool
+
+	// substitutions maps the random tokens of the requests being replayed to
+	// the recorded tokens they stand for.
+	substitutions map[string]string
+
+	mismatches []string
+}
+
+func newPlayer(c *Cassette) *player {
+	return &player{
+		cassette:      c,
+		used:          make([]bool, len(c.Interactions)),
+		substitutions: make(map[string]string),
+	}
+}
+
+// play returns the response to the request, or nil if no recorded
+// interaction matches it.
+//
+// The first unused interaction of the same operation is used, preferring one
+// with the same URL and body, then one differing only by random tokens. Other
+// interactions are never served, so a request the test did not send when
+// recording is reported as a mismatch. Once all its matching interactions are
+// used, a request is served the last of them again, e.g. when polling for a
+// state change takes longer.
+func (p *player) play(req *Request) *Response {
+	url := substituteTokens(req.URL, p.substitutions)
+	body := substituteTokens(canonicalBody(req), p.substitutions)
+
+	var interaction *Interaction
+
+	for _, matches := range []func(in *Interaction) bool{
+		func(in *Interaction) bool {
+			return in.Request.URL == url && canonicalBody(&in.Request) == body
+		},
+		func(in *Interaction) bool {
+			return p.learn(in, url, body)
+		},
+	} {
+		for i, in := range p.cassette.Interactions {
+			if p.used[i] || !sameOperation(&in.Request, req) || !matches(in) {
+				continue
+			}
+
+			p.used[i] = true
+			interaction = in
+			break
+		}
+
+		if interaction != nil {
+			break
+		}
+	}
+
+	if interaction == nil {
+		for i := len(p.cassette.Interactions) - 1; i >= 0; i-- {
+			in := p.cassette.Interactions[i]
+
+			if p.used[i] && sameOperation(&in.Request, req) && in.Request.URL == url && canonicalBody(&in.Request) == body {
+				interaction = in
+				break
+			}
+		}
+	}
+
+	if interaction == nil {
+		p.mismatches = append(p.mismatches, fmt.Sprintf("%s/%s %s %s", req.Service, req.Operation, req.Method, req.URL))
+		return nil
+	}
+
+	reverse := make(map[string]string, len(p.substitutions))
+	for actual, recorded := range p.substitutions {
+		reverse[recorded] = actual
+	}
+
+	response := &Response{
+		StatusCode: interaction.Response.StatusCode,
+		Header:     make(http.Header, len(interaction.Response.Header)),
+		Body:       Body(substituteTokens(string(interaction.Response.Body), reverse)),
+	}
+
+	for k, values := range interaction.Response.Header {
+		for _, v := range values {
+			response.Header.Add(k, substituteTokens(v, reverse))
+		}
+	}
+
+	return response
+}
+
+// learn returns whether the URL and body of the request differ from those of
+// the recorded interaction only by random tokens, in which case the
+// substitutions of those tokens are learned.
+func (p *player) learn(in *Interaction, url, body string) bool {
+	learned := make(map[string]string)
+
+	if !randomTokensDiffer(in.Request.URL, url, learned) || !randomTokensDiffer(canonicalBody(&in.Request), body, learned) {
+		return false
+	}
+
+	for actual, recorded := range learned {
+		if s, ok := p.substitutions[actual]; ok && s != recorded {
+			return false
+		}
+	}
+
+	for actual, recorded := range learned {
+		p.substitutions[actual] = recorded
+	}
+
+	return len(learned) > 0
+}
+
+var memberRegexp = regexp.MustCompile(`^(.+\.member)\.([0-9]+)(?:\.(.+))?$`)
+
+// canonicalBody returns the body of the request as it is matched. The
+// parameters of form encoded bodies are sorted, as are the members of their
+// lists, either structures or scalars: lists built from maps, e.g. tags or
+// tag keys, are sent in a random order.
+func canonicalBody(req *Request) string {
+	if !strings.HasPrefix(req.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		return string(req.Body)
+	}
+
+	var params []string
+	members := make(map[string][]string)
+
+	for _, param := range strings.Split(string(req.Body), "&") {
+		name := param
+		if i := strings.Index(param, "="); i >= 0 {
+			name = param[:i]
+		}
+
+		m := memberRegexp.FindStringSubmatch(name)
+		if m == nil {
+			params = append(params, param)
+			continue
+		}
+
+		member := m[1] + "." + m[2]
+		members[member] = append(members[member], m[3]+param[len(name):])
+	}
+
+	lists := make(map[string][]string)
+	for member, fields := range members {
+		sort.Strings(fields)
+		list := member[:strings.LastIndex(member, ".")]
+		lists[list] = append(lists[list], strings.Join(fields, "&"))
+	}
+
+	for list, entries := range lists {
+		sort.Strings(entries)
+		for i, entry := range entries {
+			params = append(params, fmt.Sprintf("%s.%d{%s}", list, i+1, entry))
+		}
+	}
+
+	sort.Strings(params)
+
+	return strings.Join(params, "&")
+}
+
+func sameOperation(recorded, req *Request) bool {
+	return recorded.Service == req.Service && recorded.Method == req.Method && recorded.Operation == req.Operation
+}
+
+var tokenRegexp = regexp.MustCompile(`[A-Za-z0-9]+`)
+
+// randomTokensDiffer returns whether the two strings are the same but for
+// tokens that both look random, adding the actual to recorded token pairs to
+// the learned substitutions.
+func randomTokensDiffer(recorded, actual string, learned map[string]string) bool {
+	recordedTokens := tokenRegexp.FindAllString(recorded, -1)
+	actualTokens := tokenRegexp.FindAllString(actual, -1)
+
+	if len(recordedTokens) != len(actualTokens) {
+		return false
+	}
+
+	if strings.Join(tokenRegexp.Split(recorded, -1), " ") != strings.Join(tokenRegexp.Split(actual, -1), " ") {
+		return false
+	}
+
+	for i, recordedToken := range recordedTokens {
+		actualToken := actualTokens[i]
+
+		if recordedToken == actualToken {
+			continue
+		}
+
+		if !randomTokens(recordedToken, actualToken) {
+			return false
+		}
+
+		if s, ok := learned[actualToken]; ok && s != recordedToken {
+			return false
+		}
+
+		learned[actualToken] = recordedToken
+	}
+
+	return true
+}
+
+var (
+	randomDigitsRegexp = regexp.MustCompile(`^[0-9]{5,}$`)
+	randomStringRegexp = regexp.MustCompile(`^[a-z0-9]{5,}$`)
+)
+
+// randomTokens returns whether both tokens could have been generated by the
+// same random helper, i.e. acctest.RandInt for numbers or acctest.RandString
+// for lowercase alphanumeric strings of the same length.
+func randomTokens(a, b string) bool {
+	if randomDigitsRegexp.MatchString(a) && randomDigitsRegexp.MatchString(b) {
+		return true
+	}
+
+	return len(a) == len(b) && randomStringRegexp.MatchString(a) && randomStringRegexp.MatchString(b)
+}
+
+// substituteTokens replaces the whole tokens of s found in the substitutions.
+func substituteTokens(s string, substitutions map[string]string) string {
+	if len(substitutions) == 0 {
+		return s
+	}
+
+	return tokenRegexp.ReplaceAllStringFunc(s, func(token string) string {
+		if substitution, ok := substitutions[token]; ok {
+			return substitution
+		}
+
+		return token
+	})
+}
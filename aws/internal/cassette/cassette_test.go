@@ -0,0 +1,104 @@
+package cassette
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestCanonicalBody(t *testing.T) {
+	form := http.Header{"Content-Type": []string{"application/x-www-form-urlencoded; charset=utf-8"}}
+
+	a := &Request{
+		Header: form,
+		Body:   Body("Action=CreateRole&RoleName=test&Tags.member.1.Key=tag1&Tags.member.1.Value=value1&Tags.member.2.Key=tag2&Tags.member.2.Value=value2"),
+	}
+	b := &Request{
+		Header: form,
+		Body:   Body("Action=CreateRole&Tags.member.1.Value=value2&Tags.member.1.Key=tag2&Tags.member.2.Key=tag1&Tags.member.2.Value=value1&RoleName=test"),
+	}
+
+	if canonicalBody(a) != canonicalBody(b) {
+		t.Errorf("expected the same canonical bodies, got:\n%s\n%s", canonicalBody(a), canonicalBody(b))
+	}
+
+	c := &Request{
+		Header: form,
+		Body:   Body("Action=CreateRole&RoleName=test&Tags.member.1.Key=tag1&Tags.member.1.Value=value2&Tags.member.2.Key=tag2&Tags.member.2.Value=value1"),
+	}
+
+	if canonicalBody(a) == canonicalBody(c) {
+		t.Errorf("expected different canonical bodies, got: %s", canonicalBody(a))
+	}
+
+	d := &Request{
+		Header: form,
+		Body:   Body("Action=UntagRole&RoleName=test&TagKeys.member.1=tag1&TagKeys.member.2=tag2"),
+	}
+	e := &Request{
+		Header: form,
+		Body:   Body("Action=UntagRole&RoleName=test&TagKeys.member.1=tag2&TagKeys.member.2=tag1"),
+	}
+
+	if canonicalBody(d) != canonicalBody(e) {
+		t.Errorf("expected the same canonical bodies, got:\n%s\n%s", canonicalBody(d), canonicalBody(e))
+	}
+
+	json := &Request{
+		Header: http.Header{"Content-Type": []string{"application/x-amz-json-1.1"}},
+		Body:   Body(`{"b":1,"a":2}`),
+	}
+
+	if canonicalBody(json) != `{"b":1,"a":2}` {
+		t.Errorf("expected JSON body unchanged, got: %s", canonicalBody(json))
+	}
+}
+
+func TestPlayer_mismatch(t *testing.T) {
+	form := http.Header{"Content-Type": []string{"application/x-www-form-urlencoded; charset=utf-8"}}
+
+	p := newPlayer(&Cassette{
+		Interactions: []*Interaction{
+			{
+				Request: Request{
+					Service:   "iam",
+					Operation: "GetRole",
+					Method:    "POST",
+					URL:       "https://iam.amazonaws.com/",
+					Header:    form,
+					Body:      Body("Action=GetRole&RoleName=tf-acc-test-abcdefghij"),
+				},
+				Response: Response{StatusCode: 200},
+			},
+		},
+	})
+
+	other := &Request{
+		Service:   "iam",
+		Operation: "GetRole",
+		Method:    "POST",
+		URL:       "https://iam.amazonaws.com/",
+		Header:    form,
+		Body:      Body("Action=GetRole&RoleName=other-role"),
+	}
+
+	if response := p.play(other); response != nil {
+		t.Fatalf("expected no response to a request differing from the recorded one, got: %#v", response)
+	}
+
+	if len(p.mismatches) != 1 {
+		t.Fatalf("expected 1 mismatch, got: %v", p.mismatches)
+	}
+
+	random := &Request{
+		Service:   "iam",
+		Operation: "GetRole",
+		Method:    "POST",
+		URL:       "https://iam.amazonaws.com/",
+		Header:    form,
+		Body:      Body("Action=GetRole&RoleName=tf-acc-test-klmnopqrst"),
+	}
+
+	if response := p.play(random); response == nil {
+		t.Fatal("expected a response to a request differing only by random tokens")
+	}
+}
@@ -0,0 +1,345 @@
+package cassette
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Mode is the mode of a Recorder.
+type Mode int
+
+const (
+	// ModeRecording sends the requests to the real services and records the
+	// interactions to the inserted cassette.
+	ModeRecording Mode = iota + 1
+
+	// ModeReplaying serves the requests from the inserted cassette, without
+	// sending them.
+	ModeReplaying
+)
+
+// ParseMode returns the mode named "record" or "replay".
+func ParseMode(s string) (Mode, error) {
+	switch strings.ToLower(s) {
+	case "record":
+		return ModeRecording, nil
+	case "replay":
+		return ModeReplaying, nil
+	}
+
+	return 0, fmt.Errorf("unknown cassette mode %q, expected \"record\" or \"replay\"", s)
+}
+
+// Recorder records or replays the requests made through its transports to
+// or from the inserted cassette. Requests made while no cassette is inserted
+// are sent as usual.
+type Recorder struct {
+	// Dir is the directory of the cassette files.
+	Dir string
+
+	Mode Mode
+
+	// Redact, if set, returns the payload with the value of its sensitive
+	// fields replaced. Payloads are redacted before they are recorded, and
+	// before they are matched when replaying.
+	Redact func(service, contentType string, payload []byte) []byte
+
+	// slot is held while a cassette is inserted.
+	slot sync.Mutex
+
+	mutex    sync.Mutex
+	name     string
+	cassette *Cassette
+	player   *player
+}
+
+// New returns a new recorder of the cassettes in the given directory.
+func New(dir string, mode Mode) *Recorder {
+	return &Recorder{
+		Dir:  dir,
+		Mode: mode,
+	}
+}
+
+// Path returns the path of the cassette file with the given name, e.g. the
+// name of a test.
+func (r *Recorder) Path(name string) string {
+	return filepath.Join(r.Dir, strings.Replace(name, "/", "_", -1)+".json")
+}
+
+// Insert inserts the cassette with the given name, an empty one when
+// recording. Only one cassette can be inserted at a time: Insert waits for
+// the inserted cassette to be ejected, which serializes the tests sharing the
+// recorder.
+//
+// ErrNotFound is returned when replaying a cassette that does not exist.
+func (r *Recorder) Insert(name string) error {
+	r.slot.Lock()
+
+	c := &Cassette{}
+
+	if r.Mode == ModeReplaying {
+		var err error
+		c, err = Load(r.Path(name))
+
+		if err != nil {
+			r.slot.Unlock()
+			return err
+		}
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.name = name
+	r.cassette = c
+	r.player = newPlayer(c)
+
+	return nil
+}
+
+// Inserted returns the name of the inserted cassette, if any.
+func (r *Recorder) Inserted() string {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return r.name
+}
+
+// Eject ejects the inserted cassette, saving it when recording. An error is
+// returned when replaying if requests did not match any recorded interaction.
+func (r *Recorder) Eject() error {
+	r.mutex.Lock()
+	name, c, p := r.name, r.cassette, r.player
+	r.name, r.cassette, r.player = "", nil, nil
+	r.mutex.Unlock()
+
+	if c == nil {
+		return nil
+	}
+
+	defer r.slot.Unlock()
+
+	if r.Mode == ModeRecording {
+		return c.Save(r.Path(name))
+	}
+
+	if len(p.mismatches) > 0 {
+		return fmt.Errorf("cassette %s has no recorded interaction matching:\n\t%s", name, strings.Join(p.mismatches, "\n\t"))
+	}
+
+	return nil
+}
+
+// Transport returns an http.RoundTripper recording or replaying the requests
+// through the recorder, sending them with the given transport.
+func (r *Recorder) Transport(next http.RoundTripper) http.RoundTripper {
+	return &transport{
+		next:     next,
+		recorder: r,
+	}
+}
+
+type transport struct {
+	next     http.RoundTripper
+	recorder *Recorder
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *transport) RoundTrip(httpRequest *http.Request) (*http.Response, error) {
+	r := t.recorder
+
+	r.mutex.Lock()
+	inserted := r.cassette != nil
+	r.mutex.Unlock()
+
+	if !inserted {
+		return t.next.RoundTrip(httpRequest)
+	}
+
+	var body []byte
+	if httpRequest.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(httpRequest.Body)
+		httpRequest.Body.Close()
+
+		if err != nil {
+			return nil, err
+		}
+
+		httpRequest.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+
+	req := r.request(httpRequest, body)
+
+	if r.Mode == ModeReplaying {
+		return r.replay(httpRequest, req), nil
+	}
+
+	httpResponse, err := t.next.RoundTrip(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	responseBody, err := ioutil.ReadAll(httpResponse.Body)
+	httpResponse.Body.Close()
+
+	if err != nil {
+		return nil, err
+	}
+
+	httpResponse.Body = ioutil.NopCloser(bytes.NewReader(responseBody))
+
+	interaction := &Interaction{
+		Request: *req,
+		Response: Response{
+			StatusCode: httpResponse.StatusCode,
+			Header:     httpResponse.Header,
+			Body:       r.redact(req.Service, httpResponse.Header.Get("Content-Type"), responseBody),
+		},
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.cassette != nil {
+		r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+	}
+
+	return httpResponse, nil
+}
+
+func (r *Recorder) replay(httpRequest *http.Request, req *Request) *http.Response {
+	r.mutex.Lock()
+	var response *Response
+	if r.player != nil {
+		response = r.player.play(req)
+	}
+	r.mutex.Unlock()
+
+	if response == nil {
+		log.Printf("[ERROR] Cassette: no recorded interaction matching %s/%s %s %s", req.Service, req.Operation, req.Method, req.URL)
+
+		response = &Response{
+			StatusCode: http.StatusBadRequest,
+			Header:     http.Header{"Content-Type": []string{"text/plain"}},
+			Body:       Body(fmt.Sprintf("no recorded interaction matching %s/%s %s %s", req.Service, req.Operation, req.Method, req.URL)),
+		}
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", response.StatusCode, http.StatusText(response.StatusCode)),
+		StatusCode:    response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        response.Header,
+		Body:          ioutil.NopCloser(bytes.NewReader(response.Body)),
+		ContentLength: int64(len(response.Body)),
+		Request:       httpRequest,
+	}
+}
+
+// recordedRequestHeaders lists the request headers kept in cassettes.
+var recordedRequestHeaders = []string{
+	"Content-Type",
+	"X-Amz-Target",
+}
+
+// redactedQueryParameters lists the query parameters carrying credentials,
+// e.g. in presigned URLs, which are removed from recorded URLs.
+var redactedQueryParameters = []string{
+	"X-Amz-Credential",
+	"X-Amz-Security-Token",
+	"X-Amz-Signature",
+}
+
+var credentialScopeRegexp = regexp.MustCompile(`Credential=[^/]+/[0-9]+/[^/]+/([^/]+)/aws4_request`)
+
+// request returns the request as recorded.
+func (r *Recorder) request(httpRequest *http.Request, body []byte) *Request {
+	req := &Request{
+		Method: httpRequest.Method,
+		Header: make(http.Header),
+	}
+
+	if m := credentialScopeRegexp.FindStringSubmatch(httpRequest.Header.Get("Authorization")); m != nil {
+		req.Service = m[1]
+	}
+
+	for _, k := range recordedRequestHeaders {
+		if v := httpRequest.Header.Get(k); v != "" {
+			req.Header.Set(k, v)
+		}
+	}
+
+	u := *httpRequest.URL
+	query := u.Query()
+	for _, k := range redactedQueryParameters {
+		query.Del(k)
+	}
+	u.RawQuery = query.Encode()
+	req.URL = u.RequestURI()
+
+	req.Body = r.redact(req.Service, httpRequest.Header.Get("Content-Type"), body)
+	req.Operation = operation(httpRequest, body)
+
+	return req
+}
+
+func (r *Recorder) redact(service, contentType string, payload []byte) Body {
+	if r.Redact == nil || len(payload) == 0 {
+		return payload
+	}
+
+	return r.Redact(service, contentType, payload)
+}
+
+// operation returns the name of the operation of the request: the target of
+// JSON protocol requests, the action of query protocol requests, or the
+// shape of the URL of REST protocol requests, e.g. "/{}/{}?tagging" for S3
+// object tagging.
+func operation(httpRequest *http.Request, body []byte) string {
+	if target := httpRequest.Header.Get("X-Amz-Target"); target != "" {
+		return target
+	}
+
+	if action := httpRequest.URL.Query().Get("Action"); action != "" {
+		return action
+	}
+
+	if strings.HasPrefix(httpRequest.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		if params, err := url.ParseQuery(string(body)); err == nil && params.Get("Action") != "" {
+			return params.Get("Action")
+		}
+	}
+
+	var segments []string
+	for _, segment := range strings.Split(strings.Trim(httpRequest.URL.Path, "/"), "/") {
+		if segment != "" {
+			segments = append(segments, "{}")
+		}
+	}
+
+	var subresources []string
+	for k := range httpRequest.URL.Query() {
+		subresources = append(subresources, k)
+	}
+	sort.Strings(subresources)
+
+	shape := "/" + strings.Join(segments, "/")
+	if len(subresources) > 0 {
+		shape += "?" + strings.Join(subresources, "&")
+	}
+
+	return shape
+}
@@ -0,0 +1,1203 @@
+package fakeaws
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const ec2DefaultDhcpOptionsID = "dopt-00000000000000000"
+
+type ec2State struct {
+	networkAcls    map[string]*ec2NetworkAcl
+	routeTables    map[string]*ec2RouteTable
+	securityGroups map[string]*ec2SecurityGroup
+	subnets        map[string]*ec2Subnet
+	tags           map[string]map[string]string
+	vpcs           map[string]*ec2Vpc
+}
+
+func newEc2State() *ec2State {
+	return &ec2State{
+		networkAcls:    make(map[string]*ec2NetworkAcl),
+		routeTables:    make(map[string]*ec2RouteTable),
+		securityGroups: make(map[string]*ec2SecurityGroup),
+		subnets:        make(map[string]*ec2Subnet),
+		tags:           make(map[string]map[string]string),
+		vpcs:           make(map[string]*ec2Vpc),
+	}
+}
+
+type ec2Tag struct {
+	Key   string `xml:"key"`
+	Value string `xml:"value"`
+}
+
+type ec2Vpc struct {
+	VpcID           string   `xml:"vpcId"`
+	OwnerID         string   `xml:"ownerId"`
+	CidrBlock       string   `xml:"cidrBlock"`
+	DhcpOptionsID   string   `xml:"dhcpOptionsId"`
+	InstanceTenancy string   `xml:"instanceTenancy"`
+	IsDefault       bool     `xml:"isDefault"`
+	State           string   `xml:"state"`
+	TagSet          []ec2Tag `xml:"tagSet>item"`
+
+	enableDNSHostnames bool
+	enableDNSSupport   bool
+}
+
+type ec2Subnet struct {
+	SubnetID                    string   `xml:"subnetId"`
+	SubnetArn                   string   `xml:"subnetArn"`
+	VpcID                       string   `xml:"vpcId"`
+	OwnerID                     string   `xml:"ownerId"`
+	CidrBlock                   string   `xml:"cidrBlock"`
+	AvailabilityZone            string   `xml:"availabilityZone"`
+	AvailabilityZoneID          string   `xml:"availabilityZoneId"`
+	AvailableIPAddressCount     int      `xml:"availableIpAddressCount"`
+	DefaultForAz                bool     `xml:"defaultForAz"`
+	MapPublicIPOnLaunch         bool     `xml:"mapPublicIpOnLaunch"`
+	AssignIpv6AddressOnCreation bool     `xml:"assignIpv6AddressOnCreation"`
+	State                       string   `xml:"state"`
+	TagSet                      []ec2Tag `xml:"tagSet>item"`
+}
+
+type ec2SecurityGroup struct {
+	OwnerID             string            `xml:"ownerId"`
+	GroupID             string            `xml:"groupId"`
+	GroupName           string            `xml:"groupName"`
+	GroupDescription    string            `xml:"groupDescription"`
+	VpcID               string            `xml:"vpcId,omitempty"`
+	IPPermissions       []ec2IPPermission `xml:"ipPermissions>item"`
+	IPPermissionsEgress []ec2IPPermission `xml:"ipPermissionsEgress>item"`
+	TagSet              []ec2Tag          `xml:"tagSet>item"`
+
+	ingress []ec2SecurityGroupRule
+	egress  []ec2SecurityGroupRule
+}
+
+type ec2IPPermission struct {
+	IPProtocol    string                `xml:"ipProtocol"`
+	FromPort      *int64                `xml:"fromPort,omitempty"`
+	ToPort        *int64                `xml:"toPort,omitempty"`
+	Groups        []ec2UserIDGroupPair  `xml:"groups>item"`
+	IPRanges      []ec2IPRange          `xml:"ipRanges>item"`
+	Ipv6Ranges    []ec2Ipv6Range        `xml:"ipv6Ranges>item"`
+	PrefixListIds []ec2PrefixListIDItem `xml:"prefixListIds>item"`
+}
+
+type ec2UserIDGroupPair struct {
+	GroupID     string `xml:"groupId"`
+	UserID      string `xml:"userId"`
+	Description string `xml:"description,omitempty"`
+}
+
+type ec2IPRange struct {
+	CidrIP      string `xml:"cidrIp"`
+	Description string `xml:"description,omitempty"`
+}
+
+type ec2Ipv6Range struct {
+	CidrIpv6    string `xml:"cidrIpv6"`
+	Description string `xml:"description,omitempty"`
+}
+
+type ec2PrefixListIDItem struct {
+	PrefixListID string `xml:"prefixListId"`
+	Description  string `xml:"description,omitempty"`
+}
+
+// ec2SecurityGroupRule is a single source or destination of a security group
+// permission, as they are authorized and revoked individually.
+type ec2SecurityGroupRule struct {
+	Protocol    string
+	FromPort    int64
+	ToPort      int64
+	Type        string
+	Value       string
+	Description string
+}
+
+const (
+	ec2SecurityGroupRuleTypeCidr         = "cidr"
+	ec2SecurityGroupRuleTypeGroup        = "group"
+	ec2SecurityGroupRuleTypeIpv6Cidr     = "ipv6"
+	ec2SecurityGroupRuleTypePrefixListID = "prefix-list"
+)
+
+type ec2RouteTable struct {
+	RouteTableID   string                     `xml:"routeTableId"`
+	VpcID          string                     `xml:"vpcId"`
+	OwnerID        string                     `xml:"ownerId"`
+	RouteSet       []ec2Route                 `xml:"routeSet>item"`
+	AssociationSet []ec2RouteTableAssociation `xml:"associationSet>item"`
+	TagSet         []ec2Tag                   `xml:"tagSet>item"`
+}
+
+type ec2Route struct {
+	DestinationCidrBlock string `xml:"destinationCidrBlock"`
+	GatewayID            string `xml:"gatewayId"`
+	Origin               string `xml:"origin"`
+	State                string `xml:"state"`
+}
+
+type ec2RouteTableAssociation struct {
+	RouteTableAssociationID string `xml:"routeTableAssociationId"`
+	RouteTableID            string `xml:"routeTableId"`
+	Main                    bool   `xml:"main"`
+}
+
+type ec2NetworkAcl struct {
+	NetworkAclID string   `xml:"networkAclId"`
+	VpcID        string   `xml:"vpcId"`
+	OwnerID      string   `xml:"ownerId"`
+	IsDefault    bool     `xml:"default"`
+	TagSet       []ec2Tag `xml:"tagSet>item"`
+}
+
+func (s *Server) ec2Operations() map[string]func(w http.ResponseWriter, r *request) {
+	return map[string]func(w http.ResponseWriter, r *request){
+		"AuthorizeSecurityGroupEgress":     s.ec2AuthorizeSecurityGroupRules(true),
+		"AuthorizeSecurityGroupIngress":    s.ec2AuthorizeSecurityGroupRules(false),
+		"CreateSecurityGroup":              s.ec2CreateSecurityGroup,
+		"CreateSubnet":                     s.ec2CreateSubnet,
+		"CreateTags":                       s.ec2CreateTags,
+		"CreateVpc":                        s.ec2CreateVpc,
+		"DeleteSecurityGroup":              s.ec2DeleteSecurityGroup,
+		"DeleteSubnet":                     s.ec2DeleteSubnet,
+		"DeleteTags":                       s.ec2DeleteTags,
+		"DeleteVpc":                        s.ec2DeleteVpc,
+		"DescribeAccountAttributes":        s.ec2DescribeAccountAttributes,
+		"DescribeNetworkAcls":              s.ec2DescribeNetworkAcls,
+		"DescribeNetworkInterfaces":        s.ec2DescribeNetworkInterfaces,
+		"DescribeRouteTables":              s.ec2DescribeRouteTables,
+		"DescribeSecurityGroups":           s.ec2DescribeSecurityGroups,
+		"DescribeSubnets":                  s.ec2DescribeSubnets,
+		"DescribeVpcAttribute":             s.ec2DescribeVpcAttribute,
+		"DescribeVpcClassicLink":           s.ec2DescribeVpcClassicLink,
+		"DescribeVpcClassicLinkDnsSupport": s.ec2DescribeVpcClassicLinkDNSSupport,
+		"DescribeVpcs":                     s.ec2DescribeVpcs,
+		"ModifySubnetAttribute":            s.ec2ModifySubnetAttribute,
+		"ModifyVpcAttribute":               s.ec2ModifyVpcAttribute,
+		"RevokeSecurityGroupEgress":        s.ec2RevokeSecurityGroupRules(true),
+		"RevokeSecurityGroupIngress":       s.ec2RevokeSecurityGroupRules(false),
+	}
+}
+
+func writeEc2Result(w http.ResponseWriter, r *request, result interface{}) {
+	writeElement(w, http.StatusOK, r.Params.Get("Action")+"Response", result)
+}
+
+func writeEc2Return(w http.ResponseWriter, r *request) {
+	writeEc2Result(w, r, struct {
+		Return bool `xml:"return"`
+	}{true})
+}
+
+func writeEc2Error(w http.ResponseWriter, code, message string) {
+	type ec2Error struct {
+		Code    string
+		Message string
+	}
+
+	type ec2ErrorResponse struct {
+		XMLName   xml.Name   `xml:"Response"`
+		Errors    []ec2Error `xml:"Errors>Error"`
+		RequestID string
+	}
+
+	b, _ := xml.Marshal(ec2ErrorResponse{
+		Errors:    []ec2Error{{Code: code, Message: message}},
+		RequestID: requestID,
+	})
+
+	writeResponse(w, Response{
+		StatusCode: http.StatusBadRequest,
+		Body:       string(b),
+	})
+}
+
+// ec2TagSet returns the tags of a resource, sorted by key.
+func (st *ec2State) ec2TagSet(id string) []ec2Tag {
+	var tags []ec2Tag
+
+	for k, v := range st.tags[id] {
+		tags = append(tags, ec2Tag{Key: k, Value: v})
+	}
+
+	sort.Slice(tags, func(i, j int) bool { return tags[i].Key < tags[j].Key })
+
+	return tags
+}
+
+// ec2FilterValues returns the values of a resource attribute for filtering.
+type ec2FilterValues func(name string) ([]string, bool)
+
+// ec2MatchFilters returns whether the resource matches all the filters, or
+// an error if a filter is not supported.
+func (st *ec2State) ec2MatchFilters(id string, filters []filter, values ec2FilterValues) (bool, error) {
+	for _, f := range filters {
+		var attributes []string
+
+		switch {
+		case strings.HasPrefix(f.Name, "tag:"):
+			if v, ok := st.tags[id][strings.TrimPrefix(f.Name, "tag:")]; ok {
+				attributes = []string{v}
+			}
+		case f.Name == "tag-key":
+			for k := range st.tags[id] {
+				attributes = append(attributes, k)
+			}
+		default:
+			var ok bool
+			if attributes, ok = values(f.Name); !ok {
+				return false, fmt.Errorf("The filter '%s' is invalid", f.Name)
+			}
+		}
+
+		matched := false
+		for _, attribute := range attributes {
+			if f.matches(attribute) {
+				matched = true
+				break
+			}
+		}
+
+		if !matched {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func ec2AvailabilityZoneID(region string) string {
+	parts := strings.Split(region, "-")
+
+	if len(parts) != 3 || parts[1] == "" {
+		return region + "-az1"
+	}
+
+	return fmt.Sprintf("%s%s%s-az1", parts[0], parts[1][:1], parts[2])
+}
+
+func (s *Server) ec2DescribeAccountAttributes(w http.ResponseWriter, r *request) {
+	type attributeValue struct {
+		AttributeValue string `xml:"attributeValue"`
+	}
+
+	type accountAttribute struct {
+		AttributeName     string           `xml:"attributeName"`
+		AttributeValueSet []attributeValue `xml:"attributeValueSet>item"`
+	}
+
+	attributes := []accountAttribute{
+		{AttributeName: "default-vpc", AttributeValueSet: []attributeValue{{"none"}}},
+		{AttributeName: "supported-platforms", AttributeValueSet: []attributeValue{{"VPC"}}},
+	}
+
+	names := listParam(r.Params, "AttributeName")
+
+	var result []accountAttribute
+	for _, attribute := range attributes {
+		if contains(names, attribute.AttributeName) {
+			result = append(result, attribute)
+		}
+	}
+
+	writeEc2Result(w, r, struct {
+		AccountAttributeSet []accountAttribute `xml:"accountAttributeSet>item"`
+	}{result})
+}
+
+func (s *Server) ec2CreateTags(w http.ResponseWriter, r *request) {
+	for _, id := range listParam(r.Params, "ResourceId") {
+		if s.ec2.tags[id] == nil {
+			s.ec2.tags[id] = make(map[string]string)
+		}
+
+		for _, tag := range structListParam(r.Params, "Tag") {
+			s.ec2.tags[id][tag.Get("Key")] = tag.Get("Value")
+		}
+	}
+
+	writeEc2Return(w, r)
+}
+
+func (s *Server) ec2DeleteTags(w http.ResponseWriter, r *request) {
+	tags := structListParam(r.Params, "Tag")
+
+	for _, id := range listParam(r.Params, "ResourceId") {
+		if len(tags) == 0 {
+			delete(s.ec2.tags, id)
+			continue
+		}
+
+		for _, tag := range tags {
+			key := tag.Get("Key")
+
+			if _, ok := tag["Value"]; ok && s.ec2.tags[id][key] != tag.Get("Value") {
+				continue
+			}
+
+			delete(s.ec2.tags[id], key)
+		}
+	}
+
+	writeEc2Return(w, r)
+}
+
+//
+// VPCs
+//
+
+func (s *Server) ec2CreateVpc(w http.ResponseWriter, r *request) {
+	tenancy := r.Params.Get("InstanceTenancy")
+	if tenancy == "" {
+		tenancy = "default"
+	}
+
+	vpc := &ec2Vpc{
+		VpcID:            s.nextID("vpc-"),
+		OwnerID:          AccountID,
+		CidrBlock:        r.Params.Get("CidrBlock"),
+		DhcpOptionsID:    ec2DefaultDhcpOptionsID,
+		InstanceTenancy:  tenancy,
+		State:            "available",
+		enableDNSSupport: true,
+	}
+	s.ec2.vpcs[vpc.VpcID] = vpc
+
+	// Every VPC comes with a default security group, main route table and
+	// default network ACL
+	sg := &ec2SecurityGroup{
+		OwnerID:          AccountID,
+		GroupID:          s.nextID("sg-"),
+		GroupName:        "default",
+		GroupDescription: "default VPC security group",
+		VpcID:            vpc.VpcID,
+	}
+	sg.ingress = []ec2SecurityGroupRule{{Protocol: "-1", Type: ec2SecurityGroupRuleTypeGroup, Value: sg.GroupID}}
+	sg.egress = []ec2SecurityGroupRule{{Protocol: "-1", Type: ec2SecurityGroupRuleTypeCidr, Value: "0.0.0.0/0"}}
+	s.ec2.securityGroups[sg.GroupID] = sg
+
+	routeTableID := s.nextID("rtb-")
+	s.ec2.routeTables[routeTableID] = &ec2RouteTable{
+		RouteTableID: routeTableID,
+		VpcID:        vpc.VpcID,
+		OwnerID:      AccountID,
+		RouteSet: []ec2Route{{
+			DestinationCidrBlock: vpc.CidrBlock,
+			GatewayID:            "local",
+			Origin:               "CreateRouteTable",
+			State:                "active",
+		}},
+		AssociationSet: []ec2RouteTableAssociation{{
+			RouteTableAssociationID: s.nextID("rtbassoc-"),
+			RouteTableID:            routeTableID,
+			Main:                    true,
+		}},
+	}
+
+	networkAclID := s.nextID("acl-")
+	s.ec2.networkAcls[networkAclID] = &ec2NetworkAcl{
+		NetworkAclID: networkAclID,
+		VpcID:        vpc.VpcID,
+		OwnerID:      AccountID,
+		IsDefault:    true,
+	}
+
+	writeEc2Result(w, r, struct {
+		Vpc *ec2Vpc `xml:"vpc"`
+	}{vpc})
+}
+
+func (s *Server) ec2DescribeVpcs(w http.ResponseWriter, r *request) {
+	ids := listParam(r.Params, "VpcId")
+
+	for _, id := range ids {
+		if _, ok := s.ec2.vpcs[id]; !ok {
+			writeEc2Error(w, "InvalidVpcID.NotFound", fmt.Sprintf("The vpc ID '%s' does not exist", id))
+			return
+		}
+	}
+
+	var vpcs []ec2Vpc
+	for _, vpc := range s.ec2.vpcs {
+		if !contains(ids, vpc.VpcID) {
+			continue
+		}
+
+		matched, err := s.ec2.ec2MatchFilters(vpc.VpcID, filtersParam(r.Params), func(name string) ([]string, bool) {
+			switch name {
+			case "cidr", "cidr-block-association.cidr-block":
+				return []string{vpc.CidrBlock}, true
+			case "dhcp-options-id":
+				return []string{vpc.DhcpOptionsID}, true
+			case "isDefault":
+				return []string{strconv.FormatBool(vpc.IsDefault)}, true
+			case "owner-id":
+				return []string{vpc.OwnerID}, true
+			case "state":
+				return []string{vpc.State}, true
+			case "vpc-id":
+				return []string{vpc.VpcID}, true
+			}
+			return nil, false
+		})
+		if err != nil {
+			writeEc2Error(w, "InvalidParameterValue", err.Error())
+			return
+		}
+
+		if matched {
+			v := *vpc
+			v.TagSet = s.ec2.ec2TagSet(vpc.VpcID)
+			vpcs = append(vpcs, v)
+		}
+	}
+
+	sort.Slice(vpcs, func(i, j int) bool { return vpcs[i].VpcID < vpcs[j].VpcID })
+
+	writeEc2Result(w, r, struct {
+		VpcSet []ec2Vpc `xml:"vpcSet>item"`
+	}{vpcs})
+}
+
+func (s *Server) ec2DescribeVpcAttribute(w http.ResponseWriter, r *request) {
+	id := r.Params.Get("VpcId")
+
+	vpc, ok := s.ec2.vpcs[id]
+	if !ok {
+		writeEc2Error(w, "InvalidVpcID.NotFound", fmt.Sprintf("The vpc ID '%s' does not exist", id))
+		return
+	}
+
+	type attributeBooleanValue struct {
+		Value bool `xml:"value"`
+	}
+
+	type result struct {
+		VpcID              string                 `xml:"vpcId"`
+		EnableDNSHostnames *attributeBooleanValue `xml:"enableDnsHostnames,omitempty"`
+		EnableDNSSupport   *attributeBooleanValue `xml:"enableDnsSupport,omitempty"`
+	}
+
+	switch attribute := r.Params.Get("Attribute"); attribute {
+	case "enableDnsHostnames":
+		writeEc2Result(w, r, result{VpcID: id, EnableDNSHostnames: &attributeBooleanValue{vpc.enableDNSHostnames}})
+	case "enableDnsSupport":
+		writeEc2Result(w, r, result{VpcID: id, EnableDNSSupport: &attributeBooleanValue{vpc.enableDNSSupport}})
+	default:
+		writeEc2Error(w, "InvalidParameterValue", fmt.Sprintf("Value (%s) for parameter attribute is invalid. Unknown attribute.", attribute))
+	}
+}
+
+func (s *Server) ec2ModifyVpcAttribute(w http.ResponseWriter, r *request) {
+	id := r.Params.Get("VpcId")
+
+	vpc, ok := s.ec2.vpcs[id]
+	if !ok {
+		writeEc2Error(w, "InvalidVpcID.NotFound", fmt.Sprintf("The vpc ID '%s' does not exist", id))
+		return
+	}
+
+	if v := r.Params.Get("EnableDnsHostnames.Value"); v != "" {
+		vpc.enableDNSHostnames = v == "true"
+	}
+
+	if v := r.Params.Get("EnableDnsSupport.Value"); v != "" {
+		vpc.enableDNSSupport = v == "true"
+	}
+
+	writeEc2Return(w, r)
+}
+
+func (s *Server) ec2DescribeVpcClassicLink(w http.ResponseWriter, r *request) {
+	type vpcClassicLink struct {
+		VpcID              string   `xml:"vpcId"`
+		ClassicLinkEnabled bool     `xml:"classicLinkEnabled"`
+		TagSet             []ec2Tag `xml:"tagSet>item"`
+	}
+
+	ids := listParam(r.Params, "VpcId")
+
+	var vpcs []vpcClassicLink
+	for _, vpc := range s.ec2.vpcs {
+		if contains(ids, vpc.VpcID) {
+			vpcs = append(vpcs, vpcClassicLink{VpcID: vpc.VpcID, TagSet: s.ec2.ec2TagSet(vpc.VpcID)})
+		}
+	}
+
+	writeEc2Result(w, r, struct {
+		VpcSet []vpcClassicLink `xml:"vpcSet>item"`
+	}{vpcs})
+}
+
+func (s *Server) ec2DescribeVpcClassicLinkDNSSupport(w http.ResponseWriter, r *request) {
+	type classicLinkDNSSupport struct {
+		VpcID                   string `xml:"vpcId"`
+		ClassicLinkDNSSupported bool   `xml:"classicLinkDnsSupported"`
+	}
+
+	ids := listParam(r.Params, "VpcIds")
+
+	var vpcs []classicLinkDNSSupport
+	for _, vpc := range s.ec2.vpcs {
+		if contains(ids, vpc.VpcID) {
+			vpcs = append(vpcs, classicLinkDNSSupport{VpcID: vpc.VpcID})
+		}
+	}
+
+	writeEc2Result(w, r, struct {
+		Vpcs []classicLinkDNSSupport `xml:"vpcs>item"`
+	}{vpcs})
+}
+
+func (s *Server) ec2DeleteVpc(w http.ResponseWriter, r *request) {
+	id := r.Params.Get("VpcId")
+
+	if _, ok := s.ec2.vpcs[id]; !ok {
+		writeEc2Error(w, "InvalidVpcID.NotFound", fmt.Sprintf("The vpc ID '%s' does not exist", id))
+		return
+	}
+
+	for _, subnet := range s.ec2.subnets {
+		if subnet.VpcID == id {
+			writeEc2Error(w, "DependencyViolation", fmt.Sprintf("The vpc '%s' has dependencies and cannot be deleted.", id))
+			return
+		}
+	}
+
+	for _, sg := range s.ec2.securityGroups {
+		if sg.VpcID == id && sg.GroupName != "default" {
+			writeEc2Error(w, "DependencyViolation", fmt.Sprintf("The vpc '%s' has dependencies and cannot be deleted.", id))
+			return
+		}
+	}
+
+	for sgID, sg := range s.ec2.securityGroups {
+		if sg.VpcID == id {
+			delete(s.ec2.securityGroups, sgID)
+			delete(s.ec2.tags, sgID)
+		}
+	}
+
+	for routeTableID, routeTable := range s.ec2.routeTables {
+		if routeTable.VpcID == id {
+			delete(s.ec2.routeTables, routeTableID)
+			delete(s.ec2.tags, routeTableID)
+		}
+	}
+
+	for networkAclID, networkAcl := range s.ec2.networkAcls {
+		if networkAcl.VpcID == id {
+			delete(s.ec2.networkAcls, networkAclID)
+			delete(s.ec2.tags, networkAclID)
+		}
+	}
+
+	delete(s.ec2.vpcs, id)
+	delete(s.ec2.tags, id)
+
+	writeEc2Return(w, r)
+}
+
+func (s *Server) ec2DescribeRouteTables(w http.ResponseWriter, r *request) {
+	ids := listParam(r.Params, "RouteTableId")
+
+	for _, id := range ids {
+		if _, ok := s.ec2.routeTables[id]; !ok {
+			writeEc2Error(w, "InvalidRouteTableID.NotFound", fmt.Sprintf("The routeTable ID '%s' does not exist", id))
+			return
+		}
+	}
+
+	var routeTables []ec2RouteTable
+	for _, routeTable := range s.ec2.routeTables {
+		if !contains(ids, routeTable.RouteTableID) {
+			continue
+		}
+
+		matched, err := s.ec2.ec2MatchFilters(routeTable.RouteTableID, filtersParam(r.Params), func(name string) ([]string, bool) {
+			switch name {
+			case "association.main":
+				var values []string
+				for _, association := range routeTable.AssociationSet {
+					values = append(values, strconv.FormatBool(association.Main))
+				}
+				return values, true
+			case "route-table-id":
+				return []string{routeTable.RouteTableID}, true
+			case "vpc-id":
+				return []string{routeTable.VpcID}, true
+			}
+			return nil, false
+		})
+		if err != nil {
+			writeEc2Error(w, "InvalidParameterValue", err.Error())
+			return
+		}
+
+		if matched {
+			rt := *routeTable
+			rt.TagSet = s.ec2.ec2TagSet(routeTable.RouteTableID)
+			routeTables = append(routeTables, rt)
+		}
+	}
+
+	sort.Slice(routeTables, func(i, j int) bool { return routeTables[i].RouteTableID < routeTables[j].RouteTableID })
+
+	writeEc2Result(w, r, struct {
+		RouteTableSet []ec2RouteTable `xml:"routeTableSet>item"`
+	}{routeTables})
+}
+
+func (s *Server) ec2DescribeNetworkAcls(w http.ResponseWriter, r *request) {
+	ids := listParam(r.Params, "NetworkAclId")
+
+	for _, id := range ids {
+		if _, ok := s.ec2.networkAcls[id]; !ok {
+			writeEc2Error(w, "InvalidNetworkAclID.NotFound", fmt.Sprintf("The network ACL ID '%s' does not exist", id))
+			return
+		}
+	}
+
+	var networkAcls []ec2NetworkAcl
+	for _, networkAcl := range s.ec2.networkAcls {
+		if !contains(ids, networkAcl.NetworkAclID) {
+			continue
+		}
+
+		matched, err := s.ec2.ec2MatchFilters(networkAcl.NetworkAclID, filtersParam(r.Params), func(name string) ([]string, bool) {
+			switch name {
+			case "default":
+				return []string{strconv.FormatBool(networkAcl.IsDefault)}, true
+			case "network-acl-id":
+				return []string{networkAcl.NetworkAclID}, true
+			case "vpc-id":
+				return []string{networkAcl.VpcID}, true
+			}
+			return nil, false
+		})
+		if err != nil {
+			writeEc2Error(w, "InvalidParameterValue", err.Error())
+			return
+		}
+
+		if matched {
+			acl := *networkAcl
+			acl.TagSet = s.ec2.ec2TagSet(networkAcl.NetworkAclID)
+			networkAcls = append(networkAcls, acl)
+		}
+	}
+
+	sort.Slice(networkAcls, func(i, j int) bool { return networkAcls[i].NetworkAclID < networkAcls[j].NetworkAclID })
+
+	writeEc2Result(w, r, struct {
+		NetworkAclSet []ec2NetworkAcl `xml:"networkAclSet>item"`
+	}{networkAcls})
+}
+
+// ec2DescribeNetworkInterfaces always returns no network interfaces, as no
+// fake resource requires one.
+func (s *Server) ec2DescribeNetworkInterfaces(w http.ResponseWriter, r *request) {
+	writeEc2Result(w, r, struct {
+		NetworkInterfaceSet []struct{} `xml:"networkInterfaceSet>item"`
+	}{})
+}
+
+//
+// Subnets
+//
+
+func (s *Server) ec2CreateSubnet(w http.ResponseWriter, r *request) {
+	vpcID := r.Params.Get("VpcId")
+
+	if _, ok := s.ec2.vpcs[vpcID]; !ok {
+		writeEc2Error(w, "InvalidVpcID.NotFound", fmt.Sprintf("The vpc ID '%s' does not exist", vpcID))
+		return
+	}
+
+	availabilityZone := r.Params.Get("AvailabilityZone")
+	if availabilityZone == "" {
+		availabilityZone = r.Region + "a"
+	}
+
+	availabilityZoneID := r.Params.Get("AvailabilityZoneId")
+	if availabilityZoneID == "" {
+		availabilityZoneID = ec2AvailabilityZoneID(r.Region)
+	}
+
+	subnet := &ec2Subnet{
+		SubnetID:                s.nextID("subnet-"),
+		VpcID:                   vpcID,
+		OwnerID:                 AccountID,
+		CidrBlock:               r.Params.Get("CidrBlock"),
+		AvailabilityZone:        availabilityZone,
+		AvailabilityZoneID:      availabilityZoneID,
+		AvailableIPAddressCount: 251,
+		State:                   "available",
+	}
+	subnet.SubnetArn = fmt.Sprintf("arn:aws:ec2:%s:%s:subnet/%s", r.Region, AccountID, subnet.SubnetID)
+	s.ec2.subnets[subnet.SubnetID] = subnet
+
+	writeEc2Result(w, r, struct {
+		Subnet *ec2Subnet `xml:"subnet"`
+	}{subnet})
+}
+
+func (s *Server) ec2DescribeSubnets(w http.ResponseWriter, r *request) {
+	ids := listParam(r.Params, "SubnetId")
+
+	for _, id := range ids {
+		if _, ok := s.ec2.subnets[id]; !ok {
+			writeEc2Error(w, "InvalidSubnetID.NotFound", fmt.Sprintf("The subnet ID '%s' does not exist", id))
+			return
+		}
+	}
+
+	var subnets []ec2Subnet
+	for _, subnet := range s.ec2.subnets {
+		if !contains(ids, subnet.SubnetID) {
+			continue
+		}
+
+		matched, err := s.ec2.ec2MatchFilters(subnet.SubnetID, filtersParam(r.Params), func(name string) ([]string, bool) {
+			switch name {
+			case "availability-zone", "availabilityZone":
+				return []string{subnet.AvailabilityZone}, true
+			case "availability-zone-id":
+				return []string{subnet.AvailabilityZoneID}, true
+			case "cidr", "cidr-block", "cidrBlock":
+				return []string{subnet.CidrBlock}, true
+			case "default-for-az", "defaultForAz":
+				return []string{strconv.FormatBool(subnet.DefaultForAz)}, true
+			case "state":
+				return []string{subnet.State}, true
+			case "subnet-id":
+				return []string{subnet.SubnetID}, true
+			case "vpc-id":
+				return []string{subnet.VpcID}, true
+			}
+			return nil, false
+		})
+		if err != nil {
+			writeEc2Error(w, "InvalidParameterValue", err.Error())
+			return
+		}
+
+		if matched {
+			sn := *subnet
+			sn.TagSet = s.ec2.ec2TagSet(subnet.SubnetID)
+			subnets = append(subnets, sn)
+		}
+	}
+
+	sort.Slice(subnets, func(i, j int) bool { return subnets[i].SubnetID < subnets[j].SubnetID })
+
+	writeEc2Result(w, r, struct {
+		SubnetSet []ec2Subnet `xml:"subnetSet>item"`
+	}{subnets})
+}
+
+func (s *Server) ec2ModifySubnetAttribute(w http.ResponseWriter, r *request) {
+	id := r.Params.Get("SubnetId")
+
+	subnet, ok := s.ec2.subnets[id]
+	if !ok {
+		writeEc2Error(w, "InvalidSubnetID.NotFound", fmt.Sprintf("The subnet ID '%s' does not exist", id))
+		return
+	}
+
+	if v := r.Params.Get("MapPublicIpOnLaunch.Value"); v != "" {
+		subnet.MapPublicIPOnLaunch = v == "true"
+	}
+
+	if v := r.Params.Get("AssignIpv6AddressOnCreation.Value"); v != "" {
+		subnet.AssignIpv6AddressOnCreation = v == "true"
+	}
+
+	writeEc2Result(w, r, struct{}{})
+}
+
+func (s *Server) ec2DeleteSubnet(w http.ResponseWriter, r *request) {
+	id := r.Params.Get("SubnetId")
+
+	if _, ok := s.ec2.subnets[id]; !ok {
+		writeEc2Error(w, "InvalidSubnetID.NotFound", fmt.Sprintf("The subnet ID '%s' does not exist", id))
+		return
+	}
+
+	delete(s.ec2.subnets, id)
+	delete(s.ec2.tags, id)
+
+	writeEc2Result(w, r, struct{}{})
+}
+
+//
+// Security groups
+//
+
+func (s *Server) ec2CreateSecurityGroup(w http.ResponseWriter, r *request) {
+	vpcID := r.Params.Get("VpcId")
+	name := r.Params.Get("GroupName")
+
+	if vpcID != "" {
+		if _, ok := s.ec2.vpcs[vpcID]; !ok {
+			writeEc2Error(w, "InvalidVpcID.NotFound", fmt.Sprintf("The vpc ID '%s' does not exist", vpcID))
+			return
+		}
+	}
+
+	for _, sg := range s.ec2.securityGroups {
+		if sg.VpcID == vpcID && sg.GroupName == name {
+			writeEc2Error(w, "InvalidGroup.Duplicate", fmt.Sprintf("The security group '%s' already exists for VPC '%s'", name, vpcID))
+			return
+		}
+	}
+
+	sg := &ec2SecurityGroup{
+		OwnerID:          AccountID,
+		GroupID:          s.nextID("sg-"),
+		GroupName:        name,
+		GroupDescription: r.Params.Get("GroupDescription"),
+		VpcID:            vpcID,
+	}
+
+	// Security groups of a VPC allow all outbound traffic by default
+	if vpcID != "" {
+		sg.egress = []ec2SecurityGroupRule{{Protocol: "-1", Type: ec2SecurityGroupRuleTypeCidr, Value: "0.0.0.0/0"}}
+	}
+
+	s.ec2.securityGroups[sg.GroupID] = sg
+
+	writeEc2Result(w, r, struct {
+		GroupID string `xml:"groupId"`
+	}{sg.GroupID})
+}
+
+// ec2SecurityGroupParam returns the security group of the GroupId or
+// GroupName parameter.
+func (s *Server) ec2SecurityGroupParam(params url.Values) (*ec2SecurityGroup, bool) {
+	if id := params.Get("GroupId"); id != "" {
+		sg, ok := s.ec2.securityGroups[id]
+		return sg, ok
+	}
+
+	for _, sg := range s.ec2.securityGroups {
+		if sg.VpcID == "" && sg.GroupName == params.Get("GroupName") {
+			return sg, true
+		}
+	}
+
+	return nil, false
+}
+
+func (s *Server) ec2DescribeSecurityGroups(w http.ResponseWriter, r *request) {
+	ids := listParam(r.Params, "GroupId")
+	names := listParam(r.Params, "GroupName")
+
+	for _, id := range ids {
+		if _, ok := s.ec2.securityGroups[id]; !ok {
+			writeEc2Error(w, "InvalidGroup.NotFound", fmt.Sprintf("The security group '%s' does not exist", id))
+			return
+		}
+	}
+
+	var securityGroups []ec2SecurityGroup
+	for _, sg := range s.ec2.securityGroups {
+		if !contains(ids, sg.GroupID) || !contains(names, sg.GroupName) {
+			continue
+		}
+
+		matched, err := s.ec2.ec2MatchFilters(sg.GroupID, filtersParam(r.Params), func(name string) ([]string, bool) {
+			switch name {
+			case "description":
+				return []string{sg.GroupDescription}, true
+			case "group-id":
+				return []string{sg.GroupID}, true
+			case "group-name":
+				return []string{sg.GroupName}, true
+			case "owner-id":
+				return []string{sg.OwnerID}, true
+			case "vpc-id":
+				return []string{sg.VpcID}, true
+			}
+			return nil, false
+		})
+		if err != nil {
+			writeEc2Error(w, "InvalidParameterValue", err.Error())
+			return
+		}
+
+		if matched {
+			group := *sg
+			group.IPPermissions = ec2IPPermissions(sg.ingress)
+			group.IPPermissionsEgress = ec2IPPermissions(sg.egress)
+			group.TagSet = s.ec2.ec2TagSet(sg.GroupID)
+			securityGroups = append(securityGroups, group)
+		}
+	}
+
+	for _, name := range names {
+		found := false
+		for _, sg := range securityGroups {
+			if sg.GroupName == name {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			writeEc2Error(w, "InvalidGroup.NotFound", fmt.Sprintf("The security group '%s' does not exist in default VPC", name))
+			return
+		}
+	}
+
+	sort.Slice(securityGroups, func(i, j int) bool { return securityGroups[i].GroupID < securityGroups[j].GroupID })
+
+	writeEc2Result(w, r, struct {
+		SecurityGroupInfo []ec2SecurityGroup `xml:"securityGroupInfo>item"`
+	}{securityGroups})
+}
+
+func (s *Server) ec2DeleteSecurityGroup(w http.ResponseWriter, r *request) {
+	sg, ok := s.ec2SecurityGroupParam(r.Params)
+	if !ok {
+		writeEc2Error(w, "InvalidGroup.NotFound", fmt.Sprintf("The security group '%s%s' does not exist", r.Params.Get("GroupId"), r.Params.Get("GroupName")))
+		return
+	}
+
+	if sg.VpcID != "" && sg.GroupName == "default" {
+		writeEc2Error(w, "CannotDelete", fmt.Sprintf("the specified group: \"%s\" name: \"default\" cannot be deleted by a user", sg.GroupID))
+		return
+	}
+
+	for _, other := range s.ec2.securityGroups {
+		if other.GroupID == sg.GroupID {
+			continue
+		}
+
+		for _, rule := range append(append([]ec2SecurityGroupRule{}, other.ingress...), other.egress...) {
+			if rule.Type == ec2SecurityGroupRuleTypeGroup && rule.Value == sg.GroupID {
+				writeEc2Error(w, "DependencyViolation", fmt.Sprintf("resource %s has a dependent object", sg.GroupID))
+				return
+			}
+		}
+	}
+
+	delete(s.ec2.securityGroups, sg.GroupID)
+	delete(s.ec2.tags, sg.GroupID)
+
+	writeEc2Return(w, r)
+}
+
+func (s *Server) ec2AuthorizeSecurityGroupRules(egress bool) func(w http.ResponseWriter, r *request) {
+	return func(w http.ResponseWriter, r *request) {
+		sg, ok := s.ec2SecurityGroupParam(r.Params)
+		if !ok {
+			writeEc2Error(w, "InvalidGroup.NotFound", fmt.Sprintf("The security group '%s%s' does not exist", r.Params.Get("GroupId"), r.Params.Get("GroupName")))
+			return
+		}
+
+		rules, err := s.ec2SecurityGroupRulesParam(r.Params)
+		if err != nil {
+			writeEc2Error(w, "InvalidGroup.NotFound", err.Error())
+			return
+		}
+
+		existing := &sg.ingress
+		if egress {
+			existing = &sg.egress
+		}
+
+		for _, rule := range rules {
+			if ec2SecurityGroupRuleIndex(*existing, rule) >= 0 {
+				writeEc2Error(w, "InvalidPermission.Duplicate", fmt.Sprintf("the specified rule \"peer: %s, %s, ALLOW\" already exists", rule.Value, ec2SecurityGroupRuleString(rule)))
+				return
+			}
+		}
+
+		*existing = append(*existing, rules...)
+
+		writeEc2Return(w, r)
+	}
+}
+
+func (s *Server) ec2RevokeSecurityGroupRules(egress bool) func(w http.ResponseWriter, r *request) {
+	return func(w http.ResponseWriter, r *request) {
+		sg, ok := s.ec2SecurityGroupParam(r.Params)
+		if !ok {
+			writeEc2Error(w, "InvalidGroup.NotFound", fmt.Sprintf("The security group '%s%s' does not exist", r.Params.Get("GroupId"), r.Params.Get("GroupName")))
+			return
+		}
+
+		rules, err := s.ec2SecurityGroupRulesParam(r.Params)
+		if err != nil {
+			writeEc2Error(w, "InvalidGroup.NotFound", err.Error())
+			return
+		}
+
+		existing := &sg.ingress
+		if egress {
+			existing = &sg.egress
+		}
+
+		for _, rule := range rules {
+			if ec2SecurityGroupRuleIndex(*existing, rule) < 0 {
+				writeEc2Error(w, "InvalidPermission.NotFound", "The specified rule does not exist in this security group.")
+				return
+			}
+		}
+
+		for _, rule := range rules {
+			i := ec2SecurityGroupRuleIndex(*existing, rule)
+			*existing = append((*existing)[:i], (*existing)[i+1:]...)
+		}
+
+		writeEc2Return(w, r)
+	}
+}
+
+// ec2SecurityGroupRulesParam returns the individual rules of the
+// IpPermissions parameter.
+func (s *Server) ec2SecurityGroupRulesParam(params url.Values) ([]ec2SecurityGroupRule, error) {
+	var rules []ec2SecurityGroupRule
+
+	for _, permission := range structListParam(params, "IpPermissions") {
+		protocol := strings.ToLower(permission.Get("IpProtocol"))
+		switch protocol {
+		case "all":
+			protocol = "-1"
+		case "1":
+			protocol = "icmp"
+		case "6":
+			protocol = "tcp"
+		case "17":
+			protocol = "udp"
+		}
+
+		var fromPort, toPort int64
+		if protocol != "-1" {
+			fromPort, _ = strconv.ParseInt(permission.Get("FromPort"), 10, 64)
+			toPort, _ = strconv.ParseInt(permission.Get("ToPort"), 10, 64)
+		}
+
+		newRule := func(ruleType, value, description string) ec2SecurityGroupRule {
+			return ec2SecurityGroupRule{
+				Protocol:    protocol,
+				FromPort:    fromPort,
+				ToPort:      toPort,
+				Type:        ruleType,
+				Value:       value,
+				Description: description,
+			}
+		}
+
+		for _, ipRange := range structListParam(permission, "IpRanges") {
+			rules = append(rules, newRule(ec2SecurityGroupRuleTypeCidr, ipRange.Get("CidrIp"), ipRange.Get("Description")))
+		}
+
+		for _, ipv6Range := range structListParam(permission, "Ipv6Ranges") {
+			rules = append(rules, newRule(ec2SecurityGroupRuleTypeIpv6Cidr, ipv6Range.Get("CidrIpv6"), ipv6Range.Get("Description")))
+		}
+
+		for _, prefixListID := range structListParam(permission, "PrefixListIds") {
+			rules = append(rules, newRule(ec2SecurityGroupRuleTypePrefixListID, prefixListID.Get("PrefixListId"), prefixListID.Get("Description")))
+		}
+
+		for _, group := range structListParam(permission, "Groups") {
+			groupID := group.Get("GroupId")
+
+			if groupID == "" {
+				sg, ok := s.ec2SecurityGroupParam(url.Values{"GroupName": []string{group.Get("GroupName")}})
+				if !ok {
+					return nil, fmt.Errorf("The security group '%s' does not exist", group.Get("GroupName"))
+				}
+				groupID = sg.GroupID
+			}
+
+			if _, ok := s.ec2.securityGroups[groupID]; !ok {
+				return nil, fmt.Errorf("The security group '%s' does not exist", groupID)
+			}
+
+			rules = append(rules, newRule(ec2SecurityGroupRuleTypeGroup, groupID, group.Get("Description")))
+		}
+	}
+
+	return rules, nil
+}
+
+// ec2SecurityGroupRuleIndex returns the index of the rule in the list, ignoring
+// descriptions, or -1 if the list does not contain the rule.
+func ec2SecurityGroupRuleIndex(rules []ec2SecurityGroupRule, rule ec2SecurityGroupRule) int {
+	for i, r := range rules {
+		if r.Protocol == rule.Protocol && r.FromPort == rule.FromPort && r.ToPort == rule.ToPort && r.Type == rule.Type && r.Value == rule.Value {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func ec2SecurityGroupRuleString(rule ec2SecurityGroupRule) string {
+	if rule.Protocol == "-1" {
+		return "ALL"
+	}
+
+	return fmt.Sprintf("%s %d-%d", strings.ToUpper(rule.Protocol), rule.FromPort, rule.ToPort)
+}
+
+// ec2IPPermissions groups the individual rules of a security group by
+// protocol and port range, as they are described by the API.
+func ec2IPPermissions(rules []ec2SecurityGroupRule) []ec2IPPermission {
+	var permissions []ec2IPPermission
+
+	for _, rule := range rules {
+		i := 0
+		for ; i < len(permissions); i++ {
+			p := permissions[i]
+
+			if p.IPProtocol != rule.Protocol {
+				continue
+			}
+
+			if rule.Protocol == "-1" || (*p.FromPort == rule.FromPort && *p.ToPort == rule.ToPort) {
+				break
+			}
+		}
+
+		if i == len(permissions) {
+			permission := ec2IPPermission{IPProtocol: rule.Protocol}
+
+			if rule.Protocol != "-1" {
+				fromPort, toPort := rule.FromPort, rule.ToPort
+				permission.FromPort = &fromPort
+				permission.ToPort = &toPort
+			}
+
+			permissions = append(permissions, permission)
+		}
+
+		p := &permissions[i]
+
+		switch rule.Type {
+		case ec2SecurityGroupRuleTypeCidr:
+			p.IPRanges = append(p.IPRanges, ec2IPRange{CidrIP: rule.Value, Description: rule.Description})
+		case ec2SecurityGroupRuleTypeGroup:
+			p.Groups = append(p.Groups, ec2UserIDGroupPair{GroupID: rule.Value, UserID: AccountID, Description: rule.Description})
+		case ec2SecurityGroupRuleTypeIpv6Cidr:
+			p.Ipv6Ranges = append(p.Ipv6Ranges, ec2Ipv6Range{CidrIpv6: rule.Value, Description: rule.Description})
+		case ec2SecurityGroupRuleTypePrefixListID:
+			p.PrefixListIds = append(p.PrefixListIds, ec2PrefixListIDItem{PrefixListID: rule.Value, Description: rule.Description})
+		}
+	}
+
+	return permissions
+}
@@ -0,0 +1,723 @@
+package fakeaws
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// creationDate is the creation date of all the resources which report one.
+const creationDate = "2019-01-01T00:00:00Z"
+
+type iamState struct {
+	policies map[string]*iamPolicy
+	roles    map[string]*iamRole
+}
+
+func newIamState() *iamState {
+	return &iamState{
+		policies: make(map[string]*iamPolicy),
+		roles:    make(map[string]*iamRole),
+	}
+}
+
+type iamTag struct {
+	Key   string
+	Value string
+}
+
+type iamRole struct {
+	Path                     string
+	RoleName                 string
+	RoleID                   string `xml:"RoleId"`
+	Arn                      string
+	CreateDate               string
+	AssumeRolePolicyDocument string
+	Description              string                  `xml:",omitempty"`
+	MaxSessionDuration       int                     `xml:",omitempty"`
+	PermissionsBoundary      *iamPermissionsBoundary `xml:",omitempty"`
+	Tags                     []iamTag                `xml:"Tags>member"`
+
+	attachedPolicies []string
+	inlinePolicies   map[string]string
+	tags             map[string]string
+}
+
+type iamPermissionsBoundary struct {
+	PermissionsBoundaryArn  string
+	PermissionsBoundaryType string
+}
+
+type iamPolicy struct {
+	PolicyName       string
+	PolicyID         string `xml:"PolicyId"`
+	Arn              string
+	Path             string
+	DefaultVersionID string `xml:"DefaultVersionId"`
+	AttachmentCount  int
+	IsAttachable     bool
+	Description      string `xml:",omitempty"`
+	CreateDate       string
+	UpdateDate       string
+
+	nextVersion int
+	versions    []*iamPolicyVersion
+}
+
+type iamPolicyVersion struct {
+	Document         string `xml:",omitempty"`
+	VersionID        string `xml:"VersionId"`
+	IsDefaultVersion bool
+	CreateDate       string
+}
+
+func (s *Server) iamOperations() map[string]func(w http.ResponseWriter, r *request) {
+	return map[string]func(w http.ResponseWriter, r *request){
+		"AttachRolePolicy":              s.iamAttachRolePolicy,
+		"CreatePolicy":                  s.iamCreatePolicy,
+		"CreatePolicyVersion":           s.iamCreatePolicyVersion,
+		"CreateRole":                    s.iamCreateRole,
+		"DeletePolicy":                  s.iamDeletePolicy,
+		"DeletePolicyVersion":           s.iamDeletePolicyVersion,
+		"DeleteRole":                    s.iamDeleteRole,
+		"DeleteRolePermissionsBoundary": s.iamDeleteRolePermissionsBoundary,
+		"DeleteRolePolicy":              s.iamDeleteRolePolicy,
+		"DetachRolePolicy":              s.iamDetachRolePolicy,
+		"GetPolicy":                     s.iamGetPolicy,
+		"GetPolicyVersion":              s.iamGetPolicyVersion,
+		"GetRole":                       s.iamGetRole,
+		"GetRolePolicy":                 s.iamGetRolePolicy,
+		"GetUser":                       s.iamGetUser,
+		"ListAttachedRolePolicies":      s.iamListAttachedRolePolicies,
+		"ListInstanceProfilesForRole":   s.iamListInstanceProfilesForRole,
+		"ListPolicyVersions":            s.iamListPolicyVersions,
+		"ListRolePolicies":              s.iamListRolePolicies,
+		"PutRolePermissionsBoundary":    s.iamPutRolePermissionsBoundary,
+		"PutRolePolicy":                 s.iamPutRolePolicy,
+		"TagRole":                       s.iamTagRole,
+		"UntagRole":                     s.iamUntagRole,
+		"UpdateAssumeRolePolicy":        s.iamUpdateAssumeRolePolicy,
+		"UpdateRole":                    s.iamUpdateRole,
+		"UpdateRoleDescription":         s.iamUpdateRoleDescription,
+	}
+}
+
+// iamPolicyDocument validates a policy document parameter, writing the error
+// response if it is not valid.
+func iamPolicyDocument(w http.ResponseWriter, r *request, name string) (string, bool) {
+	document := r.Params.Get(name)
+
+	if !json.Valid([]byte(document)) {
+		writeQueryError(w, http.StatusBadRequest, "MalformedPolicyDocument", "Syntax errors in policy.")
+		return "", false
+	}
+
+	return document, true
+}
+
+func iamPath(r *request) string {
+	if path := r.Params.Get("Path"); path != "" {
+		return path
+	}
+
+	return "/"
+}
+
+func (s *Server) iamGetUser(w http.ResponseWriter, r *request) {
+	type user struct {
+		Path       string
+		UserName   string
+		UserID     string `xml:"UserId"`
+		Arn        string
+		CreateDate string
+	}
+
+	writeQueryResult(w, r, struct {
+		User user
+	}{user{
+		Path:       "/",
+		UserName:   "fakeaws",
+		UserID:     "AIDAFAKEAWS",
+		Arn:        fmt.Sprintf("arn:aws:iam::%s:user/fakeaws", AccountID),
+		CreateDate: creationDate,
+	}})
+}
+
+//
+// Roles
+//
+
+// iamRoleParam returns the role of the RoleName parameter, writing the error
+// response if it does not exist.
+func (s *Server) iamRoleParam(w http.ResponseWriter, r *request) (*iamRole, bool) {
+	name := r.Params.Get("RoleName")
+
+	role, ok := s.iam.roles[name]
+	if !ok {
+		writeQueryError(w, http.StatusNotFound, "NoSuchEntity", fmt.Sprintf("The role with name %s cannot be found.", name))
+	}
+
+	return role, ok
+}
+
+// iamRoleResult returns the role as described by the API.
+func iamRoleResult(role *iamRole) iamRole {
+	result := *role
+	result.AssumeRolePolicyDocument = url.QueryEscape(role.AssumeRolePolicyDocument)
+	result.Tags = nil
+
+	for k, v := range role.tags {
+		result.Tags = append(result.Tags, iamTag{Key: k, Value: v})
+	}
+
+	sort.Slice(result.Tags, func(i, j int) bool { return result.Tags[i].Key < result.Tags[j].Key })
+
+	return result
+}
+
+func (s *Server) iamCreateRole(w http.ResponseWriter, r *request) {
+	name := r.Params.Get("RoleName")
+
+	if _, ok := s.iam.roles[name]; ok {
+		writeQueryError(w, http.StatusConflict, "EntityAlreadyExists", fmt.Sprintf("Role with name %s already exists.", name))
+		return
+	}
+
+	document, ok := iamPolicyDocument(w, r, "AssumeRolePolicyDocument")
+	if !ok {
+		return
+	}
+
+	maxSessionDuration := 3600
+	if v := r.Params.Get("MaxSessionDuration"); v != "" {
+		maxSessionDuration, _ = strconv.Atoi(v)
+	}
+
+	path := iamPath(r)
+
+	role := &iamRole{
+		Path:                     path,
+		RoleName:                 name,
+		RoleID:                   strings.ToUpper(s.nextID("aroa")),
+		Arn:                      fmt.Sprintf("arn:aws:iam::%s:role%s%s", AccountID, path, name),
+		CreateDate:               creationDate,
+		AssumeRolePolicyDocument: document,
+		Description:              r.Params.Get("Description"),
+		MaxSessionDuration:       maxSessionDuration,
+		inlinePolicies:           make(map[string]string),
+		tags:                     mapParam(r.Params, "Tags.member", "Key", "Value"),
+	}
+
+	if v := r.Params.Get("PermissionsBoundary"); v != "" {
+		role.PermissionsBoundary = &iamPermissionsBoundary{
+			PermissionsBoundaryArn:  v,
+			PermissionsBoundaryType: "Policy",
+		}
+	}
+
+	s.iam.roles[name] = role
+
+	writeQueryResult(w, r, struct {
+		Role iamRole
+	}{iamRoleResult(role)})
+}
+
+func (s *Server) iamGetRole(w http.ResponseWriter, r *request) {
+	role, ok := s.iamRoleParam(w, r)
+	if !ok {
+		return
+	}
+
+	writeQueryResult(w, r, struct {
+		Role iamRole
+	}{iamRoleResult(role)})
+}
+
+func (s *Server) iamUpdateRole(w http.ResponseWriter, r *request) {
+	role, ok := s.iamRoleParam(w, r)
+	if !ok {
+		return
+	}
+
+	if _, ok := r.Params["Description"]; ok {
+		role.Description = r.Params.Get("Description")
+	}
+
+	if v := r.Params.Get("MaxSessionDuration"); v != "" {
+		role.MaxSessionDuration, _ = strconv.Atoi(v)
+	}
+
+	writeQueryResult(w, r, struct{}{})
+}
+
+func (s *Server) iamUpdateRoleDescription(w http.ResponseWriter, r *request) {
+	role, ok := s.iamRoleParam(w, r)
+	if !ok {
+		return
+	}
+
+	role.Description = r.Params.Get("Description")
+
+	writeQueryResult(w, r, struct {
+		Role iamRole
+	}{iamRoleResult(role)})
+}
+
+func (s *Server) iamUpdateAssumeRolePolicy(w http.ResponseWriter, r *request) {
+	role, ok := s.iamRoleParam(w, r)
+	if !ok {
+		return
+	}
+
+	document, ok := iamPolicyDocument(w, r, "PolicyDocument")
+	if !ok {
+		return
+	}
+
+	role.AssumeRolePolicyDocument = document
+
+	writeQueryResult(w, r, nil)
+}
+
+func (s *Server) iamPutRolePermissionsBoundary(w http.ResponseWriter, r *request) {
+	role, ok := s.iamRoleParam(w, r)
+	if !ok {
+		return
+	}
+
+	role.PermissionsBoundary = &iamPermissionsBoundary{
+		PermissionsBoundaryArn:  r.Params.Get("PermissionsBoundary"),
+		PermissionsBoundaryType: "Policy",
+	}
+
+	writeQueryResult(w, r, nil)
+}
+
+func (s *Server) iamDeleteRolePermissionsBoundary(w http.ResponseWriter, r *request) {
+	role, ok := s.iamRoleParam(w, r)
+	if !ok {
+		return
+	}
+
+	role.PermissionsBoundary = nil
+
+	writeQueryResult(w, r, nil)
+}
+
+func (s *Server) iamTagRole(w http.ResponseWriter, r *request) {
+	role, ok := s.iamRoleParam(w, r)
+	if !ok {
+		return
+	}
+
+	for k, v := range mapParam(r.Params, "Tags.member", "Key", "Value") {
+		role.tags[k] = v
+	}
+
+	writeQueryResult(w, r, nil)
+}
+
+func (s *Server) iamUntagRole(w http.ResponseWriter, r *request) {
+	role, ok := s.iamRoleParam(w, r)
+	if !ok {
+		return
+	}
+
+	for _, k := range listParam(r.Params, "TagKeys.member") {
+		delete(role.tags, k)
+	}
+
+	writeQueryResult(w, r, nil)
+}
+
+// iamListInstanceProfilesForRole always returns no instance profiles, as
+// they are not supported by the fake server.
+func (s *Server) iamListInstanceProfilesForRole(w http.ResponseWriter, r *request) {
+	if _, ok := s.iamRoleParam(w, r); !ok {
+		return
+	}
+
+	writeQueryResult(w, r, struct {
+		InstanceProfiles []struct{} `xml:"InstanceProfiles>member"`
+		IsTruncated      bool
+	}{})
+}
+
+func (s *Server) iamAttachRolePolicy(w http.ResponseWriter, r *request) {
+	role, ok := s.iamRoleParam(w, r)
+	if !ok {
+		return
+	}
+
+	policyArn := r.Params.Get("PolicyArn")
+
+	// AWS managed policies are assumed to exist
+	policy, ok := s.iam.policies[policyArn]
+	if !ok && !strings.HasPrefix(policyArn, "arn:aws:iam::aws:policy/") {
+		writeQueryError(w, http.StatusNotFound, "NoSuchEntity", fmt.Sprintf("Policy %s does not exist or is not attachable.", policyArn))
+		return
+	}
+
+	for _, attached := range role.attachedPolicies {
+		if attached == policyArn {
+			writeQueryResult(w, r, nil)
+			return
+		}
+	}
+
+	role.attachedPolicies = append(role.attachedPolicies, policyArn)
+	if policy != nil {
+		policy.AttachmentCount++
+	}
+
+	writeQueryResult(w, r, nil)
+}
+
+func (s *Server) iamDetachRolePolicy(w http.ResponseWriter, r *request) {
+	role, ok := s.iamRoleParam(w, r)
+	if !ok {
+		return
+	}
+
+	policyArn := r.Params.Get("PolicyArn")
+
+	for i, attached := range role.attachedPolicies {
+		if attached == policyArn {
+			role.attachedPolicies = append(role.attachedPolicies[:i], role.attachedPolicies[i+1:]...)
+			if policy, ok := s.iam.policies[policyArn]; ok {
+				policy.AttachmentCount--
+			}
+
+			writeQueryResult(w, r, nil)
+			return
+		}
+	}
+
+	writeQueryError(w, http.StatusNotFound, "NoSuchEntity", fmt.Sprintf("Policy %s was not found.", policyArn))
+}
+
+func (s *Server) iamListAttachedRolePolicies(w http.ResponseWriter, r *request) {
+	role, ok := s.iamRoleParam(w, r)
+	if !ok {
+		return
+	}
+
+	type attachedPolicy struct {
+		PolicyName string
+		PolicyArn  string
+	}
+
+	var attachedPolicies []attachedPolicy
+	for _, policyArn := range role.attachedPolicies {
+		attachedPolicies = append(attachedPolicies, attachedPolicy{
+			PolicyName: policyArn[strings.LastIndex(policyArn, "/")+1:],
+			PolicyArn:  policyArn,
+		})
+	}
+
+	writeQueryResult(w, r, struct {
+		AttachedPolicies []attachedPolicy `xml:"AttachedPolicies>member"`
+		IsTruncated      bool
+	}{AttachedPolicies: attachedPolicies})
+}
+
+func (s *Server) iamPutRolePolicy(w http.ResponseWriter, r *request) {
+	role, ok := s.iamRoleParam(w, r)
+	if !ok {
+		return
+	}
+
+	document, ok := iamPolicyDocument(w, r, "PolicyDocument")
+	if !ok {
+		return
+	}
+
+	role.inlinePolicies[r.Params.Get("PolicyName")] = document
+
+	writeQueryResult(w, r, nil)
+}
+
+func (s *Server) iamGetRolePolicy(w http.ResponseWriter, r *request) {
+	role, ok := s.iamRoleParam(w, r)
+	if !ok {
+		return
+	}
+
+	name := r.Params.Get("PolicyName")
+
+	document, ok := role.inlinePolicies[name]
+	if !ok {
+		writeQueryError(w, http.StatusNotFound, "NoSuchEntity", fmt.Sprintf("The role policy with name %s cannot be found.", name))
+		return
+	}
+
+	writeQueryResult(w, r, struct {
+		RoleName       string
+		PolicyName     string
+		PolicyDocument string
+	}{role.RoleName, name, url.QueryEscape(document)})
+}
+
+func (s *Server) iamDeleteRolePolicy(w http.ResponseWriter, r *request) {
+	role, ok := s.iamRoleParam(w, r)
+	if !ok {
+		return
+	}
+
+	name := r.Params.Get("PolicyName")
+
+	if _, ok := role.inlinePolicies[name]; !ok {
+		writeQueryError(w, http.StatusNotFound, "NoSuchEntity", fmt.Sprintf("The role policy with name %s cannot be found.", name))
+		return
+	}
+
+	delete(role.inlinePolicies, name)
+
+	writeQueryResult(w, r, nil)
+}
+
+func (s *Server) iamListRolePolicies(w http.ResponseWriter, r *request) {
+	role, ok := s.iamRoleParam(w, r)
+	if !ok {
+		return
+	}
+
+	var names []string
+	for name := range role.inlinePolicies {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	writeQueryResult(w, r, struct {
+		PolicyNames []string `xml:"PolicyNames>member"`
+		IsTruncated bool
+	}{PolicyNames: names})
+}
+
+func (s *Server) iamDeleteRole(w http.ResponseWriter, r *request) {
+	role, ok := s.iamRoleParam(w, r)
+	if !ok {
+		return
+	}
+
+	if len(role.attachedPolicies) > 0 || len(role.inlinePolicies) > 0 {
+		writeQueryError(w, http.StatusConflict, "DeleteConflict", "Cannot delete entity, must detach all policies first.")
+		return
+	}
+
+	delete(s.iam.roles, role.RoleName)
+
+	writeQueryResult(w, r, nil)
+}
+
+//
+// Policies
+//
+
+// iamPolicyParam returns the policy of the PolicyArn parameter, writing the
+// error response if it does not exist.
+func (s *Server) iamPolicyParam(w http.ResponseWriter, r *request) (*iamPolicy, bool) {
+	policyArn := r.Params.Get("PolicyArn")
+
+	policy, ok := s.iam.policies[policyArn]
+	if !ok {
+		writeQueryError(w, http.StatusNotFound, "NoSuchEntity", fmt.Sprintf("Policy %s does not exist or is not attachable.", policyArn))
+	}
+
+	return policy, ok
+}
+
+// iamPolicyVersionParam returns the version of the policy in the VersionId
+// parameter, along with its index, writing the error response if it does not
+// exist.
+func iamPolicyVersionParam(w http.ResponseWriter, r *request, policy *iamPolicy) (int, bool) {
+	versionID := r.Params.Get("VersionId")
+
+	for i, version := range policy.versions {
+		if version.VersionID == versionID {
+			return i, true
+		}
+	}
+
+	writeQueryError(w, http.StatusNotFound, "NoSuchEntity", fmt.Sprintf("Policy %s version %s does not exist or is not attachable.", policy.Arn, versionID))
+
+	return -1, false
+}
+
+// addVersion adds a new version of the policy document.
+func (p *iamPolicy) addVersion(document string, setAsDefault bool) *iamPolicyVersion {
+	p.nextVersion++
+
+	version := &iamPolicyVersion{
+		Document:   document,
+		VersionID:  fmt.Sprintf("v%d", p.nextVersion),
+		CreateDate: creationDate,
+	}
+
+	if setAsDefault {
+		for _, v := range p.versions {
+			v.IsDefaultVersion = false
+		}
+
+		version.IsDefaultVersion = true
+		p.DefaultVersionID = version.VersionID
+	}
+
+	p.versions = append(p.versions, version)
+
+	return version
+}
+
+func (s *Server) iamCreatePolicy(w http.ResponseWriter, r *request) {
+	name := r.Params.Get("PolicyName")
+	path := iamPath(r)
+	policyArn := fmt.Sprintf("arn:aws:iam::%s:policy%s%s", AccountID, path, name)
+
+	if _, ok := s.iam.policies[policyArn]; ok {
+		writeQueryError(w, http.StatusConflict, "EntityAlreadyExists", fmt.Sprintf("A policy called %s already exists. Duplicate names are not allowed.", name))
+		return
+	}
+
+	document, ok := iamPolicyDocument(w, r, "PolicyDocument")
+	if !ok {
+		return
+	}
+
+	policy := &iamPolicy{
+		PolicyName:   name,
+		PolicyID:     strings.ToUpper(s.nextID("anpa")),
+		Arn:          policyArn,
+		Path:         path,
+		IsAttachable: true,
+		Description:  r.Params.Get("Description"),
+		CreateDate:   creationDate,
+		UpdateDate:   creationDate,
+	}
+	policy.addVersion(document, true)
+
+	s.iam.policies[policyArn] = policy
+
+	writeQueryResult(w, r, struct {
+		Policy *iamPolicy
+	}{policy})
+}
+
+func (s *Server) iamGetPolicy(w http.ResponseWriter, r *request) {
+	policy, ok := s.iamPolicyParam(w, r)
+	if !ok {
+		return
+	}
+
+	writeQueryResult(w, r, struct {
+		Policy *iamPolicy
+	}{policy})
+}
+
+func (s *Server) iamCreatePolicyVersion(w http.ResponseWriter, r *request) {
+	policy, ok := s.iamPolicyParam(w, r)
+	if !ok {
+		return
+	}
+
+	if len(policy.versions) >= 5 {
+		writeQueryError(w, http.StatusConflict, "LimitExceeded", "A managed policy can have up to 5 versions. Before you create a new version, you must delete an existing version.")
+		return
+	}
+
+	document, ok := iamPolicyDocument(w, r, "PolicyDocument")
+	if !ok {
+		return
+	}
+
+	version := *policy.addVersion(document, r.Params.Get("SetAsDefault") == "true")
+	version.Document = ""
+
+	writeQueryResult(w, r, struct {
+		PolicyVersion iamPolicyVersion
+	}{version})
+}
+
+func (s *Server) iamGetPolicyVersion(w http.ResponseWriter, r *request) {
+	policy, ok := s.iamPolicyParam(w, r)
+	if !ok {
+		return
+	}
+
+	i, ok := iamPolicyVersionParam(w, r, policy)
+	if !ok {
+		return
+	}
+
+	version := *policy.versions[i]
+	version.Document = url.QueryEscape(version.Document)
+
+	writeQueryResult(w, r, struct {
+		PolicyVersion iamPolicyVersion
+	}{version})
+}
+
+func (s *Server) iamListPolicyVersions(w http.ResponseWriter, r *request) {
+	policy, ok := s.iamPolicyParam(w, r)
+	if !ok {
+		return
+	}
+
+	var versions []iamPolicyVersion
+	for _, v := range policy.versions {
+		version := *v
+		version.Document = ""
+		versions = append(versions, version)
+	}
+
+	writeQueryResult(w, r, struct {
+		Versions    []iamPolicyVersion `xml:"Versions>member"`
+		IsTruncated bool
+	}{Versions: versions})
+}
+
+func (s *Server) iamDeletePolicyVersion(w http.ResponseWriter, r *request) {
+	policy, ok := s.iamPolicyParam(w, r)
+	if !ok {
+		return
+	}
+
+	i, ok := iamPolicyVersionParam(w, r, policy)
+	if !ok {
+		return
+	}
+
+	if policy.versions[i].IsDefaultVersion {
+		writeQueryError(w, http.StatusConflict, "DeleteConflict", "Cannot delete the default version of a policy.")
+		return
+	}
+
+	policy.versions = append(policy.versions[:i], policy.versions[i+1:]...)
+
+	writeQueryResult(w, r, nil)
+}
+
+func (s *Server) iamDeletePolicy(w http.ResponseWriter, r *request) {
+	policy, ok := s.iamPolicyParam(w, r)
+	if !ok {
+		return
+	}
+
+	if policy.AttachmentCount > 0 {
+		writeQueryError(w, http.StatusConflict, "DeleteConflict", "Cannot delete a policy attached to entities.")
+		return
+	}
+
+	if len(policy.versions) > 1 {
+		writeQueryError(w, http.StatusConflict, "DeleteConflict", "This policy has more than one version. Before you delete a policy, you must delete the policy's versions. The default version is deleted with the policy.")
+		return
+	}
+
+	delete(s.iam.policies, policy.Arn)
+
+	writeQueryResult(w, r, nil)
+}
@@ -0,0 +1,107 @@
+package fakeaws
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+)
+
+// listParam returns the values of a flattened list parameter, e.g. the
+// values of VpcId.1, VpcId.2, etc. for the VpcId prefix.
+func listParam(params url.Values, prefix string) []string {
+	var values []string
+
+	for i := 1; ; i++ {
+		key := fmt.Sprintf("%s.%d", prefix, i)
+
+		if _, ok := params[key]; !ok {
+			return values
+		}
+
+		values = append(values, params.Get(key))
+	}
+}
+
+// structListParam returns the members of a flattened list of structures
+// parameter, keyed by their field names, e.g. the Key and Value of Tag.1.Key,
+// Tag.1.Value, Tag.2.Key, etc. for the Tag prefix.
+func structListParam(params url.Values, prefix string) []url.Values {
+	var members []url.Values
+
+	for i := 1; ; i++ {
+		memberPrefix := fmt.Sprintf("%s.%d.", prefix, i)
+		member := url.Values{}
+
+		for k, v := range params {
+			if strings.HasPrefix(k, memberPrefix) {
+				member[strings.TrimPrefix(k, memberPrefix)] = v
+			}
+		}
+
+		if len(member) == 0 {
+			return members
+		}
+
+		members = append(members, member)
+	}
+}
+
+// mapParam returns the entries of a flattened map parameter, e.g.
+// Attributes.entry.1.key and Attributes.entry.1.value.
+func mapParam(params url.Values, prefix, keyName, valueName string) map[string]string {
+	m := make(map[string]string)
+
+	for _, entry := range structListParam(params, prefix) {
+		m[entry.Get(keyName)] = entry.Get(valueName)
+	}
+
+	return m
+}
+
+// filter is an EC2 style Describe* filter.
+type filter struct {
+	Name   string
+	Values []string
+}
+
+func filtersParam(params url.Values) []filter {
+	var filters []filter
+
+	for _, member := range structListParam(params, "Filter") {
+		filters = append(filters, filter{
+			Name:   member.Get("Name"),
+			Values: listParam(member, "Value"),
+		})
+	}
+
+	return filters
+}
+
+// matches returns whether any of the filter values matches the given value.
+// Filter values can contain * and ? wildcards.
+func (f filter) matches(value string) bool {
+	for _, pattern := range f.Values {
+		if ok, _ := path.Match(pattern, value); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// contains returns whether the given value, or no values at all if empty, are
+// part of the values to match.
+func contains(values []string, value string) bool {
+	if len(values) == 0 {
+		return true
+	}
+
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
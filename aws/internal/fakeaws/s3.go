@@ -0,0 +1,1285 @@
+package fakeaws
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// s3CanonicalUserID is the canonical user ID of the owner of all buckets
+	// and objects.
+	s3CanonicalUserID = "0000000000000000000000000000000000000000000000000000000000000000"
+
+	s3LastModified       = "2019-01-01T00:00:00.000Z"
+	s3LastModifiedHeader = "Tue, 01 Jan 2019 00:00:00 GMT"
+)
+
+type s3State struct {
+	buckets map[string]*s3Bucket
+	uploads map[string]*s3Upload
+}
+
+func newS3State() *s3State {
+	return &s3State{
+		buckets: make(map[string]*s3Bucket),
+		uploads: make(map[string]*s3Upload),
+	}
+}
+
+type s3Bucket struct {
+	name         string
+	objects      map[string]*s3Object
+	region       string
+	subresources map[string][]byte
+}
+
+type s3Object struct {
+	body         []byte
+	etag         string
+	headers      http.Header
+	subresources map[string][]byte
+}
+
+// s3Upload is an in progress multipart upload, by upload ID.
+type s3Upload struct {
+	bucket  string
+	key     string
+	headers http.Header
+	tagging []byte
+	parts   map[int][]byte
+}
+
+// s3MinPartSize is the minimum size of all but the last part of a multipart
+// upload.
+const s3MinPartSize = 5 * 1024 * 1024
+
+// s3Subresource describes the operations of a bucket or object subresource,
+// e.g. ?cors, whose document is stored as is by the server.
+type s3Subresource struct {
+	Get    string
+	Put    string
+	Delete string
+
+	// NotFoundCode and NotFoundMessage describe the error returned when the
+	// subresource is not configured, unless a Default document is returned.
+	NotFoundCode    string
+	NotFoundMessage string
+	Default         string
+}
+
+var s3DefaultAccessControlPolicy = fmt.Sprintf(`<AccessControlPolicy xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><Owner><ID>%[1]s</ID><DisplayName>fakeaws</DisplayName></Owner><AccessControlList><Grant><Grantee xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="CanonicalUser"><ID>%[1]s</ID><DisplayName>fakeaws</DisplayName></Grantee><Permission>FULL_CONTROL</Permission></Grant></AccessControlList></AccessControlPolicy>`, s3CanonicalUserID)
+
+// s3BucketSubresources are the bucket subresources, by query parameter.
+var s3BucketSubresources = map[string]s3Subresource{
+	"accelerate": {
+		Get:     "GetBucketAccelerateConfiguration",
+		Put:     "PutBucketAccelerateConfiguration",
+		Default: `<AccelerateConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"/>`,
+	},
+	"acl": {
+		Get:     "GetBucketAcl",
+		Put:     "PutBucketAcl",
+		Default: s3DefaultAccessControlPolicy,
+	},
+	"analytics": {
+		Get:             "GetBucketAnalyticsConfiguration",
+		Put:             "PutBucketAnalyticsConfiguration",
+		Delete:          "DeleteBucketAnalyticsConfiguration",
+		NotFoundCode:    "NoSuchConfiguration",
+		NotFoundMessage: "The specified configuration does not exist.",
+	},
+	"cors": {
+		Get:             "GetBucketCors",
+		Put:             "PutBucketCors",
+		Delete:          "DeleteBucketCors",
+		NotFoundCode:    "NoSuchCORSConfiguration",
+		NotFoundMessage: "The CORS configuration does not exist",
+	},
+	"encryption": {
+		Get:             "GetBucketEncryption",
+		Put:             "PutBucketEncryption",
+		Delete:          "DeleteBucketEncryption",
+		NotFoundCode:    "ServerSideEncryptionConfigurationNotFoundError",
+		NotFoundMessage: "The server side encryption configuration was not found",
+	},
+	"inventory": {
+		Get:             "GetBucketInventoryConfiguration",
+		Put:             "PutBucketInventoryConfiguration",
+		Delete:          "DeleteBucketInventoryConfiguration",
+		NotFoundCode:    "NoSuchConfiguration",
+		NotFoundMessage: "The specified configuration does not exist.",
+	},
+	"lifecycle": {
+		Get:             "GetBucketLifecycleConfiguration",
+		Put:             "PutBucketLifecycleConfiguration",
+		Delete:          "DeleteBucketLifecycle",
+		NotFoundCode:    "NoSuchLifecycleConfiguration",
+		NotFoundMessage: "The lifecycle configuration does not exist",
+	},
+	"logging": {
+		Get:     "GetBucketLogging",
+		Put:     "PutBucketLogging",
+		Default: `<BucketLoggingStatus xmlns="http://s3.amazonaws.com/doc/2006-03-01/"/>`,
+	},
+	"metrics": {
+		Get:             "GetBucketMetricsConfiguration",
+		Put:             "PutBucketMetricsConfiguration",
+		Delete:          "DeleteBucketMetricsConfiguration",
+		NotFoundCode:    "NoSuchConfiguration",
+		NotFoundMessage: "The specified configuration does not exist.",
+	},
+	"notification": {
+		Get:     "GetBucketNotificationConfiguration",
+		Put:     "PutBucketNotificationConfiguration",
+		Default: `<NotificationConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"/>`,
+	},
+	"object-lock": {
+		Get:             "GetObjectLockConfiguration",
+		Put:             "PutObjectLockConfiguration",
+		NotFoundCode:    "ObjectLockConfigurationNotFoundError",
+		NotFoundMessage: "Object Lock configuration does not exist for this bucket",
+	},
+	"policy": {
+		Get:             "GetBucketPolicy",
+		Put:             "PutBucketPolicy",
+		Delete:          "DeleteBucketPolicy",
+		NotFoundCode:    "NoSuchBucketPolicy",
+		NotFoundMessage: "The bucket policy does not exist",
+	},
+	"publicAccessBlock": {
+		Get:             "GetPublicAccessBlock",
+		Put:             "PutPublicAccessBlock",
+		Delete:          "DeletePublicAccessBlock",
+		NotFoundCode:    "NoSuchPublicAccessBlockConfiguration",
+		NotFoundMessage: "The public access block configuration was not found",
+	},
+	"replication": {
+		Get:             "GetBucketReplication",
+		Put:             "PutBucketReplication",
+		Delete:          "DeleteBucketReplication",
+		NotFoundCode:    "ReplicationConfigurationNotFoundError",
+		NotFoundMessage: "The replication configuration was not found",
+	},
+	"requestPayment": {
+		Get:     "GetBucketRequestPayment",
+		Put:     "PutBucketRequestPayment",
+		Default: `<RequestPaymentConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><Payer>BucketOwner</Payer></RequestPaymentConfiguration>`,
+	},
+	"tagging": {
+		Get:             "GetBucketTagging",
+		Put:             "PutBucketTagging",
+		Delete:          "DeleteBucketTagging",
+		NotFoundCode:    "NoSuchTagSet",
+		NotFoundMessage: "The TagSet does not exist",
+	},
+	"versioning": {
+		Get:     "GetBucketVersioning",
+		Put:     "PutBucketVersioning",
+		Default: `<VersioningConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"/>`,
+	},
+	"website": {
+		Get:             "GetBucketWebsite",
+		Put:             "PutBucketWebsite",
+		Delete:          "DeleteBucketWebsite",
+		NotFoundCode:    "NoSuchWebsiteConfiguration",
+		NotFoundMessage: "The specified bucket does not have a website configuration",
+	},
+}
+
+// s3ObjectSubresources are the object subresources, by query parameter.
+var s3ObjectSubresources = map[string]s3Subresource{
+	"acl": {
+		Get:     "GetObjectAcl",
+		Put:     "PutObjectAcl",
+		Default: s3DefaultAccessControlPolicy,
+	},
+	"legal-hold": {
+		Get:             "GetObjectLegalHold",
+		Put:             "PutObjectLegalHold",
+		NotFoundCode:    "NoSuchObjectLockConfiguration",
+		NotFoundMessage: "The specified object does not have a ObjectLock configuration",
+	},
+	"retention": {
+		Get:             "GetObjectRetention",
+		Put:             "PutObjectRetention",
+		NotFoundCode:    "NoSuchObjectLockConfiguration",
+		NotFoundMessage: "The specified object does not have a ObjectLock configuration",
+	},
+	"tagging": {
+		Get:     "GetObjectTagging",
+		Put:     "PutObjectTagging",
+		Delete:  "DeleteObjectTagging",
+		Default: `<Tagging xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><TagSet></TagSet></Tagging>`,
+	},
+}
+
+// s3ObjectHeaders are the request headers stored as object metadata, in
+// addition to the x-amz-meta-* user metadata.
+var s3ObjectHeaders = []string{
+	"Cache-Control",
+	"Content-Disposition",
+	"Content-Encoding",
+	"Content-Language",
+	"Content-Type",
+	"Expires",
+	"X-Amz-Object-Lock-Legal-Hold",
+	"X-Amz-Object-Lock-Mode",
+	"X-Amz-Object-Lock-Retain-Until-Date",
+	"X-Amz-Server-Side-Encryption",
+	"X-Amz-Server-Side-Encryption-Aws-Kms-Key-Id",
+	"X-Amz-Storage-Class",
+	"X-Amz-Website-Redirect-Location",
+}
+
+// s3CopyObjectHeaders are the request headers applied to copied objects
+// whose metadata is copied from the source object.
+var s3CopyObjectHeaders = []string{
+	"X-Amz-Server-Side-Encryption",
+	"X-Amz-Server-Side-Encryption-Aws-Kms-Key-Id",
+	"X-Amz-Storage-Class",
+}
+
+// s3Operation returns the operation of a path style S3 request.
+func (s *Server) s3Operation(r *request) (string, func(w http.ResponseWriter, r *request)) {
+	bucket, key := s3BucketAndKey(r)
+	query := r.URL.Query()
+
+	if bucket == "" {
+		if r.Method == http.MethodGet {
+			return "ListBuckets", s.s3ListBuckets
+		}
+		return "Unknown", nil
+	}
+
+	if key != "" {
+		if _, ok := query["uploads"]; ok && r.Method == http.MethodPost {
+			return "CreateMultipartUpload", s.s3CreateMultipartUpload
+		}
+		if _, ok := query["uploadId"]; ok {
+			switch r.Method {
+			case http.MethodDelete:
+				return "AbortMultipartUpload", s.s3AbortMultipartUpload
+			case http.MethodPost:
+				return "CompleteMultipartUpload", s.s3CompleteMultipartUpload
+			case http.MethodPut:
+				if r.Header.Get("X-Amz-Copy-Source") != "" {
+					return "UploadPartCopy", s.s3UploadPartCopy
+				}
+				return "UploadPart", s.s3UploadPart
+			}
+			return "Unknown", nil
+		}
+
+		for name, subresource := range s3ObjectSubresources {
+			if _, ok := query[name]; ok {
+				return s.s3SubresourceOperation(r, name, subresource, false)
+			}
+		}
+
+		switch r.Method {
+		case http.MethodDelete:
+			return "DeleteObject", s.s3DeleteObject
+		case http.MethodGet:
+			return "GetObject", s.s3GetObject
+		case http.MethodHead:
+			return "HeadObject", s.s3GetObject
+		case http.MethodPut:
+			if r.Header.Get("X-Amz-Copy-Source") != "" {
+				return "CopyObject", s.s3CopyObject
+			}
+			return "PutObject", s.s3PutObject
+		}
+
+		return "Unknown", nil
+	}
+
+	if _, ok := query["location"]; ok && r.Method == http.MethodGet {
+		return "GetBucketLocation", s.s3GetBucketLocation
+	}
+
+	for name, subresource := range s3BucketSubresources {
+		if _, ok := query[name]; ok {
+			return s.s3SubresourceOperation(r, name, subresource, true)
+		}
+	}
+
+	switch r.Method {
+	case http.MethodDelete:
+		return "DeleteBucket", s.s3DeleteBucket
+	case http.MethodGet:
+		if _, ok := query["versions"]; ok {
+			return "ListObjectVersions", s.s3ListObjectVersions
+		}
+		if query.Get("list-type") == "2" {
+			return "ListObjectsV2", s.s3ListObjects
+		}
+		return "ListObjects", s.s3ListObjects
+	case http.MethodHead:
+		return "HeadBucket", s.s3HeadBucket
+	case http.MethodPost:
+		if _, ok := query["delete"]; ok {
+			return "DeleteObjects", s.s3DeleteObjects
+		}
+	case http.MethodPut:
+		return "CreateBucket", s.s3CreateBucket
+	}
+
+	return "Unknown", nil
+}
+
+// s3BucketAndKey returns the bucket name and object key of a path style
+// request.
+func s3BucketAndKey(r *request) (string, string) {
+	parts := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 2)
+
+	if len(parts) == 1 {
+		return parts[0], ""
+	}
+
+	return parts[0], parts[1]
+}
+
+func writeS3Error(w http.ResponseWriter, statusCode int, code, message string) {
+	type s3Error struct {
+		XMLName   xml.Name `xml:"Error"`
+		Code      string
+		Message   string
+		RequestID string `xml:"RequestId"`
+	}
+
+	b, _ := xml.Marshal(s3Error{
+		Code:      code,
+		Message:   message,
+		RequestID: requestID,
+	})
+
+	w.Header().Set("X-Amz-Request-Id", requestID)
+	writeResponse(w, Response{
+		StatusCode:  statusCode,
+		ContentType: "application/xml",
+		Body:        string(b),
+	})
+}
+
+func writeS3Result(w http.ResponseWriter, name string, result interface{}) {
+	w.Header().Set("X-Amz-Request-Id", requestID)
+	writeElement(w, http.StatusOK, name, result)
+}
+
+// writeS3Empty writes a response without body, e.g. for HEAD requests.
+func writeS3Empty(w http.ResponseWriter, statusCode int) {
+	w.Header().Set("X-Amz-Request-Id", requestID)
+	w.WriteHeader(statusCode)
+}
+
+func s3ETag(body []byte) string {
+	sum := md5.Sum(body)
+	return `"` + hex.EncodeToString(sum[:]) + `"`
+}
+
+// s3BucketParam returns the bucket of the request, writing the error
+// response if it does not exist.
+func (s *Server) s3BucketParam(w http.ResponseWriter, r *request) (*s3Bucket, bool) {
+	name, _ := s3BucketAndKey(r)
+
+	bucket, ok := s.s3.buckets[name]
+	if !ok {
+		if r.Method == http.MethodHead {
+			writeS3Empty(w, http.StatusNotFound)
+		} else {
+			writeS3Error(w, http.StatusNotFound, "NoSuchBucket", "The specified bucket does not exist")
+		}
+	}
+
+	return bucket, ok
+}
+
+// s3ObjectParam returns the bucket and object of the request, writing the
+// error response if either does not exist.
+func (s *Server) s3ObjectParam(w http.ResponseWriter, r *request) (*s3Bucket, *s3Object, bool) {
+	bucket, ok := s.s3BucketParam(w, r)
+	if !ok {
+		return nil, nil, false
+	}
+
+	_, key := s3BucketAndKey(r)
+
+	object, ok := bucket.objects[key]
+	if !ok {
+		if r.Method == http.MethodHead {
+			writeS3Empty(w, http.StatusNotFound)
+		} else {
+			writeS3Error(w, http.StatusNotFound, "NoSuchKey", "The specified key does not exist.")
+		}
+	}
+
+	return bucket, object, ok
+}
+
+//
+// Buckets
+//
+
+func (s *Server) s3ListBuckets(w http.ResponseWriter, r *request) {
+	type bucket struct {
+		Name         string
+		CreationDate string
+	}
+
+	var buckets []bucket
+	for name := range s.s3.buckets {
+		buckets = append(buckets, bucket{Name: name, CreationDate: s3LastModified})
+	}
+
+	sort.Slice(buckets, func(i, j int) bool { return buckets[i].Name < buckets[j].Name })
+
+	writeS3Result(w, "ListAllMyBucketsResult", struct {
+		Owner   s3Owner
+		Buckets []bucket `xml:"Buckets>Bucket"`
+	}{s3Owner{ID: s3CanonicalUserID, DisplayName: "fakeaws"}, buckets})
+}
+
+type s3Owner struct {
+	ID          string
+	DisplayName string
+}
+
+func (s *Server) s3CreateBucket(w http.ResponseWriter, r *request) {
+	name, _ := s3BucketAndKey(r)
+
+	if _, ok := s.s3.buckets[name]; ok {
+		writeS3Error(w, http.StatusConflict, "BucketAlreadyOwnedByYou", "Your previous request to create the named bucket succeeded and you already own it.")
+		return
+	}
+
+	var configuration struct {
+		LocationConstraint string
+	}
+	if len(r.Body) > 0 {
+		if err := xml.Unmarshal(r.Body, &configuration); err != nil {
+			writeS3Error(w, http.StatusBadRequest, "MalformedXML", "The XML you provided was not well-formed or did not validate against our published schema")
+			return
+		}
+	}
+
+	bucket := &s3Bucket{
+		name:         name,
+		objects:      make(map[string]*s3Object),
+		region:       configuration.LocationConstraint,
+		subresources: make(map[string][]byte),
+	}
+
+	if r.Header.Get("X-Amz-Bucket-Object-Lock-Enabled") == "true" {
+		bucket.subresources["object-lock"] = []byte(`<ObjectLockConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><ObjectLockEnabled>Enabled</ObjectLockEnabled></ObjectLockConfiguration>`)
+		bucket.subresources["versioning"] = []byte(`<VersioningConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><Status>Enabled</Status></VersioningConfiguration>`)
+	}
+
+	s.s3.buckets[name] = bucket
+
+	w.Header().Set("Location", "/"+name)
+	writeS3Empty(w, http.StatusOK)
+}
+
+func (s *Server) s3HeadBucket(w http.ResponseWriter, r *request) {
+	if _, ok := s.s3BucketParam(w, r); !ok {
+		return
+	}
+
+	writeS3Empty(w, http.StatusOK)
+}
+
+func (s *Server) s3DeleteBucket(w http.ResponseWriter, r *request) {
+	bucket, ok := s.s3BucketParam(w, r)
+	if !ok {
+		return
+	}
+
+	if len(bucket.objects) > 0 {
+		writeS3Error(w, http.StatusConflict, "BucketNotEmpty", "The bucket you tried to delete is not empty")
+		return
+	}
+
+	delete(s.s3.buckets, bucket.name)
+
+	writeS3Empty(w, http.StatusNoContent)
+}
+
+func (s *Server) s3GetBucketLocation(w http.ResponseWriter, r *request) {
+	bucket, ok := s.s3BucketParam(w, r)
+	if !ok {
+		return
+	}
+
+	writeS3Result(w, "LocationConstraint", bucket.region)
+}
+
+// s3SubresourceOperation returns the operation on a bucket or object
+// subresource document, named after its query parameter.
+func (s *Server) s3SubresourceOperation(r *request, name string, subresource s3Subresource, isBucket bool) (string, func(w http.ResponseWriter, r *request)) {
+	var operation string
+
+	switch r.Method {
+	case http.MethodGet:
+		operation = subresource.Get
+	case http.MethodPut:
+		operation = subresource.Put
+	case http.MethodDelete:
+		operation = subresource.Delete
+	}
+
+	if operation == "" {
+		return "Unknown", nil
+	}
+
+	return operation, func(w http.ResponseWriter, r *request) {
+		var documents map[string][]byte
+		var object *s3Object
+
+		if isBucket {
+			bucket, ok := s.s3BucketParam(w, r)
+			if !ok {
+				return
+			}
+			documents = bucket.subresources
+		} else {
+			var ok bool
+			_, object, ok = s.s3ObjectParam(w, r)
+			if !ok {
+				return
+			}
+			documents = object.subresources
+		}
+
+		// Configurations with an identifier, e.g. analytics, are stored
+		// per identifier
+		if id := r.URL.Query().Get("id"); id != "" {
+			name += "/" + id
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			document, ok := documents[name]
+
+			if !ok && subresource.NotFoundCode != "" {
+				writeS3Error(w, http.StatusNotFound, subresource.NotFoundCode, subresource.NotFoundMessage)
+				return
+			}
+
+			if !ok {
+				document = []byte(subresource.Default)
+			}
+
+			w.Header().Set("X-Amz-Request-Id", requestID)
+			writeResponse(w, Response{
+				ContentType: "application/xml",
+				Body:        string(document),
+			})
+		case http.MethodPut:
+			// Canned ACLs are set with a header instead of a document
+			if len(r.Body) > 0 {
+				documents[name] = r.Body
+			}
+
+			if object != nil {
+				s3SetObjectLockHeaders(object, name, r.Body)
+			}
+
+			writeS3Empty(w, http.StatusOK)
+		case http.MethodDelete:
+			delete(documents, name)
+
+			writeS3Empty(w, http.StatusNoContent)
+		}
+	}
+}
+
+//
+// Objects
+//
+
+type s3ListEntry struct {
+	Key          string
+	VersionID    string `xml:"VersionId,omitempty"`
+	IsLatest     *bool  `xml:",omitempty"`
+	LastModified string
+	ETag         string
+	Size         int
+	StorageClass string
+	Owner        *s3Owner `xml:",omitempty"`
+}
+
+type s3CommonPrefix struct {
+	Prefix string
+}
+
+// s3SortedKeys returns the keys of the bucket objects in lexicographical
+// order.
+func s3SortedKeys(bucket *s3Bucket) []string {
+	var keys []string
+	for key := range bucket.objects {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+func s3StorageClass(object *s3Object) string {
+	if storageClass := object.headers.Get("X-Amz-Storage-Class"); storageClass != "" {
+		return storageClass
+	}
+
+	return "STANDARD"
+}
+
+func (s *Server) s3ListObjects(w http.ResponseWriter, r *request) {
+	bucket, ok := s.s3BucketParam(w, r)
+	if !ok {
+		return
+	}
+
+	query := r.URL.Query()
+	v2 := query.Get("list-type") == "2"
+	prefix := query.Get("prefix")
+	delimiter := query.Get("delimiter")
+
+	maxKeys := 1000
+	if v := query.Get("max-keys"); v != "" {
+		maxKeys, _ = strconv.Atoi(v)
+	}
+
+	// Keys after the marker, exclusive, are listed. The continuation token
+	// of the fake server is simply the last key of the previous page.
+	marker := query.Get("marker")
+	if v2 {
+		marker = query.Get("start-after")
+		if token := query.Get("continuation-token"); token != "" {
+			marker = token
+		}
+	}
+
+	var contents []s3ListEntry
+	var commonPrefixes []s3CommonPrefix
+	var lastKey string
+	truncated := false
+
+	for _, key := range s3SortedKeys(bucket) {
+		if !strings.HasPrefix(key, prefix) || key <= marker {
+			continue
+		}
+
+		var commonPrefix string
+		if delimiter != "" {
+			if i := strings.Index(key[len(prefix):], delimiter); i >= 0 {
+				commonPrefix = key[:len(prefix)+i+len(delimiter)]
+			}
+		}
+
+		if commonPrefix != "" && len(commonPrefixes) > 0 && commonPrefixes[len(commonPrefixes)-1].Prefix == commonPrefix {
+			continue
+		}
+
+		if len(contents)+len(commonPrefixes) >= maxKeys {
+			truncated = true
+			break
+		}
+
+		lastKey = key
+
+		if commonPrefix != "" {
+			commonPrefixes = append(commonPrefixes, s3CommonPrefix{Prefix: commonPrefix})
+			lastKey = commonPrefix + "\xff"
+			continue
+		}
+
+		object := bucket.objects[key]
+		entry := s3ListEntry{
+			Key:          key,
+			LastModified: s3LastModified,
+			ETag:         object.etag,
+			Size:         len(object.body),
+			StorageClass: s3StorageClass(object),
+		}
+		if !v2 || query.Get("fetch-owner") == "true" {
+			entry.Owner = &s3Owner{ID: s3CanonicalUserID, DisplayName: "fakeaws"}
+		}
+
+		contents = append(contents, entry)
+	}
+
+	type listBucketResult struct {
+		Name                  string
+		Prefix                string
+		Delimiter             string `xml:",omitempty"`
+		Marker                string `xml:",omitempty"`
+		NextMarker            string `xml:",omitempty"`
+		StartAfter            string `xml:",omitempty"`
+		ContinuationToken     string `xml:",omitempty"`
+		NextContinuationToken string `xml:",omitempty"`
+		KeyCount              *int   `xml:",omitempty"`
+		MaxKeys               int
+		IsTruncated           bool
+		Contents              []s3ListEntry
+		CommonPrefixes        []s3CommonPrefix
+	}
+
+	result := listBucketResult{
+		Name:           bucket.name,
+		Prefix:         prefix,
+		Delimiter:      delimiter,
+		MaxKeys:        maxKeys,
+		IsTruncated:    truncated,
+		Contents:       contents,
+		CommonPrefixes: commonPrefixes,
+	}
+
+	if v2 {
+		keyCount := len(contents) + len(commonPrefixes)
+		result.KeyCount = &keyCount
+		result.StartAfter = query.Get("start-after")
+		result.ContinuationToken = query.Get("continuation-token")
+		if truncated {
+			result.NextContinuationToken = lastKey
+		}
+	} else {
+		result.Marker = marker
+		if truncated && delimiter != "" {
+			result.NextMarker = lastKey
+		}
+	}
+
+	writeS3Result(w, "ListBucketResult", result)
+}
+
+// s3ListObjectVersions lists the objects, each as its only "null" version,
+// as versioning is not supported by the fake server.
+func (s *Server) s3ListObjectVersions(w http.ResponseWriter, r *request) {
+	bucket, ok := s.s3BucketParam(w, r)
+	if !ok {
+		return
+	}
+
+	prefix := r.URL.Query().Get("prefix")
+	isLatest := true
+
+	var versions []s3ListEntry
+	for _, key := range s3SortedKeys(bucket) {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+
+		object := bucket.objects[key]
+		versions = append(versions, s3ListEntry{
+			Key:          key,
+			VersionID:    "null",
+			IsLatest:     &isLatest,
+			LastModified: s3LastModified,
+			ETag:         object.etag,
+			Size:         len(object.body),
+			StorageClass: s3StorageClass(object),
+			Owner:        &s3Owner{ID: s3CanonicalUserID, DisplayName: "fakeaws"},
+		})
+	}
+
+	writeS3Result(w, "ListVersionsResult", struct {
+		Name        string
+		Prefix      string
+		MaxKeys     int
+		IsTruncated bool
+		Versions    []s3ListEntry `xml:"Version"`
+	}{bucket.name, prefix, 1000, false, versions})
+}
+
+// s3SetObjectLockHeaders updates the object lock headers of an object from
+// its retention or legal hold document, as returned by HeadObject.
+func s3SetObjectLockHeaders(object *s3Object, name string, document []byte) {
+	set := func(k, v string) {
+		if v == "" {
+			object.headers.Del(k)
+		} else {
+			object.headers.Set(k, v)
+		}
+	}
+
+	switch name {
+	case "legal-hold":
+		var legalHold struct {
+			Status string
+		}
+		if err := xml.Unmarshal(document, &legalHold); err == nil {
+			set("X-Amz-Object-Lock-Legal-Hold", legalHold.Status)
+		}
+	case "retention":
+		var retention struct {
+			Mode            string
+			RetainUntilDate string
+		}
+		if err := xml.Unmarshal(document, &retention); err == nil {
+			set("X-Amz-Object-Lock-Mode", retention.Mode)
+			set("X-Amz-Object-Lock-Retain-Until-Date", retention.RetainUntilDate)
+		}
+	}
+}
+
+// s3PutObjectHeaders returns the object metadata of the request headers.
+func s3PutObjectHeaders(r *request) http.Header {
+	headers := http.Header{}
+
+	for k, v := range r.Header {
+		if strings.HasPrefix(strings.ToLower(k), "x-amz-meta-") {
+			headers[k] = v
+		}
+	}
+
+	for _, k := range s3ObjectHeaders {
+		if v := r.Header.Get(k); v != "" {
+			headers.Set(k, v)
+		}
+	}
+
+	return headers
+}
+
+// s3TaggingDocument returns the tagging document of the x-amz-tagging header.
+func s3TaggingDocument(header string) ([]byte, error) {
+	type tag struct {
+		Key   string
+		Value string
+	}
+
+	type tagging struct {
+		XMLName xml.Name `xml:"Tagging"`
+		TagSet  []tag    `xml:"TagSet>Tag"`
+	}
+
+	values, err := url.ParseQuery(header)
+	if err != nil {
+		return nil, err
+	}
+
+	var document tagging
+	for k := range values {
+		document.TagSet = append(document.TagSet, tag{Key: k, Value: values.Get(k)})
+	}
+
+	sort.Slice(document.TagSet, func(i, j int) bool { return document.TagSet[i].Key < document.TagSet[j].Key })
+
+	return xml.Marshal(document)
+}
+
+func (s *Server) s3PutObject(w http.ResponseWriter, r *request) {
+	bucket, ok := s.s3BucketParam(w, r)
+	if !ok {
+		return
+	}
+
+	_, key := s3BucketAndKey(r)
+
+	object := &s3Object{
+		body:         r.Body,
+		etag:         s3ETag(r.Body),
+		headers:      s3PutObjectHeaders(r),
+		subresources: make(map[string][]byte),
+	}
+
+	if v := r.Header.Get("X-Amz-Tagging"); v != "" {
+		document, err := s3TaggingDocument(v)
+		if err != nil {
+			writeS3Error(w, http.StatusBadRequest, "InvalidArgument", "The header 'x-amz-tagging' shall be encoded as UTF-8 then URLEncoded URL query parameters without tag name duplicates.")
+			return
+		}
+		object.subresources["tagging"] = document
+	}
+
+	bucket.objects[key] = object
+
+	w.Header().Set("ETag", object.etag)
+	writeS3Empty(w, http.StatusOK)
+}
+
+func (s *Server) s3CopyObject(w http.ResponseWriter, r *request) {
+	bucket, ok := s.s3BucketParam(w, r)
+	if !ok {
+		return
+	}
+
+	_, key := s3BucketAndKey(r)
+
+	source, ok := s.s3CopySourceParam(w, r)
+	if !ok {
+		return
+	}
+
+	object := &s3Object{
+		body:         source.body,
+		etag:         source.etag,
+		headers:      source.headers,
+		subresources: make(map[string][]byte),
+	}
+
+	if r.Header.Get("X-Amz-Metadata-Directive") == "REPLACE" {
+		object.headers = s3PutObjectHeaders(r)
+	} else {
+		object.headers = http.Header{}
+		for k, v := range source.headers {
+			object.headers[k] = v
+		}
+		for _, k := range s3CopyObjectHeaders {
+			if v := r.Header.Get(k); v != "" {
+				object.headers.Set(k, v)
+			}
+		}
+	}
+
+	if r.Header.Get("X-Amz-Tagging-Directive") == "REPLACE" {
+		if v := r.Header.Get("X-Amz-Tagging"); v != "" {
+			document, err := s3TaggingDocument(v)
+			if err != nil {
+				writeS3Error(w, http.StatusBadRequest, "InvalidArgument", "The header 'x-amz-tagging' shall be encoded as UTF-8 then URLEncoded URL query parameters without tag name duplicates.")
+				return
+			}
+			object.subresources["tagging"] = document
+		}
+	} else if document, ok := source.subresources["tagging"]; ok {
+		object.subresources["tagging"] = document
+	}
+
+	bucket.objects[key] = object
+
+	writeS3Result(w, "CopyObjectResult", struct {
+		LastModified string
+		ETag         string
+	}{s3LastModified, object.etag})
+}
+
+// s3CopySourceParam returns the object of the x-amz-copy-source header.
+func (s *Server) s3CopySourceParam(w http.ResponseWriter, r *request) (*s3Object, bool) {
+	copySource, err := url.PathUnescape(r.Header.Get("X-Amz-Copy-Source"))
+	if err != nil {
+		writeS3Error(w, http.StatusBadRequest, "InvalidArgument", "Copy Source must mention the source bucket and key: sourcebucket/sourcekey")
+		return nil, false
+	}
+	if i := strings.Index(copySource, "?"); i >= 0 {
+		copySource = copySource[:i]
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(copySource, "/"), "/", 2)
+	if len(parts) != 2 {
+		writeS3Error(w, http.StatusBadRequest, "InvalidArgument", "Copy Source must mention the source bucket and key: sourcebucket/sourcekey")
+		return nil, false
+	}
+
+	sourceBucket, ok := s.s3.buckets[parts[0]]
+	if !ok {
+		writeS3Error(w, http.StatusNotFound, "NoSuchBucket", "The specified bucket does not exist")
+		return nil, false
+	}
+
+	source, ok := sourceBucket.objects[parts[1]]
+	if !ok {
+		writeS3Error(w, http.StatusNotFound, "NoSuchKey", "The specified key does not exist.")
+		return nil, false
+	}
+
+	return source, true
+}
+
+func (s *Server) s3GetObject(w http.ResponseWriter, r *request) {
+	_, object, ok := s.s3ObjectParam(w, r)
+	if !ok {
+		return
+	}
+
+	for k, v := range object.headers {
+		w.Header()[k] = v
+	}
+
+	if w.Header().Get("Content-Type") == "" {
+		w.Header().Set("Content-Type", "binary/octet-stream")
+	}
+
+	w.Header().Set("Content-Length", strconv.Itoa(len(object.body)))
+	w.Header().Set("ETag", object.etag)
+	w.Header().Set("Last-Modified", s3LastModifiedHeader)
+	w.Header().Set("X-Amz-Request-Id", requestID)
+
+	w.WriteHeader(http.StatusOK)
+
+	if r.Method == http.MethodGet {
+		w.Write(object.body)
+	}
+}
+
+func (s *Server) s3DeleteObject(w http.ResponseWriter, r *request) {
+	bucket, ok := s.s3BucketParam(w, r)
+	if !ok {
+		return
+	}
+
+	_, key := s3BucketAndKey(r)
+
+	if object, ok := bucket.objects[key]; ok && s3ObjectLocked(object, r.Header.Get("X-Amz-Bypass-Governance-Retention") == "true") {
+		writeS3Error(w, http.StatusForbidden, "AccessDenied", "Access Denied")
+		return
+	}
+
+	// Deleting an object which does not exist succeeds
+	delete(bucket.objects, key)
+
+	writeS3Empty(w, http.StatusNoContent)
+}
+
+// s3ObjectLocked returns whether an object is protected from deletion by a
+// legal hold or an unexpired retention period.
+func s3ObjectLocked(object *s3Object, bypassGovernance bool) bool {
+	if object.headers.Get("X-Amz-Object-Lock-Legal-Hold") == "ON" {
+		return true
+	}
+
+	retainUntilDate, err := time.Parse(time.RFC3339, object.headers.Get("X-Amz-Object-Lock-Retain-Until-Date"))
+	if err != nil || retainUntilDate.Before(time.Now()) {
+		return false
+	}
+
+	return !(bypassGovernance && object.headers.Get("X-Amz-Object-Lock-Mode") == "GOVERNANCE")
+}
+
+func (s *Server) s3DeleteObjects(w http.ResponseWriter, r *request) {
+	bucket, ok := s.s3BucketParam(w, r)
+	if !ok {
+		return
+	}
+
+	type object struct {
+		Key       string
+		VersionID string `xml:"VersionId,omitempty"`
+	}
+
+	var input struct {
+		Objects []object `xml:"Object"`
+		Quiet   bool
+	}
+
+	if err := xml.Unmarshal(r.Body, &input); err != nil {
+		writeS3Error(w, http.StatusBadRequest, "MalformedXML", "The XML you provided was not well-formed or did not validate against our published schema")
+		return
+	}
+
+	var deleted []object
+	for _, o := range input.Objects {
+		delete(bucket.objects, o.Key)
+
+		if !input.Quiet {
+			deleted = append(deleted, o)
+		}
+	}
+
+	writeS3Result(w, "DeleteResult", struct {
+		Deleted []object
+	}{deleted})
+}
+
+//
+// Multipart uploads
+//
+
+func (s *Server) s3CreateMultipartUpload(w http.ResponseWriter, r *request) {
+	bucket, ok := s.s3BucketParam(w, r)
+	if !ok {
+		return
+	}
+
+	_, key := s3BucketAndKey(r)
+
+	upload := &s3Upload{
+		bucket:  bucket.name,
+		key:     key,
+		headers: s3PutObjectHeaders(r),
+		parts:   make(map[int][]byte),
+	}
+
+	if v := r.Header.Get("X-Amz-Tagging"); v != "" {
+		document, err := s3TaggingDocument(v)
+		if err != nil {
+			writeS3Error(w, http.StatusBadRequest, "InvalidArgument", "The header 'x-amz-tagging' shall be encoded as UTF-8 then URLEncoded URL query parameters without tag name duplicates.")
+			return
+		}
+		upload.tagging = document
+	}
+
+	uploadID := s.nextID("upload-")
+	s.s3.uploads[uploadID] = upload
+
+	writeS3Result(w, "InitiateMultipartUploadResult", struct {
+		Bucket   string
+		Key      string
+		UploadID string `xml:"UploadId"`
+	}{bucket.name, key, uploadID})
+}
+
+// s3UploadParam returns the multipart upload of the request, writing the
+// error response if it does not exist.
+func (s *Server) s3UploadParam(w http.ResponseWriter, r *request) (string, *s3Upload, bool) {
+	bucketName, key := s3BucketAndKey(r)
+	uploadID := r.URL.Query().Get("uploadId")
+
+	upload, ok := s.s3.uploads[uploadID]
+	if !ok || upload.bucket != bucketName || upload.key != key {
+		writeS3Error(w, http.StatusNotFound, "NoSuchUpload", "The specified upload does not exist. The upload ID may be invalid, or the upload may have been aborted or completed.")
+		return "", nil, false
+	}
+
+	return uploadID, upload, true
+}
+
+func (s *Server) s3UploadPart(w http.ResponseWriter, r *request) {
+	_, upload, ok := s.s3UploadParam(w, r)
+	if !ok {
+		return
+	}
+
+	partNumber, err := strconv.Atoi(r.URL.Query().Get("partNumber"))
+	if err != nil || partNumber < 1 || partNumber > 10000 {
+		writeS3Error(w, http.StatusBadRequest, "InvalidArgument", "Part number must be an integer between 1 and 10000, inclusive")
+		return
+	}
+
+	upload.parts[partNumber] = r.Body
+
+	w.Header().Set("ETag", s3ETag(r.Body))
+	writeS3Empty(w, http.StatusOK)
+}
+
+func (s *Server) s3UploadPartCopy(w http.ResponseWriter, r *request) {
+	_, upload, ok := s.s3UploadParam(w, r)
+	if !ok {
+		return
+	}
+
+	partNumber, err := strconv.Atoi(r.URL.Query().Get("partNumber"))
+	if err != nil || partNumber < 1 || partNumber > 10000 {
+		writeS3Error(w, http.StatusBadRequest, "InvalidArgument", "Part number must be an integer between 1 and 10000, inclusive")
+		return
+	}
+
+	source, ok := s.s3CopySourceParam(w, r)
+	if !ok {
+		return
+	}
+
+	data := source.body
+	if v := r.Header.Get("X-Amz-Copy-Source-Range"); v != "" {
+		var first, last int
+		if _, err := fmt.Sscanf(v, "bytes=%d-%d", &first, &last); err != nil || first > last || last >= len(data) {
+			writeS3Error(w, http.StatusBadRequest, "InvalidArgument", "The x-amz-copy-source-range value must be of the form bytes=first-last where first and last are the zero-based offsets of the first and last bytes to copy")
+			return
+		}
+		data = data[first : last+1]
+	}
+
+	upload.parts[partNumber] = data
+
+	writeS3Result(w, "CopyPartResult", struct {
+		LastModified string
+		ETag         string
+	}{s3LastModified, s3ETag(data)})
+}
+
+func (s *Server) s3CompleteMultipartUpload(w http.ResponseWriter, r *request) {
+	uploadID, upload, ok := s.s3UploadParam(w, r)
+	if !ok {
+		return
+	}
+
+	bucket, ok := s.s3BucketParam(w, r)
+	if !ok {
+		return
+	}
+
+	var document struct {
+		Parts []struct {
+			PartNumber int
+			ETag       string
+		} `xml:"Part"`
+	}
+	if err := xml.Unmarshal(r.Body, &document); err != nil || len(document.Parts) == 0 {
+		writeS3Error(w, http.StatusBadRequest, "MalformedXML", "The XML you provided was not well-formed or did not validate against our published schema")
+		return
+	}
+
+	// The ETag of a multipart object is the MD5 digest of the MD5 digests of
+	// its parts, followed by the number of parts.
+	var body, digests []byte
+	for i, part := range document.Parts {
+		if i > 0 && part.PartNumber <= document.Parts[i-1].PartNumber {
+			writeS3Error(w, http.StatusBadRequest, "InvalidPartOrder", "The list of parts was not in ascending order. Parts must be ordered by part number.")
+			return
+		}
+
+		data, ok := upload.parts[part.PartNumber]
+		if !ok || s3ETag(data) != part.ETag {
+			writeS3Error(w, http.StatusBadRequest, "InvalidPart", "One or more of the specified parts could not be found. The part might not have been uploaded, or the specified entity tag might not have matched the part's entity tag.")
+			return
+		}
+
+		if i < len(document.Parts)-1 && len(data) < s3MinPartSize {
+			writeS3Error(w, http.StatusBadRequest, "EntityTooSmall", "Your proposed upload is smaller than the minimum allowed size")
+			return
+		}
+
+		sum := md5.Sum(data)
+		digests = append(digests, sum[:]...)
+		body = append(body, data...)
+	}
+
+	sum := md5.Sum(digests)
+	object := &s3Object{
+		body:         body,
+		etag:         fmt.Sprintf(`"%s-%d"`, hex.EncodeToString(sum[:]), len(document.Parts)),
+		headers:      upload.headers,
+		subresources: make(map[string][]byte),
+	}
+
+	if upload.tagging != nil {
+		object.subresources["tagging"] = upload.tagging
+	}
+
+	bucket.objects[upload.key] = object
+	delete(s.s3.uploads, uploadID)
+
+	writeS3Result(w, "CompleteMultipartUploadResult", struct {
+		Location string
+		Bucket   string
+		Key      string
+		ETag     string
+	}{fmt.Sprintf("/%s/%s", bucket.name, upload.key), bucket.name, upload.key, object.etag})
+}
+
+func (s *Server) s3AbortMultipartUpload(w http.ResponseWriter, r *request) {
+	uploadID, _, ok := s.s3UploadParam(w, r)
+	if !ok {
+		return
+	}
+
+	delete(s.s3.uploads, uploadID)
+
+	writeS3Empty(w, http.StatusNoContent)
+}
+
+// Uploads returns the number of multipart uploads which were neither
+// completed nor aborted.
+func (s *Server) Uploads() int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return len(s.s3.uploads)
+}
@@ -0,0 +1,285 @@
+// Package fakeaws provides an in-memory stand-in for a subset of the AWS APIs,
+// so that resources can be exercised end to end without network access or
+// an AWS account.
+//
+// The server keeps the state of the EC2 VPCs, subnets and security groups,
+// S3 buckets and objects, IAM roles and policies, SQS queues and SNS topics
+// created through it. All services are served from the same URL, the service
+// of a request is determined from its signature, so the URL can be used as
+// the endpoint of every service client:
+//
+//	server := fakeaws.New()
+//	defer server.Close()
+//
+//	conn := ec2.New(sess.Copy(&aws.Config{Endpoint: aws.String(server.URL)}))
+//
+// Responses can also be scripted per service operation, e.g. to simulate
+// errors or eventual consistency, with the Script method.
+package fakeaws
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+const (
+	// AccountID is the AWS account ID owning all the fake resources.
+	AccountID = "123456789012"
+
+	// DefaultRegion is the region used when it cannot be determined from the
+	// request signature.
+	DefaultRegion = "us-east-1"
+)
+
+// Response is a scripted response of the server.
+type Response struct {
+	StatusCode  int
+	ContentType string
+	Body        string
+}
+
+// Server is a fake AWS API server.
+type Server struct {
+	*httptest.Server
+
+	mutex    sync.Mutex
+	counter  int
+	scripts  map[string][]Response
+	ec2      *ec2State
+	iam      *iamState
+	s3       *s3State
+	sns      *snsState
+	sqs      *sqsState
+	handlers map[string]serviceHandler
+}
+
+// request holds the details of a request to the server, parsed once.
+type request struct {
+	*http.Request
+
+	Body    []byte
+	Params  url.Values
+	Region  string
+	Service string
+}
+
+// serviceHandler handles the requests of one service, it returns the name of
+// the operation along with the function serving it, or nil if the operation
+// is not supported.
+type serviceHandler func(r *request) (string, func(w http.ResponseWriter, r *request))
+
+// New starts and returns a new fake AWS API server. The caller should call
+// Close when finished, to shut it down.
+func New() *Server {
+	s := &Server{
+		scripts: make(map[string][]Response),
+		ec2:     newEc2State(),
+		iam:     newIamState(),
+		s3:      newS3State(),
+		sns:     newSnsState(),
+		sqs:     newSqsState(),
+	}
+
+	s.handlers = map[string]serviceHandler{
+		"ec2": queryHandler(s.ec2Operations()),
+		"iam": queryHandler(s.iamOperations()),
+		"s3":  s.s3Operation,
+		"sns": queryHandler(s.snsOperations()),
+		"sqs": queryHandler(s.sqsOperations()),
+		"sts": queryHandler(s.stsOperations()),
+	}
+
+	s.Server = httptest.NewServer(s)
+
+	return s
+}
+
+// Script queues responses for an operation of a service, e.g. "ec2" and
+// "DescribeVpcs". Scripted responses are returned in order, one per request,
+// before the server falls back to its stateful behaviour.
+func (s *Server) Script(service, operation string, responses ...Response) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	key := service + "/" + operation
+	s.scripts[key] = append(s.scripts[key], responses...)
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, httpRequest *http.Request) {
+	body, err := ioutil.ReadAll(httpRequest.Body)
+	if err != nil {
+		writeQueryError(w, http.StatusInternalServerError, "InternalFailure", fmt.Sprintf("error reading request body: %s", err))
+		return
+	}
+
+	r := &request{
+		Request: httpRequest,
+		Body:    body,
+		Params:  httpRequest.URL.Query(),
+		Region:  DefaultRegion,
+	}
+
+	if m := credentialScopeRegexp.FindStringSubmatch(httpRequest.Header.Get("Authorization")); m != nil {
+		r.Region = m[1]
+		r.Service = m[2]
+	}
+
+	if strings.HasPrefix(httpRequest.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		if params, err := url.ParseQuery(string(body)); err == nil {
+			for k, v := range params {
+				r.Params[k] = v
+			}
+		}
+	}
+
+	handler, ok := s.handlers[r.Service]
+	if !ok {
+		log.Printf("[WARN] Fake AWS: unsupported service %q: %s %s", r.Service, httpRequest.Method, httpRequest.URL)
+		writeQueryError(w, http.StatusBadRequest, "UnsupportedService", fmt.Sprintf("service %q is not supported by the fake AWS server", r.Service))
+		return
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	operation, serve := handler(r)
+
+	log.Printf("[DEBUG] Fake AWS: %s/%s %s %s", r.Service, operation, httpRequest.Method, httpRequest.URL)
+
+	key := r.Service + "/" + operation
+	if responses := s.scripts[key]; len(responses) > 0 {
+		s.scripts[key] = responses[1:]
+		writeResponse(w, responses[0])
+		return
+	}
+
+	if serve == nil {
+		log.Printf("[WARN] Fake AWS: unsupported operation %s/%s", r.Service, operation)
+		message := fmt.Sprintf("operation %s is not supported by the fake AWS server", operation)
+		switch r.Service {
+		case "ec2":
+			writeEc2Error(w, "InvalidAction", message)
+		case "s3":
+			writeS3Error(w, http.StatusNotImplemented, "NotImplemented", message)
+		default:
+			writeQueryError(w, http.StatusBadRequest, "InvalidAction", message)
+		}
+		return
+	}
+
+	serve(w, r)
+}
+
+var credentialScopeRegexp = regexp.MustCompile(`Credential=[^/]+/[0-9]+/([^/]+)/([^/]+)/aws4_request`)
+
+// nextID returns a new identifier with the given prefix, e.g. "vpc-".
+func (s *Server) nextID(prefix string) string {
+	s.counter++
+	return fmt.Sprintf("%s%017x", prefix, s.counter)
+}
+
+// queryHandler returns a handler dispatching on the Action parameter of
+// query protocol requests.
+func queryHandler(operations map[string]func(w http.ResponseWriter, r *request)) serviceHandler {
+	return func(r *request) (string, func(w http.ResponseWriter, r *request)) {
+		action := r.Params.Get("Action")
+		return action, operations[action]
+	}
+}
+
+func writeResponse(w http.ResponseWriter, response Response) {
+	contentType := response.ContentType
+	if contentType == "" {
+		contentType = "text/xml"
+	}
+
+	statusCode := response.StatusCode
+	if statusCode == 0 {
+		statusCode = http.StatusOK
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("X-Amzn-Requestid", requestID)
+	w.WriteHeader(statusCode)
+	fmt.Fprint(w, response.Body)
+}
+
+const requestID = "00000000-0000-0000-0000-000000000000"
+
+// writeElement writes the XML encoding of v as an element with the given name.
+func writeElement(w http.ResponseWriter, statusCode int, name string, v interface{}) {
+	var buf bytes.Buffer
+
+	if err := xml.NewEncoder(&buf).EncodeElement(v, xml.StartElement{Name: xml.Name{Local: name}}); err != nil {
+		writeQueryError(w, http.StatusInternalServerError, "InternalFailure", fmt.Sprintf("error encoding response: %s", err))
+		return
+	}
+
+	writeResponse(w, Response{
+		StatusCode: statusCode,
+		Body:       buf.String(),
+	})
+}
+
+// writeQueryResult writes a query protocol (IAM, SNS, SQS, STS) response
+// with the given result, which may be nil.
+func writeQueryResult(w http.ResponseWriter, r *request, result interface{}) {
+	action := r.Params.Get("Action")
+
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "<%sResponse>", action)
+	if result != nil {
+		if err := xml.NewEncoder(&buf).EncodeElement(result, xml.StartElement{Name: xml.Name{Local: action + "Result"}}); err != nil {
+			writeQueryError(w, http.StatusInternalServerError, "InternalFailure", fmt.Sprintf("error encoding response: %s", err))
+			return
+		}
+	}
+	fmt.Fprintf(&buf, "<ResponseMetadata><RequestId>%s</RequestId></ResponseMetadata></%sResponse>", requestID, action)
+
+	writeResponse(w, Response{Body: buf.String()})
+}
+
+// writeQueryError writes a query protocol error response.
+func writeQueryError(w http.ResponseWriter, statusCode int, code, message string) {
+	type errorDetail struct {
+		Type    string
+		Code    string
+		Message string
+	}
+
+	type errorResponse struct {
+		XMLName   xml.Name `xml:"ErrorResponse"`
+		Error     errorDetail
+		RequestID string `xml:"RequestId"`
+	}
+
+	errorType := "Sender"
+	if statusCode >= 500 {
+		errorType = "Receiver"
+	}
+
+	b, _ := xml.Marshal(errorResponse{
+		Error: errorDetail{
+			Type:    errorType,
+			Code:    code,
+			Message: message,
+		},
+		RequestID: requestID,
+	})
+
+	writeResponse(w, Response{
+		StatusCode: statusCode,
+		Body:       string(b),
+	})
+}
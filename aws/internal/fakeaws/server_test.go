@@ -0,0 +1,505 @@
+package fakeaws
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/url"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+func testSession(t *testing.T, server *Server) *session.Session {
+	sess, err := session.NewSession(&aws.Config{
+		Credentials:      credentials.NewStaticCredentials("fakeaws", "fakeaws", ""),
+		Endpoint:         aws.String(server.URL),
+		MaxRetries:       aws.Int(0),
+		Region:           aws.String("us-west-2"),
+		S3ForcePathStyle: aws.Bool(true),
+	})
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	return sess
+}
+
+func testErrorCode(t *testing.T, err error, expected string) {
+	t.Helper()
+
+	awsErr, ok := err.(awserr.Error)
+	if !ok {
+		t.Fatalf("expected error code %q, received: %v", expected, err)
+	}
+
+	if awsErr.Code() != expected {
+		t.Fatalf("expected error code %q, received: %s", expected, awsErr)
+	}
+}
+
+func TestServerEc2(t *testing.T) {
+	server := New()
+	defer server.Close()
+
+	conn := ec2.New(testSession(t, server))
+
+	vpcOutput, err := conn.CreateVpc(&ec2.CreateVpcInput{
+		CidrBlock: aws.String("10.1.0.0/16"),
+	})
+	if err != nil {
+		t.Fatalf("error creating VPC: %s", err)
+	}
+
+	vpcID := vpcOutput.Vpc.VpcId
+
+	if _, err := conn.CreateTags(&ec2.CreateTagsInput{
+		Resources: []*string{vpcID},
+		Tags:      []*ec2.Tag{{Key: aws.String("Name"), Value: aws.String("test")}},
+	}); err != nil {
+		t.Fatalf("error tagging VPC: %s", err)
+	}
+
+	vpcsOutput, err := conn.DescribeVpcs(&ec2.DescribeVpcsInput{
+		Filters: []*ec2.Filter{{Name: aws.String("tag:Name"), Values: []*string{aws.String("t*")}}},
+	})
+	if err != nil {
+		t.Fatalf("error describing VPCs: %s", err)
+	}
+
+	if len(vpcsOutput.Vpcs) != 1 || aws.StringValue(vpcsOutput.Vpcs[0].VpcId) != aws.StringValue(vpcID) {
+		t.Fatalf("expected VPC %s, received: %s", aws.StringValue(vpcID), vpcsOutput)
+	}
+
+	if got := aws.StringValue(vpcsOutput.Vpcs[0].CidrBlock); got != "10.1.0.0/16" {
+		t.Fatalf("expected CIDR block 10.1.0.0/16, received: %s", got)
+	}
+
+	defaultOutput, err := conn.DescribeSecurityGroups(&ec2.DescribeSecurityGroupsInput{
+		Filters: []*ec2.Filter{
+			{Name: aws.String("group-name"), Values: []*string{aws.String("default")}},
+			{Name: aws.String("vpc-id"), Values: []*string{vpcID}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("error describing default security group: %s", err)
+	}
+
+	if len(defaultOutput.SecurityGroups) != 1 {
+		t.Fatalf("expected a default security group, received: %s", defaultOutput)
+	}
+
+	sgOutput, err := conn.CreateSecurityGroup(&ec2.CreateSecurityGroupInput{
+		Description: aws.String("test"),
+		GroupName:   aws.String("test"),
+		VpcId:       vpcID,
+	})
+	if err != nil {
+		t.Fatalf("error creating security group: %s", err)
+	}
+
+	if _, err := conn.AuthorizeSecurityGroupIngress(&ec2.AuthorizeSecurityGroupIngressInput{
+		GroupId: sgOutput.GroupId,
+		IpPermissions: []*ec2.IpPermission{{
+			FromPort:   aws.Int64(80),
+			IpProtocol: aws.String("6"),
+			IpRanges: []*ec2.IpRange{
+				{CidrIp: aws.String("10.0.0.0/8")},
+				{CidrIp: aws.String("192.168.0.0/16"), Description: aws.String("home")},
+			},
+			ToPort: aws.Int64(80),
+		}},
+	}); err != nil {
+		t.Fatalf("error authorizing ingress: %s", err)
+	}
+
+	if _, err := conn.RevokeSecurityGroupIngress(&ec2.RevokeSecurityGroupIngressInput{
+		GroupId: sgOutput.GroupId,
+		IpPermissions: []*ec2.IpPermission{{
+			FromPort:   aws.Int64(80),
+			IpProtocol: aws.String("tcp"),
+			IpRanges:   []*ec2.IpRange{{CidrIp: aws.String("10.0.0.0/8")}},
+			ToPort:     aws.Int64(80),
+		}},
+	}); err != nil {
+		t.Fatalf("error revoking ingress: %s", err)
+	}
+
+	_, err = conn.RevokeSecurityGroupIngress(&ec2.RevokeSecurityGroupIngressInput{
+		GroupId: sgOutput.GroupId,
+		IpPermissions: []*ec2.IpPermission{{
+			FromPort:   aws.Int64(80),
+			IpProtocol: aws.String("tcp"),
+			IpRanges:   []*ec2.IpRange{{CidrIp: aws.String("10.0.0.0/8")}},
+			ToPort:     aws.Int64(80),
+		}},
+	})
+	testErrorCode(t, err, "InvalidPermission.NotFound")
+
+	sgsOutput, err := conn.DescribeSecurityGroups(&ec2.DescribeSecurityGroupsInput{
+		GroupIds: []*string{sgOutput.GroupId},
+	})
+	if err != nil {
+		t.Fatalf("error describing security group: %s", err)
+	}
+
+	sg := sgsOutput.SecurityGroups[0]
+
+	if len(sg.IpPermissions) != 1 || len(sg.IpPermissions[0].IpRanges) != 1 {
+		t.Fatalf("expected one ingress rule, received: %s", sg)
+	}
+
+	if got := aws.StringValue(sg.IpPermissions[0].IpProtocol); got != "tcp" {
+		t.Fatalf("expected ingress protocol tcp, received: %s", got)
+	}
+
+	if got := aws.StringValue(sg.IpPermissions[0].IpRanges[0].Description); got != "home" {
+		t.Fatalf("expected ingress description home, received: %s", got)
+	}
+
+	if len(sg.IpPermissionsEgress) != 1 || sg.IpPermissionsEgress[0].FromPort != nil {
+		t.Fatalf("expected default egress rule without ports, received: %s", sg)
+	}
+
+	_, err = conn.DeleteVpc(&ec2.DeleteVpcInput{VpcId: vpcID})
+	testErrorCode(t, err, "DependencyViolation")
+
+	if _, err := conn.DeleteSecurityGroup(&ec2.DeleteSecurityGroupInput{GroupId: sgOutput.GroupId}); err != nil {
+		t.Fatalf("error deleting security group: %s", err)
+	}
+
+	if _, err := conn.DeleteVpc(&ec2.DeleteVpcInput{VpcId: vpcID}); err != nil {
+		t.Fatalf("error deleting VPC: %s", err)
+	}
+
+	_, err = conn.DescribeVpcs(&ec2.DescribeVpcsInput{VpcIds: []*string{vpcID}})
+	testErrorCode(t, err, "InvalidVpcID.NotFound")
+
+	_, err = conn.DescribeSecurityGroups(&ec2.DescribeSecurityGroupsInput{
+		GroupIds: []*string{defaultOutput.SecurityGroups[0].GroupId},
+	})
+	testErrorCode(t, err, "InvalidGroup.NotFound")
+}
+
+func TestServerIam(t *testing.T) {
+	server := New()
+	defer server.Close()
+
+	conn := iam.New(testSession(t, server))
+
+	document := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`
+
+	if _, err := conn.CreateRole(&iam.CreateRoleInput{
+		AssumeRolePolicyDocument: aws.String(document),
+		RoleName:                 aws.String("test"),
+		Tags:                     []*iam.Tag{{Key: aws.String("Name"), Value: aws.String("test")}},
+	}); err != nil {
+		t.Fatalf("error creating role: %s", err)
+	}
+
+	roleOutput, err := conn.GetRole(&iam.GetRoleInput{RoleName: aws.String("test")})
+	if err != nil {
+		t.Fatalf("error reading role: %s", err)
+	}
+
+	if got, _ := url.QueryUnescape(aws.StringValue(roleOutput.Role.AssumeRolePolicyDocument)); got != document {
+		t.Fatalf("expected assume role policy %s, received: %s", document, got)
+	}
+
+	if got := aws.StringValue(roleOutput.Role.Arn); got != "arn:aws:iam::123456789012:role/test" {
+		t.Fatalf("unexpected role ARN: %s", got)
+	}
+
+	if len(roleOutput.Role.Tags) != 1 {
+		t.Fatalf("expected one role tag, received: %s", roleOutput.Role)
+	}
+
+	policyOutput, err := conn.CreatePolicy(&iam.CreatePolicyInput{
+		PolicyDocument: aws.String(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"ec2:Describe*","Resource":"*"}]}`),
+		PolicyName:     aws.String("test"),
+	})
+	if err != nil {
+		t.Fatalf("error creating policy: %s", err)
+	}
+
+	if _, err := conn.AttachRolePolicy(&iam.AttachRolePolicyInput{
+		PolicyArn: policyOutput.Policy.Arn,
+		RoleName:  aws.String("test"),
+	}); err != nil {
+		t.Fatalf("error attaching policy: %s", err)
+	}
+
+	_, err = conn.DeleteRole(&iam.DeleteRoleInput{RoleName: aws.String("test")})
+	testErrorCode(t, err, iam.ErrCodeDeleteConflictException)
+
+	_, err = conn.CreatePolicy(&iam.CreatePolicyInput{
+		PolicyDocument: aws.String(`{`),
+		PolicyName:     aws.String("invalid"),
+	})
+	testErrorCode(t, err, iam.ErrCodeMalformedPolicyDocumentException)
+
+	_, err = conn.GetRole(&iam.GetRoleInput{RoleName: aws.String("missing")})
+	testErrorCode(t, err, iam.ErrCodeNoSuchEntityException)
+}
+
+func TestServerS3(t *testing.T) {
+	server := New()
+	defer server.Close()
+
+	conn := s3.New(testSession(t, server))
+
+	if _, err := conn.CreateBucket(&s3.CreateBucketInput{
+		Bucket: aws.String("test"),
+		CreateBucketConfiguration: &s3.CreateBucketConfiguration{
+			LocationConstraint: aws.String("us-west-2"),
+		},
+	}); err != nil {
+		t.Fatalf("error creating bucket: %s", err)
+	}
+
+	locationOutput, err := conn.GetBucketLocation(&s3.GetBucketLocationInput{Bucket: aws.String("test")})
+	if err != nil {
+		t.Fatalf("error reading bucket location: %s", err)
+	}
+
+	if got := aws.StringValue(locationOutput.LocationConstraint); got != "us-west-2" {
+		t.Fatalf("expected location us-west-2, received: %s", got)
+	}
+
+	_, err = conn.GetBucketCors(&s3.GetBucketCorsInput{Bucket: aws.String("test")})
+	testErrorCode(t, err, "NoSuchCORSConfiguration")
+
+	if _, err := conn.PutBucketVersioning(&s3.PutBucketVersioningInput{
+		Bucket:                  aws.String("test"),
+		VersioningConfiguration: &s3.VersioningConfiguration{Status: aws.String(s3.BucketVersioningStatusEnabled)},
+	}); err != nil {
+		t.Fatalf("error enabling versioning: %s", err)
+	}
+
+	versioningOutput, err := conn.GetBucketVersioning(&s3.GetBucketVersioningInput{Bucket: aws.String("test")})
+	if err != nil {
+		t.Fatalf("error reading versioning: %s", err)
+	}
+
+	if got := aws.StringValue(versioningOutput.Status); got != s3.BucketVersioningStatusEnabled {
+		t.Fatalf("expected versioning status Enabled, received: %s", got)
+	}
+
+	for _, key := range []string{"a/1", "a/2", "b"} {
+		if _, err := conn.PutObject(&s3.PutObjectInput{
+			Body:        bytes.NewReader([]byte(key)),
+			Bucket:      aws.String("test"),
+			ContentType: aws.String("text/plain"),
+			Key:         aws.String(key),
+			Metadata:    map[string]*string{"Source": aws.String("test")},
+		}); err != nil {
+			t.Fatalf("error putting object %s: %s", key, err)
+		}
+	}
+
+	objectOutput, err := conn.GetObject(&s3.GetObjectInput{Bucket: aws.String("test"), Key: aws.String("a/1")})
+	if err != nil {
+		t.Fatalf("error getting object: %s", err)
+	}
+
+	body, _ := ioutil.ReadAll(objectOutput.Body)
+	objectOutput.Body.Close()
+
+	if string(body) != "a/1" || aws.StringValue(objectOutput.ContentType) != "text/plain" || aws.StringValue(objectOutput.Metadata["Source"]) != "test" {
+		t.Fatalf("unexpected object %q: %s", body, objectOutput)
+	}
+
+	var keys, prefixes []string
+	if err := conn.ListObjectsV2Pages(&s3.ListObjectsV2Input{
+		Bucket:    aws.String("test"),
+		Delimiter: aws.String("/"),
+		MaxKeys:   aws.Int64(1),
+	}, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, object := range page.Contents {
+			keys = append(keys, aws.StringValue(object.Key))
+		}
+		for _, prefix := range page.CommonPrefixes {
+			prefixes = append(prefixes, aws.StringValue(prefix.Prefix))
+		}
+		return true
+	}); err != nil {
+		t.Fatalf("error listing objects: %s", err)
+	}
+
+	if len(keys) != 1 || keys[0] != "b" || len(prefixes) != 1 || prefixes[0] != "a/" {
+		t.Fatalf("expected key b and prefix a/, received: %v and %v", keys, prefixes)
+	}
+
+	_, err = conn.DeleteBucket(&s3.DeleteBucketInput{Bucket: aws.String("test")})
+	testErrorCode(t, err, "BucketNotEmpty")
+
+	if _, err := conn.DeleteObjects(&s3.DeleteObjectsInput{
+		Bucket: aws.String("test"),
+		Delete: &s3.Delete{Objects: []*s3.ObjectIdentifier{
+			{Key: aws.String("a/1")},
+			{Key: aws.String("a/2")},
+			{Key: aws.String("b")},
+		}},
+	}); err != nil {
+		t.Fatalf("error deleting objects: %s", err)
+	}
+
+	if _, err := conn.DeleteBucket(&s3.DeleteBucketInput{Bucket: aws.String("test")}); err != nil {
+		t.Fatalf("error deleting bucket: %s", err)
+	}
+
+	_, err = conn.HeadBucket(&s3.HeadBucketInput{Bucket: aws.String("test")})
+	testErrorCode(t, err, "NotFound")
+}
+
+func TestServerSns(t *testing.T) {
+	server := New()
+	defer server.Close()
+
+	conn := sns.New(testSession(t, server))
+
+	topicOutput, err := conn.CreateTopic(&sns.CreateTopicInput{Name: aws.String("test")})
+	if err != nil {
+		t.Fatalf("error creating topic: %s", err)
+	}
+
+	if got := aws.StringValue(topicOutput.TopicArn); got != "arn:aws:sns:us-west-2:123456789012:test" {
+		t.Fatalf("unexpected topic ARN: %s", got)
+	}
+
+	if _, err := conn.SetTopicAttributes(&sns.SetTopicAttributesInput{
+		AttributeName:  aws.String("DisplayName"),
+		AttributeValue: aws.String("Test"),
+		TopicArn:       topicOutput.TopicArn,
+	}); err != nil {
+		t.Fatalf("error setting topic attribute: %s", err)
+	}
+
+	attributesOutput, err := conn.GetTopicAttributes(&sns.GetTopicAttributesInput{TopicArn: topicOutput.TopicArn})
+	if err != nil {
+		t.Fatalf("error reading topic attributes: %s", err)
+	}
+
+	if got := aws.StringValue(attributesOutput.Attributes["DisplayName"]); got != "Test" {
+		t.Fatalf("expected display name Test, received: %s", got)
+	}
+
+	if aws.StringValue(attributesOutput.Attributes["Policy"]) == "" {
+		t.Fatal("expected default topic policy")
+	}
+
+	if _, err := conn.DeleteTopic(&sns.DeleteTopicInput{TopicArn: topicOutput.TopicArn}); err != nil {
+		t.Fatalf("error deleting topic: %s", err)
+	}
+
+	_, err = conn.GetTopicAttributes(&sns.GetTopicAttributesInput{TopicArn: topicOutput.TopicArn})
+	testErrorCode(t, err, sns.ErrCodeNotFoundException)
+}
+
+func TestServerSqs(t *testing.T) {
+	server := New()
+	defer server.Close()
+
+	conn := sqs.New(testSession(t, server))
+
+	queueOutput, err := conn.CreateQueue(&sqs.CreateQueueInput{
+		Attributes: map[string]*string{"DelaySeconds": aws.String("90")},
+		QueueName:  aws.String("test"),
+	})
+	if err != nil {
+		t.Fatalf("error creating queue: %s", err)
+	}
+
+	if _, err := conn.TagQueue(&sqs.TagQueueInput{
+		QueueUrl: queueOutput.QueueUrl,
+		Tags:     map[string]*string{"Name": aws.String("test")},
+	}); err != nil {
+		t.Fatalf("error tagging queue: %s", err)
+	}
+
+	attributesOutput, err := conn.GetQueueAttributes(&sqs.GetQueueAttributesInput{
+		AttributeNames: []*string{aws.String("All")},
+		QueueUrl:       queueOutput.QueueUrl,
+	})
+	if err != nil {
+		t.Fatalf("error reading queue attributes: %s", err)
+	}
+
+	if got := aws.StringValue(attributesOutput.Attributes["DelaySeconds"]); got != "90" {
+		t.Fatalf("expected delay seconds 90, received: %s", got)
+	}
+
+	if got := aws.StringValue(attributesOutput.Attributes["QueueArn"]); got != "arn:aws:sqs:us-west-2:123456789012:test" {
+		t.Fatalf("unexpected queue ARN: %s", got)
+	}
+
+	tagsOutput, err := conn.ListQueueTags(&sqs.ListQueueTagsInput{QueueUrl: queueOutput.QueueUrl})
+	if err != nil {
+		t.Fatalf("error listing queue tags: %s", err)
+	}
+
+	if got := aws.StringValue(tagsOutput.Tags["Name"]); got != "test" {
+		t.Fatalf("expected Name tag test, received: %s", got)
+	}
+
+	if _, err := conn.DeleteQueue(&sqs.DeleteQueueInput{QueueUrl: queueOutput.QueueUrl}); err != nil {
+		t.Fatalf("error deleting queue: %s", err)
+	}
+
+	_, err = conn.GetQueueAttributes(&sqs.GetQueueAttributesInput{QueueUrl: queueOutput.QueueUrl})
+	testErrorCode(t, err, sqs.ErrCodeQueueDoesNotExist)
+}
+
+func TestServerSts(t *testing.T) {
+	server := New()
+	defer server.Close()
+
+	conn := sts.New(testSession(t, server))
+
+	output, err := conn.GetCallerIdentity(&sts.GetCallerIdentityInput{})
+	if err != nil {
+		t.Fatalf("error getting caller identity: %s", err)
+	}
+
+	if got := aws.StringValue(output.Account); got != AccountID {
+		t.Fatalf("expected account %s, received: %s", AccountID, got)
+	}
+}
+
+func TestServerScript(t *testing.T) {
+	server := New()
+	defer server.Close()
+
+	conn := ec2.New(testSession(t, server))
+
+	server.Script("ec2", "DescribeVpcs", Response{
+		StatusCode: 503,
+		Body:       `<Response><Errors><Error><Code>RequestLimitExceeded</Code><Message>Request limit exceeded.</Message></Error></Errors></Response>`,
+	})
+
+	_, err := conn.DescribeVpcs(&ec2.DescribeVpcsInput{})
+	testErrorCode(t, err, "RequestLimitExceeded")
+
+	// Once consumed, the stateful behaviour resumes
+	if _, err := conn.DescribeVpcs(&ec2.DescribeVpcsInput{}); err != nil {
+		t.Fatalf("error describing VPCs: %s", err)
+	}
+}
+
+func TestServerUnsupportedOperation(t *testing.T) {
+	server := New()
+	defer server.Close()
+
+	conn := ec2.New(testSession(t, server))
+
+	_, err := conn.DescribeInstances(&ec2.DescribeInstancesInput{})
+	testErrorCode(t, err, "InvalidAction")
+}
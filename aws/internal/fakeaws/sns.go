@@ -0,0 +1,144 @@
+package fakeaws
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+type snsState struct {
+	topics map[string]*snsTopic
+}
+
+func newSnsState() *snsState {
+	return &snsState{
+		topics: make(map[string]*snsTopic),
+	}
+}
+
+type snsTopic struct {
+	arn        string
+	attributes map[string]string
+}
+
+type snsAttribute struct {
+	Key   string `xml:"key"`
+	Value string `xml:"value"`
+}
+
+func (s *Server) snsOperations() map[string]func(w http.ResponseWriter, r *request) {
+	return map[string]func(w http.ResponseWriter, r *request){
+		"CreateTopic":        s.snsCreateTopic,
+		"DeleteTopic":        s.snsDeleteTopic,
+		"GetTopicAttributes": s.snsGetTopicAttributes,
+		"SetTopicAttributes": s.snsSetTopicAttributes,
+	}
+}
+
+// snsTopicParam returns the topic of the TopicArn parameter, writing the
+// error response if it does not exist.
+func (s *Server) snsTopicParam(w http.ResponseWriter, r *request) (*snsTopic, bool) {
+	topic, ok := s.sns.topics[r.Params.Get("TopicArn")]
+	if !ok {
+		writeQueryError(w, http.StatusNotFound, "NotFound", "Topic does not exist")
+	}
+
+	return topic, ok
+}
+
+// snsDefaultTopicPolicy returns the access policy of a new topic.
+func snsDefaultTopicPolicy(topicArn string) string {
+	actions := []string{
+		"SNS:GetTopicAttributes",
+		"SNS:SetTopicAttributes",
+		"SNS:AddPermission",
+		"SNS:RemovePermission",
+		"SNS:DeleteTopic",
+		"SNS:Subscribe",
+		"SNS:ListSubscriptionsByTopic",
+		"SNS:Publish",
+		"SNS:Receive",
+	}
+
+	return fmt.Sprintf(`{"Version":"2008-10-17","Id":"__default_policy_ID","Statement":[{"Sid":"__default_statement_ID","Effect":"Allow","Principal":{"AWS":"*"},"Action":["%s"],"Resource":"%s","Condition":{"StringEquals":{"AWS:SourceOwner":"%s"}}}]}`,
+		strings.Join(actions, `","`), topicArn, AccountID)
+}
+
+func (s *Server) snsCreateTopic(w http.ResponseWriter, r *request) {
+	topicArn := fmt.Sprintf("arn:aws:sns:%s:%s:%s", r.Region, AccountID, r.Params.Get("Name"))
+
+	// Creating a topic is idempotent
+	if _, ok := s.sns.topics[topicArn]; !ok {
+		topic := &snsTopic{
+			arn: topicArn,
+			attributes: map[string]string{
+				"DisplayName":             "",
+				"Owner":                   AccountID,
+				"Policy":                  snsDefaultTopicPolicy(topicArn),
+				"SubscriptionsConfirmed":  "0",
+				"SubscriptionsDeleted":    "0",
+				"SubscriptionsPending":    "0",
+				"TopicArn":                topicArn,
+				"EffectiveDeliveryPolicy": `{"http":{"defaultHealthyRetryPolicy":{"minDelayTarget":20,"maxDelayTarget":20,"numRetries":3,"numMaxDelayRetries":0,"numNoDelayRetries":0,"numMinDelayRetries":0,"backoffFunction":"linear"},"disableSubscriptionOverrides":false}}`,
+			},
+		}
+
+		for k, v := range mapParam(r.Params, "Attributes.entry", "key", "value") {
+			topic.attributes[k] = v
+		}
+
+		s.sns.topics[topicArn] = topic
+	}
+
+	writeQueryResult(w, r, struct {
+		TopicArn string
+	}{topicArn})
+}
+
+func (s *Server) snsGetTopicAttributes(w http.ResponseWriter, r *request) {
+	topic, ok := s.snsTopicParam(w, r)
+	if !ok {
+		return
+	}
+
+	var attributes []snsAttribute
+	for k, v := range topic.attributes {
+		attributes = append(attributes, snsAttribute{Key: k, Value: v})
+	}
+
+	sort.Slice(attributes, func(i, j int) bool { return attributes[i].Key < attributes[j].Key })
+
+	writeQueryResult(w, r, struct {
+		Attributes []snsAttribute `xml:"Attributes>entry"`
+	}{attributes})
+}
+
+func (s *Server) snsSetTopicAttributes(w http.ResponseWriter, r *request) {
+	topic, ok := s.snsTopicParam(w, r)
+	if !ok {
+		return
+	}
+
+	name := r.Params.Get("AttributeName")
+	value := r.Params.Get("AttributeValue")
+
+	// Clearing the policy of a topic restores its default policy
+	switch {
+	case name == "Policy" && value == "":
+		topic.attributes[name] = snsDefaultTopicPolicy(topic.arn)
+	case name == "DeliveryPolicy" && value == "":
+		delete(topic.attributes, name)
+	default:
+		topic.attributes[name] = value
+	}
+
+	writeQueryResult(w, r, nil)
+}
+
+// snsDeleteTopic is idempotent, as the API is.
+func (s *Server) snsDeleteTopic(w http.ResponseWriter, r *request) {
+	delete(s.sns.topics, r.Params.Get("TopicArn"))
+
+	writeQueryResult(w, r, nil)
+}
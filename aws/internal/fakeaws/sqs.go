@@ -0,0 +1,213 @@
+package fakeaws
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+type sqsState struct {
+	queues map[string]*sqsQueue
+}
+
+func newSqsState() *sqsState {
+	return &sqsState{
+		queues: make(map[string]*sqsQueue),
+	}
+}
+
+type sqsQueue struct {
+	attributes map[string]string
+	name       string
+	tags       map[string]string
+}
+
+type sqsAttribute struct {
+	Name  string
+	Value string
+}
+
+type sqsTag struct {
+	Key   string
+	Value string
+}
+
+func (s *Server) sqsOperations() map[string]func(w http.ResponseWriter, r *request) {
+	return map[string]func(w http.ResponseWriter, r *request){
+		"CreateQueue":        s.sqsCreateQueue,
+		"DeleteQueue":        s.sqsDeleteQueue,
+		"GetQueueAttributes": s.sqsGetQueueAttributes,
+		"GetQueueUrl":        s.sqsGetQueueURL,
+		"ListQueueTags":      s.sqsListQueueTags,
+		"SetQueueAttributes": s.sqsSetQueueAttributes,
+		"TagQueue":           s.sqsTagQueue,
+		"UntagQueue":         s.sqsUntagQueue,
+	}
+}
+
+func sqsQueueURL(r *request, name string) string {
+	return fmt.Sprintf("http://%s/%s/%s", r.Host, AccountID, name)
+}
+
+// sqsQueueParam returns the queue of the QueueUrl parameter, writing the
+// error response if it does not exist.
+func (s *Server) sqsQueueParam(w http.ResponseWriter, r *request) (*sqsQueue, bool) {
+	queueURL := r.Params.Get("QueueUrl")
+	name := queueURL[strings.LastIndex(queueURL, "/")+1:]
+
+	queue, ok := s.sqs.queues[name]
+	if !ok {
+		writeQueryError(w, http.StatusBadRequest, "AWS.SimpleQueueService.NonExistentQueue", "The specified queue does not exist for this wsdl version.")
+	}
+
+	return queue, ok
+}
+
+func (s *Server) sqsCreateQueue(w http.ResponseWriter, r *request) {
+	name := r.Params.Get("QueueName")
+	attributes := mapParam(r.Params, "Attribute", "Name", "Value")
+
+	if queue, ok := s.sqs.queues[name]; ok {
+		for k, v := range attributes {
+			if queue.attributes[k] != v {
+				writeQueryError(w, http.StatusBadRequest, "QueueAlreadyExists", fmt.Sprintf("A queue already exists with the same name and a different value for attribute %s", k))
+				return
+			}
+		}
+	} else {
+		queue := &sqsQueue{
+			attributes: map[string]string{
+				"ApproximateNumberOfMessages":           "0",
+				"ApproximateNumberOfMessagesDelayed":    "0",
+				"ApproximateNumberOfMessagesNotVisible": "0",
+				"CreatedTimestamp":                      "1546300800",
+				"DelaySeconds":                          "0",
+				"LastModifiedTimestamp":                 "1546300800",
+				"MaximumMessageSize":                    "262144",
+				"MessageRetentionPeriod":                "345600",
+				"QueueArn":                              fmt.Sprintf("arn:aws:sqs:%s:%s:%s", r.Region, AccountID, name),
+				"ReceiveMessageWaitTimeSeconds":         "0",
+				"VisibilityTimeout":                     "30",
+			},
+			name: name,
+			tags: mapParam(r.Params, "Tag", "Key", "Value"),
+		}
+
+		for k, v := range attributes {
+			queue.attributes[k] = v
+		}
+
+		s.sqs.queues[name] = queue
+	}
+
+	writeQueryResult(w, r, struct {
+		QueueURL string `xml:"QueueUrl"`
+	}{sqsQueueURL(r, name)})
+}
+
+func (s *Server) sqsGetQueueURL(w http.ResponseWriter, r *request) {
+	name := r.Params.Get("QueueName")
+
+	if _, ok := s.sqs.queues[name]; !ok {
+		writeQueryError(w, http.StatusBadRequest, "AWS.SimpleQueueService.NonExistentQueue", "The specified queue does not exist for this wsdl version.")
+		return
+	}
+
+	writeQueryResult(w, r, struct {
+		QueueURL string `xml:"QueueUrl"`
+	}{sqsQueueURL(r, name)})
+}
+
+func (s *Server) sqsGetQueueAttributes(w http.ResponseWriter, r *request) {
+	queue, ok := s.sqsQueueParam(w, r)
+	if !ok {
+		return
+	}
+
+	names := listParam(r.Params, "AttributeName")
+	if contains(names, "All") {
+		names = nil
+	}
+
+	var attributes []sqsAttribute
+	for k, v := range queue.attributes {
+		if contains(names, k) {
+			attributes = append(attributes, sqsAttribute{Name: k, Value: v})
+		}
+	}
+
+	sort.Slice(attributes, func(i, j int) bool { return attributes[i].Name < attributes[j].Name })
+
+	writeQueryResult(w, r, struct {
+		Attributes []sqsAttribute `xml:"Attribute"`
+	}{attributes})
+}
+
+func (s *Server) sqsSetQueueAttributes(w http.ResponseWriter, r *request) {
+	queue, ok := s.sqsQueueParam(w, r)
+	if !ok {
+		return
+	}
+
+	for k, v := range mapParam(r.Params, "Attribute", "Name", "Value") {
+		queue.attributes[k] = v
+	}
+
+	writeQueryResult(w, r, nil)
+}
+
+func (s *Server) sqsListQueueTags(w http.ResponseWriter, r *request) {
+	queue, ok := s.sqsQueueParam(w, r)
+	if !ok {
+		return
+	}
+
+	var tags []sqsTag
+	for k, v := range queue.tags {
+		tags = append(tags, sqsTag{Key: k, Value: v})
+	}
+
+	sort.Slice(tags, func(i, j int) bool { return tags[i].Key < tags[j].Key })
+
+	writeQueryResult(w, r, struct {
+		Tags []sqsTag `xml:"Tag"`
+	}{tags})
+}
+
+func (s *Server) sqsTagQueue(w http.ResponseWriter, r *request) {
+	queue, ok := s.sqsQueueParam(w, r)
+	if !ok {
+		return
+	}
+
+	for k, v := range mapParam(r.Params, "Tag", "Key", "Value") {
+		queue.tags[k] = v
+	}
+
+	writeQueryResult(w, r, nil)
+}
+
+func (s *Server) sqsUntagQueue(w http.ResponseWriter, r *request) {
+	queue, ok := s.sqsQueueParam(w, r)
+	if !ok {
+		return
+	}
+
+	for _, k := range listParam(r.Params, "TagKey") {
+		delete(queue.tags, k)
+	}
+
+	writeQueryResult(w, r, nil)
+}
+
+func (s *Server) sqsDeleteQueue(w http.ResponseWriter, r *request) {
+	queue, ok := s.sqsQueueParam(w, r)
+	if !ok {
+		return
+	}
+
+	delete(s.sqs.queues, queue.name)
+
+	writeQueryResult(w, r, nil)
+}
@@ -0,0 +1,24 @@
+package fakeaws
+
+import (
+	"fmt"
+	"net/http"
+)
+
+func (s *Server) stsOperations() map[string]func(w http.ResponseWriter, r *request) {
+	return map[string]func(w http.ResponseWriter, r *request){
+		"GetCallerIdentity": s.stsGetCallerIdentity,
+	}
+}
+
+func (s *Server) stsGetCallerIdentity(w http.ResponseWriter, r *request) {
+	writeQueryResult(w, r, struct {
+		Account string
+		Arn     string
+		UserID  string `xml:"UserId"`
+	}{
+		Account: AccountID,
+		Arn:     fmt.Sprintf("arn:aws:iam::%s:user/fakeaws", AccountID),
+		UserID:  "AIDAFAKEAWS",
+	})
+}
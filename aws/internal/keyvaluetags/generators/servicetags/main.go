@@ -0,0 +1,270 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"bytes"
+	"go/format"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+const (
+	filename     = `service_tags_gen.go`
+	testFilename = `service_tags_gen_test.go`
+)
+
+// sliceServiceTag describes an AWS Go SDK service tag type defined as a
+// slice of structs with a key and a value field.
+type sliceServiceTag struct {
+	TagType    string
+	KeyField   string
+	ValueField string
+}
+
+var sliceServiceTags = map[string]sliceServiceTag{
+	"acm":                      {TagType: "Tag", KeyField: "Key", ValueField: "Value"},
+	"acmpca":                   {TagType: "Tag", KeyField: "Key", ValueField: "Value"},
+	"cloudfront":               {TagType: "Tag", KeyField: "Key", ValueField: "Value"},
+	"cloudtrail":               {TagType: "Tag", KeyField: "Key", ValueField: "Value"},
+	"codebuild":                {TagType: "Tag", KeyField: "Key", ValueField: "Value"},
+	"databasemigrationservice": {TagType: "Tag", KeyField: "Key", ValueField: "Value"},
+	"datasync":                 {TagType: "TagListEntry", KeyField: "Key", ValueField: "Value"},
+	"dax":                      {TagType: "Tag", KeyField: "Key", ValueField: "Value"},
+	"directconnect":            {TagType: "Tag", KeyField: "Key", ValueField: "Value"},
+	"directoryservice":         {TagType: "Tag", KeyField: "Key", ValueField: "Value"},
+	"docdb":                    {TagType: "Tag", KeyField: "Key", ValueField: "Value"},
+	"dynamodb":                 {TagType: "Tag", KeyField: "Key", ValueField: "Value"},
+	"ec2":                      {TagType: "Tag", KeyField: "Key", ValueField: "Value"},
+	"ecr":                      {TagType: "Tag", KeyField: "Key", ValueField: "Value"},
+	"ecs":                      {TagType: "Tag", KeyField: "Key", ValueField: "Value"},
+	"efs":                      {TagType: "Tag", KeyField: "Key", ValueField: "Value"},
+	"elasticache":              {TagType: "Tag", KeyField: "Key", ValueField: "Value"},
+	"elasticbeanstalk":         {TagType: "Tag", KeyField: "Key", ValueField: "Value"},
+	"elasticsearchservice":     {TagType: "Tag", KeyField: "Key", ValueField: "Value"},
+	"elb":                      {TagType: "Tag", KeyField: "Key", ValueField: "Value"},
+	"elbv2":                    {TagType: "Tag", KeyField: "Key", ValueField: "Value"},
+	"firehose":                 {TagType: "Tag", KeyField: "Key", ValueField: "Value"},
+	"fsx":                      {TagType: "Tag", KeyField: "Key", ValueField: "Value"},
+	"iam":                      {TagType: "Tag", KeyField: "Key", ValueField: "Value"},
+	"inspector":                {TagType: "ResourceGroupTag", KeyField: "Key", ValueField: "Value"},
+	"kinesis":                  {TagType: "Tag", KeyField: "Key", ValueField: "Value"},
+	"kms":                      {TagType: "Tag", KeyField: "TagKey", ValueField: "TagValue"},
+	"licensemanager":           {TagType: "Tag", KeyField: "Key", ValueField: "Value"},
+	"neptune":                  {TagType: "Tag", KeyField: "Key", ValueField: "Value"},
+	"ram":                      {TagType: "Tag", KeyField: "Key", ValueField: "Value"},
+	"rds":                      {TagType: "Tag", KeyField: "Key", ValueField: "Value"},
+	"redshift":                 {TagType: "Tag", KeyField: "Key", ValueField: "Value"},
+	"route53":                  {TagType: "Tag", KeyField: "Key", ValueField: "Value"},
+	"route53resolver":          {TagType: "Tag", KeyField: "Key", ValueField: "Value"},
+	"s3":                       {TagType: "Tag", KeyField: "Key", ValueField: "Value"},
+	"sagemaker":                {TagType: "Tag", KeyField: "Key", ValueField: "Value"},
+	"secretsmanager":           {TagType: "Tag", KeyField: "Key", ValueField: "Value"},
+	"sfn":                      {TagType: "Tag", KeyField: "Key", ValueField: "Value"},
+	"ssm":                      {TagType: "Tag", KeyField: "Key", ValueField: "Value"},
+	"transfer":                 {TagType: "Tag", KeyField: "Key", ValueField: "Value"},
+}
+
+// mapServiceNames lists the AWS Go SDK services using map[string]*string tags.
+var mapServiceNames = []string{
+	"apigateway",
+	"lambda",
+	"mediapackage",
+	"mq",
+	"opsworks",
+}
+
+type TemplateData struct {
+	MapServiceNames   []string
+	SliceServiceNames []string
+	SliceServiceTags  map[string]sliceServiceTag
+}
+
+func main() {
+	var sliceServiceNames []string
+	for k := range sliceServiceTags {
+		sliceServiceNames = append(sliceServiceNames, k)
+	}
+	sort.Strings(sliceServiceNames)
+
+	templateData := TemplateData{
+		MapServiceNames:   mapServiceNames,
+		SliceServiceNames: sliceServiceNames,
+		SliceServiceTags:  sliceServiceTags,
+	}
+
+	generate(filename, templateBody, templateData)
+	generate(testFilename, testTemplateBody, templateData)
+}
+
+func generate(filename string, body string, templateData TemplateData) {
+	templateFuncMap := template.FuncMap{
+		"Title": strings.Title,
+	}
+
+	tmpl, err := template.New("servicetags").Funcs(templateFuncMap).Parse(body)
+
+	if err != nil {
+		log.Fatalf("error parsing template: %s", err)
+	}
+
+	var buffer bytes.Buffer
+	err = tmpl.Execute(&buffer, templateData)
+
+	if err != nil {
+		log.Fatalf("error executing template: %s", err)
+	}
+
+	generatedFileContents, err := format.Source(buffer.Bytes())
+
+	if err != nil {
+		log.Fatalf("error formatting generated file: %s", err)
+	}
+
+	f, err := os.Create(filename)
+
+	if err != nil {
+		log.Fatalf("error creating file (%s): %s", filename, err)
+	}
+
+	defer f.Close()
+
+	_, err = f.Write(generatedFileContents)
+
+	if err != nil {
+		log.Fatalf("error writing to file (%s): %s", filename, err)
+	}
+}
+
+var templateBody = `
+// Code generated by generators/servicetags/main.go; DO NOT EDIT.
+
+package keyvaluetags
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+{{- range .SliceServiceNames }}
+	"github.com/aws/aws-sdk-go/service/{{ . }}"
+{{- end }}
+)
+
+// map[string]*string handling
+{{- range .MapServiceNames }}
+
+// {{ . | Title }}Tags returns {{ . }} service tags.
+func (tags KeyValueTags) {{ . | Title }}Tags() map[string]*string {
+	return aws.StringMap(tags.Map())
+}
+
+// {{ . | Title }}KeyValueTags creates KeyValueTags from {{ . }} service tags.
+func {{ . | Title }}KeyValueTags(tags map[string]*string) KeyValueTags {
+	return New(tags)
+}
+{{- end }}
+
+// []*SERVICE.Tag handling
+{{- range .SliceServiceNames }}
+{{- $tag := index $.SliceServiceTags . }}
+
+// {{ . | Title }}Tags returns {{ . }} service tags.
+func (tags KeyValueTags) {{ . | Title }}Tags() []*{{ . }}.{{ $tag.TagType }} {
+	result := make([]*{{ . }}.{{ $tag.TagType }}, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &{{ . }}.{{ $tag.TagType }}{
+			{{ $tag.KeyField }}:   aws.String(k),
+			{{ $tag.ValueField }}: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// {{ . | Title }}KeyValueTags creates KeyValueTags from {{ . }} service tags.
+func {{ . | Title }}KeyValueTags(tags []*{{ . }}.{{ $tag.TagType }}) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.{{ $tag.KeyField }})] = tag.{{ $tag.ValueField }}
+	}
+
+	return New(m)
+}
+{{- end }}
+`
+
+var testTemplateBody = `
+// Code generated by generators/servicetags/main.go; DO NOT EDIT.
+
+package keyvaluetags
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+{{- range .SliceServiceNames }}
+	"github.com/aws/aws-sdk-go/service/{{ . }}"
+{{- end }}
+)
+
+// map[string]*string handling
+{{- range .MapServiceNames }}
+
+func Test{{ . | Title }}KeyValueTags(t *testing.T) {
+	tags := map[string]*string{
+		"key1": aws.String("value1"),
+		"key2": aws.String("value2"),
+	}
+
+	got := {{ . | Title }}KeyValueTags(tags)
+
+	if !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected tags: %#v", got.Map())
+	}
+
+	if got := {{ . | Title }}KeyValueTags(got.{{ . | Title }}Tags()); !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected round trip tags: %#v", got.Map())
+	}
+}
+{{- end }}
+
+// []*SERVICE.Tag handling
+{{- range .SliceServiceNames }}
+{{- $tag := index $.SliceServiceTags . }}
+
+func Test{{ . | Title }}KeyValueTags(t *testing.T) {
+	tags := []*{{ . }}.{{ $tag.TagType }}{
+		{
+			{{ $tag.KeyField }}:   aws.String("key1"),
+			{{ $tag.ValueField }}: aws.String("value1"),
+		},
+		{
+			{{ $tag.KeyField }}:   aws.String("key2"),
+			{{ $tag.ValueField }}: aws.String("value2"),
+		},
+	}
+
+	got := {{ . | Title }}KeyValueTags(tags)
+
+	if !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected tags: %#v", got.Map())
+	}
+
+	serviceTags := got.{{ . | Title }}Tags()
+
+	if len(serviceTags) != len(tags) {
+		t.Fatalf("unexpected number of {{ . }} tags: %d", len(serviceTags))
+	}
+
+	if got := {{ . | Title }}KeyValueTags(serviceTags); !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected round trip tags: %#v", got.Map())
+	}
+}
+{{- end }}
+`
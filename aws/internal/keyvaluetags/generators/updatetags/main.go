@@ -0,0 +1,497 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"bytes"
+	"go/format"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+const filename = `update_tags_gen.go`
+
+// updateServiceTag describes the AWS Go SDK API calls of a service to tag
+// and untag a resource by identifier.
+type updateServiceTag struct {
+	ClientType string
+
+	TagFunction          string
+	TagInIdentifierField string
+	// TagInIdentifierSlice is set when the identifier is passed as a list
+	TagInIdentifierSlice bool
+	TagInTagsField       string
+
+	UntagFunction          string
+	UntagInIdentifierField string
+	UntagInTagsField       string
+	// UntagInNeedTagType is set when the removed tags are passed as tags instead of keys
+	UntagInNeedTagType bool
+	// UntagInTagKeyType is set when the removed tags are passed as a service specific key type
+	UntagInTagKeyType string
+}
+
+var updateServiceTags = map[string]updateServiceTag{
+	"acm": {
+		ClientType:             "ACM",
+		TagFunction:            "AddTagsToCertificate",
+		TagInIdentifierField:   "CertificateArn",
+		TagInTagsField:         "Tags",
+		UntagFunction:          "RemoveTagsFromCertificate",
+		UntagInIdentifierField: "CertificateArn",
+		UntagInTagsField:       "Tags",
+		UntagInNeedTagType:     true,
+	},
+	"acmpca": {
+		ClientType:             "ACMPCA",
+		TagFunction:            "TagCertificateAuthority",
+		TagInIdentifierField:   "CertificateAuthorityArn",
+		TagInTagsField:         "Tags",
+		UntagFunction:          "UntagCertificateAuthority",
+		UntagInIdentifierField: "CertificateAuthorityArn",
+		UntagInTagsField:       "Tags",
+		UntagInNeedTagType:     true,
+	},
+	"apigateway": {
+		ClientType:             "APIGateway",
+		TagFunction:            "TagResource",
+		TagInIdentifierField:   "ResourceArn",
+		TagInTagsField:         "Tags",
+		UntagFunction:          "UntagResource",
+		UntagInIdentifierField: "ResourceArn",
+		UntagInTagsField:       "TagKeys",
+	},
+	"cloudtrail": {
+		ClientType:             "CloudTrail",
+		TagFunction:            "AddTags",
+		TagInIdentifierField:   "ResourceId",
+		TagInTagsField:         "TagsList",
+		UntagFunction:          "RemoveTags",
+		UntagInIdentifierField: "ResourceId",
+		UntagInTagsField:       "TagsList",
+		UntagInNeedTagType:     true,
+	},
+	"databasemigrationservice": {
+		ClientType:             "DatabaseMigrationService",
+		TagFunction:            "AddTagsToResource",
+		TagInIdentifierField:   "ResourceArn",
+		TagInTagsField:         "Tags",
+		UntagFunction:          "RemoveTagsFromResource",
+		UntagInIdentifierField: "ResourceArn",
+		UntagInTagsField:       "TagKeys",
+	},
+	"datasync": {
+		ClientType:             "DataSync",
+		TagFunction:            "TagResource",
+		TagInIdentifierField:   "ResourceArn",
+		TagInTagsField:         "Tags",
+		UntagFunction:          "UntagResource",
+		UntagInIdentifierField: "ResourceArn",
+		UntagInTagsField:       "Keys",
+	},
+	"dax": {
+		ClientType:             "DAX",
+		TagFunction:            "TagResource",
+		TagInIdentifierField:   "ResourceName",
+		TagInTagsField:         "Tags",
+		UntagFunction:          "UntagResource",
+		UntagInIdentifierField: "ResourceName",
+		UntagInTagsField:       "TagKeys",
+	},
+	"directconnect": {
+		ClientType:             "DirectConnect",
+		TagFunction:            "TagResource",
+		TagInIdentifierField:   "ResourceArn",
+		TagInTagsField:         "Tags",
+		UntagFunction:          "UntagResource",
+		UntagInIdentifierField: "ResourceArn",
+		UntagInTagsField:       "TagKeys",
+	},
+	"directoryservice": {
+		ClientType:             "DirectoryService",
+		TagFunction:            "AddTagsToResource",
+		TagInIdentifierField:   "ResourceId",
+		TagInTagsField:         "Tags",
+		UntagFunction:          "RemoveTagsFromResource",
+		UntagInIdentifierField: "ResourceId",
+		UntagInTagsField:       "TagKeys",
+	},
+	"docdb": {
+		ClientType:             "DocDB",
+		TagFunction:            "AddTagsToResource",
+		TagInIdentifierField:   "ResourceName",
+		TagInTagsField:         "Tags",
+		UntagFunction:          "RemoveTagsFromResource",
+		UntagInIdentifierField: "ResourceName",
+		UntagInTagsField:       "TagKeys",
+	},
+	"dynamodb": {
+		ClientType:             "DynamoDB",
+		TagFunction:            "TagResource",
+		TagInIdentifierField:   "ResourceArn",
+		TagInTagsField:         "Tags",
+		UntagFunction:          "UntagResource",
+		UntagInIdentifierField: "ResourceArn",
+		UntagInTagsField:       "TagKeys",
+	},
+	"ecr": {
+		ClientType:             "ECR",
+		TagFunction:            "TagResource",
+		TagInIdentifierField:   "ResourceArn",
+		TagInTagsField:         "Tags",
+		UntagFunction:          "UntagResource",
+		UntagInIdentifierField: "ResourceArn",
+		UntagInTagsField:       "TagKeys",
+	},
+	"ecs": {
+		ClientType:             "ECS",
+		TagFunction:            "TagResource",
+		TagInIdentifierField:   "ResourceArn",
+		TagInTagsField:         "Tags",
+		UntagFunction:          "UntagResource",
+		UntagInIdentifierField: "ResourceArn",
+		UntagInTagsField:       "TagKeys",
+	},
+	"efs": {
+		ClientType:             "EFS",
+		TagFunction:            "CreateTags",
+		TagInIdentifierField:   "FileSystemId",
+		TagInTagsField:         "Tags",
+		UntagFunction:          "DeleteTags",
+		UntagInIdentifierField: "FileSystemId",
+		UntagInTagsField:       "TagKeys",
+	},
+	"elasticache": {
+		ClientType:             "ElastiCache",
+		TagFunction:            "AddTagsToResource",
+		TagInIdentifierField:   "ResourceName",
+		TagInTagsField:         "Tags",
+		UntagFunction:          "RemoveTagsFromResource",
+		UntagInIdentifierField: "ResourceName",
+		UntagInTagsField:       "TagKeys",
+	},
+	"elasticsearchservice": {
+		ClientType:             "ElasticsearchService",
+		TagFunction:            "AddTags",
+		TagInIdentifierField:   "ARN",
+		TagInTagsField:         "TagList",
+		UntagFunction:          "RemoveTags",
+		UntagInIdentifierField: "ARN",
+		UntagInTagsField:       "TagKeys",
+	},
+	"elb": {
+		ClientType:             "ELB",
+		TagFunction:            "AddTags",
+		TagInIdentifierField:   "LoadBalancerNames",
+		TagInIdentifierSlice:   true,
+		TagInTagsField:         "Tags",
+		UntagFunction:          "RemoveTags",
+		UntagInIdentifierField: "LoadBalancerNames",
+		UntagInTagsField:       "Tags",
+		UntagInTagKeyType:      "TagKeyOnly",
+	},
+	"elbv2": {
+		ClientType:             "ELBV2",
+		TagFunction:            "AddTags",
+		TagInIdentifierField:   "ResourceArns",
+		TagInIdentifierSlice:   true,
+		TagInTagsField:         "Tags",
+		UntagFunction:          "RemoveTags",
+		UntagInIdentifierField: "ResourceArns",
+		UntagInTagsField:       "TagKeys",
+	},
+	"firehose": {
+		ClientType:             "Firehose",
+		TagFunction:            "TagDeliveryStream",
+		TagInIdentifierField:   "DeliveryStreamName",
+		TagInTagsField:         "Tags",
+		UntagFunction:          "UntagDeliveryStream",
+		UntagInIdentifierField: "DeliveryStreamName",
+		UntagInTagsField:       "TagKeys",
+	},
+	"fsx": {
+		ClientType:             "FSx",
+		TagFunction:            "TagResource",
+		TagInIdentifierField:   "ResourceARN",
+		TagInTagsField:         "Tags",
+		UntagFunction:          "UntagResource",
+		UntagInIdentifierField: "ResourceARN",
+		UntagInTagsField:       "TagKeys",
+	},
+	"kms": {
+		ClientType:             "KMS",
+		TagFunction:            "TagResource",
+		TagInIdentifierField:   "KeyId",
+		TagInTagsField:         "Tags",
+		UntagFunction:          "UntagResource",
+		UntagInIdentifierField: "KeyId",
+		UntagInTagsField:       "TagKeys",
+	},
+	"lambda": {
+		ClientType:             "Lambda",
+		TagFunction:            "TagResource",
+		TagInIdentifierField:   "Resource",
+		TagInTagsField:         "Tags",
+		UntagFunction:          "UntagResource",
+		UntagInIdentifierField: "Resource",
+		UntagInTagsField:       "TagKeys",
+	},
+	"licensemanager": {
+		ClientType:             "LicenseManager",
+		TagFunction:            "TagResource",
+		TagInIdentifierField:   "ResourceArn",
+		TagInTagsField:         "Tags",
+		UntagFunction:          "UntagResource",
+		UntagInIdentifierField: "ResourceArn",
+		UntagInTagsField:       "TagKeys",
+	},
+	"mediapackage": {
+		ClientType:             "MediaPackage",
+		TagFunction:            "TagResource",
+		TagInIdentifierField:   "ResourceArn",
+		TagInTagsField:         "Tags",
+		UntagFunction:          "UntagResource",
+		UntagInIdentifierField: "ResourceArn",
+		UntagInTagsField:       "TagKeys",
+	},
+	"mq": {
+		ClientType:             "MQ",
+		TagFunction:            "CreateTags",
+		TagInIdentifierField:   "ResourceArn",
+		TagInTagsField:         "Tags",
+		UntagFunction:          "DeleteTags",
+		UntagInIdentifierField: "ResourceArn",
+		UntagInTagsField:       "TagKeys",
+	},
+	"neptune": {
+		ClientType:             "Neptune",
+		TagFunction:            "AddTagsToResource",
+		TagInIdentifierField:   "ResourceName",
+		TagInTagsField:         "Tags",
+		UntagFunction:          "RemoveTagsFromResource",
+		UntagInIdentifierField: "ResourceName",
+		UntagInTagsField:       "TagKeys",
+	},
+	"opsworks": {
+		ClientType:             "OpsWorks",
+		TagFunction:            "TagResource",
+		TagInIdentifierField:   "ResourceArn",
+		TagInTagsField:         "Tags",
+		UntagFunction:          "UntagResource",
+		UntagInIdentifierField: "ResourceArn",
+		UntagInTagsField:       "TagKeys",
+	},
+	"ram": {
+		ClientType:             "RAM",
+		TagFunction:            "TagResource",
+		TagInIdentifierField:   "ResourceShareArn",
+		TagInTagsField:         "Tags",
+		UntagFunction:          "UntagResource",
+		UntagInIdentifierField: "ResourceShareArn",
+		UntagInTagsField:       "TagKeys",
+	},
+	"rds": {
+		ClientType:             "RDS",
+		TagFunction:            "AddTagsToResource",
+		TagInIdentifierField:   "ResourceName",
+		TagInTagsField:         "Tags",
+		UntagFunction:          "RemoveTagsFromResource",
+		UntagInIdentifierField: "ResourceName",
+		UntagInTagsField:       "TagKeys",
+	},
+	"redshift": {
+		ClientType:             "Redshift",
+		TagFunction:            "CreateTags",
+		TagInIdentifierField:   "ResourceName",
+		TagInTagsField:         "Tags",
+		UntagFunction:          "DeleteTags",
+		UntagInIdentifierField: "ResourceName",
+		UntagInTagsField:       "TagKeys",
+	},
+	"route53resolver": {
+		ClientType:             "Route53Resolver",
+		TagFunction:            "TagResource",
+		TagInIdentifierField:   "ResourceArn",
+		TagInTagsField:         "Tags",
+		UntagFunction:          "UntagResource",
+		UntagInIdentifierField: "ResourceArn",
+		UntagInTagsField:       "TagKeys",
+	},
+	"sagemaker": {
+		ClientType:             "SageMaker",
+		TagFunction:            "AddTags",
+		TagInIdentifierField:   "ResourceArn",
+		TagInTagsField:         "Tags",
+		UntagFunction:          "DeleteTags",
+		UntagInIdentifierField: "ResourceArn",
+		UntagInTagsField:       "TagKeys",
+	},
+	"secretsmanager": {
+		ClientType:             "SecretsManager",
+		TagFunction:            "TagResource",
+		TagInIdentifierField:   "SecretId",
+		TagInTagsField:         "Tags",
+		UntagFunction:          "UntagResource",
+		UntagInIdentifierField: "SecretId",
+		UntagInTagsField:       "TagKeys",
+	},
+	"sfn": {
+		ClientType:             "SFN",
+		TagFunction:            "TagResource",
+		TagInIdentifierField:   "ResourceArn",
+		TagInTagsField:         "Tags",
+		UntagFunction:          "UntagResource",
+		UntagInIdentifierField: "ResourceArn",
+		UntagInTagsField:       "TagKeys",
+	},
+	"transfer": {
+		ClientType:             "Transfer",
+		TagFunction:            "TagResource",
+		TagInIdentifierField:   "Arn",
+		TagInTagsField:         "Tags",
+		UntagFunction:          "UntagResource",
+		UntagInIdentifierField: "Arn",
+		UntagInTagsField:       "TagKeys",
+	},
+}
+
+type TemplateData struct {
+	ServiceNames      []string
+	UpdateServiceTags map[string]updateServiceTag
+}
+
+func main() {
+	var serviceNames []string
+	for k := range updateServiceTags {
+		serviceNames = append(serviceNames, k)
+	}
+	sort.Strings(serviceNames)
+
+	templateData := TemplateData{
+		ServiceNames:      serviceNames,
+		UpdateServiceTags: updateServiceTags,
+	}
+	templateFuncMap := template.FuncMap{
+		"Title": strings.Title,
+	}
+
+	tmpl, err := template.New("updatetags").Funcs(templateFuncMap).Parse(templateBody)
+
+	if err != nil {
+		log.Fatalf("error parsing template: %s", err)
+	}
+
+	var buffer bytes.Buffer
+	err = tmpl.Execute(&buffer, templateData)
+
+	if err != nil {
+		log.Fatalf("error executing template: %s", err)
+	}
+
+	generatedFileContents, err := format.Source(buffer.Bytes())
+
+	if err != nil {
+		log.Fatalf("error formatting generated file: %s", err)
+	}
+
+	f, err := os.Create(filename)
+
+	if err != nil {
+		log.Fatalf("error creating file (%s): %s", filename, err)
+	}
+
+	defer f.Close()
+
+	_, err = f.Write(generatedFileContents)
+
+	if err != nil {
+		log.Fatalf("error writing to file (%s): %s", filename, err)
+	}
+}
+
+var templateBody = `
+// Code generated by generators/updatetags/main.go; DO NOT EDIT.
+
+package keyvaluetags
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+{{- range .ServiceNames }}
+	"github.com/aws/aws-sdk-go/service/{{ . }}"
+{{- end }}
+)
+{{- range .ServiceNames }}
+{{- $svc := index $.UpdateServiceTags . }}
+{{- if $svc.UntagInTagKeyType }}
+
+// {{ . | Title }}{{ $svc.UntagInTagKeyType }}s returns {{ . }} service tag keys.
+func (tags KeyValueTags) {{ . | Title }}{{ $svc.UntagInTagKeyType }}s() []*{{ . }}.{{ $svc.UntagInTagKeyType }} {
+	result := make([]*{{ . }}.{{ $svc.UntagInTagKeyType }}, 0, len(tags))
+
+	for k := range tags {
+		result = append(result, &{{ . }}.{{ $svc.UntagInTagKeyType }}{
+			Key: aws.String(k),
+		})
+	}
+
+	return result
+}
+{{- end }}
+
+// {{ . | Title }}UpdateTags updates {{ . }} service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func {{ . | Title }}UpdateTags(conn *{{ . }}.{{ $svc.ClientType }}, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &{{ . }}.{{ $svc.UntagFunction }}Input{
+			{{- if $svc.TagInIdentifierSlice }}
+			{{ $svc.UntagInIdentifierField }}: aws.StringSlice([]string{identifier}),
+			{{- else }}
+			{{ $svc.UntagInIdentifierField }}: aws.String(identifier),
+			{{- end }}
+			{{- if $svc.UntagInNeedTagType }}
+			{{ $svc.UntagInTagsField }}: removedTags.{{ . | Title }}Tags(),
+			{{- else if $svc.UntagInTagKeyType }}
+			{{ $svc.UntagInTagsField }}: removedTags.{{ . | Title }}{{ $svc.UntagInTagKeyType }}s(),
+			{{- else }}
+			{{ $svc.UntagInTagsField }}: aws.StringSlice(removedTags.Keys()),
+			{{- end }}
+		}
+
+		_, err := conn.{{ $svc.UntagFunction }}(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &{{ . }}.{{ $svc.TagFunction }}Input{
+			{{- if $svc.TagInIdentifierSlice }}
+			{{ $svc.TagInIdentifierField }}: aws.StringSlice([]string{identifier}),
+			{{- else }}
+			{{ $svc.TagInIdentifierField }}: aws.String(identifier),
+			{{- end }}
+			{{ $svc.TagInTagsField }}: updatedTags.{{ . | Title }}Tags(),
+		}
+
+		_, err := conn.{{ $svc.TagFunction }}(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+{{- end }}
+`
@@ -0,0 +1,268 @@
+//go:generate go run generators/servicetags/main.go
+//go:generate go run generators/updatetags/main.go
+
+// Package keyvaluetags provides a common representation of resource tags
+// and the conversions between it and the tag types of the AWS Go SDK.
+package keyvaluetags
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+)
+
+const (
+	// AwsTagKeyPrefix is the prefix of the tag keys reserved by AWS.
+	AwsTagKeyPrefix = `aws:`
+)
+
+// IgnoreConfig contains the tag keys and tag key prefixes to remove from
+// resource tags, e.g. the provider ignore_tags configuration.
+type IgnoreConfig struct {
+	Keys        KeyValueTags
+	KeyPrefixes KeyValueTags
+}
+
+// KeyValueTags is a standard implementation for AWS key-value resource tags.
+// The AWS Go SDK is split into multiple service packages, each service with
+// its own Go struct type representing a resource tag. To standardize logic
+// across all these Go types, we convert them into this Go type.
+type KeyValueTags map[string]*string
+
+// IgnoreAws returns non-AWS tag keys.
+func (tags KeyValueTags) IgnoreAws() KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if !strings.HasPrefix(k, AwsTagKeyPrefix) {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// Ignore returns non-matching tag keys.
+func (tags KeyValueTags) Ignore(ignoreTags KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if _, ok := ignoreTags[k]; ok {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
+// IgnoreConfig returns tags not matching the keys or key prefixes of the
+// ignore configuration. A nil configuration ignores no tags.
+func (tags KeyValueTags) IgnoreConfig(config *IgnoreConfig) KeyValueTags {
+	if config == nil {
+		return tags.Merge(nil)
+	}
+
+	return tags.Ignore(config.Keys).IgnorePrefixes(config.KeyPrefixes)
+}
+
+// IgnorePrefixes returns non-matching tag key prefixes.
+func (tags KeyValueTags) IgnorePrefixes(ignoreTagPrefixes KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		var ignore bool
+
+		for ignoreTagPrefix := range ignoreTagPrefixes {
+			if strings.HasPrefix(k, ignoreTagPrefix) {
+				ignore = true
+				break
+			}
+		}
+
+		if ignore {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
+// Keys returns tag keys.
+func (tags KeyValueTags) Keys() []string {
+	result := make([]string, 0, len(tags))
+
+	for k := range tags {
+		result = append(result, k)
+	}
+
+	return result
+}
+
+// Map returns tag keys mapped to their values.
+func (tags KeyValueTags) Map() map[string]string {
+	result := make(map[string]string, len(tags))
+
+	for k, v := range tags {
+		if v == nil {
+			result[k] = ""
+			continue
+		}
+
+		result[k] = *v
+	}
+
+	return result
+}
+
+// Merge adds missing and updates existing tags.
+func (tags KeyValueTags) Merge(mergeTags KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		result[k] = v
+	}
+
+	for k, v := range mergeTags {
+		result[k] = v
+	}
+
+	return result
+}
+
+// Removed returns tags removed.
+func (tags KeyValueTags) Removed(newTags KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if _, ok := newTags[k]; !ok {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// Updated returns tags added and updated.
+func (tags KeyValueTags) Updated(newTags KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, newV := range newTags {
+		if oldV, ok := tags[k]; !ok || stringValue(oldV) != stringValue(newV) {
+			result[k] = newV
+		}
+	}
+
+	return result
+}
+
+// Diff returns the tags that must be created and the tags that must be
+// removed to go from the tags to newTags. Tags with a changed value are
+// part of both results, as some services cannot overwrite a tag value.
+func (tags KeyValueTags) Diff(newTags KeyValueTags) (KeyValueTags, KeyValueTags) {
+	return tags.Updated(newTags), newTags.Updated(tags)
+}
+
+// Equal returns whether or not two sets of tags are equal.
+func (tags KeyValueTags) Equal(other KeyValueTags) bool {
+	if tags == nil && other == nil {
+		return true
+	}
+
+	if tags == nil || other == nil {
+		return false
+	}
+
+	if len(tags) != len(other) {
+		return false
+	}
+
+	for k, v := range tags {
+		o, ok := other[k]
+
+		if !ok || stringValue(o) != stringValue(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Hash returns a stable hash value.
+// The returned value may be negative (i.e. not suitable for a 'Set' function).
+func (tags KeyValueTags) Hash() int {
+	hash := 0
+
+	for k, v := range tags {
+		hash = hash ^ hashcode.String(fmt.Sprintf("%s-%s", k, stringValue(v)))
+	}
+
+	return hash
+}
+
+// New creates KeyValueTags from common Terraform Provider SDK types.
+// Supports map[string]string, map[string]*string, map[string]interface{}, []string and []interface{}.
+// When passed []string or []interface{}, all tags will have empty values.
+func New(i interface{}) KeyValueTags {
+	switch value := i.(type) {
+	case KeyValueTags:
+		return value.Merge(nil)
+	case map[string]string:
+		kvtm := make(KeyValueTags, len(value))
+
+		for k, v := range value {
+			str := v // Prevent referencing issues
+			kvtm[k] = &str
+		}
+
+		return kvtm
+	case map[string]*string:
+		kvtm := make(KeyValueTags, len(value))
+
+		for k, v := range value {
+			kvtm[k] = v
+		}
+
+		return kvtm
+	case map[string]interface{}:
+		kvtm := make(KeyValueTags, len(value))
+
+		for k, v := range value {
+			str := v.(string)
+			kvtm[k] = &str
+		}
+
+		return kvtm
+	case []string:
+		kvtm := make(KeyValueTags, len(value))
+
+		for _, v := range value {
+			kvtm[v] = nil
+		}
+
+		return kvtm
+	case []interface{}:
+		kvtm := make(KeyValueTags, len(value))
+
+		for _, v := range value {
+			kvtm[v.(string)] = nil
+		}
+
+		return kvtm
+	default:
+		return make(KeyValueTags)
+	}
+}
+
+// stringValue returns the value of a string pointer or "" if the pointer is nil.
+func stringValue(v *string) string {
+	if v == nil {
+		return ""
+	}
+
+	return *v
+}
@@ -0,0 +1,585 @@
+package keyvaluetags
+
+import (
+	"sort"
+	"testing"
+)
+
+func testKeyValueTags() KeyValueTags {
+	return New(map[string]string{
+		"key1": "value1",
+		"key2": "value2",
+	})
+}
+
+func TestKeyValueTagsIgnoreAws(t *testing.T) {
+	testCases := []struct {
+		name string
+		tags KeyValueTags
+		want map[string]string
+	}{
+		{
+			name: "empty",
+			tags: New(map[string]string{}),
+			want: map[string]string{},
+		},
+		{
+			name: "all",
+			tags: New(map[string]string{
+				"aws:cloudformation:key1": "value1",
+				"aws:cloudformation:key2": "value2",
+			}),
+			want: map[string]string{},
+		},
+		{
+			name: "mixed",
+			tags: New(map[string]string{
+				"aws:cloudformation:key1": "value1",
+				"key2":                    "value2",
+				"key3":                    "value3",
+			}),
+			want: map[string]string{
+				"key2": "value2",
+				"key3": "value3",
+			},
+		},
+		{
+			name: "none",
+			tags: testKeyValueTags(),
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.IgnoreAws()
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsIgnore(t *testing.T) {
+	testCases := []struct {
+		name       string
+		tags       KeyValueTags
+		ignoreTags KeyValueTags
+		want       map[string]string
+	}{
+		{
+			name:       "empty",
+			tags:       New(map[string]string{}),
+			ignoreTags: New([]string{"key1"}),
+			want:       map[string]string{},
+		},
+		{
+			name:       "all",
+			tags:       testKeyValueTags(),
+			ignoreTags: New([]string{"key1", "key2"}),
+			want:       map[string]string{},
+		},
+		{
+			name:       "mixed",
+			tags:       testKeyValueTags(),
+			ignoreTags: New([]string{"key1", "key3"}),
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
+		{
+			name:       "none",
+			tags:       testKeyValueTags(),
+			ignoreTags: New([]string{"key3"}),
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.Ignore(testCase.ignoreTags)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsIgnoreConfig(t *testing.T) {
+	testCases := []struct {
+		name         string
+		tags         KeyValueTags
+		ignoreConfig *IgnoreConfig
+		want         map[string]string
+	}{
+		{
+			name:         "nil",
+			tags:         testKeyValueTags(),
+			ignoreConfig: nil,
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+		{
+			name: "keys",
+			tags: testKeyValueTags(),
+			ignoreConfig: &IgnoreConfig{
+				Keys: New([]string{"key1"}),
+			},
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
+		{
+			name: "keys and key prefixes",
+			tags: New(map[string]string{
+				"compliance:owner":           "security",
+				"CostCenter":                 "1234",
+				"CostCenterCode":             "5678",
+				"key1":                       "value1",
+				"kubernetes.io/cluster/test": "owned",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				Keys:        New([]string{"CostCenter"}),
+				KeyPrefixes: New([]string{"compliance:", "kubernetes.io/"}),
+			},
+			want: map[string]string{
+				"CostCenterCode": "5678",
+				"key1":           "value1",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.IgnoreConfig(testCase.ignoreConfig)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsIgnorePrefixes(t *testing.T) {
+	testCases := []struct {
+		name              string
+		tags              KeyValueTags
+		ignoreTagPrefixes KeyValueTags
+		want              map[string]string
+	}{
+		{
+			name:              "empty",
+			tags:              New(map[string]string{}),
+			ignoreTagPrefixes: New([]string{"key"}),
+			want:              map[string]string{},
+		},
+		{
+			name:              "all",
+			tags:              testKeyValueTags(),
+			ignoreTagPrefixes: New([]string{"key"}),
+			want:              map[string]string{},
+		},
+		{
+			name: "mixed",
+			tags: New(map[string]string{
+				"compliance:owner": "security",
+				"key1":             "value1",
+			}),
+			ignoreTagPrefixes: New([]string{"compliance:", "kubernetes.io/"}),
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name:              "none",
+			tags:              testKeyValueTags(),
+			ignoreTagPrefixes: New([]string{"other"}),
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.IgnorePrefixes(testCase.ignoreTagPrefixes)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsKeys(t *testing.T) {
+	testCases := []struct {
+		name string
+		tags KeyValueTags
+		want []string
+	}{
+		{
+			name: "empty",
+			tags: New(map[string]string{}),
+			want: []string{},
+		},
+		{
+			name: "non-empty",
+			tags: testKeyValueTags(),
+			want: []string{"key1", "key2"},
+		},
+		{
+			name: "keys only",
+			tags: New([]string{"key1", "key2"}),
+			want: []string{"key1", "key2"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.Keys()
+
+			testKeyValueTagsVerifyKeys(t, got, testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsMap(t *testing.T) {
+	testCases := []struct {
+		name string
+		tags KeyValueTags
+		want map[string]string
+	}{
+		{
+			name: "empty",
+			tags: New(map[string]string{}),
+			want: map[string]string{},
+		},
+		{
+			name: "non-empty",
+			tags: testKeyValueTags(),
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+		{
+			name: "nil values",
+			tags: New([]interface{}{"key1"}),
+			want: map[string]string{
+				"key1": "",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.Map()
+
+			testKeyValueTagsVerifyMap(t, got, testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsMerge(t *testing.T) {
+	testCases := []struct {
+		name      string
+		tags      KeyValueTags
+		mergeTags KeyValueTags
+		want      map[string]string
+	}{
+		{
+			name:      "empty",
+			tags:      New(map[string]string{}),
+			mergeTags: New(map[string]string{}),
+			want:      map[string]string{},
+		},
+		{
+			name: "add only",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			mergeTags: New(map[string]string{
+				"key2": "value2",
+			}),
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+		{
+			name: "update only",
+			tags: testKeyValueTags(),
+			mergeTags: New(map[string]string{
+				"key1": "value1updated",
+			}),
+			want: map[string]string{
+				"key1": "value1updated",
+				"key2": "value2",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.Merge(testCase.mergeTags)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsRemovedUpdated(t *testing.T) {
+	testCases := []struct {
+		name        string
+		oldTags     KeyValueTags
+		newTags     KeyValueTags
+		wantRemoved map[string]string
+		wantUpdated map[string]string
+	}{
+		{
+			name:        "empty",
+			oldTags:     New(map[string]string{}),
+			newTags:     New(map[string]string{}),
+			wantRemoved: map[string]string{},
+			wantUpdated: map[string]string{},
+		},
+		{
+			name:        "add",
+			oldTags:     New(map[string]string{}),
+			newTags:     testKeyValueTags(),
+			wantRemoved: map[string]string{},
+			wantUpdated: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+		{
+			name:    "remove",
+			oldTags: testKeyValueTags(),
+			newTags: New(map[string]string{
+				"key1": "value1",
+			}),
+			wantRemoved: map[string]string{
+				"key2": "value2",
+			},
+			wantUpdated: map[string]string{},
+		},
+		{
+			name:    "update",
+			oldTags: testKeyValueTags(),
+			newTags: New(map[string]string{
+				"key1": "value1updated",
+				"key2": "value2",
+			}),
+			wantRemoved: map[string]string{},
+			wantUpdated: map[string]string{
+				"key1": "value1updated",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testKeyValueTagsVerifyMap(t, testCase.oldTags.Removed(testCase.newTags).Map(), testCase.wantRemoved)
+			testKeyValueTagsVerifyMap(t, testCase.oldTags.Updated(testCase.newTags).Map(), testCase.wantUpdated)
+		})
+	}
+}
+
+func TestKeyValueTagsDiff(t *testing.T) {
+	oldTags := New(map[string]string{
+		"key1": "value1",
+		"key2": "value2",
+		"key3": "value3",
+	})
+	newTags := New(map[string]string{
+		"key1": "value1",
+		"key2": "value2updated",
+		"key4": "value4",
+	})
+
+	create, remove := oldTags.Diff(newTags)
+
+	testKeyValueTagsVerifyMap(t, create.Map(), map[string]string{
+		"key2": "value2updated",
+		"key4": "value4",
+	})
+	testKeyValueTagsVerifyMap(t, remove.Map(), map[string]string{
+		"key2": "value2",
+		"key3": "value3",
+	})
+}
+
+func TestKeyValueTagsEqual(t *testing.T) {
+	testCases := []struct {
+		name  string
+		tags  KeyValueTags
+		other KeyValueTags
+		want  bool
+	}{
+		{
+			name:  "nil",
+			tags:  nil,
+			other: nil,
+			want:  true,
+		},
+		{
+			name:  "nil and empty",
+			tags:  nil,
+			other: New(map[string]string{}),
+			want:  false,
+		},
+		{
+			name:  "equal",
+			tags:  testKeyValueTags(),
+			other: testKeyValueTags(),
+			want:  true,
+		},
+		{
+			name: "different value",
+			tags: testKeyValueTags(),
+			other: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2updated",
+			}),
+			want: false,
+		},
+		{
+			name: "different keys",
+			tags: testKeyValueTags(),
+			other: New(map[string]string{
+				"key1": "value1",
+			}),
+			want: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if got := testCase.tags.Equal(testCase.other); got != testCase.want {
+				t.Errorf("got %t, expected %t", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestKeyValueTagsHash(t *testing.T) {
+	if testKeyValueTags().Hash() != testKeyValueTags().Hash() {
+		t.Fatal("expected equal tags to have equal hashes")
+	}
+
+	other := New(map[string]string{
+		"key1": "value1",
+		"key2": "value2updated",
+	})
+
+	if testKeyValueTags().Hash() == other.Hash() {
+		t.Fatal("expected different tags to have different hashes")
+	}
+}
+
+func TestNew(t *testing.T) {
+	testCases := []struct {
+		name   string
+		source interface{}
+		want   map[string]string
+	}{
+		{
+			name:   "nil",
+			source: nil,
+			want:   map[string]string{},
+		},
+		{
+			name:   "KeyValueTags",
+			source: testKeyValueTags(),
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+		{
+			name: "map[string]*string",
+			source: map[string]*string{
+				"key1": testStringPtr("value1"),
+				"key2": nil,
+			},
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "",
+			},
+		},
+		{
+			name: "map[string]interface{}",
+			source: map[string]interface{}{
+				"key1": "value1",
+				"key2": "value2",
+			},
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+		{
+			name:   "[]string",
+			source: []string{"key1", "key2"},
+			want: map[string]string{
+				"key1": "",
+				"key2": "",
+			},
+		},
+		{
+			name:   "[]interface{}",
+			source: []interface{}{"key1", "key2"},
+			want: map[string]string{
+				"key1": "",
+				"key2": "",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := New(testCase.source)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func testStringPtr(s string) *string {
+	return &s
+}
+
+func testKeyValueTagsVerifyKeys(t *testing.T, got []string, want []string) {
+	sort.Strings(got)
+	sort.Strings(want)
+
+	if len(got) != len(want) {
+		t.Fatalf("got keys %v, expected %v", got, want)
+	}
+
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("got keys %v, expected %v", got, want)
+		}
+	}
+}
+
+func testKeyValueTagsVerifyMap(t *testing.T, got map[string]string, want map[string]string) {
+	if len(got) != len(want) {
+		t.Fatalf("got %#v, expected %#v", got, want)
+	}
+
+	for k, wantV := range want {
+		gotV, ok := got[k]
+
+		if !ok {
+			t.Errorf("missing key %q in %#v", k, got)
+		} else if gotV != wantV {
+			t.Errorf("got key %q value %q, expected %q", k, gotV, wantV)
+		}
+	}
+}
@@ -0,0 +1,1181 @@
+// Code generated by generators/servicetags/main.go; DO NOT EDIT.
+
+package keyvaluetags
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/aws-sdk-go/service/acmpca"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
+	"github.com/aws/aws-sdk-go/service/codebuild"
+	"github.com/aws/aws-sdk-go/service/databasemigrationservice"
+	"github.com/aws/aws-sdk-go/service/datasync"
+	"github.com/aws/aws-sdk-go/service/dax"
+	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/aws/aws-sdk-go/service/docdb"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	"github.com/aws/aws-sdk-go/service/elasticsearchservice"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/firehose"
+	"github.com/aws/aws-sdk-go/service/fsx"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/inspector"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/licensemanager"
+	"github.com/aws/aws-sdk-go/service/neptune"
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/route53resolver"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/transfer"
+)
+
+// map[string]*string handling
+
+// ApigatewayTags returns apigateway service tags.
+func (tags KeyValueTags) ApigatewayTags() map[string]*string {
+	return aws.StringMap(tags.Map())
+}
+
+// ApigatewayKeyValueTags creates KeyValueTags from apigateway service tags.
+func ApigatewayKeyValueTags(tags map[string]*string) KeyValueTags {
+	return New(tags)
+}
+
+// LambdaTags returns lambda service tags.
+func (tags KeyValueTags) LambdaTags() map[string]*string {
+	return aws.StringMap(tags.Map())
+}
+
+// LambdaKeyValueTags creates KeyValueTags from lambda service tags.
+func LambdaKeyValueTags(tags map[string]*string) KeyValueTags {
+	return New(tags)
+}
+
+// MediapackageTags returns mediapackage service tags.
+func (tags KeyValueTags) MediapackageTags() map[string]*string {
+	return aws.StringMap(tags.Map())
+}
+
+// MediapackageKeyValueTags creates KeyValueTags from mediapackage service tags.
+func MediapackageKeyValueTags(tags map[string]*string) KeyValueTags {
+	return New(tags)
+}
+
+// MqTags returns mq service tags.
+func (tags KeyValueTags) MqTags() map[string]*string {
+	return aws.StringMap(tags.Map())
+}
+
+// MqKeyValueTags creates KeyValueTags from mq service tags.
+func MqKeyValueTags(tags map[string]*string) KeyValueTags {
+	return New(tags)
+}
+
+// OpsworksTags returns opsworks service tags.
+func (tags KeyValueTags) OpsworksTags() map[string]*string {
+	return aws.StringMap(tags.Map())
+}
+
+// OpsworksKeyValueTags creates KeyValueTags from opsworks service tags.
+func OpsworksKeyValueTags(tags map[string]*string) KeyValueTags {
+	return New(tags)
+}
+
+// []*SERVICE.Tag handling
+
+// AcmTags returns acm service tags.
+func (tags KeyValueTags) AcmTags() []*acm.Tag {
+	result := make([]*acm.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &acm.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// AcmKeyValueTags creates KeyValueTags from acm service tags.
+func AcmKeyValueTags(tags []*acm.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
+// AcmpcaTags returns acmpca service tags.
+func (tags KeyValueTags) AcmpcaTags() []*acmpca.Tag {
+	result := make([]*acmpca.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &acmpca.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// AcmpcaKeyValueTags creates KeyValueTags from acmpca service tags.
+func AcmpcaKeyValueTags(tags []*acmpca.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
+// CloudfrontTags returns cloudfront service tags.
+func (tags KeyValueTags) CloudfrontTags() []*cloudfront.Tag {
+	result := make([]*cloudfront.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &cloudfront.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// CloudfrontKeyValueTags creates KeyValueTags from cloudfront service tags.
+func CloudfrontKeyValueTags(tags []*cloudfront.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
+// CloudtrailTags returns cloudtrail service tags.
+func (tags KeyValueTags) CloudtrailTags() []*cloudtrail.Tag {
+	result := make([]*cloudtrail.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &cloudtrail.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// CloudtrailKeyValueTags creates KeyValueTags from cloudtrail service tags.
+func CloudtrailKeyValueTags(tags []*cloudtrail.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
+// CodebuildTags returns codebuild service tags.
+func (tags KeyValueTags) CodebuildTags() []*codebuild.Tag {
+	result := make([]*codebuild.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &codebuild.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// CodebuildKeyValueTags creates KeyValueTags from codebuild service tags.
+func CodebuildKeyValueTags(tags []*codebuild.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
+// DatabasemigrationserviceTags returns databasemigrationservice service tags.
+func (tags KeyValueTags) DatabasemigrationserviceTags() []*databasemigrationservice.Tag {
+	result := make([]*databasemigrationservice.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &databasemigrationservice.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// DatabasemigrationserviceKeyValueTags creates KeyValueTags from databasemigrationservice service tags.
+func DatabasemigrationserviceKeyValueTags(tags []*databasemigrationservice.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
+// DatasyncTags returns datasync service tags.
+func (tags KeyValueTags) DatasyncTags() []*datasync.TagListEntry {
+	result := make([]*datasync.TagListEntry, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &datasync.TagListEntry{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// DatasyncKeyValueTags creates KeyValueTags from datasync service tags.
+func DatasyncKeyValueTags(tags []*datasync.TagListEntry) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
+// DaxTags returns dax service tags.
+func (tags KeyValueTags) DaxTags() []*dax.Tag {
+	result := make([]*dax.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &dax.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// DaxKeyValueTags creates KeyValueTags from dax service tags.
+func DaxKeyValueTags(tags []*dax.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
+// DirectconnectTags returns directconnect service tags.
+func (tags KeyValueTags) DirectconnectTags() []*directconnect.Tag {
+	result := make([]*directconnect.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &directconnect.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// DirectconnectKeyValueTags creates KeyValueTags from directconnect service tags.
+func DirectconnectKeyValueTags(tags []*directconnect.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
+// DirectoryserviceTags returns directoryservice service tags.
+func (tags KeyValueTags) DirectoryserviceTags() []*directoryservice.Tag {
+	result := make([]*directoryservice.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &directoryservice.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// DirectoryserviceKeyValueTags creates KeyValueTags from directoryservice service tags.
+func DirectoryserviceKeyValueTags(tags []*directoryservice.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
+// DocdbTags returns docdb service tags.
+func (tags KeyValueTags) DocdbTags() []*docdb.Tag {
+	result := make([]*docdb.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &docdb.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// DocdbKeyValueTags creates KeyValueTags from docdb service tags.
+func DocdbKeyValueTags(tags []*docdb.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
+// DynamodbTags returns dynamodb service tags.
+func (tags KeyValueTags) DynamodbTags() []*dynamodb.Tag {
+	result := make([]*dynamodb.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &dynamodb.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// DynamodbKeyValueTags creates KeyValueTags from dynamodb service tags.
+func DynamodbKeyValueTags(tags []*dynamodb.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
+// Ec2Tags returns ec2 service tags.
+func (tags KeyValueTags) Ec2Tags() []*ec2.Tag {
+	result := make([]*ec2.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &ec2.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// Ec2KeyValueTags creates KeyValueTags from ec2 service tags.
+func Ec2KeyValueTags(tags []*ec2.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
+// EcrTags returns ecr service tags.
+func (tags KeyValueTags) EcrTags() []*ecr.Tag {
+	result := make([]*ecr.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &ecr.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// EcrKeyValueTags creates KeyValueTags from ecr service tags.
+func EcrKeyValueTags(tags []*ecr.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
+// EcsTags returns ecs service tags.
+func (tags KeyValueTags) EcsTags() []*ecs.Tag {
+	result := make([]*ecs.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &ecs.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// EcsKeyValueTags creates KeyValueTags from ecs service tags.
+func EcsKeyValueTags(tags []*ecs.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
+// EfsTags returns efs service tags.
+func (tags KeyValueTags) EfsTags() []*efs.Tag {
+	result := make([]*efs.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &efs.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// EfsKeyValueTags creates KeyValueTags from efs service tags.
+func EfsKeyValueTags(tags []*efs.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
+// ElasticacheTags returns elasticache service tags.
+func (tags KeyValueTags) ElasticacheTags() []*elasticache.Tag {
+	result := make([]*elasticache.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &elasticache.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// ElasticacheKeyValueTags creates KeyValueTags from elasticache service tags.
+func ElasticacheKeyValueTags(tags []*elasticache.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
+// ElasticbeanstalkTags returns elasticbeanstalk service tags.
+func (tags KeyValueTags) ElasticbeanstalkTags() []*elasticbeanstalk.Tag {
+	result := make([]*elasticbeanstalk.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &elasticbeanstalk.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// ElasticbeanstalkKeyValueTags creates KeyValueTags from elasticbeanstalk service tags.
+func ElasticbeanstalkKeyValueTags(tags []*elasticbeanstalk.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
+// ElasticsearchserviceTags returns elasticsearchservice service tags.
+func (tags KeyValueTags) ElasticsearchserviceTags() []*elasticsearchservice.Tag {
+	result := make([]*elasticsearchservice.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &elasticsearchservice.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// ElasticsearchserviceKeyValueTags creates KeyValueTags from elasticsearchservice service tags.
+func ElasticsearchserviceKeyValueTags(tags []*elasticsearchservice.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
+// ElbTags returns elb service tags.
+func (tags KeyValueTags) ElbTags() []*elb.Tag {
+	result := make([]*elb.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &elb.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// ElbKeyValueTags creates KeyValueTags from elb service tags.
+func ElbKeyValueTags(tags []*elb.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
+// Elbv2Tags returns elbv2 service tags.
+func (tags KeyValueTags) Elbv2Tags() []*elbv2.Tag {
+	result := make([]*elbv2.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &elbv2.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// Elbv2KeyValueTags creates KeyValueTags from elbv2 service tags.
+func Elbv2KeyValueTags(tags []*elbv2.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
+// FirehoseTags returns firehose service tags.
+func (tags KeyValueTags) FirehoseTags() []*firehose.Tag {
+	result := make([]*firehose.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &firehose.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// FirehoseKeyValueTags creates KeyValueTags from firehose service tags.
+func FirehoseKeyValueTags(tags []*firehose.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
+// FsxTags returns fsx service tags.
+func (tags KeyValueTags) FsxTags() []*fsx.Tag {
+	result := make([]*fsx.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &fsx.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// FsxKeyValueTags creates KeyValueTags from fsx service tags.
+func FsxKeyValueTags(tags []*fsx.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
+// IamTags returns iam service tags.
+func (tags KeyValueTags) IamTags() []*iam.Tag {
+	result := make([]*iam.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &iam.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// IamKeyValueTags creates KeyValueTags from iam service tags.
+func IamKeyValueTags(tags []*iam.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
+// InspectorTags returns inspector service tags.
+func (tags KeyValueTags) InspectorTags() []*inspector.ResourceGroupTag {
+	result := make([]*inspector.ResourceGroupTag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &inspector.ResourceGroupTag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// InspectorKeyValueTags creates KeyValueTags from inspector service tags.
+func InspectorKeyValueTags(tags []*inspector.ResourceGroupTag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
+// KinesisTags returns kinesis service tags.
+func (tags KeyValueTags) KinesisTags() []*kinesis.Tag {
+	result := make([]*kinesis.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &kinesis.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// KinesisKeyValueTags creates KeyValueTags from kinesis service tags.
+func KinesisKeyValueTags(tags []*kinesis.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
+// KmsTags returns kms service tags.
+func (tags KeyValueTags) KmsTags() []*kms.Tag {
+	result := make([]*kms.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &kms.Tag{
+			TagKey:   aws.String(k),
+			TagValue: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// KmsKeyValueTags creates KeyValueTags from kms service tags.
+func KmsKeyValueTags(tags []*kms.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.TagKey)] = tag.TagValue
+	}
+
+	return New(m)
+}
+
+// LicensemanagerTags returns licensemanager service tags.
+func (tags KeyValueTags) LicensemanagerTags() []*licensemanager.Tag {
+	result := make([]*licensemanager.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &licensemanager.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// LicensemanagerKeyValueTags creates KeyValueTags from licensemanager service tags.
+func LicensemanagerKeyValueTags(tags []*licensemanager.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
+// NeptuneTags returns neptune service tags.
+func (tags KeyValueTags) NeptuneTags() []*neptune.Tag {
+	result := make([]*neptune.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &neptune.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// NeptuneKeyValueTags creates KeyValueTags from neptune service tags.
+func NeptuneKeyValueTags(tags []*neptune.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
+// RamTags returns ram service tags.
+func (tags KeyValueTags) RamTags() []*ram.Tag {
+	result := make([]*ram.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &ram.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// RamKeyValueTags creates KeyValueTags from ram service tags.
+func RamKeyValueTags(tags []*ram.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
+// RdsTags returns rds service tags.
+func (tags KeyValueTags) RdsTags() []*rds.Tag {
+	result := make([]*rds.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &rds.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// RdsKeyValueTags creates KeyValueTags from rds service tags.
+func RdsKeyValueTags(tags []*rds.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
+// RedshiftTags returns redshift service tags.
+func (tags KeyValueTags) RedshiftTags() []*redshift.Tag {
+	result := make([]*redshift.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &redshift.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// RedshiftKeyValueTags creates KeyValueTags from redshift service tags.
+func RedshiftKeyValueTags(tags []*redshift.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
+// Route53Tags returns route53 service tags.
+func (tags KeyValueTags) Route53Tags() []*route53.Tag {
+	result := make([]*route53.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &route53.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// Route53KeyValueTags creates KeyValueTags from route53 service tags.
+func Route53KeyValueTags(tags []*route53.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
+// Route53resolverTags returns route53resolver service tags.
+func (tags KeyValueTags) Route53resolverTags() []*route53resolver.Tag {
+	result := make([]*route53resolver.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &route53resolver.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// Route53resolverKeyValueTags creates KeyValueTags from route53resolver service tags.
+func Route53resolverKeyValueTags(tags []*route53resolver.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
+// S3Tags returns s3 service tags.
+func (tags KeyValueTags) S3Tags() []*s3.Tag {
+	result := make([]*s3.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &s3.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// S3KeyValueTags creates KeyValueTags from s3 service tags.
+func S3KeyValueTags(tags []*s3.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
+// SagemakerTags returns sagemaker service tags.
+func (tags KeyValueTags) SagemakerTags() []*sagemaker.Tag {
+	result := make([]*sagemaker.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &sagemaker.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// SagemakerKeyValueTags creates KeyValueTags from sagemaker service tags.
+func SagemakerKeyValueTags(tags []*sagemaker.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
+// SecretsmanagerTags returns secretsmanager service tags.
+func (tags KeyValueTags) SecretsmanagerTags() []*secretsmanager.Tag {
+	result := make([]*secretsmanager.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &secretsmanager.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// SecretsmanagerKeyValueTags creates KeyValueTags from secretsmanager service tags.
+func SecretsmanagerKeyValueTags(tags []*secretsmanager.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
+// SfnTags returns sfn service tags.
+func (tags KeyValueTags) SfnTags() []*sfn.Tag {
+	result := make([]*sfn.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &sfn.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// SfnKeyValueTags creates KeyValueTags from sfn service tags.
+func SfnKeyValueTags(tags []*sfn.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
+// SsmTags returns ssm service tags.
+func (tags KeyValueTags) SsmTags() []*ssm.Tag {
+	result := make([]*ssm.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &ssm.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// SsmKeyValueTags creates KeyValueTags from ssm service tags.
+func SsmKeyValueTags(tags []*ssm.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
+// TransferTags returns transfer service tags.
+func (tags KeyValueTags) TransferTags() []*transfer.Tag {
+	result := make([]*transfer.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &transfer.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// TransferKeyValueTags creates KeyValueTags from transfer service tags.
+func TransferKeyValueTags(tags []*transfer.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
@@ -0,0 +1,1298 @@
+// Code generated by generators/servicetags/main.go; DO NOT EDIT.
+
+package keyvaluetags
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/aws-sdk-go/service/acmpca"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
+	"github.com/aws/aws-sdk-go/service/codebuild"
+	"github.com/aws/aws-sdk-go/service/databasemigrationservice"
+	"github.com/aws/aws-sdk-go/service/datasync"
+	"github.com/aws/aws-sdk-go/service/dax"
+	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/aws/aws-sdk-go/service/docdb"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	"github.com/aws/aws-sdk-go/service/elasticsearchservice"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/firehose"
+	"github.com/aws/aws-sdk-go/service/fsx"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/inspector"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/licensemanager"
+	"github.com/aws/aws-sdk-go/service/neptune"
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/route53resolver"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/transfer"
+)
+
+// map[string]*string handling
+
+func TestApigatewayKeyValueTags(t *testing.T) {
+	tags := map[string]*string{
+		"key1": aws.String("value1"),
+		"key2": aws.String("value2"),
+	}
+
+	got := ApigatewayKeyValueTags(tags)
+
+	if !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected tags: %#v", got.Map())
+	}
+
+	if got := ApigatewayKeyValueTags(got.ApigatewayTags()); !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected round trip tags: %#v", got.Map())
+	}
+}
+
+func TestLambdaKeyValueTags(t *testing.T) {
+	tags := map[string]*string{
+		"key1": aws.String("value1"),
+		"key2": aws.String("value2"),
+	}
+
+	got := LambdaKeyValueTags(tags)
+
+	if !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected tags: %#v", got.Map())
+	}
+
+	if got := LambdaKeyValueTags(got.LambdaTags()); !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected round trip tags: %#v", got.Map())
+	}
+}
+
+func TestMediapackageKeyValueTags(t *testing.T) {
+	tags := map[string]*string{
+		"key1": aws.String("value1"),
+		"key2": aws.String("value2"),
+	}
+
+	got := MediapackageKeyValueTags(tags)
+
+	if !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected tags: %#v", got.Map())
+	}
+
+	if got := MediapackageKeyValueTags(got.MediapackageTags()); !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected round trip tags: %#v", got.Map())
+	}
+}
+
+func TestMqKeyValueTags(t *testing.T) {
+	tags := map[string]*string{
+		"key1": aws.String("value1"),
+		"key2": aws.String("value2"),
+	}
+
+	got := MqKeyValueTags(tags)
+
+	if !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected tags: %#v", got.Map())
+	}
+
+	if got := MqKeyValueTags(got.MqTags()); !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected round trip tags: %#v", got.Map())
+	}
+}
+
+func TestOpsworksKeyValueTags(t *testing.T) {
+	tags := map[string]*string{
+		"key1": aws.String("value1"),
+		"key2": aws.String("value2"),
+	}
+
+	got := OpsworksKeyValueTags(tags)
+
+	if !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected tags: %#v", got.Map())
+	}
+
+	if got := OpsworksKeyValueTags(got.OpsworksTags()); !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected round trip tags: %#v", got.Map())
+	}
+}
+
+// []*SERVICE.Tag handling
+
+func TestAcmKeyValueTags(t *testing.T) {
+	tags := []*acm.Tag{
+		{
+			Key:   aws.String("key1"),
+			Value: aws.String("value1"),
+		},
+		{
+			Key:   aws.String("key2"),
+			Value: aws.String("value2"),
+		},
+	}
+
+	got := AcmKeyValueTags(tags)
+
+	if !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected tags: %#v", got.Map())
+	}
+
+	serviceTags := got.AcmTags()
+
+	if len(serviceTags) != len(tags) {
+		t.Fatalf("unexpected number of acm tags: %d", len(serviceTags))
+	}
+
+	if got := AcmKeyValueTags(serviceTags); !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected round trip tags: %#v", got.Map())
+	}
+}
+
+func TestAcmpcaKeyValueTags(t *testing.T) {
+	tags := []*acmpca.Tag{
+		{
+			Key:   aws.String("key1"),
+			Value: aws.String("value1"),
+		},
+		{
+			Key:   aws.String("key2"),
+			Value: aws.String("value2"),
+		},
+	}
+
+	got := AcmpcaKeyValueTags(tags)
+
+	if !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected tags: %#v", got.Map())
+	}
+
+	serviceTags := got.AcmpcaTags()
+
+	if len(serviceTags) != len(tags) {
+		t.Fatalf("unexpected number of acmpca tags: %d", len(serviceTags))
+	}
+
+	if got := AcmpcaKeyValueTags(serviceTags); !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected round trip tags: %#v", got.Map())
+	}
+}
+
+func TestCloudfrontKeyValueTags(t *testing.T) {
+	tags := []*cloudfront.Tag{
+		{
+			Key:   aws.String("key1"),
+			Value: aws.String("value1"),
+		},
+		{
+			Key:   aws.String("key2"),
+			Value: aws.String("value2"),
+		},
+	}
+
+	got := CloudfrontKeyValueTags(tags)
+
+	if !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected tags: %#v", got.Map())
+	}
+
+	serviceTags := got.CloudfrontTags()
+
+	if len(serviceTags) != len(tags) {
+		t.Fatalf("unexpected number of cloudfront tags: %d", len(serviceTags))
+	}
+
+	if got := CloudfrontKeyValueTags(serviceTags); !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected round trip tags: %#v", got.Map())
+	}
+}
+
+func TestCloudtrailKeyValueTags(t *testing.T) {
+	tags := []*cloudtrail.Tag{
+		{
+			Key:   aws.String("key1"),
+			Value: aws.String("value1"),
+		},
+		{
+			Key:   aws.String("key2"),
+			Value: aws.String("value2"),
+		},
+	}
+
+	got := CloudtrailKeyValueTags(tags)
+
+	if !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected tags: %#v", got.Map())
+	}
+
+	serviceTags := got.CloudtrailTags()
+
+	if len(serviceTags) != len(tags) {
+		t.Fatalf("unexpected number of cloudtrail tags: %d", len(serviceTags))
+	}
+
+	if got := CloudtrailKeyValueTags(serviceTags); !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected round trip tags: %#v", got.Map())
+	}
+}
+
+func TestCodebuildKeyValueTags(t *testing.T) {
+	tags := []*codebuild.Tag{
+		{
+			Key:   aws.String("key1"),
+			Value: aws.String("value1"),
+		},
+		{
+			Key:   aws.String("key2"),
+			Value: aws.String("value2"),
+		},
+	}
+
+	got := CodebuildKeyValueTags(tags)
+
+	if !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected tags: %#v", got.Map())
+	}
+
+	serviceTags := got.CodebuildTags()
+
+	if len(serviceTags) != len(tags) {
+		t.Fatalf("unexpected number of codebuild tags: %d", len(serviceTags))
+	}
+
+	if got := CodebuildKeyValueTags(serviceTags); !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected round trip tags: %#v", got.Map())
+	}
+}
+
+func TestDatabasemigrationserviceKeyValueTags(t *testing.T) {
+	tags := []*databasemigrationservice.Tag{
+		{
+			Key:   aws.String("key1"),
+			Value: aws.String("value1"),
+		},
+		{
+			Key:   aws.String("key2"),
+			Value: aws.String("value2"),
+		},
+	}
+
+	got := DatabasemigrationserviceKeyValueTags(tags)
+
+	if !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected tags: %#v", got.Map())
+	}
+
+	serviceTags := got.DatabasemigrationserviceTags()
+
+	if len(serviceTags) != len(tags) {
+		t.Fatalf("unexpected number of databasemigrationservice tags: %d", len(serviceTags))
+	}
+
+	if got := DatabasemigrationserviceKeyValueTags(serviceTags); !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected round trip tags: %#v", got.Map())
+	}
+}
+
+func TestDatasyncKeyValueTags(t *testing.T) {
+	tags := []*datasync.TagListEntry{
+		{
+			Key:   aws.String("key1"),
+			Value: aws.String("value1"),
+		},
+		{
+			Key:   aws.String("key2"),
+			Value: aws.String("value2"),
+		},
+	}
+
+	got := DatasyncKeyValueTags(tags)
+
+	if !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected tags: %#v", got.Map())
+	}
+
+	serviceTags := got.DatasyncTags()
+
+	if len(serviceTags) != len(tags) {
+		t.Fatalf("unexpected number of datasync tags: %d", len(serviceTags))
+	}
+
+	if got := DatasyncKeyValueTags(serviceTags); !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected round trip tags: %#v", got.Map())
+	}
+}
+
+func TestDaxKeyValueTags(t *testing.T) {
+	tags := []*dax.Tag{
+		{
+			Key:   aws.String("key1"),
+			Value: aws.String("value1"),
+		},
+		{
+			Key:   aws.String("key2"),
+			Value: aws.String("value2"),
+		},
+	}
+
+	got := DaxKeyValueTags(tags)
+
+	if !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected tags: %#v", got.Map())
+	}
+
+	serviceTags := got.DaxTags()
+
+	if len(serviceTags) != len(tags) {
+		t.Fatalf("unexpected number of dax tags: %d", len(serviceTags))
+	}
+
+	if got := DaxKeyValueTags(serviceTags); !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected round trip tags: %#v", got.Map())
+	}
+}
+
+func TestDirectconnectKeyValueTags(t *testing.T) {
+	tags := []*directconnect.Tag{
+		{
+			Key:   aws.String("key1"),
+			Value: aws.String("value1"),
+		},
+		{
+			Key:   aws.String("key2"),
+			Value: aws.String("value2"),
+		},
+	}
+
+	got := DirectconnectKeyValueTags(tags)
+
+	if !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected tags: %#v", got.Map())
+	}
+
+	serviceTags := got.DirectconnectTags()
+
+	if len(serviceTags) != len(tags) {
+		t.Fatalf("unexpected number of directconnect tags: %d", len(serviceTags))
+	}
+
+	if got := DirectconnectKeyValueTags(serviceTags); !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected round trip tags: %#v", got.Map())
+	}
+}
+
+func TestDirectoryserviceKeyValueTags(t *testing.T) {
+	tags := []*directoryservice.Tag{
+		{
+			Key:   aws.String("key1"),
+			Value: aws.String("value1"),
+		},
+		{
+			Key:   aws.String("key2"),
+			Value: aws.String("value2"),
+		},
+	}
+
+	got := DirectoryserviceKeyValueTags(tags)
+
+	if !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected tags: %#v", got.Map())
+	}
+
+	serviceTags := got.DirectoryserviceTags()
+
+	if len(serviceTags) != len(tags) {
+		t.Fatalf("unexpected number of directoryservice tags: %d", len(serviceTags))
+	}
+
+	if got := DirectoryserviceKeyValueTags(serviceTags); !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected round trip tags: %#v", got.Map())
+	}
+}
+
+func TestDocdbKeyValueTags(t *testing.T) {
+	tags := []*docdb.Tag{
+		{
+			Key:   aws.String("key1"),
+			Value: aws.String("value1"),
+		},
+		{
+			Key:   aws.String("key2"),
+			Value: aws.String("value2"),
+		},
+	}
+
+	got := DocdbKeyValueTags(tags)
+
+	if !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected tags: %#v", got.Map())
+	}
+
+	serviceTags := got.DocdbTags()
+
+	if len(serviceTags) != len(tags) {
+		t.Fatalf("unexpected number of docdb tags: %d", len(serviceTags))
+	}
+
+	if got := DocdbKeyValueTags(serviceTags); !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected round trip tags: %#v", got.Map())
+	}
+}
+
+func TestDynamodbKeyValueTags(t *testing.T) {
+	tags := []*dynamodb.Tag{
+		{
+			Key:   aws.String("key1"),
+			Value: aws.String("value1"),
+		},
+		{
+			Key:   aws.String("key2"),
+			Value: aws.String("value2"),
+		},
+	}
+
+	got := DynamodbKeyValueTags(tags)
+
+	if !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected tags: %#v", got.Map())
+	}
+
+	serviceTags := got.DynamodbTags()
+
+	if len(serviceTags) != len(tags) {
+		t.Fatalf("unexpected number of dynamodb tags: %d", len(serviceTags))
+	}
+
+	if got := DynamodbKeyValueTags(serviceTags); !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected round trip tags: %#v", got.Map())
+	}
+}
+
+func TestEc2KeyValueTags(t *testing.T) {
+	tags := []*ec2.Tag{
+		{
+			Key:   aws.String("key1"),
+			Value: aws.String("value1"),
+		},
+		{
+			Key:   aws.String("key2"),
+			Value: aws.String("value2"),
+		},
+	}
+
+	got := Ec2KeyValueTags(tags)
+
+	if !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected tags: %#v", got.Map())
+	}
+
+	serviceTags := got.Ec2Tags()
+
+	if len(serviceTags) != len(tags) {
+		t.Fatalf("unexpected number of ec2 tags: %d", len(serviceTags))
+	}
+
+	if got := Ec2KeyValueTags(serviceTags); !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected round trip tags: %#v", got.Map())
+	}
+}
+
+func TestEcrKeyValueTags(t *testing.T) {
+	tags := []*ecr.Tag{
+		{
+			Key:   aws.String("key1"),
+			Value: aws.String("value1"),
+		},
+		{
+			Key:   aws.String("key2"),
+			Value: aws.String("value2"),
+		},
+	}
+
+	got := EcrKeyValueTags(tags)
+
+	if !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected tags: %#v", got.Map())
+	}
+
+	serviceTags := got.EcrTags()
+
+	if len(serviceTags) != len(tags) {
+		t.Fatalf("unexpected number of ecr tags: %d", len(serviceTags))
+	}
+
+	if got := EcrKeyValueTags(serviceTags); !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected round trip tags: %#v", got.Map())
+	}
+}
+
+func TestEcsKeyValueTags(t *testing.T) {
+	tags := []*ecs.Tag{
+		{
+			Key:   aws.String("key1"),
+			Value: aws.String("value1"),
+		},
+		{
+			Key:   aws.String("key2"),
+			Value: aws.String("value2"),
+		},
+	}
+
+	got := EcsKeyValueTags(tags)
+
+	if !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected tags: %#v", got.Map())
+	}
+
+	serviceTags := got.EcsTags()
+
+	if len(serviceTags) != len(tags) {
+		t.Fatalf("unexpected number of ecs tags: %d", len(serviceTags))
+	}
+
+	if got := EcsKeyValueTags(serviceTags); !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected round trip tags: %#v", got.Map())
+	}
+}
+
+func TestEfsKeyValueTags(t *testing.T) {
+	tags := []*efs.Tag{
+		{
+			Key:   aws.String("key1"),
+			Value: aws.String("value1"),
+		},
+		{
+			Key:   aws.String("key2"),
+			Value: aws.String("value2"),
+		},
+	}
+
+	got := EfsKeyValueTags(tags)
+
+	if !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected tags: %#v", got.Map())
+	}
+
+	serviceTags := got.EfsTags()
+
+	if len(serviceTags) != len(tags) {
+		t.Fatalf("unexpected number of efs tags: %d", len(serviceTags))
+	}
+
+	if got := EfsKeyValueTags(serviceTags); !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected round trip tags: %#v", got.Map())
+	}
+}
+
+func TestElasticacheKeyValueTags(t *testing.T) {
+	tags := []*elasticache.Tag{
+		{
+			Key:   aws.String("key1"),
+			Value: aws.String("value1"),
+		},
+		{
+			Key:   aws.String("key2"),
+			Value: aws.String("value2"),
+		},
+	}
+
+	got := ElasticacheKeyValueTags(tags)
+
+	if !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected tags: %#v", got.Map())
+	}
+
+	serviceTags := got.ElasticacheTags()
+
+	if len(serviceTags) != len(tags) {
+		t.Fatalf("unexpected number of elasticache tags: %d", len(serviceTags))
+	}
+
+	if got := ElasticacheKeyValueTags(serviceTags); !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected round trip tags: %#v", got.Map())
+	}
+}
+
+func TestElasticbeanstalkKeyValueTags(t *testing.T) {
+	tags := []*elasticbeanstalk.Tag{
+		{
+			Key:   aws.String("key1"),
+			Value: aws.String("value1"),
+		},
+		{
+			Key:   aws.String("key2"),
+			Value: aws.String("value2"),
+		},
+	}
+
+	got := ElasticbeanstalkKeyValueTags(tags)
+
+	if !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected tags: %#v", got.Map())
+	}
+
+	serviceTags := got.ElasticbeanstalkTags()
+
+	if len(serviceTags) != len(tags) {
+		t.Fatalf("unexpected number of elasticbeanstalk tags: %d", len(serviceTags))
+	}
+
+	if got := ElasticbeanstalkKeyValueTags(serviceTags); !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected round trip tags: %#v", got.Map())
+	}
+}
+
+func TestElasticsearchserviceKeyValueTags(t *testing.T) {
+	tags := []*elasticsearchservice.Tag{
+		{
+			Key:   aws.String("key1"),
+			Value: aws.String("value1"),
+		},
+		{
+			Key:   aws.String("key2"),
+			Value: aws.String("value2"),
+		},
+	}
+
+	got := ElasticsearchserviceKeyValueTags(tags)
+
+	if !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected tags: %#v", got.Map())
+	}
+
+	serviceTags := got.ElasticsearchserviceTags()
+
+	if len(serviceTags) != len(tags) {
+		t.Fatalf("unexpected number of elasticsearchservice tags: %d", len(serviceTags))
+	}
+
+	if got := ElasticsearchserviceKeyValueTags(serviceTags); !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected round trip tags: %#v", got.Map())
+	}
+}
+
+func TestElbKeyValueTags(t *testing.T) {
+	tags := []*elb.Tag{
+		{
+			Key:   aws.String("key1"),
+			Value: aws.String("value1"),
+		},
+		{
+			Key:   aws.String("key2"),
+			Value: aws.String("value2"),
+		},
+	}
+
+	got := ElbKeyValueTags(tags)
+
+	if !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected tags: %#v", got.Map())
+	}
+
+	serviceTags := got.ElbTags()
+
+	if len(serviceTags) != len(tags) {
+		t.Fatalf("unexpected number of elb tags: %d", len(serviceTags))
+	}
+
+	if got := ElbKeyValueTags(serviceTags); !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected round trip tags: %#v", got.Map())
+	}
+}
+
+func TestElbv2KeyValueTags(t *testing.T) {
+	tags := []*elbv2.Tag{
+		{
+			Key:   aws.String("key1"),
+			Value: aws.String("value1"),
+		},
+		{
+			Key:   aws.String("key2"),
+			Value: aws.String("value2"),
+		},
+	}
+
+	got := Elbv2KeyValueTags(tags)
+
+	if !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected tags: %#v", got.Map())
+	}
+
+	serviceTags := got.Elbv2Tags()
+
+	if len(serviceTags) != len(tags) {
+		t.Fatalf("unexpected number of elbv2 tags: %d", len(serviceTags))
+	}
+
+	if got := Elbv2KeyValueTags(serviceTags); !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected round trip tags: %#v", got.Map())
+	}
+}
+
+func TestFirehoseKeyValueTags(t *testing.T) {
+	tags := []*firehose.Tag{
+		{
+			Key:   aws.String("key1"),
+			Value: aws.String("value1"),
+		},
+		{
+			Key:   aws.String("key2"),
+			Value: aws.String("value2"),
+		},
+	}
+
+	got := FirehoseKeyValueTags(tags)
+
+	if !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected tags: %#v", got.Map())
+	}
+
+	serviceTags := got.FirehoseTags()
+
+	if len(serviceTags) != len(tags) {
+		t.Fatalf("unexpected number of firehose tags: %d", len(serviceTags))
+	}
+
+	if got := FirehoseKeyValueTags(serviceTags); !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected round trip tags: %#v", got.Map())
+	}
+}
+
+func TestFsxKeyValueTags(t *testing.T) {
+	tags := []*fsx.Tag{
+		{
+			Key:   aws.String("key1"),
+			Value: aws.String("value1"),
+		},
+		{
+			Key:   aws.String("key2"),
+			Value: aws.String("value2"),
+		},
+	}
+
+	got := FsxKeyValueTags(tags)
+
+	if !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected tags: %#v", got.Map())
+	}
+
+	serviceTags := got.FsxTags()
+
+	if len(serviceTags) != len(tags) {
+		t.Fatalf("unexpected number of fsx tags: %d", len(serviceTags))
+	}
+
+	if got := FsxKeyValueTags(serviceTags); !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected round trip tags: %#v", got.Map())
+	}
+}
+
+func TestIamKeyValueTags(t *testing.T) {
+	tags := []*iam.Tag{
+		{
+			Key:   aws.String("key1"),
+			Value: aws.String("value1"),
+		},
+		{
+			Key:   aws.String("key2"),
+			Value: aws.String("value2"),
+		},
+	}
+
+	got := IamKeyValueTags(tags)
+
+	if !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected tags: %#v", got.Map())
+	}
+
+	serviceTags := got.IamTags()
+
+	if len(serviceTags) != len(tags) {
+		t.Fatalf("unexpected number of iam tags: %d", len(serviceTags))
+	}
+
+	if got := IamKeyValueTags(serviceTags); !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected round trip tags: %#v", got.Map())
+	}
+}
+
+func TestInspectorKeyValueTags(t *testing.T) {
+	tags := []*inspector.ResourceGroupTag{
+		{
+			Key:   aws.String("key1"),
+			Value: aws.String("value1"),
+		},
+		{
+			Key:   aws.String("key2"),
+			Value: aws.String("value2"),
+		},
+	}
+
+	got := InspectorKeyValueTags(tags)
+
+	if !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected tags: %#v", got.Map())
+	}
+
+	serviceTags := got.InspectorTags()
+
+	if len(serviceTags) != len(tags) {
+		t.Fatalf("unexpected number of inspector tags: %d", len(serviceTags))
+	}
+
+	if got := InspectorKeyValueTags(serviceTags); !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected round trip tags: %#v", got.Map())
+	}
+}
+
+func TestKinesisKeyValueTags(t *testing.T) {
+	tags := []*kinesis.Tag{
+		{
+			Key:   aws.String("key1"),
+			Value: aws.String("value1"),
+		},
+		{
+			Key:   aws.String("key2"),
+			Value: aws.String("value2"),
+		},
+	}
+
+	got := KinesisKeyValueTags(tags)
+
+	if !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected tags: %#v", got.Map())
+	}
+
+	serviceTags := got.KinesisTags()
+
+	if len(serviceTags) != len(tags) {
+		t.Fatalf("unexpected number of kinesis tags: %d", len(serviceTags))
+	}
+
+	if got := KinesisKeyValueTags(serviceTags); !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected round trip tags: %#v", got.Map())
+	}
+}
+
+func TestKmsKeyValueTags(t *testing.T) {
+	tags := []*kms.Tag{
+		{
+			TagKey:   aws.String("key1"),
+			TagValue: aws.String("value1"),
+		},
+		{
+			TagKey:   aws.String("key2"),
+			TagValue: aws.String("value2"),
+		},
+	}
+
+	got := KmsKeyValueTags(tags)
+
+	if !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected tags: %#v", got.Map())
+	}
+
+	serviceTags := got.KmsTags()
+
+	if len(serviceTags) != len(tags) {
+		t.Fatalf("unexpected number of kms tags: %d", len(serviceTags))
+	}
+
+	if got := KmsKeyValueTags(serviceTags); !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected round trip tags: %#v", got.Map())
+	}
+}
+
+func TestLicensemanagerKeyValueTags(t *testing.T) {
+	tags := []*licensemanager.Tag{
+		{
+			Key:   aws.String("key1"),
+			Value: aws.String("value1"),
+		},
+		{
+			Key:   aws.String("key2"),
+			Value: aws.String("value2"),
+		},
+	}
+
+	got := LicensemanagerKeyValueTags(tags)
+
+	if !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected tags: %#v", got.Map())
+	}
+
+	serviceTags := got.LicensemanagerTags()
+
+	if len(serviceTags) != len(tags) {
+		t.Fatalf("unexpected number of licensemanager tags: %d", len(serviceTags))
+	}
+
+	if got := LicensemanagerKeyValueTags(serviceTags); !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected round trip tags: %#v", got.Map())
+	}
+}
+
+func TestNeptuneKeyValueTags(t *testing.T) {
+	tags := []*neptune.Tag{
+		{
+			Key:   aws.String("key1"),
+			Value: aws.String("value1"),
+		},
+		{
+			Key:   aws.String("key2"),
+			Value: aws.String("value2"),
+		},
+	}
+
+	got := NeptuneKeyValueTags(tags)
+
+	if !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected tags: %#v", got.Map())
+	}
+
+	serviceTags := got.NeptuneTags()
+
+	if len(serviceTags) != len(tags) {
+		t.Fatalf("unexpected number of neptune tags: %d", len(serviceTags))
+	}
+
+	if got := NeptuneKeyValueTags(serviceTags); !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected round trip tags: %#v", got.Map())
+	}
+}
+
+func TestRamKeyValueTags(t *testing.T) {
+	tags := []*ram.Tag{
+		{
+			Key:   aws.String("key1"),
+			Value: aws.String("value1"),
+		},
+		{
+			Key:   aws.String("key2"),
+			Value: aws.String("value2"),
+		},
+	}
+
+	got := RamKeyValueTags(tags)
+
+	if !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected tags: %#v", got.Map())
+	}
+
+	serviceTags := got.RamTags()
+
+	if len(serviceTags) != len(tags) {
+		t.Fatalf("unexpected number of ram tags: %d", len(serviceTags))
+	}
+
+	if got := RamKeyValueTags(serviceTags); !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected round trip tags: %#v", got.Map())
+	}
+}
+
+func TestRdsKeyValueTags(t *testing.T) {
+	tags := []*rds.Tag{
+		{
+			Key:   aws.String("key1"),
+			Value: aws.String("value1"),
+		},
+		{
+			Key:   aws.String("key2"),
+			Value: aws.String("value2"),
+		},
+	}
+
+	got := RdsKeyValueTags(tags)
+
+	if !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected tags: %#v", got.Map())
+	}
+
+	serviceTags := got.RdsTags()
+
+	if len(serviceTags) != len(tags) {
+		t.Fatalf("unexpected number of rds tags: %d", len(serviceTags))
+	}
+
+	if got := RdsKeyValueTags(serviceTags); !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected round trip tags: %#v", got.Map())
+	}
+}
+
+func TestRedshiftKeyValueTags(t *testing.T) {
+	tags := []*redshift.Tag{
+		{
+			Key:   aws.String("key1"),
+			Value: aws.String("value1"),
+		},
+		{
+			Key:   aws.String("key2"),
+			Value: aws.String("value2"),
+		},
+	}
+
+	got := RedshiftKeyValueTags(tags)
+
+	if !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected tags: %#v", got.Map())
+	}
+
+	serviceTags := got.RedshiftTags()
+
+	if len(serviceTags) != len(tags) {
+		t.Fatalf("unexpected number of redshift tags: %d", len(serviceTags))
+	}
+
+	if got := RedshiftKeyValueTags(serviceTags); !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected round trip tags: %#v", got.Map())
+	}
+}
+
+func TestRoute53KeyValueTags(t *testing.T) {
+	tags := []*route53.Tag{
+		{
+			Key:   aws.String("key1"),
+			Value: aws.String("value1"),
+		},
+		{
+			Key:   aws.String("key2"),
+			Value: aws.String("value2"),
+		},
+	}
+
+	got := Route53KeyValueTags(tags)
+
+	if !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected tags: %#v", got.Map())
+	}
+
+	serviceTags := got.Route53Tags()
+
+	if len(serviceTags) != len(tags) {
+		t.Fatalf("unexpected number of route53 tags: %d", len(serviceTags))
+	}
+
+	if got := Route53KeyValueTags(serviceTags); !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected round trip tags: %#v", got.Map())
+	}
+}
+
+func TestRoute53resolverKeyValueTags(t *testing.T) {
+	tags := []*route53resolver.Tag{
+		{
+			Key:   aws.String("key1"),
+			Value: aws.String("value1"),
+		},
+		{
+			Key:   aws.String("key2"),
+			Value: aws.String("value2"),
+		},
+	}
+
+	got := Route53resolverKeyValueTags(tags)
+
+	if !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected tags: %#v", got.Map())
+	}
+
+	serviceTags := got.Route53resolverTags()
+
+	if len(serviceTags) != len(tags) {
+		t.Fatalf("unexpected number of route53resolver tags: %d", len(serviceTags))
+	}
+
+	if got := Route53resolverKeyValueTags(serviceTags); !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected round trip tags: %#v", got.Map())
+	}
+}
+
+func TestS3KeyValueTags(t *testing.T) {
+	tags := []*s3.Tag{
+		{
+			Key:   aws.String("key1"),
+			Value: aws.String("value1"),
+		},
+		{
+			Key:   aws.String("key2"),
+			Value: aws.String("value2"),
+		},
+	}
+
+	got := S3KeyValueTags(tags)
+
+	if !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected tags: %#v", got.Map())
+	}
+
+	serviceTags := got.S3Tags()
+
+	if len(serviceTags) != len(tags) {
+		t.Fatalf("unexpected number of s3 tags: %d", len(serviceTags))
+	}
+
+	if got := S3KeyValueTags(serviceTags); !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected round trip tags: %#v", got.Map())
+	}
+}
+
+func TestSagemakerKeyValueTags(t *testing.T) {
+	tags := []*sagemaker.Tag{
+		{
+			Key:   aws.String("key1"),
+			Value: aws.String("value1"),
+		},
+		{
+			Key:   aws.String("key2"),
+			Value: aws.String("value2"),
+		},
+	}
+
+	got := SagemakerKeyValueTags(tags)
+
+	if !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected tags: %#v", got.Map())
+	}
+
+	serviceTags := got.SagemakerTags()
+
+	if len(serviceTags) != len(tags) {
+		t.Fatalf("unexpected number of sagemaker tags: %d", len(serviceTags))
+	}
+
+	if got := SagemakerKeyValueTags(serviceTags); !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected round trip tags: %#v", got.Map())
+	}
+}
+
+func TestSecretsmanagerKeyValueTags(t *testing.T) {
+	tags := []*secretsmanager.Tag{
+		{
+			Key:   aws.String("key1"),
+			Value: aws.String("value1"),
+		},
+		{
+			Key:   aws.String("key2"),
+			Value: aws.String("value2"),
+		},
+	}
+
+	got := SecretsmanagerKeyValueTags(tags)
+
+	if !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected tags: %#v", got.Map())
+	}
+
+	serviceTags := got.SecretsmanagerTags()
+
+	if len(serviceTags) != len(tags) {
+		t.Fatalf("unexpected number of secretsmanager tags: %d", len(serviceTags))
+	}
+
+	if got := SecretsmanagerKeyValueTags(serviceTags); !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected round trip tags: %#v", got.Map())
+	}
+}
+
+func TestSfnKeyValueTags(t *testing.T) {
+	tags := []*sfn.Tag{
+		{
+			Key:   aws.String("key1"),
+			Value: aws.String("value1"),
+		},
+		{
+			Key:   aws.String("key2"),
+			Value: aws.String("value2"),
+		},
+	}
+
+	got := SfnKeyValueTags(tags)
+
+	if !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected tags: %#v", got.Map())
+	}
+
+	serviceTags := got.SfnTags()
+
+	if len(serviceTags) != len(tags) {
+		t.Fatalf("unexpected number of sfn tags: %d", len(serviceTags))
+	}
+
+	if got := SfnKeyValueTags(serviceTags); !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected round trip tags: %#v", got.Map())
+	}
+}
+
+func TestSsmKeyValueTags(t *testing.T) {
+	tags := []*ssm.Tag{
+		{
+			Key:   aws.String("key1"),
+			Value: aws.String("value1"),
+		},
+		{
+			Key:   aws.String("key2"),
+			Value: aws.String("value2"),
+		},
+	}
+
+	got := SsmKeyValueTags(tags)
+
+	if !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected tags: %#v", got.Map())
+	}
+
+	serviceTags := got.SsmTags()
+
+	if len(serviceTags) != len(tags) {
+		t.Fatalf("unexpected number of ssm tags: %d", len(serviceTags))
+	}
+
+	if got := SsmKeyValueTags(serviceTags); !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected round trip tags: %#v", got.Map())
+	}
+}
+
+func TestTransferKeyValueTags(t *testing.T) {
+	tags := []*transfer.Tag{
+		{
+			Key:   aws.String("key1"),
+			Value: aws.String("value1"),
+		},
+		{
+			Key:   aws.String("key2"),
+			Value: aws.String("value2"),
+		},
+	}
+
+	got := TransferKeyValueTags(tags)
+
+	if !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected tags: %#v", got.Map())
+	}
+
+	serviceTags := got.TransferTags()
+
+	if len(serviceTags) != len(tags) {
+		t.Fatalf("unexpected number of transfer tags: %d", len(serviceTags))
+	}
+
+	if got := TransferKeyValueTags(serviceTags); !got.Equal(testKeyValueTags()) {
+		t.Fatalf("unexpected round trip tags: %#v", got.Map())
+	}
+}
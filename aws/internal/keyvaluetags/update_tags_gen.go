@@ -0,0 +1,1317 @@
+// Code generated by generators/updatetags/main.go; DO NOT EDIT.
+
+package keyvaluetags
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/aws-sdk-go/service/acmpca"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
+	"github.com/aws/aws-sdk-go/service/databasemigrationservice"
+	"github.com/aws/aws-sdk-go/service/datasync"
+	"github.com/aws/aws-sdk-go/service/dax"
+	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/aws/aws-sdk-go/service/docdb"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticsearchservice"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/firehose"
+	"github.com/aws/aws-sdk-go/service/fsx"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/licensemanager"
+	"github.com/aws/aws-sdk-go/service/mediapackage"
+	"github.com/aws/aws-sdk-go/service/mq"
+	"github.com/aws/aws-sdk-go/service/neptune"
+	"github.com/aws/aws-sdk-go/service/opsworks"
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/aws/aws-sdk-go/service/route53resolver"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/aws/aws-sdk-go/service/transfer"
+)
+
+// AcmUpdateTags updates acm service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func AcmUpdateTags(conn *acm.ACM, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &acm.RemoveTagsFromCertificateInput{
+			CertificateArn: aws.String(identifier),
+			Tags:           removedTags.AcmTags(),
+		}
+
+		_, err := conn.RemoveTagsFromCertificate(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &acm.AddTagsToCertificateInput{
+			CertificateArn: aws.String(identifier),
+			Tags:           updatedTags.AcmTags(),
+		}
+
+		_, err := conn.AddTagsToCertificate(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// AcmpcaUpdateTags updates acmpca service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func AcmpcaUpdateTags(conn *acmpca.ACMPCA, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &acmpca.UntagCertificateAuthorityInput{
+			CertificateAuthorityArn: aws.String(identifier),
+			Tags:                    removedTags.AcmpcaTags(),
+		}
+
+		_, err := conn.UntagCertificateAuthority(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &acmpca.TagCertificateAuthorityInput{
+			CertificateAuthorityArn: aws.String(identifier),
+			Tags:                    updatedTags.AcmpcaTags(),
+		}
+
+		_, err := conn.TagCertificateAuthority(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// ApigatewayUpdateTags updates apigateway service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func ApigatewayUpdateTags(conn *apigateway.APIGateway, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &apigateway.UntagResourceInput{
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &apigateway.TagResourceInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        updatedTags.ApigatewayTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// CloudtrailUpdateTags updates cloudtrail service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func CloudtrailUpdateTags(conn *cloudtrail.CloudTrail, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &cloudtrail.RemoveTagsInput{
+			ResourceId: aws.String(identifier),
+			TagsList:   removedTags.CloudtrailTags(),
+		}
+
+		_, err := conn.RemoveTags(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &cloudtrail.AddTagsInput{
+			ResourceId: aws.String(identifier),
+			TagsList:   updatedTags.CloudtrailTags(),
+		}
+
+		_, err := conn.AddTags(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// DatabasemigrationserviceUpdateTags updates databasemigrationservice service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func DatabasemigrationserviceUpdateTags(conn *databasemigrationservice.DatabaseMigrationService, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &databasemigrationservice.RemoveTagsFromResourceInput{
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.RemoveTagsFromResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &databasemigrationservice.AddTagsToResourceInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        updatedTags.DatabasemigrationserviceTags(),
+		}
+
+		_, err := conn.AddTagsToResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// DatasyncUpdateTags updates datasync service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func DatasyncUpdateTags(conn *datasync.DataSync, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &datasync.UntagResourceInput{
+			ResourceArn: aws.String(identifier),
+			Keys:        aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &datasync.TagResourceInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        updatedTags.DatasyncTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// DaxUpdateTags updates dax service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func DaxUpdateTags(conn *dax.DAX, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &dax.UntagResourceInput{
+			ResourceName: aws.String(identifier),
+			TagKeys:      aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &dax.TagResourceInput{
+			ResourceName: aws.String(identifier),
+			Tags:         updatedTags.DaxTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// DirectconnectUpdateTags updates directconnect service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func DirectconnectUpdateTags(conn *directconnect.DirectConnect, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &directconnect.UntagResourceInput{
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &directconnect.TagResourceInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        updatedTags.DirectconnectTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// DirectoryserviceUpdateTags updates directoryservice service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func DirectoryserviceUpdateTags(conn *directoryservice.DirectoryService, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &directoryservice.RemoveTagsFromResourceInput{
+			ResourceId: aws.String(identifier),
+			TagKeys:    aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.RemoveTagsFromResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &directoryservice.AddTagsToResourceInput{
+			ResourceId: aws.String(identifier),
+			Tags:       updatedTags.DirectoryserviceTags(),
+		}
+
+		_, err := conn.AddTagsToResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// DocdbUpdateTags updates docdb service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func DocdbUpdateTags(conn *docdb.DocDB, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &docdb.RemoveTagsFromResourceInput{
+			ResourceName: aws.String(identifier),
+			TagKeys:      aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.RemoveTagsFromResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &docdb.AddTagsToResourceInput{
+			ResourceName: aws.String(identifier),
+			Tags:         updatedTags.DocdbTags(),
+		}
+
+		_, err := conn.AddTagsToResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// DynamodbUpdateTags updates dynamodb service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func DynamodbUpdateTags(conn *dynamodb.DynamoDB, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &dynamodb.UntagResourceInput{
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &dynamodb.TagResourceInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        updatedTags.DynamodbTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// EcrUpdateTags updates ecr service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func EcrUpdateTags(conn *ecr.ECR, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &ecr.UntagResourceInput{
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &ecr.TagResourceInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        updatedTags.EcrTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// EcsUpdateTags updates ecs service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func EcsUpdateTags(conn *ecs.ECS, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &ecs.UntagResourceInput{
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &ecs.TagResourceInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        updatedTags.EcsTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// EfsUpdateTags updates efs service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func EfsUpdateTags(conn *efs.EFS, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &efs.DeleteTagsInput{
+			FileSystemId: aws.String(identifier),
+			TagKeys:      aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.DeleteTags(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &efs.CreateTagsInput{
+			FileSystemId: aws.String(identifier),
+			Tags:         updatedTags.EfsTags(),
+		}
+
+		_, err := conn.CreateTags(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// ElasticacheUpdateTags updates elasticache service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func ElasticacheUpdateTags(conn *elasticache.ElastiCache, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &elasticache.RemoveTagsFromResourceInput{
+			ResourceName: aws.String(identifier),
+			TagKeys:      aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.RemoveTagsFromResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &elasticache.AddTagsToResourceInput{
+			ResourceName: aws.String(identifier),
+			Tags:         updatedTags.ElasticacheTags(),
+		}
+
+		_, err := conn.AddTagsToResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// ElasticsearchserviceUpdateTags updates elasticsearchservice service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func ElasticsearchserviceUpdateTags(conn *elasticsearchservice.ElasticsearchService, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &elasticsearchservice.RemoveTagsInput{
+			ARN:     aws.String(identifier),
+			TagKeys: aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.RemoveTags(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &elasticsearchservice.AddTagsInput{
+			ARN:     aws.String(identifier),
+			TagList: updatedTags.ElasticsearchserviceTags(),
+		}
+
+		_, err := conn.AddTags(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// ElbTagKeyOnlys returns elb service tag keys.
+func (tags KeyValueTags) ElbTagKeyOnlys() []*elb.TagKeyOnly {
+	result := make([]*elb.TagKeyOnly, 0, len(tags))
+
+	for k := range tags {
+		result = append(result, &elb.TagKeyOnly{
+			Key: aws.String(k),
+		})
+	}
+
+	return result
+}
+
+// ElbUpdateTags updates elb service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func ElbUpdateTags(conn *elb.ELB, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &elb.RemoveTagsInput{
+			LoadBalancerNames: aws.StringSlice([]string{identifier}),
+			Tags:              removedTags.ElbTagKeyOnlys(),
+		}
+
+		_, err := conn.RemoveTags(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &elb.AddTagsInput{
+			LoadBalancerNames: aws.StringSlice([]string{identifier}),
+			Tags:              updatedTags.ElbTags(),
+		}
+
+		_, err := conn.AddTags(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// Elbv2UpdateTags updates elbv2 service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func Elbv2UpdateTags(conn *elbv2.ELBV2, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &elbv2.RemoveTagsInput{
+			ResourceArns: aws.StringSlice([]string{identifier}),
+			TagKeys:      aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.RemoveTags(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &elbv2.AddTagsInput{
+			ResourceArns: aws.StringSlice([]string{identifier}),
+			Tags:         updatedTags.Elbv2Tags(),
+		}
+
+		_, err := conn.AddTags(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// FirehoseUpdateTags updates firehose service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func FirehoseUpdateTags(conn *firehose.Firehose, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &firehose.UntagDeliveryStreamInput{
+			DeliveryStreamName: aws.String(identifier),
+			TagKeys:            aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagDeliveryStream(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &firehose.TagDeliveryStreamInput{
+			DeliveryStreamName: aws.String(identifier),
+			Tags:               updatedTags.FirehoseTags(),
+		}
+
+		_, err := conn.TagDeliveryStream(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// FsxUpdateTags updates fsx service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func FsxUpdateTags(conn *fsx.FSx, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &fsx.UntagResourceInput{
+			ResourceARN: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &fsx.TagResourceInput{
+			ResourceARN: aws.String(identifier),
+			Tags:        updatedTags.FsxTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// KmsUpdateTags updates kms service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func KmsUpdateTags(conn *kms.KMS, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &kms.UntagResourceInput{
+			KeyId:   aws.String(identifier),
+			TagKeys: aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &kms.TagResourceInput{
+			KeyId: aws.String(identifier),
+			Tags:  updatedTags.KmsTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// LambdaUpdateTags updates lambda service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func LambdaUpdateTags(conn *lambda.Lambda, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &lambda.UntagResourceInput{
+			Resource: aws.String(identifier),
+			TagKeys:  aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &lambda.TagResourceInput{
+			Resource: aws.String(identifier),
+			Tags:     updatedTags.LambdaTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// LicensemanagerUpdateTags updates licensemanager service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func LicensemanagerUpdateTags(conn *licensemanager.LicenseManager, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &licensemanager.UntagResourceInput{
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &licensemanager.TagResourceInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        updatedTags.LicensemanagerTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// MediapackageUpdateTags updates mediapackage service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func MediapackageUpdateTags(conn *mediapackage.MediaPackage, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &mediapackage.UntagResourceInput{
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &mediapackage.TagResourceInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        updatedTags.MediapackageTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// MqUpdateTags updates mq service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func MqUpdateTags(conn *mq.MQ, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &mq.DeleteTagsInput{
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.DeleteTags(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &mq.CreateTagsInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        updatedTags.MqTags(),
+		}
+
+		_, err := conn.CreateTags(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// NeptuneUpdateTags updates neptune service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func NeptuneUpdateTags(conn *neptune.Neptune, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &neptune.RemoveTagsFromResourceInput{
+			ResourceName: aws.String(identifier),
+			TagKeys:      aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.RemoveTagsFromResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &neptune.AddTagsToResourceInput{
+			ResourceName: aws.String(identifier),
+			Tags:         updatedTags.NeptuneTags(),
+		}
+
+		_, err := conn.AddTagsToResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// OpsworksUpdateTags updates opsworks service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func OpsworksUpdateTags(conn *opsworks.OpsWorks, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &opsworks.UntagResourceInput{
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &opsworks.TagResourceInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        updatedTags.OpsworksTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// RamUpdateTags updates ram service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func RamUpdateTags(conn *ram.RAM, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &ram.UntagResourceInput{
+			ResourceShareArn: aws.String(identifier),
+			TagKeys:          aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &ram.TagResourceInput{
+			ResourceShareArn: aws.String(identifier),
+			Tags:             updatedTags.RamTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// RdsUpdateTags updates rds service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func RdsUpdateTags(conn *rds.RDS, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &rds.RemoveTagsFromResourceInput{
+			ResourceName: aws.String(identifier),
+			TagKeys:      aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.RemoveTagsFromResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &rds.AddTagsToResourceInput{
+			ResourceName: aws.String(identifier),
+			Tags:         updatedTags.RdsTags(),
+		}
+
+		_, err := conn.AddTagsToResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// RedshiftUpdateTags updates redshift service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func RedshiftUpdateTags(conn *redshift.Redshift, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &redshift.DeleteTagsInput{
+			ResourceName: aws.String(identifier),
+			TagKeys:      aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.DeleteTags(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &redshift.CreateTagsInput{
+			ResourceName: aws.String(identifier),
+			Tags:         updatedTags.RedshiftTags(),
+		}
+
+		_, err := conn.CreateTags(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// Route53resolverUpdateTags updates route53resolver service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func Route53resolverUpdateTags(conn *route53resolver.Route53Resolver, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &route53resolver.UntagResourceInput{
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &route53resolver.TagResourceInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        updatedTags.Route53resolverTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// SagemakerUpdateTags updates sagemaker service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func SagemakerUpdateTags(conn *sagemaker.SageMaker, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &sagemaker.DeleteTagsInput{
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.DeleteTags(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &sagemaker.AddTagsInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        updatedTags.SagemakerTags(),
+		}
+
+		_, err := conn.AddTags(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// SecretsmanagerUpdateTags updates secretsmanager service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func SecretsmanagerUpdateTags(conn *secretsmanager.SecretsManager, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &secretsmanager.UntagResourceInput{
+			SecretId: aws.String(identifier),
+			TagKeys:  aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &secretsmanager.TagResourceInput{
+			SecretId: aws.String(identifier),
+			Tags:     updatedTags.SecretsmanagerTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// SfnUpdateTags updates sfn service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func SfnUpdateTags(conn *sfn.SFN, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &sfn.UntagResourceInput{
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &sfn.TagResourceInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        updatedTags.SfnTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// TransferUpdateTags updates transfer service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func TransferUpdateTags(conn *transfer.Transfer, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAws(); len(removedTags) > 0 {
+		input := &transfer.UntagResourceInput{
+			Arn:     aws.String(identifier),
+			TagKeys: aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAws(); len(updatedTags) > 0 {
+		input := &transfer.TagResourceInput{
+			Arn:  aws.String(identifier),
+			Tags: updatedTags.TransferTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+		}
+	}
+
+	return nil
+}
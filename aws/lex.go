@@ -0,0 +1,303 @@
+package aws
+
+import (
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// lexVersionLatest is the version of a bot, intent or slot type that is
+// modified by the Put* APIs.
+const lexVersionLatest = "$LATEST"
+
+// lexChecksum returns the checksum of the $LATEST version read on the last
+// refresh. Passed to the Put* APIs, it guards against overwriting changes made
+// outside of Terraform since then.
+func lexChecksum(d *schema.ResourceData) *string {
+	return aws.String(d.Get("checksum").(string))
+}
+
+func lexMessageResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"content": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"content_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					lexmodelbuildingservice.ContentTypeCustomPayload,
+					lexmodelbuildingservice.ContentTypePlainText,
+					lexmodelbuildingservice.ContentTypeSsml,
+				}, false),
+			},
+			"group_number": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 5),
+			},
+		},
+	}
+}
+
+func lexStatementResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"message": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 15,
+				Elem:     lexMessageResource(),
+			},
+			"response_card": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 50000),
+			},
+		},
+	}
+}
+
+func lexPromptResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"max_attempts": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 5),
+			},
+			"message": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 15,
+				Elem:     lexMessageResource(),
+			},
+			"response_card": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 50000),
+			},
+		},
+	}
+}
+
+func lexCodeHookResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"message_version": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 5),
+			},
+			"uri": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+		},
+	}
+}
+
+func expandLexMessages(s *schema.Set) []*lexmodelbuildingservice.Message {
+	messages := make([]*lexmodelbuildingservice.Message, 0, s.Len())
+
+	for _, v := range s.List() {
+		m := v.(map[string]interface{})
+
+		message := &lexmodelbuildingservice.Message{
+			Content:     aws.String(m["content"].(string)),
+			ContentType: aws.String(m["content_type"].(string)),
+		}
+
+		if v, ok := m["group_number"].(int); ok && v > 0 {
+			message.GroupNumber = aws.Int64(int64(v))
+		}
+
+		messages = append(messages, message)
+	}
+
+	return messages
+}
+
+func expandLexStatement(l []interface{}) *lexmodelbuildingservice.Statement {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	statement := &lexmodelbuildingservice.Statement{
+		Messages: expandLexMessages(m["message"].(*schema.Set)),
+	}
+
+	if v, ok := m["response_card"].(string); ok && v != "" {
+		statement.ResponseCard = aws.String(v)
+	}
+
+	return statement
+}
+
+func expandLexPrompt(l []interface{}) *lexmodelbuildingservice.Prompt {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	prompt := &lexmodelbuildingservice.Prompt{
+		MaxAttempts: aws.Int64(int64(m["max_attempts"].(int))),
+		Messages:    expandLexMessages(m["message"].(*schema.Set)),
+	}
+
+	if v, ok := m["response_card"].(string); ok && v != "" {
+		prompt.ResponseCard = aws.String(v)
+	}
+
+	return prompt
+}
+
+func expandLexCodeHook(l []interface{}) *lexmodelbuildingservice.CodeHook {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &lexmodelbuildingservice.CodeHook{
+		MessageVersion: aws.String(m["message_version"].(string)),
+		Uri:            aws.String(m["uri"].(string)),
+	}
+}
+
+func flattenLexMessages(messages []*lexmodelbuildingservice.Message) []interface{} {
+	l := make([]interface{}, 0, len(messages))
+
+	for _, message := range messages {
+		m := map[string]interface{}{
+			"content":      aws.StringValue(message.Content),
+			"content_type": aws.StringValue(message.ContentType),
+			"group_number": int(aws.Int64Value(message.GroupNumber)),
+		}
+
+		l = append(l, m)
+	}
+
+	return l
+}
+
+func flattenLexStatement(statement *lexmodelbuildingservice.Statement) []interface{} {
+	if statement == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"message":       flattenLexMessages(statement.Messages),
+		"response_card": aws.StringValue(statement.ResponseCard),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenLexPrompt(prompt *lexmodelbuildingservice.Prompt) []interface{} {
+	if prompt == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"max_attempts":  int(aws.Int64Value(prompt.MaxAttempts)),
+		"message":       flattenLexMessages(prompt.Messages),
+		"response_card": aws.StringValue(prompt.ResponseCard),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenLexCodeHook(codeHook *lexmodelbuildingservice.CodeHook) []interface{} {
+	if codeHook == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"message_version": aws.StringValue(codeHook.MessageVersion),
+		"uri":             aws.StringValue(codeHook.Uri),
+	}
+
+	return []interface{}{m}
+}
+
+// lexLatestVersion returns the greater of two versions, where any numbered
+// version is greater than $LATEST.
+func lexLatestVersion(current, candidate string) string {
+	c, err := strconv.Atoi(candidate)
+	if err != nil {
+		return current
+	}
+
+	if v, err := strconv.Atoi(current); err == nil && v >= c {
+		return current
+	}
+
+	return candidate
+}
+
+func lexGetLatestBotVersion(conn *lexmodelbuildingservice.LexModelBuildingService, name string) (string, error) {
+	version := lexVersionLatest
+
+	input := &lexmodelbuildingservice.GetBotVersionsInput{
+		Name: aws.String(name),
+	}
+
+	err := conn.GetBotVersionsPages(input, func(page *lexmodelbuildingservice.GetBotVersionsOutput, lastPage bool) bool {
+		for _, bot := range page.Bots {
+			version = lexLatestVersion(version, aws.StringValue(bot.Version))
+		}
+
+		return !lastPage
+	})
+
+	return version, err
+}
+
+func lexGetLatestIntentVersion(conn *lexmodelbuildingservice.LexModelBuildingService, name string) (string, error) {
+	version := lexVersionLatest
+
+	input := &lexmodelbuildingservice.GetIntentVersionsInput{
+		Name: aws.String(name),
+	}
+
+	err := conn.GetIntentVersionsPages(input, func(page *lexmodelbuildingservice.GetIntentVersionsOutput, lastPage bool) bool {
+		for _, intent := range page.Intents {
+			version = lexLatestVersion(version, aws.StringValue(intent.Version))
+		}
+
+		return !lastPage
+	})
+
+	return version, err
+}
+
+func lexGetLatestSlotTypeVersion(conn *lexmodelbuildingservice.LexModelBuildingService, name string) (string, error) {
+	version := lexVersionLatest
+
+	input := &lexmodelbuildingservice.GetSlotTypeVersionsInput{
+		Name: aws.String(name),
+	}
+
+	err := conn.GetSlotTypeVersionsPages(input, func(page *lexmodelbuildingservice.GetSlotTypeVersionsOutput, lastPage bool) bool {
+		for _, slotType := range page.SlotTypes {
+			version = lexLatestVersion(version, aws.StringValue(slotType.Version))
+		}
+
+		return !lastPage
+	})
+
+	return version, err
+}
@@ -0,0 +1,47 @@
+package aws
+
+import (
+	"testing"
+)
+
+func TestLexLatestVersion(t *testing.T) {
+	testCases := []struct {
+		Current   string
+		Candidate string
+		Expected  string
+	}{
+		{
+			Current:   lexVersionLatest,
+			Candidate: lexVersionLatest,
+			Expected:  lexVersionLatest,
+		},
+		{
+			Current:   lexVersionLatest,
+			Candidate: "1",
+			Expected:  "1",
+		},
+		{
+			Current:   "1",
+			Candidate: lexVersionLatest,
+			Expected:  "1",
+		},
+		{
+			Current:   "2",
+			Candidate: "10",
+			Expected:  "10",
+		},
+		{
+			Current:   "10",
+			Candidate: "2",
+			Expected:  "10",
+		},
+	}
+
+	for i, tc := range testCases {
+		version := lexLatestVersion(tc.Current, tc.Candidate)
+
+		if version != tc.Expected {
+			t.Fatalf("%d: expected %s, received %s", i, tc.Expected, version)
+		}
+	}
+}
@@ -235,19 +235,19 @@ func (lt *opsworksLayerType) SchemaResource() *schema.Resource {
 
 	return &schema.Resource{
 		Read: func(d *schema.ResourceData, meta interface{}) error {
-			client := meta.(*AWSClient).opsworksconn
+			client := meta.(*AWSClient).opsworksconn()
 			return lt.Read(d, client)
 		},
 		Create: func(d *schema.ResourceData, meta interface{}) error {
-			client := meta.(*AWSClient).opsworksconn
+			client := meta.(*AWSClient).opsworksconn()
 			return lt.Create(d, client)
 		},
 		Update: func(d *schema.ResourceData, meta interface{}) error {
-			client := meta.(*AWSClient).opsworksconn
+			client := meta.(*AWSClient).opsworksconn()
 			return lt.Update(d, client)
 		},
 		Delete: func(d *schema.ResourceData, meta interface{}) error {
-			client := meta.(*AWSClient).opsworksconn
+			client := meta.(*AWSClient).opsworksconn()
 			return lt.Delete(d, client)
 		},
 		Importer: &schema.ResourceImporter{
@@ -0,0 +1,436 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"sync"
+
+	"github.com/hashicorp/terraform/helper/customdiff"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+// defaultTagsSchema returns the schema for the provider level default_tags
+// configuration block.
+func defaultTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"tags": {
+					Type:        schema.TypeMap,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: descriptions["default_tags_tags"],
+				},
+			},
+		},
+	}
+}
+
+// expandProviderDefaultTags returns the default tags from the provider
+// default_tags configuration block.
+func expandProviderDefaultTags(l []interface{}) map[string]string {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	result := make(map[string]string)
+
+	for k, v := range m["tags"].(map[string]interface{}) {
+		result[k] = v.(string)
+	}
+
+	return result
+}
+
+// ignoreTagsSchema returns the schema for the provider level ignore_tags
+// configuration block.
+func ignoreTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"keys": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         schema.HashString,
+					Description: descriptions["ignore_tags_keys"],
+				},
+				"key_prefixes": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         schema.HashString,
+					Description: descriptions["ignore_tags_key_prefixes"],
+				},
+			},
+		},
+	}
+}
+
+// expandProviderIgnoreTags returns the ignore configuration from the provider
+// ignore_tags configuration block.
+func expandProviderIgnoreTags(l []interface{}) *keyvaluetags.IgnoreConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	config := &keyvaluetags.IgnoreConfig{}
+
+	if v, ok := m["keys"].(*schema.Set); ok {
+		config.Keys = keyvaluetags.New(v.List())
+	}
+
+	if v, ok := m["key_prefixes"].(*schema.Set); ok {
+		config.KeyPrefixes = keyvaluetags.New(v.List())
+	}
+
+	return config
+}
+
+// isTaggableResource returns true if the resource manages a top level
+// "tags" map that the provider level tag handling should apply to.
+func isTaggableResource(r *schema.Resource) bool {
+	if r == nil || r.Create == nil || r.Read == nil {
+		return false
+	}
+
+	s, ok := r.Schema["tags"]
+	if !ok || s.Type != schema.TypeMap || !s.Optional {
+		return false
+	}
+
+	if _, ok := r.Schema["tags_all"]; ok {
+		return false
+	}
+
+	return true
+}
+
+// wrapProviderTagsResources applies the provider level tag handling to
+// every taggable resource in the map.
+func wrapProviderTagsResources(resources map[string]*schema.Resource) {
+	for name, r := range resources {
+		if !isTaggableResource(r) {
+			continue
+		}
+
+		log.Printf("[TRACE] Enabling provider level tags for %s", name)
+		wrapProviderTagsResource(r)
+	}
+}
+
+// wrapProviderTagsDataSources applies the provider ignore_tags configuration
+// to every data source in the map exporting a top level "tags" map.
+func wrapProviderTagsDataSources(dataSources map[string]*schema.Resource) {
+	for _, r := range dataSources {
+		if s, ok := r.Schema["tags"]; !ok || s.Type != schema.TypeMap || r.Read == nil {
+			continue
+		}
+
+		read := r.Read
+		r.Read = func(d *schema.ResourceData, meta interface{}) error {
+			if err := read(d, meta); err != nil {
+				return err
+			}
+
+			if d.Id() == "" {
+				return nil
+			}
+
+			tags := removeProviderIgnoredTags(meta, d.Get("tags").(map[string]interface{}))
+
+			if err := d.Set("tags", tags); err != nil {
+				return fmt.Errorf("error setting tags: %s", err)
+			}
+
+			return nil
+		}
+	}
+}
+
+// wrapProviderTagsResource decorates the CRUD functions of a resource so the
+// provider default_tags are merged into the resource tags on create and update,
+// where the tag helpers pick them up through getTagsChange.
+// On read, the keys only present because of default_tags are removed from
+// "tags" so they do not show as a perpetual difference, while the computed
+// "tags_all" attribute holds the effective tag set of the resource. Tags
+// matching the provider ignore_tags are never saved into state, so they
+// are not removed on update either.
+func wrapProviderTagsResource(r *schema.Resource) {
+	r.Schema["tags_all"] = &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+
+	create := r.Create
+	r.Create = func(d *schema.ResourceData, meta interface{}) error {
+		configTags := d.Get("tags").(map[string]interface{})
+
+		if err := setProviderTagsConfig(d, meta, configTags); err != nil {
+			return err
+		}
+
+		if err := create(d, meta); err != nil {
+			return err
+		}
+
+		return setProviderTagsState(d, meta, configTags)
+	}
+
+	read := r.Read
+	r.Read = func(d *schema.ResourceData, meta interface{}) error {
+		priorTags := d.Get("tags").(map[string]interface{})
+
+		if err := read(d, meta); err != nil {
+			return err
+		}
+
+		return setProviderTagsState(d, meta, priorTags)
+	}
+
+	// The tags of resources without an update function are ForceNew, changes
+	// of the provider default_tags replace them as well.
+	customizeDiff := providerTagsCustomizeDiff(r.Update == nil)
+	if r.CustomizeDiff == nil {
+		r.CustomizeDiff = customizeDiff
+	} else {
+		r.CustomizeDiff = customdiff.Sequence(r.CustomizeDiff, customizeDiff)
+	}
+
+	if r.Update == nil {
+		return
+	}
+
+	update := r.Update
+	r.Update = func(d *schema.ResourceData, meta interface{}) error {
+		configTags := d.Get("tags").(map[string]interface{})
+
+		if err := setProviderTagsConfig(d, meta, configTags); err != nil {
+			return err
+		}
+
+		if err := update(d, meta); err != nil {
+			return err
+		}
+
+		return setProviderTagsState(d, meta, configTags)
+	}
+}
+
+// providerTagsCustomizeDiff returns a CustomizeDiffFunc planning the
+// "tags_all" attribute from the resource tags and the provider default_tags.
+// With forceNew, a change of "tags_all" requires a new resource.
+func providerTagsCustomizeDiff(forceNew bool) schema.CustomizeDiffFunc {
+	return func(diff *schema.ResourceDiff, meta interface{}) error {
+		if !diff.NewValueKnown("tags") {
+			return diff.SetNewComputed("tags_all")
+		}
+
+		configTags := diff.Get("tags").(map[string]interface{})
+		oldTagsAll, _ := diff.GetChange("tags_all")
+
+		newTagsAll := removeProviderIgnoredTags(meta, mergeProviderDefaultTags(meta, configTags))
+
+		if reflect.DeepEqual(oldTagsAll.(map[string]interface{}), newTagsAll) {
+			return nil
+		}
+
+		if err := diff.SetNew("tags_all", newTagsAll); err != nil {
+			return err
+		}
+
+		if !forceNew || diff.Id() == "" {
+			return nil
+		}
+
+		// The prior state was saved before the resource had "tags_all"
+		oldTags := oldTagsAll.(map[string]interface{})
+		if len(oldTags) == 0 {
+			o, _ := diff.GetChange("tags")
+			oldTags = o.(map[string]interface{})
+		}
+
+		if !reflect.DeepEqual(oldTags, newTagsAll) {
+			return diff.ForceNew("tags_all")
+		}
+
+		return nil
+	}
+}
+
+// setProviderTagsConfig merges the provider default_tags into the configured
+// tags before the resource is created or updated. The merged tags are set in
+// "tags" for the resources reading them on create, and in "tags_all" for the
+// tag helpers diffing them against the prior state on update, as the "tags"
+// diff does not include the values set here.
+func setProviderTagsConfig(d *schema.ResourceData, meta interface{}, configTags map[string]interface{}) error {
+	tags := mergeProviderDefaultTags(meta, configTags)
+
+	if err := d.Set("tags", tags); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", removeProviderIgnoredTags(meta, tags)); err != nil {
+		return fmt.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+// getTagsChange returns the old and new tags of the resource for the tag
+// helpers. For resources with provider level tags, these are the effective
+// tags of "tags_all": the prior state and the configured tags merged with
+// the provider default_tags by setProviderTagsConfig. Both exclude the tags
+// matching the provider ignore_tags, so the helpers never remove them.
+// Other resources return the "tags" diff.
+func getTagsChange(d *schema.ResourceData) (interface{}, interface{}) {
+	n, ok := d.Get("tags_all").(map[string]interface{})
+	if !ok {
+		return d.GetChange("tags")
+	}
+
+	o, _ := d.GetChange("tags_all")
+
+	// The prior state was saved before the resource had "tags_all"
+	if len(o.(map[string]interface{})) == 0 {
+		o, _ = d.GetChange("tags")
+	}
+
+	return o, n
+}
+
+// hasTagsChange returns true if the tags returned by getTagsChange differ.
+func hasTagsChange(d *schema.ResourceData) bool {
+	o, n := getTagsChange(d)
+
+	return !keyvaluetags.New(o).Equal(keyvaluetags.New(n))
+}
+
+// setProviderTagsState saves the effective tag set read from the resource,
+// without the ignored tags, into "tags_all" and removes the keys coming from
+// the provider default_tags from "tags", except when they are also present
+// in keepTags or have another value.
+func setProviderTagsState(d *schema.ResourceData, meta interface{}, keepTags map[string]interface{}) error {
+	// The resource was not found and removed from state
+	if d.Id() == "" {
+		return nil
+	}
+
+	tagsAll := removeProviderIgnoredTags(meta, d.Get("tags").(map[string]interface{}))
+
+	if err := d.Set("tags_all", tagsAll); err != nil {
+		return fmt.Errorf("error setting tags_all: %s", err)
+	}
+
+	if err := d.Set("tags", removeProviderDefaultTags(meta, tagsAll, keepTags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+// providerDefaultTags returns the provider default_tags from the client.
+func providerDefaultTags(meta interface{}) map[string]string {
+	client, ok := meta.(*AWSClient)
+	if !ok || client == nil {
+		return nil
+	}
+
+	return client.defaultTags
+}
+
+// providerIgnoreTagsConfig returns the provider ignore_tags from the client.
+func providerIgnoreTagsConfig(meta interface{}) *keyvaluetags.IgnoreConfig {
+	client, ok := meta.(*AWSClient)
+	if !ok || client == nil {
+		return nil
+	}
+
+	return client.ignoreTagsConfig
+}
+
+// configuredIgnoreTags holds the ignore_tags of the configured provider. The
+// service tag helpers, e.g. tagsToMap and diffTags, have no access to the
+// client, a provider process only serves one provider configuration.
+var configuredIgnoreTags struct {
+	sync.RWMutex
+	config *keyvaluetags.IgnoreConfig
+}
+
+// setConfiguredIgnoreTagsConfig saves the ignore_tags of the provider being
+// configured for the service tag helpers.
+func setConfiguredIgnoreTagsConfig(config *keyvaluetags.IgnoreConfig) {
+	configuredIgnoreTags.Lock()
+	defer configuredIgnoreTags.Unlock()
+
+	configuredIgnoreTags.config = config
+}
+
+// configuredIgnoreTagsConfig returns the ignore_tags of the configured
+// provider, nil if none.
+func configuredIgnoreTagsConfig() *keyvaluetags.IgnoreConfig {
+	configuredIgnoreTags.RLock()
+	defer configuredIgnoreTags.RUnlock()
+
+	return configuredIgnoreTags.config
+}
+
+// removeProviderIgnoredTags returns the given tags without the keys matching
+// the provider ignore_tags.
+func removeProviderIgnoredTags(meta interface{}, tags map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+
+	for k, v := range keyvaluetags.New(tags).IgnoreConfig(providerIgnoreTagsConfig(meta)).Map() {
+		result[k] = v
+	}
+
+	return result
+}
+
+// mergeProviderDefaultTags returns the provider default_tags merged with the
+// given resource tags. Resource tags take precedence over default tags.
+func mergeProviderDefaultTags(meta interface{}, tags map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+
+	for k, v := range providerDefaultTags(meta) {
+		result[k] = v
+	}
+
+	for k, v := range tags {
+		result[k] = v
+	}
+
+	return result
+}
+
+// removeProviderDefaultTags returns the given tags without the keys set by
+// the provider default_tags, unless the key is present in keepTags or its
+// value differs from the default one, e.g. when it is set on the resource
+// and imported.
+func removeProviderDefaultTags(meta interface{}, tags, keepTags map[string]interface{}) map[string]interface{} {
+	defaultTags := providerDefaultTags(meta)
+	result := make(map[string]interface{})
+
+	for k, v := range tags {
+		if dv, ok := defaultTags[k]; ok && dv == v.(string) {
+			if _, ok := keepTags[k]; !ok {
+				continue
+			}
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
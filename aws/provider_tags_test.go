@@ -0,0 +1,626 @@
+package aws
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/fakeaws"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func TestExpandProviderDefaultTags(t *testing.T) {
+	cases := []struct {
+		Input    []interface{}
+		Expected map[string]string
+	}{
+		{
+			Input:    []interface{}{},
+			Expected: nil,
+		},
+		{
+			Input:    []interface{}{nil},
+			Expected: nil,
+		},
+		{
+			Input: []interface{}{
+				map[string]interface{}{
+					"tags": map[string]interface{}{
+						"Owner":       "platform",
+						"Environment": "test",
+					},
+				},
+			},
+			Expected: map[string]string{
+				"Owner":       "platform",
+				"Environment": "test",
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		got := expandProviderDefaultTags(tc.Input)
+
+		if !reflect.DeepEqual(got, tc.Expected) {
+			t.Fatalf("%d: bad default tags: %#v, expected: %#v", i, got, tc.Expected)
+		}
+	}
+}
+
+func TestExpandProviderIgnoreTags(t *testing.T) {
+	if got := expandProviderIgnoreTags([]interface{}{}); got != nil {
+		t.Fatalf("expected no ignore configuration, got: %#v", got)
+	}
+
+	got := expandProviderIgnoreTags([]interface{}{
+		map[string]interface{}{
+			"keys":         schema.NewSet(schema.HashString, []interface{}{"CostCenter"}),
+			"key_prefixes": schema.NewSet(schema.HashString, []interface{}{"compliance:"}),
+		},
+	})
+	expected := &keyvaluetags.IgnoreConfig{
+		Keys:        keyvaluetags.New([]string{"CostCenter"}),
+		KeyPrefixes: keyvaluetags.New([]string{"compliance:"}),
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Fatalf("bad ignore configuration: %#v, expected: %#v", got, expected)
+	}
+}
+
+func TestRemoveProviderIgnoredTags(t *testing.T) {
+	meta := &AWSClient{
+		ignoreTagsConfig: &keyvaluetags.IgnoreConfig{
+			Keys:        keyvaluetags.New([]string{"CostCenter"}),
+			KeyPrefixes: keyvaluetags.New([]string{"compliance:"}),
+		},
+	}
+
+	tags := map[string]interface{}{
+		"Name":             "test",
+		"CostCenter":       "1234",
+		"compliance:owner": "security",
+	}
+	expected := map[string]interface{}{
+		"Name": "test",
+	}
+
+	if got := removeProviderIgnoredTags(meta, tags); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("bad tags: %#v, expected: %#v", got, expected)
+	}
+
+	if got := removeProviderIgnoredTags(&AWSClient{}, tags); !reflect.DeepEqual(got, tags) {
+		t.Fatalf("bad tags without ignore configuration: %#v, expected: %#v", got, tags)
+	}
+}
+
+func TestMergeProviderDefaultTags(t *testing.T) {
+	meta := &AWSClient{
+		defaultTags: map[string]string{
+			"Owner":       "platform",
+			"Environment": "test",
+		},
+	}
+
+	cases := []struct {
+		Meta     interface{}
+		Tags     map[string]interface{}
+		Expected map[string]interface{}
+	}{
+		// No default tags
+		{
+			Meta: &AWSClient{},
+			Tags: map[string]interface{}{
+				"Name": "test",
+			},
+			Expected: map[string]interface{}{
+				"Name": "test",
+			},
+		},
+		// No resource tags
+		{
+			Meta: meta,
+			Tags: map[string]interface{}{},
+			Expected: map[string]interface{}{
+				"Owner":       "platform",
+				"Environment": "test",
+			},
+		},
+		// Resource tags take precedence
+		{
+			Meta: meta,
+			Tags: map[string]interface{}{
+				"Name":        "test",
+				"Environment": "production",
+			},
+			Expected: map[string]interface{}{
+				"Name":        "test",
+				"Owner":       "platform",
+				"Environment": "production",
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		got := mergeProviderDefaultTags(tc.Meta, tc.Tags)
+
+		if !reflect.DeepEqual(got, tc.Expected) {
+			t.Fatalf("%d: bad merged tags: %#v, expected: %#v", i, got, tc.Expected)
+		}
+	}
+}
+
+func TestRemoveProviderDefaultTags(t *testing.T) {
+	meta := &AWSClient{
+		defaultTags: map[string]string{
+			"Owner":       "platform",
+			"Environment": "test",
+		},
+	}
+
+	cases := []struct {
+		Tags     map[string]interface{}
+		KeepTags map[string]interface{}
+		Expected map[string]interface{}
+	}{
+		// Only default tags
+		{
+			Tags: map[string]interface{}{
+				"Owner":       "platform",
+				"Environment": "test",
+			},
+			KeepTags: map[string]interface{}{},
+			Expected: map[string]interface{}{},
+		},
+		// Default tags with another value are kept, the resource overrides
+		// them or they drifted
+		{
+			Tags: map[string]interface{}{
+				"Name":  "test",
+				"Owner": "someone-else",
+			},
+			KeepTags: map[string]interface{}{
+				"Name": "test",
+			},
+			Expected: map[string]interface{}{
+				"Name":  "test",
+				"Owner": "someone-else",
+			},
+		},
+		// Import, without prior tags
+		{
+			Tags: map[string]interface{}{
+				"Name":        "test",
+				"Owner":       "platform",
+				"Environment": "production",
+			},
+			KeepTags: map[string]interface{}{},
+			Expected: map[string]interface{}{
+				"Name":        "test",
+				"Environment": "production",
+			},
+		},
+		// Resource tags overlapping default tags are kept
+		{
+			Tags: map[string]interface{}{
+				"Name":        "test",
+				"Owner":       "platform",
+				"Environment": "production",
+			},
+			KeepTags: map[string]interface{}{
+				"Name":        "test",
+				"Environment": "production",
+			},
+			Expected: map[string]interface{}{
+				"Name":        "test",
+				"Environment": "production",
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		got := removeProviderDefaultTags(meta, tc.Tags, tc.KeepTags)
+
+		if !reflect.DeepEqual(got, tc.Expected) {
+			t.Fatalf("%d: bad tags: %#v, expected: %#v", i, got, tc.Expected)
+		}
+	}
+}
+
+func TestWrapProviderTagsResource(t *testing.T) {
+	remoteTags := map[string]interface{}{}
+
+	r := &schema.Resource{
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			d.SetId("test")
+			remoteTags = d.Get("tags").(map[string]interface{})
+			return d.Set("tags", remoteTags)
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return d.Set("tags", remoteTags)
+		},
+		Update: func(d *schema.ResourceData, meta interface{}) error {
+			remoteTags = d.Get("tags").(map[string]interface{})
+			return d.Set("tags", remoteTags)
+		},
+		Delete: schema.RemoveFromState,
+		Schema: map[string]*schema.Schema{
+			"tags": tagsSchema(),
+		},
+	}
+
+	if !isTaggableResource(r) {
+		t.Fatal("expected resource to be taggable")
+	}
+
+	wrapProviderTagsResource(r)
+
+	if isTaggableResource(r) {
+		t.Fatal("expected wrapped resource to not be wrapped again")
+	}
+
+	meta := &AWSClient{
+		defaultTags: map[string]string{
+			"Owner": "platform",
+		},
+	}
+
+	d := r.TestResourceData()
+	if err := d.Set("tags", map[string]interface{}{"Name": "test"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := r.Create(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	expectedTags := map[string]interface{}{"Name": "test"}
+	expectedTagsAll := map[string]interface{}{"Name": "test", "Owner": "platform"}
+
+	if !reflect.DeepEqual(remoteTags, expectedTagsAll) {
+		t.Fatalf("bad remote tags: %#v, expected: %#v", remoteTags, expectedTagsAll)
+	}
+
+	if got := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(got, expectedTags) {
+		t.Fatalf("bad tags: %#v, expected: %#v", got, expectedTags)
+	}
+
+	if got := d.Get("tags_all").(map[string]interface{}); !reflect.DeepEqual(got, expectedTagsAll) {
+		t.Fatalf("bad tags_all: %#v, expected: %#v", got, expectedTagsAll)
+	}
+
+	if err := r.Read(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(got, expectedTags) {
+		t.Fatalf("bad tags after read: %#v, expected: %#v", got, expectedTags)
+	}
+
+	// Externally managed tag matching ignore_tags
+	remoteTags["compliance:owner"] = "security"
+	meta.ignoreTagsConfig = &keyvaluetags.IgnoreConfig{
+		KeyPrefixes: keyvaluetags.New([]string{"compliance:"}),
+	}
+
+	if err := r.Read(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(got, expectedTags) {
+		t.Fatalf("bad tags after read with ignored tags: %#v, expected: %#v", got, expectedTags)
+	}
+
+	if got := d.Get("tags_all").(map[string]interface{}); !reflect.DeepEqual(got, expectedTagsAll) {
+		t.Fatalf("bad tags_all after read with ignored tags: %#v, expected: %#v", got, expectedTagsAll)
+	}
+}
+
+func TestWrapProviderTagsResource_forceNew(t *testing.T) {
+	tags := tagsSchema()
+	tags.ForceNew = true
+
+	r := &schema.Resource{
+		Create: schema.Noop,
+		Read:   schema.Noop,
+		Delete: schema.RemoveFromState,
+		Schema: map[string]*schema.Schema{
+			"tags": tags,
+		},
+	}
+
+	wrapProviderTagsResource(r)
+
+	if r.CustomizeDiff == nil {
+		t.Fatal("expected CustomizeDiff on a resource without Update")
+	}
+
+	raw, err := config.NewRawConfig(map[string]interface{}{
+		"tags": map[string]interface{}{"Name": "test"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	state := &terraform.InstanceState{
+		ID: "test",
+		Attributes: map[string]string{
+			"id":             "test",
+			"tags.%":         "1",
+			"tags.Name":      "test",
+			"tags_all.%":     "2",
+			"tags_all.Name":  "test",
+			"tags_all.Owner": "platform",
+		},
+	}
+
+	cases := []struct {
+		DefaultTags map[string]string
+		RequiresNew bool
+	}{
+		{
+			DefaultTags: map[string]string{"Owner": "platform"},
+			RequiresNew: false,
+		},
+		{
+			DefaultTags: map[string]string{"Owner": "networking"},
+			RequiresNew: true,
+		},
+	}
+
+	for i, tc := range cases {
+		meta := &AWSClient{defaultTags: tc.DefaultTags}
+
+		diff, err := r.Diff(state, terraform.NewResourceConfig(raw), meta)
+		if err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+
+		if got := diff != nil && diff.RequiresNew(); got != tc.RequiresNew {
+			t.Fatalf("%d: expected RequiresNew %t, got %t: %#v", i, tc.RequiresNew, got, diff)
+		}
+	}
+}
+
+func TestProviderDefaultTags_fakeAws(t *testing.T) {
+	server := fakeaws.New()
+	defer server.Close()
+
+	var vpc ec2.Vpc
+	resourceName := "aws_vpc.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSProviderConfigDefaultTagsFakeAws(server.URL, "platform"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcExists(resourceName, &vpc),
+					testAccCheckTags(&vpc.Tags, "Name", "terraform-testacc-provider-default-tags"),
+					testAccCheckTags(&vpc.Tags, "Owner", "platform"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "2"),
+				),
+			},
+			{
+				Config: testAccAWSProviderConfigDefaultTagsFakeAws(server.URL, "networking"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcExists(resourceName, &vpc),
+					testAccCheckTags(&vpc.Tags, "Owner", "networking"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.Owner", "networking"),
+				),
+			},
+			{
+				Config: testAccAWSProviderConfigDefaultTagsFakeAws(server.URL, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcExists(resourceName, &vpc),
+					testAccCheckTags(&vpc.Tags, "Name", "terraform-testacc-provider-default-tags"),
+					testAccCheckTags(&vpc.Tags, "Owner", ""),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderIgnoreTags_fakeAws(t *testing.T) {
+	server := fakeaws.New()
+	defer server.Close()
+
+	var vpc ec2.Vpc
+	resourceName := "aws_vpc.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSProviderConfigIgnoreTagsFakeAws(server.URL, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcExists(resourceName, &vpc),
+					testAccCheckVpcCreateTag(&vpc, "compliance:owner", "security"),
+				),
+			},
+			{
+				Config: testAccAWSProviderConfigIgnoreTagsFakeAws(server.URL, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcExists(resourceName, &vpc),
+					testAccCheckTags(&vpc.Tags, "Name", "second"),
+					testAccCheckVpcTag(&vpc, "compliance:owner", "security"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSProvider_DefaultTags(t *testing.T) {
+	var vpc ec2.Vpc
+	resourceName := "aws_vpc.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSProviderConfigDefaultTags("platform"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcExists(resourceName, &vpc),
+					testAccCheckTags(&vpc.Tags, "Name", "terraform-testacc-provider-default-tags"),
+					testAccCheckTags(&vpc.Tags, "Owner", "platform"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.Owner", "platform"),
+				),
+			},
+			{
+				Config: testAccAWSProviderConfigDefaultTags("networking"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcExists(resourceName, &vpc),
+					testAccCheckTags(&vpc.Tags, "Owner", "networking"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.Owner", "networking"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSProviderConfigDefaultTags(owner string) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  default_tags {
+    tags = {
+      Owner = %[1]q
+    }
+  }
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = "terraform-testacc-provider-default-tags"
+  }
+}
+`, owner)
+}
+
+// testAccAWSProviderConfigDefaultTagsFakeAws returns the configuration of
+// testAccAWSProviderConfigDefaultTags for the fake AWS API server at the
+// given URL, without default_tags when owner is empty.
+func testAccAWSProviderConfigDefaultTagsFakeAws(url, owner string) string {
+	defaultTags := ""
+	if owner != "" {
+		defaultTags = fmt.Sprintf(`
+  default_tags {
+    tags = {
+      Owner = %q
+    }
+  }
+`, owner)
+	}
+
+	return fmt.Sprintf(`
+provider "aws" {
+  access_key              = "fakeaws"
+  region                  = %[2]q
+  secret_key              = "fakeaws"
+  skip_metadata_api_check = true
+%[3]s
+  endpoints {
+    ec2 = %[1]q
+    iam = %[1]q
+    sts = %[1]q
+  }
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = "terraform-testacc-provider-default-tags"
+  }
+}
+`, url, testAccGetRegion(), defaultTags)
+}
+
+// testAccAWSProviderConfigIgnoreTagsFakeAws returns the configuration of a
+// VPC ignoring the compliance: tags for the fake AWS API server at the
+// given URL.
+func testAccAWSProviderConfigIgnoreTagsFakeAws(url, name string) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  access_key              = "fakeaws"
+  region                  = %[2]q
+  secret_key              = "fakeaws"
+  skip_metadata_api_check = true
+
+  ignore_tags {
+    key_prefixes = ["compliance:"]
+  }
+
+  endpoints {
+    ec2 = %[1]q
+    iam = %[1]q
+    sts = %[1]q
+  }
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[3]q
+  }
+}
+`, url, testAccGetRegion(), name)
+}
+
+// testAccCheckVpcCreateTag adds a tag to the VPC outside of Terraform.
+func testAccCheckVpcCreateTag(vpc *ec2.Vpc, key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
+
+		_, err := conn.CreateTags(&ec2.CreateTagsInput{
+			Resources: []*string{vpc.VpcId},
+			Tags: []*ec2.Tag{
+				{
+					Key:   aws.String(key),
+					Value: aws.String(value),
+				},
+			},
+		})
+
+		return err
+	}
+}
+
+// testAccCheckVpcTag checks the value of a VPC tag, including the tags the
+// tag helpers ignore.
+func testAccCheckVpcTag(vpc *ec2.Vpc, key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		v, ok := keyvaluetags.Ec2KeyValueTags(vpc.Tags).Map()[key]
+		if !ok {
+			return fmt.Errorf("Missing tag: %s", key)
+		}
+
+		if v != value {
+			return fmt.Errorf("%s: bad value: %s", key, v)
+		}
+
+		return nil
+	}
+}
@@ -2,17 +2,27 @@ package aws
 
 import (
 	"fmt"
+	"io/ioutil"
 	"log"
+	"net/http"
 	"os"
+	"path/filepath"
+	"reflect"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/organizations"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/cassette"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/fakeaws"
 	"github.com/terraform-providers/terraform-provider-template/template"
 	"github.com/terraform-providers/terraform-provider-tls/tls"
 )
@@ -23,8 +33,25 @@ var testAccProviderFactories func(providers *[]*schema.Provider) map[string]terr
 var testAccProvider *schema.Provider
 var testAccTemplateProvider *schema.Provider
 
+// testAccCassetteRecorder records the AWS API traffic of the acceptance tests
+// to cassettes, or replays it from them, when the TF_ACC_CASSETTE_MODE
+// environment variable is set to "record" or "replay".
+var testAccCassetteRecorder *cassette.Recorder
+
 func init() {
 	testAccProvider = Provider().(*schema.Provider)
+
+	if v := os.Getenv("TF_ACC_CASSETTE_MODE"); v != "" {
+		mode, err := cassette.ParseMode(v)
+		if err != nil {
+			log.Fatalf("[ERROR] TF_ACC_CASSETTE_MODE: %s", err)
+		}
+
+		testAccCassetteRecorder = cassette.New(filepath.Join("test-fixtures", "cassettes"), mode)
+		testAccCassetteRecorder.Redact = testAccCassetteRedact
+		testAccProvider.ConfigureFunc = testAccCassetteProviderConfigure
+	}
+
 	testAccTemplateProvider = template.Provider().(*schema.Provider)
 	testAccProviders = map[string]terraform.ResourceProvider{
 		"aws":      testAccProvider,
@@ -58,6 +85,108 @@ func TestProvider_impl(t *testing.T) {
 	var _ terraform.ResourceProvider = Provider()
 }
 
+func TestProvider_endpoints(t *testing.T) {
+	endpointsSchema := Provider().(*schema.Provider).Schema["endpoints"].Elem.(*schema.Resource).Schema
+
+	if len(endpointsSchema) != len(endpointServiceNames) {
+		t.Fatalf("expected %d endpoints arguments, got %d", len(endpointServiceNames), len(endpointsSchema))
+	}
+
+	for _, endpointServiceName := range endpointServiceNames {
+		if _, ok := endpointsSchema[endpointServiceName]; !ok {
+			t.Fatalf("missing endpoints argument: %s", endpointServiceName)
+		}
+	}
+
+	endpoints := expandProviderEndpoints([]interface{}{
+		map[string]interface{}{
+			"appsync": "http://localhost:4566",
+			"r53":     "http://localhost:4580",
+			"unknown": "http://localhost:1234",
+		},
+	})
+
+	if got, expected := endpoints["appsync"], "http://localhost:4566"; got != expected {
+		t.Fatalf("bad appsync endpoint: %q, expected: %q", got, expected)
+	}
+
+	if got, expected := endpoints["r53"], "http://localhost:4580"; got != expected {
+		t.Fatalf("bad r53 endpoint: %q, expected: %q", got, expected)
+	}
+
+	if _, ok := endpoints["unknown"]; ok {
+		t.Fatal("expected unknown endpoint to be ignored")
+	}
+}
+
+func TestProvider_cassette(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cassettes")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	defer func(recorder *cassette.Recorder, configure schema.ConfigureFunc) {
+		testAccCassetteRecorder = recorder
+		testAccProvider.ConfigureFunc = configure
+	}(testAccCassetteRecorder, testAccProvider.ConfigureFunc)
+
+	testAccProvider.ConfigureFunc = testAccCassetteProviderConfigure
+
+	testCase := func(t *testing.T, url string) resource.TestCase {
+		var role iam.GetRoleOutput
+		rName := acctest.RandomWithPrefix("tf-acc-test")
+		resourceName := "aws_iam_role.test"
+
+		return resource.TestCase{
+			Providers:    testAccProviders,
+			CheckDestroy: testAccCheckAWSRoleDestroy,
+			Steps: []resource.TestStep{
+				{
+					Config: testAccFakeAwsProviderConfig(url) + testAccAWSIAMRoleConfig_tags(rName),
+					Check: resource.ComposeTestCheckFunc(
+						testAccCheckAWSRoleExists(resourceName, &role),
+						testAccCheckResourceAttrGlobalARN(resourceName, "arn", "iam", fmt.Sprintf("role/%s", rName)),
+						resource.TestCheckResourceAttr(resourceName, "name", rName),
+						resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					),
+				},
+				{
+					Config: testAccFakeAwsProviderConfig(url) + testAccAWSIAMRoleConfig_tagsUpdate(rName),
+					Check: resource.ComposeTestCheckFunc(
+						testAccCheckAWSRoleExists(resourceName, &role),
+						resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					),
+				},
+			},
+		}
+	}
+
+	server := fakeaws.New()
+	testAccCassetteRecorder = cassette.New(dir, cassette.ModeRecording)
+	testAccCassetteRecorder.Redact = testAccCassetteRedact
+
+	t.Run("record", func(t *testing.T) {
+		defer testAccCassetteInsert(t)()
+		resource.UnitTest(t, testCase(t, server.URL))
+	})
+
+	// The server is gone, replaying must not send any request
+	server.Close()
+
+	if err := os.Rename(testAccCassetteRecorder.Path(t.Name()+"/record"), testAccCassetteRecorder.Path(t.Name()+"/replay")); err != nil {
+		t.Fatalf("error renaming cassette: %s", err)
+	}
+
+	testAccCassetteRecorder = cassette.New(dir, cassette.ModeReplaying)
+	testAccCassetteRecorder.Redact = testAccCassetteRedact
+
+	t.Run("replay", func(t *testing.T) {
+		defer testAccCassetteInsert(t)()
+		resource.UnitTest(t, testCase(t, server.URL))
+	})
+}
+
 func testAccPreCheck(t *testing.T) {
 	if v := os.Getenv("AWS_PROFILE"); v == "" {
 		if v := os.Getenv("AWS_ACCESS_KEY_ID"); v == "" {
@@ -78,6 +207,124 @@ func testAccPreCheck(t *testing.T) {
 	}
 }
 
+// testAccCassetteInsert inserts the cassette of the test into the recorder,
+// which also serializes the tests, and returns the function ejecting it once
+// the test completes. Replaying a test without a cassette fails it.
+func testAccCassetteInsert(t *testing.T) func() {
+	if testAccCassetteRecorder == nil || testAccCassetteRecorder.Inserted() == t.Name() {
+		return func() {}
+	}
+
+	err := testAccCassetteRecorder.Insert(t.Name())
+
+	if err == cassette.ErrNotFound {
+		t.Fatalf("no cassette recorded for %s, record it with make testacc-record", t.Name())
+	}
+
+	if err != nil {
+		t.Fatalf("error inserting cassette: %s", err)
+	}
+
+	// Replayed requests are still signed, but never sent
+	if testAccCassetteRecorder.Mode == cassette.ModeReplaying && os.Getenv("AWS_PROFILE") == "" && os.Getenv("AWS_ACCESS_KEY_ID") == "" {
+		os.Setenv("AWS_ACCESS_KEY_ID", "cassette")
+		os.Setenv("AWS_SECRET_ACCESS_KEY", "cassette")
+	}
+
+	return func() {
+		if err := testAccCassetteRecorder.Eject(); err != nil {
+			t.Errorf("error ejecting cassette: %s", err)
+		}
+	}
+}
+
+// testAccCassetteTest runs the acceptance test case like resource.Test, with
+// the cassette of the test inserted.
+func testAccCassetteTest(t *testing.T, c resource.TestCase) {
+	defer testAccCassetteInsert(t)()
+
+	resource.Test(t, c)
+}
+
+// testAccCassetteParallelTest runs the acceptance test case like
+// resource.ParallelTest, with the cassette of the test inserted.
+func testAccCassetteParallelTest(t *testing.T, c resource.TestCase) {
+	t.Parallel()
+
+	defer testAccCassetteInsert(t)()
+
+	resource.Test(t, c)
+}
+
+// testAccCassetteProviderConfigure configures the provider like
+// providerConfigure, with the requests of all the AWS clients going through
+// the cassette recorder. The credentials validation and account ID lookup
+// happen before the session can be wrapped, so they are skipped there and
+// made again through the recorder.
+func testAccCassetteProviderConfigure(d *schema.ResourceData) (interface{}, error) {
+	config, err := expandProviderConfig(d)
+	if err != nil {
+		return nil, err
+	}
+
+	skipGetEC2Platforms := config.SkipGetEC2Platforms
+	skipRequestingAccountID := config.SkipCredsValidation && config.SkipRequestingAccountId
+
+	config.SkipCredsValidation = true
+	config.SkipGetEC2Platforms = true
+	config.SkipMetadataApiCheck = true
+	config.SkipRequestingAccountId = true
+
+	meta, err := config.Client()
+	if err != nil {
+		return nil, err
+	}
+
+	client := meta.(*AWSClient)
+
+	httpClient := client.session.Config.HTTPClient
+	transport := httpClient.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	httpClient.Transport = testAccCassetteRecorder.Transport(transport)
+
+	if !skipRequestingAccountID {
+		client.accountid, client.partition, err = awsbase.GetAccountIDAndPartitionFromSTSGetCallerIdentity(client.stsconn())
+		if err != nil {
+			return nil, fmt.Errorf("error validating provider credentials: %s", err)
+		}
+
+		if err := awsbase.ValidateAccountID(client.accountid, config.AllowedAccountIds, config.ForbiddenAccountIds); err != nil {
+			return nil, err
+		}
+	}
+
+	if !skipGetEC2Platforms {
+		client.supportedplatforms, err = GetSupportedEC2Platforms(client.ec2conn())
+		if err != nil {
+			log.Printf("[WARN] Unable to get supported EC2 platforms: %s", err)
+		}
+	}
+
+	return client, nil
+}
+
+// testAccCassetteRedact redacts the sensitive fields of the recorded payloads
+// like the debug logging does. S3 object contents are recorded as is.
+func testAccCassetteRedact(service, contentType string, payload []byte) []byte {
+	switch {
+	case strings.Contains(contentType, "json") && service != "s3":
+		return []byte(redactDebugLoggingJSONPayload(service, payload))
+	case strings.Contains(contentType, "xml"):
+		return []byte(redactDebugLoggingXMLPayload(service, payload))
+	case strings.Contains(contentType, "x-www-form-urlencoded"):
+		return []byte(redactDebugLoggingQueryPayload(service, payload))
+	}
+
+	return payload
+}
+
 // testAccAwsProviderAccountID returns the account ID of an AWS provider
 func testAccAwsProviderAccountID(provider *schema.Provider) string {
 	if provider == nil {
@@ -138,6 +385,26 @@ func testAccMatchResourceAttrRegionalARN(resourceName, attributeName, arnService
 	}
 }
 
+// testAccMatchResourceAttrRegionalARNNoAccount ensures the Terraform state regexp matches a formatted ARN with region but without account ID
+func testAccMatchResourceAttrRegionalARNNoAccount(resourceName, attributeName, arnService string, arnResourceRegexp *regexp.Regexp) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		arnRegexp := arn.ARN{
+			Partition: testAccGetPartition(),
+			Region:    testAccGetRegion(),
+			Resource:  arnResourceRegexp.String(),
+			Service:   arnService,
+		}.String()
+
+		attributeMatch, err := regexp.Compile(arnRegexp)
+
+		if err != nil {
+			return fmt.Errorf("Unable to compile ARN regexp (%s): %s", arnRegexp, err)
+		}
+
+		return resource.TestMatchResourceAttr(resourceName, attributeName, attributeMatch)(s)
+	}
+}
+
 // testAccCheckResourceAttrGlobalARN ensures the Terraform state exactly matches a formatted ARN without region
 func testAccCheckResourceAttrGlobalARN(resourceName, attributeName, arnService, arnResource string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
@@ -229,7 +496,7 @@ func testAccMultipleRegionsPreCheck(t *testing.T) {
 }
 
 func testAccOrganizationsAccountPreCheck(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).organizationsconn
+	conn := testAccProvider.Meta().(*AWSClient).organizationsconn()
 	input := &organizations.DescribeOrganizationInput{}
 	_, err := conn.DescribeOrganization(input)
 	if isAWSErr(err, organizations.ErrCodeAWSOrganizationsNotInUseException, "") {
@@ -252,6 +519,30 @@ provider "aws" {
 `, os.Getenv("AWS_ALTERNATE_ACCESS_KEY_ID"), os.Getenv("AWS_ALTERNATE_PROFILE"), os.Getenv("AWS_ALTERNATE_SECRET_ACCESS_KEY"))
 }
 
+// testAccFakeAwsProviderConfig returns the configuration of a provider sending
+// all the requests of the services supported by the fake AWS API server to the
+// server at the given URL, e.g. in resource.UnitTest runs.
+func testAccFakeAwsProviderConfig(url string) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  access_key              = "fakeaws"
+  region                  = %[2]q
+  s3_force_path_style     = true
+  secret_key              = "fakeaws"
+  skip_metadata_api_check = true
+
+  endpoints {
+    ec2 = %[1]q
+    iam = %[1]q
+    s3  = %[1]q
+    sns = %[1]q
+    sqs = %[1]q
+    sts = %[1]q
+  }
+}
+`, url, testAccGetRegion())
+}
+
 func testAccAwsRegionProviderFunc(region string, providers *[]*schema.Provider) func() *schema.Provider {
 	return func() *schema.Provider {
 		if region == "" {
@@ -355,3 +646,52 @@ func testSweepSkipSweepError(err error) bool {
 	}
 	return false
 }
+
+func TestExpandProviderAssumeRoles(t *testing.T) {
+	got := expandProviderAssumeRoles([]interface{}{
+		map[string]interface{}{
+			"role_arn":         "arn:aws:iam::111111111111:role/security",
+			"session_name":     "",
+			"external_id":      "",
+			"policy":           "",
+			"duration_seconds": 0,
+			"serial_number":    "arn:aws:iam::111111111111:mfa/ci",
+			"token_code":       "123456",
+		},
+		map[string]interface{}{
+			"role_arn":         "",
+			"session_name":     "ignored",
+			"external_id":      "",
+			"policy":           "",
+			"duration_seconds": 0,
+			"serial_number":    "",
+			"token_code":       "",
+		},
+		map[string]interface{}{
+			"role_arn":         "arn:aws:iam::222222222222:role/deploy",
+			"session_name":     "ci",
+			"external_id":      "deploy",
+			"policy":           "",
+			"duration_seconds": 3600,
+			"serial_number":    "",
+			"token_code":       "",
+		},
+	})
+	expected := []*AssumeRole{
+		{
+			RoleARN:      "arn:aws:iam::111111111111:role/security",
+			SerialNumber: "arn:aws:iam::111111111111:mfa/ci",
+			TokenCode:    "123456",
+		},
+		{
+			RoleARN:         "arn:aws:iam::222222222222:role/deploy",
+			SessionName:     "ci",
+			ExternalID:      "deploy",
+			DurationSeconds: 3600,
+		},
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Fatalf("bad assume roles: %#v, expected: %#v", got, expected)
+	}
+}
@@ -129,7 +129,7 @@ func resourceAwsAcmCertificateCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsAcmCertificateCreateImported(d *schema.ResourceData, meta interface{}) error {
-	acmconn := meta.(*AWSClient).acmconn
+	acmconn := meta.(*AWSClient).acmconn()
 	resp, err := resourceAwsAcmCertificateImport(acmconn, d, false)
 	if err != nil {
 		return fmt.Errorf("Error importing certificate: %s", err)
@@ -152,7 +152,7 @@ func resourceAwsAcmCertificateCreateImported(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsAcmCertificateCreateRequested(d *schema.ResourceData, meta interface{}) error {
-	acmconn := meta.(*AWSClient).acmconn
+	acmconn := meta.(*AWSClient).acmconn()
 	params := &acm.RequestCertificateInput{
 		DomainName:       aws.String(strings.TrimSuffix(d.Get("domain_name").(string), ".")),
 		ValidationMethod: aws.String(d.Get("validation_method").(string)),
@@ -190,7 +190,7 @@ func resourceAwsAcmCertificateCreateRequested(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsAcmCertificateRead(d *schema.ResourceData, meta interface{}) error {
-	acmconn := meta.(*AWSClient).acmconn
+	acmconn := meta.(*AWSClient).acmconn()
 
 	params := &acm.DescribeCertificateInput{
 		CertificateArn: aws.String(d.Id()),
@@ -257,7 +257,7 @@ func resourceAwsAcmCertificateGuessValidationMethod(domainValidationOptions []ma
 }
 
 func resourceAwsAcmCertificateUpdate(d *schema.ResourceData, meta interface{}) error {
-	acmconn := meta.(*AWSClient).acmconn
+	acmconn := meta.(*AWSClient).acmconn()
 
 	if d.HasChange("private_key") || d.HasChange("certificate_body") || d.HasChange("certificate_chain") {
 		_, err := resourceAwsAcmCertificateImport(acmconn, d, true)
@@ -266,7 +266,7 @@ func resourceAwsAcmCertificateUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		err := setTagsACM(acmconn, d)
 		if err != nil {
 			return err
@@ -316,7 +316,7 @@ func convertValidationOptions(certificate *acm.CertificateDetail) ([]map[string]
 }
 
 func resourceAwsAcmCertificateDelete(d *schema.ResourceData, meta interface{}) error {
-	acmconn := meta.(*AWSClient).acmconn
+	acmconn := meta.(*AWSClient).acmconn()
 
 	log.Printf("[INFO] Deleting ACM Certificate: %s", d.Id())
 
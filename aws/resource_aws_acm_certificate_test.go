@@ -628,7 +628,7 @@ resource "aws_acm_certificate" "test" {
 }
 
 func testAccCheckAcmCertificateDestroy(s *terraform.State) error {
-	acmconn := testAccProvider.Meta().(*AWSClient).acmconn
+	acmconn := testAccProvider.Meta().(*AWSClient).acmconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_acm_certificate" {
@@ -253,7 +253,7 @@ func resourceAwsAcmpcaCertificateAuthority() *schema.Resource {
 }
 
 func resourceAwsAcmpcaCertificateAuthorityCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).acmpcaconn
+	conn := meta.(*AWSClient).acmpcaconn()
 
 	input := &acmpca.CreateCertificateAuthorityInput{
 		CertificateAuthorityConfiguration: expandAcmpcaCertificateAuthorityConfiguration(d.Get("certificate_authority_configuration").([]interface{})),
@@ -316,7 +316,7 @@ func resourceAwsAcmpcaCertificateAuthorityCreate(d *schema.ResourceData, meta in
 }
 
 func resourceAwsAcmpcaCertificateAuthorityRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).acmpcaconn
+	conn := meta.(*AWSClient).acmpcaconn()
 
 	describeCertificateAuthorityInput := &acmpca.DescribeCertificateAuthorityInput{
 		CertificateAuthorityArn: aws.String(d.Id()),
@@ -420,7 +420,7 @@ func resourceAwsAcmpcaCertificateAuthorityRead(d *schema.ResourceData, meta inte
 }
 
 func resourceAwsAcmpcaCertificateAuthorityUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).acmpcaconn
+	conn := meta.(*AWSClient).acmpcaconn()
 	updateCertificateAuthority := false
 
 	input := &acmpca.UpdateCertificateAuthorityInput{
@@ -448,8 +448,8 @@ func resourceAwsAcmpcaCertificateAuthorityUpdate(d *schema.ResourceData, meta in
 		}
 	}
 
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if hasTagsChange(d) {
+		oraw, nraw := getTagsChange(d)
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := diffTagsACMPCA(tagsFromMapACMPCA(o), tagsFromMapACMPCA(n))
@@ -480,7 +480,7 @@ func resourceAwsAcmpcaCertificateAuthorityUpdate(d *schema.ResourceData, meta in
 }
 
 func resourceAwsAcmpcaCertificateAuthorityDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).acmpcaconn
+	conn := meta.(*AWSClient).acmpcaconn()
 
 	input := &acmpca.DeleteCertificateAuthorityInput{
 		CertificateAuthorityArn: aws.String(d.Id()),
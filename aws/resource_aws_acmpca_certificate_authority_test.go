@@ -25,7 +25,7 @@ func testSweepAcmpcaCertificateAuthorities(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).acmpcaconn
+	conn := client.(*AWSClient).acmpcaconn()
 
 	certificateAuthorities, err := listAcmpcaCertificateAuthorities(conn)
 	if err != nil {
@@ -394,7 +394,7 @@ func TestAccAwsAcmpcaCertificateAuthority_Tags(t *testing.T) {
 }
 
 func testAccCheckAwsAcmpcaCertificateAuthorityDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).acmpcaconn
+	conn := testAccProvider.Meta().(*AWSClient).acmpcaconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_acmpca_certificate_authority" {
@@ -430,7 +430,7 @@ func testAccCheckAwsAcmpcaCertificateAuthorityExists(resourceName string, certif
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).acmpcaconn
+		conn := testAccProvider.Meta().(*AWSClient).acmpcaconn()
 		input := &acmpca.DescribeCertificateAuthorityInput{
 			CertificateAuthorityArn: aws.String(rs.Primary.ID),
 		}
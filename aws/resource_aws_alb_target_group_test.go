@@ -454,7 +454,7 @@ func testAccCheckAWSALBTargetGroupExists(n string, res *elbv2.TargetGroup) resou
 			return errors.New("No Target Group ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).elbv2conn
+		conn := testAccProvider.Meta().(*AWSClient).elbv2conn()
 
 		describe, err := conn.DescribeTargetGroups(&elbv2.DescribeTargetGroupsInput{
 			TargetGroupArns: []*string{aws.String(rs.Primary.ID)},
@@ -475,7 +475,7 @@ func testAccCheckAWSALBTargetGroupExists(n string, res *elbv2.TargetGroup) resou
 }
 
 func testAccCheckAWSALBTargetGroupDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).elbv2conn
+	conn := testAccProvider.Meta().(*AWSClient).elbv2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_alb_target_group" {
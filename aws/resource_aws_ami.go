@@ -209,7 +209,7 @@ func resourceAwsAmi() *schema.Resource {
 }
 
 func resourceAwsAmiCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).ec2conn
+	client := meta.(*AWSClient).ec2conn()
 
 	req := &ec2.RegisterImageInput{
 		Name:               aws.String(d.Get("name").(string)),
@@ -287,7 +287,7 @@ func resourceAwsAmiCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsAmiRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).ec2conn
+	client := meta.(*AWSClient).ec2conn()
 	id := d.Id()
 
 	req := &ec2.DescribeImagesInput{
@@ -397,7 +397,7 @@ func resourceAwsAmiRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsAmiUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).ec2conn
+	client := meta.(*AWSClient).ec2conn()
 
 	d.Partial(true)
 
@@ -426,7 +426,7 @@ func resourceAwsAmiUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsAmiDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).ec2conn
+	client := meta.(*AWSClient).ec2conn()
 
 	req := &ec2.DeregisterImageInput{
 		ImageId: aws.String(d.Id()),
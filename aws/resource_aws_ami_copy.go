@@ -193,7 +193,7 @@ func resourceAwsAmiCopy() *schema.Resource {
 }
 
 func resourceAwsAmiCopyCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).ec2conn
+	client := meta.(*AWSClient).ec2conn()
 
 	req := &ec2.CopyImageInput{
 		Name:          aws.String(d.Get("name").(string)),
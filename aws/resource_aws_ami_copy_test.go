@@ -92,7 +92,7 @@ func testAccCheckAWSAMICopyExists(resourceName string, image *ec2.Image) resourc
 			return fmt.Errorf("No ID set for %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		input := &ec2.DescribeImagesInput{
 			ImageIds: []*string{aws.String(rs.Primary.ID)},
@@ -113,7 +113,7 @@ func testAccCheckAWSAMICopyExists(resourceName string, image *ec2.Image) resourc
 }
 
 func testAccCheckAWSAMICopyDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ami_copy" {
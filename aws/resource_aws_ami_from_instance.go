@@ -180,7 +180,7 @@ func resourceAwsAmiFromInstance() *schema.Resource {
 }
 
 func resourceAwsAmiFromInstanceCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).ec2conn
+	client := meta.(*AWSClient).ec2conn()
 
 	req := &ec2.CreateImageInput{
 		Name:        aws.String(d.Get("name").(string)),
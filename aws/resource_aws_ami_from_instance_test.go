@@ -37,7 +37,7 @@ func TestAccAWSAMIFromInstance(t *testing.T) {
 						return fmt.Errorf("AMI id is not set")
 					}
 
-					conn := testAccProvider.Meta().(*AWSClient).ec2conn
+					conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 					req := &ec2.DescribeImagesInput{
 						ImageIds: []*string{aws.String(amiId)},
 					}
@@ -77,7 +77,7 @@ func TestAccAWSAMIFromInstance(t *testing.T) {
 			},
 		},
 		CheckDestroy: func(state *terraform.State) error {
-			conn := testAccProvider.Meta().(*AWSClient).ec2conn
+			conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 			diReq := &ec2.DescribeImagesInput{
 				ImageIds: []*string{aws.String(amiId)},
 			}
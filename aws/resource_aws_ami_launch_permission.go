@@ -34,7 +34,7 @@ func resourceAwsAmiLaunchPermission() *schema.Resource {
 }
 
 func resourceAwsAmiLaunchPermissionExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	image_id := d.Get("image_id").(string)
 	account_id := d.Get("account_id").(string)
@@ -42,7 +42,7 @@ func resourceAwsAmiLaunchPermissionExists(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsAmiLaunchPermissionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	image_id := d.Get("image_id").(string)
 	account_id := d.Get("account_id").(string)
@@ -69,7 +69,7 @@ func resourceAwsAmiLaunchPermissionRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsAmiLaunchPermissionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	image_id := d.Get("image_id").(string)
 	account_id := d.Get("account_id").(string)
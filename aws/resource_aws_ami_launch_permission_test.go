@@ -134,7 +134,7 @@ func testAccCheckAWSAMILaunchPermissionExists(resourceName string) resource.Test
 			return fmt.Errorf("No resource ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 		accountID := rs.Primary.Attributes["account_id"]
 		imageID := rs.Primary.Attributes["image_id"]
 
@@ -153,7 +153,7 @@ func testAccCheckAWSAMILaunchPermissionDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 		accountID := rs.Primary.Attributes["account_id"]
 		imageID := rs.Primary.Attributes["image_id"]
 
@@ -178,7 +178,7 @@ func testAccCheckAWSAMILaunchPermissionAddPublic(resourceName string) resource.T
 			return fmt.Errorf("No resource ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 		imageID := rs.Primary.Attributes["image_id"]
 
 		input := &ec2.ModifyImageAttributeInput{
@@ -208,7 +208,7 @@ func testAccCheckAWSAMILaunchPermissionDisappears(resourceName string) resource.
 			return fmt.Errorf("No resource ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 		accountID := rs.Primary.Attributes["account_id"]
 		imageID := rs.Primary.Attributes["image_id"]
 
@@ -233,7 +233,7 @@ func testAccCheckAWSAMILaunchPermissionDisappears(resourceName string) resource.
 // so we can test that Terraform will react properly
 func testAccAWSAMIDisappears(imageID *string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 		req := &ec2.DeregisterImageInput{
 			ImageId: aws.String(*imageID),
 		}
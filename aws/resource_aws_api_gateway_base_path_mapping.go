@@ -50,7 +50,7 @@ func resourceAwsApiGatewayBasePathMapping() *schema.Resource {
 }
 
 func resourceAwsApiGatewayBasePathMappingCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).apigateway
+	conn := meta.(*AWSClient).apigateway()
 
 	err := resource.Retry(30*time.Second, func() *resource.RetryError {
 		_, err := conn.CreateBasePathMapping(&apigateway.CreateBasePathMappingInput{
@@ -84,7 +84,7 @@ func resourceAwsApiGatewayBasePathMappingCreate(d *schema.ResourceData, meta int
 }
 
 func resourceAwsApiGatewayBasePathMappingRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).apigateway
+	conn := meta.(*AWSClient).apigateway()
 
 	domainName, basePath, err := decodeApiGatewayBasePathMappingId(d.Id())
 	if err != nil {
@@ -120,7 +120,7 @@ func resourceAwsApiGatewayBasePathMappingRead(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsApiGatewayBasePathMappingDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).apigateway
+	conn := meta.(*AWSClient).apigateway()
 
 	domainName, basePath, err := decodeApiGatewayBasePathMappingId(d.Id())
 	if err != nil {
@@ -69,7 +69,7 @@ func testAccCheckAWSAPIGatewayModelExists(n string, res *apigateway.Model) resou
 			return fmt.Errorf("No API Gateway Model ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).apigateway
+		conn := testAccProvider.Meta().(*AWSClient).apigateway()
 
 		req := &apigateway.GetModelInput{
 			ModelName: aws.String("test"),
@@ -90,7 +90,7 @@ func testAccCheckAWSAPIGatewayModelExists(n string, res *apigateway.Model) resou
 }
 
 func testAccCheckAWSAPIGatewayModelDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).apigateway
+	conn := testAccProvider.Meta().(*AWSClient).apigateway()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_api_gateway_model" {
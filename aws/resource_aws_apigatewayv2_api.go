@@ -0,0 +1,240 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsApiGatewayV2Api() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsApiGatewayV2ApiCreate,
+		Read:   resourceAwsApiGatewayV2ApiRead,
+		Update: resourceAwsApiGatewayV2ApiUpdate,
+		Delete: resourceAwsApiGatewayV2ApiDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"api_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"api_key_selection_expression": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "$request.header.x-api-key",
+				ValidateFunc: validation.StringInSlice([]string{
+					"$context.authorizer.usageIdentifierKey",
+					"$request.header.x-api-key",
+				}, false),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"execution_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"protocol_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					apigatewayv2.ProtocolTypeWebsocket,
+				}, false),
+			},
+			"route_selection_expression": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+		},
+	}
+}
+
+func resourceAwsApiGatewayV2ApiCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2conn()
+
+	input := &apigatewayv2.CreateApiInput{
+		ApiKeySelectionExpression: aws.String(d.Get("api_key_selection_expression").(string)),
+		Name:                      aws.String(d.Get("name").(string)),
+		ProtocolType:              aws.String(d.Get("protocol_type").(string)),
+		RouteSelectionExpression:  aws.String(d.Get("route_selection_expression").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("version"); ok {
+		input.Version = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating API Gateway v2 API: %s", input)
+	output, err := conn.CreateApi(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating API Gateway v2 API: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.ApiId))
+
+	return resourceAwsApiGatewayV2ApiRead(d, meta)
+}
+
+func resourceAwsApiGatewayV2ApiRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2conn()
+
+	output, err := conn.GetApi(&apigatewayv2.GetApiInput{
+		ApiId: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, apigatewayv2.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] API Gateway v2 API (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading API Gateway v2 API (%s): %s", d.Id(), err)
+	}
+
+	d.Set("api_endpoint", output.ApiEndpoint)
+	d.Set("api_key_selection_expression", output.ApiKeySelectionExpression)
+	d.Set("arn", apiGatewayV2ApiArn(meta.(*AWSClient), d.Id()))
+	d.Set("description", output.Description)
+	d.Set("execution_arn", apiGatewayV2ApiExecutionArn(meta.(*AWSClient), d.Id()))
+	d.Set("name", output.Name)
+	d.Set("protocol_type", output.ProtocolType)
+	d.Set("route_selection_expression", output.RouteSelectionExpression)
+	d.Set("version", output.Version)
+
+	return nil
+}
+
+func resourceAwsApiGatewayV2ApiUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2conn()
+
+	input := &apigatewayv2.UpdateApiInput{
+		ApiId: aws.String(d.Id()),
+	}
+
+	if d.HasChange("api_key_selection_expression") {
+		input.ApiKeySelectionExpression = aws.String(d.Get("api_key_selection_expression").(string))
+	}
+
+	if d.HasChange("description") {
+		input.Description = aws.String(d.Get("description").(string))
+	}
+
+	if d.HasChange("name") {
+		input.Name = aws.String(d.Get("name").(string))
+	}
+
+	if d.HasChange("route_selection_expression") {
+		input.RouteSelectionExpression = aws.String(d.Get("route_selection_expression").(string))
+	}
+
+	if d.HasChange("version") {
+		input.Version = aws.String(d.Get("version").(string))
+	}
+
+	log.Printf("[DEBUG] Updating API Gateway v2 API: %s", input)
+	if _, err := conn.UpdateApi(input); err != nil {
+		return fmt.Errorf("error updating API Gateway v2 API (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsApiGatewayV2ApiRead(d, meta)
+}
+
+func resourceAwsApiGatewayV2ApiDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2conn()
+
+	log.Printf("[DEBUG] Deleting API Gateway v2 API: %s", d.Id())
+	_, err := conn.DeleteApi(&apigatewayv2.DeleteApiInput{
+		ApiId: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, apigatewayv2.ErrCodeNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting API Gateway v2 API (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func apiGatewayV2ApiArn(client *AWSClient, apiId string) string {
+	return arn.ARN{
+		Partition: client.partition,
+		Service:   "apigateway",
+		Region:    client.region,
+		Resource:  fmt.Sprintf("/apis/%s", apiId),
+	}.String()
+}
+
+func apiGatewayV2ApiExecutionArn(client *AWSClient, apiId string) string {
+	return arn.ARN{
+		Partition: client.partition,
+		Service:   "execute-api",
+		Region:    client.region,
+		AccountID: client.accountid,
+		Resource:  apiId,
+	}.String()
+}
+
+// apiGatewayV2ApiHostname returns the hostname of an API in the region, with
+// the DNS suffix of the region partition. The execute-api service is missing
+// from the endpoints model, its hostname follows the partition default.
+func apiGatewayV2ApiHostname(region, apiId string) (string, error) {
+	endpoint, err := endpoints.DefaultResolver().EndpointFor("execute-api", region, endpoints.ResolveUnknownServiceOption)
+	if err != nil {
+		return "", fmt.Errorf("error resolving API Gateway v2 API (%s) endpoint: %s", apiId, err)
+	}
+
+	return fmt.Sprintf("%s.%s", apiId, strings.TrimPrefix(endpoint.URL, "https://")), nil
+}
+
+// parseApiGatewayV2ImportId splits an import ID of the form
+// API-ID/CHILD-ID/... into the expected number of parts.
+func parseApiGatewayV2ImportId(id string, n int, format string) ([]string, error) {
+	parts := strings.Split(id, "/")
+
+	if len(parts) != n {
+		return nil, fmt.Errorf("unexpected format of ID (%q), expected %s", id, format)
+	}
+
+	for _, part := range parts {
+		if part == "" {
+			return nil, fmt.Errorf("unexpected format of ID (%q), expected %s", id, format)
+		}
+	}
+
+	return parts, nil
+}
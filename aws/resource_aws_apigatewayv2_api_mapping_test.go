@@ -0,0 +1,120 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSAPIGatewayV2ApiMapping_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	domainName := fmt.Sprintf("%s.terraformtest.com", rName)
+	resourceName := "aws_apigatewayv2_api_mapping.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProvidersWithTLS,
+		CheckDestroy: testAccCheckAWSAPIGatewayV2ApiMappingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAPIGatewayV2ApiMappingConfig_basic(rName, domainName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayV2ApiMappingExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "api_id", "aws_apigatewayv2_api.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "api_mapping_key", ""),
+					resource.TestCheckResourceAttr(resourceName, "domain_name", domainName),
+					resource.TestCheckResourceAttrPair(resourceName, "stage", "aws_apigatewayv2_stage.test", "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateIdFunc: testAccAWSAPIGatewayV2ApiMappingImportStateIdFunc(resourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSAPIGatewayV2ApiMappingConfig_basic(rName, domainName, "mapping"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayV2ApiMappingExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "api_mapping_key", "mapping"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAPIGatewayV2ApiMappingDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_apigatewayv2_api_mapping" {
+			continue
+		}
+
+		_, err := conn.GetApiMapping(&apigatewayv2.GetApiMappingInput{
+			ApiMappingId: aws.String(rs.Primary.ID),
+			DomainName:   aws.String(rs.Primary.Attributes["domain_name"]),
+		})
+
+		if isAWSErr(err, apigatewayv2.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("API Gateway v2 API mapping (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSAPIGatewayV2ApiMappingExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No API Gateway v2 API mapping ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn()
+
+		_, err := conn.GetApiMapping(&apigatewayv2.GetApiMappingInput{
+			ApiMappingId: aws.String(rs.Primary.ID),
+			DomainName:   aws.String(rs.Primary.Attributes["domain_name"]),
+		})
+
+		return err
+	}
+}
+
+func testAccAWSAPIGatewayV2ApiMappingImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.ID, rs.Primary.Attributes["domain_name"]), nil
+	}
+}
+
+func testAccAWSAPIGatewayV2ApiMappingConfig_basic(rName, domainName, apiMappingKey string) string {
+	return testAccAWSAPIGatewayV2DomainNameConfig_basic(domainName) + testAccAWSAPIGatewayV2StageConfig_basic(rName) + fmt.Sprintf(`
+resource "aws_apigatewayv2_api_mapping" "test" {
+  api_id          = "${aws_apigatewayv2_api.test.id}"
+  api_mapping_key = %[1]q
+  domain_name     = "${aws_apigatewayv2_domain_name.test.id}"
+  stage           = "${aws_apigatewayv2_stage.test.id}"
+}
+`, apiMappingKey)
+}
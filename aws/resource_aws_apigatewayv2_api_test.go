@@ -0,0 +1,202 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestApiGatewayV2ApiHostname(t *testing.T) {
+	cases := []struct {
+		Region   string
+		Expected string
+	}{
+		{
+			Region:   "us-west-2",
+			Expected: "abcdef1234.execute-api.us-west-2.amazonaws.com",
+		},
+		{
+			Region:   "cn-north-1",
+			Expected: "abcdef1234.execute-api.cn-north-1.amazonaws.com.cn",
+		},
+		{
+			Region:   "us-gov-west-1",
+			Expected: "abcdef1234.execute-api.us-gov-west-1.amazonaws.com",
+		},
+	}
+
+	for _, tc := range cases {
+		hostname, err := apiGatewayV2ApiHostname(tc.Region, "abcdef1234")
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tc.Region, err)
+		}
+
+		if hostname != tc.Expected {
+			t.Fatalf("%s: expected %q, received %q", tc.Region, tc.Expected, hostname)
+		}
+	}
+}
+
+func TestAccAWSAPIGatewayV2Api_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_apigatewayv2_api.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAPIGatewayV2ApiDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAPIGatewayV2ApiConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayV2ApiExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "api_endpoint", regexp.MustCompile(`^wss://`)),
+					resource.TestCheckResourceAttr(resourceName, "api_key_selection_expression", "$request.header.x-api-key"),
+					testAccMatchResourceAttrRegionalARNNoAccount(resourceName, "arn", "apigateway", regexp.MustCompile(`/apis/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					testAccMatchResourceAttrRegionalARN(resourceName, "execution_arn", "execute-api", regexp.MustCompile(`.+`)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "protocol_type", apigatewayv2.ProtocolTypeWebsocket),
+					resource.TestCheckResourceAttr(resourceName, "route_selection_expression", "$request.body.action"),
+					resource.TestCheckResourceAttr(resourceName, "version", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAPIGatewayV2Api_AllAttributes(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_apigatewayv2_api.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAPIGatewayV2ApiDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAPIGatewayV2ApiConfig_allAttributes(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayV2ApiExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "api_key_selection_expression", "$context.authorizer.usageIdentifierKey"),
+					resource.TestCheckResourceAttr(resourceName, "description", "test description"),
+					resource.TestCheckResourceAttr(resourceName, "route_selection_expression", "$request.body.service"),
+					resource.TestCheckResourceAttr(resourceName, "version", "v1"),
+				),
+			},
+			{
+				Config: testAccAWSAPIGatewayV2ApiConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayV2ApiExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "api_key_selection_expression", "$request.header.x-api-key"),
+					resource.TestCheckResourceAttr(resourceName, "route_selection_expression", "$request.body.action"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAPIGatewayV2ApiDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_apigatewayv2_api" {
+			continue
+		}
+
+		_, err := conn.GetApi(&apigatewayv2.GetApiInput{
+			ApiId: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, apigatewayv2.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("API Gateway v2 API (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSAPIGatewayV2ApiExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No API Gateway v2 API ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn()
+
+		_, err := conn.GetApi(&apigatewayv2.GetApiInput{
+			ApiId: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+// testAccAWSAPIGatewayV2ImportStateIdFunc returns an import ID made of the
+// API ID followed by the given attributes and the resource ID.
+func testAccAWSAPIGatewayV2ImportStateIdFunc(resourceName string, attributes ...string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id := rs.Primary.Attributes["api_id"]
+
+		for _, attribute := range attributes {
+			id = fmt.Sprintf("%s/%s", id, rs.Primary.Attributes[attribute])
+		}
+
+		return fmt.Sprintf("%s/%s", id, rs.Primary.ID), nil
+	}
+}
+
+func testAccAWSAPIGatewayV2ApiConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_apigatewayv2_api" "test" {
+  name                       = %[1]q
+  protocol_type              = "WEBSOCKET"
+  route_selection_expression = "$request.body.action"
+}
+`, rName)
+}
+
+func testAccAWSAPIGatewayV2ApiConfig_allAttributes(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_apigatewayv2_api" "test" {
+  api_key_selection_expression = "$context.authorizer.usageIdentifierKey"
+  description                  = "test description"
+  name                         = %[1]q
+  protocol_type                = "WEBSOCKET"
+  route_selection_expression   = "$request.body.service"
+  version                      = "v1"
+}
+`, rName)
+}
@@ -0,0 +1,171 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSAPIGatewayV2Authorizer_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_apigatewayv2_authorizer.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAPIGatewayV2AuthorizerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAPIGatewayV2AuthorizerConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayV2AuthorizerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "authorizer_credentials_arn", ""),
+					resource.TestCheckResourceAttr(resourceName, "authorizer_result_ttl_in_seconds", "300"),
+					resource.TestCheckResourceAttr(resourceName, "authorizer_type", apigatewayv2.AuthorizerTypeRequest),
+					resource.TestCheckResourceAttrPair(resourceName, "authorizer_uri", "aws_lambda_function.test", "invoke_arn"),
+					resource.TestCheckResourceAttr(resourceName, "identity_sources.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateIdFunc: testAccAWSAPIGatewayV2ImportStateIdFunc(resourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSAPIGatewayV2AuthorizerConfig_credentials(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayV2AuthorizerExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "authorizer_credentials_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "authorizer_result_ttl_in_seconds", "60"),
+					resource.TestCheckResourceAttr(resourceName, "identity_sources.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAPIGatewayV2AuthorizerDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_apigatewayv2_authorizer" {
+			continue
+		}
+
+		_, err := conn.GetAuthorizer(&apigatewayv2.GetAuthorizerInput{
+			ApiId:        aws.String(rs.Primary.Attributes["api_id"]),
+			AuthorizerId: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, apigatewayv2.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("API Gateway v2 authorizer (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSAPIGatewayV2AuthorizerExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No API Gateway v2 authorizer ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn()
+
+		_, err := conn.GetAuthorizer(&apigatewayv2.GetAuthorizerInput{
+			ApiId:        aws.String(rs.Primary.Attributes["api_id"]),
+			AuthorizerId: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccAWSAPIGatewayV2AuthorizerConfigBase(rName string) string {
+	return testAccAWSAPIGatewayV2ApiConfig_basic(rName) + fmt.Sprintf(`
+data "aws_iam_policy_document" "lambda_assume" {
+  statement {
+    actions = ["sts:AssumeRole"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["lambda.amazonaws.com"]
+    }
+  }
+}
+
+resource "aws_iam_role" "lambda" {
+  assume_role_policy = "${data.aws_iam_policy_document.lambda_assume.json}"
+  name               = "%[1]s-lambda"
+}
+
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  handler       = "index.handler"
+  role          = "${aws_iam_role.lambda.arn}"
+  runtime       = "nodejs8.10"
+}
+`, rName)
+}
+
+func testAccAWSAPIGatewayV2AuthorizerConfig_basic(rName string) string {
+	return testAccAWSAPIGatewayV2AuthorizerConfigBase(rName) + fmt.Sprintf(`
+resource "aws_apigatewayv2_authorizer" "test" {
+  api_id           = "${aws_apigatewayv2_api.test.id}"
+  authorizer_type  = "REQUEST"
+  authorizer_uri   = "${aws_lambda_function.test.invoke_arn}"
+  identity_sources = ["route.request.header.Auth"]
+  name             = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSAPIGatewayV2AuthorizerConfig_credentials(rName string) string {
+	return testAccAWSAPIGatewayV2AuthorizerConfigBase(rName) + fmt.Sprintf(`
+data "aws_iam_policy_document" "apigateway_assume" {
+  statement {
+    actions = ["sts:AssumeRole"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["apigateway.amazonaws.com"]
+    }
+  }
+}
+
+resource "aws_iam_role" "test" {
+  assume_role_policy = "${data.aws_iam_policy_document.apigateway_assume.json}"
+  name               = %[1]q
+}
+
+resource "aws_apigatewayv2_authorizer" "test" {
+  api_id                           = "${aws_apigatewayv2_api.test.id}"
+  authorizer_credentials_arn       = "${aws_iam_role.test.arn}"
+  authorizer_result_ttl_in_seconds = 60
+  authorizer_type                  = "REQUEST"
+  authorizer_uri                   = "${aws_lambda_function.test.invoke_arn}"
+  identity_sources                 = ["route.request.header.Auth", "route.request.querystring.Name"]
+  name                             = %[1]q
+}
+`, rName)
+}
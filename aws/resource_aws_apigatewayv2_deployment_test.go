@@ -0,0 +1,127 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSAPIGatewayV2Deployment_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_apigatewayv2_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAPIGatewayV2DeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAPIGatewayV2DeploymentConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayV2DeploymentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "test description"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateIdFunc: testAccAWSAPIGatewayV2ImportStateIdFunc(resourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSAPIGatewayV2DeploymentConfig_descriptionUpdated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayV2DeploymentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated description"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAPIGatewayV2DeploymentDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_apigatewayv2_deployment" {
+			continue
+		}
+
+		_, err := conn.GetDeployment(&apigatewayv2.GetDeploymentInput{
+			ApiId:        aws.String(rs.Primary.Attributes["api_id"]),
+			DeploymentId: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, apigatewayv2.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("API Gateway v2 deployment (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSAPIGatewayV2DeploymentExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No API Gateway v2 deployment ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn()
+
+		_, err := conn.GetDeployment(&apigatewayv2.GetDeploymentInput{
+			ApiId:        aws.String(rs.Primary.Attributes["api_id"]),
+			DeploymentId: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccAWSAPIGatewayV2DeploymentConfigBase(rName string) string {
+	return testAccAWSAPIGatewayV2ApiConfig_basic(rName) + `
+resource "aws_apigatewayv2_integration" "test" {
+  api_id           = "${aws_apigatewayv2_api.test.id}"
+  integration_type = "MOCK"
+}
+
+resource "aws_apigatewayv2_route" "test" {
+  api_id    = "${aws_apigatewayv2_api.test.id}"
+  route_key = "$default"
+  target    = "integrations/${aws_apigatewayv2_integration.test.id}"
+}
+`
+}
+
+func testAccAWSAPIGatewayV2DeploymentConfig_basic(rName string) string {
+	return testAccAWSAPIGatewayV2DeploymentConfigBase(rName) + `
+resource "aws_apigatewayv2_deployment" "test" {
+  api_id      = "${aws_apigatewayv2_route.test.api_id}"
+  description = "test description"
+}
+`
+}
+
+func testAccAWSAPIGatewayV2DeploymentConfig_descriptionUpdated(rName string) string {
+	return testAccAWSAPIGatewayV2DeploymentConfigBase(rName) + `
+resource "aws_apigatewayv2_deployment" "test" {
+  api_id      = "${aws_apigatewayv2_route.test.api_id}"
+  description = "updated description"
+}
+`
+}
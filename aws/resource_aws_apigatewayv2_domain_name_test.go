@@ -0,0 +1,116 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSAPIGatewayV2DomainName_basic(t *testing.T) {
+	domainName := fmt.Sprintf("%s.terraformtest.com", acctest.RandomWithPrefix("tf-acc-test"))
+	resourceName := "aws_apigatewayv2_domain_name.test"
+	certificateResourceName := "aws_acm_certificate.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProvidersWithTLS,
+		CheckDestroy: testAccCheckAWSAPIGatewayV2DomainNameDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAPIGatewayV2DomainNameConfig_basic(domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayV2DomainNameExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "api_mapping_selection_expression"),
+					testAccMatchResourceAttrRegionalARNNoAccount(resourceName, "arn", "apigateway", regexp.MustCompile(`/domainnames/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "domain_name", domainName),
+					resource.TestCheckResourceAttr(resourceName, "domain_name_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "domain_name_configuration.0.certificate_arn", certificateResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "domain_name_configuration.0.endpoint_type", apigatewayv2.EndpointTypeRegional),
+					resource.TestCheckResourceAttrSet(resourceName, "domain_name_configuration.0.hosted_zone_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "domain_name_configuration.0.target_domain_name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAPIGatewayV2DomainNameDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_apigatewayv2_domain_name" {
+			continue
+		}
+
+		_, err := conn.GetDomainName(&apigatewayv2.GetDomainNameInput{
+			DomainName: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, apigatewayv2.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("API Gateway v2 domain name (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSAPIGatewayV2DomainNameExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No API Gateway v2 domain name ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn()
+
+		_, err := conn.GetDomainName(&apigatewayv2.GetDomainNameInput{
+			DomainName: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccAWSAPIGatewayV2DomainNameConfigBase() string {
+	return testAccAWSAPIGatewayCerts("*.terraformtest.com") + `
+resource "aws_acm_certificate" "test" {
+  certificate_body  = "${tls_locally_signed_cert.leaf.cert_pem}"
+  certificate_chain = "${tls_self_signed_cert.ca.cert_pem}"
+  private_key       = "${tls_private_key.test.private_key_pem}"
+}
+`
+}
+
+func testAccAWSAPIGatewayV2DomainNameConfig_basic(domainName string) string {
+	return testAccAWSAPIGatewayV2DomainNameConfigBase() + fmt.Sprintf(`
+resource "aws_apigatewayv2_domain_name" "test" {
+  domain_name = %[1]q
+
+  domain_name_configuration {
+    certificate_arn = "${aws_acm_certificate.test.arn}"
+    endpoint_type   = "REGIONAL"
+  }
+}
+`, domainName)
+}
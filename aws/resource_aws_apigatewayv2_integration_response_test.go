@@ -0,0 +1,137 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSAPIGatewayV2IntegrationResponse_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_apigatewayv2_integration_response.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAPIGatewayV2IntegrationResponseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAPIGatewayV2IntegrationResponseConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayV2IntegrationResponseExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "content_handling_strategy", ""),
+					resource.TestCheckResourceAttr(resourceName, "integration_response_key", "/200/"),
+					resource.TestCheckResourceAttr(resourceName, "response_templates.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateIdFunc: testAccAWSAPIGatewayV2ImportStateIdFunc(resourceName, "integration_id"),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSAPIGatewayV2IntegrationResponseConfig_allAttributes(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayV2IntegrationResponseExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "content_handling_strategy", apigatewayv2.ContentHandlingStrategyConvertToText),
+					resource.TestCheckResourceAttr(resourceName, "integration_response_key", "$default"),
+					resource.TestCheckResourceAttr(resourceName, "response_templates.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "template_selection_expression", "$default"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAPIGatewayV2IntegrationResponseDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_apigatewayv2_integration_response" {
+			continue
+		}
+
+		_, err := conn.GetIntegrationResponse(&apigatewayv2.GetIntegrationResponseInput{
+			ApiId:                 aws.String(rs.Primary.Attributes["api_id"]),
+			IntegrationId:         aws.String(rs.Primary.Attributes["integration_id"]),
+			IntegrationResponseId: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, apigatewayv2.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("API Gateway v2 integration response (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSAPIGatewayV2IntegrationResponseExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No API Gateway v2 integration response ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn()
+
+		_, err := conn.GetIntegrationResponse(&apigatewayv2.GetIntegrationResponseInput{
+			ApiId:                 aws.String(rs.Primary.Attributes["api_id"]),
+			IntegrationId:         aws.String(rs.Primary.Attributes["integration_id"]),
+			IntegrationResponseId: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccAWSAPIGatewayV2IntegrationResponseConfig_basic(rName string) string {
+	return testAccAWSAPIGatewayV2ApiConfig_basic(rName) + `
+resource "aws_apigatewayv2_integration" "test" {
+  api_id           = "${aws_apigatewayv2_api.test.id}"
+  integration_type = "MOCK"
+}
+
+resource "aws_apigatewayv2_integration_response" "test" {
+  api_id                   = "${aws_apigatewayv2_api.test.id}"
+  integration_id           = "${aws_apigatewayv2_integration.test.id}"
+  integration_response_key = "/200/"
+}
+`
+}
+
+func testAccAWSAPIGatewayV2IntegrationResponseConfig_allAttributes(rName string) string {
+	return testAccAWSAPIGatewayV2ApiConfig_basic(rName) + `
+resource "aws_apigatewayv2_integration" "test" {
+  api_id           = "${aws_apigatewayv2_api.test.id}"
+  integration_type = "MOCK"
+}
+
+resource "aws_apigatewayv2_integration_response" "test" {
+  api_id                        = "${aws_apigatewayv2_api.test.id}"
+  content_handling_strategy     = "CONVERT_TO_TEXT"
+  integration_id                = "${aws_apigatewayv2_integration.test.id}"
+  integration_response_key      = "$default"
+  template_selection_expression = "$default"
+
+  response_templates = {
+    "$default" = "{\"statusCode\": 200}"
+  }
+}
+`
+}
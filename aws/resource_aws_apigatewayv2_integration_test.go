@@ -0,0 +1,129 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSAPIGatewayV2Integration_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_apigatewayv2_integration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAPIGatewayV2IntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAPIGatewayV2IntegrationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayV2IntegrationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "connection_type", apigatewayv2.ConnectionTypeInternet),
+					resource.TestCheckResourceAttr(resourceName, "integration_type", apigatewayv2.IntegrationTypeMock),
+					resource.TestCheckResourceAttr(resourceName, "passthrough_behavior", apigatewayv2.PassthroughBehaviorWhenNoMatch),
+					resource.TestCheckResourceAttr(resourceName, "timeout_milliseconds", "29000"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateIdFunc: testAccAWSAPIGatewayV2ImportStateIdFunc(resourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSAPIGatewayV2IntegrationConfig_allAttributes(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayV2IntegrationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "content_handling_strategy", apigatewayv2.ContentHandlingStrategyConvertToText),
+					resource.TestCheckResourceAttr(resourceName, "description", "test description"),
+					resource.TestCheckResourceAttr(resourceName, "passthrough_behavior", apigatewayv2.PassthroughBehaviorWhenNoTemplates),
+					resource.TestCheckResourceAttr(resourceName, "request_templates.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "template_selection_expression", "$request.body.name"),
+					resource.TestCheckResourceAttr(resourceName, "timeout_milliseconds", "28999"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAPIGatewayV2IntegrationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_apigatewayv2_integration" {
+			continue
+		}
+
+		_, err := conn.GetIntegration(&apigatewayv2.GetIntegrationInput{
+			ApiId:         aws.String(rs.Primary.Attributes["api_id"]),
+			IntegrationId: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, apigatewayv2.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("API Gateway v2 integration (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSAPIGatewayV2IntegrationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No API Gateway v2 integration ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn()
+
+		_, err := conn.GetIntegration(&apigatewayv2.GetIntegrationInput{
+			ApiId:         aws.String(rs.Primary.Attributes["api_id"]),
+			IntegrationId: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccAWSAPIGatewayV2IntegrationConfig_basic(rName string) string {
+	return testAccAWSAPIGatewayV2ApiConfig_basic(rName) + `
+resource "aws_apigatewayv2_integration" "test" {
+  api_id           = "${aws_apigatewayv2_api.test.id}"
+  integration_type = "MOCK"
+}
+`
+}
+
+func testAccAWSAPIGatewayV2IntegrationConfig_allAttributes(rName string) string {
+	return testAccAWSAPIGatewayV2ApiConfig_basic(rName) + `
+resource "aws_apigatewayv2_integration" "test" {
+  api_id                        = "${aws_apigatewayv2_api.test.id}"
+  content_handling_strategy     = "CONVERT_TO_TEXT"
+  description                   = "test description"
+  integration_type              = "MOCK"
+  passthrough_behavior          = "WHEN_NO_TEMPLATES"
+  template_selection_expression = "$request.body.name"
+  timeout_milliseconds          = 28999
+
+  request_templates = {
+    "123" = "456"
+  }
+}
+`
+}
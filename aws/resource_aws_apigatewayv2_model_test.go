@@ -0,0 +1,138 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSAPIGatewayV2Model_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_apigatewayv2_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAPIGatewayV2ModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAPIGatewayV2ModelConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayV2ModelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "content_type", "application/json"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", "testmodel"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateIdFunc: testAccAWSAPIGatewayV2ImportStateIdFunc(resourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSAPIGatewayV2ModelConfig_description(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayV2ModelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "test description"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAPIGatewayV2ModelDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_apigatewayv2_model" {
+			continue
+		}
+
+		_, err := conn.GetModel(&apigatewayv2.GetModelInput{
+			ApiId:   aws.String(rs.Primary.Attributes["api_id"]),
+			ModelId: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, apigatewayv2.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("API Gateway v2 model (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSAPIGatewayV2ModelExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No API Gateway v2 model ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn()
+
+		_, err := conn.GetModel(&apigatewayv2.GetModelInput{
+			ApiId:   aws.String(rs.Primary.Attributes["api_id"]),
+			ModelId: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+const testAccAWSAPIGatewayV2ModelSchema = `
+{
+  "$schema": "http://json-schema.org/draft-04/schema#",
+  "title": "ExampleModel",
+  "type": "object",
+  "properties": {
+    "id": {
+      "type": "string"
+    }
+  }
+}
+`
+
+func testAccAWSAPIGatewayV2ModelConfig_basic(rName string) string {
+	return testAccAWSAPIGatewayV2ApiConfig_basic(rName) + fmt.Sprintf(`
+resource "aws_apigatewayv2_model" "test" {
+  api_id       = "${aws_apigatewayv2_api.test.id}"
+  content_type = "application/json"
+  name         = "testmodel"
+
+  schema = <<EOF
+%[1]s
+EOF
+}
+`, testAccAWSAPIGatewayV2ModelSchema)
+}
+
+func testAccAWSAPIGatewayV2ModelConfig_description(rName string) string {
+	return testAccAWSAPIGatewayV2ApiConfig_basic(rName) + fmt.Sprintf(`
+resource "aws_apigatewayv2_model" "test" {
+  api_id       = "${aws_apigatewayv2_api.test.id}"
+  content_type = "application/json"
+  description  = "test description"
+  name         = "testmodel"
+
+  schema = <<EOF
+%[1]s
+EOF
+}
+`, testAccAWSAPIGatewayV2ModelSchema)
+}
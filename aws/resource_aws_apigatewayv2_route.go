@@ -0,0 +1,260 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsApiGatewayV2Route() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsApiGatewayV2RouteCreate,
+		Read:   resourceAwsApiGatewayV2RouteRead,
+		Update: resourceAwsApiGatewayV2RouteUpdate,
+		Delete: resourceAwsApiGatewayV2RouteDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsApiGatewayV2RouteImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"api_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"api_key_required": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"authorization_scopes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"authorization_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  apigatewayv2.AuthorizationTypeNone,
+				ValidateFunc: validation.StringInSlice([]string{
+					apigatewayv2.AuthorizationTypeAwsIam,
+					apigatewayv2.AuthorizationTypeCustom,
+					apigatewayv2.AuthorizationTypeNone,
+				}, false),
+			},
+			"authorizer_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"model_selection_expression": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"operation_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"request_models": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"route_key": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"route_response_selection_expression": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"target": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceAwsApiGatewayV2RouteCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2conn()
+
+	input := &apigatewayv2.CreateRouteInput{
+		ApiId:             aws.String(d.Get("api_id").(string)),
+		ApiKeyRequired:    aws.Bool(d.Get("api_key_required").(bool)),
+		AuthorizationType: aws.String(d.Get("authorization_type").(string)),
+		RouteKey:          aws.String(d.Get("route_key").(string)),
+	}
+
+	if v, ok := d.GetOk("authorization_scopes"); ok {
+		input.AuthorizationScopes = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("authorizer_id"); ok {
+		input.AuthorizerId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("model_selection_expression"); ok {
+		input.ModelSelectionExpression = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("operation_name"); ok {
+		input.OperationName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("request_models"); ok {
+		input.RequestModels = stringMapToPointers(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("route_response_selection_expression"); ok {
+		input.RouteResponseSelectionExpression = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("target"); ok {
+		input.Target = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating API Gateway v2 route: %s", input)
+	output, err := conn.CreateRoute(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating API Gateway v2 route: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.RouteId))
+
+	return resourceAwsApiGatewayV2RouteRead(d, meta)
+}
+
+func resourceAwsApiGatewayV2RouteRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2conn()
+
+	output, err := conn.GetRoute(&apigatewayv2.GetRouteInput{
+		ApiId:   aws.String(d.Get("api_id").(string)),
+		RouteId: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, apigatewayv2.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] API Gateway v2 route (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading API Gateway v2 route (%s): %s", d.Id(), err)
+	}
+
+	d.Set("api_key_required", output.ApiKeyRequired)
+
+	if err := d.Set("authorization_scopes", flattenStringSet(output.AuthorizationScopes)); err != nil {
+		return fmt.Errorf("error setting authorization_scopes: %s", err)
+	}
+
+	d.Set("authorization_type", output.AuthorizationType)
+	d.Set("authorizer_id", output.AuthorizerId)
+	d.Set("model_selection_expression", output.ModelSelectionExpression)
+	d.Set("operation_name", output.OperationName)
+
+	if err := d.Set("request_models", pointersMapToStringList(output.RequestModels)); err != nil {
+		return fmt.Errorf("error setting request_models: %s", err)
+	}
+
+	d.Set("route_key", output.RouteKey)
+	d.Set("route_response_selection_expression", output.RouteResponseSelectionExpression)
+	d.Set("target", output.Target)
+
+	return nil
+}
+
+func resourceAwsApiGatewayV2RouteUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2conn()
+
+	input := &apigatewayv2.UpdateRouteInput{
+		ApiId:   aws.String(d.Get("api_id").(string)),
+		RouteId: aws.String(d.Id()),
+	}
+
+	if d.HasChange("api_key_required") {
+		input.ApiKeyRequired = aws.Bool(d.Get("api_key_required").(bool))
+	}
+
+	if d.HasChange("authorization_scopes") {
+		input.AuthorizationScopes = expandStringSet(d.Get("authorization_scopes").(*schema.Set))
+	}
+
+	if d.HasChange("authorization_type") {
+		input.AuthorizationType = aws.String(d.Get("authorization_type").(string))
+	}
+
+	if d.HasChange("authorizer_id") {
+		input.AuthorizerId = aws.String(d.Get("authorizer_id").(string))
+	}
+
+	if d.HasChange("model_selection_expression") {
+		input.ModelSelectionExpression = aws.String(d.Get("model_selection_expression").(string))
+	}
+
+	if d.HasChange("operation_name") {
+		input.OperationName = aws.String(d.Get("operation_name").(string))
+	}
+
+	if d.HasChange("request_models") {
+		input.RequestModels = stringMapToPointers(d.Get("request_models").(map[string]interface{}))
+	}
+
+	if d.HasChange("route_key") {
+		input.RouteKey = aws.String(d.Get("route_key").(string))
+	}
+
+	if d.HasChange("route_response_selection_expression") {
+		input.RouteResponseSelectionExpression = aws.String(d.Get("route_response_selection_expression").(string))
+	}
+
+	if d.HasChange("target") {
+		input.Target = aws.String(d.Get("target").(string))
+	}
+
+	log.Printf("[DEBUG] Updating API Gateway v2 route: %s", input)
+	if _, err := conn.UpdateRoute(input); err != nil {
+		return fmt.Errorf("error updating API Gateway v2 route (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsApiGatewayV2RouteRead(d, meta)
+}
+
+func resourceAwsApiGatewayV2RouteDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2conn()
+
+	log.Printf("[DEBUG] Deleting API Gateway v2 route: %s", d.Id())
+	_, err := conn.DeleteRoute(&apigatewayv2.DeleteRouteInput{
+		ApiId:   aws.String(d.Get("api_id").(string)),
+		RouteId: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, apigatewayv2.ErrCodeNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting API Gateway v2 route (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsApiGatewayV2RouteImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := parseApiGatewayV2ImportId(d.Id(), 2, "API-ID/ROUTE-ID")
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(parts[1])
+	d.Set("api_id", parts[0])
+
+	return []*schema.ResourceData{d}, nil
+}
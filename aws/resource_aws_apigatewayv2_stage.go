@@ -0,0 +1,495 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsApiGatewayV2Stage() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsApiGatewayV2StageCreate,
+		Read:   resourceAwsApiGatewayV2StageRead,
+		Update: resourceAwsApiGatewayV2StageUpdate,
+		Delete: resourceAwsApiGatewayV2StageDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsApiGatewayV2StageImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access_log_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+							StateFunc: func(arn interface{}) string {
+								// arns coming from a TF reference to a log group contain a trailing `:*` which is not valid
+								return strings.TrimSuffix(arn.(string), ":*")
+							},
+						},
+						"format": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"api_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"client_certificate_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"default_route_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: apiGatewayV2StageRouteSettingsSchema(),
+				},
+			},
+			"deployment_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"execution_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"invoke_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"route_settings": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: apiGatewayV2StageRouteKeySettingsSchema(),
+				},
+			},
+			"stage_variables": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// apiGatewayV2StageRouteSettingsSchema returns the schema shared by the
+// default and the per-route settings of a stage.
+func apiGatewayV2StageRouteSettingsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"data_trace_enabled": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"detailed_metrics_enabled": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"logging_level": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				apigatewayv2.LoggingLevelError,
+				apigatewayv2.LoggingLevelFalse,
+				apigatewayv2.LoggingLevelInfo,
+			}, false),
+		},
+		"throttling_burst_limit": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+		"throttling_rate_limit": {
+			Type:     schema.TypeFloat,
+			Optional: true,
+			Computed: true,
+		},
+	}
+}
+
+func apiGatewayV2StageRouteKeySettingsSchema() map[string]*schema.Schema {
+	s := apiGatewayV2StageRouteSettingsSchema()
+
+	s["route_key"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+
+	return s
+}
+
+func resourceAwsApiGatewayV2StageCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2conn()
+
+	input := &apigatewayv2.CreateStageInput{
+		ApiId:     aws.String(d.Get("api_id").(string)),
+		StageName: aws.String(d.Get("name").(string)),
+	}
+
+	if v, ok := d.GetOk("access_log_settings"); ok {
+		input.AccessLogSettings = expandApiGatewayV2StageAccessLogSettings(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("client_certificate_id"); ok {
+		input.ClientCertificateId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_route_settings"); ok {
+		input.DefaultRouteSettings = expandApiGatewayV2StageDefaultRouteSettings(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("deployment_id"); ok {
+		input.DeploymentId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("route_settings"); ok {
+		input.RouteSettings = expandApiGatewayV2StageRouteSettings(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("stage_variables"); ok {
+		input.StageVariables = stringMapToPointers(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating API Gateway v2 stage: %s", input)
+	output, err := conn.CreateStage(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating API Gateway v2 stage: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.StageName))
+
+	return resourceAwsApiGatewayV2StageRead(d, meta)
+}
+
+func resourceAwsApiGatewayV2StageRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2conn()
+
+	apiId := d.Get("api_id").(string)
+
+	output, err := conn.GetStage(&apigatewayv2.GetStageInput{
+		ApiId:     aws.String(apiId),
+		StageName: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, apigatewayv2.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] API Gateway v2 stage (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading API Gateway v2 stage (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("access_log_settings", flattenApiGatewayV2StageAccessLogSettings(output.AccessLogSettings)); err != nil {
+		return fmt.Errorf("error setting access_log_settings: %s", err)
+	}
+
+	d.Set("arn", arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "apigateway",
+		Region:    meta.(*AWSClient).region,
+		Resource:  fmt.Sprintf("/apis/%s/stages/%s", apiId, d.Id()),
+	}.String())
+	d.Set("client_certificate_id", output.ClientCertificateId)
+
+	if err := d.Set("default_route_settings", flattenApiGatewayV2StageDefaultRouteSettings(output.DefaultRouteSettings)); err != nil {
+		return fmt.Errorf("error setting default_route_settings: %s", err)
+	}
+
+	d.Set("deployment_id", output.DeploymentId)
+	d.Set("description", output.Description)
+	d.Set("execution_arn", fmt.Sprintf("%s/%s", apiGatewayV2ApiExecutionArn(meta.(*AWSClient), apiId), d.Id()))
+
+	hostname, err := apiGatewayV2ApiHostname(meta.(*AWSClient).region, apiId)
+	if err != nil {
+		return err
+	}
+	d.Set("invoke_url", fmt.Sprintf("wss://%s/%s", hostname, d.Id()))
+	d.Set("name", output.StageName)
+
+	// The settings of removed routes are reset as they cannot be removed, skip
+	// them unless the route is configured.
+	routeKeys := make(map[string]bool)
+	for _, v := range d.Get("route_settings").(*schema.Set).List() {
+		routeKeys[v.(map[string]interface{})["route_key"].(string)] = true
+	}
+	resetRouteSetting := flattenApiGatewayV2StageRouteSetting(apiGatewayV2StageResetRouteSettings(output.DefaultRouteSettings))
+	for routeKey, routeSetting := range output.RouteSettings {
+		if !routeKeys[routeKey] && reflect.DeepEqual(flattenApiGatewayV2StageRouteSetting(routeSetting), resetRouteSetting) {
+			delete(output.RouteSettings, routeKey)
+		}
+	}
+
+	if err := d.Set("route_settings", flattenApiGatewayV2StageRouteSettings(output.RouteSettings)); err != nil {
+		return fmt.Errorf("error setting route_settings: %s", err)
+	}
+
+	if err := d.Set("stage_variables", pointersMapToStringList(output.StageVariables)); err != nil {
+		return fmt.Errorf("error setting stage_variables: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsApiGatewayV2StageUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2conn()
+
+	input := &apigatewayv2.UpdateStageInput{
+		ApiId:     aws.String(d.Get("api_id").(string)),
+		StageName: aws.String(d.Id()),
+	}
+
+	if d.HasChange("access_log_settings") {
+		input.AccessLogSettings = expandApiGatewayV2StageAccessLogSettings(d.Get("access_log_settings").([]interface{}))
+
+		// An empty structure removes any existing settings.
+		if input.AccessLogSettings == nil {
+			input.AccessLogSettings = &apigatewayv2.AccessLogSettings{}
+		}
+	}
+
+	if d.HasChange("client_certificate_id") {
+		input.ClientCertificateId = aws.String(d.Get("client_certificate_id").(string))
+	}
+
+	if d.HasChange("default_route_settings") {
+		input.DefaultRouteSettings = expandApiGatewayV2StageDefaultRouteSettings(d.Get("default_route_settings").([]interface{}))
+	}
+
+	if d.HasChange("deployment_id") {
+		input.DeploymentId = aws.String(d.Get("deployment_id").(string))
+	}
+
+	if d.HasChange("description") {
+		input.Description = aws.String(d.Get("description").(string))
+	}
+
+	if d.HasChange("route_settings") {
+		o, n := d.GetChange("route_settings")
+		input.RouteSettings = expandApiGatewayV2StageRouteSettings(n.(*schema.Set))
+
+		// The API cannot remove the settings of a route, reset those of the
+		// removed routes to the default route settings instead.
+		resetRouteSettings := apiGatewayV2StageResetRouteSettings(expandApiGatewayV2StageDefaultRouteSettings(d.Get("default_route_settings").([]interface{})))
+		for routeKey := range expandApiGatewayV2StageRouteSettings(o.(*schema.Set)) {
+			if _, ok := input.RouteSettings[routeKey]; !ok {
+				input.RouteSettings[routeKey] = resetRouteSettings
+			}
+		}
+	}
+
+	if d.HasChange("stage_variables") {
+		input.StageVariables = stringMapToPointers(d.Get("stage_variables").(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Updating API Gateway v2 stage: %s", input)
+	if _, err := conn.UpdateStage(input); err != nil {
+		return fmt.Errorf("error updating API Gateway v2 stage (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsApiGatewayV2StageRead(d, meta)
+}
+
+func resourceAwsApiGatewayV2StageDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2conn()
+
+	log.Printf("[DEBUG] Deleting API Gateway v2 stage: %s", d.Id())
+	_, err := conn.DeleteStage(&apigatewayv2.DeleteStageInput{
+		ApiId:     aws.String(d.Get("api_id").(string)),
+		StageName: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, apigatewayv2.ErrCodeNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting API Gateway v2 stage (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsApiGatewayV2StageImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := parseApiGatewayV2ImportId(d.Id(), 2, "API-ID/STAGE-NAME")
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(parts[1])
+	d.Set("api_id", parts[0])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandApiGatewayV2StageAccessLogSettings(l []interface{}) *apigatewayv2.AccessLogSettings {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &apigatewayv2.AccessLogSettings{
+		DestinationArn: aws.String(strings.TrimSuffix(m["destination_arn"].(string), ":*")),
+		Format:         aws.String(m["format"].(string)),
+	}
+}
+
+func expandApiGatewayV2StageDefaultRouteSettings(l []interface{}) *apigatewayv2.RouteSettings {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	return expandApiGatewayV2StageRouteSetting(l[0].(map[string]interface{}))
+}
+
+func expandApiGatewayV2StageRouteSettings(s *schema.Set) map[string]*apigatewayv2.RouteSettings {
+	settings := make(map[string]*apigatewayv2.RouteSettings, s.Len())
+
+	for _, v := range s.List() {
+		m := v.(map[string]interface{})
+
+		settings[m["route_key"].(string)] = expandApiGatewayV2StageRouteSetting(m)
+	}
+
+	return settings
+}
+
+func expandApiGatewayV2StageRouteSetting(m map[string]interface{}) *apigatewayv2.RouteSettings {
+	routeSettings := &apigatewayv2.RouteSettings{
+		DataTraceEnabled:       aws.Bool(m["data_trace_enabled"].(bool)),
+		DetailedMetricsEnabled: aws.Bool(m["detailed_metrics_enabled"].(bool)),
+	}
+
+	if v, ok := m["logging_level"].(string); ok && v != "" {
+		routeSettings.LoggingLevel = aws.String(v)
+	}
+
+	if v, ok := m["throttling_burst_limit"].(int); ok && v > 0 {
+		routeSettings.ThrottlingBurstLimit = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["throttling_rate_limit"].(float64); ok && v > 0 {
+		routeSettings.ThrottlingRateLimit = aws.Float64(v)
+	}
+
+	return routeSettings
+}
+
+// apiGatewayV2StageResetRouteSettings returns the settings of a route whose
+// route_settings block is removed, the default route settings of the stage.
+func apiGatewayV2StageResetRouteSettings(defaultRouteSettings *apigatewayv2.RouteSettings) *apigatewayv2.RouteSettings {
+	routeSettings := &apigatewayv2.RouteSettings{
+		DataTraceEnabled:       aws.Bool(false),
+		DetailedMetricsEnabled: aws.Bool(false),
+		LoggingLevel:           aws.String(apigatewayv2.LoggingLevelFalse),
+	}
+
+	if defaultRouteSettings == nil {
+		return routeSettings
+	}
+
+	if defaultRouteSettings.DataTraceEnabled != nil {
+		routeSettings.DataTraceEnabled = defaultRouteSettings.DataTraceEnabled
+	}
+
+	if defaultRouteSettings.DetailedMetricsEnabled != nil {
+		routeSettings.DetailedMetricsEnabled = defaultRouteSettings.DetailedMetricsEnabled
+	}
+
+	if defaultRouteSettings.LoggingLevel != nil {
+		routeSettings.LoggingLevel = defaultRouteSettings.LoggingLevel
+	}
+
+	routeSettings.ThrottlingBurstLimit = defaultRouteSettings.ThrottlingBurstLimit
+	routeSettings.ThrottlingRateLimit = defaultRouteSettings.ThrottlingRateLimit
+
+	return routeSettings
+}
+
+func flattenApiGatewayV2StageAccessLogSettings(accessLogSettings *apigatewayv2.AccessLogSettings) []interface{} {
+	if accessLogSettings == nil || aws.StringValue(accessLogSettings.DestinationArn) == "" {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"destination_arn": aws.StringValue(accessLogSettings.DestinationArn),
+		"format":          aws.StringValue(accessLogSettings.Format),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenApiGatewayV2StageDefaultRouteSettings(routeSettings *apigatewayv2.RouteSettings) []interface{} {
+	if routeSettings == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{flattenApiGatewayV2StageRouteSetting(routeSettings)}
+}
+
+func flattenApiGatewayV2StageRouteSettings(routeSettings map[string]*apigatewayv2.RouteSettings) []interface{} {
+	l := make([]interface{}, 0, len(routeSettings))
+
+	for routeKey, routeSetting := range routeSettings {
+		m := flattenApiGatewayV2StageRouteSetting(routeSetting)
+		m["route_key"] = routeKey
+
+		l = append(l, m)
+	}
+
+	return l
+}
+
+func flattenApiGatewayV2StageRouteSetting(routeSettings *apigatewayv2.RouteSettings) map[string]interface{} {
+	return map[string]interface{}{
+		"data_trace_enabled":       aws.BoolValue(routeSettings.DataTraceEnabled),
+		"detailed_metrics_enabled": aws.BoolValue(routeSettings.DetailedMetricsEnabled),
+		"logging_level":            aws.StringValue(routeSettings.LoggingLevel),
+		"throttling_burst_limit":   int(aws.Int64Value(routeSettings.ThrottlingBurstLimit)),
+		"throttling_rate_limit":    aws.Float64Value(routeSettings.ThrottlingRateLimit),
+	}
+}
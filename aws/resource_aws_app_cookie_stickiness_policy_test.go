@@ -53,7 +53,7 @@ func TestAccAWSAppCookieStickinessPolicy_missingLB(t *testing.T) {
 
 	// check that we can destroy the policy if the LB is missing
 	removeLB := func() {
-		conn := testAccProvider.Meta().(*AWSClient).elbconn
+		conn := testAccProvider.Meta().(*AWSClient).elbconn()
 		deleteElbOpts := elb.DeleteLoadBalancerInput{
 			LoadBalancerName: aws.String(lbName),
 		}
@@ -85,7 +85,7 @@ func TestAccAWSAppCookieStickinessPolicy_missingLB(t *testing.T) {
 }
 
 func testAccCheckAppCookieStickinessPolicyDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).elbconn
+	conn := testAccProvider.Meta().(*AWSClient).elbconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_app_cookie_stickiness_policy" {
@@ -129,7 +129,7 @@ func testAccCheckAppCookieStickinessPolicy(elbResource string, policyResource st
 			return fmt.Errorf("Not found: %s", policyResource)
 		}
 
-		elbconn := testAccProvider.Meta().(*AWSClient).elbconn
+		elbconn := testAccProvider.Meta().(*AWSClient).elbconn()
 		elbName, _, policyName := resourceAwsAppCookieStickinessPolicyParseId(policy.Primary.ID)
 		_, err := elbconn.DescribeLoadBalancerPolicies(&elb.DescribeLoadBalancerPoliciesInput{
 			LoadBalancerName: aws.String(elbName),
@@ -147,7 +147,7 @@ func TestAccAWSAppCookieStickinessPolicy_drift(t *testing.T) {
 	// We only want to remove the reference to the policy from the listner,
 	// beacause that's all that can be done via the console.
 	removePolicy := func() {
-		conn := testAccProvider.Meta().(*AWSClient).elbconn
+		conn := testAccProvider.Meta().(*AWSClient).elbconn()
 
 		setLoadBalancerOpts := &elb.SetLoadBalancerPoliciesOfListenerInput{
 			LoadBalancerName: aws.String(lbName),
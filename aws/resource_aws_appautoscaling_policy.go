@@ -248,7 +248,7 @@ func resourceAwsAppautoscalingPolicy() *schema.Resource {
 }
 
 func resourceAwsAppautoscalingPolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appautoscalingconn
+	conn := meta.(*AWSClient).appautoscalingconn()
 
 	params, err := getAwsAppautoscalingPutScalingPolicyInput(d)
 	if err != nil {
@@ -329,7 +329,7 @@ func resourceAwsAppautoscalingPolicyRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsAppautoscalingPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appautoscalingconn
+	conn := meta.(*AWSClient).appautoscalingconn()
 
 	params, inputErr := getAwsAppautoscalingPutScalingPolicyInput(d)
 	if inputErr != nil {
@@ -355,7 +355,7 @@ func resourceAwsAppautoscalingPolicyUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsAppautoscalingPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appautoscalingconn
+	conn := meta.(*AWSClient).appautoscalingconn()
 	p, err := getAwsAppautoscalingPolicy(d, meta)
 	if err != nil {
 		return fmt.Errorf("Error getting policy: %s", err)
@@ -588,7 +588,7 @@ func getAwsAppautoscalingPutScalingPolicyInput(d *schema.ResourceData) (applicat
 }
 
 func getAwsAppautoscalingPolicy(d *schema.ResourceData, meta interface{}) (*applicationautoscaling.ScalingPolicy, error) {
-	conn := meta.(*AWSClient).appautoscalingconn
+	conn := meta.(*AWSClient).appautoscalingconn()
 
 	params := applicationautoscaling.DescribeScalingPoliciesInput{
 		PolicyNames:       []*string{aws.String(d.Get("name").(string))},
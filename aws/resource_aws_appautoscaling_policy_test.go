@@ -317,7 +317,7 @@ func testAccCheckAWSAppautoscalingPolicyExists(n string, policy *applicationauto
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).appautoscalingconn
+		conn := testAccProvider.Meta().(*AWSClient).appautoscalingconn()
 		params := &applicationautoscaling.DescribeScalingPoliciesInput{
 			PolicyNames:       []*string{aws.String(rs.Primary.ID)},
 			ResourceId:        aws.String(rs.Primary.Attributes["resource_id"]),
@@ -339,7 +339,7 @@ func testAccCheckAWSAppautoscalingPolicyExists(n string, policy *applicationauto
 }
 
 func testAccCheckAWSAppautoscalingPolicyDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).appautoscalingconn
+	conn := testAccProvider.Meta().(*AWSClient).appautoscalingconn()
 
 	for _, rs := range s.RootModule().Resources {
 		params := applicationautoscaling.DescribeScalingPoliciesInput{
@@ -362,7 +362,7 @@ func testAccCheckAWSAppautoscalingPolicyDestroy(s *terraform.State) error {
 
 func testAccCheckAWSAppautoscalingPolicyDisappears(policy *applicationautoscaling.ScalingPolicy) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).appautoscalingconn
+		conn := testAccProvider.Meta().(*AWSClient).appautoscalingconn()
 
 		input := &applicationautoscaling.DeleteScalingPolicyInput{
 			PolicyName:        policy.PolicyName,
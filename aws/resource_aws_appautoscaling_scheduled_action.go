@@ -84,7 +84,7 @@ func resourceAwsAppautoscalingScheduledAction() *schema.Resource {
 }
 
 func resourceAwsAppautoscalingScheduledActionPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appautoscalingconn
+	conn := meta.(*AWSClient).appautoscalingconn()
 
 	input := &applicationautoscaling.PutScheduledActionInput{
 		ScheduledActionName: aws.String(d.Get("name").(string)),
@@ -143,7 +143,7 @@ func resourceAwsAppautoscalingScheduledActionPut(d *schema.ResourceData, meta in
 }
 
 func resourceAwsAppautoscalingScheduledActionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appautoscalingconn
+	conn := meta.(*AWSClient).appautoscalingconn()
 
 	saName := d.Get("name").(string)
 	input := &applicationautoscaling.DescribeScheduledActionsInput{
@@ -170,7 +170,7 @@ func resourceAwsAppautoscalingScheduledActionRead(d *schema.ResourceData, meta i
 }
 
 func resourceAwsAppautoscalingScheduledActionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appautoscalingconn
+	conn := meta.(*AWSClient).appautoscalingconn()
 
 	input := &applicationautoscaling.DeleteScheduledActionInput{
 		ScheduledActionName: aws.String(d.Get("name").(string)),
@@ -58,7 +58,7 @@ func resourceAwsAppautoscalingTarget() *schema.Resource {
 }
 
 func resourceAwsAppautoscalingTargetPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appautoscalingconn
+	conn := meta.(*AWSClient).appautoscalingconn()
 
 	var targetOpts applicationautoscaling.RegisterScalableTargetInput
 
@@ -98,7 +98,7 @@ func resourceAwsAppautoscalingTargetPut(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsAppautoscalingTargetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appautoscalingconn
+	conn := meta.(*AWSClient).appautoscalingconn()
 
 	namespace := d.Get("service_namespace").(string)
 	dimension := d.Get("scalable_dimension").(string)
@@ -123,7 +123,7 @@ func resourceAwsAppautoscalingTargetRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsAppautoscalingTargetDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appautoscalingconn
+	conn := meta.(*AWSClient).appautoscalingconn()
 
 	namespace := d.Get("service_namespace").(string)
 	dimension := d.Get("scalable_dimension").(string)
@@ -166,7 +166,7 @@ func TestAccAWSAppautoScalingTarget_optionalRoleArn(t *testing.T) {
 }
 
 func testAccCheckAWSAppautoscalingTargetDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).appautoscalingconn
+	conn := testAccProvider.Meta().(*AWSClient).appautoscalingconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_appautoscaling_target" {
@@ -212,7 +212,7 @@ func testAccCheckAWSAppautoscalingTargetExists(n string, target *applicationauto
 			return fmt.Errorf("No Application AutoScaling Target ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).appautoscalingconn
+		conn := testAccProvider.Meta().(*AWSClient).appautoscalingconn()
 
 		namespace := rs.Primary.Attributes["service_namespace"]
 		dimension := rs.Primary.Attributes["scalable_dimension"]
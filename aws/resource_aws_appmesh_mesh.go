@@ -47,7 +47,7 @@ func resourceAwsAppmeshMesh() *schema.Resource {
 }
 
 func resourceAwsAppmeshMeshCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appmeshconn
+	conn := meta.(*AWSClient).appmeshconn()
 
 	meshName := d.Get("name").(string)
 	req := &appmesh.CreateMeshInput{
@@ -66,7 +66,7 @@ func resourceAwsAppmeshMeshCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsAppmeshMeshRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appmeshconn
+	conn := meta.(*AWSClient).appmeshconn()
 
 	resp, err := conn.DescribeMesh(&appmesh.DescribeMeshInput{
 		MeshName: aws.String(d.Id()),
@@ -94,7 +94,7 @@ func resourceAwsAppmeshMeshRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsAppmeshMeshDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appmeshconn
+	conn := meta.(*AWSClient).appmeshconn()
 
 	log.Printf("[DEBUG] Deleting App Mesh service mesh: %s", d.Id())
 	_, err := conn.DeleteMesh(&appmesh.DeleteMeshInput{
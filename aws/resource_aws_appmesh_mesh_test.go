@@ -28,7 +28,7 @@ func testSweepAppmeshMeshes(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).appmeshconn
+	conn := client.(*AWSClient).appmeshconn()
 
 	err = conn.ListMeshesPages(&appmesh.ListMeshesInput{}, func(page *appmesh.ListMeshesOutput, isLast bool) bool {
 		if page == nil {
@@ -98,7 +98,7 @@ func testAccAwsAppmeshMesh_basic(t *testing.T) {
 }
 
 func testAccCheckAppmeshMeshDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).appmeshconn
+	conn := testAccProvider.Meta().(*AWSClient).appmeshconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_appmesh_mesh" {
@@ -122,7 +122,7 @@ func testAccCheckAppmeshMeshDestroy(s *terraform.State) error {
 
 func testAccCheckAppmeshMeshExists(name string, v *appmesh.MeshData) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).appmeshconn
+		conn := testAccProvider.Meta().(*AWSClient).appmeshconn()
 
 		rs, ok := s.RootModule().Resources[name]
 		if !ok {
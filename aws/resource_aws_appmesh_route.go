@@ -133,7 +133,7 @@ func resourceAwsAppmeshRoute() *schema.Resource {
 }
 
 func resourceAwsAppmeshRouteCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appmeshconn
+	conn := meta.(*AWSClient).appmeshconn()
 
 	req := &appmesh.CreateRouteInput{
 		MeshName:          aws.String(d.Get("mesh_name").(string)),
@@ -154,7 +154,7 @@ func resourceAwsAppmeshRouteCreate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsAppmeshRouteRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appmeshconn
+	conn := meta.(*AWSClient).appmeshconn()
 
 	resp, err := conn.DescribeRoute(&appmesh.DescribeRouteInput{
 		MeshName:          aws.String(d.Get("mesh_name").(string)),
@@ -190,7 +190,7 @@ func resourceAwsAppmeshRouteRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsAppmeshRouteUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appmeshconn
+	conn := meta.(*AWSClient).appmeshconn()
 
 	if d.HasChange("spec") {
 		_, v := d.GetChange("spec")
@@ -212,7 +212,7 @@ func resourceAwsAppmeshRouteUpdate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsAppmeshRouteDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appmeshconn
+	conn := meta.(*AWSClient).appmeshconn()
 
 	log.Printf("[DEBUG] Deleting App Mesh route: %s", d.Id())
 	_, err := conn.DeleteRoute(&appmesh.DeleteRouteInput{
@@ -241,7 +241,7 @@ func resourceAwsAppmeshRouteImport(d *schema.ResourceData, meta interface{}) ([]
 	name := parts[2]
 	log.Printf("[DEBUG] Importing App Mesh route %s from mesh %s/virtual router %s ", name, mesh, vrName)
 
-	conn := meta.(*AWSClient).appmeshconn
+	conn := meta.(*AWSClient).appmeshconn()
 
 	resp, err := conn.DescribeRoute(&appmesh.DescribeRouteInput{
 		MeshName:          aws.String(mesh),
@@ -25,7 +25,7 @@ func testSweepAppmeshRoutes(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).appmeshconn
+	conn := client.(*AWSClient).appmeshconn()
 
 	err = conn.ListMeshesPages(&appmesh.ListMeshesInput{}, func(page *appmesh.ListMeshesOutput, isLast bool) bool {
 		if page == nil {
@@ -224,7 +224,7 @@ func testAccAwsAppmeshRoute_allAttributes(t *testing.T) {
 }
 
 func testAccCheckAppmeshRouteDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).appmeshconn
+	conn := testAccProvider.Meta().(*AWSClient).appmeshconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_appmesh_route" {
@@ -250,7 +250,7 @@ func testAccCheckAppmeshRouteDestroy(s *terraform.State) error {
 
 func testAccCheckAppmeshRouteExists(name string, v *appmesh.RouteData) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).appmeshconn
+		conn := testAccProvider.Meta().(*AWSClient).appmeshconn()
 
 		rs, ok := s.RootModule().Resources[name]
 		if !ok {
@@ -186,7 +186,7 @@ func testAccAwsAppmeshVirtualNode_allAttributes(t *testing.T) {
 }
 
 func testAccCheckAppmeshVirtualNodeDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).appmeshconn
+	conn := testAccProvider.Meta().(*AWSClient).appmeshconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_appmesh_virtual_node" {
@@ -211,7 +211,7 @@ func testAccCheckAppmeshVirtualNodeDestroy(s *terraform.State) error {
 
 func testAccCheckAppmeshVirtualNodeExists(name string, v *appmesh.VirtualNodeData) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).appmeshconn
+		conn := testAccProvider.Meta().(*AWSClient).appmeshconn()
 
 		rs, ok := s.RootModule().Resources[name]
 		if !ok {
@@ -115,7 +115,7 @@ func resourceAwsAppmeshVirtualRouter() *schema.Resource {
 }
 
 func resourceAwsAppmeshVirtualRouterCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appmeshconn
+	conn := meta.(*AWSClient).appmeshconn()
 
 	req := &appmesh.CreateVirtualRouterInput{
 		MeshName:          aws.String(d.Get("mesh_name").(string)),
@@ -135,7 +135,7 @@ func resourceAwsAppmeshVirtualRouterCreate(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsAppmeshVirtualRouterRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appmeshconn
+	conn := meta.(*AWSClient).appmeshconn()
 
 	resp, err := conn.DescribeVirtualRouter(&appmesh.DescribeVirtualRouterInput{
 		MeshName:          aws.String(d.Get("mesh_name").(string)),
@@ -169,7 +169,7 @@ func resourceAwsAppmeshVirtualRouterRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsAppmeshVirtualRouterUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appmeshconn
+	conn := meta.(*AWSClient).appmeshconn()
 
 	if d.HasChange("spec") {
 		_, v := d.GetChange("spec")
@@ -190,7 +190,7 @@ func resourceAwsAppmeshVirtualRouterUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsAppmeshVirtualRouterDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appmeshconn
+	conn := meta.(*AWSClient).appmeshconn()
 
 	log.Printf("[DEBUG] Deleting App Mesh virtual router: %s", d.Id())
 	_, err := conn.DeleteVirtualRouter(&appmesh.DeleteVirtualRouterInput{
@@ -217,7 +217,7 @@ func resourceAwsAppmeshVirtualRouterImport(d *schema.ResourceData, meta interfac
 	name := parts[1]
 	log.Printf("[DEBUG] Importing App Mesh virtual router %s from mesh %s", name, mesh)
 
-	conn := meta.(*AWSClient).appmeshconn
+	conn := meta.(*AWSClient).appmeshconn()
 
 	resp, err := conn.DescribeVirtualRouter(&appmesh.DescribeVirtualRouterInput{
 		MeshName:          aws.String(mesh),
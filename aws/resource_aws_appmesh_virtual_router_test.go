@@ -28,7 +28,7 @@ func testSweepAppmeshVirtualRouters(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).appmeshconn
+	conn := client.(*AWSClient).appmeshconn()
 
 	err = conn.ListMeshesPages(&appmesh.ListMeshesInput{}, func(page *appmesh.ListMeshesOutput, isLast bool) bool {
 		if page == nil {
@@ -152,7 +152,7 @@ func testAccAwsAppmeshVirtualRouter_basic(t *testing.T) {
 }
 
 func testAccCheckAppmeshVirtualRouterDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).appmeshconn
+	conn := testAccProvider.Meta().(*AWSClient).appmeshconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_appmesh_virtual_router" {
@@ -177,7 +177,7 @@ func testAccCheckAppmeshVirtualRouterDestroy(s *terraform.State) error {
 
 func testAccCheckAppmeshVirtualRouterExists(name string, v *appmesh.VirtualRouterData) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).appmeshconn
+		conn := testAccProvider.Meta().(*AWSClient).appmeshconn()
 
 		rs, ok := s.RootModule().Resources[name]
 		if !ok {
@@ -110,7 +110,7 @@ func resourceAwsAppmeshVirtualService() *schema.Resource {
 }
 
 func resourceAwsAppmeshVirtualServiceCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appmeshconn
+	conn := meta.(*AWSClient).appmeshconn()
 
 	req := &appmesh.CreateVirtualServiceInput{
 		MeshName:           aws.String(d.Get("mesh_name").(string)),
@@ -130,7 +130,7 @@ func resourceAwsAppmeshVirtualServiceCreate(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsAppmeshVirtualServiceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appmeshconn
+	conn := meta.(*AWSClient).appmeshconn()
 
 	resp, err := conn.DescribeVirtualService(&appmesh.DescribeVirtualServiceInput{
 		MeshName:           aws.String(d.Get("mesh_name").(string)),
@@ -164,7 +164,7 @@ func resourceAwsAppmeshVirtualServiceRead(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsAppmeshVirtualServiceUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appmeshconn
+	conn := meta.(*AWSClient).appmeshconn()
 
 	if d.HasChange("spec") {
 		_, v := d.GetChange("spec")
@@ -185,7 +185,7 @@ func resourceAwsAppmeshVirtualServiceUpdate(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsAppmeshVirtualServiceDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appmeshconn
+	conn := meta.(*AWSClient).appmeshconn()
 
 	log.Printf("[DEBUG] Deleting App Mesh virtual service: %s", d.Id())
 	_, err := conn.DeleteVirtualService(&appmesh.DeleteVirtualServiceInput{
@@ -212,7 +212,7 @@ func resourceAwsAppmeshVirtualServiceImport(d *schema.ResourceData, meta interfa
 	name := parts[1]
 	log.Printf("[DEBUG] Importing App Mesh virtual service %s from mesh %s", name, mesh)
 
-	conn := meta.(*AWSClient).appmeshconn
+	conn := meta.(*AWSClient).appmeshconn()
 
 	resp, err := conn.DescribeVirtualService(&appmesh.DescribeVirtualServiceInput{
 		MeshName:           aws.String(mesh),
@@ -109,7 +109,7 @@ func testAccAwsAppmeshVirtualService_virtualRouter(t *testing.T) {
 }
 
 func testAccCheckAppmeshVirtualServiceDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).appmeshconn
+	conn := testAccProvider.Meta().(*AWSClient).appmeshconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_appmesh_virtual_service" {
@@ -134,7 +134,7 @@ func testAccCheckAppmeshVirtualServiceDestroy(s *terraform.State) error {
 
 func testAccCheckAppmeshVirtualServiceExists(name string, v *appmesh.VirtualServiceData) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).appmeshconn
+		conn := testAccProvider.Meta().(*AWSClient).appmeshconn()
 
 		rs, ok := s.RootModule().Resources[name]
 		if !ok {
@@ -139,7 +139,7 @@ func resourceAwsAppsyncDatasource() *schema.Resource {
 }
 
 func resourceAwsAppsyncDatasourceCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appsyncconn
+	conn := meta.(*AWSClient).appsyncconn()
 	region := meta.(*AWSClient).region
 
 	input := &appsync.CreateDataSourceInput{
@@ -183,7 +183,7 @@ func resourceAwsAppsyncDatasourceCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsAppsyncDatasourceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appsyncconn
+	conn := meta.(*AWSClient).appsyncconn()
 
 	apiID, name, err := decodeAppsyncDataSourceID(d.Id())
 
@@ -234,7 +234,7 @@ func resourceAwsAppsyncDatasourceRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsAppsyncDatasourceUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appsyncconn
+	conn := meta.(*AWSClient).appsyncconn()
 	region := meta.(*AWSClient).region
 
 	apiID, name, err := decodeAppsyncDataSourceID(d.Id())
@@ -281,7 +281,7 @@ func resourceAwsAppsyncDatasourceUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsAppsyncDatasourceDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appsyncconn
+	conn := meta.(*AWSClient).appsyncconn()
 
 	apiID, name, err := decodeAppsyncDataSourceID(d.Id())
 
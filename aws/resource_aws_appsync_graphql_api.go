@@ -135,7 +135,7 @@ func resourceAwsAppsyncGraphqlApi() *schema.Resource {
 }
 
 func resourceAwsAppsyncGraphqlApiCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appsyncconn
+	conn := meta.(*AWSClient).appsyncconn()
 
 	input := &appsync.CreateGraphqlApiInput{
 		AuthenticationType: aws.String(d.Get("authentication_type").(string)),
@@ -165,7 +165,7 @@ func resourceAwsAppsyncGraphqlApiCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsAppsyncGraphqlApiRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appsyncconn
+	conn := meta.(*AWSClient).appsyncconn()
 
 	input := &appsync.GetGraphqlApiInput{
 		ApiId: aws.String(d.Id()),
@@ -205,7 +205,7 @@ func resourceAwsAppsyncGraphqlApiRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsAppsyncGraphqlApiUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appsyncconn
+	conn := meta.(*AWSClient).appsyncconn()
 
 	input := &appsync.UpdateGraphqlApiInput{
 		ApiId:              aws.String(d.Id()),
@@ -234,7 +234,7 @@ func resourceAwsAppsyncGraphqlApiUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsAppsyncGraphqlApiDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appsyncconn
+	conn := meta.(*AWSClient).appsyncconn()
 
 	input := &appsync.DeleteGraphqlApiInput{
 		ApiId: aws.String(d.Id()),
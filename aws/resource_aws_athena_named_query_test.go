@@ -49,7 +49,7 @@ func TestAccAWSAthenaNamedQuery_import(t *testing.T) {
 }
 
 func testAccCheckAWSAthenaNamedQueryDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).athenaconn
+	conn := testAccProvider.Meta().(*AWSClient).athenaconn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_athena_named_query" {
 			continue
@@ -80,7 +80,7 @@ func testAccCheckAWSAthenaNamedQueryExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).athenaconn
+		conn := testAccProvider.Meta().(*AWSClient).athenaconn()
 
 		input := &athena.GetNamedQueryInput{
 			NamedQueryId: aws.String(rs.Primary.ID),
@@ -39,7 +39,7 @@ func resourceAwsAutoscalingAttachment() *schema.Resource {
 }
 
 func resourceAwsAutoscalingAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	asgconn := meta.(*AWSClient).autoscalingconn
+	asgconn := meta.(*AWSClient).autoscalingconn()
 	asgName := d.Get("autoscaling_group_name").(string)
 
 	if v, ok := d.GetOk("elb"); ok {
@@ -74,7 +74,7 @@ func resourceAwsAutoscalingAttachmentCreate(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsAutoscalingAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	asgconn := meta.(*AWSClient).autoscalingconn
+	asgconn := meta.(*AWSClient).autoscalingconn()
 	asgName := d.Get("autoscaling_group_name").(string)
 
 	// Retrieve the ASG properties to get list of associated ELBs
@@ -125,7 +125,7 @@ func resourceAwsAutoscalingAttachmentRead(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsAutoscalingAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	asgconn := meta.(*AWSClient).autoscalingconn
+	asgconn := meta.(*AWSClient).autoscalingconn()
 	asgName := d.Get("autoscaling_group_name").(string)
 
 	if v, ok := d.GetOk("elb"); ok {
@@ -102,7 +102,7 @@ func testAccCheckAWSAutocalingElbAttachmentExists(asgname string, loadBalancerCo
 			return fmt.Errorf("Not found: %s", asgname)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).autoscalingconn
+		conn := testAccProvider.Meta().(*AWSClient).autoscalingconn()
 		asg := rs.Primary.ID
 
 		actual, err := conn.DescribeAutoScalingGroups(&autoscaling.DescribeAutoScalingGroupsInput{
@@ -128,7 +128,7 @@ func testAccCheckAWSAutocalingAlbAttachmentExists(asgname string, targetGroupCou
 			return fmt.Errorf("Not found: %s", asgname)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).autoscalingconn
+		conn := testAccProvider.Meta().(*AWSClient).autoscalingconn()
 		asg := rs.Primary.ID
 
 		actual, err := conn.DescribeAutoScalingGroups(&autoscaling.DescribeAutoScalingGroupsInput{
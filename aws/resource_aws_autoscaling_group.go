@@ -445,7 +445,7 @@ func generatePutLifecycleHookInputs(asgName string, cfgs []interface{}) []autosc
 }
 
 func resourceAwsAutoscalingGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).autoscalingconn
+	conn := meta.(*AWSClient).autoscalingconn()
 
 	var asgName string
 	if v, ok := d.GetOk("name"); ok {
@@ -632,7 +632,7 @@ func resourceAwsAutoscalingGroupCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsAutoscalingGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).autoscalingconn
+	conn := meta.(*AWSClient).autoscalingconn()
 
 	g, err := getAwsAutoscalingGroup(d.Id(), conn)
 	if err != nil {
@@ -759,7 +759,7 @@ func resourceAwsAutoscalingGroupRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsAutoscalingGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).autoscalingconn
+	conn := meta.(*AWSClient).autoscalingconn()
 	shouldWaitForCapacity := false
 
 	opts := autoscaling.UpdateAutoScalingGroupInput{
@@ -953,7 +953,7 @@ func resourceAwsAutoscalingGroupUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsAutoscalingGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).autoscalingconn
+	conn := meta.(*AWSClient).autoscalingconn()
 
 	// Read the autoscaling group first. If it doesn't exist, we're done.
 	// We need the group in order to check if there are instances attached.
@@ -1042,7 +1042,7 @@ func getAwsAutoscalingGroup(
 }
 
 func resourceAwsAutoscalingGroupDrain(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).autoscalingconn
+	conn := meta.(*AWSClient).autoscalingconn()
 
 	if d.Get("force_delete").(bool) {
 		log.Printf("[DEBUG] Skipping ASG drain, force_delete was set.")
@@ -1199,7 +1199,7 @@ func updateASGMetricsCollection(d *schema.ResourceData, conn *autoscaling.AutoSc
 // Nested like: lbName -> instanceId -> instanceState
 func getELBInstanceStates(g *autoscaling.Group, meta interface{}) (map[string]map[string]string, error) {
 	lbInstanceStates := make(map[string]map[string]string)
-	elbconn := meta.(*AWSClient).elbconn
+	elbconn := meta.(*AWSClient).elbconn()
 
 	for _, lbName := range g.LoadBalancerNames {
 		lbInstanceStates[*lbName] = make(map[string]string)
@@ -1228,7 +1228,7 @@ func getELBInstanceStates(g *autoscaling.Group, meta interface{}) (map[string]ma
 // Nested like: targetGroupARN -> instanceId -> instanceState
 func getTargetGroupInstanceStates(g *autoscaling.Group, meta interface{}) (map[string]map[string]string, error) {
 	targetInstanceStates := make(map[string]map[string]string)
-	elbv2conn := meta.(*AWSClient).elbv2conn
+	elbv2conn := meta.(*AWSClient).elbv2conn()
 
 	for _, targetGroupARN := range g.TargetGroupARNs {
 		targetInstanceStates[*targetGroupARN] = make(map[string]string)
@@ -35,7 +35,7 @@ func waitForASGCapacity(
 	log.Printf("[DEBUG] Waiting on %s for capacity...", d.Id())
 
 	err = resource.Retry(wait, func() *resource.RetryError {
-		g, err := getAwsAutoscalingGroup(d.Id(), meta.(*AWSClient).autoscalingconn)
+		g, err := getAwsAutoscalingGroup(d.Id(), meta.(*AWSClient).autoscalingconn())
 		if err != nil {
 			return resource.NonRetryableError(err)
 		}
@@ -108,7 +108,7 @@ func waitForASGCapacity(
 
 	recentStatus := ""
 
-	conn := meta.(*AWSClient).autoscalingconn
+	conn := meta.(*AWSClient).autoscalingconn()
 	resp, aErr := conn.DescribeScalingActivities(&autoscaling.DescribeScalingActivitiesInput{
 		AutoScalingGroupName: aws.String(d.Id()),
 		MaxRecords:           aws.Int64(1),
@@ -77,7 +77,7 @@ func resourceAwsAutoscalingLifecycleHookPutOp(conn *autoscaling.AutoScaling, par
 }
 
 func resourceAwsAutoscalingLifecycleHookPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).autoscalingconn
+	conn := meta.(*AWSClient).autoscalingconn()
 	params := getAwsAutoscalingPutLifecycleHookInput(d)
 
 	if err := resourceAwsAutoscalingLifecycleHookPutOp(conn, &params); err != nil {
@@ -114,7 +114,7 @@ func resourceAwsAutoscalingLifecycleHookRead(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsAutoscalingLifecycleHookDelete(d *schema.ResourceData, meta interface{}) error {
-	autoscalingconn := meta.(*AWSClient).autoscalingconn
+	autoscalingconn := meta.(*AWSClient).autoscalingconn()
 	p, err := getAwsAutoscalingLifecycleHook(d, meta)
 	if err != nil {
 		return err
@@ -168,7 +168,7 @@ func getAwsAutoscalingPutLifecycleHookInput(d *schema.ResourceData) autoscaling.
 }
 
 func getAwsAutoscalingLifecycleHook(d *schema.ResourceData, meta interface{}) (*autoscaling.LifecycleHook, error) {
-	autoscalingconn := meta.(*AWSClient).autoscalingconn
+	autoscalingconn := meta.(*AWSClient).autoscalingconn()
 
 	params := autoscaling.DescribeLifecycleHooksInput{
 		AutoScalingGroupName: aws.String(d.Get("autoscaling_group_name").(string)),
@@ -65,7 +65,7 @@ func testAccCheckLifecycleHookExists(n string) resource.TestCheckFunc {
 }
 
 func checkLifecycleHookExistsByName(asgName, hookName string) error {
-	conn := testAccProvider.Meta().(*AWSClient).autoscalingconn
+	conn := testAccProvider.Meta().(*AWSClient).autoscalingconn()
 	params := &autoscaling.DescribeLifecycleHooksInput{
 		AutoScalingGroupName: aws.String(asgName),
 		LifecycleHookNames:   []*string{aws.String(hookName)},
@@ -82,7 +82,7 @@ func checkLifecycleHookExistsByName(asgName, hookName string) error {
 }
 
 func testAccCheckAWSAutoscalingLifecycleHookDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).autoscalingconn
+	conn := testAccProvider.Meta().(*AWSClient).autoscalingconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_autoscaling_group" {
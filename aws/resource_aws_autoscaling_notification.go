@@ -42,7 +42,7 @@ func resourceAwsAutoscalingNotification() *schema.Resource {
 }
 
 func resourceAwsAutoscalingNotificationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).autoscalingconn
+	conn := meta.(*AWSClient).autoscalingconn()
 	gl := expandStringList(d.Get("group_names").(*schema.Set).List())
 	nl := expandStringList(d.Get("notifications").(*schema.Set).List())
 
@@ -58,7 +58,7 @@ func resourceAwsAutoscalingNotificationCreate(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsAutoscalingNotificationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).autoscalingconn
+	conn := meta.(*AWSClient).autoscalingconn()
 	gl := expandStringList(d.Get("group_names").(*schema.Set).List())
 
 	opts := &autoscaling.DescribeNotificationConfigurationsInput{
@@ -116,7 +116,7 @@ func resourceAwsAutoscalingNotificationRead(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsAutoscalingNotificationUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).autoscalingconn
+	conn := meta.(*AWSClient).autoscalingconn()
 
 	// Notifications API call is a PUT, so we don't need to diff the list, just
 	// push whatever it is and AWS sorts it out
@@ -188,7 +188,7 @@ func removeNotificationConfigToGroupsWithTopic(conn *autoscaling.AutoScaling, gr
 }
 
 func resourceAwsAutoscalingNotificationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).autoscalingconn
+	conn := meta.(*AWSClient).autoscalingconn()
 
 	gl := expandStringList(d.Get("group_names").(*schema.Set).List())
 
@@ -114,7 +114,7 @@ func testAccCheckASGNotificationExists(n string, groups []string, asgn *autoscal
 			return fmt.Errorf("No ASG Notification ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).autoscalingconn
+		conn := testAccProvider.Meta().(*AWSClient).autoscalingconn()
 		opts := &autoscaling.DescribeNotificationConfigurationsInput{
 			AutoScalingGroupNames: aws.StringSlice(groups),
 			MaxRecords:            aws.Int64(100),
@@ -138,7 +138,7 @@ func testAccCheckASGNDestroy(s *terraform.State) error {
 		}
 
 		groups := []*string{aws.String("foobar1-terraform-test")}
-		conn := testAccProvider.Meta().(*AWSClient).autoscalingconn
+		conn := testAccProvider.Meta().(*AWSClient).autoscalingconn()
 		opts := &autoscaling.DescribeNotificationConfigurationsInput{
 			AutoScalingGroupNames: groups,
 		}
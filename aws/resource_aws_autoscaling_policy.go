@@ -186,7 +186,7 @@ func resourceAwsAutoscalingPolicy() *schema.Resource {
 }
 
 func resourceAwsAutoscalingPolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	autoscalingconn := meta.(*AWSClient).autoscalingconn
+	autoscalingconn := meta.(*AWSClient).autoscalingconn()
 
 	params, err := getAwsAutoscalingPutScalingPolicyInput(d)
 	log.Printf("[DEBUG] AutoScaling PutScalingPolicy on Create: %#v", params)
@@ -242,7 +242,7 @@ func resourceAwsAutoscalingPolicyRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsAutoscalingPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
-	autoscalingconn := meta.(*AWSClient).autoscalingconn
+	autoscalingconn := meta.(*AWSClient).autoscalingconn()
 
 	params, inputErr := getAwsAutoscalingPutScalingPolicyInput(d)
 	log.Printf("[DEBUG] AutoScaling PutScalingPolicy on Update: %#v", params)
@@ -259,7 +259,7 @@ func resourceAwsAutoscalingPolicyUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsAutoscalingPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	autoscalingconn := meta.(*AWSClient).autoscalingconn
+	autoscalingconn := meta.(*AWSClient).autoscalingconn()
 	p, err := getAwsAutoscalingPolicy(d, meta)
 	if err != nil {
 		return err
@@ -386,7 +386,7 @@ func getAwsAutoscalingPutScalingPolicyInput(d *schema.ResourceData) (autoscaling
 }
 
 func getAwsAutoscalingPolicy(d *schema.ResourceData, meta interface{}) (*autoscaling.ScalingPolicy, error) {
-	autoscalingconn := meta.(*AWSClient).autoscalingconn
+	autoscalingconn := meta.(*AWSClient).autoscalingconn()
 
 	params := autoscaling.DescribePoliciesInput{
 		AutoScalingGroupName: aws.String(d.Get("autoscaling_group_name").(string)),
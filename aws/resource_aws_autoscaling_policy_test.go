@@ -117,7 +117,7 @@ func TestAccAWSAutoscalingPolicy_disappears(t *testing.T) {
 
 func testAccCheckScalingPolicyDisappears(conf *autoscaling.ScalingPolicy) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).autoscalingconn
+		conn := testAccProvider.Meta().(*AWSClient).autoscalingconn()
 
 		params := &autoscaling.DeletePolicyInput{
 			AutoScalingGroupName: conf.AutoScalingGroupName,
@@ -275,7 +275,7 @@ func testAccCheckScalingPolicyExists(n string, policy *autoscaling.ScalingPolicy
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).autoscalingconn
+		conn := testAccProvider.Meta().(*AWSClient).autoscalingconn()
 		params := &autoscaling.DescribePoliciesInput{
 			AutoScalingGroupName: aws.String(rs.Primary.Attributes["autoscaling_group_name"]),
 			PolicyNames:          []*string{aws.String(rs.Primary.ID)},
@@ -295,7 +295,7 @@ func testAccCheckScalingPolicyExists(n string, policy *autoscaling.ScalingPolicy
 }
 
 func testAccCheckAWSAutoscalingPolicyDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).autoscalingconn
+	conn := testAccProvider.Meta().(*AWSClient).autoscalingconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_autoscaling_group" {
@@ -72,7 +72,7 @@ func resourceAwsAutoscalingSchedule() *schema.Resource {
 }
 
 func resourceAwsAutoscalingScheduleCreate(d *schema.ResourceData, meta interface{}) error {
-	autoscalingconn := meta.(*AWSClient).autoscalingconn
+	autoscalingconn := meta.(*AWSClient).autoscalingconn()
 	params := &autoscaling.PutScheduledUpdateGroupActionInput{
 		AutoScalingGroupName: aws.String(d.Get("autoscaling_group_name").(string)),
 		ScheduledActionName:  aws.String(d.Get("scheduled_action_name").(string)),
@@ -173,7 +173,7 @@ func resourceAwsAutoscalingScheduleRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsAutoscalingScheduleDelete(d *schema.ResourceData, meta interface{}) error {
-	autoscalingconn := meta.(*AWSClient).autoscalingconn
+	autoscalingconn := meta.(*AWSClient).autoscalingconn()
 
 	params := &autoscaling.DeleteScheduledActionInput{
 		AutoScalingGroupName: aws.String(d.Get("autoscaling_group_name").(string)),
@@ -190,7 +190,7 @@ func resourceAwsAutoscalingScheduleDelete(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsASGScheduledActionRetrieve(d *schema.ResourceData, meta interface{}) (*autoscaling.ScheduledUpdateGroupAction, bool, error) {
-	autoscalingconn := meta.(*AWSClient).autoscalingconn
+	autoscalingconn := meta.(*AWSClient).autoscalingconn()
 
 	params := &autoscaling.DescribeScheduledActionsInput{
 		AutoScalingGroupName: aws.String(d.Get("autoscaling_group_name").(string)),
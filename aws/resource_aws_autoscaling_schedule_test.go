@@ -58,7 +58,7 @@ func TestAccAWSAutoscalingSchedule_disappears(t *testing.T) {
 
 func testAccCheckScalingScheduleDisappears(schedule *autoscaling.ScheduledUpdateGroupAction) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		autoscalingconn := testAccProvider.Meta().(*AWSClient).autoscalingconn
+		autoscalingconn := testAccProvider.Meta().(*AWSClient).autoscalingconn()
 		params := &autoscaling.DeleteScheduledActionInput{
 			AutoScalingGroupName: schedule.AutoScalingGroupName,
 			ScheduledActionName:  schedule.ScheduledActionName,
@@ -159,7 +159,7 @@ func testAccCheckScalingScheduleExists(n string, policy *autoscaling.ScheduledUp
 		}
 
 		autoScalingGroup := rs.Primary.Attributes["autoscaling_group_name"]
-		conn := testAccProvider.Meta().(*AWSClient).autoscalingconn
+		conn := testAccProvider.Meta().(*AWSClient).autoscalingconn()
 		params := &autoscaling.DescribeScheduledActionsInput{
 			AutoScalingGroupName: aws.String(autoScalingGroup),
 			ScheduledActionNames: []*string{aws.String(rs.Primary.ID)},
@@ -180,7 +180,7 @@ func testAccCheckScalingScheduleExists(n string, policy *autoscaling.ScheduledUp
 }
 
 func testAccCheckAWSAutoscalingScheduleDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).autoscalingconn
+	conn := testAccProvider.Meta().(*AWSClient).autoscalingconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_autoscaling_schedule" {
@@ -91,7 +91,7 @@ func resourceAwsBackupPlan() *schema.Resource {
 }
 
 func resourceAwsBackupPlanCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).backupconn
+	conn := meta.(*AWSClient).backupconn()
 
 	plan := &backup.PlanInput{
 		BackupPlanName: aws.String(d.Get("name").(string)),
@@ -120,7 +120,7 @@ func resourceAwsBackupPlanCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsBackupPlanRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).backupconn
+	conn := meta.(*AWSClient).backupconn()
 
 	input := &backup.GetBackupPlanInput{
 		BackupPlanId: aws.String(d.Id()),
@@ -180,7 +180,7 @@ func resourceAwsBackupPlanRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsBackupPlanUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).backupconn
+	conn := meta.(*AWSClient).backupconn()
 
 	plan := &backup.PlanInput{
 		BackupPlanName: aws.String(d.Get("name").(string)),
@@ -200,9 +200,9 @@ func resourceAwsBackupPlanUpdate(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("error updating Backup Plan: %s", err)
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		resourceArn := d.Get("arn").(string)
-		oraw, nraw := d.GetChange("tags")
+		oraw, nraw := getTagsChange(d)
 		create, remove := diffTagsGeneric(oraw.(map[string]interface{}), nraw.(map[string]interface{}))
 
 		if len(remove) > 0 {
@@ -246,7 +246,7 @@ func resourceAwsBackupPlanUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsBackupPlanDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).backupconn
+	conn := meta.(*AWSClient).backupconn()
 
 	input := &backup.DeleteBackupPlanInput{
 		BackupPlanId: aws.String(d.Id()),
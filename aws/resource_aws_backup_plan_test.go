@@ -187,7 +187,7 @@ func TestAccAwsBackupPlan_disappears(t *testing.T) {
 }
 
 func testAccCheckAwsBackupPlanDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).backupconn
+	conn := testAccProvider.Meta().(*AWSClient).backupconn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_backup_plan" {
 			continue
@@ -211,7 +211,7 @@ func testAccCheckAwsBackupPlanDestroy(s *terraform.State) error {
 
 func testAccCheckAwsBackupPlanDisappears(backupPlan *backup.GetBackupPlanOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).backupconn
+		conn := testAccProvider.Meta().(*AWSClient).backupconn()
 
 		input := &backup.DeleteBackupPlanInput{
 			BackupPlanId: backupPlan.BackupPlanId,
@@ -235,7 +235,7 @@ func testAccCheckAwsBackupPlanExists(name string, plan *backup.GetBackupPlanOutp
 			return fmt.Errorf("Resource ID is not set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).backupconn
+		conn := testAccProvider.Meta().(*AWSClient).backupconn()
 
 		input := &backup.GetBackupPlanInput{
 			BackupPlanId: aws.String(rs.Primary.ID),
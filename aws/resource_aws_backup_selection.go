@@ -0,0 +1,241 @@
+package aws
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/backup"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsBackupSelection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsBackupSelectionCreate,
+		Read:   resourceAwsBackupSelectionRead,
+		Delete: resourceAwsBackupSelectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsBackupSelectionImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9\-\_\.]{1,50}$`), "must consist of alphanumeric characters, hyphens, underscores or periods and be at most 50 characters long"),
+			},
+			"plan_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"iam_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"selection_tag": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								backup.ConditionTypeStringequals,
+							}, false),
+						},
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+				Set: resourceAwsBackupSelectionTagHash,
+			},
+			"resources": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func resourceAwsBackupSelectionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).backupconn()
+
+	selection := &backup.Selection{
+		IamRoleArn:    aws.String(d.Get("iam_role_arn").(string)),
+		ListOfTags:    expandBackupConditionTags(d.Get("selection_tag").(*schema.Set).List()),
+		Resources:     expandStringSet(d.Get("resources").(*schema.Set)),
+		SelectionName: aws.String(d.Get("name").(string)),
+	}
+
+	input := &backup.CreateBackupSelectionInput{
+		BackupPlanId:    aws.String(d.Get("plan_id").(string)),
+		BackupSelection: selection,
+	}
+
+	var output *backup.CreateBackupSelectionOutput
+	// Retry for IAM eventual consistency
+	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		var err error
+		output, err = conn.CreateBackupSelection(input)
+
+		if isAWSErr(err, backup.ErrCodeInvalidParameterValueException, "Unable to assume role") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("error creating Backup Selection: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.SelectionId))
+
+	return resourceAwsBackupSelectionRead(d, meta)
+}
+
+func resourceAwsBackupSelectionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).backupconn()
+
+	input := &backup.GetBackupSelectionInput{
+		BackupPlanId: aws.String(d.Get("plan_id").(string)),
+		SelectionId:  aws.String(d.Id()),
+	}
+
+	resp, err := conn.GetBackupSelection(input)
+
+	// Deleting the plan also deletes its selections.
+	if isAWSErr(err, backup.ErrCodeResourceNotFoundException, "") || isAWSErr(err, backup.ErrCodeInvalidParameterValueException, "Cannot find Backup plan") {
+		log.Printf("[WARN] Backup Selection (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Backup Selection (%s): %s", d.Id(), err)
+	}
+
+	d.Set("plan_id", resp.BackupPlanId)
+	d.Set("name", resp.BackupSelection.SelectionName)
+	d.Set("iam_role_arn", resp.BackupSelection.IamRoleArn)
+
+	if err := d.Set("selection_tag", flattenBackupConditionTags(resp.BackupSelection.ListOfTags)); err != nil {
+		return fmt.Errorf("error setting selection_tag: %s", err)
+	}
+
+	if err := d.Set("resources", flattenStringSet(resp.BackupSelection.Resources)); err != nil {
+		return fmt.Errorf("error setting resources: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsBackupSelectionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).backupconn()
+
+	input := &backup.DeleteBackupSelectionInput{
+		BackupPlanId: aws.String(d.Get("plan_id").(string)),
+		SelectionId:  aws.String(d.Id()),
+	}
+
+	_, err := conn.DeleteBackupSelection(input)
+	if isAWSErr(err, backup.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Backup Selection (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsBackupSelectionImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), "|")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("unexpected format of ID (%q), expected <plan-id>|<selection-id>", d.Id())
+	}
+
+	d.Set("plan_id", idParts[0])
+	d.SetId(idParts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandBackupConditionTags(tagList []interface{}) []*backup.Condition {
+	conditions := []*backup.Condition{}
+
+	for _, i := range tagList {
+		item := i.(map[string]interface{})
+
+		conditions = append(conditions, &backup.Condition{
+			ConditionKey:   aws.String(item["key"].(string)),
+			ConditionType:  aws.String(item["type"].(string)),
+			ConditionValue: aws.String(item["value"].(string)),
+		})
+	}
+
+	return conditions
+}
+
+func flattenBackupConditionTags(conditions []*backup.Condition) *schema.Set {
+	tagSet := &schema.Set{F: resourceAwsBackupSelectionTagHash}
+
+	for _, condition := range conditions {
+		tagSet.Add(map[string]interface{}{
+			"key":   aws.StringValue(condition.ConditionKey),
+			"type":  aws.StringValue(condition.ConditionType),
+			"value": aws.StringValue(condition.ConditionValue),
+		})
+	}
+
+	return tagSet
+}
+
+func resourceAwsBackupSelectionTagHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+
+	if v, ok := m["key"]; ok {
+		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
+	}
+
+	if v, ok := m["type"]; ok {
+		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
+	}
+
+	if v, ok := m["value"]; ok {
+		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
+	}
+
+	return hashcode.String(buf.String())
+}
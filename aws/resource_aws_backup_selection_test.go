@@ -0,0 +1,313 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/backup"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAwsBackupSelection_basic(t *testing.T) {
+	var selection1 backup.GetBackupSelectionOutput
+	resourceName := "aws_backup_selection.test"
+	rInt := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsBackupSelectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBackupSelectionConfigBasic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsBackupSelectionExists(resourceName, &selection1),
+					resource.TestCheckResourceAttrPair(resourceName, "iam_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("tf_acc_test_backup_selection_%d", rInt)),
+					resource.TestCheckResourceAttrPair(resourceName, "plan_id", "aws_backup_plan.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "resources.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "selection_tag.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAwsBackupSelectionImportStateIDFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAwsBackupSelection_disappears(t *testing.T) {
+	var selection1 backup.GetBackupSelectionOutput
+	resourceName := "aws_backup_selection.test"
+	rInt := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsBackupSelectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBackupSelectionConfigBasic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsBackupSelectionExists(resourceName, &selection1),
+					testAccCheckAwsBackupSelectionDisappears(&selection1),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAwsBackupSelection_withTags(t *testing.T) {
+	var selection1 backup.GetBackupSelectionOutput
+	resourceName := "aws_backup_selection.test"
+	rInt := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsBackupSelectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBackupSelectionConfigWithTags(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsBackupSelectionExists(resourceName, &selection1),
+					resource.TestCheckResourceAttr(resourceName, "resources.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "selection_tag.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAwsBackupSelectionImportStateIDFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAwsBackupSelection_withResources(t *testing.T) {
+	var selection1 backup.GetBackupSelectionOutput
+	resourceName := "aws_backup_selection.test"
+	rInt := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsBackupSelectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBackupSelectionConfigWithResources(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsBackupSelectionExists(resourceName, &selection1),
+					resource.TestCheckResourceAttr(resourceName, "resources.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "selection_tag.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAwsBackupSelectionImportStateIDFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsBackupSelectionDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).backupconn()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_backup_selection" {
+			continue
+		}
+
+		input := &backup.GetBackupSelectionInput{
+			BackupPlanId: aws.String(rs.Primary.Attributes["plan_id"]),
+			SelectionId:  aws.String(rs.Primary.ID),
+		}
+
+		resp, err := conn.GetBackupSelection(input)
+
+		if err == nil {
+			if *resp.SelectionId == rs.Primary.ID {
+				return fmt.Errorf("Selection '%s' was not deleted properly", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsBackupSelectionExists(name string, selection *backup.GetBackupSelectionOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Resource ID is not set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).backupconn()
+
+		input := &backup.GetBackupSelectionInput{
+			BackupPlanId: aws.String(rs.Primary.Attributes["plan_id"]),
+			SelectionId:  aws.String(rs.Primary.ID),
+		}
+
+		output, err := conn.GetBackupSelection(input)
+
+		if err != nil {
+			return err
+		}
+
+		*selection = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAwsBackupSelectionDisappears(selection *backup.GetBackupSelectionOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).backupconn()
+
+		input := &backup.DeleteBackupSelectionInput{
+			BackupPlanId: selection.BackupPlanId,
+			SelectionId:  selection.SelectionId,
+		}
+
+		_, err := conn.DeleteBackupSelection(input)
+
+		return err
+	}
+}
+
+func testAccAwsBackupSelectionImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s|%s", rs.Primary.Attributes["plan_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccBackupSelectionConfigBase(rInt int) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = "tf_acc_test_backup_selection_%[1]d"
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "backup.amazonaws.com"
+      }
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AWSBackupServiceRolePolicyForBackup"
+  role       = "${aws_iam_role.test.name}"
+}
+
+resource "aws_backup_vault" "test" {
+  name = "tf_acc_test_backup_vault_%[1]d"
+}
+
+resource "aws_backup_plan" "test" {
+  name = "tf_acc_test_backup_plan_%[1]d"
+
+  rule {
+    rule_name         = "tf_acc_test_backup_rule_%[1]d"
+    target_vault_name = "${aws_backup_vault.test.name}"
+    schedule          = "cron(0 12 * * ? *)"
+  }
+}
+`, rInt)
+}
+
+func testAccBackupSelectionConfigBasic(rInt int) string {
+	return testAccBackupSelectionConfigBase(rInt) + fmt.Sprintf(`
+resource "aws_backup_selection" "test" {
+  plan_id      = "${aws_backup_plan.test.id}"
+  name         = "tf_acc_test_backup_selection_%[1]d"
+  iam_role_arn = "${aws_iam_role.test.arn}"
+
+  selection_tag {
+    type  = "STRINGEQUALS"
+    key   = "foo"
+    value = "bar"
+  }
+
+  resources = [
+    "arn:${data.aws_partition.current.partition}:ec2:us-east-1:${data.aws_caller_identity.current.account_id}:volume/",
+  ]
+}
+`, rInt)
+}
+
+func testAccBackupSelectionConfigWithTags(rInt int) string {
+	return testAccBackupSelectionConfigBase(rInt) + fmt.Sprintf(`
+resource "aws_backup_selection" "test" {
+  plan_id      = "${aws_backup_plan.test.id}"
+  name         = "tf_acc_test_backup_selection_%[1]d"
+  iam_role_arn = "${aws_iam_role.test.arn}"
+
+  selection_tag {
+    type  = "STRINGEQUALS"
+    key   = "foo"
+    value = "bar"
+  }
+
+  selection_tag {
+    type  = "STRINGEQUALS"
+    key   = "boo"
+    value = "far"
+  }
+}
+`, rInt)
+}
+
+func testAccBackupSelectionConfigWithResources(rInt int) string {
+	return testAccBackupSelectionConfigBase(rInt) + fmt.Sprintf(`
+data "aws_availability_zones" "available" {
+  state = "available"
+}
+
+resource "aws_ebs_volume" "test" {
+  count = 2
+
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+  size              = 1
+}
+
+resource "aws_backup_selection" "test" {
+  plan_id      = "${aws_backup_plan.test.id}"
+  name         = "tf_acc_test_backup_selection_%[1]d"
+  iam_role_arn = "${aws_iam_role.test.arn}"
+
+  resources = ["${aws_ebs_volume.test.*.arn}"]
+}
+`, rInt)
+}
@@ -51,7 +51,7 @@ func resourceAwsBackupVault() *schema.Resource {
 }
 
 func resourceAwsBackupVaultCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).backupconn
+	conn := meta.(*AWSClient).backupconn()
 
 	input := &backup.CreateBackupVaultInput{
 		BackupVaultName: aws.String(d.Get("name").(string)),
@@ -76,7 +76,7 @@ func resourceAwsBackupVaultCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsBackupVaultRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).backupconn
+	conn := meta.(*AWSClient).backupconn()
 
 	input := &backup.DescribeBackupVaultInput{
 		BackupVaultName: aws.String(d.Id()),
@@ -112,11 +112,11 @@ func resourceAwsBackupVaultRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsBackupVaultUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).backupconn
+	conn := meta.(*AWSClient).backupconn()
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		resourceArn := d.Get("arn").(string)
-		oraw, nraw := d.GetChange("tags")
+		oraw, nraw := getTagsChange(d)
 		create, remove := diffTagsGeneric(oraw.(map[string]interface{}), nraw.(map[string]interface{}))
 
 		if len(remove) > 0 {
@@ -160,7 +160,7 @@ func resourceAwsBackupVaultUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsBackupVaultDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).backupconn
+	conn := meta.(*AWSClient).backupconn()
 
 	input := &backup.DeleteBackupVaultInput{
 		BackupVaultName: aws.String(d.Get("name").(string)),
@@ -93,7 +93,7 @@ func TestAccAwsBackupVault_withTags(t *testing.T) {
 }
 
 func testAccCheckAwsBackupVaultDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).backupconn
+	conn := testAccProvider.Meta().(*AWSClient).backupconn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_backup_vault" {
 			continue
@@ -122,7 +122,7 @@ func testAccCheckAwsBackupVaultExists(name string, vault *backup.DescribeBackupV
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).backupconn
+		conn := testAccProvider.Meta().(*AWSClient).backupconn()
 		params := &backup.DescribeBackupVaultInput{
 			BackupVaultName: aws.String(rs.Primary.ID),
 		}
@@ -143,7 +143,7 @@ func resourceAwsBatchComputeEnvironment() *schema.Resource {
 }
 
 func resourceAwsBatchComputeEnvironmentCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).batchconn
+	conn := meta.(*AWSClient).batchconn()
 
 	computeEnvironmentName := d.Get("compute_environment_name").(string)
 
@@ -240,7 +240,7 @@ func resourceAwsBatchComputeEnvironmentCreate(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsBatchComputeEnvironmentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).batchconn
+	conn := meta.(*AWSClient).batchconn()
 
 	computeEnvironmentName := d.Get("compute_environment_name").(string)
 
@@ -303,7 +303,7 @@ func flattenBatchComputeResources(computeResource *batch.ComputeResource) []map[
 }
 
 func resourceAwsBatchComputeEnvironmentDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).batchconn
+	conn := meta.(*AWSClient).batchconn()
 	computeEnvironmentName := d.Get("compute_environment_name").(string)
 
 	log.Printf("[DEBUG] Disabling Batch Compute Environment: %s", computeEnvironmentName)
@@ -322,7 +322,7 @@ func resourceAwsBatchComputeEnvironmentDelete(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsBatchComputeEnvironmentUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).batchconn
+	conn := meta.(*AWSClient).batchconn()
 
 	computeEnvironmentName := d.Get("compute_environment_name").(string)
 
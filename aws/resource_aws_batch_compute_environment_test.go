@@ -29,7 +29,7 @@ func testSweepBatchComputeEnvironments(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).batchconn
+	conn := client.(*AWSClient).batchconn()
 
 	out, err := conn.DescribeComputeEnvironments(&batch.DescribeComputeEnvironmentsInput{})
 	if err != nil {
@@ -295,7 +295,7 @@ func TestAccAWSBatchComputeEnvironment_updateState(t *testing.T) {
 }
 
 func testAccCheckBatchComputeEnvironmentDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).batchconn
+	conn := testAccProvider.Meta().(*AWSClient).batchconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_batch_compute_environment" {
@@ -322,7 +322,7 @@ func testAccCheckBatchComputeEnvironmentDestroy(s *terraform.State) error {
 
 func testAccCheckAwsBatchComputeEnvironmentExists() resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).batchconn
+		conn := testAccProvider.Meta().(*AWSClient).batchconn()
 
 		for _, rs := range s.RootModule().Resources {
 			if rs.Type != "aws_batch_compute_environment" {
@@ -93,7 +93,7 @@ func resourceAwsBatchJobDefinition() *schema.Resource {
 }
 
 func resourceAwsBatchJobDefinitionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).batchconn
+	conn := meta.(*AWSClient).batchconn()
 	name := d.Get("name").(string)
 
 	input := &batch.RegisterJobDefinitionInput{
@@ -131,7 +131,7 @@ func resourceAwsBatchJobDefinitionCreate(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsBatchJobDefinitionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).batchconn
+	conn := meta.(*AWSClient).batchconn()
 	arn := d.Get("arn").(string)
 	job, err := getJobDefinition(conn, arn)
 	if err != nil {
@@ -159,7 +159,7 @@ func resourceAwsBatchJobDefinitionRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsBatchJobDefinitionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).batchconn
+	conn := meta.(*AWSClient).batchconn()
 	arn := d.Get("arn").(string)
 	_, err := conn.DeregisterJobDefinition(&batch.DeregisterJobDefinitionInput{
 		JobDefinition: aws.String(arn),
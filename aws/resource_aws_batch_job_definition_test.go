@@ -107,7 +107,7 @@ func testAccCheckBatchJobDefinitionExists(n string, jd *batch.JobDefinition) res
 			return fmt.Errorf("No Batch Job Queue ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).batchconn
+		conn := testAccProvider.Meta().(*AWSClient).batchconn()
 		arn := rs.Primary.Attributes["arn"]
 		def, err := getJobDefinition(conn, arn)
 		if err != nil {
@@ -165,7 +165,7 @@ func testAccCheckBatchJobDefinitionDestroy(s *terraform.State) error {
 		if rs.Type != "aws_batch_job_definition" {
 			continue
 		}
-		conn := testAccProvider.Meta().(*AWSClient).batchconn
+		conn := testAccProvider.Meta().(*AWSClient).batchconn()
 		js, err := getJobDefinition(conn, rs.Primary.Attributes["arn"])
 		if err == nil && js != nil {
 			if *js.Status == "ACTIVE" {
@@ -26,7 +26,7 @@ func testSweepBatchJobQueues(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).batchconn
+	conn := client.(*AWSClient).batchconn()
 
 	prefixes := []string{
 		"tf_acc",
@@ -153,7 +153,7 @@ func testAccCheckBatchJobQueueExists(n string, jq *batch.JobQueueDetail) resourc
 			return fmt.Errorf("No Batch Job Queue ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).batchconn
+		conn := testAccProvider.Meta().(*AWSClient).batchconn()
 		name := rs.Primary.Attributes["name"]
 		queue, err := getJobQueue(conn, name)
 		if err != nil {
@@ -200,7 +200,7 @@ func testAccCheckBatchJobQueueDestroy(s *terraform.State) error {
 		if rs.Type != "aws_batch_job_queue" {
 			continue
 		}
-		conn := testAccProvider.Meta().(*AWSClient).batchconn
+		conn := testAccProvider.Meta().(*AWSClient).batchconn()
 		jq, err := getJobQueue(conn, rs.Primary.Attributes["name"])
 		if err == nil {
 			if jq != nil {
@@ -214,7 +214,7 @@ func testAccCheckBatchJobQueueDestroy(s *terraform.State) error {
 
 func testAccCheckBatchJobQueueDisappears(jobQueue *batch.JobQueueDetail) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).batchconn
+		conn := testAccProvider.Meta().(*AWSClient).batchconn()
 		name := aws.StringValue(jobQueue.JobQueueName)
 
 		err := disableBatchJobQueue(name, conn)
@@ -157,7 +157,7 @@ func resourceAwsBudgetsBudgetCreate(d *schema.ResourceData, meta interface{}) er
 		budget.BudgetName = aws.String(resource.UniqueId())
 	}
 
-	client := meta.(*AWSClient).budgetconn
+	client := meta.(*AWSClient).budgetconn()
 	var accountID string
 	if v, ok := d.GetOk("account_id"); ok {
 		accountID = v.(string)
@@ -183,7 +183,7 @@ func resourceAwsBudgetsBudgetRead(d *schema.ResourceData, meta interface{}) erro
 		return err
 	}
 
-	client := meta.(*AWSClient).budgetconn
+	client := meta.(*AWSClient).budgetconn()
 	describeBudgetOutput, err := client.DescribeBudget(&budgets.DescribeBudgetInput{
 		BudgetName: aws.String(budgetName),
 		AccountId:  aws.String(accountID),
@@ -239,7 +239,7 @@ func resourceAwsBudgetsBudgetUpdate(d *schema.ResourceData, meta interface{}) er
 		return err
 	}
 
-	client := meta.(*AWSClient).budgetconn
+	client := meta.(*AWSClient).budgetconn()
 	budget, err := expandBudgetsBudgetUnmarshal(d)
 	if err != nil {
 		return fmt.Errorf("could not create budget: %v", err)
@@ -262,7 +262,7 @@ func resourceAwsBudgetsBudgetDelete(d *schema.ResourceData, meta interface{}) er
 		return err
 	}
 
-	client := meta.(*AWSClient).budgetconn
+	client := meta.(*AWSClient).budgetconn()
 	_, err = client.DeleteBudget(&budgets.DeleteBudgetInput{
 		BudgetName: aws.String(budgetName),
 		AccountId:  aws.String(accountID),
@@ -66,7 +66,7 @@ func resourceAwsCloud9EnvironmentEc2() *schema.Resource {
 }
 
 func resourceAwsCloud9EnvironmentEc2Create(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloud9conn
+	conn := meta.(*AWSClient).cloud9conn()
 
 	params := &cloud9.CreateEnvironmentEC2Input{
 		InstanceType:       aws.String(d.Get("instance_type").(string)),
@@ -141,7 +141,7 @@ func resourceAwsCloud9EnvironmentEc2Create(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsCloud9EnvironmentEc2Read(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloud9conn
+	conn := meta.(*AWSClient).cloud9conn()
 
 	log.Printf("[INFO] Reading Cloud9 Environment EC2 %s", d.Id())
 
@@ -175,7 +175,7 @@ func resourceAwsCloud9EnvironmentEc2Read(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsCloud9EnvironmentEc2Update(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloud9conn
+	conn := meta.(*AWSClient).cloud9conn()
 
 	input := cloud9.UpdateEnvironmentInput{
 		Description:   aws.String(d.Get("description").(string)),
@@ -196,7 +196,7 @@ func resourceAwsCloud9EnvironmentEc2Update(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsCloud9EnvironmentEc2Delete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloud9conn
+	conn := meta.(*AWSClient).cloud9conn()
 
 	_, err := conn.DeleteEnvironment(&cloud9.DeleteEnvironmentInput{
 		EnvironmentId: aws.String(d.Id()),
@@ -128,7 +128,7 @@ func testAccCheckAWSCloud9EnvironmentEc2Exists(n string, res *cloud9.Environment
 			return fmt.Errorf("No Cloud9 Environment EC2 ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cloud9conn
+		conn := testAccProvider.Meta().(*AWSClient).cloud9conn()
 
 		out, err := conn.DescribeEnvironments(&cloud9.DescribeEnvironmentsInput{
 			EnvironmentIds: []*string{aws.String(rs.Primary.ID)},
@@ -151,7 +151,7 @@ func testAccCheckAWSCloud9EnvironmentEc2Exists(n string, res *cloud9.Environment
 }
 
 func testAccCheckAWSCloud9EnvironmentEc2Destroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cloud9conn
+	conn := testAccProvider.Meta().(*AWSClient).cloud9conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloud9_environment_ec2" {
@@ -115,7 +115,7 @@ func resourceAwsCloudFormationStack() *schema.Resource {
 }
 
 func resourceAwsCloudFormationStackCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cfconn
+	conn := meta.(*AWSClient).cfconn()
 
 	input := cloudformation.CreateStackInput{
 		StackName: aws.String(d.Get("name").(string)),
@@ -257,7 +257,7 @@ func resourceAwsCloudFormationStackCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsCloudFormationStackRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cfconn
+	conn := meta.(*AWSClient).cfconn()
 
 	input := &cloudformation.DescribeStacksInput{
 		StackName: aws.String(d.Id()),
@@ -353,7 +353,7 @@ func resourceAwsCloudFormationStackRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsCloudFormationStackUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cfconn
+	conn := meta.(*AWSClient).cfconn()
 
 	input := &cloudformation.UpdateStackInput{
 		StackName: aws.String(d.Id()),
@@ -479,7 +479,7 @@ func resourceAwsCloudFormationStackUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsCloudFormationStackDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cfconn
+	conn := meta.(*AWSClient).cfconn()
 
 	input := &cloudformation.DeleteStackInput{
 		StackName: aws.String(d.Id()),
@@ -69,7 +69,7 @@ func resourceAwsCloudFormationStackSetInstance() *schema.Resource {
 }
 
 func resourceAwsCloudFormationStackSetInstanceCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cfconn
+	conn := meta.(*AWSClient).cfconn()
 
 	accountID := meta.(*AWSClient).accountid
 	if v, ok := d.GetOk("account_id"); ok {
@@ -111,7 +111,7 @@ func resourceAwsCloudFormationStackSetInstanceCreate(d *schema.ResourceData, met
 }
 
 func resourceAwsCloudFormationStackSetInstanceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cfconn
+	conn := meta.(*AWSClient).cfconn()
 
 	stackSetName, accountID, region, err := resourceAwsCloudFormationStackSetInstanceParseId(d.Id())
 
@@ -164,7 +164,7 @@ func resourceAwsCloudFormationStackSetInstanceRead(d *schema.ResourceData, meta
 }
 
 func resourceAwsCloudFormationStackSetInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cfconn
+	conn := meta.(*AWSClient).cfconn()
 
 	if d.HasChange("parameter_overrides") {
 		stackSetName, accountID, region, err := resourceAwsCloudFormationStackSetInstanceParseId(d.Id())
@@ -201,7 +201,7 @@ func resourceAwsCloudFormationStackSetInstanceUpdate(d *schema.ResourceData, met
 }
 
 func resourceAwsCloudFormationStackSetInstanceDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cfconn
+	conn := meta.(*AWSClient).cfconn()
 
 	stackSetName, accountID, region, err := resourceAwsCloudFormationStackSetInstanceParseId(d.Id())
 
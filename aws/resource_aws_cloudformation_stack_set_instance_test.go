@@ -219,7 +219,7 @@ func testAccCheckCloudFormationStackSetInstanceExists(resourceName string, stack
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cfconn
+		conn := testAccProvider.Meta().(*AWSClient).cfconn()
 
 		stackSetName, accountID, region, err := resourceAwsCloudFormationStackSetInstanceParseId(rs.Primary.ID)
 
@@ -251,7 +251,7 @@ func testAccCheckCloudFormationStackSetInstanceExists(resourceName string, stack
 
 func testAccCheckCloudFormationStackSetInstanceStackExists(stackInstance *cloudformation.StackInstance, stack *cloudformation.Stack) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).cfconn
+		conn := testAccProvider.Meta().(*AWSClient).cfconn()
 
 		input := &cloudformation.DescribeStacksInput{
 			StackName: stackInstance.StackId,
@@ -274,7 +274,7 @@ func testAccCheckCloudFormationStackSetInstanceStackExists(stackInstance *cloudf
 }
 
 func testAccCheckAWSCloudFormationStackSetInstanceDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cfconn
+	conn := testAccProvider.Meta().(*AWSClient).cfconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudformation_stack_set_instance" {
@@ -317,7 +317,7 @@ func testAccCheckAWSCloudFormationStackSetInstanceDestroy(s *terraform.State) er
 
 func testAccCheckCloudFormationStackSetInstanceDisappears(stackInstance *cloudformation.StackInstance) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).cfconn
+		conn := testAccProvider.Meta().(*AWSClient).cfconn()
 
 		input := &cloudformation.DeleteStackInstancesInput{
 			Accounts:     []*string{stackInstance.Account},
@@ -529,7 +529,7 @@ func testAccCheckCloudFormationStackSetExists(resourceName string, stackSet *clo
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cfconn
+		conn := testAccProvider.Meta().(*AWSClient).cfconn()
 
 		input := &cloudformation.DescribeStackSetInput{
 			StackSetName: aws.String(rs.Primary.ID),
@@ -552,7 +552,7 @@ func testAccCheckCloudFormationStackSetExists(resourceName string, stackSet *clo
 }
 
 func testAccCheckAWSCloudFormationStackSetDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cfconn
+	conn := testAccProvider.Meta().(*AWSClient).cfconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudformation_stack_set" {
@@ -583,7 +583,7 @@ func testAccCheckAWSCloudFormationStackSetDestroy(s *terraform.State) error {
 
 func testAccCheckCloudFormationStackSetDisappears(stackSet *cloudformation.StackSet) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).cfconn
+		conn := testAccProvider.Meta().(*AWSClient).cfconn()
 
 		input := &cloudformation.DeleteStackSetInput{
 			StackSetName: stackSet.StackSetName,
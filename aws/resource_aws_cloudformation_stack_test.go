@@ -265,7 +265,7 @@ func testAccCheckCloudFormationStackExists(n string, stack *cloudformation.Stack
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cfconn
+		conn := testAccProvider.Meta().(*AWSClient).cfconn()
 		params := &cloudformation.DescribeStacksInput{
 			StackName: aws.String(rs.Primary.ID),
 		}
@@ -284,7 +284,7 @@ func testAccCheckCloudFormationStackExists(n string, stack *cloudformation.Stack
 }
 
 func testAccCheckAWSCloudFormationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cfconn
+	conn := testAccProvider.Meta().(*AWSClient).cfconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudformation_stack" {
@@ -313,7 +313,7 @@ func testAccCheckAWSCloudFormationDestroy(s *terraform.State) error {
 
 func testAccCheckCloudFormationStackDisappears(stack *cloudformation.Stack) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).cfconn
+		conn := testAccProvider.Meta().(*AWSClient).cfconn()
 
 		input := &cloudformation.DeleteStackInput{
 			StackName: stack.StackName,
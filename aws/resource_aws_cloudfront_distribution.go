@@ -726,7 +726,7 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 }
 
 func resourceAwsCloudFrontDistributionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudfrontconn
+	conn := meta.(*AWSClient).cloudfrontconn()
 
 	params := &cloudfront.CreateDistributionWithTagsInput{
 		DistributionConfigWithTags: &cloudfront.DistributionConfigWithTags{
@@ -774,7 +774,7 @@ func resourceAwsCloudFrontDistributionCreate(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsCloudFrontDistributionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudfrontconn
+	conn := meta.(*AWSClient).cloudfrontconn()
 	params := &cloudfront.GetDistributionInput{
 		Id: aws.String(d.Id()),
 	}
@@ -825,7 +825,7 @@ func resourceAwsCloudFrontDistributionRead(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsCloudFrontDistributionUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudfrontconn
+	conn := meta.(*AWSClient).cloudfrontconn()
 	params := &cloudfront.UpdateDistributionInput{
 		Id:                 aws.String(d.Id()),
 		DistributionConfig: expandDistributionConfig(d),
@@ -871,7 +871,7 @@ func resourceAwsCloudFrontDistributionUpdate(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsCloudFrontDistributionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudfrontconn
+	conn := meta.(*AWSClient).cloudfrontconn()
 
 	if d.Get("retain_on_delete").(bool) {
 		// Check if we need to disable first
@@ -1056,7 +1056,7 @@ func resourceAwsCloudFrontDistributionWaitUntilDeployed(id string, meta interfac
 // The refresh function for resourceAwsCloudFrontWebDistributionWaitUntilDeployed.
 func resourceAwsCloudFrontWebDistributionStateRefreshFunc(id string, meta interface{}) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		conn := meta.(*AWSClient).cloudfrontconn
+		conn := meta.(*AWSClient).cloudfrontconn()
 		params := &cloudfront.GetDistributionInput{
 			Id: aws.String(id),
 		}
@@ -27,7 +27,7 @@ func testSweepCloudFrontDistributions(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).cloudfrontconn
+	conn := client.(*AWSClient).cloudfrontconn()
 
 	distributionSummaries := make([]*cloudfront.DistributionSummary, 0)
 
@@ -572,7 +572,7 @@ func TestAccAWSCloudFrontDistribution_ViewerCertificate_AcmCertificateArn_Confli
 }
 
 func testAccCheckCloudFrontDistributionDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn
+	conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudfront_distribution" {
@@ -617,7 +617,7 @@ func testAccCheckCloudFrontDistributionExists(resourceName string, distribution
 			return fmt.Errorf("Resource ID not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn
+		conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn()
 
 		input := &cloudfront.GetDistributionInput{
 			Id: aws.String(rs.Primary.ID),
@@ -637,7 +637,7 @@ func testAccCheckCloudFrontDistributionExists(resourceName string, distribution
 
 func testAccCheckCloudFrontDistributionExistsAPIOnly(distribution *cloudfront.Distribution) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn
+		conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn()
 
 		input := &cloudfront.GetDistributionInput{
 			Id: distribution.Id,
@@ -673,7 +673,7 @@ func testAccCheckCloudFrontDistributionDisabled(distribution *cloudfront.Distrib
 // This requires the CloudFront Distribution to previously be disabled and fetches latest ETag automatically.
 func testAccCheckCloudFrontDistributionDisappears(distribution *cloudfront.Distribution) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn
+		conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn()
 
 		getDistributionInput := &cloudfront.GetDistributionInput{
 			Id: distribution.Id,
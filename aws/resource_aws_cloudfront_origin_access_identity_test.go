@@ -90,7 +90,7 @@ func TestAccAWSCloudFrontOriginAccessIdentity_noComment(t *testing.T) {
 }
 
 func testAccCheckCloudFrontOriginAccessIdentityDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn
+	conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudfront_origin_access_identity" {
@@ -120,7 +120,7 @@ func testAccCheckCloudFrontOriginAccessIdentityExistence(r string) resource.Test
 			return fmt.Errorf("No Id is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn
+		conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn()
 
 		params := &cloudfront.GetCloudFrontOriginAccessIdentityInput{
 			Id: aws.String(rs.Primary.ID),
@@ -57,7 +57,7 @@ func resourceAwsCloudFrontPublicKey() *schema.Resource {
 }
 
 func resourceAwsCloudFrontPublicKeyCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudfrontconn
+	conn := meta.(*AWSClient).cloudfrontconn()
 
 	if v, ok := d.GetOk("name"); ok {
 		d.Set("name", v.(string))
@@ -83,7 +83,7 @@ func resourceAwsCloudFrontPublicKeyCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsCloudFrontPublicKeyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudfrontconn
+	conn := meta.(*AWSClient).cloudfrontconn()
 	request := &cloudfront.GetPublicKeyInput{
 		Id: aws.String(d.Id()),
 	}
@@ -115,7 +115,7 @@ func resourceAwsCloudFrontPublicKeyRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsCloudFrontPublicKeyUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudfrontconn
+	conn := meta.(*AWSClient).cloudfrontconn()
 
 	request := &cloudfront.UpdatePublicKeyInput{
 		Id:              aws.String(d.Id()),
@@ -132,7 +132,7 @@ func resourceAwsCloudFrontPublicKeyUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsCloudFrontPublicKeyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudfrontconn
+	conn := meta.(*AWSClient).cloudfrontconn()
 
 	request := &cloudfront.DeletePublicKeyInput{
 		Id:      aws.String(d.Id()),
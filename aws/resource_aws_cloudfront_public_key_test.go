@@ -90,7 +90,7 @@ func testAccCheckCloudFrontPublicKeyExistence(r string) resource.TestCheckFunc {
 			return fmt.Errorf("No Id is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn
+		conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn()
 
 		params := &cloudfront.GetPublicKeyInput{
 			Id: aws.String(rs.Primary.ID),
@@ -105,7 +105,7 @@ func testAccCheckCloudFrontPublicKeyExistence(r string) resource.TestCheckFunc {
 }
 
 func testAccCheckCloudFrontPublicKeyDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn
+	conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudfront_public_key" {
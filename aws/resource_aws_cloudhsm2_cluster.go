@@ -149,7 +149,7 @@ func resourceAwsCloudHsm2ClusterRefreshFunc(conn *cloudhsmv2.CloudHSMV2, cluster
 }
 
 func resourceAwsCloudHsm2ClusterCreate(d *schema.ResourceData, meta interface{}) error {
-	cloudhsm2 := meta.(*AWSClient).cloudhsmv2conn
+	cloudhsm2 := meta.(*AWSClient).cloudhsmv2conn()
 
 	input := &cloudhsmv2.CreateClusterInput{
 		HsmType:   aws.String(d.Get("hsm_type").(string)),
@@ -219,7 +219,7 @@ func resourceAwsCloudHsm2ClusterCreate(d *schema.ResourceData, meta interface{})
 
 func resourceAwsCloudHsm2ClusterRead(d *schema.ResourceData, meta interface{}) error {
 
-	cluster, err := describeCloudHsm2Cluster(meta.(*AWSClient).cloudhsmv2conn, d.Id())
+	cluster, err := describeCloudHsm2Cluster(meta.(*AWSClient).cloudhsmv2conn(), d.Id())
 
 	if cluster == nil {
 		log.Printf("[WARN] CloudHSMv2 Cluster (%s) not found", d.Id())
@@ -251,7 +251,7 @@ func resourceAwsCloudHsm2ClusterRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsCloudHsm2ClusterUpdate(d *schema.ResourceData, meta interface{}) error {
-	cloudhsm2 := meta.(*AWSClient).cloudhsmv2conn
+	cloudhsm2 := meta.(*AWSClient).cloudhsmv2conn()
 
 	if err := setTagsAwsCloudHsm2Cluster(cloudhsm2, d); err != nil {
 		return err
@@ -261,7 +261,7 @@ func resourceAwsCloudHsm2ClusterUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsCloudHsm2ClusterDelete(d *schema.ResourceData, meta interface{}) error {
-	cloudhsm2 := meta.(*AWSClient).cloudhsmv2conn
+	cloudhsm2 := meta.(*AWSClient).cloudhsmv2conn()
 
 	log.Printf("[DEBUG] CloudHSMv2 Delete cluster: %s", d.Id())
 	err := resource.Retry(180*time.Second, func() *resource.RetryError {
@@ -303,8 +303,8 @@ func resourceAwsCloudHsm2ClusterDelete(d *schema.ResourceData, meta interface{})
 }
 
 func setTagsAwsCloudHsm2Cluster(conn *cloudhsmv2.CloudHSMV2, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if hasTagsChange(d) {
+		oraw, nraw := getTagsChange(d)
 		create, remove := diffTagsGeneric(oraw.(map[string]interface{}), nraw.(map[string]interface{}))
 
 		if len(remove) > 0 {
@@ -80,7 +80,7 @@ func testAccCheckAWSCloudHsm2ClusterDestroy(s *terraform.State) error {
 		if rs.Type != "aws_cloudhsm_v2_cluster" {
 			continue
 		}
-		cluster, err := describeCloudHsm2Cluster(testAccProvider.Meta().(*AWSClient).cloudhsmv2conn, rs.Primary.ID)
+		cluster, err := describeCloudHsm2Cluster(testAccProvider.Meta().(*AWSClient).cloudhsmv2conn(), rs.Primary.ID)
 
 		if err != nil {
 			return err
@@ -96,7 +96,7 @@ func testAccCheckAWSCloudHsm2ClusterDestroy(s *terraform.State) error {
 
 func testAccCheckAWSCloudHsm2ClusterExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).cloudhsmv2conn
+		conn := testAccProvider.Meta().(*AWSClient).cloudhsmv2conn()
 		it, ok := s.RootModule().Resources[name]
 		if !ok {
 			return fmt.Errorf("Not found: %s", name)
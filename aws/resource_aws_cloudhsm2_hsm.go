@@ -103,7 +103,7 @@ func describeHsm(conn *cloudhsmv2.CloudHSMV2, hsmId string) (*cloudhsmv2.Hsm, er
 func resourceAwsCloudHsm2HsmRefreshFunc(
 	d *schema.ResourceData, meta interface{}) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		hsm, err := describeHsm(meta.(*AWSClient).cloudhsmv2conn, d.Id())
+		hsm, err := describeHsm(meta.(*AWSClient).cloudhsmv2conn(), d.Id())
 
 		if hsm == nil {
 			return 42, "destroyed", nil
@@ -118,7 +118,7 @@ func resourceAwsCloudHsm2HsmRefreshFunc(
 }
 
 func resourceAwsCloudHsm2HsmCreate(d *schema.ResourceData, meta interface{}) error {
-	cloudhsm2 := meta.(*AWSClient).cloudhsmv2conn
+	cloudhsm2 := meta.(*AWSClient).cloudhsmv2conn()
 
 	clusterId := d.Get("cluster_id").(string)
 
@@ -194,7 +194,7 @@ func resourceAwsCloudHsm2HsmCreate(d *schema.ResourceData, meta interface{}) err
 
 func resourceAwsCloudHsm2HsmRead(d *schema.ResourceData, meta interface{}) error {
 
-	hsm, err := describeHsm(meta.(*AWSClient).cloudhsmv2conn, d.Id())
+	hsm, err := describeHsm(meta.(*AWSClient).cloudhsmv2conn(), d.Id())
 
 	if hsm == nil {
 		log.Printf("[WARN] CloudHSMv2 HSM (%s) not found", d.Id())
@@ -216,7 +216,7 @@ func resourceAwsCloudHsm2HsmRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsCloudHsm2HsmDelete(d *schema.ResourceData, meta interface{}) error {
-	cloudhsm2 := meta.(*AWSClient).cloudhsmv2conn
+	cloudhsm2 := meta.(*AWSClient).cloudhsmv2conn()
 	clusterId := d.Get("cluster_id").(string)
 
 	log.Printf("[DEBUG] CloudHSMv2 HSM delete %s %s", clusterId, d.Id())
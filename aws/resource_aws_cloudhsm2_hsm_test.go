@@ -80,7 +80,7 @@ resource "aws_cloudhsm_v2_hsm" "hsm" {
 }
 
 func testAccCheckAWSCloudHsm2HsmDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cloudhsmv2conn
+	conn := testAccProvider.Meta().(*AWSClient).cloudhsmv2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudhsm_v2_hsm" {
@@ -103,7 +103,7 @@ func testAccCheckAWSCloudHsm2HsmDestroy(s *terraform.State) error {
 
 func testAccCheckAWSCloudHsm2HsmExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).cloudhsmv2conn
+		conn := testAccProvider.Meta().(*AWSClient).cloudhsmv2conn()
 
 		it, ok := s.RootModule().Resources[name]
 		if !ok {
@@ -137,7 +137,7 @@ func resourceAwsCloudTrail() *schema.Resource {
 }
 
 func resourceAwsCloudTrailCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudtrailconn
+	conn := meta.(*AWSClient).cloudtrailconn()
 
 	input := cloudtrail.CreateTrailInput{
 		Name:         aws.String(d.Get("name").(string)),
@@ -215,7 +215,7 @@ func resourceAwsCloudTrailCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsCloudTrailRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudtrailconn
+	conn := meta.(*AWSClient).cloudtrailconn()
 
 	input := cloudtrail.DescribeTrailsInput{
 		TrailNameList: []*string{
@@ -305,7 +305,7 @@ func resourceAwsCloudTrailRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsCloudTrailUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudtrailconn
+	conn := meta.(*AWSClient).cloudtrailconn()
 
 	input := cloudtrail.UpdateTrailInput{
 		Name: aws.String(d.Id()),
@@ -362,7 +362,7 @@ func resourceAwsCloudTrailUpdate(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		err := setTagsCloudtrail(conn, d)
 		if err != nil {
 			return err
@@ -390,7 +390,7 @@ func resourceAwsCloudTrailUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsCloudTrailDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudtrailconn
+	conn := meta.(*AWSClient).cloudtrailconn()
 
 	log.Printf("[DEBUG] Deleting CloudTrail: %q", d.Id())
 	_, err := conn.DeleteTrail(&cloudtrail.DeleteTrailInput{
@@ -448,7 +448,7 @@ func testAccCheckCloudTrailExists(n string, trail *cloudtrail.Trail) resource.Te
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cloudtrailconn
+		conn := testAccProvider.Meta().(*AWSClient).cloudtrailconn()
 		params := cloudtrail.DescribeTrailsInput{
 			TrailNameList: []*string{aws.String(rs.Primary.ID)},
 		}
@@ -472,7 +472,7 @@ func testAccCheckCloudTrailLoggingEnabled(n string, desired bool, trail *cloudtr
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cloudtrailconn
+		conn := testAccProvider.Meta().(*AWSClient).cloudtrailconn()
 		params := cloudtrail.GetTrailStatusInput{
 			Name: aws.String(rs.Primary.ID),
 		}
@@ -558,7 +558,7 @@ func testAccCheckCloudTrailKmsKeyIdEquals(n string, desired string, trail *cloud
 }
 
 func testAccCheckAWSCloudTrailDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cloudtrailconn
+	conn := testAccProvider.Meta().(*AWSClient).cloudtrailconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudtrail" {
@@ -584,7 +584,7 @@ func testAccCheckAWSCloudTrailDestroy(s *terraform.State) error {
 
 func testAccCheckCloudTrailLoadTags(trail *cloudtrail.Trail, tags *[]*cloudtrail.Tag) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).cloudtrailconn
+		conn := testAccProvider.Meta().(*AWSClient).cloudtrailconn()
 		input := cloudtrail.ListTagsInput{
 			ResourceIdList: []*string{trail.TrailARN},
 		}
@@ -53,7 +53,7 @@ func resourceAwsCloudWatchDashboard() *schema.Resource {
 func resourceAwsCloudWatchDashboardRead(d *schema.ResourceData, meta interface{}) error {
 	dashboardName := d.Get("dashboard_name").(string)
 	log.Printf("[DEBUG] Reading CloudWatch Dashboard: %s", dashboardName)
-	conn := meta.(*AWSClient).cloudwatchconn
+	conn := meta.(*AWSClient).cloudwatchconn()
 
 	params := cloudwatch.GetDashboardInput{
 		DashboardName: aws.String(d.Id()),
@@ -76,7 +76,7 @@ func resourceAwsCloudWatchDashboardRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsCloudWatchDashboardPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatchconn
+	conn := meta.(*AWSClient).cloudwatchconn()
 	params := cloudwatch.PutDashboardInput{
 		DashboardBody: aws.String(d.Get("dashboard_body").(string)),
 		DashboardName: aws.String(d.Get("dashboard_name").(string)),
@@ -96,7 +96,7 @@ func resourceAwsCloudWatchDashboardPut(d *schema.ResourceData, meta interface{})
 
 func resourceAwsCloudWatchDashboardDelete(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[INFO] Deleting CloudWatch Dashboard %s", d.Id())
-	conn := meta.(*AWSClient).cloudwatchconn
+	conn := meta.(*AWSClient).cloudwatchconn()
 	params := cloudwatch.DeleteDashboardsInput{
 		DashboardNames: []*string{aws.String(d.Id())},
 	}
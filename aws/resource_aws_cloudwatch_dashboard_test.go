@@ -89,7 +89,7 @@ func testAccCheckCloudWatchDashboardExists(n string, dashboard *cloudwatch.GetDa
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cloudwatchconn
+		conn := testAccProvider.Meta().(*AWSClient).cloudwatchconn()
 		params := cloudwatch.GetDashboardInput{
 			DashboardName: aws.String(rs.Primary.ID),
 		}
@@ -106,7 +106,7 @@ func testAccCheckCloudWatchDashboardExists(n string, dashboard *cloudwatch.GetDa
 }
 
 func testAccCheckAWSCloudWatchDashboardDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cloudwatchconn
+	conn := testAccProvider.Meta().(*AWSClient).cloudwatchconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudwatch_dashboard" {
@@ -188,7 +188,7 @@ func testAccCloudWatchCheckDashboardBodyIsExpected(resourceName, expected string
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cloudwatchconn
+		conn := testAccProvider.Meta().(*AWSClient).cloudwatchconn()
 		params := cloudwatch.GetDashboardInput{
 			DashboardName: aws.String(rs.Primary.ID),
 		}
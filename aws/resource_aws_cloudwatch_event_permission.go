@@ -73,7 +73,7 @@ func resourceAwsCloudWatchEventPermission() *schema.Resource {
 }
 
 func resourceAwsCloudWatchEventPermissionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatcheventsconn
+	conn := meta.(*AWSClient).cloudwatcheventsconn()
 
 	statementID := d.Get("statement_id").(string)
 
@@ -97,7 +97,7 @@ func resourceAwsCloudWatchEventPermissionCreate(d *schema.ResourceData, meta int
 
 // See also: https://docs.aws.amazon.com/AmazonCloudWatchEvents/latest/APIReference/API_DescribeEventBus.html
 func resourceAwsCloudWatchEventPermissionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatcheventsconn
+	conn := meta.(*AWSClient).cloudwatcheventsconn()
 	input := events.DescribeEventBusInput{}
 	var policyDoc CloudWatchEventPermissionPolicyDoc
 	var policyStatement *CloudWatchEventPermissionPolicyStatement
@@ -156,7 +156,7 @@ func resourceAwsCloudWatchEventPermissionRead(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsCloudWatchEventPermissionUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatcheventsconn
+	conn := meta.(*AWSClient).cloudwatcheventsconn()
 
 	input := events.PutPermissionInput{
 		Action:      aws.String(d.Get("action").(string)),
@@ -175,7 +175,7 @@ func resourceAwsCloudWatchEventPermissionUpdate(d *schema.ResourceData, meta int
 }
 
 func resourceAwsCloudWatchEventPermissionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatcheventsconn
+	conn := meta.(*AWSClient).cloudwatcheventsconn()
 	input := events.RemovePermissionInput{
 		StatementId: aws.String(d.Id()),
 	}
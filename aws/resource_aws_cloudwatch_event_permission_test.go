@@ -28,7 +28,7 @@ func testSweepCloudWatchEventPermissions(region string) error {
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).cloudwatcheventsconn
+	conn := client.(*AWSClient).cloudwatcheventsconn()
 
 	output, err := conn.DescribeEventBus(&events.DescribeEventBusInput{})
 	if err != nil {
@@ -250,7 +250,7 @@ func TestAccAWSCloudWatchEventPermission_Multiple(t *testing.T) {
 
 func testAccCheckCloudWatchEventPermissionExists(pr string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
+		conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn()
 		rs, ok := s.RootModule().Resources[pr]
 		if !ok {
 			return fmt.Errorf("Not found: %s", pr)
@@ -281,7 +281,7 @@ func testAccCheckCloudWatchEventPermissionExists(pr string) resource.TestCheckFu
 }
 
 func testAccCheckCloudWatchEventPermissionDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
+	conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudwatch_event_permission" {
@@ -78,7 +78,7 @@ func resourceAwsCloudWatchEventRule() *schema.Resource {
 }
 
 func resourceAwsCloudWatchEventRuleCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatcheventsconn
+	conn := meta.(*AWSClient).cloudwatcheventsconn()
 
 	var name string
 	if v, ok := d.GetOk("name"); ok {
@@ -125,7 +125,7 @@ func resourceAwsCloudWatchEventRuleCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsCloudWatchEventRuleRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatcheventsconn
+	conn := meta.(*AWSClient).cloudwatcheventsconn()
 
 	input := events.DescribeRuleInput{
 		Name: aws.String(d.Id()),
@@ -168,7 +168,7 @@ func resourceAwsCloudWatchEventRuleRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsCloudWatchEventRuleUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatcheventsconn
+	conn := meta.(*AWSClient).cloudwatcheventsconn()
 
 	if d.HasChange("is_enabled") && d.Get("is_enabled").(bool) {
 		log.Printf("[DEBUG] Enabling CloudWatch Event Rule %q", d.Id())
@@ -221,7 +221,7 @@ func resourceAwsCloudWatchEventRuleUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsCloudWatchEventRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatcheventsconn
+	conn := meta.(*AWSClient).cloudwatcheventsconn()
 
 	log.Printf("[INFO] Deleting CloudWatch Event Rule: %s", d.Id())
 	_, err := conn.DeleteRule(&events.DeleteRuleInput{
@@ -28,7 +28,7 @@ func testSweepCloudWatchEventRules(region string) error {
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).cloudwatcheventsconn
+	conn := client.(*AWSClient).cloudwatcheventsconn()
 
 	input := &events.ListRulesInput{}
 
@@ -200,7 +200,7 @@ func testAccCheckCloudWatchEventRuleExists(n string, rule *events.DescribeRuleOu
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
+		conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn()
 		params := events.DescribeRuleInput{
 			Name: aws.String(rs.Primary.ID),
 		}
@@ -225,7 +225,7 @@ func testAccCheckCloudWatchEventRuleEnabled(n string, desired string, rule *even
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
+		conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn()
 		params := events.DescribeRuleInput{
 			Name: aws.String(rs.Primary.ID),
 		}
@@ -243,7 +243,7 @@ func testAccCheckCloudWatchEventRuleEnabled(n string, desired string, rule *even
 }
 
 func testAccCheckAWSCloudWatchEventRuleDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
+	conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudwatch_event_rule" {
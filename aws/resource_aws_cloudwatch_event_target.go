@@ -223,7 +223,7 @@ func resourceAwsCloudWatchEventTarget() *schema.Resource {
 }
 
 func resourceAwsCloudWatchEventTargetCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatcheventsconn
+	conn := meta.(*AWSClient).cloudwatcheventsconn()
 
 	rule := d.Get("rule").(string)
 
@@ -257,7 +257,7 @@ func resourceAwsCloudWatchEventTargetCreate(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsCloudWatchEventTargetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatcheventsconn
+	conn := meta.(*AWSClient).cloudwatcheventsconn()
 
 	t, err := findEventTargetById(
 		d.Get("target_id").(string),
@@ -360,7 +360,7 @@ func findEventTargetById(id, rule string, nextToken *string, conn *events.CloudW
 }
 
 func resourceAwsCloudWatchEventTargetUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatcheventsconn
+	conn := meta.(*AWSClient).cloudwatcheventsconn()
 
 	input := buildPutTargetInputStruct(d)
 
@@ -374,7 +374,7 @@ func resourceAwsCloudWatchEventTargetUpdate(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsCloudWatchEventTargetDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatcheventsconn
+	conn := meta.(*AWSClient).cloudwatcheventsconn()
 
 	input := events.RemoveTargetsInput{
 		Ids:  []*string{aws.String(d.Get("target_id").(string))},
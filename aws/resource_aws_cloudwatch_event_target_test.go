@@ -25,7 +25,7 @@ func testSweepCloudWatchEventTargets(region string) error {
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).cloudwatcheventsconn
+	conn := client.(*AWSClient).cloudwatcheventsconn()
 
 	input := &events.ListRulesInput{}
 
@@ -300,7 +300,7 @@ func testAccCheckCloudWatchEventTargetExists(n string, rule *events.Target) reso
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
+		conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn()
 		t, err := findEventTargetById(rs.Primary.Attributes["target_id"],
 			rs.Primary.Attributes["rule"], nil, conn)
 		if err != nil {
@@ -314,7 +314,7 @@ func testAccCheckCloudWatchEventTargetExists(n string, rule *events.Target) reso
 }
 
 func testAccCheckAWSCloudWatchEventTargetDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
+	conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudwatch_event_target" {
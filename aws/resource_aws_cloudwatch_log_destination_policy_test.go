@@ -54,7 +54,7 @@ func TestAccAWSCloudwatchLogDestinationPolicy_basic(t *testing.T) {
 }
 
 func testAccCheckAWSCloudwatchLogDestinationPolicyDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cloudwatchlogsconn
+	conn := testAccProvider.Meta().(*AWSClient).cloudwatchlogsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudwatch_log_destination_policy" {
@@ -81,7 +81,7 @@ func testAccCheckAWSCloudwatchLogDestinationPolicyExists(n string, d *cloudwatch
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cloudwatchlogsconn
+		conn := testAccProvider.Meta().(*AWSClient).cloudwatchlogsconn()
 		destination, exists, err := lookupCloudWatchLogDestination(conn, rs.Primary.ID, nil)
 		if err != nil {
 			return err
@@ -54,7 +54,7 @@ func TestAccAWSCloudwatchLogDestination_basic(t *testing.T) {
 }
 
 func testAccCheckAWSCloudwatchLogDestinationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cloudwatchlogsconn
+	conn := testAccProvider.Meta().(*AWSClient).cloudwatchlogsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudwatch_log_destination" {
@@ -81,7 +81,7 @@ func testAccCheckAWSCloudwatchLogDestinationExists(n string, d *cloudwatchlogs.D
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cloudwatchlogsconn
+		conn := testAccProvider.Meta().(*AWSClient).cloudwatchlogsconn()
 		destination, exists, err := lookupCloudWatchLogDestination(conn, rs.Primary.ID, nil)
 		if err != nil {
 			return err
@@ -60,7 +60,7 @@ func resourceAwsCloudWatchLogGroup() *schema.Resource {
 }
 
 func resourceAwsCloudWatchLogGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatchlogsconn
+	conn := meta.(*AWSClient).cloudwatchlogsconn()
 
 	var logGroupName string
 	if v, ok := d.GetOk("name"); ok {
@@ -97,7 +97,7 @@ func resourceAwsCloudWatchLogGroupCreate(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsCloudWatchLogGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatchlogsconn
+	conn := meta.(*AWSClient).cloudwatchlogsconn()
 	log.Printf("[DEBUG] Reading CloudWatch Log Group: %q", d.Get("name").(string))
 	lg, err := lookupCloudWatchLogGroup(conn, d.Id())
 	if err != nil {
@@ -154,7 +154,7 @@ func lookupCloudWatchLogGroup(conn *cloudwatchlogs.CloudWatchLogs, name string)
 }
 
 func resourceAwsCloudWatchLogGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatchlogsconn
+	conn := meta.(*AWSClient).cloudwatchlogsconn()
 
 	name := d.Id()
 	log.Printf("[DEBUG] Updating CloudWatch Log Group: %q", name)
@@ -181,8 +181,8 @@ func resourceAwsCloudWatchLogGroupUpdate(d *schema.ResourceData, meta interface{
 		}
 	}
 
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if hasTagsChange(d) {
+		oraw, nraw := getTagsChange(d)
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := diffCloudWatchTags(o, n)
@@ -252,7 +252,7 @@ func diffCloudWatchTags(oldTags map[string]interface{}, newTags map[string]inter
 }
 
 func resourceAwsCloudWatchLogGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatchlogsconn
+	conn := meta.(*AWSClient).cloudwatchlogsconn()
 	log.Printf("[INFO] Deleting CloudWatch Log Group: %s", d.Id())
 	_, err := conn.DeleteLogGroup(&cloudwatchlogs.DeleteLogGroupInput{
 		LogGroupName: aws.String(d.Get("name").(string)),
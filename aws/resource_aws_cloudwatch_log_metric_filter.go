@@ -83,7 +83,7 @@ func resourceAwsCloudWatchLogMetricFilter() *schema.Resource {
 }
 
 func resourceAwsCloudWatchLogMetricFilterUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatchlogsconn
+	conn := meta.(*AWSClient).cloudwatchlogsconn()
 
 	input := cloudwatchlogs.PutMetricFilterInput{
 		FilterName:    aws.String(d.Get("name").(string)),
@@ -115,7 +115,7 @@ func resourceAwsCloudWatchLogMetricFilterUpdate(d *schema.ResourceData, meta int
 }
 
 func resourceAwsCloudWatchLogMetricFilterRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatchlogsconn
+	conn := meta.(*AWSClient).cloudwatchlogsconn()
 
 	mf, err := lookupCloudWatchLogMetricFilter(conn, d.Get("name").(string),
 		d.Get("log_group_name").(string), nil)
@@ -180,7 +180,7 @@ func lookupCloudWatchLogMetricFilter(conn *cloudwatchlogs.CloudWatchLogs,
 }
 
 func resourceAwsCloudWatchLogMetricFilterDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatchlogsconn
+	conn := meta.(*AWSClient).cloudwatchlogsconn()
 
 	input := cloudwatchlogs.DeleteMetricFilterInput{
 		FilterName:   aws.String(d.Get("name").(string)),
@@ -39,7 +39,7 @@ func resourceAwsCloudWatchLogStream() *schema.Resource {
 }
 
 func resourceAwsCloudWatchLogStreamCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatchlogsconn
+	conn := meta.(*AWSClient).cloudwatchlogsconn()
 
 	log.Printf("[DEBUG] Creating CloudWatch Log Stream: %s", d.Get("name").(string))
 	_, err := conn.CreateLogStream(&cloudwatchlogs.CreateLogStreamInput{
@@ -56,7 +56,7 @@ func resourceAwsCloudWatchLogStreamCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsCloudWatchLogStreamRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatchlogsconn
+	conn := meta.(*AWSClient).cloudwatchlogsconn()
 
 	group := d.Get("log_group_name").(string)
 
@@ -83,7 +83,7 @@ func resourceAwsCloudWatchLogStreamRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsCloudWatchLogStreamDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatchlogsconn
+	conn := meta.(*AWSClient).cloudwatchlogsconn()
 
 	log.Printf("[INFO] Deleting CloudWatch Log Stream: %s", d.Id())
 	params := &cloudwatchlogs.DeleteLogStreamInput{
@@ -88,7 +88,7 @@ func TestAccAWSCloudwatchLogSubscriptionFilter_disappears_LogGroup(t *testing.T)
 
 func testAccCheckCloudwatchLogSubscriptionFilterDisappears(rName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).cloudwatchlogsconn
+		conn := testAccProvider.Meta().(*AWSClient).cloudwatchlogsconn()
 		params := &cloudwatchlogs.DeleteLogGroupInput{
 			LogGroupName: aws.String(fmt.Sprintf("example_lambda_name_%s", rName)),
 		}
@@ -98,7 +98,7 @@ func testAccCheckCloudwatchLogSubscriptionFilterDisappears(rName string) resourc
 }
 
 func testAccCheckCloudwatchLogSubscriptionFilterDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cloudwatchlogsconn
+	conn := testAccProvider.Meta().(*AWSClient).cloudwatchlogsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudwatch_log_subscription_filter" {
@@ -136,7 +136,7 @@ func testAccCheckAwsCloudwatchLogSubscriptionFilterExists(n string, filter *clou
 			return fmt.Errorf("SubscriptionFilter ID not set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cloudwatchlogsconn
+		conn := testAccProvider.Meta().(*AWSClient).cloudwatchlogsconn()
 
 		logGroupName := rs.Primary.Attributes["log_group_name"]
 		filterNamePrefix := rs.Primary.Attributes["name"]
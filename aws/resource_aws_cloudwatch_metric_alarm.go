@@ -220,7 +220,7 @@ func validateResourceAwsCloudWatchMetricAlarm(d *schema.ResourceData) error {
 }
 
 func resourceAwsCloudWatchMetricAlarmCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatchconn
+	conn := meta.(*AWSClient).cloudwatchconn()
 
 	err := validateResourceAwsCloudWatchMetricAlarm(d)
 	if err != nil {
@@ -314,7 +314,7 @@ func resourceAwsCloudWatchMetricAlarmRead(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsCloudWatchMetricAlarmUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatchconn
+	conn := meta.(*AWSClient).cloudwatchconn()
 	params := getAwsCloudWatchPutMetricAlarmInput(d)
 
 	log.Printf("[DEBUG] Updating CloudWatch Metric Alarm: %#v", params)
@@ -339,7 +339,7 @@ func resourceAwsCloudWatchMetricAlarmDelete(d *schema.ResourceData, meta interfa
 
 	log.Printf("[INFO] Deleting CloudWatch Metric Alarm: %s", d.Id())
 
-	conn := meta.(*AWSClient).cloudwatchconn
+	conn := meta.(*AWSClient).cloudwatchconn()
 	params := cloudwatch.DeleteAlarmsInput{
 		AlarmNames: []*string{aws.String(d.Id())},
 	}
@@ -493,7 +493,7 @@ func getAwsCloudWatchPutMetricAlarmInput(d *schema.ResourceData) cloudwatch.PutM
 }
 
 func getAwsCloudWatchMetricAlarm(d *schema.ResourceData, meta interface{}) (*cloudwatch.MetricAlarm, error) {
-	conn := meta.(*AWSClient).cloudwatchconn
+	conn := meta.(*AWSClient).cloudwatchconn()
 
 	params := cloudwatch.DescribeAlarmsInput{
 		AlarmNames: []*string{aws.String(d.Id())},
@@ -312,7 +312,7 @@ func testAccCheckCloudWatchMetricAlarmExists(n string, alarm *cloudwatch.MetricA
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cloudwatchconn
+		conn := testAccProvider.Meta().(*AWSClient).cloudwatchconn()
 		params := cloudwatch.DescribeAlarmsInput{
 			AlarmNames: []*string{aws.String(rs.Primary.ID)},
 		}
@@ -330,7 +330,7 @@ func testAccCheckCloudWatchMetricAlarmExists(n string, alarm *cloudwatch.MetricA
 }
 
 func testAccCheckAWSCloudWatchMetricAlarmDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cloudwatchconn
+	conn := testAccProvider.Meta().(*AWSClient).cloudwatchconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudwatch_metric_alarm" {
@@ -449,7 +449,7 @@ func resourceAwsCodeBuildProject() *schema.Resource {
 }
 
 func resourceAwsCodeBuildProjectCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).codebuildconn
+	conn := meta.(*AWSClient).codebuildconn()
 
 	projectEnv := expandProjectEnvironment(d)
 	projectSource := expandProjectSource(d)
@@ -755,7 +755,7 @@ func expandProjectSourceData(data map[string]interface{}) codebuild.ProjectSourc
 }
 
 func resourceAwsCodeBuildProjectRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).codebuildconn
+	conn := meta.(*AWSClient).codebuildconn()
 
 	resp, err := conn.BatchGetProjects(&codebuild.BatchGetProjectsInput{
 		Names: []*string{
@@ -826,7 +826,7 @@ func resourceAwsCodeBuildProjectRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsCodeBuildProjectUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).codebuildconn
+	conn := meta.(*AWSClient).codebuildconn()
 
 	params := &codebuild.UpdateProjectInput{
 		Name: aws.String(d.Get("name").(string)),
@@ -924,7 +924,7 @@ func resourceAwsCodeBuildProjectUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsCodeBuildProjectDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).codebuildconn
+	conn := meta.(*AWSClient).codebuildconn()
 
 	_, err := conn.DeleteProject(&codebuild.DeleteProjectInput{
 		Name: aws.String(d.Id()),
@@ -849,7 +849,7 @@ func testAccCheckAWSCodeBuildProjectExists(n string, project *codebuild.Project)
 			return fmt.Errorf("No CodeBuild Project ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).codebuildconn
+		conn := testAccProvider.Meta().(*AWSClient).codebuildconn()
 
 		out, err := conn.BatchGetProjects(&codebuild.BatchGetProjectsInput{
 			Names: []*string{
@@ -872,7 +872,7 @@ func testAccCheckAWSCodeBuildProjectExists(n string, project *codebuild.Project)
 }
 
 func testAccCheckAWSCodeBuildProjectDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).codebuildconn
+	conn := testAccProvider.Meta().(*AWSClient).codebuildconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_codebuild_project" {
@@ -909,7 +909,7 @@ func testAccCheckAWSCodeBuildProjectCertificate(project *codebuild.Project, expe
 }
 
 func testAccPreCheckAWSCodeBuild(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).codebuildconn
+	conn := testAccProvider.Meta().(*AWSClient).codebuildconn()
 
 	input := &codebuild.BatchGetProjectsInput{
 		Names: []*string{aws.String("tf-acc-test-precheck")},
@@ -48,7 +48,7 @@ func resourceAwsCodeBuildWebhook() *schema.Resource {
 }
 
 func resourceAwsCodeBuildWebhookCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).codebuildconn
+	conn := meta.(*AWSClient).codebuildconn()
 
 	input := &codebuild.CreateWebhookInput{
 		ProjectName: aws.String(d.Get("project_name").(string)),
@@ -74,7 +74,7 @@ func resourceAwsCodeBuildWebhookCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsCodeBuildWebhookRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).codebuildconn
+	conn := meta.(*AWSClient).codebuildconn()
 
 	resp, err := conn.BatchGetProjects(&codebuild.BatchGetProjectsInput{
 		Names: []*string{
@@ -110,7 +110,7 @@ func resourceAwsCodeBuildWebhookRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsCodeBuildWebhookUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).codebuildconn
+	conn := meta.(*AWSClient).codebuildconn()
 
 	_, err := conn.UpdateWebhook(&codebuild.UpdateWebhookInput{
 		ProjectName:  aws.String(d.Id()),
@@ -126,7 +126,7 @@ func resourceAwsCodeBuildWebhookUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsCodeBuildWebhookDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).codebuildconn
+	conn := meta.(*AWSClient).codebuildconn()
 
 	_, err := conn.DeleteWebhook(&codebuild.DeleteWebhookInput{
 		ProjectName: aws.String(d.Id()),
@@ -157,7 +157,7 @@ func TestAccAWSCodeBuildWebhook_BranchFilter(t *testing.T) {
 }
 
 func testAccCheckAWSCodeBuildWebhookDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).codebuildconn
+	conn := testAccProvider.Meta().(*AWSClient).codebuildconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_codebuild_webhook" {
@@ -193,7 +193,7 @@ func testAccCheckAWSCodeBuildWebhookExists(name string, webhook *codebuild.Webho
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).codebuildconn
+		conn := testAccProvider.Meta().(*AWSClient).codebuildconn()
 
 		resp, err := conn.BatchGetProjects(&codebuild.BatchGetProjectsInput{
 			Names: []*string{
@@ -134,7 +134,7 @@ func testAccCheckCodeCommitRepositoryExists(name string) resource.TestCheckFunc
 			return fmt.Errorf("No ID is set")
 		}
 
-		codecommitconn := testAccProvider.Meta().(*AWSClient).codecommitconn
+		codecommitconn := testAccProvider.Meta().(*AWSClient).codecommitconn()
 		out, err := codecommitconn.GetRepository(&codecommit.GetRepositoryInput{
 			RepositoryName: aws.String(rs.Primary.ID),
 		})
@@ -157,7 +157,7 @@ func testAccCheckCodeCommitRepositoryExists(name string) resource.TestCheckFunc
 }
 
 func testAccCheckCodeCommitRepositoryDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).codecommitconn
+	conn := testAccProvider.Meta().(*AWSClient).codecommitconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_codecommit_repository" {
@@ -30,7 +30,7 @@ func TestAccAWSCodeCommitTrigger_basic(t *testing.T) {
 }
 
 func testAccCheckCodeCommitTriggerDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).codecommitconn
+	conn := testAccProvider.Meta().(*AWSClient).codecommitconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_codecommit_trigger" {
@@ -64,7 +64,7 @@ func testAccCheckCodeCommitTriggerExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("No ID is set")
 		}
 
-		codecommitconn := testAccProvider.Meta().(*AWSClient).codecommitconn
+		codecommitconn := testAccProvider.Meta().(*AWSClient).codecommitconn()
 		out, err := codecommitconn.GetRepositoryTriggers(&codecommit.GetRepositoryTriggersInput{
 			RepositoryName: aws.String(rs.Primary.ID),
 		})
@@ -28,7 +28,7 @@ func resourceAwsCodeDeployApp() *schema.Resource {
 				}
 
 				applicationName := d.Id()
-				conn := meta.(*AWSClient).codedeployconn
+				conn := meta.(*AWSClient).codedeployconn()
 
 				input := &codedeploy.GetApplicationInput{
 					ApplicationName: aws.String(applicationName),
@@ -82,7 +82,7 @@ func resourceAwsCodeDeployApp() *schema.Resource {
 }
 
 func resourceAwsCodeDeployAppCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).codedeployconn
+	conn := meta.(*AWSClient).codedeployconn()
 
 	application := d.Get("name").(string)
 	computePlatform := d.Get("compute_platform").(string)
@@ -108,7 +108,7 @@ func resourceAwsCodeDeployAppCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsCodeDeployAppRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).codedeployconn
+	conn := meta.(*AWSClient).codedeployconn()
 
 	application := resourceAwsCodeDeployAppParseId(d.Id())
 	log.Printf("[DEBUG] Reading CodeDeploy application %s", application)
@@ -132,7 +132,7 @@ func resourceAwsCodeDeployAppRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsCodeDeployUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).codedeployconn
+	conn := meta.(*AWSClient).codedeployconn()
 
 	o, n := d.GetChange("name")
 
@@ -151,7 +151,7 @@ func resourceAwsCodeDeployUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsCodeDeployAppDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).codedeployconn
+	conn := meta.(*AWSClient).codedeployconn()
 
 	_, err := conn.DeleteApplication(&codedeploy.DeleteApplicationInput{
 		ApplicationName: aws.String(d.Get("name").(string)),
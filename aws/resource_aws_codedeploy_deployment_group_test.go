@@ -2174,7 +2174,7 @@ func testAccCheckTriggerTargetArn(group *codedeploy.DeploymentGroupInfo, trigger
 }
 
 func testAccCheckAWSCodeDeployDeploymentGroupDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).codedeployconn
+	conn := testAccProvider.Meta().(*AWSClient).codedeployconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_codedeploy_deployment_group" {
@@ -2204,7 +2204,7 @@ func testAccCheckAWSCodeDeployDeploymentGroupDestroy(s *terraform.State) error {
 
 func testAccAWSCodeDeployDeploymentGroupDisappears(group *codedeploy.DeploymentGroupInfo) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).codedeployconn
+		conn := testAccProvider.Meta().(*AWSClient).codedeployconn()
 		opts := &codedeploy.DeleteDeploymentGroupInput{
 			ApplicationName:     group.ApplicationName,
 			DeploymentGroupName: group.DeploymentGroupName,
@@ -2239,7 +2239,7 @@ func testAccCheckAWSCodeDeployDeploymentGroupExists(name string, group *codedepl
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).codedeployconn
+		conn := testAccProvider.Meta().(*AWSClient).codedeployconn()
 
 		resp, err := conn.GetDeploymentGroup(&codedeploy.GetDeploymentGroupInput{
 			ApplicationName:     aws.String(rs.Primary.Attributes["app_name"]),
@@ -166,7 +166,7 @@ func resourceAwsCodePipeline() *schema.Resource {
 }
 
 func resourceAwsCodePipelineCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).codepipelineconn
+	conn := meta.(*AWSClient).codepipelineconn()
 	params := &codepipeline.CreatePipelineInput{
 		Pipeline: expandAwsCodePipeline(d),
 	}
@@ -419,7 +419,7 @@ func flattenAwsCodePipelineActionsInputArtifacts(artifacts []*codepipeline.Input
 }
 
 func resourceAwsCodePipelineRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).codepipelineconn
+	conn := meta.(*AWSClient).codepipelineconn()
 	resp, err := conn.GetPipeline(&codepipeline.GetPipelineInput{
 		Name: aws.String(d.Id()),
 	})
@@ -451,7 +451,7 @@ func resourceAwsCodePipelineRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsCodePipelineUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).codepipelineconn
+	conn := meta.(*AWSClient).codepipelineconn()
 
 	pipeline := expandAwsCodePipeline(d)
 	params := &codepipeline.UpdatePipelineInput{
@@ -469,7 +469,7 @@ func resourceAwsCodePipelineUpdate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsCodePipelineDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).codepipelineconn
+	conn := meta.(*AWSClient).codepipelineconn()
 
 	_, err := conn.DeletePipeline(&codepipeline.DeletePipelineInput{
 		Name: aws.String(d.Id()),
@@ -126,7 +126,7 @@ func extractCodePipelineWebhookAuthConfig(authType string, authConfig map[string
 }
 
 func resourceAwsCodePipelineWebhookCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).codepipelineconn
+	conn := meta.(*AWSClient).codepipelineconn()
 	authType := d.Get("authentication").(string)
 
 	var authConfig map[string]interface{}
@@ -222,7 +222,7 @@ func flattenCodePipelineWebhookAuthenticationConfiguration(authConfig *codepipel
 }
 
 func resourceAwsCodePipelineWebhookRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).codepipelineconn
+	conn := meta.(*AWSClient).codepipelineconn()
 
 	arn := d.Id()
 	webhook, err := getCodePipelineWebhook(conn, arn)
@@ -269,7 +269,7 @@ func resourceAwsCodePipelineWebhookRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsCodePipelineWebhookDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).codepipelineconn
+	conn := meta.(*AWSClient).codepipelineconn()
 	name := d.Get("name").(string)
 
 	input := codepipeline.DeleteWebhookInput{
@@ -118,7 +118,7 @@ func testAccCheckAWSCodePipelineWebhookExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No webhook ARN is set as ID")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).codepipelineconn
+		conn := testAccProvider.Meta().(*AWSClient).codepipelineconn()
 
 		_, err := getCodePipelineWebhook(conn, rs.Primary.ID)
 		return err
@@ -104,7 +104,7 @@ func resourceAwsCognitoIdentityPool() *schema.Resource {
 }
 
 func resourceAwsCognitoIdentityPoolCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cognitoconn
+	conn := meta.(*AWSClient).cognitoconn()
 	log.Print("[DEBUG] Creating Cognito Identity Pool")
 
 	params := &cognitoidentity.CreateIdentityPoolInput{
@@ -143,7 +143,7 @@ func resourceAwsCognitoIdentityPoolCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsCognitoIdentityPoolRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cognitoconn
+	conn := meta.(*AWSClient).cognitoconn()
 	log.Printf("[DEBUG] Reading Cognito Identity Pool: %s", d.Id())
 
 	ip, err := conn.DescribeIdentityPool(&cognitoidentity.DescribeIdentityPoolInput{
@@ -189,7 +189,7 @@ func resourceAwsCognitoIdentityPoolRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsCognitoIdentityPoolUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cognitoconn
+	conn := meta.(*AWSClient).cognitoconn()
 	log.Print("[DEBUG] Updating Cognito Identity Pool")
 
 	params := &cognitoidentity.IdentityPool{
@@ -227,7 +227,7 @@ func resourceAwsCognitoIdentityPoolUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsCognitoIdentityPoolDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cognitoconn
+	conn := meta.(*AWSClient).cognitoconn()
 	log.Printf("[DEBUG] Deleting Cognito Identity Pool: %s", d.Id())
 
 	return resource.Retry(5*time.Minute, func() *resource.RetryError {
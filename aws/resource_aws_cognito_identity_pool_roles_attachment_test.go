@@ -150,7 +150,7 @@ func testAccCheckAWSCognitoIdentityPoolRolesAttachmentExists(n string) resource.
 			return errors.New("No Cognito Identity Pool Roles Attachment ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cognitoconn
+		conn := testAccProvider.Meta().(*AWSClient).cognitoconn()
 
 		_, err := conn.GetIdentityPoolRoles(&cognitoidentity.GetIdentityPoolRolesInput{
 			IdentityPoolId: aws.String(rs.Primary.Attributes["identity_pool_id"]),
@@ -161,7 +161,7 @@ func testAccCheckAWSCognitoIdentityPoolRolesAttachmentExists(n string) resource.
 }
 
 func testAccCheckAWSCognitoIdentityPoolRolesAttachmentDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cognitoconn
+	conn := testAccProvider.Meta().(*AWSClient).cognitoconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cognito_identity_pool_roles_attachment" {
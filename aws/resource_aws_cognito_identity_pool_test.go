@@ -226,7 +226,7 @@ func testAccCheckAWSCognitoIdentityPoolExists(n string) resource.TestCheckFunc {
 			return errors.New("No Cognito Identity Pool ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cognitoconn
+		conn := testAccProvider.Meta().(*AWSClient).cognitoconn()
 
 		_, err := conn.DescribeIdentityPool(&cognitoidentity.DescribeIdentityPoolInput{
 			IdentityPoolId: aws.String(rs.Primary.ID),
@@ -237,7 +237,7 @@ func testAccCheckAWSCognitoIdentityPoolExists(n string) resource.TestCheckFunc {
 }
 
 func testAccCheckAWSCognitoIdentityPoolDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cognitoconn
+	conn := testAccProvider.Meta().(*AWSClient).cognitoconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cognito_identity_pool" {
@@ -60,7 +60,7 @@ func resourceAwsCognitoIdentityProvider() *schema.Resource {
 }
 
 func resourceAwsCognitoIdentityProviderCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cognitoidpconn
+	conn := meta.(*AWSClient).cognitoidpconn()
 	log.Print("[DEBUG] Creating Cognito Identity Provider")
 
 	providerName := d.Get("provider_name").(string)
@@ -94,7 +94,7 @@ func resourceAwsCognitoIdentityProviderCreate(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsCognitoIdentityProviderRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cognitoidpconn
+	conn := meta.(*AWSClient).cognitoidpconn()
 	log.Printf("[DEBUG] Reading Cognito Identity Provider: %s", d.Id())
 
 	userPoolID, providerName, err := decodeCognitoIdentityProviderID(d.Id())
@@ -143,7 +143,7 @@ func resourceAwsCognitoIdentityProviderRead(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsCognitoIdentityProviderUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cognitoidpconn
+	conn := meta.(*AWSClient).cognitoidpconn()
 	log.Print("[DEBUG] Updating Cognito Identity Provider")
 
 	userPoolID, providerName, err := decodeCognitoIdentityProviderID(d.Id())
@@ -177,7 +177,7 @@ func resourceAwsCognitoIdentityProviderUpdate(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsCognitoIdentityProviderDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cognitoidpconn
+	conn := meta.(*AWSClient).cognitoidpconn()
 	log.Printf("[DEBUG] Deleting Cognito Identity Provider: %s", d.Id())
 
 	userPoolID, providerName, err := decodeCognitoIdentityProviderID(d.Id())
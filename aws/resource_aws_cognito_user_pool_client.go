@@ -153,7 +153,7 @@ func resourceAwsCognitoUserPoolClient() *schema.Resource {
 }
 
 func resourceAwsCognitoUserPoolClientCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cognitoidpconn
+	conn := meta.(*AWSClient).cognitoidpconn()
 
 	params := &cognitoidentityprovider.CreateUserPoolClientInput{
 		ClientName: aws.String(d.Get("name").(string)),
@@ -222,7 +222,7 @@ func resourceAwsCognitoUserPoolClientCreate(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsCognitoUserPoolClientRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cognitoidpconn
+	conn := meta.(*AWSClient).cognitoidpconn()
 
 	params := &cognitoidentityprovider.DescribeUserPoolClientInput{
 		ClientId:   aws.String(d.Id()),
@@ -262,7 +262,7 @@ func resourceAwsCognitoUserPoolClientRead(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsCognitoUserPoolClientUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cognitoidpconn
+	conn := meta.(*AWSClient).cognitoidpconn()
 
 	params := &cognitoidentityprovider.UpdateUserPoolClientInput{
 		ClientId:   aws.String(d.Id()),
@@ -324,7 +324,7 @@ func resourceAwsCognitoUserPoolClientUpdate(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsCognitoUserPoolClientDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cognitoidpconn
+	conn := meta.(*AWSClient).cognitoidpconn()
 
 	params := &cognitoidentityprovider.DeleteUserPoolClientInput{
 		ClientId:   aws.String(d.Id()),
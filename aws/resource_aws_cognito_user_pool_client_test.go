@@ -52,7 +52,7 @@ func TestAccAWSCognitoUserPoolClient_importBasic(t *testing.T) {
 			return "", errors.New("No Cognito User Pool Client ID set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+		conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn()
 		userPoolId := rs.Primary.Attributes["user_pool_id"]
 		clientId := rs.Primary.ID
 
@@ -210,7 +210,7 @@ func TestAccAWSCognitoUserPoolClient_allFieldsUpdatingOneField(t *testing.T) {
 }
 
 func testAccCheckAWSCognitoUserPoolClientDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+	conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cognito_user_pool_client" {
@@ -246,7 +246,7 @@ func testAccCheckAWSCognitoUserPoolClientExists(name string) resource.TestCheckF
 			return errors.New("No Cognito User Pool Client ID set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+		conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn()
 
 		params := &cognitoidentityprovider.DescribeUserPoolClientInput{
 			ClientId:   aws.String(rs.Primary.ID),
@@ -60,7 +60,7 @@ func resourceAwsCognitoUserPoolDomain() *schema.Resource {
 }
 
 func resourceAwsCognitoUserPoolDomainCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cognitoidpconn
+	conn := meta.(*AWSClient).cognitoidpconn()
 
 	domain := d.Get("domain").(string)
 
@@ -120,7 +120,7 @@ func resourceAwsCognitoUserPoolDomainCreate(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsCognitoUserPoolDomainRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cognitoidpconn
+	conn := meta.(*AWSClient).cognitoidpconn()
 	log.Printf("[DEBUG] Reading Cognito User Pool Domain: %s", d.Id())
 
 	domain, err := conn.DescribeUserPoolDomain(&cognitoidentityprovider.DescribeUserPoolDomainInput{
@@ -152,7 +152,7 @@ func resourceAwsCognitoUserPoolDomainRead(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsCognitoUserPoolDomainDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cognitoidpconn
+	conn := meta.(*AWSClient).cognitoidpconn()
 	log.Printf("[DEBUG] Deleting Cognito User Pool Domain: %s", d.Id())
 
 	_, err := conn.DeleteUserPoolDomain(&cognitoidentityprovider.DeleteUserPoolDomainInput{
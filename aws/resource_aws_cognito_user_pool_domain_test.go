@@ -102,7 +102,7 @@ func testAccCheckAWSCognitoUserPoolDomainExists(n string) resource.TestCheckFunc
 			return errors.New("No Cognito User Pool Domain ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+		conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn()
 
 		_, err := conn.DescribeUserPoolDomain(&cognitoidentityprovider.DescribeUserPoolDomainInput{
 			Domain: aws.String(rs.Primary.ID),
@@ -113,7 +113,7 @@ func testAccCheckAWSCognitoUserPoolDomainExists(n string) resource.TestCheckFunc
 }
 
 func testAccCheckAWSCognitoUserPoolDomainDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+	conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cognito_user_pool_domain" {
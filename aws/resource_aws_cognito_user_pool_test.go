@@ -27,7 +27,7 @@ func testSweepCognitoUserPools(region string) error {
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).cognitoidpconn
+	conn := client.(*AWSClient).cognitoidpconn()
 
 	input := &cognitoidentityprovider.ListUserPoolsInput{
 		MaxResults: aws.Int64(int64(50)),
@@ -672,7 +672,7 @@ func TestAccAWSCognitoUserPool_update(t *testing.T) {
 }
 
 func testAccCheckAWSCognitoUserPoolDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+	conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cognito_user_pool" {
@@ -707,7 +707,7 @@ func testAccCheckAWSCognitoUserPoolExists(name string) resource.TestCheckFunc {
 			return errors.New("No Cognito User Pool ID set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+		conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn()
 
 		params := &cognitoidentityprovider.DescribeUserPoolInput{
 			UserPoolId: aws.String(rs.Primary.ID),
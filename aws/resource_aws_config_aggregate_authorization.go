@@ -42,7 +42,7 @@ func resourceAwsConfigAggregateAuthorization() *schema.Resource {
 }
 
 func resourceAwsConfigAggregateAuthorizationPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).configconn
+	conn := meta.(*AWSClient).configconn()
 
 	accountId := d.Get("account_id").(string)
 	region := d.Get("region").(string)
@@ -62,7 +62,7 @@ func resourceAwsConfigAggregateAuthorizationPut(d *schema.ResourceData, meta int
 }
 
 func resourceAwsConfigAggregateAuthorizationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).configconn
+	conn := meta.(*AWSClient).configconn()
 
 	accountId, region, err := resourceAwsConfigAggregateAuthorizationParseID(d.Id())
 	if err != nil {
@@ -91,7 +91,7 @@ func resourceAwsConfigAggregateAuthorizationRead(d *schema.ResourceData, meta in
 }
 
 func resourceAwsConfigAggregateAuthorizationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).configconn
+	conn := meta.(*AWSClient).configconn()
 
 	accountId, region, err := resourceAwsConfigAggregateAuthorizationParseID(d.Id())
 	if err != nil {
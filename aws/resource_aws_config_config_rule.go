@@ -141,7 +141,7 @@ func resourceAwsConfigConfigRule() *schema.Resource {
 }
 
 func resourceAwsConfigConfigRulePut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).configconn
+	conn := meta.(*AWSClient).configconn()
 
 	name := d.Get("name").(string)
 	ruleInput := configservice.ConfigRule{
@@ -191,7 +191,7 @@ func resourceAwsConfigConfigRulePut(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsConfigConfigRuleRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).configconn
+	conn := meta.(*AWSClient).configconn()
 
 	out, err := conn.DescribeConfigRules(&configservice.DescribeConfigRulesInput{
 		ConfigRuleNames: []*string{aws.String(d.Id())},
@@ -237,7 +237,7 @@ func resourceAwsConfigConfigRuleRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsConfigConfigRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).configconn
+	conn := meta.(*AWSClient).configconn()
 
 	name := d.Get("name").(string)
 
@@ -263,7 +263,7 @@ func testAccCheckConfigConfigRuleExists(n string, obj *configservice.ConfigRule)
 			return fmt.Errorf("No config rule ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).configconn
+		conn := testAccProvider.Meta().(*AWSClient).configconn()
 		out, err := conn.DescribeConfigRules(&configservice.DescribeConfigRulesInput{
 			ConfigRuleNames: []*string{aws.String(rs.Primary.Attributes["name"])},
 		})
@@ -282,7 +282,7 @@ func testAccCheckConfigConfigRuleExists(n string, obj *configservice.ConfigRule)
 }
 
 func testAccCheckConfigConfigRuleDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).configconn
+	conn := testAccProvider.Meta().(*AWSClient).configconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_config_config_rule" {
@@ -25,7 +25,7 @@ func testSweepConfigConfigurationAggregators(region string) error {
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).configconn
+	conn := client.(*AWSClient).configconn()
 
 	resp, err := conn.DescribeConfigurationAggregators(&configservice.DescribeConfigurationAggregatorsInput{})
 	if err != nil {
@@ -169,7 +169,7 @@ func testAccCheckAWSConfigConfigurationAggregatorExists(n string, obj *configser
 			return fmt.Errorf("No config configuration aggregator ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).configconn
+		conn := testAccProvider.Meta().(*AWSClient).configconn()
 		out, err := conn.DescribeConfigurationAggregators(&configservice.DescribeConfigurationAggregatorsInput{
 			ConfigurationAggregatorNames: []*string{aws.String(rs.Primary.Attributes["name"])},
 		})
@@ -188,7 +188,7 @@ func testAccCheckAWSConfigConfigurationAggregatorExists(n string, obj *configser
 }
 
 func testAccCheckAWSConfigConfigurationAggregatorDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).configconn
+	conn := testAccProvider.Meta().(*AWSClient).configconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_config_configuration_aggregator" {
@@ -66,7 +66,7 @@ func resourceAwsConfigConfigurationRecorder() *schema.Resource {
 }
 
 func resourceAwsConfigConfigurationRecorderPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).configconn
+	conn := meta.(*AWSClient).configconn()
 
 	name := d.Get("name").(string)
 	recorder := configservice.ConfigurationRecorder{
@@ -92,7 +92,7 @@ func resourceAwsConfigConfigurationRecorderPut(d *schema.ResourceData, meta inte
 }
 
 func resourceAwsConfigConfigurationRecorderRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).configconn
+	conn := meta.(*AWSClient).configconn()
 
 	input := configservice.DescribeConfigurationRecordersInput{
 		ConfigurationRecorderNames: []*string{aws.String(d.Id())},
@@ -136,7 +136,7 @@ func resourceAwsConfigConfigurationRecorderRead(d *schema.ResourceData, meta int
 }
 
 func resourceAwsConfigConfigurationRecorderDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).configconn
+	conn := meta.(*AWSClient).configconn()
 	input := configservice.DeleteConfigurationRecorderInput{
 		ConfigurationRecorderName: aws.String(d.Id()),
 	}
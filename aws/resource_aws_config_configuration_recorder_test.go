@@ -25,7 +25,7 @@ func testSweepConfigConfigurationRecorder(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).configconn
+	conn := client.(*AWSClient).configconn()
 
 	req := &configservice.DescribeConfigurationRecordersInput{}
 	resp, err := conn.DescribeConfigurationRecorders(req)
@@ -186,7 +186,7 @@ func testAccCheckConfigConfigurationRecorderExists(n string, obj *configservice.
 			return fmt.Errorf("No configuration recorder ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).configconn
+		conn := testAccProvider.Meta().(*AWSClient).configconn()
 		out, err := conn.DescribeConfigurationRecorders(&configservice.DescribeConfigurationRecordersInput{
 			ConfigurationRecorderNames: []*string{aws.String(rs.Primary.Attributes["name"])},
 		})
@@ -205,7 +205,7 @@ func testAccCheckConfigConfigurationRecorderExists(n string, obj *configservice.
 }
 
 func testAccCheckConfigConfigurationRecorderDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).configconn
+	conn := testAccProvider.Meta().(*AWSClient).configconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_config_configuration_recorder_status" {
@@ -29,7 +29,7 @@ func testSweepConfigDeliveryChannels(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).configconn
+	conn := client.(*AWSClient).configconn()
 
 	req := &configservice.DescribeDeliveryChannelsInput{}
 	var resp *configservice.DescribeDeliveryChannelsOutput
@@ -170,7 +170,7 @@ func testAccCheckConfigDeliveryChannelExists(n string, obj *configservice.Delive
 			return fmt.Errorf("No delivery channel ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).configconn
+		conn := testAccProvider.Meta().(*AWSClient).configconn()
 		out, err := conn.DescribeDeliveryChannels(&configservice.DescribeDeliveryChannelsInput{
 			DeliveryChannelNames: []*string{aws.String(rs.Primary.Attributes["name"])},
 		})
@@ -189,7 +189,7 @@ func testAccCheckConfigDeliveryChannelExists(n string, obj *configservice.Delive
 }
 
 func testAccCheckConfigDeliveryChannelDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).configconn
+	conn := testAccProvider.Meta().(*AWSClient).configconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_config_delivery_channel" {
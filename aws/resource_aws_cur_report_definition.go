@@ -95,7 +95,7 @@ func resourceAwsCurReportDefinition() *schema.Resource {
 }
 
 func resourceAwsCurReportDefinitionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).costandusagereportconn
+	conn := meta.(*AWSClient).costandusagereportconn()
 
 	reportName := d.Get("report_name").(string)
 
@@ -125,7 +125,7 @@ func resourceAwsCurReportDefinitionCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsCurReportDefinitionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).costandusagereportconn
+	conn := meta.(*AWSClient).costandusagereportconn()
 
 	reportName := *aws.String(d.Id())
 
@@ -152,7 +152,7 @@ func resourceAwsCurReportDefinitionRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsCurReportDefinitionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).costandusagereportconn
+	conn := meta.(*AWSClient).costandusagereportconn()
 	log.Printf("[DEBUG] Deleting AWS Cost and Usage Report Definition : %s", d.Id())
 	_, err := conn.DeleteReportDefinition(&costandusagereportservice.DeleteReportDefinitionInput{
 		ReportName: aws.String(d.Id()),
@@ -47,7 +47,7 @@ func TestAccAwsCurReportDefinition_basic(t *testing.T) {
 }
 
 func testAccCheckAwsCurReportDefinitionDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).costandusagereportconn
+	conn := testAccProvider.Meta().(*AWSClient).costandusagereportconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cur_report_definition" {
@@ -68,7 +68,7 @@ func testAccCheckAwsCurReportDefinitionDestroy(s *terraform.State) error {
 
 func testAccCheckAwsCurReportDefinitionExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).costandusagereportconn
+		conn := testAccProvider.Meta().(*AWSClient).costandusagereportconn()
 
 		rs, ok := s.RootModule().Resources[resourceName]
 		if !ok {
@@ -87,7 +87,7 @@ func testAccCheckAwsCurReportDefinitionExists(resourceName string) resource.Test
 }
 
 func testAccPreCheckAWSCur(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).costandusagereportconn
+	conn := testAccProvider.Meta().(*AWSClient).costandusagereportconn()
 
 	input := &costandusagereportservice.DescribeReportDefinitionsInput{
 		MaxResults: aws.Int64(5),
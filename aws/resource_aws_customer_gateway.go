@@ -49,7 +49,7 @@ func resourceAwsCustomerGateway() *schema.Resource {
 }
 
 func resourceAwsCustomerGatewayCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	ipAddress := d.Get("ip_address").(string)
 	vpnType := d.Get("type").(string)
@@ -168,7 +168,7 @@ func resourceAwsCustomerGatewayExists(vpnType, ipAddress string, bgpAsn int, con
 }
 
 func resourceAwsCustomerGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	gatewayFilter := &ec2.Filter{
 		Name:   aws.String("customer-gateway-id"),
@@ -216,7 +216,7 @@ func resourceAwsCustomerGatewayRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsCustomerGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	// Update tags if required.
 	if err := setTags(conn, d); err != nil {
@@ -229,7 +229,7 @@ func resourceAwsCustomerGatewayUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsCustomerGatewayDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	_, err := conn.DeleteCustomerGateway(&ec2.DeleteCustomerGatewayInput{
 		CustomerGatewayId: aws.String(d.Id()),
@@ -117,7 +117,7 @@ func TestAccAWSCustomerGateway_disappears(t *testing.T) {
 
 func testAccAWSCustomerGatewayDisappears(gateway *ec2.CustomerGateway) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 		opts := &ec2.DeleteCustomerGatewayInput{
 			CustomerGatewayId: gateway.CustomerGatewayId,
 		}
@@ -147,7 +147,7 @@ func testAccAWSCustomerGatewayDisappears(gateway *ec2.CustomerGateway) resource.
 }
 
 func testAccCheckCustomerGatewayDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_customer_gatewah" {
@@ -199,7 +199,7 @@ func testAccCheckCustomerGateway(gatewayResource string, cgw *ec2.CustomerGatewa
 			return fmt.Errorf("Not found: %s", gatewayResource)
 		}
 
-		ec2conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		ec2conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 		gatewayFilter := &ec2.Filter{
 			Name:   aws.String("customer-gateway-id"),
 			Values: []*string{aws.String(gateway.Primary.ID)},
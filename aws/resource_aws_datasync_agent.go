@@ -59,7 +59,7 @@ func resourceAwsDataSyncAgent() *schema.Resource {
 }
 
 func resourceAwsDataSyncAgentCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).datasyncconn
+	conn := meta.(*AWSClient).datasyncconn()
 	region := meta.(*AWSClient).region
 
 	activationKey := d.Get("activation_key").(string)
@@ -160,7 +160,7 @@ func resourceAwsDataSyncAgentCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsDataSyncAgentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).datasyncconn
+	conn := meta.(*AWSClient).datasyncconn()
 
 	input := &datasync.DescribeAgentInput{
 		AgentArn: aws.String(d.Id()),
@@ -201,7 +201,7 @@ func resourceAwsDataSyncAgentRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsDataSyncAgentUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).datasyncconn
+	conn := meta.(*AWSClient).datasyncconn()
 
 	if d.HasChange("name") {
 		input := &datasync.UpdateAgentInput{
@@ -216,8 +216,8 @@ func resourceAwsDataSyncAgentUpdate(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
-	if d.HasChange("tags") {
-		oldRaw, newRaw := d.GetChange("tags")
+	if hasTagsChange(d) {
+		oldRaw, newRaw := getTagsChange(d)
 		createTags, removeTags := dataSyncTagsDiff(expandDataSyncTagListEntry(oldRaw.(map[string]interface{})), expandDataSyncTagListEntry(newRaw.(map[string]interface{})))
 
 		if len(removeTags) > 0 {
@@ -249,7 +249,7 @@ func resourceAwsDataSyncAgentUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsDataSyncAgentDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).datasyncconn
+	conn := meta.(*AWSClient).datasyncconn()
 
 	input := &datasync.DeleteAgentInput{
 		AgentArn: aws.String(d.Id()),
@@ -84,7 +84,7 @@ func resourceAwsDataSyncLocationEfs() *schema.Resource {
 }
 
 func resourceAwsDataSyncLocationEfsCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).datasyncconn
+	conn := meta.(*AWSClient).datasyncconn()
 
 	input := &datasync.CreateLocationEfsInput{
 		Ec2Config:        expandDataSyncEc2Config(d.Get("ec2_config").([]interface{})),
@@ -105,7 +105,7 @@ func resourceAwsDataSyncLocationEfsCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsDataSyncLocationEfsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).datasyncconn
+	conn := meta.(*AWSClient).datasyncconn()
 
 	input := &datasync.DescribeLocationEfsInput{
 		LocationArn: aws.String(d.Id()),
@@ -159,10 +159,10 @@ func resourceAwsDataSyncLocationEfsRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsDataSyncLocationEfsUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).datasyncconn
+	conn := meta.(*AWSClient).datasyncconn()
 
-	if d.HasChange("tags") {
-		oldRaw, newRaw := d.GetChange("tags")
+	if hasTagsChange(d) {
+		oldRaw, newRaw := getTagsChange(d)
 		createTags, removeTags := dataSyncTagsDiff(expandDataSyncTagListEntry(oldRaw.(map[string]interface{})), expandDataSyncTagListEntry(newRaw.(map[string]interface{})))
 
 		if len(removeTags) > 0 {
@@ -194,7 +194,7 @@ func resourceAwsDataSyncLocationEfsUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsDataSyncLocationEfsDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).datasyncconn
+	conn := meta.(*AWSClient).datasyncconn()
 
 	input := &datasync.DeleteLocationInput{
 		LocationArn: aws.String(d.Id()),
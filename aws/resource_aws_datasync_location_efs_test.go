@@ -26,7 +26,7 @@ func testSweepDataSyncLocationEfss(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).datasyncconn
+	conn := client.(*AWSClient).datasyncconn()
 
 	input := &datasync.ListLocationsInput{}
 	for {
@@ -207,7 +207,7 @@ func TestAccAWSDataSyncLocationEfs_Tags(t *testing.T) {
 }
 
 func testAccCheckAWSDataSyncLocationEfsDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).datasyncconn
+	conn := testAccProvider.Meta().(*AWSClient).datasyncconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_datasync_location_efs" {
@@ -239,7 +239,7 @@ func testAccCheckAWSDataSyncLocationEfsExists(resourceName string, locationEfs *
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).datasyncconn
+		conn := testAccProvider.Meta().(*AWSClient).datasyncconn()
 		input := &datasync.DescribeLocationEfsInput{
 			LocationArn: aws.String(rs.Primary.ID),
 		}
@@ -262,7 +262,7 @@ func testAccCheckAWSDataSyncLocationEfsExists(resourceName string, locationEfs *
 
 func testAccCheckAWSDataSyncLocationEfsDisappears(location *datasync.DescribeLocationEfsOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).datasyncconn
+		conn := testAccProvider.Meta().(*AWSClient).datasyncconn()
 
 		input := &datasync.DeleteLocationInput{
 			LocationArn: location.LocationArn,
@@ -77,7 +77,7 @@ func resourceAwsDataSyncLocationNfs() *schema.Resource {
 }
 
 func resourceAwsDataSyncLocationNfsCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).datasyncconn
+	conn := meta.(*AWSClient).datasyncconn()
 
 	input := &datasync.CreateLocationNfsInput{
 		OnPremConfig:   expandDataSyncOnPremConfig(d.Get("on_prem_config").([]interface{})),
@@ -98,7 +98,7 @@ func resourceAwsDataSyncLocationNfsCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsDataSyncLocationNfsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).datasyncconn
+	conn := meta.(*AWSClient).datasyncconn()
 
 	input := &datasync.DescribeLocationNfsInput{
 		LocationArn: aws.String(d.Id()),
@@ -152,10 +152,10 @@ func resourceAwsDataSyncLocationNfsRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsDataSyncLocationNfsUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).datasyncconn
+	conn := meta.(*AWSClient).datasyncconn()
 
-	if d.HasChange("tags") {
-		oldRaw, newRaw := d.GetChange("tags")
+	if hasTagsChange(d) {
+		oldRaw, newRaw := getTagsChange(d)
 		createTags, removeTags := dataSyncTagsDiff(expandDataSyncTagListEntry(oldRaw.(map[string]interface{})), expandDataSyncTagListEntry(newRaw.(map[string]interface{})))
 
 		if len(removeTags) > 0 {
@@ -187,7 +187,7 @@ func resourceAwsDataSyncLocationNfsUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsDataSyncLocationNfsDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).datasyncconn
+	conn := meta.(*AWSClient).datasyncconn()
 
 	input := &datasync.DeleteLocationInput{
 		LocationArn: aws.String(d.Id()),
@@ -27,7 +27,7 @@ func testSweepDataSyncLocationNfss(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).datasyncconn
+	conn := client.(*AWSClient).datasyncconn()
 
 	input := &datasync.ListLocationsInput{}
 	for {
@@ -237,7 +237,7 @@ func TestAccAWSDataSyncLocationNfs_Tags(t *testing.T) {
 }
 
 func testAccCheckAWSDataSyncLocationNfsDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).datasyncconn
+	conn := testAccProvider.Meta().(*AWSClient).datasyncconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_datasync_location_nfs" {
@@ -269,7 +269,7 @@ func testAccCheckAWSDataSyncLocationNfsExists(resourceName string, locationNfs *
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).datasyncconn
+		conn := testAccProvider.Meta().(*AWSClient).datasyncconn()
 		input := &datasync.DescribeLocationNfsInput{
 			LocationArn: aws.String(rs.Primary.ID),
 		}
@@ -292,7 +292,7 @@ func testAccCheckAWSDataSyncLocationNfsExists(resourceName string, locationNfs *
 
 func testAccCheckAWSDataSyncLocationNfsDisappears(location *datasync.DescribeLocationNfsOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).datasyncconn
+		conn := testAccProvider.Meta().(*AWSClient).datasyncconn()
 
 		input := &datasync.DeleteLocationInput{
 			LocationArn: location.LocationArn,
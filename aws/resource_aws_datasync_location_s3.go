@@ -77,7 +77,7 @@ func resourceAwsDataSyncLocationS3() *schema.Resource {
 }
 
 func resourceAwsDataSyncLocationS3Create(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).datasyncconn
+	conn := meta.(*AWSClient).datasyncconn()
 
 	input := &datasync.CreateLocationS3Input{
 		S3BucketArn:  aws.String(d.Get("s3_bucket_arn").(string)),
@@ -98,7 +98,7 @@ func resourceAwsDataSyncLocationS3Create(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsDataSyncLocationS3Read(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).datasyncconn
+	conn := meta.(*AWSClient).datasyncconn()
 
 	input := &datasync.DescribeLocationS3Input{
 		LocationArn: aws.String(d.Id()),
@@ -152,10 +152,10 @@ func resourceAwsDataSyncLocationS3Read(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsDataSyncLocationS3Update(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).datasyncconn
+	conn := meta.(*AWSClient).datasyncconn()
 
-	if d.HasChange("tags") {
-		oldRaw, newRaw := d.GetChange("tags")
+	if hasTagsChange(d) {
+		oldRaw, newRaw := getTagsChange(d)
 		createTags, removeTags := dataSyncTagsDiff(expandDataSyncTagListEntry(oldRaw.(map[string]interface{})), expandDataSyncTagListEntry(newRaw.(map[string]interface{})))
 
 		if len(removeTags) > 0 {
@@ -187,7 +187,7 @@ func resourceAwsDataSyncLocationS3Update(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsDataSyncLocationS3Delete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).datasyncconn
+	conn := meta.(*AWSClient).datasyncconn()
 
 	input := &datasync.DeleteLocationInput{
 		LocationArn: aws.String(d.Id()),
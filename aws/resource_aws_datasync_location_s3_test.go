@@ -27,7 +27,7 @@ func testSweepDataSyncLocationS3s(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).datasyncconn
+	conn := client.(*AWSClient).datasyncconn()
 
 	input := &datasync.ListLocationsInput{}
 	for {
@@ -184,7 +184,7 @@ func TestAccAWSDataSyncLocationS3_Tags(t *testing.T) {
 }
 
 func testAccCheckAWSDataSyncLocationS3Destroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).datasyncconn
+	conn := testAccProvider.Meta().(*AWSClient).datasyncconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_datasync_location_s3" {
@@ -216,7 +216,7 @@ func testAccCheckAWSDataSyncLocationS3Exists(resourceName string, locationS3 *da
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).datasyncconn
+		conn := testAccProvider.Meta().(*AWSClient).datasyncconn()
 		input := &datasync.DescribeLocationS3Input{
 			LocationArn: aws.String(rs.Primary.ID),
 		}
@@ -239,7 +239,7 @@ func testAccCheckAWSDataSyncLocationS3Exists(resourceName string, locationS3 *da
 
 func testAccCheckAWSDataSyncLocationS3Disappears(location *datasync.DescribeLocationS3Output) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).datasyncconn
+		conn := testAccProvider.Meta().(*AWSClient).datasyncconn()
 
 		input := &datasync.DeleteLocationInput{
 			LocationArn: location.LocationArn,
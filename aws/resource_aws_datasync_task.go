@@ -161,7 +161,7 @@ func resourceAwsDataSyncTask() *schema.Resource {
 }
 
 func resourceAwsDataSyncTaskCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).datasyncconn
+	conn := meta.(*AWSClient).datasyncconn()
 
 	input := &datasync.CreateTaskInput{
 		DestinationLocationArn: aws.String(d.Get("destination_location_arn").(string)),
@@ -221,7 +221,7 @@ func resourceAwsDataSyncTaskCreate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsDataSyncTaskRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).datasyncconn
+	conn := meta.(*AWSClient).datasyncconn()
 
 	input := &datasync.DescribeTaskInput{
 		TaskArn: aws.String(d.Id()),
@@ -270,7 +270,7 @@ func resourceAwsDataSyncTaskRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsDataSyncTaskUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).datasyncconn
+	conn := meta.(*AWSClient).datasyncconn()
 
 	if d.HasChange("options") || d.HasChange("name") {
 		input := &datasync.UpdateTaskInput{
@@ -285,8 +285,8 @@ func resourceAwsDataSyncTaskUpdate(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
-	if d.HasChange("tags") {
-		oldRaw, newRaw := d.GetChange("tags")
+	if hasTagsChange(d) {
+		oldRaw, newRaw := getTagsChange(d)
 		createTags, removeTags := dataSyncTagsDiff(expandDataSyncTagListEntry(oldRaw.(map[string]interface{})), expandDataSyncTagListEntry(newRaw.(map[string]interface{})))
 
 		if len(removeTags) > 0 {
@@ -318,7 +318,7 @@ func resourceAwsDataSyncTaskUpdate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsDataSyncTaskDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).datasyncconn
+	conn := meta.(*AWSClient).datasyncconn()
 
 	input := &datasync.DeleteTaskInput{
 		TaskArn: aws.String(d.Id()),
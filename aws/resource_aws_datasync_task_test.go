@@ -26,7 +26,7 @@ func testSweepDataSyncTasks(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).datasyncconn
+	conn := client.(*AWSClient).datasyncconn()
 
 	input := &datasync.ListTasksInput{}
 	for {
@@ -504,7 +504,7 @@ func TestAccAWSDataSyncTask_Tags(t *testing.T) {
 }
 
 func testAccCheckAWSDataSyncTaskDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).datasyncconn
+	conn := testAccProvider.Meta().(*AWSClient).datasyncconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_datasync_task" {
@@ -536,7 +536,7 @@ func testAccCheckAWSDataSyncTaskExists(resourceName string, task *datasync.Descr
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).datasyncconn
+		conn := testAccProvider.Meta().(*AWSClient).datasyncconn()
 		input := &datasync.DescribeTaskInput{
 			TaskArn: aws.String(rs.Primary.ID),
 		}
@@ -564,7 +564,7 @@ func testAccCheckAWSDataSyncTaskExists(resourceName string, task *datasync.Descr
 
 func testAccCheckAWSDataSyncTaskDisappears(location *datasync.DescribeTaskOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).datasyncconn
+		conn := testAccProvider.Meta().(*AWSClient).datasyncconn()
 
 		input := &datasync.DeleteTaskInput{
 			TaskArn: location.TaskArn,
@@ -587,7 +587,7 @@ func testAccCheckAWSDataSyncTaskNotRecreated(i, j *datasync.DescribeTaskOutput)
 }
 
 func testAccPreCheckAWSDataSync(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).datasyncconn
+	conn := testAccProvider.Meta().(*AWSClient).datasyncconn()
 
 	input := &datasync.ListTasksInput{
 		MaxResults: aws.Int64(1),
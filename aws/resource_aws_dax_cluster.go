@@ -164,7 +164,7 @@ func resourceAwsDaxCluster() *schema.Resource {
 }
 
 func resourceAwsDaxClusterCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).daxconn
+	conn := meta.(*AWSClient).daxconn()
 
 	clusterName := d.Get("cluster_name").(string)
 	iamRoleArn := d.Get("iam_role_arn").(string)
@@ -259,7 +259,7 @@ func resourceAwsDaxClusterCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsDaxClusterRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).daxconn
+	conn := meta.(*AWSClient).daxconn()
 	req := &dax.DescribeClustersInput{
 		ClusterNames: []*string{aws.String(d.Id())},
 	}
@@ -337,7 +337,7 @@ func resourceAwsDaxClusterRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsDaxClusterUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).daxconn
+	conn := meta.(*AWSClient).daxconn()
 
 	if err := setTagsDax(conn, d, d.Get("arn").(string)); err != nil {
 		return err
@@ -471,7 +471,7 @@ func (b byNodeId) Less(i, j int) bool {
 }
 
 func resourceAwsDaxClusterDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).daxconn
+	conn := meta.(*AWSClient).daxconn()
 
 	req := &dax.DeleteClusterInput{
 		ClusterName: aws.String(d.Id()),
@@ -25,7 +25,7 @@ func testSweepDAXClusters(region string) error {
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).daxconn
+	conn := client.(*AWSClient).daxconn()
 
 	resp, err := conn.DescribeClusters(&dax.DescribeClustersInput{})
 	if err != nil {
@@ -217,7 +217,7 @@ func TestAccAWSDAXCluster_encryption_enabled(t *testing.T) {
 }
 
 func testAccCheckAWSDAXClusterDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).daxconn
+	conn := testAccProvider.Meta().(*AWSClient).daxconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_dax_cluster" {
@@ -251,7 +251,7 @@ func testAccCheckAWSDAXClusterExists(n string, v *dax.Cluster) resource.TestChec
 			return fmt.Errorf("No DAX cluster ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).daxconn
+		conn := testAccProvider.Meta().(*AWSClient).daxconn()
 		resp, err := conn.DescribeClusters(&dax.DescribeClustersInput{
 			ClusterNames: []*string{aws.String(rs.Primary.ID)},
 		})
@@ -59,7 +59,7 @@ func TestAccAwsDaxParameterGroup_import(t *testing.T) {
 }
 
 func testAccCheckAwsDaxParameterGroupDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).daxconn
+	conn := testAccProvider.Meta().(*AWSClient).daxconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_dax_parameter_group" {
@@ -86,7 +86,7 @@ func testAccCheckAwsDaxParameterGroupExists(name string) resource.TestCheckFunc
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).daxconn
+		conn := testAccProvider.Meta().(*AWSClient).daxconn()
 
 		_, err := conn.DescribeParameterGroups(&dax.DescribeParameterGroupsInput{
 			ParameterGroupNames: []*string{aws.String(rs.Primary.ID)},
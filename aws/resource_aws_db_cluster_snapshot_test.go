@@ -51,7 +51,7 @@ func TestAccAWSDBClusterSnapshot_basic(t *testing.T) {
 }
 
 func testAccCheckDbClusterSnapshotDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).rdsconn
+	conn := testAccProvider.Meta().(*AWSClient).rdsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_db_cluster_snapshot" {
@@ -89,7 +89,7 @@ func testAccCheckDbClusterSnapshotExists(resourceName string, dbClusterSnapshot
 			return fmt.Errorf("No ID is set for %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).rdsconn
+		conn := testAccProvider.Meta().(*AWSClient).rdsconn()
 
 		request := &rds.DescribeDBClusterSnapshotsInput{
 			DBClusterSnapshotIdentifier: aws.String(rs.Primary.ID),
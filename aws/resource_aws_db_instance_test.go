@@ -28,7 +28,7 @@ func testSweepDbInstances(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).rdsconn
+	conn := client.(*AWSClient).rdsconn()
 
 	err = conn.DescribeDBInstancesPages(&rds.DescribeDBInstancesInput{}, func(out *rds.DescribeDBInstancesOutput, lastPage bool) bool {
 		for _, dbi := range out.DBInstances {
@@ -359,7 +359,7 @@ func TestAccAWSDBInstance_IsAlreadyBeingDeleted(t *testing.T) {
 			{
 				PreConfig: func() {
 					// Get Database Instance into deleting state
-					conn := testAccProvider.Meta().(*AWSClient).rdsconn
+					conn := testAccProvider.Meta().(*AWSClient).rdsconn()
 					input := &rds.DeleteDBInstanceInput{
 						DBInstanceIdentifier: aws.String(rName),
 						SkipFinalSnapshot:    aws.Bool(true),
@@ -1731,7 +1731,7 @@ func TestAccAWSDBInstance_EnabledCloudwatchLogsExports_Postgresql(t *testing.T)
 }
 
 func testAccCheckAWSDBInstanceDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).rdsconn
+	conn := testAccProvider.Meta().(*AWSClient).rdsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_db_instance" {
@@ -1847,7 +1847,7 @@ func testAccCheckAWSDBInstanceSnapshot(s *terraform.State) error {
 		}
 
 		awsClient := testAccProvider.Meta().(*AWSClient)
-		conn := awsClient.rdsconn
+		conn := awsClient.rdsconn()
 
 		log.Printf("[INFO] Trying to locate the DBInstance Final Snapshot")
 		snapOutput, err := conn.DescribeDBSnapshots(
@@ -1918,7 +1918,7 @@ func testAccCheckAWSDBInstanceSnapshot(s *terraform.State) error {
 }
 
 func testAccCheckAWSDBInstanceNoSnapshot(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).rdsconn
+	conn := testAccProvider.Meta().(*AWSClient).rdsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_db_instance" {
@@ -1962,7 +1962,7 @@ func testAccCheckAWSDBInstanceExists(n string, v *rds.DBInstance) resource.TestC
 			return fmt.Errorf("No DB Instance ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).rdsconn
+		conn := testAccProvider.Meta().(*AWSClient).rdsconn()
 
 		opts := rds.DescribeDBInstancesInput{
 			DBInstanceIdentifier: aws.String(rs.Primary.ID),
@@ -123,7 +123,7 @@ func resourceAwsDbOptionGroup() *schema.Resource {
 }
 
 func resourceAwsDbOptionGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	rdsconn := meta.(*AWSClient).rdsconn
+	rdsconn := meta.(*AWSClient).rdsconn()
 	tags := tagsFromMapRDS(d.Get("tags").(map[string]interface{}))
 
 	var groupName string
@@ -159,7 +159,7 @@ func resourceAwsDbOptionGroupCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsDbOptionGroupRead(d *schema.ResourceData, meta interface{}) error {
-	rdsconn := meta.(*AWSClient).rdsconn
+	rdsconn := meta.(*AWSClient).rdsconn()
 	params := &rds.DescribeOptionGroupsInput{
 		OptionGroupName: aws.String(d.Id()),
 	}
@@ -226,7 +226,7 @@ func optionInList(optionName string, list []*string) bool {
 }
 
 func resourceAwsDbOptionGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	rdsconn := meta.(*AWSClient).rdsconn
+	rdsconn := meta.(*AWSClient).rdsconn()
 	if d.HasChange("option") {
 		o, n := d.GetChange("option")
 		if o == nil {
@@ -299,7 +299,7 @@ func resourceAwsDbOptionGroupUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsDbOptionGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	rdsconn := meta.(*AWSClient).rdsconn
+	rdsconn := meta.(*AWSClient).rdsconn()
 
 	deleteOpts := &rds.DeleteOptionGroupInput{
 		OptionGroupName: aws.String(d.Id()),
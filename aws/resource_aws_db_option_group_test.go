@@ -29,7 +29,7 @@ func testSweepDbOptionGroups(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*AWSClient).rdsconn
+	conn := client.(*AWSClient).rdsconn()
 
 	opts := rds.DescribeOptionGroupsInput{}
 	resp, err := conn.DescribeOptionGroups(&opts)
@@ -600,7 +600,7 @@ func testAccCheckAWSDBOptionGroupExists(n string, v *rds.OptionGroup) resource.T
 			return fmt.Errorf("No DB Option Group Name is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).rdsconn
+		conn := testAccProvider.Meta().(*AWSClient).rdsconn()
 
 		opts := rds.DescribeOptionGroupsInput{
 			OptionGroupName: aws.String(rs.Primary.ID),
@@ -624,7 +624,7 @@ func testAccCheckAWSDBOptionGroupExists(n string, v *rds.OptionGroup) resource.T
 }
 
 func testAccCheckAWSDBOptionGroupDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).rdsconn
+	conn := testAccProvider.Meta().(*AWSClient).rdsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_db_option_group" {
@@ -88,7 +88,7 @@ func resourceAwsDbParameterGroup() *schema.Resource {
 }
 
 func resourceAwsDbParameterGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	rdsconn := meta.(*AWSClient).rdsconn
+	rdsconn := meta.(*AWSClient).rdsconn()
 	tags := tagsFromMapRDS(d.Get("tags").(map[string]interface{}))
 
 	var groupName string
@@ -128,7 +128,7 @@ func resourceAwsDbParameterGroupCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsDbParameterGroupRead(d *schema.ResourceData, meta interface{}) error {
-	rdsconn := meta.(*AWSClient).rdsconn
+	rdsconn := meta.(*AWSClient).rdsconn()
 
 	describeOpts := rds.DescribeDBParameterGroupsInput{
 		DBParameterGroupName: aws.String(d.Id()),
@@ -249,7 +249,7 @@ func resourceAwsDbParameterGroupRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsDbParameterGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	rdsconn := meta.(*AWSClient).rdsconn
+	rdsconn := meta.(*AWSClient).rdsconn()
 
 	d.Partial(true)
 
@@ -309,7 +309,7 @@ func resourceAwsDbParameterGroupUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsDbParameterGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).rdsconn
+	conn := meta.(*AWSClient).rdsconn()
 	return resource.Retry(3*time.Minute, func() *resource.RetryError {
 		deleteOpts := rds.DeleteDBParameterGroupInput{
 			DBParameterGroupName: aws.String(d.Id()),
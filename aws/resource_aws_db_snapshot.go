@@ -109,7 +109,7 @@ func resourceAwsDbSnapshot() *schema.Resource {
 }
 
 func resourceAwsDbSnapshotCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).rdsconn
+	conn := meta.(*AWSClient).rdsconn()
 	tags := tagsFromMapRDS(d.Get("tags").(map[string]interface{}))
 
 	params := &rds.CreateDBSnapshotInput{
@@ -143,7 +143,7 @@ func resourceAwsDbSnapshotCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsDbSnapshotRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).rdsconn
+	conn := meta.(*AWSClient).rdsconn()
 
 	params := &rds.DescribeDBSnapshotsInput{
 		DBSnapshotIdentifier: aws.String(d.Id()),
@@ -179,7 +179,7 @@ func resourceAwsDbSnapshotRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsDbSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).rdsconn
+	conn := meta.(*AWSClient).rdsconn()
 
 	params := &rds.DeleteDBSnapshotInput{
 		DBSnapshotIdentifier: aws.String(d.Id()),
@@ -189,10 +189,10 @@ func resourceAwsDbSnapshotDelete(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsDbSnapshotUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).rdsconn
+	conn := meta.(*AWSClient).rdsconn()
 	arn := d.Get("db_snapshot_arn").(string)
-	if d.HasChange("tags") {
-		oldTagsRaw, newTagsRaw := d.GetChange("tags")
+	if hasTagsChange(d) {
+		oldTagsRaw, newTagsRaw := getTagsChange(d)
 		oldTagsMap := oldTagsRaw.(map[string]interface{})
 		newTagsMap := newTagsRaw.(map[string]interface{})
 		createTags, removeTags := diffTagsRDS(tagsFromMapRDS(oldTagsMap), tagsFromMapRDS(newTagsMap))
@@ -233,7 +233,7 @@ func resourceAwsDbSnapshotUpdate(d *schema.ResourceData, meta interface{}) error
 func resourceAwsDbSnapshotStateRefreshFunc(
 	d *schema.ResourceData, meta interface{}) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		conn := meta.(*AWSClient).rdsconn
+		conn := meta.(*AWSClient).rdsconn()
 
 		opts := &rds.DescribeDBSnapshotsInput{
 			DBSnapshotIdentifier: aws.String(d.Id()),
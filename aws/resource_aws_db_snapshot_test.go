@@ -67,7 +67,7 @@ func testAccCheckDbSnapshotExists(n string, v *rds.DBSnapshot) resource.TestChec
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).rdsconn
+		conn := testAccProvider.Meta().(*AWSClient).rdsconn()
 
 		request := &rds.DescribeDBSnapshotsInput{
 			DBSnapshotIdentifier: aws.String(rs.Primary.ID),
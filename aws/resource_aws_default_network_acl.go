@@ -170,7 +170,7 @@ func resourceAwsDefaultNetworkAclCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsDefaultNetworkAclUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	d.Partial(true)
 
 	if d.HasChange("ingress") {
@@ -254,7 +254,7 @@ func resourceAwsDefaultNetworkAclDelete(d *schema.ResourceData, meta interface{}
 // revokeAllNetworkACLEntries revoke all ingress and egress rules that the Default
 // Network ACL currently has
 func revokeAllNetworkACLEntries(netaclId string, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	resp, err := conn.DescribeNetworkAcls(&ec2.DescribeNetworkAclsInput{
 		NetworkAclIds: []*string{aws.String(netaclId)},
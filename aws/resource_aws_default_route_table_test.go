@@ -106,7 +106,7 @@ func TestAccAWSDefaultRouteTable_vpc_endpoint(t *testing.T) {
 }
 
 func testAccCheckDefaultRouteTableDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_default_route_table" {
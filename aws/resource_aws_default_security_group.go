@@ -34,7 +34,7 @@ func resourceAwsDefaultSecurityGroup() *schema.Resource {
 }
 
 func resourceAwsDefaultSecurityGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	securityGroupOpts := &ec2.DescribeSecurityGroupsInput{
 		Filters: []*ec2.Filter{
 			{
@@ -104,7 +104,7 @@ func resourceAwsDefaultSecurityGroupDelete(d *schema.ResourceData, meta interfac
 }
 
 func revokeDefaultSecurityGroupRules(meta interface{}, g *ec2.SecurityGroup) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	log.Printf("[WARN] Removing all ingress and egress rules found on Default Security Group (%s)", *g.GroupId)
 	if len(g.IpPermissionsEgress) > 0 {
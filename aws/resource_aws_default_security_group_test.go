@@ -91,7 +91,7 @@ func testAccCheckAWSDefaultSecurityGroupExists(n string, group *ec2.SecurityGrou
 			return fmt.Errorf("No Security Group is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 		req := &ec2.DescribeSecurityGroupsInput{
 			GroupIds: []*string{aws.String(rs.Primary.ID)},
 		}
@@ -35,7 +35,7 @@ func resourceAwsDefaultVpcDhcpOptions() *schema.Resource {
 }
 
 func resourceAwsDefaultVpcDhcpOptionsCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	var domainName string
 	awsRegion := meta.(*AWSClient).region
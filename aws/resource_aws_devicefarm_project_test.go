@@ -68,7 +68,7 @@ func testAccCheckDeviceFarmProjectExists(n string, v *devicefarm.Project) resour
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).devicefarmconn
+		conn := testAccProvider.Meta().(*AWSClient).devicefarmconn()
 		resp, err := conn.GetProject(
 			&devicefarm.GetProjectInput{Arn: aws.String(rs.Primary.ID)})
 		if err != nil {
@@ -85,7 +85,7 @@ func testAccCheckDeviceFarmProjectExists(n string, v *devicefarm.Project) resour
 }
 
 func testAccCheckDeviceFarmProjectDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).devicefarmconn
+	conn := testAccProvider.Meta().(*AWSClient).devicefarmconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_devicefarm_project" {
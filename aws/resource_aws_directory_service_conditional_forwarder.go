@@ -51,7 +51,7 @@ func resourceAwsDirectoryServiceConditionalForwarder() *schema.Resource {
 }
 
 func resourceAwsDirectoryServiceConditionalForwarderCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dsconn
+	conn := meta.(*AWSClient).dsconn()
 
 	dnsIps := expandStringList(d.Get("dns_ips").([]interface{}))
 
@@ -74,7 +74,7 @@ func resourceAwsDirectoryServiceConditionalForwarderCreate(d *schema.ResourceDat
 }
 
 func resourceAwsDirectoryServiceConditionalForwarderRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dsconn
+	conn := meta.(*AWSClient).dsconn()
 
 	directoryId, domainName, err := parseDSConditionalForwarderId(d.Id())
 	if err != nil {
@@ -111,7 +111,7 @@ func resourceAwsDirectoryServiceConditionalForwarderRead(d *schema.ResourceData,
 }
 
 func resourceAwsDirectoryServiceConditionalForwarderUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dsconn
+	conn := meta.(*AWSClient).dsconn()
 
 	directoryId, domainName, err := parseDSConditionalForwarderId(d.Id())
 	if err != nil {
@@ -134,7 +134,7 @@ func resourceAwsDirectoryServiceConditionalForwarderUpdate(d *schema.ResourceDat
 }
 
 func resourceAwsDirectoryServiceConditionalForwarderDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dsconn
+	conn := meta.(*AWSClient).dsconn()
 
 	directoryId, domainName, err := parseDSConditionalForwarderId(d.Id())
 	if err != nil {
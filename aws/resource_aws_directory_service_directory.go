@@ -311,7 +311,7 @@ func createActiveDirectoryService(dsconn *directoryservice.DirectoryService, d *
 }
 
 func resourceAwsDirectoryServiceDirectoryCreate(d *schema.ResourceData, meta interface{}) error {
-	dsconn := meta.(*AWSClient).dsconn
+	dsconn := meta.(*AWSClient).dsconn()
 
 	var directoryId string
 	var err error
@@ -384,7 +384,7 @@ func resourceAwsDirectoryServiceDirectoryCreate(d *schema.ResourceData, meta int
 }
 
 func resourceAwsDirectoryServiceDirectoryUpdate(d *schema.ResourceData, meta interface{}) error {
-	dsconn := meta.(*AWSClient).dsconn
+	dsconn := meta.(*AWSClient).dsconn()
 
 	if d.HasChange("enable_sso") {
 		d.SetPartial("enable_sso")
@@ -415,7 +415,7 @@ func resourceAwsDirectoryServiceDirectoryUpdate(d *schema.ResourceData, meta int
 }
 
 func resourceAwsDirectoryServiceDirectoryRead(d *schema.ResourceData, meta interface{}) error {
-	dsconn := meta.(*AWSClient).dsconn
+	dsconn := meta.(*AWSClient).dsconn()
 
 	input := directoryservice.DescribeDirectoriesInput{
 		DirectoryIds: []*string{aws.String(d.Id())},
@@ -471,7 +471,7 @@ func resourceAwsDirectoryServiceDirectoryRead(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsDirectoryServiceDirectoryDelete(d *schema.ResourceData, meta interface{}) error {
-	dsconn := meta.(*AWSClient).dsconn
+	dsconn := meta.(*AWSClient).dsconn()
 
 	input := directoryservice.DeleteDirectoryInput{
 		DirectoryId: aws.String(d.Id()),
@@ -143,7 +143,7 @@ func resourceAwsDlmLifecyclePolicy() *schema.Resource {
 }
 
 func resourceAwsDlmLifecyclePolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dlmconn
+	conn := meta.(*AWSClient).dlmconn()
 
 	input := dlm.CreateLifecyclePolicyInput{
 		Description:      aws.String(d.Get("description").(string)),
@@ -164,7 +164,7 @@ func resourceAwsDlmLifecyclePolicyCreate(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsDlmLifecyclePolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dlmconn
+	conn := meta.(*AWSClient).dlmconn()
 
 	log.Printf("[INFO] Reading DLM lifecycle policy: %s", d.Id())
 	out, err := conn.GetLifecyclePolicy(&dlm.GetLifecyclePolicyInput{
@@ -192,7 +192,7 @@ func resourceAwsDlmLifecyclePolicyRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsDlmLifecyclePolicyUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dlmconn
+	conn := meta.(*AWSClient).dlmconn()
 
 	input := dlm.UpdateLifecyclePolicyInput{
 		PolicyId: aws.String(d.Id()),
@@ -221,7 +221,7 @@ func resourceAwsDlmLifecyclePolicyUpdate(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsDlmLifecyclePolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dlmconn
+	conn := meta.(*AWSClient).dlmconn()
 
 	log.Printf("[INFO] Deleting DLM lifecycle policy: %s", d.Id())
 	_, err := conn.DeleteLifecyclePolicy(&dlm.DeleteLifecyclePolicyInput{
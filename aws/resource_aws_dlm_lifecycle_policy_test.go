@@ -95,7 +95,7 @@ func TestAccAWSDlmLifecyclePolicy_Full(t *testing.T) {
 }
 
 func dlmLifecyclePolicyDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).dlmconn
+	conn := testAccProvider.Meta().(*AWSClient).dlmconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_dlm_lifecycle_policy" {
@@ -131,7 +131,7 @@ func checkDlmLifecyclePolicyExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).dlmconn
+		conn := testAccProvider.Meta().(*AWSClient).dlmconn()
 
 		input := dlm.GetLifecyclePolicyInput{
 			PolicyId: aws.String(rs.Primary.ID),
@@ -223,7 +223,7 @@ func resourceAwsDmsEndpoint() *schema.Resource {
 }
 
 func resourceAwsDmsEndpointCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dmsconn
+	conn := meta.(*AWSClient).dmsconn()
 
 	request := &dms.CreateEndpointInput{
 		EndpointIdentifier: aws.String(d.Get("endpoint_id").(string)),
@@ -320,7 +320,7 @@ func resourceAwsDmsEndpointCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsDmsEndpointRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dmsconn
+	conn := meta.(*AWSClient).dmsconn()
 
 	response, err := conn.DescribeEndpoints(&dms.DescribeEndpointsInput{
 		Filters: []*dms.Filter{
@@ -354,7 +354,7 @@ func resourceAwsDmsEndpointRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsDmsEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dmsconn
+	conn := meta.(*AWSClient).dmsconn()
 
 	request := &dms.ModifyEndpointInput{
 		EndpointArn: aws.String(d.Get("endpoint_arn").(string)),
@@ -398,7 +398,7 @@ func resourceAwsDmsEndpointUpdate(d *schema.ResourceData, meta interface{}) erro
 		hasChanges = true
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		err := dmsSetTags(d.Get("endpoint_arn").(string), d, meta)
 		if err != nil {
 			return err
@@ -513,7 +513,7 @@ func resourceAwsDmsEndpointUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsDmsEndpointDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dmsconn
+	conn := meta.(*AWSClient).dmsconn()
 
 	request := &dms.DeleteEndpointInput{
 		EndpointArn: aws.String(d.Get("endpoint_arn").(string)),
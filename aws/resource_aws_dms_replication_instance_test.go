@@ -468,7 +468,7 @@ func testAccCheckAWSDmsReplicationInstanceExists(n string) resource.TestCheckFun
 		if rs.Primary.ID == "" {
 			return fmt.Errorf("No ID is set")
 		}
-		conn := testAccProvider.Meta().(*AWSClient).dmsconn
+		conn := testAccProvider.Meta().(*AWSClient).dmsconn()
 		resp, err := conn.DescribeReplicationInstances(&dms.DescribeReplicationInstancesInput{
 			Filters: []*dms.Filter{
 				{
@@ -495,7 +495,7 @@ func testAccCheckAWSDmsReplicationInstanceDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).dmsconn
+		conn := testAccProvider.Meta().(*AWSClient).dmsconn()
 
 		resp, err := conn.DescribeReplicationInstances(&dms.DescribeReplicationInstancesInput{
 			Filters: []*dms.Filter{
@@ -528,7 +528,7 @@ func testAccCheckAWSDmsReplicationInstanceDestroy(s *terraform.State) error {
 
 // Ensure at least two engine versions of the replication instance class are available
 func testAccAWSDmsReplicationInstanceEngineVersionsPreCheck(t *testing.T) []string {
-	conn := testAccProvider.Meta().(*AWSClient).dmsconn
+	conn := testAccProvider.Meta().(*AWSClient).dmsconn()
 
 	// Gather all orderable DMS replication instances of the instance class
 	// used in the acceptance testing. Not currently available as an input
@@ -89,7 +89,7 @@ func resourceAwsDmsReplicationTask() *schema.Resource {
 }
 
 func resourceAwsDmsReplicationTaskCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dmsconn
+	conn := meta.(*AWSClient).dmsconn()
 
 	request := &dms.CreateReplicationTaskInput{
 		MigrationType:             aws.String(d.Get("migration_type").(string)),
@@ -142,7 +142,7 @@ func resourceAwsDmsReplicationTaskCreate(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsDmsReplicationTaskRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dmsconn
+	conn := meta.(*AWSClient).dmsconn()
 
 	response, err := conn.DescribeReplicationTasks(&dms.DescribeReplicationTasksInput{
 		Filters: []*dms.Filter{
@@ -178,7 +178,7 @@ func resourceAwsDmsReplicationTaskRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsDmsReplicationTaskUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dmsconn
+	conn := meta.(*AWSClient).dmsconn()
 
 	request := &dms.ModifyReplicationTaskInput{
 		ReplicationTaskArn: aws.String(d.Get("replication_task_arn").(string)),
@@ -209,7 +209,7 @@ func resourceAwsDmsReplicationTaskUpdate(d *schema.ResourceData, meta interface{
 		hasChanges = true
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		err := dmsSetTags(d.Get("replication_task_arn").(string), d, meta)
 		if err != nil {
 			return err
@@ -246,7 +246,7 @@ func resourceAwsDmsReplicationTaskUpdate(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsDmsReplicationTaskDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dmsconn
+	conn := meta.(*AWSClient).dmsconn()
 
 	request := &dms.DeleteReplicationTaskInput{
 		ReplicationTaskArn: aws.String(d.Get("replication_task_arn").(string)),
@@ -296,7 +296,7 @@ func resourceAwsDmsReplicationTaskSetState(d *schema.ResourceData, task *dms.Rep
 func resourceAwsDmsReplicationTaskStateRefreshFunc(
 	d *schema.ResourceData, meta interface{}) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		conn := meta.(*AWSClient).dmsconn
+		conn := meta.(*AWSClient).dmsconn()
 
 		v, err := conn.DescribeReplicationTasks(&dms.DescribeReplicationTasksInput{
 			Filters: []*dms.Filter{
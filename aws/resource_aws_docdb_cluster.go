@@ -251,7 +251,7 @@ func resourceAwsDocDBClusterImport(
 }
 
 func resourceAwsDocDBClusterCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).docdbconn
+	conn := meta.(*AWSClient).docdbconn()
 	tags := tagsFromMapDocDB(d.Get("tags").(map[string]interface{}))
 
 	// Some API calls (e.g. RestoreDBClusterFromSnapshot do not support all
@@ -470,7 +470,7 @@ func resourceAwsDocDBClusterCreate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsDocDBClusterRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).docdbconn
+	conn := meta.(*AWSClient).docdbconn()
 
 	input := &docdb.DescribeDBClustersInput{
 		DBClusterIdentifier: aws.String(d.Id()),
@@ -562,7 +562,7 @@ func resourceAwsDocDBClusterRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsDocDBClusterUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).docdbconn
+	conn := meta.(*AWSClient).docdbconn()
 	requestUpdate := false
 
 	req := &docdb.ModifyDBClusterInput{
@@ -647,7 +647,7 @@ func resourceAwsDocDBClusterUpdate(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		if err := setTagsDocDB(conn, d); err != nil {
 			return err
 		}
@@ -659,7 +659,7 @@ func resourceAwsDocDBClusterUpdate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsDocDBClusterDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).docdbconn
+	conn := meta.(*AWSClient).docdbconn()
 	log.Printf("[DEBUG] Destroying DocDB Cluster (%s)", d.Id())
 
 	deleteOpts := docdb.DeleteDBClusterInput{
@@ -170,7 +170,7 @@ func resourceAwsDocDBClusterInstance() *schema.Resource {
 }
 
 func resourceAwsDocDBClusterInstanceCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).docdbconn
+	conn := meta.(*AWSClient).docdbconn()
 	tags := tagsFromMapDocDB(d.Get("tags").(map[string]interface{}))
 
 	createOpts := &docdb.CreateDBInstanceInput{
@@ -239,7 +239,7 @@ func resourceAwsDocDBClusterInstanceCreate(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsDocDBClusterInstanceRead(d *schema.ResourceData, meta interface{}) error {
-	db, err := resourceAwsDocDBInstanceRetrieve(d.Id(), meta.(*AWSClient).docdbconn)
+	db, err := resourceAwsDocDBInstanceRetrieve(d.Id(), meta.(*AWSClient).docdbconn())
 	// Errors from this helper are always reportable
 	if err != nil {
 		return fmt.Errorf("Error on retrieving DocDB Cluster Instance (%s): %s", d.Id(), err)
@@ -252,7 +252,7 @@ func resourceAwsDocDBClusterInstanceRead(d *schema.ResourceData, meta interface{
 	}
 
 	// Retrieve DB Cluster information, to determine if this Instance is a writer
-	conn := meta.(*AWSClient).docdbconn
+	conn := meta.(*AWSClient).docdbconn()
 	resp, err := conn.DescribeDBClusters(&docdb.DescribeDBClustersInput{
 		DBClusterIdentifier: db.DBClusterIdentifier,
 	})
@@ -312,7 +312,7 @@ func resourceAwsDocDBClusterInstanceRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsDocDBClusterInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).docdbconn
+	conn := meta.(*AWSClient).docdbconn()
 	requestUpdate := false
 
 	req := &docdb.ModifyDBInstanceInput{
@@ -386,7 +386,7 @@ func resourceAwsDocDBClusterInstanceUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsDocDBClusterInstanceDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).docdbconn
+	conn := meta.(*AWSClient).docdbconn()
 
 	log.Printf("[DEBUG] DocDB Cluster Instance destroy: %v", d.Id())
 
@@ -91,7 +91,7 @@ func resourceAwsDocDBClusterParameterGroup() *schema.Resource {
 }
 
 func resourceAwsDocDBClusterParameterGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).docdbconn
+	conn := meta.(*AWSClient).docdbconn()
 	tags := tagsFromMapDocDB(d.Get("tags").(map[string]interface{}))
 
 	var groupName string
@@ -125,7 +125,7 @@ func resourceAwsDocDBClusterParameterGroupCreate(d *schema.ResourceData, meta in
 }
 
 func resourceAwsDocDBClusterParameterGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).docdbconn
+	conn := meta.(*AWSClient).docdbconn()
 
 	describeOpts := &docdb.DescribeDBClusterParameterGroupsInput{
 		DBClusterParameterGroupName: aws.String(d.Id()),
@@ -182,7 +182,7 @@ func resourceAwsDocDBClusterParameterGroupRead(d *schema.ResourceData, meta inte
 }
 
 func resourceAwsDocDBClusterParameterGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).docdbconn
+	conn := meta.(*AWSClient).docdbconn()
 
 	d.Partial(true)
 
@@ -244,7 +244,7 @@ func resourceAwsDocDBClusterParameterGroupUpdate(d *schema.ResourceData, meta in
 }
 
 func resourceAwsDocDBClusterParameterGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).docdbconn
+	conn := meta.(*AWSClient).docdbconn()
 
 	deleteOpts := &docdb.DeleteDBClusterParameterGroupInput{
 		DBClusterParameterGroupName: aws.String(d.Id()),
@@ -235,7 +235,7 @@ func TestAccAWSDocDBClusterParameterGroup_Tags(t *testing.T) {
 }
 
 func testAccCheckAWSDocDBClusterParameterGroupDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).docdbconn
+	conn := testAccProvider.Meta().(*AWSClient).docdbconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_docdb_cluster_parameter_group" {
@@ -268,7 +268,7 @@ func testAccCheckAWSDocDBClusterParameterGroupDestroy(s *terraform.State) error
 func testAccCheckAWSDocDBClusterParameterGroupDisappears(group *docdb.DBClusterParameterGroup) resource.TestCheckFunc {
 
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).docdbconn
+		conn := testAccProvider.Meta().(*AWSClient).docdbconn()
 
 		params := &docdb.DeleteDBClusterParameterGroupInput{
 			DBClusterParameterGroupName: group.DBClusterParameterGroupName,
@@ -309,7 +309,7 @@ func testAccCheckAWSDocDBClusterParameterGroupExists(n string, v *docdb.DBCluste
 			return errors.New("No DocDB Cluster Parameter Group ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).docdbconn
+		conn := testAccProvider.Meta().(*AWSClient).docdbconn()
 
 		opts := docdb.DescribeDBClusterParameterGroupsInput{
 			DBClusterParameterGroupName: aws.String(rs.Primary.ID),
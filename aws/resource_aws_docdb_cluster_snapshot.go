@@ -88,7 +88,7 @@ func resourceAwsDocDBClusterSnapshot() *schema.Resource {
 }
 
 func resourceAwsDocDBClusterSnapshotCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).docdbconn
+	conn := meta.(*AWSClient).docdbconn()
 
 	params := &docdb.CreateDBClusterSnapshotInput{
 		DBClusterIdentifier:         aws.String(d.Get("db_cluster_identifier").(string)),
@@ -120,7 +120,7 @@ func resourceAwsDocDBClusterSnapshotCreate(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsDocDBClusterSnapshotRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).docdbconn
+	conn := meta.(*AWSClient).docdbconn()
 
 	params := &docdb.DescribeDBClusterSnapshotsInput{
 		DBClusterSnapshotIdentifier: aws.String(d.Id()),
@@ -163,7 +163,7 @@ func resourceAwsDocDBClusterSnapshotRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsDocDBClusterSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).docdbconn
+	conn := meta.(*AWSClient).docdbconn()
 
 	params := &docdb.DeleteDBClusterSnapshotInput{
 		DBClusterSnapshotIdentifier: aws.String(d.Id()),
@@ -400,7 +400,7 @@ func testAccCheckDocDBClusterDestroy(s *terraform.State) error {
 }
 
 func testAccCheckDocDBClusterDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
-	conn := provider.Meta().(*AWSClient).docdbconn
+	conn := provider.Meta().(*AWSClient).docdbconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_docdb_cluster" {
@@ -450,7 +450,7 @@ func testAccCheckDocDBClusterExistsWithProvider(n string, v *docdb.DBCluster, pr
 		}
 
 		provider := providerF()
-		conn := provider.Meta().(*AWSClient).docdbconn
+		conn := provider.Meta().(*AWSClient).docdbconn()
 		resp, err := conn.DescribeDBClusters(&docdb.DescribeDBClustersInput{
 			DBClusterIdentifier: aws.String(rs.Primary.ID),
 		})
@@ -491,7 +491,7 @@ func testAccCheckDocDBClusterSnapshot(rInt int) resource.TestCheckFunc {
 			snapshot_identifier := fmt.Sprintf("tf-acctest-docdbcluster-snapshot-%d", rInt)
 
 			awsClient := testAccProvider.Meta().(*AWSClient)
-			conn := awsClient.docdbconn
+			conn := awsClient.docdbconn()
 
 			log.Printf("[INFO] Deleting the Snapshot %s", snapshot_identifier)
 			_, snapDeleteErr := conn.DeleteDBClusterSnapshot(
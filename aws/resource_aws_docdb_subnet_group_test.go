@@ -156,7 +156,7 @@ func TestAccAWSDocDBSubnetGroup_updateDescription(t *testing.T) {
 }
 
 func testAccCheckDocDBSubnetGroupDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).docdbconn
+	conn := testAccProvider.Meta().(*AWSClient).docdbconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_docdb_subnet_group" {
@@ -188,7 +188,7 @@ func testAccCheckDocDBSubnetGroupDestroy(s *terraform.State) error {
 func testAccCheckAWSDocDBSubnetGroupDisappears(group *docdb.DBSubnetGroup) resource.TestCheckFunc {
 
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).docdbconn
+		conn := testAccProvider.Meta().(*AWSClient).docdbconn()
 
 		params := &docdb.DeleteDBSubnetGroupInput{
 			DBSubnetGroupName: group.DBSubnetGroupName,
@@ -214,7 +214,7 @@ func testAccCheckDocDBSubnetGroupExists(n string, v *docdb.DBSubnetGroup) resour
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).docdbconn
+		conn := testAccProvider.Meta().(*AWSClient).docdbconn()
 		resp, err := conn.DescribeDBSubnetGroups(
 			&docdb.DescribeDBSubnetGroupsInput{DBSubnetGroupName: aws.String(rs.Primary.ID)})
 		if err != nil {
@@ -61,7 +61,7 @@ func resourceAwsDxConnection() *schema.Resource {
 }
 
 func resourceAwsDxConnectionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).dxconn()
 
 	req := &directconnect.CreateConnectionInput{
 		Bandwidth:      aws.String(d.Get("bandwidth").(string)),
@@ -80,7 +80,7 @@ func resourceAwsDxConnectionCreate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsDxConnectionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).dxconn()
 
 	resp, err := conn.DescribeConnections(&directconnect.DescribeConnectionsInput{
 		ConnectionId: aws.String(d.Id()),
@@ -132,7 +132,7 @@ func resourceAwsDxConnectionRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsDxConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).dxconn()
 
 	arn := arn.ARN{
 		Partition: meta.(*AWSClient).partition,
@@ -149,7 +149,7 @@ func resourceAwsDxConnectionUpdate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsDxConnectionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).dxconn()
 
 	log.Printf("[DEBUG] Deleting Direct Connect connection: %s", d.Id())
 	_, err := conn.DeleteConnection(&directconnect.DeleteConnectionInput{
@@ -45,7 +45,7 @@ func TestAccAWSDxConnectionAssociation_multiConns(t *testing.T) {
 }
 
 func testAccCheckAwsDxConnectionAssociationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).dxconn
+	conn := testAccProvider.Meta().(*AWSClient).dxconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_dx_connection_association" {
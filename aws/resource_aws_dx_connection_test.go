@@ -85,7 +85,7 @@ func TestAccAWSDxConnection_tags(t *testing.T) {
 }
 
 func testAccCheckAwsDxConnectionDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).dxconn
+	conn := testAccProvider.Meta().(*AWSClient).dxconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_dx_connection" {
@@ -43,7 +43,7 @@ func resourceAwsDxGateway() *schema.Resource {
 }
 
 func resourceAwsDxGatewayCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).dxconn()
 
 	req := &directconnect.CreateDirectConnectGatewayInput{
 		DirectConnectGatewayName: aws.String(d.Get("name").(string)),
@@ -81,7 +81,7 @@ func resourceAwsDxGatewayCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsDxGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).dxconn()
 
 	dxGwRaw, state, err := dxGatewayStateRefresh(conn, d.Id())()
 	if err != nil {
@@ -101,7 +101,7 @@ func resourceAwsDxGatewayRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsDxGatewayDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).dxconn()
 
 	_, err := conn.DeleteDirectConnectGateway(&directconnect.DeleteDirectConnectGatewayInput{
 		DirectConnectGatewayId: aws.String(d.Id()),
@@ -42,7 +42,7 @@ func resourceAwsDxGatewayAssociation() *schema.Resource {
 }
 
 func resourceAwsDxGatewayAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).dxconn()
 
 	dxgwId := d.Get("dx_gateway_id").(string)
 	vgwId := d.Get("vpn_gateway_id").(string)
@@ -76,7 +76,7 @@ func resourceAwsDxGatewayAssociationCreate(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsDxGatewayAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).dxconn()
 
 	dxgwId := d.Get("dx_gateway_id").(string)
 	vgwId := d.Get("vpn_gateway_id").(string)
@@ -94,7 +94,7 @@ func resourceAwsDxGatewayAssociationRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsDxGatewayAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).dxconn()
 
 	dxgwId := d.Get("dx_gateway_id").(string)
 	vgwId := d.Get("vpn_gateway_id").(string)
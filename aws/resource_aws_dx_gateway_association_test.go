@@ -25,7 +25,7 @@ func testSweepDirectConnectGatewayAssociations(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).dxconn
+	conn := client.(*AWSClient).dxconn()
 	gatewayInput := &directconnect.DescribeDirectConnectGatewaysInput{}
 
 	for {
@@ -135,7 +135,7 @@ func TestAccAwsDxGatewayAssociation_multiVgws(t *testing.T) {
 }
 
 func testAccCheckAwsDxGatewayAssociationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).dxconn
+	conn := testAccProvider.Meta().(*AWSClient).dxconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_dx_gateway_association" {
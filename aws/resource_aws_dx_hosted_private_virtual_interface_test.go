@@ -99,7 +99,7 @@ func TestAccAwsDxHostedPrivateVirtualInterface_mtuUpdate(t *testing.T) {
 }
 
 func testAccCheckAwsDxHostedPrivateVirtualInterfaceDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).dxconn
+	conn := testAccProvider.Meta().(*AWSClient).dxconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_dx_hosted_private_virtual_interface" {
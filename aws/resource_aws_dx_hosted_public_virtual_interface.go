@@ -98,7 +98,7 @@ func resourceAwsDxHostedPublicVirtualInterface() *schema.Resource {
 }
 
 func resourceAwsDxHostedPublicVirtualInterfaceCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).dxconn()
 
 	addressFamily := d.Get("address_family").(string)
 	caRaw, caOk := d.GetOk("customer_address")
@@ -159,7 +159,7 @@ func resourceAwsDxHostedPublicVirtualInterfaceCreate(d *schema.ResourceData, met
 }
 
 func resourceAwsDxHostedPublicVirtualInterfaceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).dxconn()
 
 	vif, err := dxVirtualInterfaceRead(d.Id(), conn)
 	if err != nil {
@@ -50,7 +50,7 @@ func TestAccAwsDxHostedPublicVirtualInterface_basic(t *testing.T) {
 }
 
 func testAccCheckAwsDxHostedPublicVirtualInterfaceDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).dxconn
+	conn := testAccProvider.Meta().(*AWSClient).dxconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_dx_hosted_public_virtual_interface" {
@@ -68,7 +68,7 @@ func resourceAwsDxLag() *schema.Resource {
 }
 
 func resourceAwsDxLagCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).dxconn()
 
 	req := &directconnect.CreateLagInput{
 		ConnectionsBandwidth: aws.String(d.Get("connections_bandwidth").(string)),
@@ -100,7 +100,7 @@ func resourceAwsDxLagCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsDxLagRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).dxconn()
 
 	resp, err := conn.DescribeLags(&directconnect.DescribeLagsInput{
 		LagId: aws.String(d.Id()),
@@ -152,7 +152,7 @@ func resourceAwsDxLagRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsDxLagUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).dxconn()
 
 	d.Partial(true)
 
@@ -190,7 +190,7 @@ func resourceAwsDxLagUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsDxLagDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).dxconn()
 
 	if d.Get("force_destroy").(bool) {
 		resp, err := conn.DescribeLags(&directconnect.DescribeLagsInput{
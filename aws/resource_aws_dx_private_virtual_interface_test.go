@@ -126,7 +126,7 @@ func TestAccAwsDxPrivateVirtualInterface_mtuUpdate(t *testing.T) {
 }
 
 func testAccCheckAwsDxPrivateVirtualInterfaceDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).dxconn
+	conn := testAccProvider.Meta().(*AWSClient).dxconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_dx_private_virtual_interface" {
@@ -55,7 +55,7 @@ func TestAccAwsDxPublicVirtualInterface_basic(t *testing.T) {
 }
 
 func testAccCheckAwsDxPublicVirtualInterfaceDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).dxconn
+	conn := testAccProvider.Meta().(*AWSClient).dxconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_dx_public_virtual_interface" {
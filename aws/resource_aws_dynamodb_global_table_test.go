@@ -112,7 +112,7 @@ func TestAccAWSDynamoDbGlobalTable_import(t *testing.T) {
 }
 
 func testAccCheckAwsDynamoDbGlobalTableDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).dynamodbconn
+	conn := testAccProvider.Meta().(*AWSClient).dynamodbconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_dynamodb_global_table" {
@@ -278,7 +278,7 @@ func resourceAwsDynamoDbTable() *schema.Resource {
 }
 
 func resourceAwsDynamoDbTableCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dynamodbconn
+	conn := meta.(*AWSClient).dynamodbconn()
 
 	keySchemaMap := map[string]interface{}{
 		"hash_key": d.Get("hash_key").(string),
@@ -406,7 +406,7 @@ func resourceAwsDynamoDbTableCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsDynamoDbTableUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dynamodbconn
+	conn := meta.(*AWSClient).dynamodbconn()
 	billingMode := d.Get("billing_mode").(string)
 
 	// Global Secondary Index operations must occur in multiple phases
@@ -551,7 +551,7 @@ func resourceAwsDynamoDbTableUpdate(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		if err := setTagsDynamoDb(conn, d); err != nil {
 			return fmt.Errorf("error updating DynamoDB Table (%s) tags: %s", d.Id(), err)
 		}
@@ -567,7 +567,7 @@ func resourceAwsDynamoDbTableUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsDynamoDbTableRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dynamodbconn
+	conn := meta.(*AWSClient).dynamodbconn()
 
 	result, err := conn.DescribeTable(&dynamodb.DescribeTableInput{
 		TableName: aws.String(d.Id()),
@@ -615,7 +615,7 @@ func resourceAwsDynamoDbTableRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsDynamoDbTableDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dynamodbconn
+	conn := meta.(*AWSClient).dynamodbconn()
 
 	log.Printf("[DEBUG] DynamoDB delete table: %s", d.Id())
 
@@ -52,7 +52,7 @@ func validateDynamoDbTableItem(v interface{}, k string) (ws []string, errors []e
 }
 
 func resourceAwsDynamoDbTableItemCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dynamodbconn
+	conn := meta.(*AWSClient).dynamodbconn()
 
 	tableName := d.Get("table_name").(string)
 	hashKey := d.Get("hash_key").(string)
@@ -88,7 +88,7 @@ func resourceAwsDynamoDbTableItemCreate(d *schema.ResourceData, meta interface{}
 
 func resourceAwsDynamoDbTableItemUpdate(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[DEBUG] Updating DynamoDB table %s", d.Id())
-	conn := meta.(*AWSClient).dynamodbconn
+	conn := meta.(*AWSClient).dynamodbconn()
 
 	if d.HasChange("item") {
 		tableName := d.Get("table_name").(string)
@@ -153,7 +153,7 @@ func resourceAwsDynamoDbTableItemUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsDynamoDbTableItemRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dynamodbconn
+	conn := meta.(*AWSClient).dynamodbconn()
 
 	log.Printf("[DEBUG] Loading data for DynamoDB table item '%s'", d.Id())
 
@@ -203,7 +203,7 @@ func resourceAwsDynamoDbTableItemRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsDynamoDbTableItemDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dynamodbconn
+	conn := meta.(*AWSClient).dynamodbconn()
 
 	attributes, err := expandDynamoDbTableItemAttributes(d.Get("item").(string))
 	if err != nil {
@@ -27,7 +27,7 @@ func testSweepDynamoDbTables(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).dynamodbconn
+	conn := client.(*AWSClient).dynamodbconn()
 
 	err = conn.ListTablesPages(&dynamodb.ListTablesInput{}, func(out *dynamodb.ListTablesOutput, lastPage bool) bool {
 		for _, tableName := range out.TableNames {
@@ -1015,7 +1015,7 @@ func TestAccAWSDynamoDbTable_encryption(t *testing.T) {
 }
 
 func testAccCheckAWSDynamoDbTableDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).dynamodbconn
+	conn := testAccProvider.Meta().(*AWSClient).dynamodbconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_dynamodb_table" {
@@ -1056,7 +1056,7 @@ func testAccCheckInitialAWSDynamoDbTableExists(n string, table *dynamodb.Describ
 			return fmt.Errorf("No DynamoDB table name specified!")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).dynamodbconn
+		conn := testAccProvider.Meta().(*AWSClient).dynamodbconn()
 
 		params := &dynamodb.DescribeTableInput{
 			TableName: aws.String(rs.Primary.ID),
@@ -1086,7 +1086,7 @@ func testAccCheckInitialAWSDynamoDbTableConf(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No DynamoDB table name specified!")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).dynamodbconn
+		conn := testAccProvider.Meta().(*AWSClient).dynamodbconn()
 
 		params := &dynamodb.DescribeTableInput{
 			TableName: aws.String(rs.Primary.ID),
@@ -1163,7 +1163,7 @@ func testAccCheckDynamoDbTableHasPointInTimeRecoveryEnabled(n string) resource.T
 			return fmt.Errorf("No DynamoDB table name specified!")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).dynamodbconn
+		conn := testAccProvider.Meta().(*AWSClient).dynamodbconn()
 
 		resp, err := conn.DescribeContinuousBackups(&dynamodb.DescribeContinuousBackupsInput{
 			TableName: aws.String(rs.Primary.ID),
@@ -1194,7 +1194,7 @@ func testAccCheckDynamoDbTableHasBilling_PayPerRequest(n string) resource.TestCh
 			return fmt.Errorf("No DynamoDB table name specified!")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).dynamodbconn
+		conn := testAccProvider.Meta().(*AWSClient).dynamodbconn()
 		params := &dynamodb.DescribeTableInput{
 			TableName: aws.String(rs.Primary.ID),
 		}
@@ -1227,7 +1227,7 @@ func testAccCheckDynamoDbTableHasBilling_Provisioned(n string) resource.TestChec
 			return fmt.Errorf("No DynamoDB table name specified!")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).dynamodbconn
+		conn := testAccProvider.Meta().(*AWSClient).dynamodbconn()
 		params := &dynamodb.DescribeTableInput{
 			TableName: aws.String(rs.Primary.ID),
 		}
@@ -1259,7 +1259,7 @@ func testAccCheckDynamoDbTableWasUpdated(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No DynamoDB table name specified!")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).dynamodbconn
+		conn := testAccProvider.Meta().(*AWSClient).dynamodbconn()
 
 		params := &dynamodb.DescribeTableInput{
 			TableName: aws.String(rs.Primary.ID),
@@ -1315,7 +1315,7 @@ func testAccCheckDynamoDbTableWasUpdated(n string) resource.TestCheckFunc {
 
 func testAccCheckAWSDynamoDbTableDisappears(table *dynamodb.DescribeTableOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).dynamodbconn
+		conn := testAccProvider.Meta().(*AWSClient).dynamodbconn()
 		tableName := aws.StringValue(table.Table.TableName)
 
 		input := &dynamodb.DeleteTableInput{
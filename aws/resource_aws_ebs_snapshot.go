@@ -64,7 +64,7 @@ func resourceAwsEbsSnapshot() *schema.Resource {
 }
 
 func resourceAwsEbsSnapshotCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	request := &ec2.CreateSnapshotInput{
 		VolumeId: aws.String(d.Get("volume_id").(string)),
@@ -108,7 +108,7 @@ func resourceAwsEbsSnapshotCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsEbsSnapshotRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	req := &ec2.DescribeSnapshotsInput{
 		SnapshotIds: []*string{aws.String(d.Id())},
@@ -147,7 +147,7 @@ func resourceAwsEbsSnapshotRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsEbsSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	return resource.Retry(5*time.Minute, func() *resource.RetryError {
 		request := &ec2.DeleteSnapshotInput{
@@ -74,7 +74,7 @@ func resourceAwsEbsSnapshotCopy() *schema.Resource {
 }
 
 func resourceAwsEbsSnapshotCopyCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	request := &ec2.CopySnapshotInput{
 		SourceRegion:     aws.String(d.Get("source_region").(string)),
@@ -110,7 +110,7 @@ func resourceAwsEbsSnapshotCopyCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsEbsSnapshotCopyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	req := &ec2.DescribeSnapshotsInput{
 		SnapshotIds: []*string{aws.String(d.Id())},
@@ -141,7 +141,7 @@ func resourceAwsEbsSnapshotCopyRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsEbsSnapshotCopyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	return resource.Retry(5*time.Minute, func() *resource.RetryError {
 		request := &ec2.DeleteSnapshotInput{
@@ -115,7 +115,7 @@ func testAccCheckEbsSnapshotCopyExistsWithProviders(n string, v *ec2.Snapshot, p
 				continue
 			}
 
-			conn := provider.Meta().(*AWSClient).ec2conn
+			conn := provider.Meta().(*AWSClient).ec2conn()
 
 			request := &ec2.DescribeSnapshotsInput{
 				SnapshotIds: []*string{aws.String(rs.Primary.ID)},
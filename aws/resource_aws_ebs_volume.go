@@ -74,7 +74,7 @@ func resourceAwsEbsVolume() *schema.Resource {
 }
 
 func resourceAwsEbsVolumeCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	request := &ec2.CreateVolumeInput{
 		AvailabilityZone: aws.String(d.Get("availability_zone").(string)),
@@ -150,7 +150,7 @@ func resourceAwsEbsVolumeCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAWSEbsVolumeUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	if _, ok := d.GetOk("tags"); ok {
 		if err := setTags(conn, d); err != nil {
 			return fmt.Errorf("Error updating tags for EBS Volume: %s", err)
@@ -229,7 +229,7 @@ func volumeStateRefreshFunc(conn *ec2.EC2, volumeID string) resource.StateRefres
 }
 
 func resourceAwsEbsVolumeRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	request := &ec2.DescribeVolumesInput{
 		VolumeIds: []*string{aws.String(d.Id())},
@@ -248,7 +248,7 @@ func resourceAwsEbsVolumeRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsEbsVolumeDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	return resource.Retry(5*time.Minute, func() *resource.RetryError {
 		request := &ec2.DeleteVolumeInput{
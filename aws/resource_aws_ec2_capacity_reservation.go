@@ -103,7 +103,7 @@ func resourceAwsEc2CapacityReservation() *schema.Resource {
 }
 
 func resourceAwsEc2CapacityReservationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	opts := &ec2.CreateCapacityReservationInput{
 		AvailabilityZone: aws.String(d.Get("availability_zone").(string)),
@@ -158,7 +158,7 @@ func resourceAwsEc2CapacityReservationCreate(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsEc2CapacityReservationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	resp, err := conn.DescribeCapacityReservations(&ec2.DescribeCapacityReservationsInput{
 		CapacityReservationIds: []*string{aws.String(d.Id())},
@@ -207,11 +207,11 @@ func resourceAwsEc2CapacityReservationRead(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsEc2CapacityReservationUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	d.Partial(true)
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		if err := setTags(conn, d); err != nil {
 			return err
 		} else {
@@ -245,7 +245,7 @@ func resourceAwsEc2CapacityReservationUpdate(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsEc2CapacityReservationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	opts := &ec2.CancelCapacityReservationInput{
 		CapacityReservationId: aws.String(d.Id()),
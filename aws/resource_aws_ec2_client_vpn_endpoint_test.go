@@ -163,7 +163,7 @@ func TestAccAwsEc2ClientVpnEndpoint_tags(t *testing.T) {
 }
 
 func testAccCheckAwsEc2ClientVpnEndpointDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_client_vpn_endpoint" {
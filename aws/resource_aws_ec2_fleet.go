@@ -302,7 +302,7 @@ func resourceAwsEc2Fleet() *schema.Resource {
 }
 
 func resourceAwsEc2FleetCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	input := &ec2.CreateFleetInput{
 		ExcessCapacityTerminationPolicy:  aws.String(d.Get("excess_capacity_termination_policy").(string)),
@@ -353,7 +353,7 @@ func resourceAwsEc2FleetCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsEc2FleetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	input := &ec2.DescribeFleetsInput{
 		FleetIds: []*string{aws.String(d.Id())},
@@ -443,7 +443,7 @@ func resourceAwsEc2FleetRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsEc2FleetUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	input := &ec2.ModifyFleetInput{
 		ExcessCapacityTerminationPolicy: aws.String(d.Get("excess_capacity_termination_policy").(string)),
@@ -479,7 +479,7 @@ func resourceAwsEc2FleetUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsEc2FleetDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	input := &ec2.DeleteFleetsInput{
 		FleetIds:           []*string{aws.String(d.Id())},
@@ -998,7 +998,7 @@ func testAccCheckAWSEc2FleetExists(resourceName string, fleet *ec2.FleetData) re
 			return fmt.Errorf("No EC2 Fleet ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		input := &ec2.DescribeFleetsInput{
 			FleetIds: []*string{aws.String(rs.Primary.ID)},
@@ -1034,7 +1034,7 @@ func testAccCheckAWSEc2FleetExists(resourceName string, fleet *ec2.FleetData) re
 }
 
 func testAccCheckAWSEc2FleetDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ec2_fleet" {
@@ -1089,7 +1089,7 @@ func testAccCheckAWSEc2FleetDestroy(s *terraform.State) error {
 
 func testAccCheckAWSEc2FleetDisappears(fleet *ec2.FleetData) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		input := &ec2.DeleteFleetsInput{
 			FleetIds:           []*string{fleet.FleetId},
@@ -1123,7 +1123,7 @@ func testAccCheckAWSEc2FleetRecreated(i, j *ec2.FleetData) resource.TestCheckFun
 }
 
 func testAccPreCheckAWSEc2Fleet(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	input := &ec2.DescribeFleetsInput{
 		MaxResults: aws.Int64(1),
@@ -110,7 +110,7 @@ func resourceAwsEc2TransitGateway() *schema.Resource {
 }
 
 func resourceAwsEc2TransitGatewayCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	input := &ec2.CreateTransitGatewayInput{
 		Options: &ec2.TransitGatewayRequestOptions{
@@ -147,7 +147,7 @@ func resourceAwsEc2TransitGatewayCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsEc2TransitGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	transitGateway, err := ec2DescribeTransitGateway(conn, d.Id())
 
@@ -198,7 +198,7 @@ func resourceAwsEc2TransitGatewayRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsEc2TransitGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	if err := setTags(conn, d); err != nil {
 		return fmt.Errorf("error updating EC2 Transit Gateway (%s) tags: %s", d.Id(), err)
@@ -208,7 +208,7 @@ func resourceAwsEc2TransitGatewayUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsEc2TransitGatewayDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	input := &ec2.DeleteTransitGatewayInput{
 		TransitGatewayId: aws.String(d.Id()),
@@ -45,7 +45,7 @@ func resourceAwsEc2TransitGatewayRoute() *schema.Resource {
 }
 
 func resourceAwsEc2TransitGatewayRouteCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	destination := d.Get("destination_cidr_block").(string)
 	transitGatewayRouteTableID := d.Get("transit_gateway_route_table_id").(string)
@@ -68,7 +68,7 @@ func resourceAwsEc2TransitGatewayRouteCreate(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsEc2TransitGatewayRouteRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	transitGatewayRouteTableID, destination, err := decodeEc2TransitGatewayRouteID(d.Id())
 	if err != nil {
@@ -134,7 +134,7 @@ func resourceAwsEc2TransitGatewayRouteRead(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsEc2TransitGatewayRouteDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	transitGatewayRouteTableID, destination, err := decodeEc2TransitGatewayRouteID(d.Id())
 	if err != nil {
@@ -141,7 +141,7 @@ func testAccCheckAWSEc2TransitGatewayRouteTableExists(resourceName string, trans
 			return fmt.Errorf("No EC2 Transit Gateway Route Table ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		routeTable, err := ec2DescribeTransitGatewayRouteTable(conn, rs.Primary.ID)
 
@@ -164,7 +164,7 @@ func testAccCheckAWSEc2TransitGatewayRouteTableExists(resourceName string, trans
 }
 
 func testAccCheckAWSEc2TransitGatewayRouteTableDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ec2_transit_gateway_route_table" {
@@ -195,7 +195,7 @@ func testAccCheckAWSEc2TransitGatewayRouteTableDestroy(s *terraform.State) error
 
 func testAccCheckAWSEc2TransitGatewayRouteTableDisappears(transitGatewayRouteTable *ec2.TransitGatewayRouteTable) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		input := &ec2.DeleteTransitGatewayRouteTableInput{
 			TransitGatewayRouteTableId: transitGatewayRouteTable.TransitGatewayRouteTableId,
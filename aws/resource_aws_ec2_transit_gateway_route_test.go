@@ -106,7 +106,7 @@ func testAccCheckAWSEc2TransitGatewayRouteExists(resourceName string, transitGat
 			return err
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		route, err := ec2DescribeTransitGatewayRoute(conn, transitGatewayRouteTableID, destination)
 
@@ -125,7 +125,7 @@ func testAccCheckAWSEc2TransitGatewayRouteExists(resourceName string, transitGat
 }
 
 func testAccCheckAWSEc2TransitGatewayRouteDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ec2_transit_gateway_route" {
@@ -160,7 +160,7 @@ func testAccCheckAWSEc2TransitGatewayRouteDestroy(s *terraform.State) error {
 
 func testAccCheckAWSEc2TransitGatewayRouteDisappears(transitGateway *ec2.TransitGateway, transitGatewayRoute *ec2.TransitGatewayRoute) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		input := &ec2.DeleteTransitGatewayRouteInput{
 			DestinationCidrBlock:       transitGatewayRoute.DestinationCidrBlock,
@@ -54,7 +54,7 @@ func TestAccAWSEcrLifecyclePolicy_import(t *testing.T) {
 }
 
 func testAccCheckAWSEcrLifecyclePolicyDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ecrconn
+	conn := testAccProvider.Meta().(*AWSClient).ecrconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ecr_lifecycle_policy" {
@@ -87,7 +87,7 @@ func testAccCheckAWSEcrLifecyclePolicyExists(name string) resource.TestCheckFunc
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ecrconn
+		conn := testAccProvider.Meta().(*AWSClient).ecrconn()
 
 		input := &ecr.GetLifecyclePolicyInput{
 			RepositoryName: aws.String(rs.Primary.ID),
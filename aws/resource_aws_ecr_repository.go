@@ -50,7 +50,7 @@ func resourceAwsEcrRepository() *schema.Resource {
 }
 
 func resourceAwsEcrRepositoryCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ecrconn
+	conn := meta.(*AWSClient).ecrconn()
 
 	input := ecr.CreateRepositoryInput{
 		RepositoryName: aws.String(d.Get("name").(string)),
@@ -78,7 +78,7 @@ func resourceAwsEcrRepositoryCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsEcrRepositoryRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ecrconn
+	conn := meta.(*AWSClient).ecrconn()
 
 	log.Printf("[DEBUG] Reading ECR repository %s", d.Id())
 	var out *ecr.DescribeRepositoriesOutput
@@ -123,7 +123,7 @@ func resourceAwsEcrRepositoryRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsEcrRepositoryUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ecrconn
+	conn := meta.(*AWSClient).ecrconn()
 
 	if err := setTagsECR(conn, d); err != nil {
 		return fmt.Errorf("error setting ECR repository tags: %s", err)
@@ -133,7 +133,7 @@ func resourceAwsEcrRepositoryUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsEcrRepositoryDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ecrconn
+	conn := meta.(*AWSClient).ecrconn()
 
 	_, err := conn.DeleteRepository(&ecr.DeleteRepositoryInput{
 		RepositoryName: aws.String(d.Id()),
@@ -71,7 +71,7 @@ func TestAccAWSEcrRepository_tags(t *testing.T) {
 }
 
 func testAccCheckAWSEcrRepositoryDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ecrconn
+	conn := testAccProvider.Meta().(*AWSClient).ecrconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ecr_repository" {
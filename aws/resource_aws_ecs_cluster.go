@@ -51,7 +51,7 @@ func resourceAwsEcsClusterImport(d *schema.ResourceData, meta interface{}) ([]*s
 }
 
 func resourceAwsEcsClusterCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ecsconn
+	conn := meta.(*AWSClient).ecsconn()
 
 	clusterName := d.Get("name").(string)
 	log.Printf("[DEBUG] Creating ECS cluster %s", clusterName)
@@ -71,7 +71,7 @@ func resourceAwsEcsClusterCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsEcsClusterRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ecsconn
+	conn := meta.(*AWSClient).ecsconn()
 
 	input := &ecs.DescribeClustersInput{
 		Clusters: []*string{aws.String(d.Id())},
@@ -140,10 +140,10 @@ func resourceAwsEcsClusterRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsEcsClusterUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ecsconn
+	conn := meta.(*AWSClient).ecsconn()
 
-	if d.HasChange("tags") {
-		oldTagsRaw, newTagsRaw := d.GetChange("tags")
+	if hasTagsChange(d) {
+		oldTagsRaw, newTagsRaw := getTagsChange(d)
 		oldTagsMap := oldTagsRaw.(map[string]interface{})
 		newTagsMap := newTagsRaw.(map[string]interface{})
 		createTags, removeTags := diffTagsECS(tagsFromMapECS(oldTagsMap), tagsFromMapECS(newTagsMap))
@@ -182,7 +182,7 @@ func resourceAwsEcsClusterUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsEcsClusterDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ecsconn
+	conn := meta.(*AWSClient).ecsconn()
 
 	log.Printf("[DEBUG] Deleting ECS cluster %s", d.Id())
 
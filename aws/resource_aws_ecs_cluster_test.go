@@ -27,7 +27,7 @@ func testSweepEcsClusters(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).ecsconn
+	conn := client.(*AWSClient).ecsconn()
 
 	err = conn.ListClustersPages(&ecs.ListClustersInput{}, func(page *ecs.ListClustersOutput, isLast bool) bool {
 		if page == nil {
@@ -158,7 +158,7 @@ func TestAccAWSEcsCluster_Tags(t *testing.T) {
 }
 
 func testAccCheckAWSEcsClusterDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ecsconn
+	conn := testAccProvider.Meta().(*AWSClient).ecsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ecs_cluster" {
@@ -190,7 +190,7 @@ func testAccCheckAWSEcsClusterExists(resourceName string, cluster *ecs.Cluster)
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ecsconn
+		conn := testAccProvider.Meta().(*AWSClient).ecsconn()
 
 		input := &ecs.DescribeClustersInput{
 			Clusters: []*string{aws.String(rs.Primary.ID)},
@@ -216,7 +216,7 @@ func testAccCheckAWSEcsClusterExists(resourceName string, cluster *ecs.Cluster)
 
 func testAccCheckAWSEcsClusterDisappears(cluster *ecs.Cluster) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).ecsconn
+		conn := testAccProvider.Meta().(*AWSClient).ecsconn()
 
 		input := &ecs.DeleteClusterInput{
 			Cluster: cluster.ClusterArn,
@@ -353,7 +353,7 @@ func resourceAwsEcsServiceImport(d *schema.ResourceData, meta interface{}) ([]*s
 }
 
 func resourceAwsEcsServiceCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ecsconn
+	conn := meta.(*AWSClient).ecsconn()
 
 	deploymentMinimumHealthyPercent := d.Get("deployment_minimum_healthy_percent").(int)
 	schedulingStrategy := d.Get("scheduling_strategy").(string)
@@ -500,7 +500,7 @@ func resourceAwsEcsServiceCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsEcsServiceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ecsconn
+	conn := meta.(*AWSClient).ecsconn()
 
 	log.Printf("[DEBUG] Reading ECS service %s", d.Id())
 	input := ecs.DescribeServicesInput{
@@ -782,7 +782,7 @@ func flattenServiceRegistries(srs []*ecs.ServiceRegistry) []map[string]interface
 }
 
 func resourceAwsEcsServiceUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ecsconn
+	conn := meta.(*AWSClient).ecsconn()
 	updateService := false
 
 	input := ecs.UpdateServiceInput{
@@ -857,8 +857,8 @@ func resourceAwsEcsServiceUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	if d.HasChange("tags") {
-		oldTagsRaw, newTagsRaw := d.GetChange("tags")
+	if hasTagsChange(d) {
+		oldTagsRaw, newTagsRaw := getTagsChange(d)
 		oldTagsMap := oldTagsRaw.(map[string]interface{})
 		newTagsMap := newTagsRaw.(map[string]interface{})
 		createTags, removeTags := diffTagsECS(tagsFromMapECS(oldTagsMap), tagsFromMapECS(newTagsMap))
@@ -897,7 +897,7 @@ func resourceAwsEcsServiceUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsEcsServiceDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ecsconn
+	conn := meta.(*AWSClient).ecsconn()
 
 	// Check if it's not already gone
 	resp, err := conn.DescribeServices(&ecs.DescribeServicesInput{
@@ -27,7 +27,7 @@ func testSweepEcsServices(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).ecsconn
+	conn := client.(*AWSClient).ecsconn()
 
 	err = conn.ListClustersPages(&ecs.ListClustersInput{}, func(page *ecs.ListClustersOutput, isLast bool) bool {
 		if page == nil {
@@ -993,7 +993,7 @@ func TestAccAWSEcsService_PropagateTags(t *testing.T) {
 }
 
 func testAccCheckAWSEcsServiceDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ecsconn
+	conn := testAccProvider.Meta().(*AWSClient).ecsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ecs_service" {
@@ -1035,7 +1035,7 @@ func testAccCheckAWSEcsServiceExists(name string, service *ecs.Service) resource
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ecsconn
+		conn := testAccProvider.Meta().(*AWSClient).ecsconn()
 
 		input := &ecs.DescribeServicesInput{
 			Cluster:  aws.String(rs.Primary.Attributes["cluster"]),
@@ -1075,7 +1075,7 @@ func testAccCheckAWSEcsServiceExists(name string, service *ecs.Service) resource
 
 func testAccCheckAWSEcsServiceDisappears(service *ecs.Service) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).ecsconn
+		conn := testAccProvider.Meta().(*AWSClient).ecsconn()
 
 		input := &ecs.DeleteServiceInput{
 			Cluster: service.ClusterArn,
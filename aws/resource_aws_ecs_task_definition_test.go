@@ -434,7 +434,7 @@ func TestAccAWSEcsTaskDefinition_Inactive(t *testing.T) {
 	tdName := fmt.Sprintf("tf_acc_td_basic_%s", rString)
 
 	markTaskDefinitionInactive := func() {
-		conn := testAccProvider.Meta().(*AWSClient).ecsconn
+		conn := testAccProvider.Meta().(*AWSClient).ecsconn()
 
 		_, err := conn.DeregisterTaskDefinition(&ecs.DeregisterTaskDefinitionInput{
 			TaskDefinition: aws.String(fmt.Sprintf("%s:1", tdName)),
@@ -545,7 +545,7 @@ func TestValidateAwsEcsTaskDefinitionContainerDefinitions(t *testing.T) {
 }
 
 func testAccCheckAWSEcsTaskDefinitionDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ecsconn
+	conn := testAccProvider.Meta().(*AWSClient).ecsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ecs_task_definition" {
@@ -577,7 +577,7 @@ func testAccCheckAWSEcsTaskDefinitionExists(name string, def *ecs.TaskDefinition
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ecsconn
+		conn := testAccProvider.Meta().(*AWSClient).ecsconn()
 
 		out, err := conn.DescribeTaskDefinition(&ecs.DescribeTaskDefinitionInput{
 			TaskDefinition: aws.String(rs.Primary.Attributes["arn"]),
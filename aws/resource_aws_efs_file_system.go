@@ -98,7 +98,7 @@ func resourceAwsEfsFileSystem() *schema.Resource {
 }
 
 func resourceAwsEfsFileSystemCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).efsconn
+	conn := meta.(*AWSClient).efsconn()
 
 	creationToken := ""
 	if v, ok := d.GetOk("creation_token"); ok {
@@ -169,7 +169,7 @@ func resourceAwsEfsFileSystemCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsEfsFileSystemUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).efsconn
+	conn := meta.(*AWSClient).efsconn()
 
 	if d.HasChange("provisioned_throughput_in_mibps") || d.HasChange("throughput_mode") {
 		throughputMode := d.Get("throughput_mode").(string)
@@ -203,7 +203,7 @@ func resourceAwsEfsFileSystemUpdate(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		err := setTagsEFS(conn, d)
 		if err != nil {
 			return fmt.Errorf("Error setting EC2 tags for EFS file system (%q): %s",
@@ -215,7 +215,7 @@ func resourceAwsEfsFileSystemUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsEfsFileSystemRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).efsconn
+	conn := meta.(*AWSClient).efsconn()
 
 	resp, err := conn.DescribeFileSystems(&efs.DescribeFileSystemsInput{
 		FileSystemId: aws.String(d.Id()),
@@ -301,7 +301,7 @@ func resourceAwsEfsFileSystemRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsEfsFileSystemDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).efsconn
+	conn := meta.(*AWSClient).efsconn()
 
 	log.Printf("[DEBUG] Deleting EFS file system: %s", d.Id())
 	_, err := conn.DeleteFileSystem(&efs.DeleteFileSystemInput{
@@ -262,7 +262,7 @@ func TestAccAWSEFSFileSystem_ThroughputMode(t *testing.T) {
 }
 
 func testAccCheckEfsFileSystemDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).efsconn
+	conn := testAccProvider.Meta().(*AWSClient).efsconn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_efs_file_system" {
 			continue
@@ -297,7 +297,7 @@ func testAccCheckEfsFileSystem(resourceID string) resource.TestCheckFunc {
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).efsconn
+		conn := testAccProvider.Meta().(*AWSClient).efsconn()
 		_, err := conn.DescribeFileSystems(&efs.DescribeFileSystemsInput{
 			FileSystemId: aws.String(rs.Primary.ID),
 		})
@@ -317,7 +317,7 @@ func testAccCheckEfsCreationToken(resourceID string, expectedToken string) resou
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).efsconn
+		conn := testAccProvider.Meta().(*AWSClient).efsconn()
 		resp, err := conn.DescribeFileSystems(&efs.DescribeFileSystemsInput{
 			FileSystemId: aws.String(rs.Primary.ID),
 		})
@@ -343,7 +343,7 @@ func testAccCheckEfsFileSystemTags(resourceID string, expectedTags map[string]st
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).efsconn
+		conn := testAccProvider.Meta().(*AWSClient).efsconn()
 		resp, err := conn.DescribeTags(&efs.DescribeTagsInput{
 			FileSystemId: aws.String(rs.Primary.ID),
 		})
@@ -372,7 +372,7 @@ func testAccCheckEfsFileSystemPerformanceMode(resourceID string, expectedMode st
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).efsconn
+		conn := testAccProvider.Meta().(*AWSClient).efsconn()
 		resp, err := conn.DescribeFileSystems(&efs.DescribeFileSystemsInput{
 			FileSystemId: aws.String(rs.Primary.ID),
 		})
@@ -144,7 +144,7 @@ func TestResourceAWSEFSMountTarget_hasEmptyMountTargets(t *testing.T) {
 }
 
 func testAccCheckEfsMountTargetDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).efsconn
+	conn := testAccProvider.Meta().(*AWSClient).efsconn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_efs_mount_target" {
 			continue
@@ -184,7 +184,7 @@ func testAccCheckEfsMountTarget(resourceID string, mount *efs.MountTargetDescrip
 			return fmt.Errorf("Not found: %s", resourceID)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).efsconn
+		conn := testAccProvider.Meta().(*AWSClient).efsconn()
 		mt, err := conn.DescribeMountTargets(&efs.DescribeMountTargetsInput{
 			MountTargetId: aws.String(fs.Primary.ID),
 		})
@@ -205,7 +205,7 @@ func testAccCheckEfsMountTarget(resourceID string, mount *efs.MountTargetDescrip
 
 func testAccAWSEFSMountTargetDisappears(mount *efs.MountTargetDescription) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).efsconn
+		conn := testAccProvider.Meta().(*AWSClient).efsconn()
 
 		_, err := conn.DeleteMountTarget(&efs.DeleteMountTargetInput{
 			MountTargetId: mount.MountTargetId,
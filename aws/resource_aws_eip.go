@@ -103,7 +103,7 @@ func resourceAwsEip() *schema.Resource {
 }
 
 func resourceAwsEipCreate(d *schema.ResourceData, meta interface{}) error {
-	ec2conn := meta.(*AWSClient).ec2conn
+	ec2conn := meta.(*AWSClient).ec2conn()
 
 	// By default, we're not in a VPC
 	domainOpt := ""
@@ -151,7 +151,7 @@ func resourceAwsEipCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsEipRead(d *schema.ResourceData, meta interface{}) error {
-	ec2conn := meta.(*AWSClient).ec2conn
+	ec2conn := meta.(*AWSClient).ec2conn()
 
 	domain := resourceAwsEipDomain(d)
 	id := d.Id()
@@ -275,7 +275,7 @@ func resourceAwsEipRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsEipUpdate(d *schema.ResourceData, meta interface{}) error {
-	ec2conn := meta.(*AWSClient).ec2conn
+	ec2conn := meta.(*AWSClient).ec2conn()
 
 	domain := resourceAwsEipDomain(d)
 
@@ -359,7 +359,7 @@ func resourceAwsEipUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsEipDelete(d *schema.ResourceData, meta interface{}) error {
-	ec2conn := meta.(*AWSClient).ec2conn
+	ec2conn := meta.(*AWSClient).ec2conn()
 
 	if err := resourceAwsEipRead(d, meta); err != nil {
 		return err
@@ -418,7 +418,7 @@ func resourceAwsEipDomain(d *schema.ResourceData) string {
 }
 
 func disassociateEip(d *schema.ResourceData, meta interface{}) error {
-	ec2conn := meta.(*AWSClient).ec2conn
+	ec2conn := meta.(*AWSClient).ec2conn()
 	log.Printf("[DEBUG] Disassociating EIP: %s", d.Id())
 	var err error
 	switch resourceAwsEipDomain(d) {
@@ -68,7 +68,7 @@ func resourceAwsEipAssociation() *schema.Resource {
 }
 
 func resourceAwsEipAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	request := &ec2.AssociateAddressInput{}
 
@@ -130,7 +130,7 @@ func resourceAwsEipAssociationCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsEipAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	request, err := describeAddressesById(d.Id(), meta.(*AWSClient).supportedplatforms)
 	if err != nil {
@@ -152,7 +152,7 @@ func resourceAwsEipAssociationRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsEipAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	var opts *ec2.DisassociateAddressInput
 	// We assume EC2 Classic if ID is a valid IPv4 address
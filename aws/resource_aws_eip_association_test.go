@@ -15,7 +15,7 @@ import (
 func TestAccAWSEIPAssociation_importInstance(t *testing.T) {
 	resourceName := "aws_eip_association.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccCassetteParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckAWSEIPAssociationDestroy,
@@ -35,7 +35,7 @@ func TestAccAWSEIPAssociation_importInstance(t *testing.T) {
 func TestAccAWSEIPAssociation_importNetworkInterface(t *testing.T) {
 	resourceName := "aws_eip_association.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccCassetteParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckAWSEIPAssociationDestroy,
@@ -55,7 +55,7 @@ func TestAccAWSEIPAssociation_importNetworkInterface(t *testing.T) {
 func TestAccAWSEIPAssociation_basic(t *testing.T) {
 	var a ec2.Address
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccCassetteParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckAWSEIPAssociationDestroy,
@@ -88,7 +88,7 @@ func TestAccAWSEIPAssociation_ec2Classic(t *testing.T) {
 	os.Setenv("AWS_DEFAULT_REGION", "us-east-1")
 	defer os.Setenv("AWS_DEFAULT_REGION", oldvar)
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccCassetteParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t); testAccEC2ClassicPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckAWSEIPAssociationDestroy,
@@ -111,7 +111,7 @@ func TestAccAWSEIPAssociation_spotInstance(t *testing.T) {
 	var a ec2.Address
 	rInt := acctest.RandInt()
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccCassetteParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckAWSEIPAssociationDestroy,
@@ -132,7 +132,7 @@ func TestAccAWSEIPAssociation_spotInstance(t *testing.T) {
 func TestAccAWSEIPAssociation_disappears(t *testing.T) {
 	var a ec2.Address
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccCassetteParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckAWSEIPAssociationDestroy,
@@ -154,7 +154,7 @@ func TestAccAWSEIPAssociation_disappears(t *testing.T) {
 
 func testAccCheckEIPAssociationDisappears(address *ec2.Address) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 		opts := &ec2.DisassociateAddressInput{
 			AssociationId: address.AssociationId,
 		}
@@ -174,7 +174,7 @@ func testAccCheckAWSEIPAssociationExists(name string, res *ec2.Address) resource
 			return fmt.Errorf("No EIP Association ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 		platforms := testAccProvider.Meta().(*AWSClient).supportedplatforms
 
 		request, err := describeAddressesById(rs.Primary.ID, platforms)
@@ -225,7 +225,7 @@ func testAccCheckAWSEIPAssociationDestroy(s *terraform.State) error {
 			return fmt.Errorf("No EIP Association ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		request := &ec2.DescribeAddressesInput{
 			Filters: []*ec2.Filter{
@@ -119,7 +119,7 @@ func resourceAwsEksCluster() *schema.Resource {
 }
 
 func resourceAwsEksClusterCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).eksconn
+	conn := meta.(*AWSClient).eksconn()
 	name := d.Get("name").(string)
 
 	input := &eks.CreateClusterInput{
@@ -177,7 +177,7 @@ func resourceAwsEksClusterCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsEksClusterRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).eksconn
+	conn := meta.(*AWSClient).eksconn()
 
 	input := &eks.DescribeClusterInput{
 		Name: aws.String(d.Id()),
@@ -222,7 +222,7 @@ func resourceAwsEksClusterRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsEksClusterUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).eksconn
+	conn := meta.(*AWSClient).eksconn()
 
 	if d.HasChange("version") {
 		input := &eks.UpdateClusterVersionInput{
@@ -278,7 +278,7 @@ func resourceAwsEksClusterUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsEksClusterDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).eksconn
+	conn := meta.(*AWSClient).eksconn()
 
 	log.Printf("[DEBUG] Deleting EKS Cluster: %s", d.Id())
 	err := deleteEksCluster(conn, d.Id())
@@ -27,7 +27,7 @@ func testSweepEksClusters(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).eksconn
+	conn := client.(*AWSClient).eksconn()
 
 	input := &eks.ListClustersInput{}
 	for {
@@ -276,7 +276,7 @@ func testAccCheckAWSEksClusterExists(resourceName string, cluster *eks.Cluster)
 			return fmt.Errorf("No EKS Cluster ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).eksconn
+		conn := testAccProvider.Meta().(*AWSClient).eksconn()
 		output, err := conn.DescribeCluster(&eks.DescribeClusterInput{
 			Name: aws.String(rs.Primary.ID),
 		})
@@ -304,7 +304,7 @@ func testAccCheckAWSEksClusterDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).eksconn
+		conn := testAccProvider.Meta().(*AWSClient).eksconn()
 
 		// Handle eventual consistency
 		err := resource.Retry(1*time.Minute, func() *resource.RetryError {
@@ -63,7 +63,7 @@ func resourceAwsElasticBeanstalkApplication() *schema.Resource {
 }
 
 func resourceAwsElasticBeanstalkApplicationCreate(d *schema.ResourceData, meta interface{}) error {
-	beanstalkConn := meta.(*AWSClient).elasticbeanstalkconn
+	beanstalkConn := meta.(*AWSClient).elasticbeanstalkconn()
 
 	// Get the name and description
 	name := d.Get("name").(string)
@@ -91,7 +91,7 @@ func resourceAwsElasticBeanstalkApplicationCreate(d *schema.ResourceData, meta i
 }
 
 func resourceAwsElasticBeanstalkApplicationUpdate(d *schema.ResourceData, meta interface{}) error {
-	beanstalkConn := meta.(*AWSClient).elasticbeanstalkconn
+	beanstalkConn := meta.(*AWSClient).elasticbeanstalkconn()
 
 	if d.HasChange("description") {
 		if err := resourceAwsElasticBeanstalkApplicationDescriptionUpdate(beanstalkConn, d); err != nil {
@@ -195,7 +195,7 @@ func resourceAwsElasticBeanstalkApplicationAppversionLifecycleUpdate(beanstalkCo
 }
 
 func resourceAwsElasticBeanstalkApplicationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elasticbeanstalkconn
+	conn := meta.(*AWSClient).elasticbeanstalkconn()
 
 	var app *elasticbeanstalk.ApplicationDescription
 	err := resource.Retry(30*time.Second, func() *resource.RetryError {
@@ -234,7 +234,7 @@ func resourceAwsElasticBeanstalkApplicationRead(d *schema.ResourceData, meta int
 }
 
 func resourceAwsElasticBeanstalkApplicationDelete(d *schema.ResourceData, meta interface{}) error {
-	beanstalkConn := meta.(*AWSClient).elasticbeanstalkconn
+	beanstalkConn := meta.(*AWSClient).elasticbeanstalkconn()
 
 	_, err := beanstalkConn.DeleteApplication(&elasticbeanstalk.DeleteApplicationInput{
 		ApplicationName: aws.String(d.Id()),
@@ -244,7 +244,7 @@ func resourceAwsElasticBeanstalkApplicationDelete(d *schema.ResourceData, meta i
 	}
 
 	return resource.Retry(10*time.Second, func() *resource.RetryError {
-		app, err := getBeanstalkApplication(d.Id(), meta.(*AWSClient).elasticbeanstalkconn)
+		app, err := getBeanstalkApplication(d.Id(), meta.(*AWSClient).elasticbeanstalkconn())
 		if err != nil {
 			return resource.NonRetryableError(err)
 		}
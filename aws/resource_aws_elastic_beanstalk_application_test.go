@@ -27,7 +27,7 @@ func testSweepBeanstalkApplications(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	beanstalkconn := client.(*AWSClient).elasticbeanstalkconn
+	beanstalkconn := client.(*AWSClient).elasticbeanstalkconn()
 
 	resp, err := beanstalkconn.DescribeApplications(&elasticbeanstalk.DescribeApplicationsInput{})
 	if err != nil {
@@ -170,7 +170,7 @@ func TestAccAWSBeanstalkApp_appversionlifecycle(t *testing.T) {
 }
 
 func testAccCheckBeanstalkAppDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).elasticbeanstalkconn
+	conn := testAccProvider.Meta().(*AWSClient).elasticbeanstalkconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_elastic_beanstalk_application" {
@@ -214,7 +214,7 @@ func testAccCheckBeanstalkAppExists(n string, app *elasticbeanstalk.ApplicationD
 			return fmt.Errorf("Elastic Beanstalk app ID is not set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).elasticbeanstalkconn
+		conn := testAccProvider.Meta().(*AWSClient).elasticbeanstalkconn()
 		DescribeBeanstalkAppOpts := &elasticbeanstalk.DescribeApplicationsInput{
 			ApplicationNames: []*string{aws.String(rs.Primary.ID)},
 		}
@@ -52,7 +52,7 @@ func TestAccAWSBeanstalkAppVersion_duplicateLabels(t *testing.T) {
 }
 
 func testAccCheckApplicationVersionDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).elasticbeanstalkconn
+	conn := testAccProvider.Meta().(*AWSClient).elasticbeanstalkconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_elastic_beanstalk_application_version" {
@@ -94,7 +94,7 @@ func testAccCheckApplicationVersionExists(n string, app *elasticbeanstalk.Applic
 			return fmt.Errorf("Elastic Beanstalk Application Version is not set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).elasticbeanstalkconn
+		conn := testAccProvider.Meta().(*AWSClient).elasticbeanstalkconn()
 		describeApplicationVersionOpts := &elasticbeanstalk.DescribeApplicationVersionsInput{
 			ApplicationName: aws.String(rs.Primary.Attributes["application"]),
 			VersionLabels:   []*string{aws.String(rs.Primary.ID)},
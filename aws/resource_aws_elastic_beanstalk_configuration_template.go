@@ -56,7 +56,7 @@ func resourceAwsElasticBeanstalkConfigurationTemplate() *schema.Resource {
 }
 
 func resourceAwsElasticBeanstalkConfigurationTemplateCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elasticbeanstalkconn
+	conn := meta.(*AWSClient).elasticbeanstalkconn()
 
 	// Get the relevant properties
 	name := d.Get("name").(string)
@@ -93,7 +93,7 @@ func resourceAwsElasticBeanstalkConfigurationTemplateCreate(d *schema.ResourceDa
 }
 
 func resourceAwsElasticBeanstalkConfigurationTemplateRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elasticbeanstalkconn
+	conn := meta.(*AWSClient).elasticbeanstalkconn()
 
 	log.Printf("[DEBUG] Elastic Beanstalk configuration template read: %s", d.Get("name").(string))
 
@@ -127,7 +127,7 @@ func resourceAwsElasticBeanstalkConfigurationTemplateRead(d *schema.ResourceData
 }
 
 func resourceAwsElasticBeanstalkConfigurationTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elasticbeanstalkconn
+	conn := meta.(*AWSClient).elasticbeanstalkconn()
 
 	log.Printf("[DEBUG] Elastic Beanstalk configuration template update: %s", d.Get("name").(string))
 
@@ -222,7 +222,7 @@ func resourceAwsElasticBeanstalkConfigurationTemplateOptionSettingsUpdate(conn *
 }
 
 func resourceAwsElasticBeanstalkConfigurationTemplateDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elasticbeanstalkconn
+	conn := meta.(*AWSClient).elasticbeanstalkconn()
 
 	application := d.Get("application").(string)
 
@@ -71,7 +71,7 @@ func TestAccAWSBeanstalkConfigurationTemplate_Setting(t *testing.T) {
 }
 
 func testAccCheckBeanstalkConfigurationTemplateDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).elasticbeanstalkconn
+	conn := testAccProvider.Meta().(*AWSClient).elasticbeanstalkconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_elastic_beanstalk_configuration_template" {
@@ -114,7 +114,7 @@ func testAccCheckBeanstalkConfigurationTemplateDestroy(s *terraform.State) error
 
 func testAccCheckBeanstalkConfigurationTemplateExists(n string, config *elasticbeanstalk.ConfigurationSettingsDescription) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).elasticbeanstalkconn
+		conn := testAccProvider.Meta().(*AWSClient).elasticbeanstalkconn()
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
 			return fmt.Errorf("Not found: %s", n)
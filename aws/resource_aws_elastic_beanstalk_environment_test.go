@@ -30,7 +30,7 @@ func testSweepBeanstalkEnvironments(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	beanstalkconn := client.(*AWSClient).elasticbeanstalkconn
+	beanstalkconn := client.(*AWSClient).elasticbeanstalkconn()
 
 	resp, err := beanstalkconn.DescribeEnvironments(&elasticbeanstalk.DescribeEnvironmentsInput{
 		IncludeDeleted: aws.Bool(false),
@@ -549,7 +549,7 @@ func testAccVerifyBeanstalkConfig(env *elasticbeanstalk.EnvironmentDescription,
 		if env == nil {
 			return fmt.Errorf("Nil environment in testAccVerifyBeanstalkConfig")
 		}
-		conn := testAccProvider.Meta().(*AWSClient).elasticbeanstalkconn
+		conn := testAccProvider.Meta().(*AWSClient).elasticbeanstalkconn()
 
 		resp, err := conn.DescribeConfigurationSettings(&elasticbeanstalk.DescribeConfigurationSettingsInput{
 			ApplicationName: env.ApplicationName,
@@ -597,7 +597,7 @@ func testAccVerifyBeanstalkConfig(env *elasticbeanstalk.EnvironmentDescription,
 }
 
 func testAccCheckBeanstalkEnvDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).elasticbeanstalkconn
+	conn := testAccProvider.Meta().(*AWSClient).elasticbeanstalkconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_elastic_beanstalk_environment" {
@@ -647,7 +647,7 @@ func testAccCheckBeanstalkEnvExists(n string, app *elasticbeanstalk.EnvironmentD
 			return fmt.Errorf("Elastic Beanstalk ENV is not set")
 		}
 
-		env, err := describeBeanstalkEnv(testAccProvider.Meta().(*AWSClient).elasticbeanstalkconn, aws.String(rs.Primary.ID))
+		env, err := describeBeanstalkEnv(testAccProvider.Meta().(*AWSClient).elasticbeanstalkconn(), aws.String(rs.Primary.ID))
 		if err != nil {
 			return err
 		}
@@ -669,7 +669,7 @@ func testAccCheckBeanstalkEnvTier(n string, app *elasticbeanstalk.EnvironmentDes
 			return fmt.Errorf("Elastic Beanstalk ENV is not set")
 		}
 
-		env, err := describeBeanstalkEnv(testAccProvider.Meta().(*AWSClient).elasticbeanstalkconn, aws.String(rs.Primary.ID))
+		env, err := describeBeanstalkEnv(testAccProvider.Meta().(*AWSClient).elasticbeanstalkconn(), aws.String(rs.Primary.ID))
 		if err != nil {
 			return err
 		}
@@ -685,7 +685,7 @@ func testAccCheckBeanstalkEnvTier(n string, app *elasticbeanstalk.EnvironmentDes
 
 func testAccCheckBeanstalkEnvConfigValue(n string, expectedValue string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).elasticbeanstalkconn
+		conn := testAccProvider.Meta().(*AWSClient).elasticbeanstalkconn()
 
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -732,7 +732,7 @@ func testAccCheckBeanstalkEnvTagsMatch(env *elasticbeanstalk.EnvironmentDescript
 			return fmt.Errorf("Nil environment in testAccCheckBeanstalkEnvTagsMatch")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).elasticbeanstalkconn
+		conn := testAccProvider.Meta().(*AWSClient).elasticbeanstalkconn()
 
 		tags, err := conn.ListTagsForResource(&elasticbeanstalk.ListTagsForResourceInput{
 			ResourceArn: env.EnvironmentArn,
@@ -763,7 +763,7 @@ func testAccCheckBeanstalkApplicationVersionDeployed(n string, app *elasticbeans
 			return fmt.Errorf("Elastic Beanstalk ENV is not set")
 		}
 
-		env, err := describeBeanstalkEnv(testAccProvider.Meta().(*AWSClient).elasticbeanstalkconn, aws.String(rs.Primary.ID))
+		env, err := describeBeanstalkEnv(testAccProvider.Meta().(*AWSClient).elasticbeanstalkconn(), aws.String(rs.Primary.ID))
 		if err != nil {
 			return err
 		}
@@ -191,7 +191,7 @@ func resourceAwsElasticTranscoderPipeline() *schema.Resource {
 }
 
 func resourceAwsElasticTranscoderPipelineCreate(d *schema.ResourceData, meta interface{}) error {
-	elastictranscoderconn := meta.(*AWSClient).elastictranscoderconn
+	elastictranscoderconn := meta.(*AWSClient).elastictranscoderconn()
 
 	req := &elastictranscoder.CreatePipelineInput{
 		AwsKmsKeyArn:    getStringPtr(d, "aws_kms_key_arn"),
@@ -358,7 +358,7 @@ func flattenETPermList(perms []*elastictranscoder.Permission) []map[string]inter
 }
 
 func resourceAwsElasticTranscoderPipelineUpdate(d *schema.ResourceData, meta interface{}) error {
-	elastictranscoderconn := meta.(*AWSClient).elastictranscoderconn
+	elastictranscoderconn := meta.(*AWSClient).elastictranscoderconn()
 
 	req := &elastictranscoder.UpdatePipelineInput{
 		Id: aws.String(d.Id()),
@@ -406,7 +406,7 @@ func resourceAwsElasticTranscoderPipelineUpdate(d *schema.ResourceData, meta int
 }
 
 func resourceAwsElasticTranscoderPipelineRead(d *schema.ResourceData, meta interface{}) error {
-	elastictranscoderconn := meta.(*AWSClient).elastictranscoderconn
+	elastictranscoderconn := meta.(*AWSClient).elastictranscoderconn()
 
 	resp, err := elastictranscoderconn.ReadPipeline(&elastictranscoder.ReadPipelineInput{
 		Id: aws.String(d.Id()),
@@ -478,7 +478,7 @@ func resourceAwsElasticTranscoderPipelineRead(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsElasticTranscoderPipelineDelete(d *schema.ResourceData, meta interface{}) error {
-	elastictranscoderconn := meta.(*AWSClient).elastictranscoderconn
+	elastictranscoderconn := meta.(*AWSClient).elastictranscoderconn()
 
 	log.Printf("[DEBUG] Elastic Transcoder Delete Pipeline: %s", d.Id())
 	_, err := elastictranscoderconn.DeletePipeline(&elastictranscoder.DeletePipelineInput{
@@ -320,7 +320,7 @@ func resourceAwsElasticTranscoderPreset() *schema.Resource {
 }
 
 func resourceAwsElasticTranscoderPresetCreate(d *schema.ResourceData, meta interface{}) error {
-	elastictranscoderconn := meta.(*AWSClient).elastictranscoderconn
+	elastictranscoderconn := meta.(*AWSClient).elastictranscoderconn()
 
 	req := &elastictranscoder.CreatePresetInput{
 		Audio:       expandETAudioParams(d),
@@ -471,7 +471,7 @@ func expandETVideoWatermarks(d *schema.ResourceData) []*elastictranscoder.Preset
 }
 
 func resourceAwsElasticTranscoderPresetRead(d *schema.ResourceData, meta interface{}) error {
-	elastictranscoderconn := meta.(*AWSClient).elastictranscoderconn
+	elastictranscoderconn := meta.(*AWSClient).elastictranscoderconn()
 
 	resp, err := elastictranscoderconn.ReadPreset(&elastictranscoder.ReadPresetInput{
 		Id: aws.String(d.Id()),
@@ -628,7 +628,7 @@ func flattenETWatermarks(watermarks []*elastictranscoder.PresetWatermark) []map[
 }
 
 func resourceAwsElasticTranscoderPresetDelete(d *schema.ResourceData, meta interface{}) error {
-	elastictranscoderconn := meta.(*AWSClient).elastictranscoderconn
+	elastictranscoderconn := meta.(*AWSClient).elastictranscoderconn()
 
 	log.Printf("[DEBUG] Elastic Transcoder Delete Preset: %s", d.Id())
 	_, err := elastictranscoderconn.DeletePreset(&elastictranscoder.DeletePresetInput{
@@ -40,7 +40,7 @@ func TestAccAWSElasticTranscoderPreset_basic(t *testing.T) {
 	// these are very easy to leak
 	checkExists := func(replaced bool) resource.TestCheckFunc {
 		return func(s *terraform.State) error {
-			conn := testAccProvider.Meta().(*AWSClient).elastictranscoderconn
+			conn := testAccProvider.Meta().(*AWSClient).elastictranscoderconn()
 
 			if replaced {
 				_, err := conn.ReadPreset(&elastictranscoder.ReadPresetInput{Id: preset.Id})
@@ -117,7 +117,7 @@ func TestAccAWSElasticTranscoderPreset_basic(t *testing.T) {
 }
 
 func testAccCheckElasticTranscoderPresetDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).elastictranscoderconn
+	conn := testAccProvider.Meta().(*AWSClient).elastictranscoderconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_elastictranscoder_preset" {
@@ -297,7 +297,7 @@ func resourceAwsElasticacheCluster() *schema.Resource {
 }
 
 func resourceAwsElasticacheClusterCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elasticacheconn
+	conn := meta.(*AWSClient).elasticacheconn()
 
 	req := &elasticache.CreateCacheClusterInput{}
 
@@ -403,7 +403,7 @@ func resourceAwsElasticacheClusterCreate(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsElasticacheClusterRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elasticacheconn
+	conn := meta.(*AWSClient).elasticacheconn()
 	req := &elasticache.DescribeCacheClustersInput{
 		CacheClusterId:    aws.String(d.Id()),
 		ShowCacheNodeInfo: aws.Bool(true),
@@ -491,7 +491,7 @@ func resourceAwsElasticacheClusterRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsElasticacheClusterUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elasticacheconn
+	conn := meta.(*AWSClient).elasticacheconn()
 
 	arn := arn.ARN{
 		Partition: meta.(*AWSClient).partition,
@@ -662,7 +662,7 @@ func (b byCacheNodeId) Less(i, j int) bool {
 }
 
 func resourceAwsElasticacheClusterDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elasticacheconn
+	conn := meta.(*AWSClient).elasticacheconn()
 
 	err := deleteElasticacheCacheCluster(conn, d.Id())
 	if err != nil {
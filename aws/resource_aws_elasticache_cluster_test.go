@@ -34,7 +34,7 @@ func testSweepElasticacheClusters(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).elasticacheconn
+	conn := client.(*AWSClient).elasticacheconn()
 
 	err = conn.DescribeCacheClustersPages(&elasticache.DescribeCacheClustersInput{}, func(page *elasticache.DescribeCacheClustersOutput, isLast bool) bool {
 		if len(page.CacheClusters) == 0 {
@@ -849,7 +849,7 @@ func testAccCheckAWSElasticacheClusterRecreated(i, j *elasticache.CacheCluster)
 }
 
 func testAccCheckAWSElasticacheClusterDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+	conn := testAccProvider.Meta().(*AWSClient).elasticacheconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_elasticache_cluster" {
@@ -883,7 +883,7 @@ func testAccCheckAWSElasticacheClusterExists(n string, v *elasticache.CacheClust
 			return fmt.Errorf("No cache cluster ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+		conn := testAccProvider.Meta().(*AWSClient).elasticacheconn()
 		resp, err := conn.DescribeCacheClusters(&elasticache.DescribeCacheClustersInput{
 			CacheClusterId: aws.String(rs.Primary.ID),
 		})
@@ -272,7 +272,7 @@ func TestAccAWSElasticacheParameterGroup_Description(t *testing.T) {
 }
 
 func testAccCheckAWSElasticacheParameterGroupDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+	conn := testAccProvider.Meta().(*AWSClient).elasticacheconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_elasticache_parameter_group" {
@@ -331,7 +331,7 @@ func testAccCheckAWSElasticacheParameterGroupExists(n string, v *elasticache.Cac
 			return fmt.Errorf("No Cache Parameter Group ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+		conn := testAccProvider.Meta().(*AWSClient).elasticacheconn()
 
 		opts := elasticache.DescribeCacheParameterGroupsInput{
 			CacheParameterGroupName: aws.String(rs.Primary.ID),
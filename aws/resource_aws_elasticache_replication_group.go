@@ -233,7 +233,7 @@ func resourceAwsElasticacheReplicationGroup() *schema.Resource {
 }
 
 func resourceAwsElasticacheReplicationGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elasticacheconn
+	conn := meta.(*AWSClient).elasticacheconn()
 
 	tags := tagsFromMapEC(d.Get("tags").(map[string]interface{}))
 	params := &elasticache.CreateReplicationGroupInput{
@@ -366,7 +366,7 @@ func resourceAwsElasticacheReplicationGroupCreate(d *schema.ResourceData, meta i
 }
 
 func resourceAwsElasticacheReplicationGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elasticacheconn
+	conn := meta.(*AWSClient).elasticacheconn()
 	req := &elasticache.DescribeReplicationGroupsInput{
 		ReplicationGroupId: aws.String(d.Id()),
 	}
@@ -478,7 +478,7 @@ func resourceAwsElasticacheReplicationGroupRead(d *schema.ResourceData, meta int
 }
 
 func resourceAwsElasticacheReplicationGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elasticacheconn
+	conn := meta.(*AWSClient).elasticacheconn()
 
 	if d.HasChange("cluster_mode.0.num_node_groups") {
 		o, n := d.GetChange("cluster_mode.0.num_node_groups")
@@ -765,7 +765,7 @@ func resourceAwsElasticacheReplicationGroupUpdate(d *schema.ResourceData, meta i
 }
 
 func resourceAwsElasticacheReplicationGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elasticacheconn
+	conn := meta.(*AWSClient).elasticacheconn()
 
 	err := deleteElasticacheReplicationGroup(d.Id(), conn)
 	if err != nil {
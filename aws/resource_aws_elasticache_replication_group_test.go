@@ -28,7 +28,7 @@ func testSweepElasticacheReplicationGroups(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).elasticacheconn
+	conn := client.(*AWSClient).elasticacheconn()
 
 	err = conn.DescribeReplicationGroupsPages(&elasticache.DescribeReplicationGroupsInput{}, func(page *elasticache.DescribeReplicationGroupsOutput, isLast bool) bool {
 		if len(page.ReplicationGroups) == 0 {
@@ -551,7 +551,7 @@ func TestAccAWSElasticacheReplicationGroup_NumberCacheClusters_Failover_AutoFail
 			{
 				PreConfig: func() {
 					// Simulate failover so primary is on node we are trying to delete
-					conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+					conn := testAccProvider.Meta().(*AWSClient).elasticacheconn()
 					input := &elasticache.ModifyReplicationGroupInput{
 						ApplyImmediately:   aws.Bool(true),
 						PrimaryClusterId:   aws.String(fmt.Sprintf("%s-003", rName)),
@@ -596,7 +596,7 @@ func TestAccAWSElasticacheReplicationGroup_NumberCacheClusters_Failover_AutoFail
 			{
 				PreConfig: func() {
 					// Simulate failover so primary is on node we are trying to delete
-					conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+					conn := testAccProvider.Meta().(*AWSClient).elasticacheconn()
 
 					// Must disable automatic failover first
 					var input *elasticache.ModifyReplicationGroupInput = &elasticache.ModifyReplicationGroupInput{
@@ -727,7 +727,7 @@ func testAccCheckAWSElasticacheReplicationGroupExists(n string, v *elasticache.R
 			return fmt.Errorf("No replication group ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+		conn := testAccProvider.Meta().(*AWSClient).elasticacheconn()
 		res, err := conn.DescribeReplicationGroups(&elasticache.DescribeReplicationGroupsInput{
 			ReplicationGroupId: aws.String(rs.Primary.ID),
 		})
@@ -746,7 +746,7 @@ func testAccCheckAWSElasticacheReplicationGroupExists(n string, v *elasticache.R
 }
 
 func testAccCheckAWSElasticacheReplicationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+	conn := testAccProvider.Meta().(*AWSClient).elasticacheconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_elasticache_replication_group" {
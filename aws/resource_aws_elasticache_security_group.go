@@ -45,7 +45,7 @@ func resourceAwsElasticacheSecurityGroup() *schema.Resource {
 }
 
 func resourceAwsElasticacheSecurityGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elasticacheconn
+	conn := meta.(*AWSClient).elasticacheconn()
 
 	name := d.Get("name").(string)
 	desc := d.Get("description").(string)
@@ -87,7 +87,7 @@ func resourceAwsElasticacheSecurityGroupCreate(d *schema.ResourceData, meta inte
 }
 
 func resourceAwsElasticacheSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elasticacheconn
+	conn := meta.(*AWSClient).elasticacheconn()
 	req := &elasticache.DescribeCacheSecurityGroupsInput{
 		CacheSecurityGroupName: aws.String(d.Id()),
 	}
@@ -124,7 +124,7 @@ func resourceAwsElasticacheSecurityGroupRead(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsElasticacheSecurityGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elasticacheconn
+	conn := meta.(*AWSClient).elasticacheconn()
 
 	log.Printf("[DEBUG] Cache security group delete: %s", d.Id())
 
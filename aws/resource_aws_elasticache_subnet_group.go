@@ -51,7 +51,7 @@ func resourceAwsElasticacheSubnetGroup() *schema.Resource {
 }
 
 func resourceAwsElasticacheSubnetGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elasticacheconn
+	conn := meta.(*AWSClient).elasticacheconn()
 
 	// Get the group properties
 	name := d.Get("name").(string)
@@ -83,7 +83,7 @@ func resourceAwsElasticacheSubnetGroupCreate(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsElasticacheSubnetGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elasticacheconn
+	conn := meta.(*AWSClient).elasticacheconn()
 	req := &elasticache.DescribeCacheSubnetGroupsInput{
 		CacheSubnetGroupName: aws.String(d.Get("name").(string)),
 	}
@@ -126,7 +126,7 @@ func resourceAwsElasticacheSubnetGroupRead(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsElasticacheSubnetGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elasticacheconn
+	conn := meta.(*AWSClient).elasticacheconn()
 	if d.HasChange("subnet_ids") || d.HasChange("description") {
 		var subnets []*string
 		if v := d.Get("subnet_ids"); v != nil {
@@ -149,7 +149,7 @@ func resourceAwsElasticacheSubnetGroupUpdate(d *schema.ResourceData, meta interf
 	return resourceAwsElasticacheSubnetGroupRead(d, meta)
 }
 func resourceAwsElasticacheSubnetGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elasticacheconn
+	conn := meta.(*AWSClient).elasticacheconn()
 
 	log.Printf("[DEBUG] Cache subnet group delete: %s", d.Id())
 
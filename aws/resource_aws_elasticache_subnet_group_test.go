@@ -89,7 +89,7 @@ func TestAccAWSElasticacheSubnetGroup_update(t *testing.T) {
 }
 
 func testAccCheckAWSElasticacheSubnetGroupDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+	conn := testAccProvider.Meta().(*AWSClient).elasticacheconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_elasticache_subnet_group" {
@@ -123,7 +123,7 @@ func testAccCheckAWSElasticacheSubnetGroupExists(n string, csg *elasticache.Cach
 			return fmt.Errorf("No cache subnet group ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+		conn := testAccProvider.Meta().(*AWSClient).elasticacheconn()
 		resp, err := conn.DescribeCacheSubnetGroups(&elasticache.DescribeCacheSubnetGroupsInput{
 			CacheSubnetGroupName: aws.String(rs.Primary.ID),
 		})
@@ -32,7 +32,7 @@ func resourceAwsElasticSearchDomain() *schema.Resource {
 				newVersion := d.Get("elasticsearch_version").(string)
 				domainName := d.Get("domain_name").(string)
 
-				conn := meta.(*AWSClient).esconn
+				conn := meta.(*AWSClient).esconn()
 				resp, err := conn.GetCompatibleElasticsearchVersions(&elasticsearch.GetCompatibleElasticsearchVersionsInput{
 					DomainName: aws.String(domainName),
 				})
@@ -320,7 +320,7 @@ func resourceAwsElasticSearchDomainImport(
 }
 
 func resourceAwsElasticSearchDomainCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).esconn
+	conn := meta.(*AWSClient).esconn()
 
 	// The API doesn't check for duplicate names
 	// so w/out this check Create would act as upsert
@@ -511,7 +511,7 @@ func waitForElasticSearchDomainCreation(conn *elasticsearch.ElasticsearchService
 }
 
 func resourceAwsElasticSearchDomainRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).esconn
+	conn := meta.(*AWSClient).esconn()
 
 	out, err := conn.DescribeElasticsearchDomain(&elasticsearch.DescribeElasticsearchDomainInput{
 		DomainName: aws.String(d.Get("domain_name").(string)),
@@ -628,7 +628,7 @@ func resourceAwsElasticSearchDomainRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsElasticSearchDomainUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).esconn
+	conn := meta.(*AWSClient).esconn()
 
 	d.Partial(true)
 
@@ -770,7 +770,7 @@ func resourceAwsElasticSearchDomainUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsElasticSearchDomainDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).esconn
+	conn := meta.(*AWSClient).esconn()
 	domainName := d.Get("domain_name").(string)
 
 	log.Printf("[DEBUG] Deleting ElasticSearch domain: %q", domainName)
@@ -34,7 +34,7 @@ func resourceAwsElasticSearchDomainPolicy() *schema.Resource {
 }
 
 func resourceAwsElasticSearchDomainPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).esconn
+	conn := meta.(*AWSClient).esconn()
 	name := d.Get("domain_name").(string)
 	out, err := conn.DescribeElasticsearchDomain(&elasticsearch.DescribeElasticsearchDomainInput{
 		DomainName: aws.String(name),
@@ -57,7 +57,7 @@ func resourceAwsElasticSearchDomainPolicyRead(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsElasticSearchDomainPolicyUpsert(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).esconn
+	conn := meta.(*AWSClient).esconn()
 	domainName := d.Get("domain_name").(string)
 	_, err := conn.UpdateElasticsearchDomainConfig(&elasticsearch.UpdateElasticsearchDomainConfigInput{
 		DomainName:     aws.String(domainName),
@@ -92,7 +92,7 @@ func resourceAwsElasticSearchDomainPolicyUpsert(d *schema.ResourceData, meta int
 }
 
 func resourceAwsElasticSearchDomainPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).esconn
+	conn := meta.(*AWSClient).esconn()
 
 	_, err := conn.UpdateElasticsearchDomainConfig(&elasticsearch.UpdateElasticsearchDomainConfigInput{
 		DomainName:     aws.String(d.Get("domain_name").(string)),
@@ -26,7 +26,7 @@ func testSweepElasticSearchDomains(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).esconn
+	conn := client.(*AWSClient).esconn()
 
 	out, err := conn.ListDomainNames(&elasticsearch.ListDomainNamesInput{})
 	if err != nil {
@@ -117,7 +117,7 @@ func TestAccAWSElasticSearchDomain_duplicate(t *testing.T) {
 		PreCheck:  func() { testAccPreCheck(t) },
 		Providers: testAccProviders,
 		CheckDestroy: func(s *terraform.State) error {
-			conn := testAccProvider.Meta().(*AWSClient).esconn
+			conn := testAccProvider.Meta().(*AWSClient).esconn()
 			_, err := conn.DeleteElasticsearchDomain(&elasticsearch.DeleteElasticsearchDomainInput{
 				DomainName: aws.String(name),
 			})
@@ -127,7 +127,7 @@ func TestAccAWSElasticSearchDomain_duplicate(t *testing.T) {
 			{
 				PreConfig: func() {
 					// Create duplicate
-					conn := testAccProvider.Meta().(*AWSClient).esconn
+					conn := testAccProvider.Meta().(*AWSClient).esconn()
 					_, err := conn.CreateElasticsearchDomain(&elasticsearch.CreateElasticsearchDomainInput{
 						DomainName: aws.String(name),
 						EBSOptions: &elasticsearch.EBSOptions{
@@ -645,7 +645,7 @@ func testAccCheckESCognitoOptions(enabled bool, status *elasticsearch.Elasticsea
 
 func testAccLoadESTags(conf *elasticsearch.ElasticsearchDomainStatus, td *elasticsearch.ListTagsOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).esconn
+		conn := testAccProvider.Meta().(*AWSClient).esconn()
 
 		describe, err := conn.ListTags(&elasticsearch.ListTagsInput{
 			ARN: conf.ARN,
@@ -672,7 +672,7 @@ func testAccCheckESDomainExists(n string, domain *elasticsearch.ElasticsearchDom
 			return fmt.Errorf("No ES Domain ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).esconn
+		conn := testAccProvider.Meta().(*AWSClient).esconn()
 		opts := &elasticsearch.DescribeElasticsearchDomainInput{
 			DomainName: aws.String(rs.Primary.Attributes["domain_name"]),
 		}
@@ -690,7 +690,7 @@ func testAccCheckESDomainExists(n string, domain *elasticsearch.ElasticsearchDom
 
 func testAccCheckAWSESDomainNotRecreated(i, j *elasticsearch.ElasticsearchDomainStatus) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).esconn
+		conn := testAccProvider.Meta().(*AWSClient).esconn()
 
 		iConfig, err := conn.DescribeElasticsearchDomainConfig(&elasticsearch.DescribeElasticsearchDomainConfigInput{
 			DomainName: i.DomainName,
@@ -719,7 +719,7 @@ func testAccCheckESDomainDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).esconn
+		conn := testAccProvider.Meta().(*AWSClient).esconn()
 		opts := &elasticsearch.DescribeElasticsearchDomainInput{
 			DomainName: aws.String(rs.Primary.Attributes["domain_name"]),
 		}
@@ -251,7 +251,7 @@ func resourceAwsElb() *schema.Resource {
 }
 
 func resourceAwsElbCreate(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbconn
+	elbconn := meta.(*AWSClient).elbconn()
 
 	// Expand the "listener" set to aws-sdk-go compat []*elb.Listener
 	listeners, err := expandListeners(d.Get("listener").(*schema.Set).List())
@@ -335,7 +335,7 @@ func resourceAwsElbCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsElbRead(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbconn
+	elbconn := meta.(*AWSClient).elbconn()
 	elbName := d.Id()
 
 	arn := arn.ARN{
@@ -366,7 +366,7 @@ func resourceAwsElbRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Unable to find ELB: %#v", describeResp.LoadBalancerDescriptions)
 	}
 
-	return flattenAwsELbResource(d, meta.(*AWSClient).ec2conn, elbconn, describeResp.LoadBalancerDescriptions[0])
+	return flattenAwsELbResource(d, meta.(*AWSClient).ec2conn(), elbconn, describeResp.LoadBalancerDescriptions[0])
 }
 
 // flattenAwsELbResource takes a *elbv2.LoadBalancer and populates all respective resource fields.
@@ -468,7 +468,7 @@ func flattenAwsELbResource(d *schema.ResourceData, ec2conn *ec2.EC2, elbconn *el
 }
 
 func resourceAwsElbUpdate(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbconn
+	elbconn := meta.(*AWSClient).elbconn()
 
 	d.Partial(true)
 
@@ -796,7 +796,7 @@ func resourceAwsElbUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsElbDelete(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbconn
+	elbconn := meta.(*AWSClient).elbconn()
 
 	log.Printf("[INFO] Deleting ELB: %s", d.Id())
 
@@ -810,7 +810,7 @@ func resourceAwsElbDelete(d *schema.ResourceData, meta interface{}) error {
 
 	name := d.Get("name").(string)
 
-	err := cleanupELBNetworkInterfaces(meta.(*AWSClient).ec2conn, name)
+	err := cleanupELBNetworkInterfaces(meta.(*AWSClient).ec2conn(), name)
 	if err != nil {
 		log.Printf("[WARN] Failed to cleanup ENIs for ELB %q: %#v", name, err)
 	}
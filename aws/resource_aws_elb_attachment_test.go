@@ -68,7 +68,7 @@ func TestAccAWSELBAttachment_drift(t *testing.T) {
 	var conf elb.LoadBalancerDescription
 
 	deregInstance := func() {
-		conn := testAccProvider.Meta().(*AWSClient).elbconn
+		conn := testAccProvider.Meta().(*AWSClient).elbconn()
 
 		deRegisterInstancesOpts := elb.DeregisterInstancesFromLoadBalancerInput{
 			LoadBalancerName: conf.LoadBalancerName,
@@ -30,7 +30,7 @@ func testSweepELBs(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).elbconn
+	conn := client.(*AWSClient).elbconn()
 
 	err = conn.DescribeLoadBalancersPages(&elb.DescribeLoadBalancersInput{}, func(out *elb.DescribeLoadBalancersOutput, isLast bool) bool {
 		if len(out.LoadBalancerDescriptions) == 0 {
@@ -48,7 +48,7 @@ func testSweepELBs(region string) error {
 				log.Printf("[ERROR] Failed to delete ELB %s: %s", *lb.LoadBalancerName, err)
 				continue
 			}
-			err = cleanupELBNetworkInterfaces(client.(*AWSClient).ec2conn, *lb.LoadBalancerName)
+			err = cleanupELBNetworkInterfaces(client.(*AWSClient).ec2conn(), *lb.LoadBalancerName)
 			if err != nil {
 				log.Printf("[WARN] Failed to cleanup ENIs for ELB %q: %s", *lb.LoadBalancerName, err)
 			}
@@ -472,7 +472,7 @@ func TestAccAWSELB_swap_subnets(t *testing.T) {
 
 func testAccLoadTags(conf *elb.LoadBalancerDescription, td *elb.TagDescription) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).elbconn
+		conn := testAccProvider.Meta().(*AWSClient).elbconn()
 
 		describe, err := conn.DescribeTags(&elb.DescribeTagsInput{
 			LoadBalancerNames: []*string{conf.LoadBalancerName},
@@ -586,7 +586,7 @@ func TestAccAWSELB_listener(t *testing.T) {
 			{
 				PreConfig: func() {
 					// Simulate out of band listener removal
-					conn := testAccProvider.Meta().(*AWSClient).elbconn
+					conn := testAccProvider.Meta().(*AWSClient).elbconn()
 					input := &elb.DeleteLoadBalancerListenersInput{
 						LoadBalancerName:  conf.LoadBalancerName,
 						LoadBalancerPorts: []*int64{aws.Int64(int64(80))},
@@ -608,7 +608,7 @@ func TestAccAWSELB_listener(t *testing.T) {
 			{
 				PreConfig: func() {
 					// Simulate out of band listener addition
-					conn := testAccProvider.Meta().(*AWSClient).elbconn
+					conn := testAccProvider.Meta().(*AWSClient).elbconn()
 					input := &elb.CreateLoadBalancerListenersInput{
 						LoadBalancerName: conf.LoadBalancerName,
 						Listeners: []*elb.Listener{
@@ -1039,7 +1039,7 @@ func TestResourceAWSELB_validateHealthCheckTarget(t *testing.T) {
 }
 
 func testAccCheckAWSELBDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).elbconn
+	conn := testAccProvider.Meta().(*AWSClient).elbconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_elb" {
@@ -1073,7 +1073,7 @@ func testAccCheckAWSELBDestroy(s *terraform.State) error {
 
 func testAccCheckAWSELBDisappears(loadBalancer *elb.LoadBalancerDescription) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).elbconn
+		conn := testAccProvider.Meta().(*AWSClient).elbconn()
 
 		input := elb.DeleteLoadBalancerInput{
 			LoadBalancerName: loadBalancer.LoadBalancerName,
@@ -1164,7 +1164,7 @@ func testAccCheckAWSELBExists(n string, res *elb.LoadBalancerDescription) resour
 			return fmt.Errorf("No ELB ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).elbconn
+		conn := testAccProvider.Meta().(*AWSClient).elbconn()
 
 		describe, err := conn.DescribeLoadBalancers(&elb.DescribeLoadBalancersInput{
 			LoadBalancerNames: []*string{aws.String(rs.Primary.ID)},
@@ -473,7 +473,7 @@ func resourceAwsEMRCluster() *schema.Resource {
 }
 
 func resourceAwsEMRClusterCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).emrconn
+	conn := meta.(*AWSClient).emrconn()
 
 	log.Printf("[DEBUG] Creating EMR cluster")
 	applications := d.Get("applications").(*schema.Set).List()
@@ -707,7 +707,7 @@ func resourceAwsEMRClusterCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsEMRClusterRead(d *schema.ResourceData, meta interface{}) error {
-	emrconn := meta.(*AWSClient).emrconn
+	emrconn := meta.(*AWSClient).emrconn()
 
 	req := &emr.DescribeClusterInput{
 		ClusterId: aws.String(d.Id()),
@@ -841,7 +841,7 @@ func resourceAwsEMRClusterRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsEMRClusterUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).emrconn
+	conn := meta.(*AWSClient).emrconn()
 
 	d.Partial(true)
 
@@ -974,7 +974,7 @@ func resourceAwsEMRClusterUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsEMRClusterDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).emrconn
+	conn := meta.(*AWSClient).emrconn()
 
 	req := &emr.TerminateJobFlowsInput{
 		JobFlowIds: []*string{
@@ -1342,8 +1342,8 @@ func diffTagsEMR(oldTags, newTags []*emr.Tag) ([]*emr.Tag, []*emr.Tag) {
 }
 
 func setTagsEMR(conn *emr.EMR, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if hasTagsChange(d) {
+		oraw, nraw := getTagsChange(d)
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := diffTagsEMR(expandTags(o), expandTags(n))
@@ -1620,7 +1620,7 @@ func readBodyJson(body string, target interface{}) error {
 
 func resourceAwsEMRClusterStateRefreshFunc(d *schema.ResourceData, meta interface{}) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		conn := meta.(*AWSClient).emrconn
+		conn := meta.(*AWSClient).emrconn()
 
 		log.Printf("[INFO] Reading EMR Cluster Information: %s", d.Id())
 		params := &emr.DescribeClusterInput{
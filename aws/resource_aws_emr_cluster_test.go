@@ -27,7 +27,7 @@ func testSweepEmrClusters(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).emrconn
+	conn := client.(*AWSClient).emrconn()
 
 	input := &emr.ListClustersInput{
 		ClusterStates: []*string{
@@ -716,7 +716,7 @@ func TestAccAWSEMRCluster_custom_ami_id(t *testing.T) {
 func testAccCheck_bootstrap_order(cluster *emr.Cluster, argsInts, argsStrings []string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
-		emrconn := testAccProvider.Meta().(*AWSClient).emrconn
+		emrconn := testAccProvider.Meta().(*AWSClient).emrconn()
 		req := emr.ListBootstrapActionsInput{
 			ClusterId: cluster.Id,
 		}
@@ -753,7 +753,7 @@ func testAccCheck_bootstrap_order(cluster *emr.Cluster, argsInts, argsStrings []
 }
 
 func testAccCheckAWSEmrDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).emrconn
+	conn := testAccProvider.Meta().(*AWSClient).emrconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_emr_cluster" {
@@ -793,7 +793,7 @@ func testAccCheckAWSEmrClusterExists(n string, v *emr.Cluster) resource.TestChec
 		if rs.Primary.ID == "" {
 			return fmt.Errorf("No cluster id set")
 		}
-		conn := testAccProvider.Meta().(*AWSClient).emrconn
+		conn := testAccProvider.Meta().(*AWSClient).emrconn()
 		describe, err := conn.DescribeCluster(&emr.DescribeClusterInput{
 			ClusterId: aws.String(rs.Primary.ID),
 		})
@@ -73,7 +73,7 @@ func TestAccAWSEMRInstanceGroup_ebsBasic(t *testing.T) {
 }
 
 func testAccCheckAWSEmrInstanceGroupDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).emrconn
+	conn := testAccProvider.Meta().(*AWSClient).emrconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_emr_cluster" {
@@ -114,7 +114,7 @@ func testAccCheckAWSEmrInstanceGroupExists(n string, v *emr.InstanceGroup) resou
 			return fmt.Errorf("No task group id set")
 		}
 		meta := testAccProvider.Meta()
-		conn := meta.(*AWSClient).emrconn
+		conn := meta.(*AWSClient).emrconn()
 		g, err := fetchEMRInstanceGroup(conn, rs.Primary.Attributes["cluster_id"], rs.Primary.ID)
 		if err != nil {
 			return fmt.Errorf("EMR error: %v", err)
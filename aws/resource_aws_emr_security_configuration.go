@@ -52,7 +52,7 @@ func resourceAwsEMRSecurityConfiguration() *schema.Resource {
 }
 
 func resourceAwsEmrSecurityConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).emrconn
+	conn := meta.(*AWSClient).emrconn()
 
 	var emrSCName string
 	if v, ok := d.GetOk("name"); ok {
@@ -79,7 +79,7 @@ func resourceAwsEmrSecurityConfigurationCreate(d *schema.ResourceData, meta inte
 }
 
 func resourceAwsEmrSecurityConfigurationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).emrconn
+	conn := meta.(*AWSClient).emrconn()
 
 	resp, err := conn.DescribeSecurityConfiguration(&emr.DescribeSecurityConfigurationInput{
 		Name: aws.String(d.Id()),
@@ -101,7 +101,7 @@ func resourceAwsEmrSecurityConfigurationRead(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsEmrSecurityConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).emrconn
+	conn := meta.(*AWSClient).emrconn()
 
 	_, err := conn.DeleteSecurityConfiguration(&emr.DeleteSecurityConfigurationInput{
 		Name: aws.String(d.Id()),
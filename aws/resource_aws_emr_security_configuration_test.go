@@ -48,7 +48,7 @@ func TestAccAWSEmrSecurityConfiguration_basic(t *testing.T) {
 }
 
 func testAccCheckEmrSecurityConfigurationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).emrconn
+	conn := testAccProvider.Meta().(*AWSClient).emrconn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_emr_security_configuration" {
 			continue
@@ -88,7 +88,7 @@ func testAccCheckEmrSecurityConfigurationExists(n string) resource.TestCheckFunc
 			return fmt.Errorf("No EMR Security Configuration ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).emrconn
+		conn := testAccProvider.Meta().(*AWSClient).emrconn()
 		resp, err := conn.DescribeSecurityConfiguration(&emr.DescribeSecurityConfigurationInput{
 			Name: aws.String(rs.Primary.ID),
 		})
@@ -91,7 +91,7 @@ func resourceAwsFlowLog() *schema.Resource {
 }
 
 func resourceAwsLogFlowCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	types := []struct {
 		ID   string
@@ -156,7 +156,7 @@ func resourceAwsLogFlowCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsLogFlowRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	opts := &ec2.DescribeFlowLogsInput{
 		FlowLogIds: []*string{aws.String(d.Id())},
@@ -198,7 +198,7 @@ func resourceAwsLogFlowRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsLogFlowDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	log.Printf(
 		"[DEBUG] Flow Log Destroy: %s", d.Id())
@@ -174,7 +174,7 @@ func testAccCheckFlowLogExists(n string, flowLog *ec2.FlowLog) resource.TestChec
 			return fmt.Errorf("No Flow Log ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 		describeOpts := &ec2.DescribeFlowLogsInput{
 			FlowLogIds: []*string{aws.String(rs.Primary.ID)},
 		}
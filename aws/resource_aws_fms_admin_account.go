@@ -0,0 +1,163 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsFmsAdminAccount() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsFmsAdminAccountCreate,
+		Read:   resourceAwsFmsAdminAccountRead,
+		Delete: resourceAwsFmsAdminAccountDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+		},
+	}
+}
+
+func resourceAwsFmsAdminAccountCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fmsconn()
+
+	accountID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+
+	output, err := conn.GetAdminAccount(&fms.GetAdminAccountInput{})
+
+	if err != nil && !isAWSErr(err, fms.ErrCodeResourceNotFoundException, "") {
+		return fmt.Errorf("error getting FMS Admin Account: %s", err)
+	}
+
+	if output != nil && aws.StringValue(output.AdminAccount) != "" && aws.StringValue(output.RoleStatus) != fms.AccountRoleStatusDeleted {
+		return fmt.Errorf("FMS Admin Account (%s) already associated: import this Terraform resource to manage", aws.StringValue(output.AdminAccount))
+	}
+
+	log.Printf("[DEBUG] Associating FMS Admin Account: %s", accountID)
+	_, err = conn.AssociateAdminAccount(&fms.AssociateAdminAccountInput{
+		AdminAccount: aws.String(accountID),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error associating FMS Admin Account (%s): %s", accountID, err)
+	}
+
+	d.SetId(accountID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"", fms.AccountRoleStatusCreating},
+		Target:  []string{fms.AccountRoleStatusReady},
+		Refresh: refreshFmsAdminAccountRoleStatus(conn, accountID),
+		Timeout: d.Timeout(schema.TimeoutCreate),
+		Delay:   10 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for FMS Admin Account (%s) association: %s", accountID, err)
+	}
+
+	return resourceAwsFmsAdminAccountRead(d, meta)
+}
+
+func resourceAwsFmsAdminAccountRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fmsconn()
+
+	output, err := conn.GetAdminAccount(&fms.GetAdminAccountInput{})
+
+	if isAWSErr(err, fms.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] FMS Admin Account (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error getting FMS Admin Account (%s): %s", d.Id(), err)
+	}
+
+	if aws.StringValue(output.AdminAccount) != d.Id() || aws.StringValue(output.RoleStatus) == fms.AccountRoleStatusDeleted {
+		log.Printf("[WARN] FMS Admin Account (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("account_id", output.AdminAccount)
+
+	return nil
+}
+
+func resourceAwsFmsAdminAccountDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fmsconn()
+
+	log.Printf("[DEBUG] Disassociating FMS Admin Account: %s", d.Id())
+	_, err := conn.DisassociateAdminAccount(&fms.DisassociateAdminAccountInput{})
+
+	if isAWSErr(err, fms.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error disassociating FMS Admin Account (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			fms.AccountRoleStatusDeleting,
+			fms.AccountRoleStatusPendingDeletion,
+			fms.AccountRoleStatusReady,
+		},
+		Target:  []string{"", fms.AccountRoleStatusDeleted},
+		Refresh: refreshFmsAdminAccountRoleStatus(conn, d.Id()),
+		Timeout: d.Timeout(schema.TimeoutDelete),
+		Delay:   10 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for FMS Admin Account (%s) disassociation: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// refreshFmsAdminAccountRoleStatus returns the role status of the given
+// administrator account, or an empty status when it is not associated.
+func refreshFmsAdminAccountRoleStatus(conn *fms.FMS, accountID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.GetAdminAccount(&fms.GetAdminAccountInput{})
+
+		if isAWSErr(err, fms.ErrCodeResourceNotFoundException, "") {
+			return &fms.GetAdminAccountOutput{}, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if aws.StringValue(output.AdminAccount) != accountID {
+			return output, "", nil
+		}
+
+		return output, aws.StringValue(output.RoleStatus), nil
+	}
+}
@@ -0,0 +1,73 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// The Firewall Manager administrator is a single setting of the
+// Organizations master account, so these tests cannot run in parallel.
+func TestAccAWSFmsAdminAccount_basic(t *testing.T) {
+	resourceName := "aws_fms_admin_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccOrganizationsAccountPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFmsAdminAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFmsAdminAccountConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceAttrAccountID(resourceName, "account_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckFmsAdminAccountDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).fmsconn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_fms_admin_account" {
+			continue
+		}
+
+		output, err := conn.GetAdminAccount(&fms.GetAdminAccountInput{})
+
+		if isAWSErr(err, fms.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(output.AdminAccount) == rs.Primary.ID && aws.StringValue(output.RoleStatus) != fms.AccountRoleStatusDeleted {
+			return fmt.Errorf("FMS Admin Account (%s) still associated", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+const testAccFmsAdminAccountConfig = `
+resource "aws_organizations_organization" "test" {
+  aws_service_access_principals = ["fms.amazonaws.com"]
+  feature_set                   = "ALL"
+}
+
+resource "aws_fms_admin_account" "test" {
+  account_id = "${aws_organizations_organization.test.master_account_id}"
+}
+`
@@ -0,0 +1,325 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsFmsPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsFmsPolicyCreate,
+		Read:   resourceAwsFmsPolicyRead,
+		Update: resourceAwsFmsPolicyUpdate,
+		Delete: resourceAwsFmsPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsFmsPolicyImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"delete_all_policy_resources": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"exclude_map": fmsPolicyAccountMapSchema(),
+			"exclude_resource_tags": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"include_map": fmsPolicyAccountMapSchema(),
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"policy_update_token": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"remediation_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"resource_tags": tagsSchema(),
+			"resource_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"security_service_policy_data": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"managed_service_data": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     validation.ValidateJsonString,
+							DiffSuppressFunc: suppressEquivalentJsonDiffs,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								fms.SecurityServiceTypeWaf,
+							}, false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// fmsPolicyAccountMapSchema returns the schema of the include and exclude
+// maps, which scope a policy to member accounts.
+func fmsPolicyAccountMapSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"account": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validateAwsAccountId,
+					},
+					Set: schema.HashString,
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsFmsPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fmsconn()
+
+	input := &fms.PutPolicyInput{
+		Policy: expandFmsPolicy(d),
+	}
+
+	log.Printf("[DEBUG] Creating FMS Policy: %s", input)
+	output, err := conn.PutPolicy(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating FMS Policy: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.Policy.PolicyId))
+
+	return resourceAwsFmsPolicyRead(d, meta)
+}
+
+func resourceAwsFmsPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fmsconn()
+
+	output, err := conn.GetPolicy(&fms.GetPolicyInput{
+		PolicyId: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, fms.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] FMS Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading FMS Policy (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.Policy == nil {
+		return fmt.Errorf("error reading FMS Policy (%s): empty response", d.Id())
+	}
+
+	policy := output.Policy
+
+	d.Set("arn", output.PolicyArn)
+
+	if err := d.Set("exclude_map", flattenFmsPolicyAccountMap(policy.ExcludeMap)); err != nil {
+		return fmt.Errorf("error setting exclude_map: %s", err)
+	}
+
+	d.Set("exclude_resource_tags", policy.ExcludeResourceTags)
+
+	if err := d.Set("include_map", flattenFmsPolicyAccountMap(policy.IncludeMap)); err != nil {
+		return fmt.Errorf("error setting include_map: %s", err)
+	}
+
+	d.Set("name", policy.PolicyName)
+	d.Set("policy_update_token", policy.PolicyUpdateToken)
+	d.Set("remediation_enabled", policy.RemediationEnabled)
+
+	if err := d.Set("resource_tags", flattenFmsPolicyResourceTags(policy.ResourceTags)); err != nil {
+		return fmt.Errorf("error setting resource_tags: %s", err)
+	}
+
+	d.Set("resource_type", policy.ResourceType)
+
+	if err := d.Set("security_service_policy_data", flattenFmsSecurityServicePolicyData(policy.SecurityServicePolicyData)); err != nil {
+		return fmt.Errorf("error setting security_service_policy_data: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsFmsPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fmsconn()
+
+	policy := expandFmsPolicy(d)
+	policy.PolicyId = aws.String(d.Id())
+	policy.PolicyUpdateToken = aws.String(d.Get("policy_update_token").(string))
+
+	input := &fms.PutPolicyInput{
+		Policy: policy,
+	}
+
+	log.Printf("[DEBUG] Updating FMS Policy: %s", input)
+	if _, err := conn.PutPolicy(input); err != nil {
+		return fmt.Errorf("error updating FMS Policy (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsFmsPolicyRead(d, meta)
+}
+
+func resourceAwsFmsPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fmsconn()
+
+	log.Printf("[DEBUG] Deleting FMS Policy: %s", d.Id())
+	_, err := conn.DeletePolicy(&fms.DeletePolicyInput{
+		DeleteAllPolicyResources: aws.Bool(d.Get("delete_all_policy_resources").(bool)),
+		PolicyId:                 aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, fms.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting FMS Policy (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsFmsPolicyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// delete_all_policy_resources cannot be read from the API.
+	d.Set("delete_all_policy_resources", true)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandFmsPolicy(d *schema.ResourceData) *fms.Policy {
+	policy := &fms.Policy{
+		ExcludeMap:                expandFmsPolicyAccountMap(d.Get("exclude_map").([]interface{})),
+		ExcludeResourceTags:       aws.Bool(d.Get("exclude_resource_tags").(bool)),
+		IncludeMap:                expandFmsPolicyAccountMap(d.Get("include_map").([]interface{})),
+		PolicyName:                aws.String(d.Get("name").(string)),
+		RemediationEnabled:        aws.Bool(d.Get("remediation_enabled").(bool)),
+		ResourceTags:              expandFmsPolicyResourceTags(d.Get("resource_tags").(map[string]interface{})),
+		ResourceType:              aws.String(d.Get("resource_type").(string)),
+		SecurityServicePolicyData: expandFmsSecurityServicePolicyData(d.Get("security_service_policy_data").([]interface{})),
+	}
+
+	return policy
+}
+
+func expandFmsPolicyAccountMap(l []interface{}) map[string][]*string {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	accounts := m["account"].(*schema.Set)
+
+	if accounts.Len() == 0 {
+		return nil
+	}
+
+	return map[string][]*string{
+		fms.CustomerPolicyScopeIdTypeAccount: expandStringSet(accounts),
+	}
+}
+
+func expandFmsPolicyResourceTags(m map[string]interface{}) []*fms.ResourceTag {
+	var resourceTags []*fms.ResourceTag
+
+	for k, v := range m {
+		resourceTags = append(resourceTags, &fms.ResourceTag{
+			Key:   aws.String(k),
+			Value: aws.String(v.(string)),
+		})
+	}
+
+	return resourceTags
+}
+
+func expandFmsSecurityServicePolicyData(l []interface{}) *fms.SecurityServicePolicyData {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	securityServicePolicyData := &fms.SecurityServicePolicyData{
+		Type: aws.String(m["type"].(string)),
+	}
+
+	if v, ok := m["managed_service_data"].(string); ok && v != "" {
+		securityServicePolicyData.ManagedServiceData = aws.String(v)
+	}
+
+	return securityServicePolicyData
+}
+
+func flattenFmsPolicyAccountMap(accountMap map[string][]*string) []interface{} {
+	accounts, ok := accountMap[fms.CustomerPolicyScopeIdTypeAccount]
+
+	if !ok || len(accounts) == 0 {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"account": flattenStringSet(accounts),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenFmsPolicyResourceTags(resourceTags []*fms.ResourceTag) map[string]string {
+	m := make(map[string]string, len(resourceTags))
+
+	for _, resourceTag := range resourceTags {
+		m[aws.StringValue(resourceTag.Key)] = aws.StringValue(resourceTag.Value)
+	}
+
+	return m
+}
+
+func flattenFmsSecurityServicePolicyData(securityServicePolicyData *fms.SecurityServicePolicyData) []interface{} {
+	if securityServicePolicyData == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"managed_service_data": aws.StringValue(securityServicePolicyData.ManagedServiceData),
+		"type":                 aws.StringValue(securityServicePolicyData.Type),
+	}
+
+	return []interface{}{m}
+}
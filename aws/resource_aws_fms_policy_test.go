@@ -0,0 +1,287 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSFmsPolicy_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_fms_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSFms(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFmsPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFmsPolicyConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFmsPolicyExists(resourceName),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "fms", regexp.MustCompile(`policy/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "exclude_resource_tags", "false"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "policy_update_token"),
+					resource.TestCheckResourceAttr(resourceName, "remediation_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "resource_type", "AWS::ElasticLoadBalancingV2::LoadBalancer"),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.type", fms.SecurityServiceTypeWaf),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSFmsPolicy_IncludeMap(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_fms_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSFms(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFmsPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFmsPolicyConfigIncludeMap(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFmsPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "include_map.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "include_map.0.account.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSFmsPolicy_ResourceTags(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_fms_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSFms(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFmsPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFmsPolicyConfigResourceTags(rName, false, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFmsPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "exclude_resource_tags", "false"),
+					resource.TestCheckResourceAttr(resourceName, "resource_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "resource_tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFmsPolicyConfigResourceTags(rName, true, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFmsPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "exclude_resource_tags", "true"),
+					resource.TestCheckResourceAttr(resourceName, "resource_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "resource_tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPreCheckAWSFms(t *testing.T) {
+	conn := testAccProvider.Meta().(*AWSClient).fmsconn()
+
+	output, err := conn.GetAdminAccount(&fms.GetAdminAccountInput{})
+
+	if testAccPreCheckSkipError(err) || isAWSErr(err, fms.ErrCodeResourceNotFoundException, "") {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+
+	if aws.StringValue(output.AdminAccount) != testAccProvider.Meta().(*AWSClient).accountid {
+		t.Skip("skipping acceptance testing: this AWS account is not the Firewall Manager administrator")
+	}
+}
+
+func testAccCheckFmsPolicyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).fmsconn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_fms_policy" {
+			continue
+		}
+
+		_, err := conn.GetPolicy(&fms.GetPolicyInput{
+			PolicyId: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, fms.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("FMS Policy (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckFmsPolicyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No FMS Policy ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).fmsconn()
+
+		_, err := conn.GetPolicy(&fms.GetPolicyInput{
+			PolicyId: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccFmsPolicyConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_wafregional_rule_group" "test" {
+  metric_name = "MyTest"
+  name        = %[1]q
+}
+`, rName)
+}
+
+func testAccFmsPolicyConfig(rName string) string {
+	return testAccFmsPolicyConfigBase(rName) + fmt.Sprintf(`
+resource "aws_fms_policy" "test" {
+  exclude_resource_tags = false
+  name                  = %[1]q
+  resource_type         = "AWS::ElasticLoadBalancingV2::LoadBalancer"
+
+  security_service_policy_data {
+    type = "WAF"
+
+    managed_service_data = <<EOF
+{
+  "type": "WAF",
+  "ruleGroups": [
+    {
+      "id": "${aws_wafregional_rule_group.test.id}",
+      "overrideAction": {
+        "type": "COUNT"
+      }
+    }
+  ],
+  "defaultAction": {
+    "type": "BLOCK"
+  },
+  "overrideCustomerWebACLAssociation": false
+}
+EOF
+  }
+}
+`, rName)
+}
+
+func testAccFmsPolicyConfigIncludeMap(rName string) string {
+	return testAccFmsPolicyConfigBase(rName) + fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_fms_policy" "test" {
+  exclude_resource_tags = false
+  name                  = %[1]q
+  resource_type         = "AWS::ElasticLoadBalancingV2::LoadBalancer"
+
+  include_map {
+    account = ["${data.aws_caller_identity.current.account_id}"]
+  }
+
+  security_service_policy_data {
+    type = "WAF"
+
+    managed_service_data = <<EOF
+{
+  "type": "WAF",
+  "ruleGroups": [
+    {
+      "id": "${aws_wafregional_rule_group.test.id}",
+      "overrideAction": {
+        "type": "COUNT"
+      }
+    }
+  ],
+  "defaultAction": {
+    "type": "BLOCK"
+  },
+  "overrideCustomerWebACLAssociation": false
+}
+EOF
+  }
+}
+`, rName)
+}
+
+func testAccFmsPolicyConfigResourceTags(rName string, excludeResourceTags bool, tagKey, tagValue string) string {
+	return testAccFmsPolicyConfigBase(rName) + fmt.Sprintf(`
+resource "aws_fms_policy" "test" {
+  exclude_resource_tags = %[2]t
+  name                  = %[1]q
+  resource_type         = "AWS::ElasticLoadBalancingV2::LoadBalancer"
+
+  resource_tags = {
+    %[3]q = %[4]q
+  }
+
+  security_service_policy_data {
+    type = "WAF"
+
+    managed_service_data = <<EOF
+{
+  "type": "WAF",
+  "ruleGroups": [
+    {
+      "id": "${aws_wafregional_rule_group.test.id}",
+      "overrideAction": {
+        "type": "COUNT"
+      }
+    }
+  ],
+  "defaultAction": {
+    "type": "BLOCK"
+  },
+  "overrideCustomerWebACLAssociation": false
+}
+EOF
+  }
+}
+`, rName, excludeResourceTags, tagKey, tagValue)
+}
@@ -0,0 +1,376 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fsx"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func resourceAwsFsxLustreFileSystem() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsFsxLustreFileSystemCreate,
+		Read:   resourceAwsFsxLustreFileSystemRead,
+		Update: resourceAwsFsxLustreFileSystemUpdate,
+		Delete: resourceAwsFsxLustreFileSystemDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dns_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"export_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(3, 900),
+					validation.StringMatch(regexp.MustCompile(`^s3://`), "must begin with s3://"),
+				),
+			},
+			"import_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(3, 900),
+					validation.StringMatch(regexp.MustCompile(`^s3://`), "must begin with s3://"),
+				),
+			},
+			"imported_file_chunk_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 512000),
+			},
+			"network_interface_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"owner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"security_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 50,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"storage_capacity": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(3600),
+			},
+			"subnet_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"tags": tagsSchema(),
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"weekly_maintenance_start_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateFsxWeeklyMaintenanceStartTime,
+			},
+		},
+	}
+}
+
+func resourceAwsFsxLustreFileSystemCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fsxconn()
+
+	input := &fsx.CreateFileSystemInput{
+		ClientRequestToken:  aws.String(resource.UniqueId()),
+		FileSystemType:      aws.String(fsx.FileSystemTypeLustre),
+		StorageCapacity:     aws.Int64(int64(d.Get("storage_capacity").(int))),
+		SubnetIds:           expandStringSet(d.Get("subnet_ids").(*schema.Set)),
+		LustreConfiguration: &fsx.CreateFileSystemLustreConfiguration{},
+	}
+
+	if v, ok := d.GetOk("export_path"); ok {
+		input.LustreConfiguration.ExportPath = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("import_path"); ok {
+		input.LustreConfiguration.ImportPath = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("imported_file_chunk_size"); ok {
+		input.LustreConfiguration.ImportedFileChunkSize = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("security_group_ids"); ok {
+		input.SecurityGroupIds = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		input.Tags = keyvaluetags.New(v.(map[string]interface{})).IgnoreAws().FsxTags()
+	}
+
+	if v, ok := d.GetOk("weekly_maintenance_start_time"); ok {
+		input.LustreConfiguration.WeeklyMaintenanceStartTime = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating FSx Lustre File System: %s", input)
+	result, err := conn.CreateFileSystem(input)
+	if err != nil {
+		return fmt.Errorf("Error creating FSx Lustre File System: %s", err)
+	}
+
+	d.SetId(aws.StringValue(result.FileSystem.FileSystemId))
+
+	log.Println("[DEBUG] Waiting for FSx Lustre File System to become available")
+	if err := waitForFsxFileSystemCreation(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("Error waiting for FSx Lustre File System (%s) to be available: %s", d.Id(), err)
+	}
+
+	return resourceAwsFsxLustreFileSystemRead(d, meta)
+}
+
+func resourceAwsFsxLustreFileSystemUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fsxconn()
+
+	if hasTagsChange(d) {
+		o, n := getTagsChange(d)
+
+		if err := keyvaluetags.FsxUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating FSx Lustre File System (%s) tags: %s", d.Get("arn").(string), err)
+		}
+	}
+
+	if d.HasChange("weekly_maintenance_start_time") {
+		input := &fsx.UpdateFileSystemInput{
+			ClientRequestToken: aws.String(resource.UniqueId()),
+			FileSystemId:       aws.String(d.Id()),
+			LustreConfiguration: &fsx.UpdateFileSystemLustreConfiguration{
+				WeeklyMaintenanceStartTime: aws.String(d.Get("weekly_maintenance_start_time").(string)),
+			},
+		}
+
+		log.Printf("[DEBUG] Updating FSx Lustre File System: %s", input)
+		if _, err := conn.UpdateFileSystem(input); err != nil {
+			return fmt.Errorf("error updating FSx Lustre File System (%s): %s", d.Id(), err)
+		}
+
+		if err := waitForFsxFileSystemUpdate(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for FSx Lustre File System (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsFsxLustreFileSystemRead(d, meta)
+}
+
+func resourceAwsFsxLustreFileSystemRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fsxconn()
+
+	filesystem, err := describeFsxFileSystem(conn, d.Id())
+
+	if isAWSErr(err, fsx.ErrCodeFileSystemNotFound, "") {
+		log.Printf("[WARN] FSx Lustre File System (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("Error reading FSx Lustre File System (%s): %s", d.Id(), err)
+	}
+
+	if filesystem == nil {
+		log.Printf("[WARN] FSx Lustre File System (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if filesystem.WindowsConfiguration != nil {
+		return fmt.Errorf("expected FSx Lustre File System, found FSx Windows File System: %s", d.Id())
+	}
+
+	if filesystem.LustreConfiguration == nil {
+		return fmt.Errorf("error describing FSx Lustre File System (%s): empty Lustre configuration", d.Id())
+	}
+
+	if filesystem.LustreConfiguration.DataRepositoryConfiguration == nil {
+		// Initialize an empty structure to simplify d.Set() handling
+		filesystem.LustreConfiguration.DataRepositoryConfiguration = &fsx.DataRepositoryConfiguration{}
+	}
+
+	d.Set("arn", filesystem.ResourceARN)
+	d.Set("dns_name", filesystem.DNSName)
+	d.Set("export_path", filesystem.LustreConfiguration.DataRepositoryConfiguration.ExportPath)
+	d.Set("import_path", filesystem.LustreConfiguration.DataRepositoryConfiguration.ImportPath)
+	d.Set("imported_file_chunk_size", filesystem.LustreConfiguration.DataRepositoryConfiguration.ImportedFileChunkSize)
+
+	if err := d.Set("network_interface_ids", aws.StringValueSlice(filesystem.NetworkInterfaceIds)); err != nil {
+		return fmt.Errorf("error setting network_interface_ids: %s", err)
+	}
+
+	d.Set("owner_id", filesystem.OwnerId)
+	d.Set("storage_capacity", filesystem.StorageCapacity)
+
+	if err := d.Set("subnet_ids", aws.StringValueSlice(filesystem.SubnetIds)); err != nil {
+		return fmt.Errorf("error setting subnet_ids: %s", err)
+	}
+
+	if err := d.Set("tags", keyvaluetags.FsxKeyValueTags(filesystem.Tags).IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	d.Set("vpc_id", filesystem.VpcId)
+	d.Set("weekly_maintenance_start_time", filesystem.LustreConfiguration.WeeklyMaintenanceStartTime)
+
+	return nil
+}
+
+func resourceAwsFsxLustreFileSystemDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fsxconn()
+
+	input := &fsx.DeleteFileSystemInput{
+		ClientRequestToken: aws.String(resource.UniqueId()),
+		FileSystemId:       aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting FSx Lustre File System: %s", input)
+	_, err := conn.DeleteFileSystem(input)
+
+	if isAWSErr(err, fsx.ErrCodeFileSystemNotFound, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("Error deleting FSx Lustre File System (%s): %s", d.Id(), err)
+	}
+
+	log.Println("[DEBUG] Waiting for FSx Lustre File System to delete")
+	if err := waitForFsxFileSystemDeletion(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("Error waiting for FSx Lustre File System (%s) to delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// fsxFileSystemLifecycleUpdating is the lifecycle status of a file system
+// while an update is applied, it is missing from the vendored SDK.
+const fsxFileSystemLifecycleUpdating = "UPDATING"
+
+func describeFsxFileSystem(conn *fsx.FSx, id string) (*fsx.FileSystem, error) {
+	input := &fsx.DescribeFileSystemsInput{
+		FileSystemIds: []*string{aws.String(id)},
+	}
+	var filesystem *fsx.FileSystem
+
+	err := conn.DescribeFileSystemsPages(input, func(page *fsx.DescribeFileSystemsOutput, lastPage bool) bool {
+		for _, fs := range page.FileSystems {
+			if aws.StringValue(fs.FileSystemId) == id {
+				filesystem = fs
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	return filesystem, err
+}
+
+func refreshFsxFileSystemLifecycle(conn *fsx.FSx, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		filesystem, err := describeFsxFileSystem(conn, id)
+
+		if isAWSErr(err, fsx.ErrCodeFileSystemNotFound, "") {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if filesystem == nil {
+			return nil, "", nil
+		}
+
+		if aws.StringValue(filesystem.Lifecycle) == fsx.FileSystemLifecycleFailed && filesystem.FailureDetails != nil {
+			return filesystem, fsx.FileSystemLifecycleFailed, fmt.Errorf("file system failed: %s", aws.StringValue(filesystem.FailureDetails.Message))
+		}
+
+		return filesystem, aws.StringValue(filesystem.Lifecycle), nil
+	}
+}
+
+func waitForFsxFileSystemCreation(conn *fsx.FSx, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{fsx.FileSystemLifecycleCreating},
+		Target:  []string{fsx.FileSystemLifecycleAvailable},
+		Refresh: refreshFsxFileSystemLifecycle(conn, id),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForFsxFileSystemUpdate(conn *fsx.FSx, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{fsxFileSystemLifecycleUpdating},
+		Target:  []string{fsx.FileSystemLifecycleAvailable},
+		Refresh: refreshFsxFileSystemLifecycle(conn, id),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForFsxFileSystemDeletion(conn *fsx.FSx, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{fsx.FileSystemLifecycleAvailable, fsx.FileSystemLifecycleDeleting},
+		Target:  []string{},
+		Refresh: refreshFsxFileSystemLifecycle(conn, id),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
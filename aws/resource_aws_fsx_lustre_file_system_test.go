@@ -0,0 +1,474 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fsx"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_fsx_lustre_file_system", &resource.Sweeper{
+		Name: "aws_fsx_lustre_file_system",
+		F:    testSweepFSXLustreFileSystems,
+	})
+}
+
+func testSweepFSXLustreFileSystems(region string) error {
+	return testSweepFSXFileSystems(region, fsx.FileSystemTypeLustre)
+}
+
+// testSweepFSXFileSystems deletes the file systems of the given type.
+func testSweepFSXFileSystems(region, fileSystemType string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).fsxconn()
+
+	input := &fsx.DescribeFileSystemsInput{}
+	err = conn.DescribeFileSystemsPages(input, func(page *fsx.DescribeFileSystemsOutput, lastPage bool) bool {
+		for _, fs := range page.FileSystems {
+			if aws.StringValue(fs.FileSystemType) != fileSystemType {
+				continue
+			}
+
+			id := aws.StringValue(fs.FileSystemId)
+			input := &fsx.DeleteFileSystemInput{
+				ClientRequestToken: aws.String(resource.UniqueId()),
+				FileSystemId:       fs.FileSystemId,
+			}
+			if fileSystemType == fsx.FileSystemTypeWindows {
+				input.WindowsConfiguration = &fsx.DeleteFileSystemWindowsConfiguration{
+					SkipFinalBackup: aws.Bool(true),
+				}
+			}
+
+			log.Printf("[INFO] Deleting FSx File System: %s", id)
+			if _, err := conn.DeleteFileSystem(input); err != nil {
+				log.Printf("[ERROR] Error deleting FSx File System (%s): %s", id, err)
+				continue
+			}
+
+			if err := waitForFsxFileSystemDeletion(conn, id, 30*time.Minute); err != nil {
+				log.Printf("[ERROR] Error waiting for FSx File System (%s) deletion: %s", id, err)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping FSx File System sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing FSx File Systems: %s", err)
+	}
+
+	return nil
+}
+
+func TestAccAWSFsxLustreFileSystem_basic(t *testing.T) {
+	var filesystem fsx.FileSystem
+	resourceName := "aws_fsx_lustre_file_system.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFsxLustreFileSystemDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsFsxLustreFileSystemConfigSubnetIds1(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFsxFileSystemExists(resourceName, &filesystem),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "fsx", regexp.MustCompile(`file-system/fs-.+`)),
+					resource.TestMatchResourceAttr(resourceName, "dns_name", regexp.MustCompile(`fs-.+\.fsx\.`)),
+					resource.TestCheckResourceAttr(resourceName, "export_path", ""),
+					resource.TestCheckResourceAttr(resourceName, "import_path", ""),
+					resource.TestCheckResourceAttr(resourceName, "imported_file_chunk_size", "0"),
+					resource.TestCheckResourceAttr(resourceName, "network_interface_ids.#", "2"),
+					testAccCheckResourceAttrAccountID(resourceName, "owner_id"),
+					resource.TestCheckResourceAttr(resourceName, "security_group_ids.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "storage_capacity", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "subnet_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestMatchResourceAttr(resourceName, "vpc_id", regexp.MustCompile(`^vpc-.+`)),
+					resource.TestMatchResourceAttr(resourceName, "weekly_maintenance_start_time", regexp.MustCompile(`^\d:\d\d:\d\d$`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSFsxLustreFileSystem_disappears(t *testing.T) {
+	var filesystem fsx.FileSystem
+	resourceName := "aws_fsx_lustre_file_system.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFsxLustreFileSystemDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsFsxLustreFileSystemConfigSubnetIds1(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFsxFileSystemExists(resourceName, &filesystem),
+					testAccCheckFsxFileSystemDisappears(&filesystem),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSFsxLustreFileSystem_ExportPath(t *testing.T) {
+	var filesystem1, filesystem2 fsx.FileSystem
+	resourceName := "aws_fsx_lustre_file_system.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFsxLustreFileSystemDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsFsxLustreFileSystemConfigExportPath(rName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFsxFileSystemExists(resourceName, &filesystem1),
+					resource.TestCheckResourceAttr(resourceName, "export_path", fmt.Sprintf("s3://%s", rName)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsFsxLustreFileSystemConfigExportPath(rName, "/prefix/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFsxFileSystemExists(resourceName, &filesystem2),
+					testAccCheckFsxFileSystemRecreated(&filesystem1, &filesystem2),
+					resource.TestCheckResourceAttr(resourceName, "export_path", fmt.Sprintf("s3://%s/prefix/", rName)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSFsxLustreFileSystem_ImportPath(t *testing.T) {
+	var filesystem1, filesystem2 fsx.FileSystem
+	resourceName := "aws_fsx_lustre_file_system.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFsxLustreFileSystemDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsFsxLustreFileSystemConfigImportPath(rName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFsxFileSystemExists(resourceName, &filesystem1),
+					resource.TestCheckResourceAttr(resourceName, "import_path", fmt.Sprintf("s3://%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "imported_file_chunk_size", "1024"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsFsxLustreFileSystemConfigImportPath(rName, "/prefix/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFsxFileSystemExists(resourceName, &filesystem2),
+					testAccCheckFsxFileSystemRecreated(&filesystem1, &filesystem2),
+					resource.TestCheckResourceAttr(resourceName, "import_path", fmt.Sprintf("s3://%s/prefix/", rName)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSFsxLustreFileSystem_Tags(t *testing.T) {
+	var filesystem1, filesystem2 fsx.FileSystem
+	resourceName := "aws_fsx_lustre_file_system.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFsxLustreFileSystemDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsFsxLustreFileSystemConfigTags1("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFsxFileSystemExists(resourceName, &filesystem1),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsFsxLustreFileSystemConfigTags2("key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFsxFileSystemExists(resourceName, &filesystem2),
+					testAccCheckFsxFileSystemNotRecreated(&filesystem1, &filesystem2),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSFsxLustreFileSystem_WeeklyMaintenanceStartTime(t *testing.T) {
+	var filesystem1, filesystem2 fsx.FileSystem
+	resourceName := "aws_fsx_lustre_file_system.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFsxLustreFileSystemDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsFsxLustreFileSystemConfigWeeklyMaintenanceStartTime("1:01:01"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFsxFileSystemExists(resourceName, &filesystem1),
+					resource.TestCheckResourceAttr(resourceName, "weekly_maintenance_start_time", "1:01:01"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsFsxLustreFileSystemConfigWeeklyMaintenanceStartTime("2:02:02"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFsxFileSystemExists(resourceName, &filesystem2),
+					testAccCheckFsxFileSystemNotRecreated(&filesystem1, &filesystem2),
+					resource.TestCheckResourceAttr(resourceName, "weekly_maintenance_start_time", "2:02:02"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFsxFileSystemExists(resourceName string, fs *fsx.FileSystem) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).fsxconn()
+
+		filesystem, err := describeFsxFileSystem(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if filesystem == nil {
+			return fmt.Errorf("FSx File System (%s) not found", rs.Primary.ID)
+		}
+
+		*fs = *filesystem
+
+		return nil
+	}
+}
+
+func testAccCheckFsxLustreFileSystemDestroy(s *terraform.State) error {
+	return testAccCheckFsxFileSystemDestroy(s, "aws_fsx_lustre_file_system")
+}
+
+func testAccCheckFsxFileSystemDestroy(s *terraform.State, resourceType string) error {
+	conn := testAccProvider.Meta().(*AWSClient).fsxconn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != resourceType {
+			continue
+		}
+
+		filesystem, err := describeFsxFileSystem(conn, rs.Primary.ID)
+
+		if isAWSErr(err, fsx.ErrCodeFileSystemNotFound, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if filesystem != nil {
+			return fmt.Errorf("FSx File System (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckFsxFileSystemDisappears(filesystem *fsx.FileSystem) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).fsxconn()
+
+		input := &fsx.DeleteFileSystemInput{
+			FileSystemId: filesystem.FileSystemId,
+		}
+		if filesystem.WindowsConfiguration != nil {
+			input.WindowsConfiguration = &fsx.DeleteFileSystemWindowsConfiguration{
+				SkipFinalBackup: aws.Bool(true),
+			}
+		}
+
+		if _, err := conn.DeleteFileSystem(input); err != nil {
+			return err
+		}
+
+		return waitForFsxFileSystemDeletion(conn, aws.StringValue(filesystem.FileSystemId), 30*time.Minute)
+	}
+}
+
+func testAccCheckFsxFileSystemNotRecreated(i, j *fsx.FileSystem) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(i.FileSystemId) != aws.StringValue(j.FileSystemId) {
+			return fmt.Errorf("FSx File System (%s) recreated", aws.StringValue(i.FileSystemId))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckFsxFileSystemRecreated(i, j *fsx.FileSystem) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(i.FileSystemId) == aws.StringValue(j.FileSystemId) {
+			return errors.New("FSx File System not recreated")
+		}
+
+		return nil
+	}
+}
+
+func testAccAwsFsxLustreFileSystemConfigBase() string {
+	return `
+data "aws_availability_zones" "available" {
+  state = "available"
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = "terraform-testacc-fsx-file-system"
+  }
+}
+
+resource "aws_subnet" "test1" {
+  vpc_id            = "${aws_vpc.test.id}"
+  cidr_block        = "10.0.1.0/24"
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+
+  tags = {
+    Name = "tf-acc-fsx-file-system-test1"
+  }
+}
+`
+}
+
+func testAccAwsFsxLustreFileSystemConfigExportPath(rName, exportPrefix string) string {
+	return testAccAwsFsxLustreFileSystemConfigBase() + fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  acl    = "private"
+  bucket = %[1]q
+}
+
+resource "aws_fsx_lustre_file_system" "test" {
+  export_path      = "s3://${aws_s3_bucket.test.bucket}%[2]s"
+  import_path      = "s3://${aws_s3_bucket.test.bucket}"
+  storage_capacity = 3600
+  subnet_ids       = ["${aws_subnet.test1.id}"]
+}
+`, rName, exportPrefix)
+}
+
+func testAccAwsFsxLustreFileSystemConfigImportPath(rName, importPrefix string) string {
+	return testAccAwsFsxLustreFileSystemConfigBase() + fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  acl    = "private"
+  bucket = %[1]q
+}
+
+resource "aws_fsx_lustre_file_system" "test" {
+  import_path      = "s3://${aws_s3_bucket.test.bucket}%[2]s"
+  storage_capacity = 3600
+  subnet_ids       = ["${aws_subnet.test1.id}"]
+}
+`, rName, importPrefix)
+}
+
+func testAccAwsFsxLustreFileSystemConfigSubnetIds1() string {
+	return testAccAwsFsxLustreFileSystemConfigBase() + `
+resource "aws_fsx_lustre_file_system" "test" {
+  storage_capacity = 3600
+  subnet_ids       = ["${aws_subnet.test1.id}"]
+}
+`
+}
+
+func testAccAwsFsxLustreFileSystemConfigTags1(tagKey1, tagValue1 string) string {
+	return testAccAwsFsxLustreFileSystemConfigBase() + fmt.Sprintf(`
+resource "aws_fsx_lustre_file_system" "test" {
+  storage_capacity = 3600
+  subnet_ids       = ["${aws_subnet.test1.id}"]
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1)
+}
+
+func testAccAwsFsxLustreFileSystemConfigTags2(tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return testAccAwsFsxLustreFileSystemConfigBase() + fmt.Sprintf(`
+resource "aws_fsx_lustre_file_system" "test" {
+  storage_capacity = 3600
+  subnet_ids       = ["${aws_subnet.test1.id}"]
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2)
+}
+
+func testAccAwsFsxLustreFileSystemConfigWeeklyMaintenanceStartTime(weeklyMaintenanceStartTime string) string {
+	return testAccAwsFsxLustreFileSystemConfigBase() + fmt.Sprintf(`
+resource "aws_fsx_lustre_file_system" "test" {
+  storage_capacity              = 3600
+  subnet_ids                    = ["${aws_subnet.test1.id}"]
+  weekly_maintenance_start_time = %[1]q
+}
+`, weeklyMaintenanceStartTime)
+}
@@ -0,0 +1,314 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/fsx"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_fsx_windows_file_system", &resource.Sweeper{
+		Name: "aws_fsx_windows_file_system",
+		F:    testSweepFSXWindowsFileSystems,
+	})
+}
+
+func testSweepFSXWindowsFileSystems(region string) error {
+	return testSweepFSXFileSystems(region, fsx.FileSystemTypeWindows)
+}
+
+func TestAccAWSFsxWindowsFileSystem_basic(t *testing.T) {
+	var filesystem fsx.FileSystem
+	resourceName := "aws_fsx_windows_file_system.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFsxWindowsFileSystemDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsFsxWindowsFileSystemConfigSubnetIds1(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFsxFileSystemExists(resourceName, &filesystem),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "fsx", regexp.MustCompile(`file-system/fs-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "automatic_backup_retention_days", "7"),
+					resource.TestCheckResourceAttr(resourceName, "copy_tags_to_backups", "false"),
+					resource.TestMatchResourceAttr(resourceName, "daily_automatic_backup_start_time", regexp.MustCompile(`^\d\d:\d\d$`)),
+					resource.TestMatchResourceAttr(resourceName, "dns_name", regexp.MustCompile(`fs-.+\..+`)),
+					testAccMatchResourceAttrRegionalARN(resourceName, "kms_key_id", "kms", regexp.MustCompile(`key/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "network_interface_ids.#", "1"),
+					testAccCheckResourceAttrAccountID(resourceName, "owner_id"),
+					resource.TestCheckResourceAttr(resourceName, "security_group_ids.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "skip_final_backup", "true"),
+					resource.TestCheckResourceAttr(resourceName, "storage_capacity", "300"),
+					resource.TestCheckResourceAttr(resourceName, "subnet_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "throughput_capacity", "8"),
+					resource.TestMatchResourceAttr(resourceName, "vpc_id", regexp.MustCompile(`^vpc-.+`)),
+					resource.TestMatchResourceAttr(resourceName, "weekly_maintenance_start_time", regexp.MustCompile(`^\d:\d\d:\d\d$`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"skip_final_backup",
+				},
+			},
+		},
+	})
+}
+
+func TestAccAWSFsxWindowsFileSystem_disappears(t *testing.T) {
+	var filesystem fsx.FileSystem
+	resourceName := "aws_fsx_windows_file_system.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFsxWindowsFileSystemDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsFsxWindowsFileSystemConfigSubnetIds1(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFsxFileSystemExists(resourceName, &filesystem),
+					testAccCheckFsxFileSystemDisappears(&filesystem),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSFsxWindowsFileSystem_AutomaticBackups(t *testing.T) {
+	var filesystem1, filesystem2 fsx.FileSystem
+	resourceName := "aws_fsx_windows_file_system.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFsxWindowsFileSystemDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsFsxWindowsFileSystemConfigAutomaticBackups(35, "01:01"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFsxFileSystemExists(resourceName, &filesystem1),
+					resource.TestCheckResourceAttr(resourceName, "automatic_backup_retention_days", "35"),
+					resource.TestCheckResourceAttr(resourceName, "daily_automatic_backup_start_time", "01:01"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"skip_final_backup",
+				},
+			},
+			{
+				Config: testAccAwsFsxWindowsFileSystemConfigAutomaticBackups(0, "02:02"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFsxFileSystemExists(resourceName, &filesystem2),
+					testAccCheckFsxFileSystemNotRecreated(&filesystem1, &filesystem2),
+					resource.TestCheckResourceAttr(resourceName, "automatic_backup_retention_days", "0"),
+					resource.TestCheckResourceAttr(resourceName, "daily_automatic_backup_start_time", "02:02"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSFsxWindowsFileSystem_ThroughputCapacity(t *testing.T) {
+	var filesystem1, filesystem2 fsx.FileSystem
+	resourceName := "aws_fsx_windows_file_system.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFsxWindowsFileSystemDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsFsxWindowsFileSystemConfigThroughputCapacity(16),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFsxFileSystemExists(resourceName, &filesystem1),
+					resource.TestCheckResourceAttr(resourceName, "throughput_capacity", "16"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"skip_final_backup",
+				},
+			},
+			{
+				Config: testAccAwsFsxWindowsFileSystemConfigThroughputCapacity(8),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFsxFileSystemExists(resourceName, &filesystem2),
+					testAccCheckFsxFileSystemRecreated(&filesystem1, &filesystem2),
+					resource.TestCheckResourceAttr(resourceName, "throughput_capacity", "8"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSFsxWindowsFileSystem_Tags(t *testing.T) {
+	var filesystem1, filesystem2 fsx.FileSystem
+	resourceName := "aws_fsx_windows_file_system.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFsxWindowsFileSystemDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsFsxWindowsFileSystemConfigTags1("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFsxFileSystemExists(resourceName, &filesystem1),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAwsFsxWindowsFileSystemConfigTags2("key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFsxFileSystemExists(resourceName, &filesystem2),
+					testAccCheckFsxFileSystemNotRecreated(&filesystem1, &filesystem2),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFsxWindowsFileSystemDestroy(s *terraform.State) error {
+	return testAccCheckFsxFileSystemDestroy(s, "aws_fsx_windows_file_system")
+}
+
+func testAccAwsFsxWindowsFileSystemConfigBase() string {
+	return `
+data "aws_availability_zones" "available" {
+  state = "available"
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = "terraform-testacc-fsx-windows-file-system"
+  }
+}
+
+resource "aws_subnet" "test1" {
+  vpc_id            = "${aws_vpc.test.id}"
+  cidr_block        = "10.0.1.0/24"
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+
+  tags = {
+    Name = "tf-acc-fsx-windows-file-system-test1"
+  }
+}
+
+resource "aws_subnet" "test2" {
+  vpc_id            = "${aws_vpc.test.id}"
+  cidr_block        = "10.0.2.0/24"
+  availability_zone = "${data.aws_availability_zones.available.names[1]}"
+
+  tags = {
+    Name = "tf-acc-fsx-windows-file-system-test2"
+  }
+}
+
+resource "aws_directory_service_directory" "test" {
+  edition  = "Standard"
+  name     = "corp.notexample.com"
+  password = "SuperSecretPassw0rd"
+  type     = "MicrosoftAD"
+
+  vpc_settings {
+    subnet_ids = ["${aws_subnet.test1.id}", "${aws_subnet.test2.id}"]
+    vpc_id     = "${aws_vpc.test.id}"
+  }
+}
+`
+}
+
+func testAccAwsFsxWindowsFileSystemConfigAutomaticBackups(automaticBackupRetentionDays int, dailyAutomaticBackupStartTime string) string {
+	return testAccAwsFsxWindowsFileSystemConfigBase() + fmt.Sprintf(`
+resource "aws_fsx_windows_file_system" "test" {
+  active_directory_id               = "${aws_directory_service_directory.test.id}"
+  automatic_backup_retention_days   = %[1]d
+  daily_automatic_backup_start_time = %[2]q
+  skip_final_backup                 = true
+  storage_capacity                  = 300
+  subnet_ids                        = ["${aws_subnet.test1.id}"]
+  throughput_capacity               = 8
+}
+`, automaticBackupRetentionDays, dailyAutomaticBackupStartTime)
+}
+
+func testAccAwsFsxWindowsFileSystemConfigSubnetIds1() string {
+	return testAccAwsFsxWindowsFileSystemConfigBase() + `
+resource "aws_fsx_windows_file_system" "test" {
+  active_directory_id = "${aws_directory_service_directory.test.id}"
+  skip_final_backup   = true
+  storage_capacity    = 300
+  subnet_ids          = ["${aws_subnet.test1.id}"]
+  throughput_capacity = 8
+}
+`
+}
+
+func testAccAwsFsxWindowsFileSystemConfigTags1(tagKey1, tagValue1 string) string {
+	return testAccAwsFsxWindowsFileSystemConfigBase() + fmt.Sprintf(`
+resource "aws_fsx_windows_file_system" "test" {
+  active_directory_id = "${aws_directory_service_directory.test.id}"
+  skip_final_backup   = true
+  storage_capacity    = 300
+  subnet_ids          = ["${aws_subnet.test1.id}"]
+  throughput_capacity = 8
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1)
+}
+
+func testAccAwsFsxWindowsFileSystemConfigTags2(tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return testAccAwsFsxWindowsFileSystemConfigBase() + fmt.Sprintf(`
+resource "aws_fsx_windows_file_system" "test" {
+  active_directory_id = "${aws_directory_service_directory.test.id}"
+  skip_final_backup   = true
+  storage_capacity    = 300
+  subnet_ids          = ["${aws_subnet.test1.id}"]
+  throughput_capacity = 8
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2)
+}
+
+func testAccAwsFsxWindowsFileSystemConfigThroughputCapacity(throughputCapacity int) string {
+	return testAccAwsFsxWindowsFileSystemConfigBase() + fmt.Sprintf(`
+resource "aws_fsx_windows_file_system" "test" {
+  active_directory_id = "${aws_directory_service_directory.test.id}"
+  skip_final_backup   = true
+  storage_capacity    = 300
+  subnet_ids          = ["${aws_subnet.test1.id}"]
+  throughput_capacity = %[1]d
+}
+`, throughputCapacity)
+}
@@ -65,7 +65,7 @@ func resourceAwsGameliftAlias() *schema.Resource {
 }
 
 func resourceAwsGameliftAliasCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).gameliftconn
+	conn := meta.(*AWSClient).gameliftconn()
 
 	rs := expandGameliftRoutingStrategy(d.Get("routing_strategy").([]interface{}))
 	input := gamelift.CreateAliasInput{
@@ -87,7 +87,7 @@ func resourceAwsGameliftAliasCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsGameliftAliasRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).gameliftconn
+	conn := meta.(*AWSClient).gameliftconn()
 
 	log.Printf("[INFO] Describing Gamelift Alias: %s", d.Id())
 	out, err := conn.DescribeAlias(&gamelift.DescribeAliasInput{
@@ -112,7 +112,7 @@ func resourceAwsGameliftAliasRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsGameliftAliasUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).gameliftconn
+	conn := meta.(*AWSClient).gameliftconn()
 
 	log.Printf("[INFO] Updating Gamelift Alias: %s", d.Id())
 	_, err := conn.UpdateAlias(&gamelift.UpdateAliasInput{
@@ -129,7 +129,7 @@ func resourceAwsGameliftAliasUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsGameliftAliasDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).gameliftconn
+	conn := meta.(*AWSClient).gameliftconn()
 
 	log.Printf("[INFO] Deleting Gamelift Alias: %s", d.Id())
 	_, err := conn.DeleteAlias(&gamelift.DeleteAliasInput{
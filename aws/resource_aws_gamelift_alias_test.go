@@ -27,7 +27,7 @@ func testSweepGameliftAliases(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).gameliftconn
+	conn := client.(*AWSClient).gameliftconn()
 
 	err = listGameliftAliases(&gamelift.ListAliasesInput{}, conn, func(resp *gamelift.ListAliasesOutput) error {
 		if len(resp.Aliases) == 0 {
@@ -203,7 +203,7 @@ func testAccCheckAWSGameliftAliasExists(n string, res *gamelift.Alias) resource.
 			return fmt.Errorf("No Gamelift Alias ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).gameliftconn
+		conn := testAccProvider.Meta().(*AWSClient).gameliftconn()
 
 		out, err := conn.DescribeAlias(&gamelift.DescribeAliasInput{
 			AliasId: aws.String(rs.Primary.ID),
@@ -224,7 +224,7 @@ func testAccCheckAWSGameliftAliasExists(n string, res *gamelift.Alias) resource.
 }
 
 func testAccCheckAWSGameliftAliasDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).gameliftconn
+	conn := testAccProvider.Meta().(*AWSClient).gameliftconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_gamelift_alias" {
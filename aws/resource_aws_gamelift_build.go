@@ -69,7 +69,7 @@ func resourceAwsGameliftBuild() *schema.Resource {
 }
 
 func resourceAwsGameliftBuildCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).gameliftconn
+	conn := meta.(*AWSClient).gameliftconn()
 
 	sl := expandGameliftStorageLocation(d.Get("storage_location").([]interface{}))
 	input := gamelift.CreateBuildInput{
@@ -123,7 +123,7 @@ func resourceAwsGameliftBuildCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsGameliftBuildRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).gameliftconn
+	conn := meta.(*AWSClient).gameliftconn()
 
 	log.Printf("[INFO] Reading Gamelift Build: %s", d.Id())
 	out, err := conn.DescribeBuild(&gamelift.DescribeBuildInput{
@@ -147,7 +147,7 @@ func resourceAwsGameliftBuildRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsGameliftBuildUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).gameliftconn
+	conn := meta.(*AWSClient).gameliftconn()
 
 	log.Printf("[INFO] Updating Gamelift Build: %s", d.Id())
 	input := gamelift.UpdateBuildInput{
@@ -167,7 +167,7 @@ func resourceAwsGameliftBuildUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsGameliftBuildDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).gameliftconn
+	conn := meta.(*AWSClient).gameliftconn()
 
 	log.Printf("[INFO] Deleting Gamelift Build: %s", d.Id())
 	_, err := conn.DeleteBuild(&gamelift.DeleteBuildInput{
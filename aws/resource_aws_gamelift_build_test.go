@@ -26,7 +26,7 @@ func testSweepGameliftBuilds(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).gameliftconn
+	conn := client.(*AWSClient).gameliftconn()
 
 	resp, err := conn.ListBuilds(&gamelift.ListBuildsInput{})
 	if err != nil {
@@ -121,7 +121,7 @@ func testAccCheckAWSGameliftBuildExists(n string, res *gamelift.Build) resource.
 			return fmt.Errorf("No Gamelift Build ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).gameliftconn
+		conn := testAccProvider.Meta().(*AWSClient).gameliftconn()
 
 		req := &gamelift.DescribeBuildInput{
 			BuildId: aws.String(rs.Primary.ID),
@@ -144,7 +144,7 @@ func testAccCheckAWSGameliftBuildExists(n string, res *gamelift.Build) resource.
 }
 
 func testAccCheckAWSGameliftBuildDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).gameliftconn
+	conn := testAccProvider.Meta().(*AWSClient).gameliftconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_gamelift_build" {
@@ -176,7 +176,7 @@ func resourceAwsGameliftFleet() *schema.Resource {
 }
 
 func resourceAwsGameliftFleetCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).gameliftconn
+	conn := meta.(*AWSClient).gameliftconn()
 
 	input := gamelift.CreateFleetInput{
 		BuildId:         aws.String(d.Get("build_id").(string)),
@@ -259,7 +259,7 @@ func resourceAwsGameliftFleetCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsGameliftFleetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).gameliftconn
+	conn := meta.(*AWSClient).gameliftconn()
 
 	log.Printf("[INFO] Describing Gamelift Fleet: %s", d.Id())
 	out, err := conn.DescribeFleetAttributes(&gamelift.DescribeFleetAttributesInput{
@@ -294,7 +294,7 @@ func resourceAwsGameliftFleetRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsGameliftFleetUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).gameliftconn
+	conn := meta.(*AWSClient).gameliftconn()
 
 	log.Printf("[INFO] Updating Gamelift Fleet: %s", d.Id())
 
@@ -341,7 +341,7 @@ func resourceAwsGameliftFleetUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsGameliftFleetDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).gameliftconn
+	conn := meta.(*AWSClient).gameliftconn()
 
 	log.Printf("[INFO] Deleting Gamelift Fleet: %s", d.Id())
 	// It can take ~ 1 hr as Gamelift will keep retrying on errors like
@@ -31,7 +31,7 @@ func testSweepGameliftFleets(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).gameliftconn
+	conn := client.(*AWSClient).gameliftconn()
 
 	return testAccGameliftListFleets(conn, nil, region, func(fleetIds []*string) error {
 		if len(fleetIds) == 0 {
@@ -433,7 +433,7 @@ func testAccCheckAWSGameliftFleetExists(n string, res *gamelift.FleetAttributes)
 			return fmt.Errorf("No Gamelift Fleet ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).gameliftconn
+		conn := testAccProvider.Meta().(*AWSClient).gameliftconn()
 
 		out, err := conn.DescribeFleetAttributes(&gamelift.DescribeFleetAttributesInput{
 			FleetIds: aws.StringSlice([]string{rs.Primary.ID}),
@@ -462,7 +462,7 @@ func testAccCheckAWSGameliftFleetExists(n string, res *gamelift.FleetAttributes)
 }
 
 func testAccCheckAWSGameliftFleetDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).gameliftconn
+	conn := testAccProvider.Meta().(*AWSClient).gameliftconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_gamelift_fleet" {
@@ -63,7 +63,7 @@ func resourceAwsGameliftGameSessionQueue() *schema.Resource {
 }
 
 func resourceAwsGameliftGameSessionQueueCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).gameliftconn
+	conn := meta.(*AWSClient).gameliftconn()
 
 	input := gamelift.CreateGameSessionQueueInput{
 		Name:                  aws.String(d.Get("name").(string)),
@@ -83,7 +83,7 @@ func resourceAwsGameliftGameSessionQueueCreate(d *schema.ResourceData, meta inte
 }
 
 func resourceAwsGameliftGameSessionQueueRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).gameliftconn
+	conn := meta.(*AWSClient).gameliftconn()
 	log.Printf("[INFO] Describing Gamelift Session Queues: %s", d.Id())
 	limit := int64(1)
 	out, err := conn.DescribeGameSessionQueues(&gamelift.DescribeGameSessionQueuesInput{
@@ -150,7 +150,7 @@ func flattenGameliftPlayerLatencyPolicies(playerLatencyPolicies []*gamelift.Play
 }
 
 func resourceAwsGameliftGameSessionQueueUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).gameliftconn
+	conn := meta.(*AWSClient).gameliftconn()
 
 	log.Printf("[INFO] Updating Gamelift Session Queue: %s", d.Id())
 
@@ -170,7 +170,7 @@ func resourceAwsGameliftGameSessionQueueUpdate(d *schema.ResourceData, meta inte
 }
 
 func resourceAwsGameliftGameSessionQueueDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).gameliftconn
+	conn := meta.(*AWSClient).gameliftconn()
 	log.Printf("[INFO] Deleting Gamelift Session Queue: %s", d.Id())
 	_, err := conn.DeleteGameSessionQueue(&gamelift.DeleteGameSessionQueueInput{
 		Name: aws.String(d.Id()),
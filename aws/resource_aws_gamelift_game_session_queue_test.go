@@ -27,7 +27,7 @@ func testSweepGameliftGameSessionQueue(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).gameliftconn
+	conn := client.(*AWSClient).gameliftconn()
 
 	out, err := conn.DescribeGameSessionQueues(&gamelift.DescribeGameSessionQueuesInput{})
 
@@ -179,7 +179,7 @@ func testAccCheckAWSGameliftGameSessionQueueExists(n string, res *gamelift.GameS
 			return fmt.Errorf("no Gamelift Session Queue Name is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).gameliftconn
+		conn := testAccProvider.Meta().(*AWSClient).gameliftconn()
 
 		name := rs.Primary.Attributes["name"]
 		limit := int64(1)
@@ -211,7 +211,7 @@ func testAccCheckAWSGameliftGameSessionQueueExists(n string, res *gamelift.GameS
 }
 
 func testAccCheckAWSGameliftGameSessionQueueDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).gameliftconn
+	conn := testAccProvider.Meta().(*AWSClient).gameliftconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_gamelift_game_session_queue" {
@@ -90,7 +90,7 @@ func resourceAwsGlacierVault() *schema.Resource {
 }
 
 func resourceAwsGlacierVaultCreate(d *schema.ResourceData, meta interface{}) error {
-	glacierconn := meta.(*AWSClient).glacierconn
+	glacierconn := meta.(*AWSClient).glacierconn()
 
 	input := &glacier.CreateVaultInput{
 		VaultName: aws.String(d.Get("name").(string)),
@@ -108,7 +108,7 @@ func resourceAwsGlacierVaultCreate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsGlacierVaultUpdate(d *schema.ResourceData, meta interface{}) error {
-	glacierconn := meta.(*AWSClient).glacierconn
+	glacierconn := meta.(*AWSClient).glacierconn()
 
 	if err := setGlacierVaultTags(glacierconn, d); err != nil {
 		return err
@@ -130,7 +130,7 @@ func resourceAwsGlacierVaultUpdate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsGlacierVaultRead(d *schema.ResourceData, meta interface{}) error {
-	glacierconn := meta.(*AWSClient).glacierconn
+	glacierconn := meta.(*AWSClient).glacierconn()
 
 	input := &glacier.DescribeVaultInput{
 		VaultName: aws.String(d.Id()),
@@ -187,7 +187,7 @@ func resourceAwsGlacierVaultRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsGlacierVaultDelete(d *schema.ResourceData, meta interface{}) error {
-	glacierconn := meta.(*AWSClient).glacierconn
+	glacierconn := meta.(*AWSClient).glacierconn()
 
 	log.Printf("[DEBUG] Glacier Delete Vault: %s", d.Id())
 	_, err := glacierconn.DeleteVault(&glacier.DeleteVaultInput{
@@ -273,8 +273,8 @@ func resourceAwsGlacierVaultPolicyUpdate(glacierconn *glacier.Glacier, d *schema
 }
 
 func setGlacierVaultTags(conn *glacier.Glacier, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if hasTagsChange(d) {
+		oraw, nraw := getTagsChange(d)
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := diffGlacierVaultTags(mapGlacierVaultTags(o), mapGlacierVaultTags(n))
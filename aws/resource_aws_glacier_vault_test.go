@@ -156,7 +156,7 @@ func testAccCheckGlacierVaultExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("No ID is set")
 		}
 
-		glacierconn := testAccProvider.Meta().(*AWSClient).glacierconn
+		glacierconn := testAccProvider.Meta().(*AWSClient).glacierconn()
 		out, err := glacierconn.DescribeVault(&glacier.DescribeVaultInput{
 			VaultName: aws.String(rs.Primary.ID),
 		})
@@ -189,7 +189,7 @@ func testAccCheckVaultNotificationsMissing(name string) resource.TestCheckFunc {
 			return fmt.Errorf("No ID is set")
 		}
 
-		glacierconn := testAccProvider.Meta().(*AWSClient).glacierconn
+		glacierconn := testAccProvider.Meta().(*AWSClient).glacierconn()
 		out, err := glacierconn.GetVaultNotifications(&glacier.GetVaultNotificationsInput{
 			VaultName: aws.String(rs.Primary.ID),
 		})
@@ -208,7 +208,7 @@ func testAccCheckVaultNotificationsMissing(name string) resource.TestCheckFunc {
 }
 
 func testAccCheckGlacierVaultDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).glacierconn
+	conn := testAccProvider.Meta().(*AWSClient).glacierconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_glacier_vault" {
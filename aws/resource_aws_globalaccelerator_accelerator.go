@@ -92,7 +92,7 @@ func resourceAwsGlobalAcceleratorAccelerator() *schema.Resource {
 }
 
 func resourceAwsGlobalAcceleratorAcceleratorCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).globalacceleratorconn
+	conn := meta.(*AWSClient).globalacceleratorconn()
 
 	opts := &globalaccelerator.CreateAcceleratorInput{
 		Name:             aws.String(d.Get("name").(string)),
@@ -129,7 +129,7 @@ func resourceAwsGlobalAcceleratorAcceleratorCreate(d *schema.ResourceData, meta
 }
 
 func resourceAwsGlobalAcceleratorAcceleratorRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).globalacceleratorconn
+	conn := meta.(*AWSClient).globalacceleratorconn()
 
 	accelerator, err := resourceAwsGlobalAcceleratorAcceleratorRetrieve(conn, d.Id())
 
@@ -232,7 +232,7 @@ func resourceAwsGlobalAcceleratorAcceleratorRetrieve(conn *globalaccelerator.Glo
 }
 
 func resourceAwsGlobalAcceleratorAcceleratorUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).globalacceleratorconn
+	conn := meta.(*AWSClient).globalacceleratorconn()
 
 	d.Partial(true)
 
@@ -323,7 +323,7 @@ func resourceAwsGlobalAcceleratorAcceleratorUpdateAttributes(conn *globalacceler
 }
 
 func resourceAwsGlobalAcceleratorAcceleratorDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).globalacceleratorconn
+	conn := meta.(*AWSClient).globalacceleratorconn()
 
 	{
 		opts := &globalaccelerator.UpdateAcceleratorInput{
@@ -113,7 +113,7 @@ func TestAccAwsGlobalAcceleratorAccelerator_attributes(t *testing.T) {
 
 func testAccCheckGlobalAcceleratorAcceleratorExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).globalacceleratorconn
+		conn := testAccProvider.Meta().(*AWSClient).globalacceleratorconn()
 
 		rs, ok := s.RootModule().Resources[name]
 		if !ok {
@@ -138,7 +138,7 @@ func testAccCheckGlobalAcceleratorAcceleratorExists(name string) resource.TestCh
 }
 
 func testAccCheckGlobalAcceleratorAcceleratorDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).globalacceleratorconn
+	conn := testAccProvider.Meta().(*AWSClient).globalacceleratorconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_globalaccelerator_accelerator" {
@@ -73,7 +73,7 @@ func resourceAwsGlobalAcceleratorListener() *schema.Resource {
 }
 
 func resourceAwsGlobalAcceleratorListenerCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).globalacceleratorconn
+	conn := meta.(*AWSClient).globalacceleratorconn()
 
 	opts := &globalaccelerator.CreateListenerInput{
 		AcceleratorArn:   aws.String(d.Get("accelerator_arn").(string)),
@@ -110,7 +110,7 @@ func resourceAwsGlobalAcceleratorListenerCreate(d *schema.ResourceData, meta int
 }
 
 func resourceAwsGlobalAcceleratorListenerRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).globalacceleratorconn
+	conn := meta.(*AWSClient).globalacceleratorconn()
 
 	listener, err := resourceAwsGlobalAcceleratorListenerRetrieve(conn, d.Id())
 
@@ -195,7 +195,7 @@ func resourceAwsGlobalAcceleratorListenerRetrieve(conn *globalaccelerator.Global
 }
 
 func resourceAwsGlobalAcceleratorListenerUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).globalacceleratorconn
+	conn := meta.(*AWSClient).globalacceleratorconn()
 
 	opts := &globalaccelerator.UpdateListenerInput{
 		ClientAffinity: aws.String(d.Get("client_affinity").(string)),
@@ -229,7 +229,7 @@ func resourceAwsGlobalAcceleratorListenerUpdate(d *schema.ResourceData, meta int
 }
 
 func resourceAwsGlobalAcceleratorListenerDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).globalacceleratorconn
+	conn := meta.(*AWSClient).globalacceleratorconn()
 
 	opts := &globalaccelerator.DeleteListenerInput{
 		ListenerArn: aws.String(d.Id()),
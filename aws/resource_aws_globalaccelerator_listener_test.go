@@ -72,7 +72,7 @@ func TestAccAwsGlobalAcceleratorListener_update(t *testing.T) {
 
 func testAccCheckGlobalAcceleratorListenerExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).globalacceleratorconn
+		conn := testAccProvider.Meta().(*AWSClient).globalacceleratorconn()
 
 		rs, ok := s.RootModule().Resources[name]
 		if !ok {
@@ -97,7 +97,7 @@ func testAccCheckGlobalAcceleratorListenerExists(name string) resource.TestCheck
 }
 
 func testAccCheckGlobalAcceleratorListenerDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).globalacceleratorconn
+	conn := testAccProvider.Meta().(*AWSClient).globalacceleratorconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_globalaccelerator_listener" {
@@ -52,7 +52,7 @@ func resourceAwsGlueCatalogDatabase() *schema.Resource {
 }
 
 func resourceAwsGlueCatalogDatabaseCreate(d *schema.ResourceData, meta interface{}) error {
-	glueconn := meta.(*AWSClient).glueconn
+	glueconn := meta.(*AWSClient).glueconn()
 	catalogID := createAwsGlueCatalogID(d, meta.(*AWSClient).accountid)
 	name := d.Get("name").(string)
 
@@ -74,7 +74,7 @@ func resourceAwsGlueCatalogDatabaseCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsGlueCatalogDatabaseUpdate(d *schema.ResourceData, meta interface{}) error {
-	glueconn := meta.(*AWSClient).glueconn
+	glueconn := meta.(*AWSClient).glueconn()
 
 	catalogID, name, err := readAwsGlueCatalogID(d.Id())
 	if err != nil {
@@ -118,7 +118,7 @@ func resourceAwsGlueCatalogDatabaseUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsGlueCatalogDatabaseRead(d *schema.ResourceData, meta interface{}) error {
-	glueconn := meta.(*AWSClient).glueconn
+	glueconn := meta.(*AWSClient).glueconn()
 
 	catalogID, name, err := readAwsGlueCatalogID(d.Id())
 	if err != nil {
@@ -159,7 +159,7 @@ func resourceAwsGlueCatalogDatabaseRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsGlueCatalogDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
-	glueconn := meta.(*AWSClient).glueconn
+	glueconn := meta.(*AWSClient).glueconn()
 	catalogID, name, err := readAwsGlueCatalogID(d.Id())
 	if err != nil {
 		return err
@@ -176,7 +176,7 @@ func resourceAwsGlueCatalogDatabaseDelete(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsGlueCatalogDatabaseExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	glueconn := meta.(*AWSClient).glueconn
+	glueconn := meta.(*AWSClient).glueconn()
 	catalogID, name, err := readAwsGlueCatalogID(d.Id())
 	if err != nil {
 		return false, err
@@ -152,7 +152,7 @@ func TestAccAWSGlueCatalogDatabase_recreates(t *testing.T) {
 			{
 				// Simulate deleting the database outside Terraform
 				PreConfig: func() {
-					conn := testAccProvider.Meta().(*AWSClient).glueconn
+					conn := testAccProvider.Meta().(*AWSClient).glueconn()
 					input := &glue.DeleteDatabaseInput{
 						Name: aws.String(fmt.Sprintf("my_test_catalog_database_%d", rInt)),
 					}
@@ -170,7 +170,7 @@ func TestAccAWSGlueCatalogDatabase_recreates(t *testing.T) {
 }
 
 func testAccCheckGlueDatabaseDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).glueconn
+	conn := testAccProvider.Meta().(*AWSClient).glueconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_glue_catalog_database" {
@@ -238,7 +238,7 @@ func testAccCheckGlueCatalogDatabaseExists(name string) resource.TestCheckFunc {
 			return err
 		}
 
-		glueconn := testAccProvider.Meta().(*AWSClient).glueconn
+		glueconn := testAccProvider.Meta().(*AWSClient).glueconn()
 		out, err := glueconn.GetDatabase(&glue.GetDatabaseInput{
 			CatalogId: aws.String(catalogId),
 			Name:      aws.String(dbName),
@@ -224,7 +224,7 @@ func readAwsGlueTableID(id string) (catalogID string, dbName string, name string
 }
 
 func resourceAwsGlueCatalogTableCreate(d *schema.ResourceData, meta interface{}) error {
-	glueconn := meta.(*AWSClient).glueconn
+	glueconn := meta.(*AWSClient).glueconn()
 	catalogID := createAwsGlueCatalogID(d, meta.(*AWSClient).accountid)
 	dbName := d.Get("database_name").(string)
 	name := d.Get("name").(string)
@@ -246,7 +246,7 @@ func resourceAwsGlueCatalogTableCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsGlueCatalogTableRead(d *schema.ResourceData, meta interface{}) error {
-	glueconn := meta.(*AWSClient).glueconn
+	glueconn := meta.(*AWSClient).glueconn()
 
 	catalogID, dbName, name, err := readAwsGlueTableID(d.Id())
 	if err != nil {
@@ -297,7 +297,7 @@ func resourceAwsGlueCatalogTableRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsGlueCatalogTableUpdate(d *schema.ResourceData, meta interface{}) error {
-	glueconn := meta.(*AWSClient).glueconn
+	glueconn := meta.(*AWSClient).glueconn()
 
 	catalogID, dbName, _, err := readAwsGlueTableID(d.Id())
 	if err != nil {
@@ -318,7 +318,7 @@ func resourceAwsGlueCatalogTableUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsGlueCatalogTableDelete(d *schema.ResourceData, meta interface{}) error {
-	glueconn := meta.(*AWSClient).glueconn
+	glueconn := meta.(*AWSClient).glueconn()
 
 	catalogID, dbName, name, tableIdErr := readAwsGlueTableID(d.Id())
 	if tableIdErr != nil {
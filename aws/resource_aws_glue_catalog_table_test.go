@@ -494,7 +494,7 @@ resource "aws_glue_catalog_table" "test" {
 }
 
 func testAccCheckGlueTableDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).glueconn
+	conn := testAccProvider.Meta().(*AWSClient).glueconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_glue_catalog_table" {
@@ -540,7 +540,7 @@ func testAccCheckGlueCatalogTableExists(name string) resource.TestCheckFunc {
 			return err
 		}
 
-		glueconn := testAccProvider.Meta().(*AWSClient).glueconn
+		glueconn := testAccProvider.Meta().(*AWSClient).glueconn()
 		out, err := glueconn.GetTable(&glue.GetTableInput{
 			CatalogId:    aws.String(catalogId),
 			DatabaseName: aws.String(dbName),
@@ -24,7 +24,7 @@ func testSweepGlueClassifiers(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).glueconn
+	conn := client.(*AWSClient).glueconn()
 
 	input := &glue.GetClassifiersInput{}
 	err = conn.GetClassifiersPages(input, func(page *glue.GetClassifiersOutput, lastPage bool) bool {
@@ -318,7 +318,7 @@ func testAccCheckAWSGlueClassifierExists(resourceName string, classifier *glue.C
 			return fmt.Errorf("No Glue Classifier ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).glueconn
+		conn := testAccProvider.Meta().(*AWSClient).glueconn()
 
 		output, err := conn.GetClassifier(&glue.GetClassifierInput{
 			Name: aws.String(rs.Primary.ID),
@@ -342,7 +342,7 @@ func testAccCheckAWSGlueClassifierDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).glueconn
+		conn := testAccProvider.Meta().(*AWSClient).glueconn()
 
 		output, err := conn.GetClassifier(&glue.GetClassifierInput{
 			Name: aws.String(rs.Primary.ID),
@@ -83,7 +83,7 @@ func resourceAwsGlueConnection() *schema.Resource {
 }
 
 func resourceAwsGlueConnectionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).glueconn
+	conn := meta.(*AWSClient).glueconn()
 	var catalogID string
 	if v, ok := d.GetOkExists("catalog_id"); ok {
 		catalogID = v.(string)
@@ -109,7 +109,7 @@ func resourceAwsGlueConnectionCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsGlueConnectionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).glueconn
+	conn := meta.(*AWSClient).glueconn()
 
 	catalogID, connectionName, err := decodeGlueConnectionID(d.Id())
 	if err != nil {
@@ -157,7 +157,7 @@ func resourceAwsGlueConnectionRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsGlueConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).glueconn
+	conn := meta.(*AWSClient).glueconn()
 
 	catalogID, connectionName, err := decodeGlueConnectionID(d.Id())
 	if err != nil {
@@ -180,7 +180,7 @@ func resourceAwsGlueConnectionUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsGlueConnectionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).glueconn
+	conn := meta.(*AWSClient).glueconn()
 
 	catalogID, connectionName, err := decodeGlueConnectionID(d.Id())
 	if err != nil {
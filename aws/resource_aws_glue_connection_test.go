@@ -24,7 +24,7 @@ func testSweepGlueConnections(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).glueconn
+	conn := client.(*AWSClient).glueconn()
 	catalogID := client.(*AWSClient).accountid
 
 	input := &glue.GetConnectionsInput{
@@ -219,7 +219,7 @@ func testAccCheckAWSGlueConnectionExists(resourceName string, connection *glue.C
 			return fmt.Errorf("No Glue Connection ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).glueconn
+		conn := testAccProvider.Meta().(*AWSClient).glueconn()
 		catalogID, connectionName, err := decodeGlueConnectionID(rs.Primary.ID)
 		if err != nil {
 			return err
@@ -252,7 +252,7 @@ func testAccCheckAWSGlueConnectionDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).glueconn
+		conn := testAccProvider.Meta().(*AWSClient).glueconn()
 		catalogID, connectionName, err := decodeGlueConnectionID(rs.Primary.ID)
 		if err != nil {
 			return err
@@ -178,7 +178,7 @@ func resourceAwsGlueCrawler() *schema.Resource {
 }
 
 func resourceAwsGlueCrawlerCreate(d *schema.ResourceData, meta interface{}) error {
-	glueConn := meta.(*AWSClient).glueconn
+	glueConn := meta.(*AWSClient).glueconn()
 	name := d.Get("name").(string)
 
 	crawlerInput, err := createCrawlerInput(name, d)
@@ -408,7 +408,7 @@ func expandGlueJdbcTarget(cfg map[string]interface{}) *glue.JdbcTarget {
 }
 
 func resourceAwsGlueCrawlerUpdate(d *schema.ResourceData, meta interface{}) error {
-	glueConn := meta.(*AWSClient).glueconn
+	glueConn := meta.(*AWSClient).glueconn()
 	name := d.Get("name").(string)
 
 	updateCrawlerInput, err := updateCrawlerInput(name, d)
@@ -440,7 +440,7 @@ func resourceAwsGlueCrawlerUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsGlueCrawlerRead(d *schema.ResourceData, meta interface{}) error {
-	glueConn := meta.(*AWSClient).glueconn
+	glueConn := meta.(*AWSClient).glueconn()
 
 	input := &glue.GetCrawlerInput{
 		Name: aws.String(d.Id()),
@@ -554,7 +554,7 @@ func flattenGlueJdbcTargets(jdbcTargets []*glue.JdbcTarget) []map[string]interfa
 }
 
 func resourceAwsGlueCrawlerDelete(d *schema.ResourceData, meta interface{}) error {
-	glueConn := meta.(*AWSClient).glueconn
+	glueConn := meta.(*AWSClient).glueconn()
 
 	log.Printf("[DEBUG] deleting Glue crawler: %s", d.Id())
 	_, err := glueConn.DeleteCrawler(&glue.DeleteCrawlerInput{
@@ -570,7 +570,7 @@ func resourceAwsGlueCrawlerDelete(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsGlueCrawlerExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	glueConn := meta.(*AWSClient).glueconn
+	glueConn := meta.(*AWSClient).glueconn()
 
 	input := &glue.GetCrawlerInput{
 		Name: aws.String(d.Id()),
@@ -27,7 +27,7 @@ func testSweepGlueCrawlers(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).glueconn
+	conn := client.(*AWSClient).glueconn()
 
 	input := &glue.GetCrawlersInput{}
 	err = conn.GetCrawlersPages(input, func(page *glue.GetCrawlersOutput, lastPage bool) bool {
@@ -464,7 +464,7 @@ func TestAccAWSGlueCrawler_recreates(t *testing.T) {
 			{
 				// Simulate deleting the crawler outside Terraform
 				PreConfig: func() {
-					conn := testAccProvider.Meta().(*AWSClient).glueconn
+					conn := testAccProvider.Meta().(*AWSClient).glueconn()
 					input := &glue.DeleteCrawlerInput{
 						Name: aws.String(rName),
 					}
@@ -819,7 +819,7 @@ func testAccCheckAWSGlueCrawlerExists(resourceName string, crawler *glue.Crawler
 			return fmt.Errorf("no ID is set")
 		}
 
-		glueConn := testAccProvider.Meta().(*AWSClient).glueconn
+		glueConn := testAccProvider.Meta().(*AWSClient).glueconn()
 		out, err := glueConn.GetCrawler(&glue.GetCrawlerInput{
 			Name: aws.String(rs.Primary.ID),
 		})
@@ -844,7 +844,7 @@ func testAccCheckAWSGlueCrawlerDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).glueconn
+		conn := testAccProvider.Meta().(*AWSClient).glueconn()
 		output, err := conn.GetCrawler(&glue.GetCrawlerInput{
 			Name: aws.String(rs.Primary.ID),
 		})
@@ -104,7 +104,7 @@ func resourceAwsGlueJob() *schema.Resource {
 }
 
 func resourceAwsGlueJobCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).glueconn
+	conn := meta.(*AWSClient).glueconn()
 	name := d.Get("name").(string)
 
 	input := &glue.CreateJobInput{
@@ -160,7 +160,7 @@ func resourceAwsGlueJobCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsGlueJobRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).glueconn
+	conn := meta.(*AWSClient).glueconn()
 
 	input := &glue.GetJobInput{
 		JobName: aws.String(d.Id()),
@@ -210,7 +210,7 @@ func resourceAwsGlueJobRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsGlueJobUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).glueconn
+	conn := meta.(*AWSClient).glueconn()
 
 	jobUpdate := &glue.JobUpdate{
 		Command: expandGlueJobCommand(d.Get("command").([]interface{})),
@@ -267,7 +267,7 @@ func resourceAwsGlueJobUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsGlueJobDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).glueconn
+	conn := meta.(*AWSClient).glueconn()
 
 	log.Printf("[DEBUG] Deleting Glue Job: %s", d.Id())
 	err := deleteGlueJob(conn, d.Id())
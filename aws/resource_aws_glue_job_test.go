@@ -25,7 +25,7 @@ func testSweepGlueJobs(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).glueconn
+	conn := client.(*AWSClient).glueconn()
 
 	input := &glue.GetJobsInput{}
 	err = conn.GetJobsPages(input, func(page *glue.GetJobsOutput, lastPage bool) bool {
@@ -390,7 +390,7 @@ func testAccCheckAWSGlueJobExists(resourceName string, job *glue.Job) resource.T
 			return fmt.Errorf("No Glue Job ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).glueconn
+		conn := testAccProvider.Meta().(*AWSClient).glueconn()
 
 		output, err := conn.GetJob(&glue.GetJobInput{
 			JobName: aws.String(rs.Primary.ID),
@@ -418,7 +418,7 @@ func testAccCheckAWSGlueJobDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).glueconn
+		conn := testAccProvider.Meta().(*AWSClient).glueconn()
 
 		output, err := conn.GetJob(&glue.GetJobInput{
 			JobName: aws.String(rs.Primary.ID),
@@ -131,7 +131,7 @@ func resourceAwsGlueTrigger() *schema.Resource {
 }
 
 func resourceAwsGlueTriggerCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).glueconn
+	conn := meta.(*AWSClient).glueconn()
 	name := d.Get("name").(string)
 	triggerType := d.Get("type").(string)
 
@@ -187,7 +187,7 @@ func resourceAwsGlueTriggerCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsGlueTriggerRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).glueconn
+	conn := meta.(*AWSClient).glueconn()
 
 	input := &glue.GetTriggerInput{
 		Name: aws.String(d.Id()),
@@ -239,7 +239,7 @@ func resourceAwsGlueTriggerRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsGlueTriggerUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).glueconn
+	conn := meta.(*AWSClient).glueconn()
 
 	if d.HasChange("actions") ||
 		d.HasChange("description") ||
@@ -300,7 +300,7 @@ func resourceAwsGlueTriggerUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsGlueTriggerDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).glueconn
+	conn := meta.(*AWSClient).glueconn()
 
 	log.Printf("[DEBUG] Deleting Glue Trigger: %s", d.Id())
 	err := deleteGlueTrigger(conn, d.Id())
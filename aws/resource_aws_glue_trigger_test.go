@@ -24,7 +24,7 @@ func testSweepGlueTriggers(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).glueconn
+	conn := client.(*AWSClient).glueconn()
 
 	input := &glue.GetTriggersInput{}
 	err = conn.GetTriggersPages(input, func(page *glue.GetTriggersOutput, lastPage bool) bool {
@@ -250,7 +250,7 @@ func testAccCheckAWSGlueTriggerExists(resourceName string, trigger *glue.Trigger
 			return fmt.Errorf("No Glue Trigger ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).glueconn
+		conn := testAccProvider.Meta().(*AWSClient).glueconn()
 
 		output, err := conn.GetTrigger(&glue.GetTriggerInput{
 			Name: aws.String(rs.Primary.ID),
@@ -278,7 +278,7 @@ func testAccCheckAWSGlueTriggerDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).glueconn
+		conn := testAccProvider.Meta().(*AWSClient).glueconn()
 
 		output, err := conn.GetTrigger(&glue.GetTriggerInput{
 			Name: aws.String(rs.Primary.ID),
@@ -42,7 +42,7 @@ func resourceAwsGuardDutyDetector() *schema.Resource {
 }
 
 func resourceAwsGuardDutyDetectorCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).guarddutyconn
+	conn := meta.(*AWSClient).guarddutyconn()
 
 	input := guardduty.CreateDetectorInput{
 		Enable: aws.Bool(d.Get("enable").(bool)),
@@ -63,7 +63,7 @@ func resourceAwsGuardDutyDetectorCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsGuardDutyDetectorRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).guarddutyconn
+	conn := meta.(*AWSClient).guarddutyconn()
 	input := guardduty.GetDetectorInput{
 		DetectorId: aws.String(d.Id()),
 	}
@@ -87,7 +87,7 @@ func resourceAwsGuardDutyDetectorRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsGuardDutyDetectorUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).guarddutyconn
+	conn := meta.(*AWSClient).guarddutyconn()
 
 	input := guardduty.UpdateDetectorInput{
 		DetectorId:                 aws.String(d.Id()),
@@ -105,7 +105,7 @@ func resourceAwsGuardDutyDetectorUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsGuardDutyDetectorDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).guarddutyconn
+	conn := meta.(*AWSClient).guarddutyconn()
 	input := guardduty.DeleteDetectorInput{
 		DetectorId: aws.String(d.Id()),
 	}
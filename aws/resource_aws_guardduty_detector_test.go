@@ -74,7 +74,7 @@ func testAccAwsGuardDutyDetector_import(t *testing.T) {
 }
 
 func testAccCheckAwsGuardDutyDetectorDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).guarddutyconn
+	conn := testAccProvider.Meta().(*AWSClient).guarddutyconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_guardduty_detector" {
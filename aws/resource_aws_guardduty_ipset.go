@@ -60,7 +60,7 @@ func resourceAwsGuardDutyIpset() *schema.Resource {
 }
 
 func resourceAwsGuardDutyIpsetCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).guarddutyconn
+	conn := meta.(*AWSClient).guarddutyconn()
 
 	detectorID := d.Get("detector_id").(string)
 	input := &guardduty.CreateIPSetInput{
@@ -94,7 +94,7 @@ func resourceAwsGuardDutyIpsetCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsGuardDutyIpsetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).guarddutyconn
+	conn := meta.(*AWSClient).guarddutyconn()
 
 	ipSetId, detectorId, err := decodeGuardDutyIpsetID(d.Id())
 	if err != nil {
@@ -124,7 +124,7 @@ func resourceAwsGuardDutyIpsetRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsGuardDutyIpsetUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).guarddutyconn
+	conn := meta.(*AWSClient).guarddutyconn()
 
 	ipSetId, detectorId, err := decodeGuardDutyIpsetID(d.Id())
 	if err != nil {
@@ -154,7 +154,7 @@ func resourceAwsGuardDutyIpsetUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsGuardDutyIpsetDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).guarddutyconn
+	conn := meta.(*AWSClient).guarddutyconn()
 
 	ipSetId, detectorId, err := decodeGuardDutyIpsetID(d.Id())
 	if err != nil {
@@ -74,7 +74,7 @@ func testAccAwsGuardDutyIpset_import(t *testing.T) {
 }
 
 func testAccCheckAwsGuardDutyIpsetDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).guarddutyconn
+	conn := testAccProvider.Meta().(*AWSClient).guarddutyconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_guardduty_ipset" {
@@ -125,7 +125,7 @@ func testAccCheckAwsGuardDutyIpsetExists(name string) resource.TestCheckFunc {
 			IpSetId:    aws.String(ipSetId),
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).guarddutyconn
+		conn := testAccProvider.Meta().(*AWSClient).guarddutyconn()
 		_, err = conn.GetIPSet(input)
 		return err
 	}
@@ -152,7 +152,7 @@ func testAccAwsGuardDutyMember_invitationMessage(t *testing.T) {
 }
 
 func testAccCheckAwsGuardDutyMemberDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).guarddutyconn
+	conn := testAccProvider.Meta().(*AWSClient).guarddutyconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_guardduty_member" {
@@ -204,7 +204,7 @@ func testAccCheckAwsGuardDutyMemberExists(name string) resource.TestCheckFunc {
 			DetectorId: aws.String(detectorID),
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).guarddutyconn
+		conn := testAccProvider.Meta().(*AWSClient).guarddutyconn()
 		gmo, err := conn.GetMembers(input)
 		if err != nil {
 			return err
@@ -60,7 +60,7 @@ func resourceAwsGuardDutyThreatintelset() *schema.Resource {
 }
 
 func resourceAwsGuardDutyThreatintelsetCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).guarddutyconn
+	conn := meta.(*AWSClient).guarddutyconn()
 
 	detectorID := d.Get("detector_id").(string)
 	input := &guardduty.CreateThreatIntelSetInput{
@@ -95,7 +95,7 @@ func resourceAwsGuardDutyThreatintelsetCreate(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsGuardDutyThreatintelsetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).guarddutyconn
+	conn := meta.(*AWSClient).guarddutyconn()
 
 	threatIntelSetId, detectorId, err := decodeGuardDutyThreatintelsetID(d.Id())
 	if err != nil {
@@ -125,7 +125,7 @@ func resourceAwsGuardDutyThreatintelsetRead(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsGuardDutyThreatintelsetUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).guarddutyconn
+	conn := meta.(*AWSClient).guarddutyconn()
 
 	threatIntelSetID, detectorId, err := decodeGuardDutyThreatintelsetID(d.Id())
 	if err != nil {
@@ -155,7 +155,7 @@ func resourceAwsGuardDutyThreatintelsetUpdate(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsGuardDutyThreatintelsetDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).guarddutyconn
+	conn := meta.(*AWSClient).guarddutyconn()
 
 	threatIntelSetID, detectorId, err := decodeGuardDutyThreatintelsetID(d.Id())
 	if err != nil {
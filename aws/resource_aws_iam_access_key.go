@@ -64,7 +64,7 @@ func resourceAwsIamAccessKey() *schema.Resource {
 }
 
 func resourceAwsIamAccessKeyCreate(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 
 	request := &iam.CreateAccessKeyInput{
 		UserName: aws.String(d.Get("user").(string)),
@@ -119,7 +119,7 @@ func resourceAwsIamAccessKeyCreate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsIamAccessKeyRead(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 
 	request := &iam.ListAccessKeysInput{
 		UserName: aws.String(d.Get("user").(string)),
@@ -158,7 +158,7 @@ func resourceAwsIamAccessKeyReadResult(d *schema.ResourceData, key *iam.AccessKe
 }
 
 func resourceAwsIamAccessKeyUpdate(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 
 	if d.HasChange("status") {
 		if err := resourceAwsIamAccessKeyStatusUpdate(iamconn, d); err != nil {
@@ -170,7 +170,7 @@ func resourceAwsIamAccessKeyUpdate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsIamAccessKeyDelete(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 
 	request := &iam.DeleteAccessKeyInput{
 		AccessKeyId: aws.String(d.Id()),
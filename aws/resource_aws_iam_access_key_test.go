@@ -93,7 +93,7 @@ func TestAccAWSAccessKey_inactive(t *testing.T) {
 }
 
 func testAccCheckAWSAccessKeyDestroy(s *terraform.State) error {
-	iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+	iamconn := testAccProvider.Meta().(*AWSClient).iamconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_access_key" {
@@ -135,7 +135,7 @@ func testAccCheckAWSAccessKeyExists(n string, res *iam.AccessKeyMetadata) resour
 			return fmt.Errorf("No Role name is set")
 		}
 
-		iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+		iamconn := testAccProvider.Meta().(*AWSClient).iamconn()
 		name := rs.Primary.Attributes["user"]
 
 		resp, err := iamconn.ListAccessKeys(&iam.ListAccessKeysInput{
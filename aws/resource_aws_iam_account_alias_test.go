@@ -39,7 +39,7 @@ func testAccAWSIAMAccountAlias_importBasic(t *testing.T) {
 
 	rstring := acctest.RandString(5)
 
-	resource.Test(t, resource.TestCase{
+	testAccCassetteTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckAWSIAMAccountAliasDestroy,
@@ -62,7 +62,7 @@ func testAccAWSIAMAccountAlias_basic_with_datasource(t *testing.T) {
 
 	rstring := acctest.RandString(5)
 
-	resource.Test(t, resource.TestCase{
+	testAccCassetteTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckAWSIAMAccountAliasDestroy,
@@ -90,7 +90,7 @@ func testAccAWSIAMAccountAlias_basic_with_datasource(t *testing.T) {
 }
 
 func testAccCheckAWSIAMAccountAliasDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).iamconn
+	conn := testAccProvider.Meta().(*AWSClient).iamconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_iam_account_alias" {
@@ -136,7 +136,7 @@ func testAccCheckAWSIAMAccountAliasExists(n string, a *string) resource.TestChec
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).iamconn
+		conn := testAccProvider.Meta().(*AWSClient).iamconn()
 		params := &iam.ListAccountAliasesInput{}
 
 		resp, err := conn.ListAccountAliases(params)
@@ -76,7 +76,7 @@ func resourceAwsIamAccountPasswordPolicy() *schema.Resource {
 }
 
 func resourceAwsIamAccountPasswordPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 
 	input := &iam.UpdateAccountPasswordPolicyInput{}
 
@@ -121,7 +121,7 @@ func resourceAwsIamAccountPasswordPolicyUpdate(d *schema.ResourceData, meta inte
 }
 
 func resourceAwsIamAccountPasswordPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 
 	input := &iam.GetAccountPasswordPolicyInput{}
 	resp, err := iamconn.GetAccountPasswordPolicy(input)
@@ -154,7 +154,7 @@ func resourceAwsIamAccountPasswordPolicyRead(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsIamAccountPasswordPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 
 	log.Println("[DEBUG] Deleting IAM account password policy")
 	input := &iam.DeleteAccountPasswordPolicyInput{}
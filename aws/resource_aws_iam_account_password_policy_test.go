@@ -13,7 +13,7 @@ import (
 func TestAccAWSIAMAccountPasswordPolicy_importBasic(t *testing.T) {
 	resourceName := "aws_iam_account_password_policy.default"
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccCassetteParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckAWSIAMAccountPasswordPolicyDestroy,
@@ -34,7 +34,7 @@ func TestAccAWSIAMAccountPasswordPolicy_importBasic(t *testing.T) {
 func TestAccAWSIAMAccountPasswordPolicy_basic(t *testing.T) {
 	var policy iam.GetAccountPasswordPolicyOutput
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccCassetteParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckAWSIAMAccountPasswordPolicyDestroy,
@@ -58,7 +58,7 @@ func TestAccAWSIAMAccountPasswordPolicy_basic(t *testing.T) {
 }
 
 func testAccCheckAWSIAMAccountPasswordPolicyDestroy(s *terraform.State) error {
-	iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+	iamconn := testAccProvider.Meta().(*AWSClient).iamconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_iam_account_password_policy" {
@@ -95,7 +95,7 @@ func testAccCheckAWSIAMAccountPasswordPolicyExists(n string, res *iam.GetAccount
 			return fmt.Errorf("No policy ID is set")
 		}
 
-		iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+		iamconn := testAccProvider.Meta().(*AWSClient).iamconn()
 
 		resp, err := iamconn.GetAccountPasswordPolicy(&iam.GetAccountPasswordPolicyInput{})
 		if err != nil {
@@ -45,7 +45,7 @@ func resourceAwsIamGroup() *schema.Resource {
 }
 
 func resourceAwsIamGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 	name := d.Get("name").(string)
 	path := d.Get("path").(string)
 
@@ -64,7 +64,7 @@ func resourceAwsIamGroupCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsIamGroupRead(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 
 	request := &iam.GetGroupInput{
 		GroupName: aws.String(d.Id()),
@@ -99,7 +99,7 @@ func resourceAwsIamGroupReadResult(d *schema.ResourceData, group *iam.Group) err
 
 func resourceAwsIamGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("name") || d.HasChange("path") {
-		iamconn := meta.(*AWSClient).iamconn
+		iamconn := meta.(*AWSClient).iamconn()
 		on, nn := d.GetChange("name")
 		_, np := d.GetChange("path")
 
@@ -118,7 +118,7 @@ func resourceAwsIamGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsIamGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 
 	request := &iam.DeleteGroupInput{
 		GroupName: aws.String(d.Id()),
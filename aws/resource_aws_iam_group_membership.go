@@ -40,7 +40,7 @@ func resourceAwsIamGroupMembership() *schema.Resource {
 }
 
 func resourceAwsIamGroupMembershipCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).iamconn()
 
 	group := d.Get("group").(string)
 	userList := expandStringList(d.Get("users").(*schema.Set).List())
@@ -54,7 +54,7 @@ func resourceAwsIamGroupMembershipCreate(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsIamGroupMembershipRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).iamconn()
 	group := d.Get("group").(string)
 
 	var ul []string
@@ -95,7 +95,7 @@ func resourceAwsIamGroupMembershipRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsIamGroupMembershipUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).iamconn()
 
 	if d.HasChange("users") {
 		group := d.Get("group").(string)
@@ -126,7 +126,7 @@ func resourceAwsIamGroupMembershipUpdate(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsIamGroupMembershipDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).iamconn()
 	userList := expandStringList(d.Get("users").(*schema.Set).List())
 	group := d.Get("group").(string)
 
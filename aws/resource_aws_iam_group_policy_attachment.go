@@ -37,7 +37,7 @@ func resourceAwsIamGroupPolicyAttachment() *schema.Resource {
 }
 
 func resourceAwsIamGroupPolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).iamconn()
 
 	group := d.Get("group").(string)
 	arn := d.Get("policy_arn").(string)
@@ -52,7 +52,7 @@ func resourceAwsIamGroupPolicyAttachmentCreate(d *schema.ResourceData, meta inte
 }
 
 func resourceAwsIamGroupPolicyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).iamconn()
 	group := d.Get("group").(string)
 	arn := d.Get("policy_arn").(string)
 
@@ -94,7 +94,7 @@ func resourceAwsIamGroupPolicyAttachmentRead(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsIamGroupPolicyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).iamconn()
 	group := d.Get("group").(string)
 	arn := d.Get("policy_arn").(string)
 
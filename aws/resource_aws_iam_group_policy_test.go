@@ -16,7 +16,7 @@ import (
 func TestAccAWSIAMGroupPolicy_basic(t *testing.T) {
 	var groupPolicy1, groupPolicy2 iam.GetGroupPolicyOutput
 	rInt := acctest.RandInt()
-	resource.ParallelTest(t, resource.TestCase{
+	testAccCassetteParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckIAMGroupPolicyDestroy,
@@ -50,7 +50,7 @@ func TestAccAWSIAMGroupPolicy_disappears(t *testing.T) {
 	var out iam.GetGroupPolicyOutput
 	rInt := acctest.RandInt()
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccCassetteParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckIAMGroupPolicyDestroy,
@@ -74,7 +74,7 @@ func TestAccAWSIAMGroupPolicy_disappears(t *testing.T) {
 func TestAccAWSIAMGroupPolicy_namePrefix(t *testing.T) {
 	var groupPolicy1, groupPolicy2 iam.GetGroupPolicyOutput
 	rInt := acctest.RandInt()
-	resource.ParallelTest(t, resource.TestCase{
+	testAccCassetteParallelTest(t, resource.TestCase{
 		PreCheck:      func() { testAccPreCheck(t) },
 		IDRefreshName: "aws_iam_group_policy.test",
 		Providers:     testAccProviders,
@@ -108,7 +108,7 @@ func TestAccAWSIAMGroupPolicy_namePrefix(t *testing.T) {
 func TestAccAWSIAMGroupPolicy_generatedName(t *testing.T) {
 	var groupPolicy1, groupPolicy2 iam.GetGroupPolicyOutput
 	rInt := acctest.RandInt()
-	resource.ParallelTest(t, resource.TestCase{
+	testAccCassetteParallelTest(t, resource.TestCase{
 		PreCheck:      func() { testAccPreCheck(t) },
 		IDRefreshName: "aws_iam_group_policy.test",
 		Providers:     testAccProviders,
@@ -140,7 +140,7 @@ func TestAccAWSIAMGroupPolicy_generatedName(t *testing.T) {
 }
 
 func testAccCheckIAMGroupPolicyDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).iamconn
+	conn := testAccProvider.Meta().(*AWSClient).iamconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_iam_group_policy" {
@@ -171,7 +171,7 @@ func testAccCheckIAMGroupPolicyDestroy(s *terraform.State) error {
 
 func testAccCheckIAMGroupPolicyDisappears(out *iam.GetGroupPolicyOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+		iamconn := testAccProvider.Meta().(*AWSClient).iamconn()
 
 		params := &iam.DeleteGroupPolicyInput{
 			PolicyName: out.PolicyName,
@@ -202,7 +202,7 @@ func testAccCheckIAMGroupPolicyExists(
 			return fmt.Errorf("Not Found: %s", iamGroupPolicyResource)
 		}
 
-		iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+		iamconn := testAccProvider.Meta().(*AWSClient).iamconn()
 		group, name := resourceAwsIamGroupPolicyParseId(policy.Primary.ID)
 		output, err := iamconn.GetGroupPolicy(&iam.GetGroupPolicyInput{
 			GroupName:  aws.String(group),
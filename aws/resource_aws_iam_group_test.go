@@ -56,7 +56,7 @@ func TestAccAWSIAMGroup_importBasic(t *testing.T) {
 	rString := acctest.RandString(8)
 	groupName := fmt.Sprintf("tf-acc-group-import-%s", rString)
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccCassetteParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckAWSGroupDestroy,
@@ -81,7 +81,7 @@ func TestAccAWSIAMGroup_basic(t *testing.T) {
 	groupName := fmt.Sprintf("tf-acc-group-basic-%s", rString)
 	groupName2 := fmt.Sprintf("tf-acc-group-basic-2-%s", rString)
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccCassetteParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckAWSGroupDestroy,
@@ -105,7 +105,7 @@ func TestAccAWSIAMGroup_basic(t *testing.T) {
 }
 
 func testAccCheckAWSGroupDestroy(s *terraform.State) error {
-	iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+	iamconn := testAccProvider.Meta().(*AWSClient).iamconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_iam_group" {
@@ -144,7 +144,7 @@ func testAccCheckAWSGroupExists(n string, res *iam.GetGroupOutput) resource.Test
 			return errors.New("No Group name is set")
 		}
 
-		iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+		iamconn := testAccProvider.Meta().(*AWSClient).iamconn()
 
 		resp, err := iamconn.GetGroup(&iam.GetGroupInput{
 			GroupName: aws.String(rs.Primary.ID),
@@ -16,7 +16,7 @@ func TestAccAWSIAMOpenIDConnectProvider_basic(t *testing.T) {
 	rString := acctest.RandString(5)
 	url := "accounts.google.com/" + rString
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccCassetteParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckIAMOpenIDConnectProviderDestroy,
@@ -53,7 +53,7 @@ func TestAccAWSIAMOpenIDConnectProvider_importBasic(t *testing.T) {
 	resourceName := "aws_iam_openid_connect_provider.goog"
 	rString := acctest.RandString(5)
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccCassetteParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckIAMOpenIDConnectProviderDestroy,
@@ -74,7 +74,7 @@ func TestAccAWSIAMOpenIDConnectProvider_importBasic(t *testing.T) {
 func TestAccAWSIAMOpenIDConnectProvider_disappears(t *testing.T) {
 	rString := acctest.RandString(5)
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccCassetteParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckIAMOpenIDConnectProviderDestroy,
@@ -92,7 +92,7 @@ func TestAccAWSIAMOpenIDConnectProvider_disappears(t *testing.T) {
 }
 
 func testAccCheckIAMOpenIDConnectProviderDestroy(s *terraform.State) error {
-	iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+	iamconn := testAccProvider.Meta().(*AWSClient).iamconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_iam_openid_connect_provider" {
@@ -130,7 +130,7 @@ func testAccCheckIAMOpenIDConnectProviderDisappears(id string) resource.TestChec
 			return fmt.Errorf("No ID is set")
 		}
 
-		iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+		iamconn := testAccProvider.Meta().(*AWSClient).iamconn()
 		_, err := iamconn.DeleteOpenIDConnectProvider(&iam.DeleteOpenIDConnectProviderInput{
 			OpenIDConnectProviderArn: aws.String(rs.Primary.ID),
 		})
@@ -149,7 +149,7 @@ func testAccCheckIAMOpenIDConnectProvider(id string) resource.TestCheckFunc {
 			return fmt.Errorf("No ID is set")
 		}
 
-		iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+		iamconn := testAccProvider.Meta().(*AWSClient).iamconn()
 		_, err := iamconn.GetOpenIDConnectProvider(&iam.GetOpenIDConnectProviderInput{
 			OpenIDConnectProviderArn: aws.String(rs.Primary.ID),
 		})
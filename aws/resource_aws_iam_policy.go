@@ -89,7 +89,7 @@ func resourceAwsIamPolicy() *schema.Resource {
 }
 
 func resourceAwsIamPolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 
 	var name string
 	if v, ok := d.GetOk("name"); ok {
@@ -118,7 +118,7 @@ func resourceAwsIamPolicyCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsIamPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 
 	getPolicyRequest := &iam.GetPolicyInput{
 		PolicyArn: aws.String(d.Id()),
@@ -213,7 +213,7 @@ func resourceAwsIamPolicyRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsIamPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 
 	if err := iamPolicyPruneVersions(d.Id(), iamconn); err != nil {
 		return err
@@ -233,7 +233,7 @@ func resourceAwsIamPolicyUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsIamPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 
 	if err := iamPolicyDeleteNondefaultVersions(d.Id(), iamconn); err != nil {
 		return err
@@ -56,7 +56,7 @@ func resourceAwsIamPolicyAttachment() *schema.Resource {
 }
 
 func resourceAwsIamPolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).iamconn()
 
 	name := d.Get("name").(string)
 	arn := d.Get("policy_arn").(string)
@@ -86,7 +86,7 @@ func resourceAwsIamPolicyAttachmentCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsIamPolicyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).iamconn()
 	arn := d.Get("policy_arn").(string)
 	name := d.Get("name").(string)
 
@@ -141,7 +141,7 @@ func resourceAwsIamPolicyAttachmentRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 func resourceAwsIamPolicyAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).iamconn()
 	name := d.Get("name").(string)
 	var userErr, roleErr, groupErr error
 
@@ -161,7 +161,7 @@ func resourceAwsIamPolicyAttachmentUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsIamPolicyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).iamconn()
 	name := d.Get("name").(string)
 	arn := d.Get("policy_arn").(string)
 	users := expandStringList(d.Get("users").(*schema.Set).List())
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/fakeaws"
 )
 
 func TestAccAWSIAMPolicy_basic(t *testing.T) {
@@ -17,7 +18,7 @@ func TestAccAWSIAMPolicy_basic(t *testing.T) {
 	rName := acctest.RandomWithPrefix("tf-acc-test")
 	resourceName := "aws_iam_policy.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccCassetteParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckAWSIAMPolicyDestroy,
@@ -42,12 +43,52 @@ func TestAccAWSIAMPolicy_basic(t *testing.T) {
 	})
 }
 
+func TestResourceAwsIamPolicy_fakeAws(t *testing.T) {
+	server := fakeaws.New()
+	defer server.Close()
+
+	var out iam.GetPolicyOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_iam_policy.test"
+	policy1 := "{\"Version\":\"2012-10-17\",\"Statement\":[{\"Action\":[\"ec2:Describe*\"],\"Effect\":\"Allow\",\"Resource\":\"*\"}]}"
+	policy2 := "{\"Version\":\"2012-10-17\",\"Statement\":[{\"Action\":[\"ec2:*\"],\"Effect\":\"Allow\",\"Resource\":\"*\"}]}"
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIAMPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFakeAwsProviderConfig(server.URL) + testAccAWSIAMPolicyConfigPolicy(rName, policy1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIAMPolicyExists(resourceName, &out),
+					testAccCheckResourceAttrGlobalARN(resourceName, "arn", "iam", fmt.Sprintf("policy/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "path", "/"),
+					resource.TestCheckResourceAttr(resourceName, "policy", policy1),
+				),
+			},
+			{
+				Config: testAccFakeAwsProviderConfig(server.URL) + testAccAWSIAMPolicyConfigPolicy(rName, policy2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIAMPolicyExists(resourceName, &out),
+					resource.TestCheckResourceAttr(resourceName, "policy", policy2),
+				),
+			},
+			{
+				Config:            testAccFakeAwsProviderConfig(server.URL),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAWSIAMPolicy_description(t *testing.T) {
 	var out iam.GetPolicyOutput
 	rName := acctest.RandomWithPrefix("tf-acc-test")
 	resourceName := "aws_iam_policy.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccCassetteParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckAWSIAMPolicyDestroy,
@@ -73,7 +114,7 @@ func TestAccAWSIAMPolicy_disappears(t *testing.T) {
 	rName := acctest.RandomWithPrefix("tf-acc-test")
 	resourceName := "aws_iam_policy.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccCassetteParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckAWSIAMPolicyDestroy,
@@ -95,7 +136,7 @@ func TestAccAWSIAMPolicy_namePrefix(t *testing.T) {
 	namePrefix := "tf-acc-test-"
 	resourceName := "aws_iam_policy.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccCassetteParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckAWSIAMPolicyDestroy,
@@ -122,7 +163,7 @@ func TestAccAWSIAMPolicy_path(t *testing.T) {
 	rName := acctest.RandomWithPrefix("tf-acc-test")
 	resourceName := "aws_iam_policy.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccCassetteParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckAWSIAMPolicyDestroy,
@@ -150,7 +191,7 @@ func TestAccAWSIAMPolicy_policy(t *testing.T) {
 	policy1 := "{\"Version\":\"2012-10-17\",\"Statement\":[{\"Action\":[\"ec2:Describe*\"],\"Effect\":\"Allow\",\"Resource\":\"*\"}]}"
 	policy2 := "{\"Version\":\"2012-10-17\",\"Statement\":[{\"Action\":[\"ec2:*\"],\"Effect\":\"Allow\",\"Resource\":\"*\"}]}"
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccCassetteParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckAWSIAMPolicyDestroy,
@@ -193,7 +234,7 @@ func testAccCheckAWSIAMPolicyExists(resource string, res *iam.GetPolicyOutput) r
 			return fmt.Errorf("No Policy name is set")
 		}
 
-		iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+		iamconn := testAccProvider.Meta().(*AWSClient).iamconn()
 
 		resp, err := iamconn.GetPolicy(&iam.GetPolicyInput{
 			PolicyArn: aws.String(rs.Primary.Attributes["arn"]),
@@ -209,7 +250,7 @@ func testAccCheckAWSIAMPolicyExists(resource string, res *iam.GetPolicyOutput) r
 }
 
 func testAccCheckAWSIAMPolicyDestroy(s *terraform.State) error {
-	iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+	iamconn := testAccProvider.Meta().(*AWSClient).iamconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_iam_policy" {
@@ -236,7 +277,7 @@ func testAccCheckAWSIAMPolicyDestroy(s *terraform.State) error {
 
 func testAccCheckAWSIAMPolicyDisappears(out *iam.GetPolicyOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+		iamconn := testAccProvider.Meta().(*AWSClient).iamconn()
 
 		params := &iam.DeletePolicyInput{
 			PolicyArn: out.Policy.Arn,
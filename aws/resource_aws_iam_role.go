@@ -133,7 +133,7 @@ func resourceAwsIamRoleImport(
 }
 
 func resourceAwsIamRoleCreate(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 
 	var name string
 	if v, ok := d.GetOk("name"); ok {
@@ -185,7 +185,7 @@ func resourceAwsIamRoleCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsIamRoleRead(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 
 	request := &iam.GetRoleInput{
 		RoleName: aws.String(d.Id()),
@@ -236,7 +236,7 @@ func resourceAwsIamRoleRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsIamRoleUpdate(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 
 	if d.HasChange("assume_role_policy") {
 		assumeRolePolicyInput := &iam.UpdateAssumeRolePolicyInput{
@@ -305,9 +305,9 @@ func resourceAwsIamRoleUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		// Reset all tags to empty set
-		oraw, nraw := d.GetChange("tags")
+		oraw, nraw := getTagsChange(d)
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		c, r := diffTagsIAM(tagsFromMapIAM(o), tagsFromMapIAM(n))
@@ -338,7 +338,7 @@ func resourceAwsIamRoleUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsIamRoleDelete(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 
 	// Roles cannot be destroyed when attached to an existing Instance Profile
 	if err := deleteAwsIamRoleInstanceProfiles(iamconn, d.Id()); err != nil {
@@ -57,7 +57,7 @@ func resourceAwsIamRolePolicy() *schema.Resource {
 }
 
 func resourceAwsIamRolePolicyPut(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 
 	request := &iam.PutRolePolicyInput{
 		RoleName:       aws.String(d.Get("role").(string)),
@@ -83,7 +83,7 @@ func resourceAwsIamRolePolicyPut(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsIamRolePolicyRead(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 
 	role, name, err := resourceAwsIamRolePolicyParseId(d.Id())
 	if err != nil {
@@ -123,7 +123,7 @@ func resourceAwsIamRolePolicyRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsIamRolePolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 
 	role, name, err := resourceAwsIamRolePolicyParseId(d.Id())
 	if err != nil {
@@ -47,7 +47,7 @@ func resourceAwsIamSamlProvider() *schema.Resource {
 }
 
 func resourceAwsIamSamlProviderCreate(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 
 	input := &iam.CreateSAMLProviderInput{
 		Name:                 aws.String(d.Get("name").(string)),
@@ -65,7 +65,7 @@ func resourceAwsIamSamlProviderCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsIamSamlProviderRead(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 
 	input := &iam.GetSAMLProviderInput{
 		SAMLProviderArn: aws.String(d.Id()),
@@ -94,7 +94,7 @@ func resourceAwsIamSamlProviderRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsIamSamlProviderUpdate(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 
 	input := &iam.UpdateSAMLProviderInput{
 		SAMLProviderArn:      aws.String(d.Id()),
@@ -109,7 +109,7 @@ func resourceAwsIamSamlProviderUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsIamSamlProviderDelete(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 
 	input := &iam.DeleteSAMLProviderInput{
 		SAMLProviderArn: aws.String(d.Id()),
@@ -68,7 +68,7 @@ func resourceAwsIamUser() *schema.Resource {
 }
 
 func resourceAwsIamUserCreate(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 	name := d.Get("name").(string)
 	path := d.Get("path").(string)
 
@@ -98,7 +98,7 @@ func resourceAwsIamUserCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsIamUserRead(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 
 	request := &iam.GetUserInput{
 		UserName: aws.String(d.Id()),
@@ -135,7 +135,7 @@ func resourceAwsIamUserRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsIamUserUpdate(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 
 	if d.HasChange("name") || d.HasChange("path") {
 		on, nn := d.GetChange("name")
@@ -183,9 +183,9 @@ func resourceAwsIamUserUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		// Reset all tags to empty set
-		oraw, nraw := d.GetChange("tags")
+		oraw, nraw := getTagsChange(d)
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		c, r := diffTagsIAM(tagsFromMapIAM(o), tagsFromMapIAM(n))
@@ -216,7 +216,7 @@ func resourceAwsIamUserUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsIamUserDelete(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 
 	// IAM Users must be removed from all groups before they can be deleted
 	if err := deleteAwsIamUserGroupMemberships(iamconn, d.Id()); err != nil {
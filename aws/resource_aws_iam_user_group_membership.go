@@ -34,7 +34,7 @@ func resourceAwsIamUserGroupMembership() *schema.Resource {
 }
 
 func resourceAwsIamUserGroupMembershipCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).iamconn()
 
 	user := d.Get("user").(string)
 	groupList := expandStringList(d.Get("groups").(*schema.Set).List())
@@ -49,7 +49,7 @@ func resourceAwsIamUserGroupMembershipCreate(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsIamUserGroupMembershipRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).iamconn()
 
 	user := d.Get("user").(string)
 	groups := d.Get("groups").(*schema.Set)
@@ -93,7 +93,7 @@ func resourceAwsIamUserGroupMembershipRead(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsIamUserGroupMembershipUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).iamconn()
 
 	if d.HasChange("groups") {
 		user := d.Get("user").(string)
@@ -124,7 +124,7 @@ func resourceAwsIamUserGroupMembershipUpdate(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsIamUserGroupMembershipDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).iamconn()
 	user := d.Get("user").(string)
 	groups := expandStringList(d.Get("groups").(*schema.Set).List())
 
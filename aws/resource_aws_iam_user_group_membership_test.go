@@ -95,7 +95,7 @@ func TestAccAWSUserGroupMembership_basic(t *testing.T) {
 }
 
 func testAccAWSUserGroupMembershipDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).iamconn
+	conn := testAccProvider.Meta().(*AWSClient).iamconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type == "aws_iam_user_group_membership" {
@@ -126,7 +126,7 @@ func testAccAWSUserGroupMembershipDestroy(s *terraform.State) error {
 
 func testAccAWSUserGroupMembershipCheckGroupListForUser(userName string, groups []string, groupsNeg []string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).iamconn
+		conn := testAccProvider.Meta().(*AWSClient).iamconn()
 
 		// get list of groups for user
 		userGroupList, err := conn.ListGroupsForUser(&iam.ListGroupsForUserInput{
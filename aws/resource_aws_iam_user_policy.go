@@ -55,7 +55,7 @@ func resourceAwsIamUserPolicy() *schema.Resource {
 }
 
 func resourceAwsIamUserPolicyPut(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 
 	request := &iam.PutUserPolicyInput{
 		UserName:       aws.String(d.Get("user").(string)),
@@ -87,7 +87,7 @@ func resourceAwsIamUserPolicyPut(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsIamUserPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 
 	user, name, err := resourceAwsIamUserPolicyParseId(d.Id())
 	if err != nil {
@@ -127,7 +127,7 @@ func resourceAwsIamUserPolicyRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsIamUserPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 
 	user, name, err := resourceAwsIamUserPolicyParseId(d.Id())
 	if err != nil {
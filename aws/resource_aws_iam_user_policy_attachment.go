@@ -37,7 +37,7 @@ func resourceAwsIamUserPolicyAttachment() *schema.Resource {
 }
 
 func resourceAwsIamUserPolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).iamconn()
 
 	user := d.Get("user").(string)
 	arn := d.Get("policy_arn").(string)
@@ -52,7 +52,7 @@ func resourceAwsIamUserPolicyAttachmentCreate(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsIamUserPolicyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).iamconn()
 	user := d.Get("user").(string)
 	arn := d.Get("policy_arn").(string)
 
@@ -93,7 +93,7 @@ func resourceAwsIamUserPolicyAttachmentRead(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsIamUserPolicyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).iamconn()
 	user := d.Get("user").(string)
 	arn := d.Get("policy_arn").(string)
 
@@ -18,7 +18,7 @@ func TestAccAWSIAMUserPolicy_importBasic(t *testing.T) {
 	suffix := randomString(10)
 	resourceName := fmt.Sprintf("aws_iam_user_policy.foo_%s", suffix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccCassetteParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckIAMUserPolicyDestroy,
@@ -45,7 +45,7 @@ func TestAccAWSIAMUserPolicy_basic(t *testing.T) {
 	userResourceName := "aws_iam_user.user"
 	userName := fmt.Sprintf("test_user_%d", rInt)
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccCassetteParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckIAMUserPolicyDestroy,
@@ -82,7 +82,7 @@ func TestAccAWSIAMUserPolicy_disappears(t *testing.T) {
 	suffix := randomString(10)
 	resourceName := fmt.Sprintf("aws_iam_user_policy.foo_%s", suffix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccCassetteParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckIAMUserPolicyDestroy,
@@ -108,7 +108,7 @@ func TestAccAWSIAMUserPolicy_namePrefix(t *testing.T) {
 	userResourceName := "aws_iam_user.user"
 	userName := fmt.Sprintf("test_user_%d", rInt)
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccCassetteParallelTest(t, resource.TestCase{
 		PreCheck:      func() { testAccPreCheck(t) },
 		IDRefreshName: policyResourceName,
 		Providers:     testAccProviders,
@@ -144,7 +144,7 @@ func TestAccAWSIAMUserPolicy_generatedName(t *testing.T) {
 	userResourceName := "aws_iam_user.user"
 	userName := fmt.Sprintf("test_user_%d", rInt)
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccCassetteParallelTest(t, resource.TestCase{
 		PreCheck:      func() { testAccPreCheck(t) },
 		IDRefreshName: policyResourceName,
 		Providers:     testAccProviders,
@@ -181,7 +181,7 @@ func TestAccAWSIAMUserPolicy_multiplePolicies(t *testing.T) {
 	policyResourceName2 := "aws_iam_user_policy.bar"
 	userResourceName := "aws_iam_user.user"
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccCassetteParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckIAMUserPolicyDestroy,
@@ -242,7 +242,7 @@ func testAccCheckIAMUserPolicyExists(resource string, res *iam.GetUserPolicyOutp
 			return err
 		}
 
-		iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+		iamconn := testAccProvider.Meta().(*AWSClient).iamconn()
 
 		resp, err := iamconn.GetUserPolicy(&iam.GetUserPolicyInput{
 			PolicyName: aws.String(name),
@@ -259,7 +259,7 @@ func testAccCheckIAMUserPolicyExists(resource string, res *iam.GetUserPolicyOutp
 }
 
 func testAccCheckIAMUserPolicyDestroy(s *terraform.State) error {
-	iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+	iamconn := testAccProvider.Meta().(*AWSClient).iamconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_iam_user_policy" {
@@ -295,7 +295,7 @@ func testAccCheckIAMUserPolicyDestroy(s *terraform.State) error {
 
 func testAccCheckIAMUserPolicyDisappears(out *iam.GetUserPolicyOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+		iamconn := testAccProvider.Meta().(*AWSClient).iamconn()
 
 		params := &iam.DeleteUserPolicyInput{
 			PolicyName: out.PolicyName,
@@ -325,7 +325,7 @@ func testAccCheckIAMUserPolicy(
 			return fmt.Errorf("Not Found: %s", iamUserPolicyResource)
 		}
 
-		iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+		iamconn := testAccProvider.Meta().(*AWSClient).iamconn()
 		username, name, err := resourceAwsIamUserPolicyParseId(policy.Primary.ID)
 		if err != nil {
 			return err
@@ -351,7 +351,7 @@ func testAccCheckIAMUserPolicyExpectedPolicies(iamUserResource string, expected
 			return fmt.Errorf("No ID is set")
 		}
 
-		iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+		iamconn := testAccProvider.Meta().(*AWSClient).iamconn()
 		userPolicies, err := iamconn.ListUserPolicies(&iam.ListUserPoliciesInput{
 			UserName: aws.String(rs.Primary.ID),
 		})
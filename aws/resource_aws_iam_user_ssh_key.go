@@ -70,7 +70,7 @@ func resourceAwsIamUserSshKey() *schema.Resource {
 }
 
 func resourceAwsIamUserSshKeyCreate(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 	username := d.Get("username").(string)
 	publicKey := d.Get("public_key").(string)
 
@@ -91,7 +91,7 @@ func resourceAwsIamUserSshKeyCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsIamUserSshKeyRead(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 	username := d.Get("username").(string)
 	encoding := d.Get("encoding").(string)
 	request := &iam.GetSSHPublicKeyInput{
@@ -124,7 +124,7 @@ func resourceAwsIamUserSshKeyRead(d *schema.ResourceData, meta interface{}) erro
 
 func resourceAwsIamUserSshKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("status") {
-		iamconn := meta.(*AWSClient).iamconn
+		iamconn := meta.(*AWSClient).iamconn()
 
 		request := &iam.UpdateSSHPublicKeyInput{
 			UserName:       aws.String(d.Get("username").(string)),
@@ -147,7 +147,7 @@ func resourceAwsIamUserSshKeyUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsIamUserSshKeyDelete(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 
 	request := &iam.DeleteSSHPublicKeyInput{
 		UserName:       aws.String(d.Get("username").(string)),
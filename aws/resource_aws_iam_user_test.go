@@ -66,7 +66,7 @@ func testSweepIamUsers(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).iamconn
+	conn := client.(*AWSClient).iamconn()
 	prefixes := []string{
 		"test-user",
 		"tf-acc-test",
@@ -491,7 +491,7 @@ func TestAccAWSUser_tags(t *testing.T) {
 }
 
 func testAccCheckAWSUserDestroy(s *terraform.State) error {
-	iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+	iamconn := testAccProvider.Meta().(*AWSClient).iamconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_iam_user" {
@@ -526,7 +526,7 @@ func testAccCheckAWSUserExists(n string, res *iam.GetUserOutput) resource.TestCh
 			return fmt.Errorf("No User name is set")
 		}
 
-		iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+		iamconn := testAccProvider.Meta().(*AWSClient).iamconn()
 
 		resp, err := iamconn.GetUser(&iam.GetUserInput{
 			UserName: aws.String(rs.Primary.ID),
@@ -557,7 +557,7 @@ func testAccCheckAWSUserAttributes(user *iam.GetUserOutput, name string, path st
 
 func testAccCheckAWSUserDisappears(getUserOutput *iam.GetUserOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+		iamconn := testAccProvider.Meta().(*AWSClient).iamconn()
 
 		userName := aws.StringValue(getUserOutput.User.UserName)
 
@@ -590,7 +590,7 @@ func testAccCheckAWSUserPermissionsBoundary(getUserOutput *iam.GetUserOutput, ex
 
 func testAccCheckAWSUserCreatesAccessKey(getUserOutput *iam.GetUserOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+		iamconn := testAccProvider.Meta().(*AWSClient).iamconn()
 
 		input := &iam.CreateAccessKeyInput{
 			UserName: getUserOutput.User.UserName,
@@ -606,7 +606,7 @@ func testAccCheckAWSUserCreatesAccessKey(getUserOutput *iam.GetUserOutput) resou
 
 func testAccCheckAWSUserCreatesLoginProfile(getUserOutput *iam.GetUserOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+		iamconn := testAccProvider.Meta().(*AWSClient).iamconn()
 
 		input := &iam.CreateLoginProfileInput{
 			Password: aws.String(generateIAMPassword(32)),
@@ -623,7 +623,7 @@ func testAccCheckAWSUserCreatesLoginProfile(getUserOutput *iam.GetUserOutput) re
 
 func testAccCheckAWSUserCreatesMFADevice(getUserOutput *iam.GetUserOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+		iamconn := testAccProvider.Meta().(*AWSClient).iamconn()
 
 		createVirtualMFADeviceInput := &iam.CreateVirtualMFADeviceInput{
 			Path:                 getUserOutput.User.Path,
@@ -669,7 +669,7 @@ func testAccCheckAWSUserUploadsSSHKey(getUserOutput *iam.GetUserOutput) resource
 			return fmt.Errorf("error reading SSH fixture: %s", err)
 		}
 
-		iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+		iamconn := testAccProvider.Meta().(*AWSClient).iamconn()
 
 		input := &iam.UploadSSHPublicKeyInput{
 			UserName:         getUserOutput.User.UserName,
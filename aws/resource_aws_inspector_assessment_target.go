@@ -40,7 +40,7 @@ func resourceAWSInspectorAssessmentTarget() *schema.Resource {
 }
 
 func resourceAwsInspectorAssessmentTargetCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).inspectorconn
+	conn := meta.(*AWSClient).inspectorconn()
 
 	input := &inspector.CreateAssessmentTargetInput{
 		AssessmentTargetName: aws.String(d.Get("name").(string)),
@@ -61,7 +61,7 @@ func resourceAwsInspectorAssessmentTargetCreate(d *schema.ResourceData, meta int
 }
 
 func resourceAwsInspectorAssessmentTargetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).inspectorconn
+	conn := meta.(*AWSClient).inspectorconn()
 
 	assessmentTarget, err := describeInspectorAssessmentTarget(conn, d.Id())
 
@@ -83,7 +83,7 @@ func resourceAwsInspectorAssessmentTargetRead(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsInspectorAssessmentTargetUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).inspectorconn
+	conn := meta.(*AWSClient).inspectorconn()
 
 	input := inspector.UpdateAssessmentTargetInput{
 		AssessmentTargetArn:  aws.String(d.Id()),
@@ -103,7 +103,7 @@ func resourceAwsInspectorAssessmentTargetUpdate(d *schema.ResourceData, meta int
 }
 
 func resourceAwsInspectorAssessmentTargetDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).inspectorconn
+	conn := meta.(*AWSClient).inspectorconn()
 
 	return resource.Retry(60*time.Minute, func() *resource.RetryError {
 		_, err := conn.DeleteAssessmentTarget(&inspector.DeleteAssessmentTargetInput{
@@ -48,7 +48,7 @@ func resourceAWSInspectorAssessmentTemplate() *schema.Resource {
 }
 
 func resourceAwsInspectorAssessmentTemplateCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).inspectorconn
+	conn := meta.(*AWSClient).inspectorconn()
 
 	rules := []*string{}
 	if attr := d.Get("rules_package_arns").(*schema.Set); attr.Len() > 0 {
@@ -78,7 +78,7 @@ func resourceAwsInspectorAssessmentTemplateCreate(d *schema.ResourceData, meta i
 }
 
 func resourceAwsInspectorAssessmentTemplateRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).inspectorconn
+	conn := meta.(*AWSClient).inspectorconn()
 
 	resp, err := conn.DescribeAssessmentTemplates(&inspector.DescribeAssessmentTemplatesInput{
 		AssessmentTemplateArns: []*string{
@@ -102,7 +102,7 @@ func resourceAwsInspectorAssessmentTemplateRead(d *schema.ResourceData, meta int
 }
 
 func resourceAwsInspectorAssessmentTemplateDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).inspectorconn
+	conn := meta.(*AWSClient).inspectorconn()
 
 	_, err := conn.DeleteAssessmentTemplate(&inspector.DeleteAssessmentTemplateInput{
 		AssessmentTemplateArn: aws.String(d.Id()),
@@ -37,7 +37,7 @@ func TestAccAWSInspectorTemplate_basic(t *testing.T) {
 }
 
 func testAccCheckAWSInspectorTemplateDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).inspectorconn
+	conn := testAccProvider.Meta().(*AWSClient).inspectorconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_inspector_assessment_template" {
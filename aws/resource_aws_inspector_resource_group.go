@@ -30,7 +30,7 @@ func resourceAWSInspectorResourceGroup() *schema.Resource {
 }
 
 func resourceAwsInspectorResourceGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).inspectorconn
+	conn := meta.(*AWSClient).inspectorconn()
 
 	resp, err := conn.CreateResourceGroup(&inspector.CreateResourceGroupInput{
 		ResourceGroupTags: tagsFromMapInspector(d.Get("tags").(map[string]interface{})),
@@ -48,7 +48,7 @@ func resourceAwsInspectorResourceGroupCreate(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsInspectorResourceGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).inspectorconn
+	conn := meta.(*AWSClient).inspectorconn()
 
 	_, err := conn.DescribeResourceGroups(&inspector.DescribeResourceGroupsInput{
 		ResourceGroupArns: []*string{
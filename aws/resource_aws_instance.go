@@ -509,7 +509,7 @@ func iopsDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
 }
 
 func resourceAwsInstanceCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	instanceOpts, err := buildAwsInstanceOpts(d, meta)
 	if err != nil {
@@ -658,7 +658,7 @@ func resourceAwsInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsInstanceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	resp, err := conn.DescribeInstances(&ec2.DescribeInstancesInput{
 		InstanceIds: []*string{aws.String(d.Id())},
@@ -887,11 +887,11 @@ func resourceAwsInstanceRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	d.Partial(true)
 
-	if d.HasChange("tags") && !d.IsNewResource() {
+	if hasTagsChange(d) && !d.IsNewResource() {
 		if err := setTags(conn, d); err != nil {
 			return err
 		}
@@ -1192,7 +1192,7 @@ func resourceAwsInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsInstanceDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	err := awsTerminateInstance(conn, d.Id(), d.Timeout(schema.TimeoutDelete))
 	return err
@@ -1737,7 +1737,7 @@ type awsInstanceOpts struct {
 
 func buildAwsInstanceOpts(
 	d *schema.ResourceData, meta interface{}) (*awsInstanceOpts, error) {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	instanceType := d.Get("instance_type").(string)
 	opts := &awsInstanceOpts{
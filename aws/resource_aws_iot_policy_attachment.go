@@ -30,7 +30,7 @@ func resourceAwsIotPolicyAttachment() *schema.Resource {
 }
 
 func resourceAwsIotPolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).iotconn()
 
 	policyName := d.Get("policy").(string)
 	target := d.Get("target").(string)
@@ -89,7 +89,7 @@ func getIotPolicyAttachment(conn *iot.IoT, target, policyName string) (*iot.Poli
 }
 
 func resourceAwsIotPolicyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).iotconn()
 
 	policyName := d.Get("policy").(string)
 	target := d.Get("target").(string)
@@ -112,7 +112,7 @@ func resourceAwsIotPolicyAttachmentRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsIotPolicyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).iotconn()
 
 	policyName := d.Get("policy").(string)
 	target := d.Get("target").(string)
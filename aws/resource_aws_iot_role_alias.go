@@ -40,7 +40,7 @@ func resourceAwsIotRoleAlias() *schema.Resource {
 }
 
 func resourceAwsIotRoleAliasCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).iotconn()
 
 	roleAlias := d.Get("alias").(string)
 	roleArn := d.Get("role_arn").(string)
@@ -78,7 +78,7 @@ func getIotRoleAliasDescription(conn *iot.IoT, alias string) (*iot.RoleAliasDesc
 }
 
 func resourceAwsIotRoleAliasRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).iotconn()
 
 	var roleAliasDescription *iot.RoleAliasDescription
 
@@ -102,7 +102,7 @@ func resourceAwsIotRoleAliasRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsIotRoleAliasDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).iotconn()
 
 	alias := d.Get("alias").(string)
 
@@ -118,7 +118,7 @@ func resourceAwsIotRoleAliasDelete(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsIotRoleAliasUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).iotconn()
 
 	if d.HasChange("credential_duration") {
 		roleAliasInput := &iot.UpdateRoleAliasInput{
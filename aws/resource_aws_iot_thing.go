@@ -53,7 +53,7 @@ func resourceAwsIotThing() *schema.Resource {
 }
 
 func resourceAwsIotThingCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).iotconn()
 
 	params := &iot.CreateThingInput{
 		ThingName: aws.String(d.Get("name").(string)),
@@ -80,7 +80,7 @@ func resourceAwsIotThingCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsIotThingRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).iotconn()
 
 	params := &iot.DescribeThingInput{
 		ThingName: aws.String(d.Id()),
@@ -109,7 +109,7 @@ func resourceAwsIotThingRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsIotThingUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).iotconn()
 
 	params := &iot.UpdateThingInput{
 		ThingName: aws.String(d.Get("name").(string)),
@@ -143,7 +143,7 @@ func resourceAwsIotThingUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsIotThingDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).iotconn()
 
 	params := &iot.DeleteThingInput{
 		ThingName: aws.String(d.Id()),
@@ -65,7 +65,7 @@ func TestAccAWSIotThingPrincipalAttachment_basic(t *testing.T) {
 }
 
 func testAccCheckAWSIotThingPrincipalAttachmentDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).iotconn
+	conn := testAccProvider.Meta().(*AWSClient).iotconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_iot_thing_principal_attachment" {
@@ -102,7 +102,7 @@ func testAccCheckAWSIotThingPrincipalAttachmentExists(n string) resource.TestChe
 			return fmt.Errorf("No attachment")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).iotconn
+		conn := testAccProvider.Meta().(*AWSClient).iotconn()
 		thing := rs.Primary.Attributes["thing"]
 		principal := rs.Primary.Attributes["principal"]
 
@@ -122,7 +122,7 @@ func testAccCheckAWSIotThingPrincipalAttachmentExists(n string) resource.TestChe
 
 func testAccCheckAWSIotThingPrincipalAttachmentStatus(thingName string, exists bool, principals []string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).iotconn
+		conn := testAccProvider.Meta().(*AWSClient).iotconn()
 
 		principalARNs := make(map[string]string)
 
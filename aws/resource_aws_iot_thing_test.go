@@ -127,7 +127,7 @@ func testAccCheckIotThingExists(n string, thing *iot.DescribeThingOutput) resour
 			return fmt.Errorf("No IoT Thing ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).iotconn
+		conn := testAccProvider.Meta().(*AWSClient).iotconn()
 		params := &iot.DescribeThingInput{
 			ThingName: aws.String(rs.Primary.ID),
 		}
@@ -143,7 +143,7 @@ func testAccCheckIotThingExists(n string, thing *iot.DescribeThingOutput) resour
 }
 
 func testAccCheckAWSIotThingDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).iotconn
+	conn := testAccProvider.Meta().(*AWSClient).iotconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_iot_thing" {
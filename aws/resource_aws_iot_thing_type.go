@@ -72,7 +72,7 @@ func resourceAwsIotThingType() *schema.Resource {
 }
 
 func resourceAwsIotThingTypeCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).iotconn()
 
 	params := &iot.CreateThingTypeInput{
 		ThingTypeName: aws.String(d.Get("name").(string)),
@@ -114,7 +114,7 @@ func resourceAwsIotThingTypeCreate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsIotThingTypeRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).iotconn()
 
 	params := &iot.DescribeThingTypeInput{
 		ThingTypeName: aws.String(d.Id()),
@@ -141,7 +141,7 @@ func resourceAwsIotThingTypeRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsIotThingTypeUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).iotconn()
 
 	if d.HasChange("deprecated") {
 		params := &iot.DeprecateThingTypeInput{
@@ -161,7 +161,7 @@ func resourceAwsIotThingTypeUpdate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsIotThingTypeDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).iotconn()
 
 	// In order to delete an IoT Thing Type, you must deprecate it first and wait
 	// at least 5 minutes.
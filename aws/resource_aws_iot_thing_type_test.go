@@ -79,7 +79,7 @@ func TestAccAWSIotThingType_full(t *testing.T) {
 }
 
 func testAccCheckAWSIotThingTypeDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).iotconn
+	conn := testAccProvider.Meta().(*AWSClient).iotconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_iot_thing_type" {
@@ -532,7 +532,7 @@ func createTopicRulePayload(d *schema.ResourceData) *iot.TopicRulePayload {
 }
 
 func resourceAwsIotTopicRuleCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).iotconn()
 
 	ruleName := d.Get("name").(string)
 
@@ -553,7 +553,7 @@ func resourceAwsIotTopicRuleCreate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsIotTopicRuleRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).iotconn()
 
 	params := &iot.GetTopicRuleInput{
 		RuleName: aws.String(d.Id()),
@@ -587,7 +587,7 @@ func resourceAwsIotTopicRuleRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsIotTopicRuleUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).iotconn()
 
 	params := &iot.ReplaceTopicRuleInput{
 		RuleName:         aws.String(d.Get("name").(string)),
@@ -604,7 +604,7 @@ func resourceAwsIotTopicRuleUpdate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsIotTopicRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).iotconn()
 
 	params := &iot.DeleteTopicRuleInput{
 		RuleName: aws.String(d.Id()),
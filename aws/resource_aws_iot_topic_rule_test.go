@@ -283,7 +283,7 @@ func TestAccAWSIoTTopicRule_sqs(t *testing.T) {
 }
 
 func testAccCheckAWSIoTTopicRuleDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).iotconn
+	conn := testAccProvider.Meta().(*AWSClient).iotconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_iot_topic_rule" {
@@ -31,7 +31,7 @@ func testSweepKeyPairs(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	ec2conn := client.(*AWSClient).ec2conn
+	ec2conn := client.(*AWSClient).ec2conn()
 
 	log.Printf("Destroying the tmp keys in (%s)", client.(*AWSClient).region)
 
@@ -143,7 +143,7 @@ func TestAccAWSKeyPair_namePrefix(t *testing.T) {
 }
 
 func testAccCheckAWSKeyPairDestroy(s *terraform.State) error {
-	ec2conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	ec2conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_key_pair" {
@@ -203,7 +203,7 @@ func testAccCheckAWSKeyPairExists(n string, res *ec2.KeyPairInfo) resource.TestC
 			return fmt.Errorf("No KeyPair name is set")
 		}
 
-		ec2conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		ec2conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		resp, err := ec2conn.DescribeKeyPairs(&ec2.DescribeKeyPairsInput{
 			KeyNames: []*string{aws.String(rs.Primary.ID)},
@@ -557,7 +557,7 @@ func resourceAwsKinesisAnalyticsApplication() *schema.Resource {
 }
 
 func resourceAwsKinesisAnalyticsApplicationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kinesisanalyticsconn
+	conn := meta.(*AWSClient).kinesisanalyticsconn()
 	name := d.Get("name").(string)
 	createOpts := &kinesisanalytics.CreateApplicationInput{
 		ApplicationName: aws.String(name),
@@ -617,7 +617,7 @@ func resourceAwsKinesisAnalyticsApplicationCreate(d *schema.ResourceData, meta i
 }
 
 func resourceAwsKinesisAnalyticsApplicationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kinesisanalyticsconn
+	conn := meta.(*AWSClient).kinesisanalyticsconn()
 	name := d.Get("name").(string)
 
 	describeOpts := &kinesisanalytics.DescribeApplicationInput{
@@ -663,7 +663,7 @@ func resourceAwsKinesisAnalyticsApplicationRead(d *schema.ResourceData, meta int
 
 func resourceAwsKinesisAnalyticsApplicationUpdate(d *schema.ResourceData, meta interface{}) error {
 	var version int
-	conn := meta.(*AWSClient).kinesisanalyticsconn
+	conn := meta.(*AWSClient).kinesisanalyticsconn()
 	name := d.Get("name").(string)
 
 	if v, ok := d.GetOk("version"); ok {
@@ -819,7 +819,7 @@ func resourceAwsKinesisAnalyticsApplicationUpdate(d *schema.ResourceData, meta i
 }
 
 func resourceAwsKinesisAnalyticsApplicationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kinesisanalyticsconn
+	conn := meta.(*AWSClient).kinesisanalyticsconn()
 	name := d.Get("name").(string)
 	createTimestamp, parseErr := time.Parse(time.RFC3339, d.Get("create_timestamp").(string))
 	if parseErr != nil {
@@ -588,7 +588,7 @@ func testAccCheckKinesisAnalyticsApplicationDestroy(s *terraform.State) error {
 		if rs.Type != "aws_kinesis_analytics_application" {
 			continue
 		}
-		conn := testAccProvider.Meta().(*AWSClient).kinesisanalyticsconn
+		conn := testAccProvider.Meta().(*AWSClient).kinesisanalyticsconn()
 		describeOpts := &kinesisanalytics.DescribeApplicationInput{
 			ApplicationName: aws.String(rs.Primary.Attributes["name"]),
 		}
@@ -613,7 +613,7 @@ func testAccCheckKinesisAnalyticsApplicationExists(n string, application *kinesi
 			return fmt.Errorf("No Kinesis Analytics Application ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).kinesisanalyticsconn
+		conn := testAccProvider.Meta().(*AWSClient).kinesisanalyticsconn()
 		describeOpts := &kinesisanalytics.DescribeApplicationInput{
 			ApplicationName: aws.String(rs.Primary.Attributes["name"]),
 		}
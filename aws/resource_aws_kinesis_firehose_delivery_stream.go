@@ -2033,7 +2033,7 @@ func resourceAwsKinesisFirehoseDeliveryStreamCreate(d *schema.ResourceData, meta
 		return validateError
 	}
 
-	conn := meta.(*AWSClient).firehoseconn
+	conn := meta.(*AWSClient).firehoseconn()
 
 	sn := d.Get("name").(string)
 
@@ -2174,7 +2174,7 @@ func resourceAwsKinesisFirehoseDeliveryStreamUpdate(d *schema.ResourceData, meta
 		return validateError
 	}
 
-	conn := meta.(*AWSClient).firehoseconn
+	conn := meta.(*AWSClient).firehoseconn()
 
 	sn := d.Get("name").(string)
 	updateInput := &firehose.UpdateDestinationInput{
@@ -2254,7 +2254,7 @@ func resourceAwsKinesisFirehoseDeliveryStreamUpdate(d *schema.ResourceData, meta
 }
 
 func resourceAwsKinesisFirehoseDeliveryStreamRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).firehoseconn
+	conn := meta.(*AWSClient).firehoseconn()
 
 	sn := d.Get("name").(string)
 	resp, err := conn.DescribeDeliveryStream(&firehose.DescribeDeliveryStreamInput{
@@ -2284,7 +2284,7 @@ func resourceAwsKinesisFirehoseDeliveryStreamRead(d *schema.ResourceData, meta i
 }
 
 func resourceAwsKinesisFirehoseDeliveryStreamDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).firehoseconn
+	conn := meta.(*AWSClient).firehoseconn()
 
 	sn := d.Get("name").(string)
 	_, err := conn.DeleteDeliveryStream(&firehose.DeleteDeliveryStreamInput{
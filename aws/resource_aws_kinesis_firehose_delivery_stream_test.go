@@ -28,7 +28,7 @@ func testSweepKinesisFirehoseDeliveryStreams(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*AWSClient).firehoseconn
+	conn := client.(*AWSClient).firehoseconn()
 	input := &firehose.ListDeliveryStreamsInput{}
 
 	for {
@@ -921,7 +921,7 @@ func testAccCheckKinesisFirehoseDeliveryStreamExists(n string, stream *firehose.
 			return fmt.Errorf("No Kinesis Firehose ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).firehoseconn
+		conn := testAccProvider.Meta().(*AWSClient).firehoseconn()
 		describeOpts := &firehose.DescribeDeliveryStreamInput{
 			DeliveryStreamName: aws.String(rs.Primary.Attributes["name"]),
 		}
@@ -1093,7 +1093,7 @@ func testAccCheckKinesisFirehoseDeliveryStreamDestroy(s *terraform.State) error
 		if rs.Type != "aws_kinesis_firehose_delivery_stream" {
 			continue
 		}
-		conn := testAccProvider.Meta().(*AWSClient).firehoseconn
+		conn := testAccProvider.Meta().(*AWSClient).firehoseconn()
 		describeOpts := &firehose.DescribeDeliveryStreamInput{
 			DeliveryStreamName: aws.String(rs.Primary.Attributes["name"]),
 		}
@@ -1112,7 +1112,7 @@ func testAccCheckKinesisFirehoseDeliveryStreamDestroy(s *terraform.State) error
 }
 
 func testAccCheckFirehoseLambdaFunctionDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).lambdaconn
+	conn := testAccProvider.Meta().(*AWSClient).lambdaconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_lambda_function" {
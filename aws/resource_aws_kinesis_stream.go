@@ -92,7 +92,7 @@ func resourceAwsKinesisStreamImport(
 }
 
 func resourceAwsKinesisStreamCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kinesisconn
+	conn := meta.(*AWSClient).kinesisconn()
 	sn := d.Get("name").(string)
 	createOpts := &kinesis.CreateStreamInput{
 		ShardCount: aws.Int64(int64(d.Get("shard_count").(int))),
@@ -130,7 +130,7 @@ func resourceAwsKinesisStreamCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsKinesisStreamUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kinesisconn
+	conn := meta.(*AWSClient).kinesisconn()
 
 	d.Partial(true)
 	if err := setTagsKinesis(conn, d); err != nil {
@@ -158,7 +158,7 @@ func resourceAwsKinesisStreamUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsKinesisStreamRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kinesisconn
+	conn := meta.(*AWSClient).kinesisconn()
 	sn := d.Get("name").(string)
 
 	state, err := readKinesisStreamState(conn, sn)
@@ -200,7 +200,7 @@ func resourceAwsKinesisStreamRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsKinesisStreamDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kinesisconn
+	conn := meta.(*AWSClient).kinesisconn()
 	sn := d.Get("name").(string)
 
 	_, err := conn.DeleteStream(&kinesis.DeleteStreamInput{
@@ -322,7 +322,7 @@ func testAccCheckKinesisStreamExists(n string, stream *kinesis.StreamDescription
 			return fmt.Errorf("No Kinesis ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).kinesisconn
+		conn := testAccProvider.Meta().(*AWSClient).kinesisconn()
 		describeOpts := &kinesis.DescribeStreamInput{
 			StreamName: aws.String(rs.Primary.Attributes["name"]),
 		}
@@ -363,7 +363,7 @@ func testAccCheckKinesisStreamDestroy(s *terraform.State) error {
 		if rs.Type != "aws_kinesis_stream" {
 			continue
 		}
-		conn := testAccProvider.Meta().(*AWSClient).kinesisconn
+		conn := testAccProvider.Meta().(*AWSClient).kinesisconn()
 		describeOpts := &kinesis.DescribeStreamInput{
 			StreamName: aws.String(rs.Primary.Attributes["name"]),
 		}
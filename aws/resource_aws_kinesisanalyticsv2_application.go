@@ -0,0 +1,1066 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/kinesisanalyticsv2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsKinesisAnalyticsV2Application() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsKinesisAnalyticsV2ApplicationCreate,
+		Read:   resourceAwsKinesisAnalyticsV2ApplicationRead,
+		Update: resourceAwsKinesisAnalyticsV2ApplicationUpdate,
+		Delete: resourceAwsKinesisAnalyticsV2ApplicationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsKinesisAnalyticsV2ApplicationImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"application_code_configuration": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"code_content": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"s3_content_location": {
+													Type:          schema.TypeList,
+													Optional:      true,
+													MaxItems:      1,
+													ConflictsWith: []string{"application_configuration.0.application_code_configuration.0.code_content.0.text_content"},
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"bucket_arn": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validateArn,
+															},
+															"file_key": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 1024),
+															},
+															"object_version": {
+																Type:     schema.TypeString,
+																Optional: true,
+															},
+														},
+													},
+												},
+												"text_content": {
+													Type:          schema.TypeString,
+													Optional:      true,
+													ConflictsWith: []string{"application_configuration.0.application_code_configuration.0.code_content.0.s3_content_location"},
+													ValidateFunc:  validation.StringLenBetween(0, 102400),
+												},
+											},
+										},
+									},
+									"code_content_type": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											kinesisanalyticsv2.CodeContentTypePlaintext,
+											kinesisanalyticsv2.CodeContentTypeZipfile,
+										}, false),
+									},
+								},
+							},
+						},
+						"application_snapshot_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"snapshots_enabled": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+								},
+							},
+						},
+						"environment_properties": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"property_group": {
+										Type:     schema.TypeSet,
+										Required: true,
+										MinItems: 1,
+										MaxItems: 50,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"property_group_id": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 50),
+												},
+												"property_map": {
+													Type:     schema.TypeMap,
+													Required: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"flink_application_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"checkpoint_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"checkpoint_interval": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+												"checkpointing_enabled": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Computed: true,
+												},
+												"configuration_type": {
+													Type:     schema.TypeString,
+													Required: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														kinesisanalyticsv2.ConfigurationTypeCustom,
+														kinesisanalyticsv2.ConfigurationTypeDefault,
+													}, false),
+												},
+												"min_pause_between_checkpoints": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.IntAtLeast(0),
+												},
+											},
+										},
+									},
+									"monitoring_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"configuration_type": {
+													Type:     schema.TypeString,
+													Required: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														kinesisanalyticsv2.ConfigurationTypeCustom,
+														kinesisanalyticsv2.ConfigurationTypeDefault,
+													}, false),
+												},
+												"log_level": {
+													Type:     schema.TypeString,
+													Optional: true,
+													Computed: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														kinesisanalyticsv2.LogLevelDebug,
+														kinesisanalyticsv2.LogLevelError,
+														kinesisanalyticsv2.LogLevelInfo,
+														kinesisanalyticsv2.LogLevelWarn,
+													}, false),
+												},
+												"metrics_level": {
+													Type:     schema.TypeString,
+													Optional: true,
+													Computed: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														kinesisanalyticsv2.MetricsLevelApplication,
+														kinesisanalyticsv2.MetricsLevelOperator,
+														kinesisanalyticsv2.MetricsLevelParallelism,
+														kinesisanalyticsv2.MetricsLevelTask,
+													}, false),
+												},
+											},
+										},
+									},
+									"parallelism_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"auto_scaling_enabled": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Computed: true,
+												},
+												"configuration_type": {
+													Type:     schema.TypeString,
+													Required: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														kinesisanalyticsv2.ConfigurationTypeCustom,
+														kinesisanalyticsv2.ConfigurationTypeDefault,
+													}, false),
+												},
+												"parallelism": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+												"parallelism_per_kpu": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cloudwatch_logging_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"log_stream_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+					},
+				},
+			},
+			"create_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "",
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"last_update_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`), "must only include alphanumeric, underscore, period, or hyphen characters"),
+				),
+			},
+			"runtime_environment": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					kinesisanalyticsv2.RuntimeEnvironmentFlink16,
+				}, false),
+			},
+			"service_execution_role": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+			"start_application": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsKinesisAnalyticsV2ApplicationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kinesisanalyticsv2conn()
+	name := d.Get("name").(string)
+
+	input := &kinesisanalyticsv2.CreateApplicationInput{
+		ApplicationConfiguration: expandKinesisAnalyticsV2ApplicationConfiguration(d.Get("application_configuration").([]interface{})),
+		ApplicationDescription:   aws.String(d.Get("description").(string)),
+		ApplicationName:          aws.String(name),
+		CloudWatchLoggingOptions: expandKinesisAnalyticsV2CloudWatchLoggingOptions(d.Get("cloudwatch_logging_options").([]interface{})),
+		RuntimeEnvironment:       aws.String(d.Get("runtime_environment").(string)),
+		ServiceExecutionRole:     aws.String(d.Get("service_execution_role").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating Kinesis Analytics v2 Application: %s", input)
+	var output *kinesisanalyticsv2.CreateApplicationOutput
+	// Retry for IAM eventual consistency
+	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		var err error
+		output, err = conn.CreateApplication(input)
+
+		if isAWSErr(err, kinesisanalyticsv2.ErrCodeInvalidArgumentException, "sufficient privileges") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("error creating Kinesis Analytics v2 Application (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ApplicationDetail.ApplicationARN))
+
+	if d.Get("start_application").(bool) {
+		if err := kinesisAnalyticsV2StartApplication(conn, name, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsKinesisAnalyticsV2ApplicationRead(d, meta)
+}
+
+func resourceAwsKinesisAnalyticsV2ApplicationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kinesisanalyticsv2conn()
+
+	output, err := conn.DescribeApplication(&kinesisanalyticsv2.DescribeApplicationInput{
+		ApplicationName: aws.String(d.Get("name").(string)),
+	})
+
+	if isAWSErr(err, kinesisanalyticsv2.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Kinesis Analytics v2 Application (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Kinesis Analytics v2 Application (%s): %s", d.Id(), err)
+	}
+
+	application := output.ApplicationDetail
+	status := aws.StringValue(application.ApplicationStatus)
+
+	if err := d.Set("application_configuration", flattenKinesisAnalyticsV2ApplicationConfigurationDescription(application.ApplicationConfigurationDescription)); err != nil {
+		return fmt.Errorf("error setting application_configuration: %s", err)
+	}
+
+	d.Set("arn", application.ApplicationARN)
+
+	if err := d.Set("cloudwatch_logging_options", flattenKinesisAnalyticsV2CloudWatchLoggingOptionDescriptions(application.CloudWatchLoggingOptionDescriptions)); err != nil {
+		return fmt.Errorf("error setting cloudwatch_logging_options: %s", err)
+	}
+
+	d.Set("create_timestamp", aws.TimeValue(application.CreateTimestamp).Format(time.RFC3339))
+	d.Set("description", application.ApplicationDescription)
+	d.Set("last_update_timestamp", aws.TimeValue(application.LastUpdateTimestamp).Format(time.RFC3339))
+	d.Set("name", application.ApplicationName)
+	d.Set("runtime_environment", application.RuntimeEnvironment)
+	d.Set("service_execution_role", application.ServiceExecutionRole)
+	// A running application is UPDATING while its configuration is updated.
+	d.Set("start_application", status == kinesisanalyticsv2.ApplicationStatusRunning || status == kinesisanalyticsv2.ApplicationStatusStarting || status == kinesisanalyticsv2.ApplicationStatusUpdating)
+	d.Set("status", status)
+	d.Set("version", int(aws.Int64Value(application.ApplicationVersionId)))
+
+	return nil
+}
+
+func resourceAwsKinesisAnalyticsV2ApplicationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kinesisanalyticsv2conn()
+	name := d.Get("name").(string)
+	version := int64(d.Get("version").(int))
+
+	// Adding or removing the logging option is only possible through the
+	// dedicated operations, each of which increments the application version.
+	if d.HasChange("cloudwatch_logging_options") {
+		o, n := d.GetChange("cloudwatch_logging_options")
+		oldOptions := o.([]interface{})
+		newOptions := n.([]interface{})
+
+		if len(oldOptions) > 0 && oldOptions[0] != nil && (len(newOptions) == 0 || newOptions[0] == nil) {
+			input := &kinesisanalyticsv2.DeleteApplicationCloudWatchLoggingOptionInput{
+				ApplicationName:             aws.String(name),
+				CloudWatchLoggingOptionId:   aws.String(oldOptions[0].(map[string]interface{})["id"].(string)),
+				CurrentApplicationVersionId: aws.Int64(version),
+			}
+
+			log.Printf("[DEBUG] Deleting Kinesis Analytics v2 Application (%s) CloudWatch logging option: %s", d.Id(), input)
+			output, err := conn.DeleteApplicationCloudWatchLoggingOption(input)
+
+			if err != nil {
+				return fmt.Errorf("error deleting Kinesis Analytics v2 Application (%s) CloudWatch logging option: %s", d.Id(), err)
+			}
+
+			version = aws.Int64Value(output.ApplicationVersionId)
+		}
+
+		if (len(oldOptions) == 0 || oldOptions[0] == nil) && len(newOptions) > 0 && newOptions[0] != nil {
+			input := &kinesisanalyticsv2.AddApplicationCloudWatchLoggingOptionInput{
+				ApplicationName:             aws.String(name),
+				CloudWatchLoggingOption:     expandKinesisAnalyticsV2CloudWatchLoggingOptions(newOptions)[0],
+				CurrentApplicationVersionId: aws.Int64(version),
+			}
+
+			log.Printf("[DEBUG] Adding Kinesis Analytics v2 Application (%s) CloudWatch logging option: %s", d.Id(), input)
+			output, err := conn.AddApplicationCloudWatchLoggingOption(input)
+
+			if err != nil {
+				return fmt.Errorf("error adding Kinesis Analytics v2 Application (%s) CloudWatch logging option: %s", d.Id(), err)
+			}
+
+			version = aws.Int64Value(output.ApplicationVersionId)
+		}
+	}
+
+	if d.HasChange("application_configuration") || d.HasChange("cloudwatch_logging_options") || d.HasChange("service_execution_role") {
+		input := &kinesisanalyticsv2.UpdateApplicationInput{
+			ApplicationName:             aws.String(name),
+			CurrentApplicationVersionId: aws.Int64(version),
+		}
+		hasUpdate := false
+
+		if d.HasChange("application_configuration") {
+			input.ApplicationConfigurationUpdate = expandKinesisAnalyticsV2ApplicationConfigurationUpdate(d)
+			hasUpdate = true
+		}
+
+		if d.HasChange("cloudwatch_logging_options") {
+			o, n := d.GetChange("cloudwatch_logging_options")
+			oldOptions := o.([]interface{})
+			newOptions := n.([]interface{})
+
+			if len(oldOptions) > 0 && oldOptions[0] != nil && len(newOptions) > 0 && newOptions[0] != nil {
+				input.CloudWatchLoggingOptionUpdates = []*kinesisanalyticsv2.CloudWatchLoggingOptionUpdate{
+					{
+						CloudWatchLoggingOptionId: aws.String(oldOptions[0].(map[string]interface{})["id"].(string)),
+						LogStreamARNUpdate:        aws.String(newOptions[0].(map[string]interface{})["log_stream_arn"].(string)),
+					},
+				}
+				hasUpdate = true
+			}
+		}
+
+		if d.HasChange("service_execution_role") {
+			input.ServiceExecutionRoleUpdate = aws.String(d.Get("service_execution_role").(string))
+			hasUpdate = true
+		}
+
+		if hasUpdate {
+			log.Printf("[DEBUG] Updating Kinesis Analytics v2 Application: %s", input)
+			err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+				_, err := conn.UpdateApplication(input)
+
+				if isAWSErr(err, kinesisanalyticsv2.ErrCodeInvalidArgumentException, "sufficient privileges") {
+					return resource.RetryableError(err)
+				}
+
+				if err != nil {
+					return resource.NonRetryableError(err)
+				}
+
+				return nil
+			})
+
+			if err != nil {
+				return fmt.Errorf("error updating Kinesis Analytics v2 Application (%s): %s", d.Id(), err)
+			}
+
+			if err := waitForKinesisAnalyticsV2ApplicationStatus(conn, name, []string{kinesisanalyticsv2.ApplicationStatusUpdating}, []string{kinesisanalyticsv2.ApplicationStatusReady, kinesisanalyticsv2.ApplicationStatusRunning}, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) update: %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("start_application") {
+		if d.Get("start_application").(bool) {
+			if err := kinesisAnalyticsV2StartApplication(conn, name, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+		} else {
+			if err := kinesisAnalyticsV2StopApplication(conn, name, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return resourceAwsKinesisAnalyticsV2ApplicationRead(d, meta)
+}
+
+func resourceAwsKinesisAnalyticsV2ApplicationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kinesisanalyticsv2conn()
+	name := d.Get("name").(string)
+
+	createTimestamp, err := time.Parse(time.RFC3339, d.Get("create_timestamp").(string))
+	if err != nil {
+		return fmt.Errorf("error parsing Kinesis Analytics v2 Application (%s) create_timestamp: %s", d.Id(), err)
+	}
+
+	input := &kinesisanalyticsv2.DeleteApplicationInput{
+		ApplicationName: aws.String(name),
+		CreateTimestamp: aws.Time(createTimestamp),
+	}
+
+	log.Printf("[DEBUG] Deleting Kinesis Analytics v2 Application: %s", input)
+	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err := conn.DeleteApplication(input)
+
+		// The application cannot be deleted while it is starting, stopping or updating.
+		if isAWSErr(err, kinesisanalyticsv2.ErrCodeResourceInUseException, "") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if isAWSErr(err, kinesisanalyticsv2.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Kinesis Analytics v2 Application (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForKinesisAnalyticsV2ApplicationStatus(conn, name, []string{kinesisanalyticsv2.ApplicationStatusDeleting}, []string{}, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsKinesisAnalyticsV2ApplicationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	applicationArn, err := arn.Parse(d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("error parsing ARN (%s): %s", d.Id(), err)
+	}
+
+	// application/<name>
+	parts := regexp.MustCompile(`^application/(.+)$`).FindStringSubmatch(applicationArn.Resource)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("unexpected format of ARN (%s), expected arn:PARTITION:kinesisanalytics:REGION:ACCOUNT:application/NAME", d.Id())
+	}
+
+	d.Set("name", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func kinesisAnalyticsV2StartApplication(conn *kinesisanalyticsv2.KinesisAnalyticsV2, name string, timeout time.Duration) error {
+	input := &kinesisanalyticsv2.StartApplicationInput{
+		ApplicationName:  aws.String(name),
+		RunConfiguration: &kinesisanalyticsv2.RunConfiguration{},
+	}
+
+	log.Printf("[DEBUG] Starting Kinesis Analytics v2 Application: %s", input)
+	if _, err := conn.StartApplication(input); err != nil {
+		return fmt.Errorf("error starting Kinesis Analytics v2 Application (%s): %s", name, err)
+	}
+
+	if err := waitForKinesisAnalyticsV2ApplicationStatus(conn, name, []string{kinesisanalyticsv2.ApplicationStatusStarting}, []string{kinesisanalyticsv2.ApplicationStatusRunning}, timeout); err != nil {
+		return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) to start: %s", name, err)
+	}
+
+	return nil
+}
+
+func kinesisAnalyticsV2StopApplication(conn *kinesisanalyticsv2.KinesisAnalyticsV2, name string, timeout time.Duration) error {
+	input := &kinesisanalyticsv2.StopApplicationInput{
+		ApplicationName: aws.String(name),
+	}
+
+	log.Printf("[DEBUG] Stopping Kinesis Analytics v2 Application: %s", input)
+	if _, err := conn.StopApplication(input); err != nil {
+		return fmt.Errorf("error stopping Kinesis Analytics v2 Application (%s): %s", name, err)
+	}
+
+	if err := waitForKinesisAnalyticsV2ApplicationStatus(conn, name, []string{kinesisanalyticsv2.ApplicationStatusStopping}, []string{kinesisanalyticsv2.ApplicationStatusReady}, timeout); err != nil {
+		return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) to stop: %s", name, err)
+	}
+
+	return nil
+}
+
+func waitForKinesisAnalyticsV2ApplicationStatus(conn *kinesisanalyticsv2.KinesisAnalyticsV2, name string, pending, target []string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: pending,
+		Target:  target,
+		Refresh: refreshKinesisAnalyticsV2ApplicationStatus(conn, name),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func refreshKinesisAnalyticsV2ApplicationStatus(conn *kinesisanalyticsv2.KinesisAnalyticsV2, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.DescribeApplication(&kinesisanalyticsv2.DescribeApplicationInput{
+			ApplicationName: aws.String(name),
+		})
+
+		if isAWSErr(err, kinesisanalyticsv2.ErrCodeResourceNotFoundException, "") {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil || output.ApplicationDetail == nil {
+			return nil, "", nil
+		}
+
+		return output.ApplicationDetail, aws.StringValue(output.ApplicationDetail.ApplicationStatus), nil
+	}
+}
+
+func expandKinesisAnalyticsV2ApplicationConfiguration(l []interface{}) *kinesisanalyticsv2.ApplicationConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	applicationConfiguration := &kinesisanalyticsv2.ApplicationConfiguration{
+		ApplicationCodeConfiguration: expandKinesisAnalyticsV2ApplicationCodeConfiguration(m["application_code_configuration"].([]interface{})),
+	}
+
+	if v, ok := m["application_snapshot_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		applicationConfiguration.ApplicationSnapshotConfiguration = &kinesisanalyticsv2.ApplicationSnapshotConfiguration{
+			SnapshotsEnabled: aws.Bool(v[0].(map[string]interface{})["snapshots_enabled"].(bool)),
+		}
+	}
+
+	if v, ok := m["environment_properties"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		applicationConfiguration.EnvironmentProperties = &kinesisanalyticsv2.EnvironmentProperties{
+			PropertyGroups: expandKinesisAnalyticsV2PropertyGroups(v[0].(map[string]interface{})["property_group"].(*schema.Set)),
+		}
+	}
+
+	if v, ok := m["flink_application_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		applicationConfiguration.FlinkApplicationConfiguration = expandKinesisAnalyticsV2FlinkApplicationConfiguration(v[0].(map[string]interface{}))
+	}
+
+	return applicationConfiguration
+}
+
+func expandKinesisAnalyticsV2ApplicationCodeConfiguration(l []interface{}) *kinesisanalyticsv2.ApplicationCodeConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	applicationCodeConfiguration := &kinesisanalyticsv2.ApplicationCodeConfiguration{
+		CodeContentType: aws.String(m["code_content_type"].(string)),
+	}
+
+	if v, ok := m["code_content"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		mCodeContent := v[0].(map[string]interface{})
+		codeContent := &kinesisanalyticsv2.CodeContent{}
+
+		if v, ok := mCodeContent["s3_content_location"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			mS3ContentLocation := v[0].(map[string]interface{})
+
+			codeContent.S3ContentLocation = &kinesisanalyticsv2.S3ContentLocation{
+				BucketARN: aws.String(mS3ContentLocation["bucket_arn"].(string)),
+				FileKey:   aws.String(mS3ContentLocation["file_key"].(string)),
+			}
+
+			if v, ok := mS3ContentLocation["object_version"].(string); ok && v != "" {
+				codeContent.S3ContentLocation.ObjectVersion = aws.String(v)
+			}
+		}
+
+		if v, ok := mCodeContent["text_content"].(string); ok && v != "" {
+			codeContent.TextContent = aws.String(v)
+		}
+
+		applicationCodeConfiguration.CodeContent = codeContent
+	}
+
+	return applicationCodeConfiguration
+}
+
+func expandKinesisAnalyticsV2FlinkApplicationConfiguration(m map[string]interface{}) *kinesisanalyticsv2.FlinkApplicationConfiguration {
+	flinkApplicationConfiguration := &kinesisanalyticsv2.FlinkApplicationConfiguration{}
+
+	if v, ok := m["checkpoint_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		mCheckpointConfiguration := v[0].(map[string]interface{})
+		checkpointConfiguration := &kinesisanalyticsv2.CheckpointConfiguration{
+			ConfigurationType: aws.String(mCheckpointConfiguration["configuration_type"].(string)),
+		}
+
+		// Individual settings may only be specified for a custom configuration.
+		if aws.StringValue(checkpointConfiguration.ConfigurationType) == kinesisanalyticsv2.ConfigurationTypeCustom {
+			if v, ok := mCheckpointConfiguration["checkpoint_interval"].(int); ok && v > 0 {
+				checkpointConfiguration.CheckpointInterval = aws.Int64(int64(v))
+			}
+			if v, ok := mCheckpointConfiguration["checkpointing_enabled"].(bool); ok {
+				checkpointConfiguration.CheckpointingEnabled = aws.Bool(v)
+			}
+			if v, ok := mCheckpointConfiguration["min_pause_between_checkpoints"].(int); ok && v > 0 {
+				checkpointConfiguration.MinPauseBetweenCheckpoints = aws.Int64(int64(v))
+			}
+		}
+
+		flinkApplicationConfiguration.CheckpointConfiguration = checkpointConfiguration
+	}
+
+	if v, ok := m["monitoring_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		mMonitoringConfiguration := v[0].(map[string]interface{})
+		monitoringConfiguration := &kinesisanalyticsv2.MonitoringConfiguration{
+			ConfigurationType: aws.String(mMonitoringConfiguration["configuration_type"].(string)),
+		}
+
+		if aws.StringValue(monitoringConfiguration.ConfigurationType) == kinesisanalyticsv2.ConfigurationTypeCustom {
+			if v, ok := mMonitoringConfiguration["log_level"].(string); ok && v != "" {
+				monitoringConfiguration.LogLevel = aws.String(v)
+			}
+			if v, ok := mMonitoringConfiguration["metrics_level"].(string); ok && v != "" {
+				monitoringConfiguration.MetricsLevel = aws.String(v)
+			}
+		}
+
+		flinkApplicationConfiguration.MonitoringConfiguration = monitoringConfiguration
+	}
+
+	if v, ok := m["parallelism_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		mParallelismConfiguration := v[0].(map[string]interface{})
+		parallelismConfiguration := &kinesisanalyticsv2.ParallelismConfiguration{
+			ConfigurationType: aws.String(mParallelismConfiguration["configuration_type"].(string)),
+		}
+
+		if aws.StringValue(parallelismConfiguration.ConfigurationType) == kinesisanalyticsv2.ConfigurationTypeCustom {
+			if v, ok := mParallelismConfiguration["auto_scaling_enabled"].(bool); ok {
+				parallelismConfiguration.AutoScalingEnabled = aws.Bool(v)
+			}
+			if v, ok := mParallelismConfiguration["parallelism"].(int); ok && v > 0 {
+				parallelismConfiguration.Parallelism = aws.Int64(int64(v))
+			}
+			if v, ok := mParallelismConfiguration["parallelism_per_kpu"].(int); ok && v > 0 {
+				parallelismConfiguration.ParallelismPerKPU = aws.Int64(int64(v))
+			}
+		}
+
+		flinkApplicationConfiguration.ParallelismConfiguration = parallelismConfiguration
+	}
+
+	return flinkApplicationConfiguration
+}
+
+func expandKinesisAnalyticsV2PropertyGroups(s *schema.Set) []*kinesisanalyticsv2.PropertyGroup {
+	propertyGroups := make([]*kinesisanalyticsv2.PropertyGroup, 0, s.Len())
+
+	for _, v := range s.List() {
+		m := v.(map[string]interface{})
+
+		propertyGroups = append(propertyGroups, &kinesisanalyticsv2.PropertyGroup{
+			PropertyGroupId: aws.String(m["property_group_id"].(string)),
+			PropertyMap:     stringMapToPointers(m["property_map"].(map[string]interface{})),
+		})
+	}
+
+	return propertyGroups
+}
+
+func expandKinesisAnalyticsV2CloudWatchLoggingOptions(l []interface{}) []*kinesisanalyticsv2.CloudWatchLoggingOption {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return []*kinesisanalyticsv2.CloudWatchLoggingOption{
+		{
+			LogStreamARN: aws.String(m["log_stream_arn"].(string)),
+		},
+	}
+}
+
+func expandKinesisAnalyticsV2ApplicationConfigurationUpdate(d *schema.ResourceData) *kinesisanalyticsv2.ApplicationConfigurationUpdate {
+	applicationConfiguration := expandKinesisAnalyticsV2ApplicationConfiguration(d.Get("application_configuration").([]interface{}))
+	update := &kinesisanalyticsv2.ApplicationConfigurationUpdate{}
+
+	if d.HasChange("application_configuration.0.application_code_configuration") {
+		applicationCodeConfiguration := applicationConfiguration.ApplicationCodeConfiguration
+		codeUpdate := &kinesisanalyticsv2.ApplicationCodeConfigurationUpdate{
+			CodeContentTypeUpdate: applicationCodeConfiguration.CodeContentType,
+		}
+
+		if codeContent := applicationCodeConfiguration.CodeContent; codeContent != nil {
+			codeUpdate.CodeContentUpdate = &kinesisanalyticsv2.CodeContentUpdate{
+				TextContentUpdate: codeContent.TextContent,
+			}
+
+			if s3ContentLocation := codeContent.S3ContentLocation; s3ContentLocation != nil {
+				codeUpdate.CodeContentUpdate.S3ContentLocationUpdate = &kinesisanalyticsv2.S3ContentLocationUpdate{
+					BucketARNUpdate:     s3ContentLocation.BucketARN,
+					FileKeyUpdate:       s3ContentLocation.FileKey,
+					ObjectVersionUpdate: s3ContentLocation.ObjectVersion,
+				}
+			}
+		}
+
+		update.ApplicationCodeConfigurationUpdate = codeUpdate
+	}
+
+	if d.HasChange("application_configuration.0.application_snapshot_configuration") && applicationConfiguration.ApplicationSnapshotConfiguration != nil {
+		update.ApplicationSnapshotConfigurationUpdate = &kinesisanalyticsv2.ApplicationSnapshotConfigurationUpdate{
+			SnapshotsEnabledUpdate: applicationConfiguration.ApplicationSnapshotConfiguration.SnapshotsEnabled,
+		}
+	}
+
+	if d.HasChange("application_configuration.0.environment_properties") {
+		// The property groups are replaced as a whole; an empty list removes them all.
+		propertyGroups := []*kinesisanalyticsv2.PropertyGroup{}
+
+		if environmentProperties := applicationConfiguration.EnvironmentProperties; environmentProperties != nil {
+			propertyGroups = environmentProperties.PropertyGroups
+		}
+
+		update.EnvironmentPropertyUpdates = &kinesisanalyticsv2.EnvironmentPropertyUpdates{
+			PropertyGroups: propertyGroups,
+		}
+	}
+
+	if d.HasChange("application_configuration.0.flink_application_configuration") && applicationConfiguration.FlinkApplicationConfiguration != nil {
+		flinkApplicationConfiguration := applicationConfiguration.FlinkApplicationConfiguration
+		flinkUpdate := &kinesisanalyticsv2.FlinkApplicationConfigurationUpdate{}
+
+		if v := flinkApplicationConfiguration.CheckpointConfiguration; v != nil {
+			flinkUpdate.CheckpointConfigurationUpdate = &kinesisanalyticsv2.CheckpointConfigurationUpdate{
+				CheckpointIntervalUpdate:         v.CheckpointInterval,
+				CheckpointingEnabledUpdate:       v.CheckpointingEnabled,
+				ConfigurationTypeUpdate:          v.ConfigurationType,
+				MinPauseBetweenCheckpointsUpdate: v.MinPauseBetweenCheckpoints,
+			}
+		}
+
+		if v := flinkApplicationConfiguration.MonitoringConfiguration; v != nil {
+			flinkUpdate.MonitoringConfigurationUpdate = &kinesisanalyticsv2.MonitoringConfigurationUpdate{
+				ConfigurationTypeUpdate: v.ConfigurationType,
+				LogLevelUpdate:          v.LogLevel,
+				MetricsLevelUpdate:      v.MetricsLevel,
+			}
+		}
+
+		if v := flinkApplicationConfiguration.ParallelismConfiguration; v != nil {
+			flinkUpdate.ParallelismConfigurationUpdate = &kinesisanalyticsv2.ParallelismConfigurationUpdate{
+				AutoScalingEnabledUpdate: v.AutoScalingEnabled,
+				ConfigurationTypeUpdate:  v.ConfigurationType,
+				ParallelismPerKPUUpdate:  v.ParallelismPerKPU,
+				ParallelismUpdate:        v.Parallelism,
+			}
+		}
+
+		update.FlinkApplicationConfigurationUpdate = flinkUpdate
+	}
+
+	return update
+}
+
+func flattenKinesisAnalyticsV2ApplicationConfigurationDescription(applicationConfiguration *kinesisanalyticsv2.ApplicationConfigurationDescription) []interface{} {
+	if applicationConfiguration == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"application_code_configuration":     flattenKinesisAnalyticsV2ApplicationCodeConfigurationDescription(applicationConfiguration.ApplicationCodeConfigurationDescription),
+		"application_snapshot_configuration": []interface{}{},
+		"environment_properties":             []interface{}{},
+		"flink_application_configuration":    flattenKinesisAnalyticsV2FlinkApplicationConfigurationDescription(applicationConfiguration.FlinkApplicationConfigurationDescription),
+	}
+
+	if v := applicationConfiguration.ApplicationSnapshotConfigurationDescription; v != nil {
+		m["application_snapshot_configuration"] = []interface{}{
+			map[string]interface{}{
+				"snapshots_enabled": aws.BoolValue(v.SnapshotsEnabled),
+			},
+		}
+	}
+
+	if v := applicationConfiguration.EnvironmentPropertyDescriptions; v != nil && len(v.PropertyGroupDescriptions) > 0 {
+		m["environment_properties"] = []interface{}{
+			map[string]interface{}{
+				"property_group": flattenKinesisAnalyticsV2PropertyGroups(v.PropertyGroupDescriptions),
+			},
+		}
+	}
+
+	return []interface{}{m}
+}
+
+func flattenKinesisAnalyticsV2ApplicationCodeConfigurationDescription(applicationCodeConfiguration *kinesisanalyticsv2.ApplicationCodeConfigurationDescription) []interface{} {
+	if applicationCodeConfiguration == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"code_content":      []interface{}{},
+		"code_content_type": aws.StringValue(applicationCodeConfiguration.CodeContentType),
+	}
+
+	if codeContent := applicationCodeConfiguration.CodeContentDescription; codeContent != nil {
+		mCodeContent := map[string]interface{}{
+			"s3_content_location": []interface{}{},
+			"text_content":        aws.StringValue(codeContent.TextContent),
+		}
+
+		if v := codeContent.S3ApplicationCodeLocationDescription; v != nil {
+			mCodeContent["s3_content_location"] = []interface{}{
+				map[string]interface{}{
+					"bucket_arn":     aws.StringValue(v.BucketARN),
+					"file_key":       aws.StringValue(v.FileKey),
+					"object_version": aws.StringValue(v.ObjectVersion),
+				},
+			}
+		}
+
+		m["code_content"] = []interface{}{mCodeContent}
+	}
+
+	return []interface{}{m}
+}
+
+func flattenKinesisAnalyticsV2FlinkApplicationConfigurationDescription(flinkApplicationConfiguration *kinesisanalyticsv2.FlinkApplicationConfigurationDescription) []interface{} {
+	if flinkApplicationConfiguration == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"checkpoint_configuration":  []interface{}{},
+		"monitoring_configuration":  []interface{}{},
+		"parallelism_configuration": []interface{}{},
+	}
+
+	if v := flinkApplicationConfiguration.CheckpointConfigurationDescription; v != nil {
+		m["checkpoint_configuration"] = []interface{}{
+			map[string]interface{}{
+				"checkpoint_interval":           int(aws.Int64Value(v.CheckpointInterval)),
+				"checkpointing_enabled":         aws.BoolValue(v.CheckpointingEnabled),
+				"configuration_type":            aws.StringValue(v.ConfigurationType),
+				"min_pause_between_checkpoints": int(aws.Int64Value(v.MinPauseBetweenCheckpoints)),
+			},
+		}
+	}
+
+	if v := flinkApplicationConfiguration.MonitoringConfigurationDescription; v != nil {
+		m["monitoring_configuration"] = []interface{}{
+			map[string]interface{}{
+				"configuration_type": aws.StringValue(v.ConfigurationType),
+				"log_level":          aws.StringValue(v.LogLevel),
+				"metrics_level":      aws.StringValue(v.MetricsLevel),
+			},
+		}
+	}
+
+	if v := flinkApplicationConfiguration.ParallelismConfigurationDescription; v != nil {
+		m["parallelism_configuration"] = []interface{}{
+			map[string]interface{}{
+				"auto_scaling_enabled": aws.BoolValue(v.AutoScalingEnabled),
+				"configuration_type":   aws.StringValue(v.ConfigurationType),
+				"parallelism":          int(aws.Int64Value(v.Parallelism)),
+				"parallelism_per_kpu":  int(aws.Int64Value(v.ParallelismPerKPU)),
+			},
+		}
+	}
+
+	return []interface{}{m}
+}
+
+func flattenKinesisAnalyticsV2PropertyGroups(propertyGroups []*kinesisanalyticsv2.PropertyGroup) []interface{} {
+	l := make([]interface{}, 0, len(propertyGroups))
+
+	for _, propertyGroup := range propertyGroups {
+		m := map[string]interface{}{
+			"property_group_id": aws.StringValue(propertyGroup.PropertyGroupId),
+			"property_map":      pointersMapToStringList(propertyGroup.PropertyMap),
+		}
+
+		l = append(l, m)
+	}
+
+	return l
+}
+
+func flattenKinesisAnalyticsV2CloudWatchLoggingOptionDescriptions(cloudWatchLoggingOptions []*kinesisanalyticsv2.CloudWatchLoggingOptionDescription) []interface{} {
+	l := make([]interface{}, 0, len(cloudWatchLoggingOptions))
+
+	for _, cloudWatchLoggingOption := range cloudWatchLoggingOptions {
+		m := map[string]interface{}{
+			"id":             aws.StringValue(cloudWatchLoggingOption.CloudWatchLoggingOptionId),
+			"log_stream_arn": aws.StringValue(cloudWatchLoggingOption.LogStreamARN),
+		}
+
+		l = append(l, m)
+	}
+
+	return l
+}
@@ -0,0 +1,425 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kinesisanalyticsv2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSKinesisAnalyticsV2Application_basic(t *testing.T) {
+	var application kinesisanalyticsv2.ApplicationDetail
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_kinesisanalyticsv2_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKinesisAnalyticsV2ApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKinesisAnalyticsV2ApplicationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisAnalyticsV2ApplicationExists(resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.application_code_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.application_code_configuration.0.code_content_type", kinesisanalyticsv2.CodeContentTypeZipfile),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.application_code_configuration.0.code_content.0.s3_content_location.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.environment_properties.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.flink_application_configuration.#", "1"),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "kinesisanalytics", regexp.MustCompile(`application/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "cloudwatch_logging_options.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "create_timestamp"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "last_update_timestamp"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "runtime_environment", kinesisanalyticsv2.RuntimeEnvironmentFlink16),
+					resource.TestCheckResourceAttrPair(resourceName, "service_execution_role", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "start_application", "false"),
+					resource.TestCheckResourceAttr(resourceName, "status", kinesisanalyticsv2.ApplicationStatusReady),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSKinesisAnalyticsV2Application_disappears(t *testing.T) {
+	var application kinesisanalyticsv2.ApplicationDetail
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_kinesisanalyticsv2_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKinesisAnalyticsV2ApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKinesisAnalyticsV2ApplicationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisAnalyticsV2ApplicationExists(resourceName, &application),
+					testAccCheckKinesisAnalyticsV2ApplicationDisappears(&application),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSKinesisAnalyticsV2Application_flinkApplicationConfiguration(t *testing.T) {
+	var application kinesisanalyticsv2.ApplicationDetail
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_kinesisanalyticsv2_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKinesisAnalyticsV2ApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKinesisAnalyticsV2ApplicationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisAnalyticsV2ApplicationExists(resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				Config: testAccKinesisAnalyticsV2ApplicationConfig_flinkApplicationConfiguration(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisAnalyticsV2ApplicationExists(resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.application_snapshot_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.application_snapshot_configuration.0.snapshots_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.environment_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.environment_properties.0.property_group.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.flink_application_configuration.0.checkpoint_configuration.0.checkpoint_interval", "30000"),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.flink_application_configuration.0.checkpoint_configuration.0.checkpointing_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.flink_application_configuration.0.checkpoint_configuration.0.configuration_type", kinesisanalyticsv2.ConfigurationTypeCustom),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.flink_application_configuration.0.monitoring_configuration.0.configuration_type", kinesisanalyticsv2.ConfigurationTypeCustom),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.flink_application_configuration.0.monitoring_configuration.0.log_level", kinesisanalyticsv2.LogLevelDebug),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.flink_application_configuration.0.monitoring_configuration.0.metrics_level", kinesisanalyticsv2.MetricsLevelTask),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.flink_application_configuration.0.parallelism_configuration.0.auto_scaling_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.flink_application_configuration.0.parallelism_configuration.0.configuration_type", kinesisanalyticsv2.ConfigurationTypeCustom),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.flink_application_configuration.0.parallelism_configuration.0.parallelism", "2"),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.flink_application_configuration.0.parallelism_configuration.0.parallelism_per_kpu", "1"),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSKinesisAnalyticsV2Application_cloudwatchLoggingOptions(t *testing.T) {
+	var application kinesisanalyticsv2.ApplicationDetail
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_kinesisanalyticsv2_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKinesisAnalyticsV2ApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKinesisAnalyticsV2ApplicationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisAnalyticsV2ApplicationExists(resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "cloudwatch_logging_options.#", "0"),
+				),
+			},
+			{
+				Config: testAccKinesisAnalyticsV2ApplicationConfig_cloudwatchLoggingOptions(rName, "test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisAnalyticsV2ApplicationExists(resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "cloudwatch_logging_options.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "cloudwatch_logging_options.0.id"),
+					resource.TestCheckResourceAttrPair(resourceName, "cloudwatch_logging_options.0.log_stream_arn", "aws_cloudwatch_log_stream.test1", "arn"),
+				),
+			},
+			{
+				Config: testAccKinesisAnalyticsV2ApplicationConfig_cloudwatchLoggingOptions(rName, "test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisAnalyticsV2ApplicationExists(resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "cloudwatch_logging_options.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "cloudwatch_logging_options.0.log_stream_arn", "aws_cloudwatch_log_stream.test2", "arn"),
+				),
+			},
+			{
+				Config: testAccKinesisAnalyticsV2ApplicationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisAnalyticsV2ApplicationExists(resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "cloudwatch_logging_options.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKinesisAnalyticsV2ApplicationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).kinesisanalyticsv2conn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_kinesisanalyticsv2_application" {
+			continue
+		}
+
+		_, err := conn.DescribeApplication(&kinesisanalyticsv2.DescribeApplicationInput{
+			ApplicationName: aws.String(rs.Primary.Attributes["name"]),
+		})
+
+		if isAWSErr(err, kinesisanalyticsv2.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Kinesis Analytics v2 Application (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckKinesisAnalyticsV2ApplicationExists(resourceName string, application *kinesisanalyticsv2.ApplicationDetail) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Kinesis Analytics v2 Application ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).kinesisanalyticsv2conn()
+
+		output, err := conn.DescribeApplication(&kinesisanalyticsv2.DescribeApplicationInput{
+			ApplicationName: aws.String(rs.Primary.Attributes["name"]),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		*application = *output.ApplicationDetail
+
+		return nil
+	}
+}
+
+func testAccCheckKinesisAnalyticsV2ApplicationDisappears(application *kinesisanalyticsv2.ApplicationDetail) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).kinesisanalyticsv2conn()
+
+		_, err := conn.DeleteApplication(&kinesisanalyticsv2.DeleteApplicationInput{
+			ApplicationName: application.ApplicationName,
+			CreateTimestamp: application.CreateTimestamp,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		return waitForKinesisAnalyticsV2ApplicationStatus(conn, aws.StringValue(application.ApplicationName), []string{kinesisanalyticsv2.ApplicationStatusDeleting}, []string{}, 10*time.Minute)
+	}
+}
+
+func testAccKinesisAnalyticsV2ApplicationConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_iam_policy_document" "assume_role" {
+  statement {
+    actions = ["sts:AssumeRole"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["kinesisanalytics.amazonaws.com"]
+    }
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name               = %[1]q
+  assume_role_policy = "${data.aws_iam_policy_document.assume_role.json}"
+}
+
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions   = ["s3:GetObject", "s3:GetObjectVersion"]
+    resources = ["${aws_s3_bucket.test.arn}/*"]
+  }
+
+  statement {
+    actions   = ["logs:DescribeLogGroups", "logs:DescribeLogStreams", "logs:PutLogEvents"]
+    resources = ["*"]
+  }
+}
+
+resource "aws_iam_role_policy" "test" {
+  name   = %[1]q
+  role   = "${aws_iam_role.test.id}"
+  policy = "${data.aws_iam_policy_document.test.json}"
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_object" "test" {
+  bucket = "${aws_s3_bucket.test.id}"
+  key    = "flink-application.zip"
+  source = "test-fixtures/lambdatest.zip"
+}
+`, rName)
+}
+
+func testAccKinesisAnalyticsV2ApplicationConfig_basic(rName string) string {
+	return testAccKinesisAnalyticsV2ApplicationConfigBase(rName) + fmt.Sprintf(`
+resource "aws_kinesisanalyticsv2_application" "test" {
+  name                   = %[1]q
+  runtime_environment    = "FLINK-1_6"
+  service_execution_role = "${aws_iam_role.test.arn}"
+
+  application_configuration {
+    application_code_configuration {
+      code_content_type = "ZIPFILE"
+
+      code_content {
+        s3_content_location {
+          bucket_arn = "${aws_s3_bucket.test.arn}"
+          file_key   = "${aws_s3_bucket_object.test.key}"
+        }
+      }
+    }
+  }
+
+  depends_on = ["aws_iam_role_policy.test"]
+}
+`, rName)
+}
+
+func testAccKinesisAnalyticsV2ApplicationConfig_flinkApplicationConfiguration(rName string) string {
+	return testAccKinesisAnalyticsV2ApplicationConfigBase(rName) + fmt.Sprintf(`
+resource "aws_kinesisanalyticsv2_application" "test" {
+  name                   = %[1]q
+  runtime_environment    = "FLINK-1_6"
+  service_execution_role = "${aws_iam_role.test.arn}"
+
+  application_configuration {
+    application_code_configuration {
+      code_content_type = "ZIPFILE"
+
+      code_content {
+        s3_content_location {
+          bucket_arn = "${aws_s3_bucket.test.arn}"
+          file_key   = "${aws_s3_bucket_object.test.key}"
+        }
+      }
+    }
+
+    application_snapshot_configuration {
+      snapshots_enabled = false
+    }
+
+    environment_properties {
+      property_group {
+        property_group_id = "ConsumerConfigProperties"
+
+        property_map = {
+          "aws.region"           = "us-west-2"
+          "flink.stream.initpos" = "LATEST"
+        }
+      }
+
+      property_group {
+        property_group_id = "ProducerConfigProperties"
+
+        property_map = {
+          "AggregationEnabled" = "false"
+        }
+      }
+    }
+
+    flink_application_configuration {
+      checkpoint_configuration {
+        checkpoint_interval   = 30000
+        checkpointing_enabled = true
+        configuration_type    = "CUSTOM"
+      }
+
+      monitoring_configuration {
+        configuration_type = "CUSTOM"
+        log_level          = "DEBUG"
+        metrics_level      = "TASK"
+      }
+
+      parallelism_configuration {
+        auto_scaling_enabled = true
+        configuration_type   = "CUSTOM"
+        parallelism          = 2
+        parallelism_per_kpu  = 1
+      }
+    }
+  }
+
+  depends_on = ["aws_iam_role_policy.test"]
+}
+`, rName)
+}
+
+func testAccKinesisAnalyticsV2ApplicationConfig_cloudwatchLoggingOptions(rName, streamName string) string {
+	return testAccKinesisAnalyticsV2ApplicationConfigBase(rName) + fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudwatch_log_stream" "test1" {
+  name           = "test1"
+  log_group_name = "${aws_cloudwatch_log_group.test.name}"
+}
+
+resource "aws_cloudwatch_log_stream" "test2" {
+  name           = "test2"
+  log_group_name = "${aws_cloudwatch_log_group.test.name}"
+}
+
+resource "aws_kinesisanalyticsv2_application" "test" {
+  name                   = %[1]q
+  runtime_environment    = "FLINK-1_6"
+  service_execution_role = "${aws_iam_role.test.arn}"
+
+  application_configuration {
+    application_code_configuration {
+      code_content_type = "ZIPFILE"
+
+      code_content {
+        s3_content_location {
+          bucket_arn = "${aws_s3_bucket.test.arn}"
+          file_key   = "${aws_s3_bucket_object.test.key}"
+        }
+      }
+    }
+  }
+
+  cloudwatch_logging_options {
+    log_stream_arn = "${aws_cloudwatch_log_stream.%[2]s.arn}"
+  }
+
+  depends_on = ["aws_iam_role_policy.test"]
+}
+`, rName, streamName)
+}
@@ -118,7 +118,7 @@ func resourceAwsKmsGrant() *schema.Resource {
 }
 
 func resourceAwsKmsGrantCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kmsconn
+	conn := meta.(*AWSClient).kmsconn()
 	keyId := d.Get("key_id").(string)
 
 	input := kms.CreateGrantInput{
@@ -182,7 +182,7 @@ func resourceAwsKmsGrantCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsKmsGrantRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kmsconn
+	conn := meta.(*AWSClient).kmsconn()
 
 	keyId, grantId, err := decodeKmsGrantId(d.Id())
 	if err != nil {
@@ -238,7 +238,7 @@ func resourceAwsKmsGrantRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsKmsGrantDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kmsconn
+	conn := meta.(*AWSClient).kmsconn()
 
 	keyId, grantId, decodeErr := decodeKmsGrantId(d.Id())
 	if decodeErr != nil {
@@ -279,7 +279,7 @@ func resourceAwsKmsGrantDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsKmsGrantExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	conn := meta.(*AWSClient).kmsconn
+	conn := meta.(*AWSClient).kmsconn()
 
 	keyId, grantId, err := decodeKmsGrantId(d.Id())
 	if err != nil {
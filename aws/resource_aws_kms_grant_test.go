@@ -134,7 +134,7 @@ func TestAccAWSKmsGrant_ARN(t *testing.T) {
 }
 
 func testAccCheckAWSKmsGrantDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).kmsconn
+	conn := testAccProvider.Meta().(*AWSClient).kmsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_kms_grant" {
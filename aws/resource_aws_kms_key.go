@@ -78,7 +78,7 @@ func resourceAwsKmsKey() *schema.Resource {
 }
 
 func resourceAwsKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kmsconn
+	conn := meta.(*AWSClient).kmsconn()
 
 	// Allow aws to chose default values if we don't pass them
 	var req kms.CreateKeyInput
@@ -119,7 +119,7 @@ func resourceAwsKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kmsconn
+	conn := meta.(*AWSClient).kmsconn()
 
 	req := &kms.DescribeKeyInput{
 		KeyId: aws.String(d.Id()),
@@ -198,7 +198,7 @@ func resourceAwsKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsKmsKeyUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kmsconn
+	conn := meta.(*AWSClient).kmsconn()
 
 	// We expect new keys to be enabled already
 	if d.HasChange("is_enabled") && d.Get("is_enabled").(bool) && !d.IsNewResource() {
@@ -405,7 +405,7 @@ func updateKmsKeyRotationStatus(conn *kms.KMS, d *schema.ResourceData) error {
 }
 
 func resourceAwsKmsKeyExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	conn := meta.(*AWSClient).kmsconn
+	conn := meta.(*AWSClient).kmsconn()
 
 	req := &kms.DescribeKeyInput{
 		KeyId: aws.String(d.Id()),
@@ -429,7 +429,7 @@ func resourceAwsKmsKeyExists(d *schema.ResourceData, meta interface{}) (bool, er
 }
 
 func resourceAwsKmsKeyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kmsconn
+	conn := meta.(*AWSClient).kmsconn()
 	keyId := d.Get("key_id").(string)
 
 	req := &kms.ScheduleKeyDeletionInput{
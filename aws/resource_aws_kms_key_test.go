@@ -25,7 +25,7 @@ func testSweepKmsKeys(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).kmsconn
+	conn := client.(*AWSClient).kmsconn()
 
 	err = conn.ListKeysPages(&kms.ListKeysInput{Limit: aws.Int64(int64(1000))}, func(out *kms.ListKeysOutput, lastPage bool) bool {
 		for _, k := range out.Keys {
@@ -231,7 +231,7 @@ func testAccCheckAWSKmsKeyHasPolicy(name string, expectedPolicyText string) reso
 			return fmt.Errorf("No KMS Key ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).kmsconn
+		conn := testAccProvider.Meta().(*AWSClient).kmsconn()
 
 		out, err := conn.GetKeyPolicy(&kms.GetKeyPolicyInput{
 			KeyId:      aws.String(rs.Primary.ID),
@@ -257,7 +257,7 @@ func testAccCheckAWSKmsKeyHasPolicy(name string, expectedPolicyText string) reso
 }
 
 func testAccCheckAWSKmsKeyDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).kmsconn
+	conn := testAccProvider.Meta().(*AWSClient).kmsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_kms_key" {
@@ -293,7 +293,7 @@ func testAccCheckAWSKmsKeyExists(name string, key *kms.KeyMetadata) resource.Tes
 			return fmt.Errorf("No KMS Key ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).kmsconn
+		conn := testAccProvider.Meta().(*AWSClient).kmsconn()
 
 		o, err := retryOnAwsCode("NotFoundException", func() (interface{}, error) {
 			return conn.DescribeKey(&kms.DescribeKeyInput{
@@ -65,7 +65,7 @@ func resourceAwsLambdaAlias() *schema.Resource {
 // resourceAwsLambdaAliasCreate maps to:
 // CreateAlias in the API / SDK
 func resourceAwsLambdaAliasCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lambdaconn
+	conn := meta.(*AWSClient).lambdaconn()
 
 	functionName := d.Get("function_name").(string)
 	aliasName := d.Get("name").(string)
@@ -93,7 +93,7 @@ func resourceAwsLambdaAliasCreate(d *schema.ResourceData, meta interface{}) erro
 // resourceAwsLambdaAliasRead maps to:
 // GetAlias in the API / SDK
 func resourceAwsLambdaAliasRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lambdaconn
+	conn := meta.(*AWSClient).lambdaconn()
 
 	log.Printf("[DEBUG] Fetching Lambda alias: %s:%s", d.Get("function_name"), d.Get("name"))
 
@@ -131,7 +131,7 @@ func resourceAwsLambdaAliasRead(d *schema.ResourceData, meta interface{}) error
 // resourceAwsLambdaAliasDelete maps to:
 // DeleteAlias in the API / SDK
 func resourceAwsLambdaAliasDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lambdaconn
+	conn := meta.(*AWSClient).lambdaconn()
 
 	log.Printf("[INFO] Deleting Lambda alias: %s:%s", d.Get("function_name"), d.Get("name"))
 
@@ -151,7 +151,7 @@ func resourceAwsLambdaAliasDelete(d *schema.ResourceData, meta interface{}) erro
 // resourceAwsLambdaAliasUpdate maps to:
 // UpdateAlias in the API / SDK
 func resourceAwsLambdaAliasUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lambdaconn
+	conn := meta.(*AWSClient).lambdaconn()
 
 	log.Printf("[DEBUG] Updating Lambda alias: %s:%s", d.Get("function_name"), d.Get("name"))
 
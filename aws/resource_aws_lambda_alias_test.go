@@ -126,7 +126,7 @@ func TestAccAWSLambdaAlias_routingconfig(t *testing.T) {
 }
 
 func testAccCheckAwsLambdaAliasDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).lambdaconn
+	conn := testAccProvider.Meta().(*AWSClient).lambdaconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_lambda_alias" {
@@ -157,7 +157,7 @@ func testAccCheckAwsLambdaAliasExists(n string, mapping *lambda.AliasConfigurati
 			return fmt.Errorf("Lambda alias not set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).lambdaconn
+		conn := testAccProvider.Meta().(*AWSClient).lambdaconn()
 
 		params := &lambda.GetAliasInput{
 			FunctionName: aws.String(rs.Primary.ID),
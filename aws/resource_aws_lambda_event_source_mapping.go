@@ -127,7 +127,7 @@ func resourceAwsLambdaEventSourceMapping() *schema.Resource {
 // resourceAwsLambdaEventSourceMappingCreate maps to:
 // CreateEventSourceMapping in the API / SDK
 func resourceAwsLambdaEventSourceMappingCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lambdaconn
+	conn := meta.(*AWSClient).lambdaconn()
 
 	functionName := d.Get("function_name").(string)
 	eventSourceArn := d.Get("event_source_arn").(string)
@@ -186,7 +186,7 @@ func resourceAwsLambdaEventSourceMappingCreate(d *schema.ResourceData, meta inte
 // resourceAwsLambdaEventSourceMappingRead maps to:
 // GetEventSourceMapping in the API / SDK
 func resourceAwsLambdaEventSourceMappingRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lambdaconn
+	conn := meta.(*AWSClient).lambdaconn()
 
 	log.Printf("[DEBUG] Fetching Lambda event source mapping: %s", d.Id())
 
@@ -232,7 +232,7 @@ func resourceAwsLambdaEventSourceMappingRead(d *schema.ResourceData, meta interf
 // resourceAwsLambdaEventSourceMappingDelete maps to:
 // DeleteEventSourceMapping in the API / SDK
 func resourceAwsLambdaEventSourceMappingDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lambdaconn
+	conn := meta.(*AWSClient).lambdaconn()
 
 	log.Printf("[INFO] Deleting Lambda event source mapping: %s", d.Id())
 
@@ -261,7 +261,7 @@ func resourceAwsLambdaEventSourceMappingDelete(d *schema.ResourceData, meta inte
 // resourceAwsLambdaEventSourceMappingUpdate maps to:
 // UpdateEventSourceMapping in the API / SDK
 func resourceAwsLambdaEventSourceMappingUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lambdaconn
+	conn := meta.(*AWSClient).lambdaconn()
 
 	log.Printf("[DEBUG] Updating Lambda event source mapping: %s", d.Id())
 
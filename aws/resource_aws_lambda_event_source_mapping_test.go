@@ -318,7 +318,7 @@ func TestAccAWSLambdaEventSourceMapping_StartingPositionTimestamp(t *testing.T)
 
 func testAccCheckAWSLambdaEventSourceMappingIsBeingDisabled(conf *lambda.EventSourceMappingConfiguration) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).lambdaconn
+		conn := testAccProvider.Meta().(*AWSClient).lambdaconn()
 		// Disable enabled state
 		err := resource.Retry(10*time.Minute, func() *resource.RetryError {
 			params := &lambda.UpdateEventSourceMappingInput{
@@ -370,7 +370,7 @@ func testAccCheckAWSLambdaEventSourceMappingIsBeingDisabled(conf *lambda.EventSo
 
 func testAccCheckAWSLambdaEventSourceMappingDisappears(conf *lambda.EventSourceMappingConfiguration) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).lambdaconn
+		conn := testAccProvider.Meta().(*AWSClient).lambdaconn()
 
 		err := resource.Retry(10*time.Minute, func() *resource.RetryError {
 			params := &lambda.DeleteEventSourceMappingInput{
@@ -420,7 +420,7 @@ func testAccCheckAWSLambdaEventSourceMappingDisappears(conf *lambda.EventSourceM
 }
 
 func testAccCheckLambdaEventSourceMappingDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).lambdaconn
+	conn := testAccProvider.Meta().(*AWSClient).lambdaconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_lambda_event_source_mapping" {
@@ -453,7 +453,7 @@ func testAccCheckAwsLambdaEventSourceMappingExists(n string, mapping *lambda.Eve
 			return fmt.Errorf("Lambda event source mapping ID not set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).lambdaconn
+		conn := testAccProvider.Meta().(*AWSClient).lambdaconn()
 
 		params := &lambda.GetEventSourceMappingInput{
 			UUID: aws.String(rs.Primary.ID),
@@ -32,7 +32,7 @@ func testSweepLambdaFunctions(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	lambdaconn := client.(*AWSClient).lambdaconn
+	lambdaconn := client.(*AWSClient).lambdaconn()
 
 	resp, err := lambdaconn.ListFunctions(&lambda.ListFunctionsInput{})
 	if err != nil {
@@ -1360,7 +1360,7 @@ func TestAccAWSLambdaFunction_runtimeValidation_ruby25(t *testing.T) {
 }
 
 func testAccCheckLambdaFunctionDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).lambdaconn
+	conn := testAccProvider.Meta().(*AWSClient).lambdaconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_lambda_function" {
@@ -1393,7 +1393,7 @@ func testAccCheckAwsLambdaFunctionExists(res, funcName string, function *lambda.
 			return fmt.Errorf("Lambda function ID not set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).lambdaconn
+		conn := testAccProvider.Meta().(*AWSClient).lambdaconn()
 
 		params := &lambda.GetFunctionInput{
 			FunctionName: aws.String(funcName),
@@ -1413,7 +1413,7 @@ func testAccCheckAwsLambdaFunctionExists(res, funcName string, function *lambda.
 func testAccAwsInvokeLambdaFunction(function *lambda.GetFunctionOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		f := function.Configuration
-		conn := testAccProvider.Meta().(*AWSClient).lambdaconn
+		conn := testAccProvider.Meta().(*AWSClient).lambdaconn()
 
 		// If the function is VPC-enabled this will create ENI automatically
 		_, err := conn.Invoke(&lambda.InvokeInput{
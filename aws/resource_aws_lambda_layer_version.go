@@ -110,7 +110,7 @@ func resourceAwsLambdaLayerVersion() *schema.Resource {
 }
 
 func resourceAwsLambdaLayerVersionPublish(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lambdaconn
+	conn := meta.(*AWSClient).lambdaconn()
 
 	layerName := d.Get("layer_name").(string)
 	filename, hasFilename := d.GetOk("filename")
@@ -168,7 +168,7 @@ func resourceAwsLambdaLayerVersionPublish(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsLambdaLayerVersionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lambdaconn
+	conn := meta.(*AWSClient).lambdaconn()
 
 	layerName, version, err := resourceAwsLambdaLayerVersionParseId(d.Id())
 	if err != nil {
@@ -225,7 +225,7 @@ func resourceAwsLambdaLayerVersionRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsLambdaLayerVersionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lambdaconn
+	conn := meta.(*AWSClient).lambdaconn()
 
 	version, err := strconv.ParseInt(d.Get("version").(string), 10, 64)
 	if err != nil {
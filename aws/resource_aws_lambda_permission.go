@@ -85,7 +85,7 @@ func resourceAwsLambdaPermission() *schema.Resource {
 }
 
 func resourceAwsLambdaPermissionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lambdaconn
+	conn := meta.(*AWSClient).lambdaconn()
 
 	functionName := d.Get("function_name").(string)
 
@@ -183,7 +183,7 @@ func resourceAwsLambdaPermissionCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsLambdaPermissionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lambdaconn
+	conn := meta.(*AWSClient).lambdaconn()
 
 	input := lambda.GetPolicyInput{
 		FunctionName: aws.String(d.Get("function_name").(string)),
@@ -281,7 +281,7 @@ func resourceAwsLambdaPermissionRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsLambdaPermissionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lambdaconn
+	conn := meta.(*AWSClient).lambdaconn()
 
 	functionName := d.Get("function_name").(string)
 
@@ -446,7 +446,7 @@ func testAccCheckLambdaPermissionExists(n string, statement *LambdaPolicyStateme
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).lambdaconn
+		conn := testAccProvider.Meta().(*AWSClient).lambdaconn()
 
 		// IAM is eventually consistent
 		var foundStatement *LambdaPolicyStatement
@@ -478,7 +478,7 @@ func testAccCheckLambdaPermissionExists(n string, statement *LambdaPolicyStateme
 }
 
 func testAccCheckAWSLambdaPermissionDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).lambdaconn
+	conn := testAccProvider.Meta().(*AWSClient).lambdaconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_lambda_permission" {
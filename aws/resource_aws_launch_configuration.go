@@ -288,8 +288,8 @@ func resourceAwsLaunchConfiguration() *schema.Resource {
 }
 
 func resourceAwsLaunchConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
-	autoscalingconn := meta.(*AWSClient).autoscalingconn
-	ec2conn := meta.(*AWSClient).ec2conn
+	autoscalingconn := meta.(*AWSClient).autoscalingconn()
+	ec2conn := meta.(*AWSClient).ec2conn()
 
 	createLaunchConfigurationOpts := autoscaling.CreateLaunchConfigurationInput{
 		LaunchConfigurationName: aws.String(d.Get("name").(string)),
@@ -499,8 +499,8 @@ func resourceAwsLaunchConfigurationCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsLaunchConfigurationRead(d *schema.ResourceData, meta interface{}) error {
-	autoscalingconn := meta.(*AWSClient).autoscalingconn
-	ec2conn := meta.(*AWSClient).ec2conn
+	autoscalingconn := meta.(*AWSClient).autoscalingconn()
+	ec2conn := meta.(*AWSClient).ec2conn()
 
 	describeOpts := autoscaling.DescribeLaunchConfigurationsInput{
 		LaunchConfigurationNames: []*string{aws.String(d.Id())},
@@ -562,7 +562,7 @@ func resourceAwsLaunchConfigurationRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsLaunchConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
-	autoscalingconn := meta.(*AWSClient).autoscalingconn
+	autoscalingconn := meta.(*AWSClient).autoscalingconn()
 	input := &autoscaling.DeleteLaunchConfigurationInput{
 		LaunchConfigurationName: aws.String(d.Id()),
 	}
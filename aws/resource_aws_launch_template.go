@@ -497,7 +497,7 @@ func resourceAwsLaunchTemplate() *schema.Resource {
 }
 
 func resourceAwsLaunchTemplateCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	var ltName string
 	if v, ok := d.GetOk("name"); ok {
@@ -538,7 +538,7 @@ func resourceAwsLaunchTemplateCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsLaunchTemplateRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	log.Printf("[DEBUG] Reading launch template %s", d.Id())
 
@@ -671,7 +671,7 @@ func resourceAwsLaunchTemplateRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsLaunchTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	if !d.IsNewResource() {
 		launchTemplateData, err := buildLaunchTemplateData(d)
@@ -709,7 +709,7 @@ func resourceAwsLaunchTemplateUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsLaunchTemplateDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	log.Printf("[DEBUG] Launch Template destroy: %v", d.Id())
 	_, err := conn.DeleteLaunchTemplate(&ec2.DeleteLaunchTemplateInput{
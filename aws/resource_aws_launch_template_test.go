@@ -615,7 +615,7 @@ func testAccCheckAWSLaunchTemplateExists(n string, t *ec2.LaunchTemplate) resour
 			return fmt.Errorf("No Launch Template ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		resp, err := conn.DescribeLaunchTemplates(&ec2.DescribeLaunchTemplatesInput{
 			LaunchTemplateIds: []*string{aws.String(rs.Primary.ID)},
@@ -635,7 +635,7 @@ func testAccCheckAWSLaunchTemplateExists(n string, t *ec2.LaunchTemplate) resour
 }
 
 func testAccCheckAWSLaunchTemplateDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_launch_template" {
@@ -664,7 +664,7 @@ func testAccCheckAWSLaunchTemplateDestroy(s *terraform.State) error {
 
 func testAccCheckAWSLaunchTemplateDisappears(launchTemplate *ec2.LaunchTemplate) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		input := &ec2.DeleteLaunchTemplateInput{
 			LaunchTemplateId: launchTemplate.LaunchTemplateId,
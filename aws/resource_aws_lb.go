@@ -209,7 +209,7 @@ func suppressIfLBType(t string) schema.SchemaDiffSuppressFunc {
 }
 
 func resourceAwsLbCreate(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbv2conn
+	elbconn := meta.(*AWSClient).elbv2conn()
 
 	var name string
 	if v, ok := d.GetOk("name"); ok {
@@ -307,7 +307,7 @@ func resourceAwsLbCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsLbRead(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbv2conn
+	elbconn := meta.(*AWSClient).elbv2conn()
 	lbArn := d.Id()
 
 	describeLbOpts := &elbv2.DescribeLoadBalancersInput{
@@ -333,7 +333,7 @@ func resourceAwsLbRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsLbUpdate(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbv2conn
+	elbconn := meta.(*AWSClient).elbv2conn()
 
 	if !d.IsNewResource() {
 		if err := setElbV2Tags(elbconn, d); err != nil {
@@ -490,7 +490,7 @@ func resourceAwsLbUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsLbDelete(d *schema.ResourceData, meta interface{}) error {
-	lbconn := meta.(*AWSClient).elbv2conn
+	lbconn := meta.(*AWSClient).elbv2conn()
 
 	log.Printf("[INFO] Deleting LB: %s", d.Id())
 
@@ -502,7 +502,7 @@ func resourceAwsLbDelete(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error deleting LB: %s", err)
 	}
 
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	err := cleanupLBNetworkInterfaces(conn, d.Id())
 	if err != nil {
@@ -657,7 +657,7 @@ func lbSuffixFromARN(arn *string) string {
 
 // flattenAwsLbResource takes a *elbv2.LoadBalancer and populates all respective resource fields.
 func flattenAwsLbResource(d *schema.ResourceData, meta interface{}, lb *elbv2.LoadBalancer) error {
-	elbconn := meta.(*AWSClient).elbv2conn
+	elbconn := meta.(*AWSClient).elbv2conn()
 
 	d.Set("arn", lb.LoadBalancerArn)
 	d.Set("arn_suffix", lbSuffixFromARN(lb.LoadBalancerArn))
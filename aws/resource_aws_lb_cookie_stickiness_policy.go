@@ -50,7 +50,7 @@ func resourceAwsLBCookieStickinessPolicy() *schema.Resource {
 }
 
 func resourceAwsLBCookieStickinessPolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbconn
+	elbconn := meta.(*AWSClient).elbconn()
 
 	// Provision the LBStickinessPolicy
 	lbspOpts := &elb.CreateLBCookieStickinessPolicyInput{
@@ -86,7 +86,7 @@ func resourceAwsLBCookieStickinessPolicyCreate(d *schema.ResourceData, meta inte
 }
 
 func resourceAwsLBCookieStickinessPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbconn
+	elbconn := meta.(*AWSClient).elbconn()
 
 	lbName, lbPort, policyName := resourceAwsLBCookieStickinessPolicyParseId(d.Id())
 
@@ -139,7 +139,7 @@ func resourceAwsLBCookieStickinessPolicyRead(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsLBCookieStickinessPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbconn
+	elbconn := meta.(*AWSClient).elbconn()
 
 	lbName, _, policyName := resourceAwsLBCookieStickinessPolicyParseId(d.Id())
 
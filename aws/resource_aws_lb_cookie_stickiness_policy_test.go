@@ -43,7 +43,7 @@ func TestAccAWSLBCookieStickinessPolicy_basic(t *testing.T) {
 }
 
 func testAccCheckLBCookieStickinessPolicyDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).elbconn
+	conn := testAccProvider.Meta().(*AWSClient).elbconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_lb_cookie_stickiness_policy" {
@@ -87,7 +87,7 @@ func testAccCheckLBCookieStickinessPolicy(elbResource string, policyResource str
 			return fmt.Errorf("Not found: %s", policyResource)
 		}
 
-		elbconn := testAccProvider.Meta().(*AWSClient).elbconn
+		elbconn := testAccProvider.Meta().(*AWSClient).elbconn()
 		elbName, _, policyName := resourceAwsLBCookieStickinessPolicyParseId(policy.Primary.ID)
 		_, err := elbconn.DescribeLoadBalancerPolicies(&elb.DescribeLoadBalancerPoliciesInput{
 			LoadBalancerName: aws.String(elbName),
@@ -104,7 +104,7 @@ func TestAccAWSLBCookieStickinessPolicy_drift(t *testing.T) {
 	// We only want to remove the reference to the policy from the listner,
 	// beacause that's all that can be done via the console.
 	removePolicy := func() {
-		conn := testAccProvider.Meta().(*AWSClient).elbconn
+		conn := testAccProvider.Meta().(*AWSClient).elbconn()
 
 		setLoadBalancerOpts := &elb.SetLoadBalancerPoliciesOfListenerInput{
 			LoadBalancerName: aws.String(lbName),
@@ -150,7 +150,7 @@ func TestAccAWSLBCookieStickinessPolicy_missingLB(t *testing.T) {
 
 	// check that we can destroy the policy if the LB is missing
 	removeLB := func() {
-		conn := testAccProvider.Meta().(*AWSClient).elbconn
+		conn := testAccProvider.Meta().(*AWSClient).elbconn()
 		deleteElbOpts := elb.DeleteLoadBalancerInput{
 			LoadBalancerName: aws.String(lbName),
 		}
@@ -331,7 +331,7 @@ func suppressIfDefaultActionTypeNot(t string) schema.SchemaDiffSuppressFunc {
 }
 
 func resourceAwsLbListenerCreate(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbv2conn
+	elbconn := meta.(*AWSClient).elbv2conn()
 
 	lbArn := d.Get("load_balancer_arn").(string)
 
@@ -507,7 +507,7 @@ func resourceAwsLbListenerCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsLbListenerRead(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbv2conn
+	elbconn := meta.(*AWSClient).elbv2conn()
 
 	var resp *elbv2.DescribeListenersOutput
 	var request = &elbv2.DescribeListenersInput{
@@ -641,7 +641,7 @@ func resourceAwsLbListenerRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsLbListenerUpdate(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbv2conn
+	elbconn := meta.(*AWSClient).elbv2conn()
 
 	params := &elbv2.ModifyListenerInput{
 		ListenerArn: aws.String(d.Id()),
@@ -807,7 +807,7 @@ func resourceAwsLbListenerUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsLbListenerDelete(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbv2conn
+	elbconn := meta.(*AWSClient).elbv2conn()
 
 	_, err := elbconn.DeleteListener(&elbv2.DeleteListenerInput{
 		ListenerArn: aws.String(d.Id()),
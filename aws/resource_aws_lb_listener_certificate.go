@@ -34,7 +34,7 @@ func resourceAwsLbListenerCertificate() *schema.Resource {
 }
 
 func resourceAwsLbListenerCertificateCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elbv2conn
+	conn := meta.(*AWSClient).elbv2conn()
 
 	params := &elbv2.AddListenerCertificatesInput{
 		ListenerArn: aws.String(d.Get("listener_arn").(string)),
@@ -61,7 +61,7 @@ func resourceAwsLbListenerCertificateCreate(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsLbListenerCertificateRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elbv2conn
+	conn := meta.(*AWSClient).elbv2conn()
 
 	certificateArn := d.Get("certificate_arn").(string)
 	listenerArn := d.Get("listener_arn").(string)
@@ -99,7 +99,7 @@ func resourceAwsLbListenerCertificateRead(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsLbListenerCertificateDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elbv2conn
+	conn := meta.(*AWSClient).elbv2conn()
 	log.Printf("[DEBUG] Deleting certificate: %s of listener: %s", d.Get("certificate_arn").(string), d.Get("listener_arn").(string))
 
 	params := &elbv2.RemoveListenerCertificatesInput{
@@ -96,7 +96,7 @@ func TestAccAwsLbListenerCertificate_cycle(t *testing.T) {
 }
 
 func testAccCheckAwsLbListenerCertificateDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).elbv2conn
+	conn := testAccProvider.Meta().(*AWSClient).elbv2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_lb_listener_certificate" {
@@ -319,7 +319,7 @@ func suppressIfActionTypeNot(t string) schema.SchemaDiffSuppressFunc {
 }
 
 func resourceAwsLbListenerRuleCreate(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbv2conn
+	elbconn := meta.(*AWSClient).elbv2conn()
 	listenerArn := d.Get("listener_arn").(string)
 
 	params := &elbv2.CreateRuleInput{
@@ -506,7 +506,7 @@ func resourceAwsLbListenerRuleCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsLbListenerRuleRead(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbv2conn
+	elbconn := meta.(*AWSClient).elbv2conn()
 
 	var resp *elbv2.DescribeRulesOutput
 	var req = &elbv2.DescribeRulesInput{
@@ -658,7 +658,7 @@ func resourceAwsLbListenerRuleRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsLbListenerRuleUpdate(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbv2conn
+	elbconn := meta.(*AWSClient).elbv2conn()
 
 	d.Partial(true)
 
@@ -850,7 +850,7 @@ func resourceAwsLbListenerRuleUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsLbListenerRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbv2conn
+	elbconn := meta.(*AWSClient).elbv2conn()
 
 	_, err := elbconn.DeleteRule(&elbv2.DeleteRuleInput{
 		RuleArn: aws.String(d.Id()),
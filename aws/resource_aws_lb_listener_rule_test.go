@@ -493,7 +493,7 @@ func testAccCheckAWSLBListenerRuleActionOrderDisappears(rule *elbv2.Rule, action
 			return fmt.Errorf("Unable to find action order %d from actions: %#v", actionOrderToDelete, rule.Actions)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).elbv2conn
+		conn := testAccProvider.Meta().(*AWSClient).elbv2conn()
 
 		input := &elbv2.ModifyRuleInput{
 			Actions: newActions,
@@ -527,7 +527,7 @@ func testAccCheckAWSLBListenerRuleExists(n string, res *elbv2.Rule) resource.Tes
 			return errors.New("No Listener Rule ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).elbv2conn
+		conn := testAccProvider.Meta().(*AWSClient).elbv2conn()
 
 		describe, err := conn.DescribeRules(&elbv2.DescribeRulesInput{
 			RuleArns: []*string{aws.String(rs.Primary.ID)},
@@ -548,7 +548,7 @@ func testAccCheckAWSLBListenerRuleExists(n string, res *elbv2.Rule) resource.Tes
 }
 
 func testAccCheckAWSLBListenerRuleDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).elbv2conn
+	conn := testAccProvider.Meta().(*AWSClient).elbv2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_lb_listener_rule" && rs.Type != "aws_alb_listener_rule" {
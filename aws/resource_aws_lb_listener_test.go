@@ -334,7 +334,7 @@ func testAccCheckAWSLBListenerDefaultActionOrderDisappears(listener *elbv2.Liste
 			return fmt.Errorf("Unable to find default action order %d from default actions: %#v", actionOrderToDelete, listener.DefaultActions)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).elbv2conn
+		conn := testAccProvider.Meta().(*AWSClient).elbv2conn()
 
 		input := &elbv2.ModifyListenerInput{
 			DefaultActions: newDefaultActions,
@@ -358,7 +358,7 @@ func testAccCheckAWSLBListenerExists(n string, res *elbv2.Listener) resource.Tes
 			return errors.New("No Listener ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).elbv2conn
+		conn := testAccProvider.Meta().(*AWSClient).elbv2conn()
 
 		describe, err := conn.DescribeListeners(&elbv2.DescribeListenersInput{
 			ListenerArns: []*string{aws.String(rs.Primary.ID)},
@@ -379,7 +379,7 @@ func testAccCheckAWSLBListenerExists(n string, res *elbv2.Listener) resource.Tes
 }
 
 func testAccCheckAWSLBListenerDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).elbv2conn
+	conn := testAccProvider.Meta().(*AWSClient).elbv2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_lb_listener" && rs.Type != "aws_alb_listener" {
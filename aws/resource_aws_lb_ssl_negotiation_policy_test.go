@@ -40,7 +40,7 @@ func TestAccAWSLBSSLNegotiationPolicy_missingLB(t *testing.T) {
 
 	// check that we can destroy the policy if the LB is missing
 	removeLB := func() {
-		conn := testAccProvider.Meta().(*AWSClient).elbconn
+		conn := testAccProvider.Meta().(*AWSClient).elbconn()
 		deleteElbOpts := elb.DeleteLoadBalancerInput{
 			LoadBalancerName: aws.String(lbName),
 		}
@@ -74,7 +74,7 @@ func TestAccAWSLBSSLNegotiationPolicy_missingLB(t *testing.T) {
 }
 
 func testAccCheckLBSSLNegotiationPolicyDestroy(s *terraform.State) error {
-	elbconn := testAccProvider.Meta().(*AWSClient).elbconn
+	elbconn := testAccProvider.Meta().(*AWSClient).elbconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_elb" && rs.Type != "aws_lb_ssl_negotiation_policy" {
@@ -136,7 +136,7 @@ func testAccCheckLBSSLNegotiationPolicy(elbResource string, policyResource strin
 			return fmt.Errorf("Not found: %s", policyResource)
 		}
 
-		elbconn := testAccProvider.Meta().(*AWSClient).elbconn
+		elbconn := testAccProvider.Meta().(*AWSClient).elbconn()
 
 		elbName, _, policyName := resourceAwsLBSSLNegotiationPolicyParseId(policy.Primary.ID)
 		resp, err := elbconn.DescribeLoadBalancerPolicies(&elb.DescribeLoadBalancerPoliciesInput{
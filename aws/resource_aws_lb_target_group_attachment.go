@@ -45,7 +45,7 @@ func resourceAwsLbTargetGroupAttachment() *schema.Resource {
 }
 
 func resourceAwsLbAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbv2conn
+	elbconn := meta.(*AWSClient).elbv2conn()
 
 	target := &elbv2.TargetDescription{
 		Id: aws.String(d.Get("target_id").(string)),
@@ -78,7 +78,7 @@ func resourceAwsLbAttachmentCreate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsLbAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbv2conn
+	elbconn := meta.(*AWSClient).elbv2conn()
 
 	target := &elbv2.TargetDescription{
 		Id: aws.String(d.Get("target_id").(string)),
@@ -108,7 +108,7 @@ func resourceAwsLbAttachmentDelete(d *schema.ResourceData, meta interface{}) err
 // resourceAwsLbAttachmentRead requires all of the fields in order to describe the correct
 // target, so there is no work to do beyond ensuring that the target and group still exist.
 func resourceAwsLbAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbv2conn
+	elbconn := meta.(*AWSClient).elbv2conn()
 
 	target := &elbv2.TargetDescription{
 		Id: aws.String(d.Get("target_id").(string)),
@@ -119,7 +119,7 @@ func testAccCheckAWSLBTargetGroupAttachmentExists(n string) resource.TestCheckFu
 			return errors.New("No Target Group Attachment ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).elbv2conn
+		conn := testAccProvider.Meta().(*AWSClient).elbv2conn()
 
 		_, hasPort := rs.Primary.Attributes["port"]
 		targetGroupArn := rs.Primary.Attributes["target_group_arn"]
@@ -150,7 +150,7 @@ func testAccCheckAWSLBTargetGroupAttachmentExists(n string) resource.TestCheckFu
 }
 
 func testAccCheckAWSLBTargetGroupAttachmentDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).elbv2conn
+	conn := testAccProvider.Meta().(*AWSClient).elbv2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_lb_target_group_attachment" && rs.Type != "aws_alb_target_group_attachment" {
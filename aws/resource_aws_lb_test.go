@@ -30,7 +30,7 @@ func testSweepLBs(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).elbv2conn
+	conn := client.(*AWSClient).elbv2conn()
 
 	err = conn.DescribeLoadBalancersPages(&elbv2.DescribeLoadBalancersInput{}, func(page *elbv2.DescribeLoadBalancersOutput, isLast bool) bool {
 		if page == nil || len(page.LoadBalancers) == 0 {
@@ -712,7 +712,7 @@ func testAccCheckAWSLBExists(n string, res *elbv2.LoadBalancer) resource.TestChe
 			return errors.New("No LB ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).elbv2conn
+		conn := testAccProvider.Meta().(*AWSClient).elbv2conn()
 
 		describe, err := conn.DescribeLoadBalancers(&elbv2.DescribeLoadBalancersInput{
 			LoadBalancerArns: []*string{aws.String(rs.Primary.ID)},
@@ -743,7 +743,7 @@ func testAccCheckAWSLBAttribute(n, key, value string) resource.TestCheckFunc {
 			return errors.New("No LB ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).elbv2conn
+		conn := testAccProvider.Meta().(*AWSClient).elbv2conn()
 		attributesResp, err := conn.DescribeLoadBalancerAttributes(&elbv2.DescribeLoadBalancerAttributesInput{
 			LoadBalancerArn: aws.String(rs.Primary.ID),
 		})
@@ -764,7 +764,7 @@ func testAccCheckAWSLBAttribute(n, key, value string) resource.TestCheckFunc {
 }
 
 func testAccCheckAWSLBDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).elbv2conn
+	conn := testAccProvider.Meta().(*AWSClient).elbv2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_lb" && rs.Type != "aws_alb" {
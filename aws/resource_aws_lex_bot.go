@@ -0,0 +1,470 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsLexBot() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLexBotCreate,
+		Read:   resourceAwsLexBotRead,
+		Update: resourceAwsLexBotUpdate,
+		Delete: resourceAwsLexBotDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsLexBotImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"abort_statement": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 1,
+				Elem:     lexStatementResource(),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"child_directed": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"clarification_prompt": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem:     lexPromptResource(),
+			},
+			"create_version": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"created_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "",
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"failure_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"idle_session_ttl_in_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      300,
+				ValidateFunc: validation.IntBetween(60, 86400),
+			},
+			"intent": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 100,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"intent_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 100),
+								validation.StringMatch(regexp.MustCompile(`^([A-Za-z]_?)+$`), "must contain only letters separated by single underscores"),
+							),
+						},
+						"intent_version": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 64),
+						},
+					},
+				},
+			},
+			"last_updated_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"locale": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  lexmodelbuildingservice.LocaleEnUs,
+				ValidateFunc: validation.StringInSlice([]string{
+					lexmodelbuildingservice.LocaleDeDe,
+					lexmodelbuildingservice.LocaleEnGb,
+					lexmodelbuildingservice.LocaleEnUs,
+				}, false),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(2, 50),
+					validation.StringMatch(regexp.MustCompile(`^([A-Za-z]_?)+$`), "must contain only letters separated by single underscores"),
+				),
+			},
+			"process_behavior": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  lexmodelbuildingservice.ProcessBehaviorSave,
+				ValidateFunc: validation.StringInSlice([]string{
+					lexmodelbuildingservice.ProcessBehaviorBuild,
+					lexmodelbuildingservice.ProcessBehaviorSave,
+				}, false),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"voice_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsLexBotCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn()
+	name := d.Get("name").(string)
+
+	input := expandLexBot(d)
+
+	log.Printf("[DEBUG] Creating Lex Bot: %s", input)
+	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		_, err := conn.PutBot(input)
+
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeConflictException, "") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("error creating Lex Bot (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if err := waitForLexBotBuild(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Lex Bot (%s) build: %s", d.Id(), err)
+	}
+
+	return resourceAwsLexBotRead(d, meta)
+}
+
+func resourceAwsLexBotRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn()
+
+	output, err := conn.GetBot(&lexmodelbuildingservice.GetBotInput{
+		Name:           aws.String(d.Id()),
+		VersionOrAlias: aws.String(lexVersionLatest),
+	})
+
+	if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] Lex Bot (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lex Bot (%s): %s", d.Id(), err)
+	}
+
+	version, err := lexGetLatestBotVersion(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error reading Lex Bot (%s) versions: %s", d.Id(), err)
+	}
+
+	if err := d.Set("abort_statement", flattenLexStatement(output.AbortStatement)); err != nil {
+		return fmt.Errorf("error setting abort_statement: %s", err)
+	}
+
+	d.Set("arn", lexBotArn(meta.(*AWSClient), d.Id()))
+	d.Set("checksum", output.Checksum)
+	d.Set("child_directed", output.ChildDirected)
+
+	if err := d.Set("clarification_prompt", flattenLexPrompt(output.ClarificationPrompt)); err != nil {
+		return fmt.Errorf("error setting clarification_prompt: %s", err)
+	}
+
+	d.Set("created_date", aws.TimeValue(output.CreatedDate).Format(time.RFC3339))
+	d.Set("description", output.Description)
+	d.Set("failure_reason", output.FailureReason)
+	d.Set("idle_session_ttl_in_seconds", output.IdleSessionTTLInSeconds)
+
+	if err := d.Set("intent", flattenLexIntents(output.Intents)); err != nil {
+		return fmt.Errorf("error setting intent: %s", err)
+	}
+
+	d.Set("last_updated_date", aws.TimeValue(output.LastUpdatedDate).Format(time.RFC3339))
+	d.Set("locale", output.Locale)
+	d.Set("name", output.Name)
+	d.Set("status", output.Status)
+	d.Set("version", version)
+	d.Set("voice_id", output.VoiceId)
+
+	return nil
+}
+
+func resourceAwsLexBotUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn()
+
+	input := expandLexBot(d)
+	input.Checksum = lexChecksum(d)
+
+	log.Printf("[DEBUG] Updating Lex Bot: %s", input)
+	err := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		_, err := conn.PutBot(input)
+
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeConflictException, "") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("error updating Lex Bot (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForLexBotBuild(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("error waiting for Lex Bot (%s) build: %s", d.Id(), err)
+	}
+
+	return resourceAwsLexBotRead(d, meta)
+}
+
+func resourceAwsLexBotDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn()
+
+	input := &lexmodelbuildingservice.DeleteBotInput{
+		Name: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting Lex Bot: %s", input)
+	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err := conn.DeleteBot(input)
+
+		// Bot aliases that are being deleted still reference the bot for a short while.
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeConflictException, "") || isAWSErr(err, lexmodelbuildingservice.ErrCodeResourceInUseException, "") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Lex Bot (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForLexBotDeletion(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Lex Bot (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsLexBotImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// create_version and process_behavior cannot be read from the API.
+	d.Set("create_version", false)
+	d.Set("process_behavior", lexmodelbuildingservice.ProcessBehaviorSave)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func lexBotArn(client *AWSClient, name string) string {
+	return arn.ARN{
+		Partition: client.partition,
+		Service:   "lex",
+		Region:    client.region,
+		AccountID: client.accountid,
+		Resource:  fmt.Sprintf("bot:%s", name),
+	}.String()
+}
+
+func lexBotStatusRefreshFunc(conn *lexmodelbuildingservice.LexModelBuildingService, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.GetBot(&lexmodelbuildingservice.GetBotInput{
+			Name:           aws.String(name),
+			VersionOrAlias: aws.String(lexVersionLatest),
+		})
+
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+			return "", "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil {
+			return "", "", nil
+		}
+
+		status := aws.StringValue(output.Status)
+
+		if status == lexmodelbuildingservice.StatusFailed {
+			return output, status, fmt.Errorf("%s", aws.StringValue(output.FailureReason))
+		}
+
+		return output, status, nil
+	}
+}
+
+func waitForLexBotBuild(conn *lexmodelbuildingservice.LexModelBuildingService, name string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		// The status can be empty right after the bot is put.
+		Pending: []string{"", lexmodelbuildingservice.StatusBuilding},
+		Target: []string{
+			lexmodelbuildingservice.StatusNotBuilt,
+			lexmodelbuildingservice.StatusReady,
+			lexmodelbuildingservice.StatusReadyBasicTesting,
+		},
+		Refresh: lexBotStatusRefreshFunc(conn, name),
+		Timeout: timeout,
+	}
+
+	log.Printf("[DEBUG] Waiting for Lex Bot (%s) build", name)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForLexBotDeletion(conn *lexmodelbuildingservice.LexModelBuildingService, name string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			lexmodelbuildingservice.StatusBuilding,
+			lexmodelbuildingservice.StatusFailed,
+			lexmodelbuildingservice.StatusNotBuilt,
+			lexmodelbuildingservice.StatusReady,
+			lexmodelbuildingservice.StatusReadyBasicTesting,
+		},
+		Target:  []string{},
+		Refresh: lexBotDeletionRefreshFunc(conn, name),
+		Timeout: timeout,
+	}
+
+	log.Printf("[DEBUG] Waiting for Lex Bot (%s) deletion", name)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func lexBotDeletionRefreshFunc(conn *lexmodelbuildingservice.LexModelBuildingService, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.GetBot(&lexmodelbuildingservice.GetBotInput{
+			Name:           aws.String(name),
+			VersionOrAlias: aws.String(lexVersionLatest),
+		})
+
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func expandLexBot(d *schema.ResourceData) *lexmodelbuildingservice.PutBotInput {
+	input := &lexmodelbuildingservice.PutBotInput{
+		AbortStatement:          expandLexStatement(d.Get("abort_statement").([]interface{})),
+		ChildDirected:           aws.Bool(d.Get("child_directed").(bool)),
+		ClarificationPrompt:     expandLexPrompt(d.Get("clarification_prompt").([]interface{})),
+		CreateVersion:           aws.Bool(d.Get("create_version").(bool)),
+		Description:             aws.String(d.Get("description").(string)),
+		IdleSessionTTLInSeconds: aws.Int64(int64(d.Get("idle_session_ttl_in_seconds").(int))),
+		Intents:                 expandLexIntents(d.Get("intent").(*schema.Set)),
+		Locale:                  aws.String(d.Get("locale").(string)),
+		Name:                    aws.String(d.Get("name").(string)),
+		ProcessBehavior:         aws.String(d.Get("process_behavior").(string)),
+	}
+
+	if v, ok := d.GetOk("voice_id"); ok {
+		input.VoiceId = aws.String(v.(string))
+	}
+
+	return input
+}
+
+func expandLexIntents(s *schema.Set) []*lexmodelbuildingservice.Intent {
+	intents := make([]*lexmodelbuildingservice.Intent, 0, s.Len())
+
+	for _, v := range s.List() {
+		m := v.(map[string]interface{})
+
+		intents = append(intents, &lexmodelbuildingservice.Intent{
+			IntentName:    aws.String(m["intent_name"].(string)),
+			IntentVersion: aws.String(m["intent_version"].(string)),
+		})
+	}
+
+	return intents
+}
+
+func flattenLexIntents(intents []*lexmodelbuildingservice.Intent) []interface{} {
+	l := make([]interface{}, 0, len(intents))
+
+	for _, intent := range intents {
+		m := map[string]interface{}{
+			"intent_name":    aws.StringValue(intent.IntentName),
+			"intent_version": aws.StringValue(intent.IntentVersion),
+		}
+
+		l = append(l, m)
+	}
+
+	return l
+}
@@ -0,0 +1,154 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSLexBotAlias_basic(t *testing.T) {
+	var botAlias lexmodelbuildingservice.GetBotAliasOutput
+	rName := acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)
+	resourceName := "aws_lex_bot_alias.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSLex(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLexBotAliasDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLexBotAliasConfig_basic(rName, "Testing Lex Bot Alias"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLexBotAliasExists(resourceName, &botAlias),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "lex", regexp.MustCompile(`bot:.+:.+`)),
+					resource.TestCheckResourceAttr(resourceName, "bot_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "bot_version", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "checksum"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_date"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Testing Lex Bot Alias"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated_date"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSLexBotAliasConfig_basic(rName, "Testing Lex Bot Alias updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLexBotAliasExists(resourceName, &botAlias),
+					resource.TestCheckResourceAttr(resourceName, "description", "Testing Lex Bot Alias updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSLexBotAlias_disappears(t *testing.T) {
+	var botAlias lexmodelbuildingservice.GetBotAliasOutput
+	rName := acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)
+	resourceName := "aws_lex_bot_alias.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSLex(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLexBotAliasDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLexBotAliasConfig_basic(rName, "Testing Lex Bot Alias"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLexBotAliasExists(resourceName, &botAlias),
+					testAccCheckAWSLexBotAliasDisappears(&botAlias),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSLexBotAliasDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lexmodelconn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lex_bot_alias" {
+			continue
+		}
+
+		_, err := conn.GetBotAlias(&lexmodelbuildingservice.GetBotAliasInput{
+			BotName: aws.String(rs.Primary.Attributes["bot_name"]),
+			Name:    aws.String(rs.Primary.Attributes["name"]),
+		})
+
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Lex Bot Alias (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSLexBotAliasExists(resourceName string, botAlias *lexmodelbuildingservice.GetBotAliasOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lex Bot Alias ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lexmodelconn()
+
+		output, err := conn.GetBotAlias(&lexmodelbuildingservice.GetBotAliasInput{
+			BotName: aws.String(rs.Primary.Attributes["bot_name"]),
+			Name:    aws.String(rs.Primary.Attributes["name"]),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		*botAlias = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSLexBotAliasDisappears(botAlias *lexmodelbuildingservice.GetBotAliasOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).lexmodelconn()
+
+		_, err := conn.DeleteBotAlias(&lexmodelbuildingservice.DeleteBotAliasInput{
+			BotName: botAlias.BotName,
+			Name:    botAlias.Name,
+		})
+
+		return err
+	}
+}
+
+func testAccAWSLexBotAliasConfig_basic(rName, description string) string {
+	return testAccAWSLexBotConfig_build(rName) + fmt.Sprintf(`
+resource "aws_lex_bot_alias" "test" {
+  bot_name    = "${aws_lex_bot.test.name}"
+  bot_version = "${aws_lex_bot.test.version}"
+  description = %[2]q
+  name        = %[1]q
+}
+`, rName, description)
+}
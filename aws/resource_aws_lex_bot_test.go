@@ -0,0 +1,250 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSLexBot_basic(t *testing.T) {
+	var bot lexmodelbuildingservice.GetBotOutput
+	rName := acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)
+	resourceName := "aws_lex_bot.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSLex(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLexBotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLexBotConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLexBotExists(resourceName, &bot),
+					resource.TestCheckResourceAttr(resourceName, "abort_statement.#", "1"),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "lex", regexp.MustCompile(`bot:.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "checksum"),
+					resource.TestCheckResourceAttr(resourceName, "child_directed", "false"),
+					resource.TestCheckResourceAttr(resourceName, "create_version", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_date"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "idle_session_ttl_in_seconds", "300"),
+					resource.TestCheckResourceAttr(resourceName, "intent.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated_date"),
+					resource.TestCheckResourceAttr(resourceName, "locale", lexmodelbuildingservice.LocaleEnUs),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "process_behavior", lexmodelbuildingservice.ProcessBehaviorSave),
+					resource.TestCheckResourceAttr(resourceName, "status", lexmodelbuildingservice.StatusNotBuilt),
+					resource.TestCheckResourceAttr(resourceName, "version", lexVersionLatest),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSLexBot_build(t *testing.T) {
+	var bot lexmodelbuildingservice.GetBotOutput
+	rName := acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)
+	resourceName := "aws_lex_bot.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSLex(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLexBotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLexBotConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLexBotExists(resourceName, &bot),
+					resource.TestCheckResourceAttr(resourceName, "status", lexmodelbuildingservice.StatusNotBuilt),
+				),
+			},
+			{
+				Config: testAccAWSLexBotConfig_build(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLexBotExists(resourceName, &bot),
+					resource.TestCheckResourceAttr(resourceName, "clarification_prompt.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "create_version", "true"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Bot to order flowers on the behalf of a user"),
+					resource.TestCheckResourceAttr(resourceName, "idle_session_ttl_in_seconds", "600"),
+					resource.TestCheckResourceAttr(resourceName, "process_behavior", lexmodelbuildingservice.ProcessBehaviorBuild),
+					resource.TestCheckResourceAttr(resourceName, "status", lexmodelbuildingservice.StatusReady),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSLexBot_disappears(t *testing.T) {
+	var bot lexmodelbuildingservice.GetBotOutput
+	rName := acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)
+	resourceName := "aws_lex_bot.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSLex(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLexBotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLexBotConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLexBotExists(resourceName, &bot),
+					testAccCheckAWSLexBotDisappears(&bot),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSLexBotDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lexmodelconn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lex_bot" {
+			continue
+		}
+
+		_, err := conn.GetBot(&lexmodelbuildingservice.GetBotInput{
+			Name:           aws.String(rs.Primary.ID),
+			VersionOrAlias: aws.String(lexVersionLatest),
+		})
+
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Lex Bot (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSLexBotExists(resourceName string, bot *lexmodelbuildingservice.GetBotOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lex Bot ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lexmodelconn()
+
+		output, err := conn.GetBot(&lexmodelbuildingservice.GetBotInput{
+			Name:           aws.String(rs.Primary.ID),
+			VersionOrAlias: aws.String(lexVersionLatest),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		*bot = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSLexBotDisappears(bot *lexmodelbuildingservice.GetBotOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).lexmodelconn()
+
+		_, err := conn.DeleteBot(&lexmodelbuildingservice.DeleteBotInput{
+			Name: bot.Name,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		return waitForLexBotDeletion(conn, aws.StringValue(bot.Name), 5*time.Minute)
+	}
+}
+
+func testAccAWSLexBotConfig_intent(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_lex_intent" "test" {
+  create_version    = true
+  name              = %[1]q
+  sample_utterances = ["I would like to pick up flowers"]
+
+  fulfillment_activity {
+    type = "ReturnIntent"
+  }
+}
+`, rName)
+}
+
+func testAccAWSLexBotConfig_basic(rName string) string {
+	return testAccAWSLexBotConfig_intent(rName) + fmt.Sprintf(`
+resource "aws_lex_bot" "test" {
+  child_directed = false
+  name           = %[1]q
+
+  abort_statement {
+    message {
+      content      = "Sorry, I am not able to assist at this time"
+      content_type = "PlainText"
+    }
+  }
+
+  intent {
+    intent_name    = "${aws_lex_intent.test.name}"
+    intent_version = "${aws_lex_intent.test.version}"
+  }
+}
+`, rName)
+}
+
+func testAccAWSLexBotConfig_build(rName string) string {
+	return testAccAWSLexBotConfig_intent(rName) + fmt.Sprintf(`
+resource "aws_lex_bot" "test" {
+  child_directed              = false
+  create_version              = true
+  description                 = "Bot to order flowers on the behalf of a user"
+  idle_session_ttl_in_seconds = 600
+  name                        = %[1]q
+  process_behavior            = "BUILD"
+
+  abort_statement {
+    message {
+      content      = "Sorry, I am not able to assist at this time"
+      content_type = "PlainText"
+    }
+  }
+
+  clarification_prompt {
+    max_attempts = 2
+
+    message {
+      content      = "I didn't understand you, what would you like to do?"
+      content_type = "PlainText"
+    }
+  }
+
+  intent {
+    intent_name    = "${aws_lex_intent.test.name}"
+    intent_version = "${aws_lex_intent.test.version}"
+  }
+}
+`, rName)
+}
@@ -0,0 +1,253 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSLexIntent_basic(t *testing.T) {
+	var intent lexmodelbuildingservice.GetIntentOutput
+	rName := acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)
+	resourceName := "aws_lex_intent.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSLex(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLexIntentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLexIntentConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLexIntentExists(resourceName, &intent),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "lex", regexp.MustCompile(`intent:.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "checksum"),
+					resource.TestCheckResourceAttr(resourceName, "create_version", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_date"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "fulfillment_activity.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "fulfillment_activity.0.type", lexmodelbuildingservice.FulfillmentActivityTypeReturnIntent),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated_date"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "sample_utterances.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "slot.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "version", lexVersionLatest),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSLexIntent_updateWithSlots(t *testing.T) {
+	var intent lexmodelbuildingservice.GetIntentOutput
+	rName := acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)
+	resourceName := "aws_lex_intent.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSLex(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLexIntentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLexIntentConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLexIntentExists(resourceName, &intent),
+					resource.TestCheckResourceAttr(resourceName, "slot.#", "0"),
+				),
+			},
+			{
+				Config: testAccAWSLexIntentConfig_slots(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLexIntentExists(resourceName, &intent),
+					resource.TestCheckResourceAttr(resourceName, "confirmation_prompt.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "confirmation_prompt.0.max_attempts", "2"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Intent to order a bouquet of flowers for pick up"),
+					resource.TestCheckResourceAttr(resourceName, "rejection_statement.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sample_utterances.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "slot.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSLexIntent_disappears(t *testing.T) {
+	var intent lexmodelbuildingservice.GetIntentOutput
+	rName := acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)
+	resourceName := "aws_lex_intent.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSLex(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLexIntentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLexIntentConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLexIntentExists(resourceName, &intent),
+					testAccCheckAWSLexIntentDisappears(&intent),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSLexIntentDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lexmodelconn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lex_intent" {
+			continue
+		}
+
+		_, err := conn.GetIntent(&lexmodelbuildingservice.GetIntentInput{
+			Name:    aws.String(rs.Primary.ID),
+			Version: aws.String(lexVersionLatest),
+		})
+
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Lex Intent (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSLexIntentExists(resourceName string, intent *lexmodelbuildingservice.GetIntentOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lex Intent ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lexmodelconn()
+
+		output, err := conn.GetIntent(&lexmodelbuildingservice.GetIntentInput{
+			Name:    aws.String(rs.Primary.ID),
+			Version: aws.String(lexVersionLatest),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		*intent = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSLexIntentDisappears(intent *lexmodelbuildingservice.GetIntentOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).lexmodelconn()
+
+		_, err := conn.DeleteIntent(&lexmodelbuildingservice.DeleteIntentInput{
+			Name: intent.Name,
+		})
+
+		return err
+	}
+}
+
+func testAccAWSLexIntentConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_lex_intent" "test" {
+  name              = %[1]q
+  sample_utterances = ["I would like to pick up flowers"]
+
+  fulfillment_activity {
+    type = "ReturnIntent"
+  }
+}
+`, rName)
+}
+
+func testAccAWSLexIntentConfig_slots(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_lex_slot_type" "test" {
+  name = %[1]q
+
+  enumeration_value {
+    synonyms = ["Lirium", "Martagon"]
+    value    = "lilies"
+  }
+}
+
+resource "aws_lex_intent" "test" {
+  description = "Intent to order a bouquet of flowers for pick up"
+  name        = %[1]q
+
+  sample_utterances = [
+    "I would like to pick up flowers",
+    "I would like to order some flowers",
+  ]
+
+  confirmation_prompt {
+    max_attempts = 2
+
+    message {
+      content      = "Okay, your {FlowerType} will be ready for pickup. Does this sound okay?"
+      content_type = "PlainText"
+    }
+  }
+
+  fulfillment_activity {
+    type = "ReturnIntent"
+  }
+
+  rejection_statement {
+    message {
+      content      = "Okay, I will not place your order."
+      content_type = "PlainText"
+    }
+  }
+
+  slot {
+    description       = "The type of flowers to pick up"
+    name              = "FlowerType"
+    priority          = 1
+    slot_constraint   = "Required"
+    slot_type         = "${aws_lex_slot_type.test.name}"
+    slot_type_version = "$LATEST"
+
+    sample_utterances = ["I would like to order {FlowerType}"]
+
+    value_elicitation_prompt {
+      max_attempts = 2
+
+      message {
+        content      = "What type of flowers would you like to order?"
+        content_type = "PlainText"
+      }
+    }
+  }
+}
+`, rName)
+}
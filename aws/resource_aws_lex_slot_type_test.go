@@ -0,0 +1,237 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSLexSlotType_basic(t *testing.T) {
+	var slotType lexmodelbuildingservice.GetSlotTypeOutput
+	rName := acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)
+	resourceName := "aws_lex_slot_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSLex(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLexSlotTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLexSlotTypeConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLexSlotTypeExists(resourceName, &slotType),
+					resource.TestCheckResourceAttrSet(resourceName, "checksum"),
+					resource.TestCheckResourceAttr(resourceName, "create_version", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_date"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Types of flowers to pick up"),
+					resource.TestCheckResourceAttr(resourceName, "enumeration_value.#", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated_date"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "value_selection_strategy", lexmodelbuildingservice.SlotValueSelectionStrategyOriginalValue),
+					resource.TestCheckResourceAttr(resourceName, "version", lexVersionLatest),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSLexSlotTypeConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLexSlotTypeExists(resourceName, &slotType),
+					resource.TestCheckResourceAttr(resourceName, "description", "Types of flowers to pick up and deliver"),
+					resource.TestCheckResourceAttr(resourceName, "enumeration_value.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "value_selection_strategy", lexmodelbuildingservice.SlotValueSelectionStrategyTopResolution),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSLexSlotType_createVersion(t *testing.T) {
+	var slotType lexmodelbuildingservice.GetSlotTypeOutput
+	rName := acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)
+	resourceName := "aws_lex_slot_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSLex(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLexSlotTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLexSlotTypeConfig_createVersion(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLexSlotTypeExists(resourceName, &slotType),
+					resource.TestCheckResourceAttr(resourceName, "create_version", "true"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSLexSlotType_disappears(t *testing.T) {
+	var slotType lexmodelbuildingservice.GetSlotTypeOutput
+	rName := acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)
+	resourceName := "aws_lex_slot_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSLex(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLexSlotTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLexSlotTypeConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLexSlotTypeExists(resourceName, &slotType),
+					testAccCheckAWSLexSlotTypeDisappears(&slotType),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccPreCheckAWSLex(t *testing.T) {
+	conn := testAccProvider.Meta().(*AWSClient).lexmodelconn()
+
+	_, err := conn.GetBots(&lexmodelbuildingservice.GetBotsInput{})
+
+	if testAccPreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccCheckAWSLexSlotTypeDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lexmodelconn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lex_slot_type" {
+			continue
+		}
+
+		_, err := conn.GetSlotType(&lexmodelbuildingservice.GetSlotTypeInput{
+			Name:    aws.String(rs.Primary.ID),
+			Version: aws.String(lexVersionLatest),
+		})
+
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Lex Slot Type (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSLexSlotTypeExists(resourceName string, slotType *lexmodelbuildingservice.GetSlotTypeOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lex Slot Type ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lexmodelconn()
+
+		output, err := conn.GetSlotType(&lexmodelbuildingservice.GetSlotTypeInput{
+			Name:    aws.String(rs.Primary.ID),
+			Version: aws.String(lexVersionLatest),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		*slotType = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSLexSlotTypeDisappears(slotType *lexmodelbuildingservice.GetSlotTypeOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).lexmodelconn()
+
+		_, err := conn.DeleteSlotType(&lexmodelbuildingservice.DeleteSlotTypeInput{
+			Name: slotType.Name,
+		})
+
+		return err
+	}
+}
+
+func testAccAWSLexSlotTypeConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_lex_slot_type" "test" {
+  description = "Types of flowers to pick up"
+  name        = %[1]q
+
+  enumeration_value {
+    synonyms = ["Lirium", "Martagon"]
+    value    = "lilies"
+  }
+
+  enumeration_value {
+    synonyms = ["Eduardoregelia", "Podonix"]
+    value    = "tulips"
+  }
+}
+`, rName)
+}
+
+func testAccAWSLexSlotTypeConfig_updated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_lex_slot_type" "test" {
+  description              = "Types of flowers to pick up and deliver"
+  name                     = %[1]q
+  value_selection_strategy = "TOP_RESOLUTION"
+
+  enumeration_value {
+    synonyms = ["Lirium", "Martagon"]
+    value    = "lilies"
+  }
+
+  enumeration_value {
+    synonyms = ["Eduardoregelia", "Podonix"]
+    value    = "tulips"
+  }
+
+  enumeration_value {
+    synonyms = ["Rosa"]
+    value    = "roses"
+  }
+}
+`, rName)
+}
+
+func testAccAWSLexSlotTypeConfig_createVersion(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_lex_slot_type" "test" {
+  create_version = true
+  name           = %[1]q
+
+  enumeration_value {
+    value = "lilies"
+  }
+}
+`, rName)
+}
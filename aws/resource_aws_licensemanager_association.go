@@ -37,7 +37,7 @@ func resourceAwsLicenseManagerAssociation() *schema.Resource {
 }
 
 func resourceAwsLicenseManagerAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).licensemanagerconn
+	conn := meta.(*AWSClient).licensemanagerconn()
 
 	resourceArn := d.Get("resource_arn").(string)
 	licenseConfigurationArn := d.Get("license_configuration_arn").(string)
@@ -62,7 +62,7 @@ func resourceAwsLicenseManagerAssociationCreate(d *schema.ResourceData, meta int
 }
 
 func resourceAwsLicenseManagerAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).licensemanagerconn
+	conn := meta.(*AWSClient).licensemanagerconn()
 
 	resourceArn, licenseConfigurationArn, err := resourceAwsLicenseManagerAssociationParseId(d.Id())
 	if err != nil {
@@ -121,7 +121,7 @@ func resourceAwsLicenseManagerAssociationParseId(id string) (string, string, err
 }
 
 func resourceAwsLicenseManagerAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).licensemanagerconn
+	conn := meta.(*AWSClient).licensemanagerconn()
 
 	resourceArn, licenseConfigurationArn, err := resourceAwsLicenseManagerAssociationParseId(d.Id())
 	if err != nil {
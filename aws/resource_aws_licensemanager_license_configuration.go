@@ -65,7 +65,7 @@ func resourceAwsLicenseManagerLicenseConfiguration() *schema.Resource {
 }
 
 func resourceAwsLicenseManagerLicenseConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).licensemanagerconn
+	conn := meta.(*AWSClient).licensemanagerconn()
 
 	opts := &licensemanager.CreateLicenseConfigurationInput{
 		LicenseCountingType: aws.String(d.Get("license_counting_type").(string)),
@@ -103,7 +103,7 @@ func resourceAwsLicenseManagerLicenseConfigurationCreate(d *schema.ResourceData,
 }
 
 func resourceAwsLicenseManagerLicenseConfigurationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).licensemanagerconn
+	conn := meta.(*AWSClient).licensemanagerconn()
 
 	resp, err := conn.GetLicenseConfiguration(&licensemanager.GetLicenseConfigurationInput{
 		LicenseConfigurationArn: aws.String(d.Id()),
@@ -135,11 +135,11 @@ func resourceAwsLicenseManagerLicenseConfigurationRead(d *schema.ResourceData, m
 }
 
 func resourceAwsLicenseManagerLicenseConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).licensemanagerconn
+	conn := meta.(*AWSClient).licensemanagerconn()
 
 	d.Partial(true)
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		if err := setTagsLicenseManager(conn, d); err != nil {
 			return err
 		}
@@ -169,7 +169,7 @@ func resourceAwsLicenseManagerLicenseConfigurationUpdate(d *schema.ResourceData,
 }
 
 func resourceAwsLicenseManagerLicenseConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).licensemanagerconn
+	conn := meta.(*AWSClient).licensemanagerconn()
 
 	opts := &licensemanager.DeleteLicenseConfigurationInput{
 		LicenseConfigurationArn: aws.String(d.Id()),
@@ -23,7 +23,7 @@ func testSweepLicenseManagerLicenseConfigurations(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).licensemanagerconn
+	conn := client.(*AWSClient).licensemanagerconn()
 
 	resp, err := conn.ListLicenseConfigurations(&licensemanager.ListLicenseConfigurationsInput{})
 
@@ -140,7 +140,7 @@ func testAccCheckLicenseManagerLicenseConfigurationExists(resourceName string, l
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).licensemanagerconn
+		conn := testAccProvider.Meta().(*AWSClient).licensemanagerconn()
 		resp, err := conn.ListLicenseConfigurations(&licensemanager.ListLicenseConfigurationsInput{
 			LicenseConfigurationArns: [](*string){aws.String(rs.Primary.ID)},
 		})
@@ -159,7 +159,7 @@ func testAccCheckLicenseManagerLicenseConfigurationExists(resourceName string, l
 }
 
 func testAccCheckLicenseManagerLicenseConfigurationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).licensemanagerconn
+	conn := testAccProvider.Meta().(*AWSClient).licensemanagerconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_licensemanager_license_configuration" {
@@ -108,7 +108,7 @@ func resourceAwsLightsailInstance() *schema.Resource {
 }
 
 func resourceAwsLightsailInstanceCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lightsailconn
+	conn := meta.(*AWSClient).lightsailconn()
 
 	iName := d.Get("name").(string)
 
@@ -157,7 +157,7 @@ func resourceAwsLightsailInstanceCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsLightsailInstanceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lightsailconn
+	conn := meta.(*AWSClient).lightsailconn()
 	resp, err := conn.GetInstance(&lightsail.GetInstanceInput{
 		InstanceName: aws.String(d.Id()),
 	})
@@ -203,7 +203,7 @@ func resourceAwsLightsailInstanceRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsLightsailInstanceDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lightsailconn
+	conn := meta.(*AWSClient).lightsailconn()
 	resp, err := conn.DeleteInstance(&lightsail.DeleteInstanceInput{
 		InstanceName: aws.String(d.Id()),
 	})
@@ -244,7 +244,7 @@ func resourceAwsLightsailInstanceDelete(d *schema.ResourceData, meta interface{}
 func resourceAwsLightsailOperationRefreshFunc(
 	oid *string, meta interface{}) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		conn := meta.(*AWSClient).lightsailconn
+		conn := meta.(*AWSClient).lightsailconn()
 		log.Printf("[DEBUG] Checking if Lightsail Operation (%s) is Completed", *oid)
 		o, err := conn.GetOperation(&lightsail.GetOperationInput{
 			OperationId: oid,
@@ -68,7 +68,7 @@ func TestAccAWSLightsailInstance_disapear(t *testing.T) {
 
 	testDestroy := func(*terraform.State) error {
 		// reach out and DELETE the Instance
-		conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+		conn := testAccProvider.Meta().(*AWSClient).lightsailconn()
 		_, err := conn.DeleteInstance(&lightsail.DeleteInstanceInput{
 			InstanceName: aws.String(lightsailName),
 		})
@@ -111,7 +111,7 @@ func testAccCheckAWSLightsailInstanceExists(n string, res *lightsail.Instance) r
 			return errors.New("No LightsailInstance ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+		conn := testAccProvider.Meta().(*AWSClient).lightsailconn()
 
 		respInstance, err := conn.GetInstance(&lightsail.GetInstanceInput{
 			InstanceName: aws.String(rs.Primary.Attributes["name"]),
@@ -136,7 +136,7 @@ func testAccCheckAWSLightsailInstanceDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+		conn := testAccProvider.Meta().(*AWSClient).lightsailconn()
 
 		respInstance, err := conn.GetInstance(&lightsail.GetInstanceInput{
 			InstanceName: aws.String(rs.Primary.Attributes["name"]),
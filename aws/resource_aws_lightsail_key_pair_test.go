@@ -118,7 +118,7 @@ func testAccCheckAWSLightsailKeyPairExists(n string, res *lightsail.KeyPair) res
 			return errors.New("No LightsailKeyPair set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+		conn := testAccProvider.Meta().(*AWSClient).lightsailconn()
 
 		respKeyPair, err := conn.GetKeyPair(&lightsail.GetKeyPairInput{
 			KeyPairName: aws.String(rs.Primary.Attributes["name"]),
@@ -143,7 +143,7 @@ func testAccCheckAWSLightsailKeyPairDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+		conn := testAccProvider.Meta().(*AWSClient).lightsailconn()
 
 		respKeyPair, err := conn.GetKeyPair(&lightsail.GetKeyPairInput{
 			KeyPairName: aws.String(rs.Primary.Attributes["name"]),
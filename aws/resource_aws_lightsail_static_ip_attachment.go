@@ -31,7 +31,7 @@ func resourceAwsLightsailStaticIpAttachment() *schema.Resource {
 }
 
 func resourceAwsLightsailStaticIpAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lightsailconn
+	conn := meta.(*AWSClient).lightsailconn()
 
 	staticIpName := d.Get("static_ip_name").(string)
 	log.Printf("[INFO] Attaching Lightsail Static IP: %q", staticIpName)
@@ -50,7 +50,7 @@ func resourceAwsLightsailStaticIpAttachmentCreate(d *schema.ResourceData, meta i
 }
 
 func resourceAwsLightsailStaticIpAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lightsailconn
+	conn := meta.(*AWSClient).lightsailconn()
 
 	staticIpName := d.Get("static_ip_name").(string)
 	log.Printf("[INFO] Reading Lightsail Static IP: %q", staticIpName)
@@ -81,7 +81,7 @@ func resourceAwsLightsailStaticIpAttachmentRead(d *schema.ResourceData, meta int
 }
 
 func resourceAwsLightsailStaticIpAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lightsailconn
+	conn := meta.(*AWSClient).lightsailconn()
 
 	name := d.Get("static_ip_name").(string)
 	log.Printf("[INFO] Detaching Lightsail Static IP: %q", name)
@@ -41,7 +41,7 @@ func TestAccAWSLightsailStaticIpAttachment_disappears(t *testing.T) {
 	keypairName := fmt.Sprintf("tf-test-lightsail-%s", acctest.RandString(5))
 
 	staticIpDestroy := func(*terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+		conn := testAccProvider.Meta().(*AWSClient).lightsailconn()
 		_, err := conn.DetachStaticIp(&lightsail.DetachStaticIpInput{
 			StaticIpName: aws.String(staticIpName),
 		})
@@ -81,7 +81,7 @@ func testAccCheckAWSLightsailStaticIpAttachmentExists(n string, staticIp *lights
 			return errors.New("No Lightsail Static IP Attachment ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+		conn := testAccProvider.Meta().(*AWSClient).lightsailconn()
 
 		resp, err := conn.GetStaticIp(&lightsail.GetStaticIpInput{
 			StaticIpName: aws.String(rs.Primary.ID),
@@ -109,7 +109,7 @@ func testAccCheckAWSLightsailStaticIpAttachmentDestroy(s *terraform.State) error
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+		conn := testAccProvider.Meta().(*AWSClient).lightsailconn()
 
 		resp, err := conn.GetStaticIp(&lightsail.GetStaticIpInput{
 			StaticIpName: aws.String(rs.Primary.ID),
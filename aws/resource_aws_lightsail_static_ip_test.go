@@ -26,7 +26,7 @@ func testSweepLightsailStaticIps(region string) error {
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).lightsailconn
+	conn := client.(*AWSClient).lightsailconn()
 
 	input := &lightsail.GetStaticIpsInput{}
 
@@ -90,7 +90,7 @@ func TestAccAWSLightsailStaticIp_disappears(t *testing.T) {
 	staticIpName := fmt.Sprintf("tf-test-lightsail-%s", acctest.RandString(5))
 
 	staticIpDestroy := func(*terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+		conn := testAccProvider.Meta().(*AWSClient).lightsailconn()
 		_, err := conn.ReleaseStaticIp(&lightsail.ReleaseStaticIpInput{
 			StaticIpName: aws.String(staticIpName),
 		})
@@ -130,7 +130,7 @@ func testAccCheckAWSLightsailStaticIpExists(n string, staticIp *lightsail.Static
 			return errors.New("No Lightsail Static IP ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+		conn := testAccProvider.Meta().(*AWSClient).lightsailconn()
 
 		resp, err := conn.GetStaticIp(&lightsail.GetStaticIpInput{
 			StaticIpName: aws.String(rs.Primary.ID),
@@ -155,7 +155,7 @@ func testAccCheckAWSLightsailStaticIpDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+		conn := testAccProvider.Meta().(*AWSClient).lightsailconn()
 
 		resp, err := conn.GetStaticIp(&lightsail.GetStaticIpInput{
 			StaticIpName: aws.String(rs.Primary.ID),
@@ -62,7 +62,7 @@ func policyInBackendServerPolicies(str string, list []string) bool {
 }
 
 func testAccCheckAWSLoadBalancerBackendServerPolicyDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).elbconn
+	conn := testAccProvider.Meta().(*AWSClient).elbconn()
 
 	for _, rs := range s.RootModule().Resources {
 		switch {
@@ -112,7 +112,7 @@ func testAccCheckAWSLoadBalancerBackendServerPolicyDestroy(s *terraform.State) e
 
 func testAccCheckAWSLoadBalancerBackendServerPolicyState(loadBalancerName string, loadBalancerBackendAuthPolicyName string, assigned bool) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		elbconn := testAccProvider.Meta().(*AWSClient).elbconn
+		elbconn := testAccProvider.Meta().(*AWSClient).elbconn()
 
 		loadBalancerDescription, err := elbconn.DescribeLoadBalancers(&elb.DescribeLoadBalancersInput{
 			LoadBalancerNames: []*string{aws.String(loadBalancerName)},
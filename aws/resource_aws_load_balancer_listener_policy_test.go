@@ -57,7 +57,7 @@ func policyInListenerPolicies(str string, list []string) bool {
 }
 
 func testAccCheckAWSLoadBalancerListenerPolicyDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).elbconn
+	conn := testAccProvider.Meta().(*AWSClient).elbconn()
 
 	for _, rs := range s.RootModule().Resources {
 		switch {
@@ -116,7 +116,7 @@ func testAccCheckAWSLoadBalancerListenerPolicyDestroy(s *terraform.State) error
 
 func testAccCheckAWSLoadBalancerListenerPolicyState(loadBalancerName string, loadBalancerListenerPort int64, loadBalancerListenerPolicyName string, assigned bool) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		elbconn := testAccProvider.Meta().(*AWSClient).elbconn
+		elbconn := testAccProvider.Meta().(*AWSClient).elbconn()
 
 		loadBalancerDescription, err := elbconn.DescribeLoadBalancers(&elb.DescribeLoadBalancersInput{
 			LoadBalancerNames: []*string{aws.String(loadBalancerName)},
@@ -113,7 +113,7 @@ func testAccCheckAWSLoadBalancerPolicyExists(resourceName string, policyDescript
 
 		loadBalancerName, policyName := resourceAwsLoadBalancerPolicyParseId(rs.Primary.ID)
 
-		conn := testAccProvider.Meta().(*AWSClient).elbconn
+		conn := testAccProvider.Meta().(*AWSClient).elbconn()
 
 		input := &elb.DescribeLoadBalancerPoliciesInput{
 			LoadBalancerName: aws.String(loadBalancerName),
@@ -137,7 +137,7 @@ func testAccCheckAWSLoadBalancerPolicyExists(resourceName string, policyDescript
 }
 
 func testAccCheckAWSLoadBalancerPolicyDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).elbconn
+	conn := testAccProvider.Meta().(*AWSClient).elbconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_load_balancer_policy" {
@@ -166,7 +166,7 @@ func testAccCheckAWSLoadBalancerPolicyDestroy(s *terraform.State) error {
 
 func testAccCheckAWSLoadBalancerPolicyDisappears(loadBalancer *elb.LoadBalancerDescription, policy *elb.PolicyDescription) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).elbconn
+		conn := testAccProvider.Meta().(*AWSClient).elbconn()
 
 		input := elb.DeleteLoadBalancerPolicyInput{
 			LoadBalancerName: loadBalancer.LoadBalancerName,
@@ -194,7 +194,7 @@ func testAccCheckAWSLoadBalancerPolicyState(elbResource string, policyResource s
 			return fmt.Errorf("Not found: %s", policyResource)
 		}
 
-		elbconn := testAccProvider.Meta().(*AWSClient).elbconn
+		elbconn := testAccProvider.Meta().(*AWSClient).elbconn()
 		loadBalancerName, policyName := resourceAwsLoadBalancerPolicyParseId(policy.Primary.ID)
 		loadBalancerPolicies, err := elbconn.DescribeLoadBalancerPolicies(&elb.DescribeLoadBalancerPoliciesInput{
 			LoadBalancerName: aws.String(loadBalancerName),
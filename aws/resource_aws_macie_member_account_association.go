@@ -27,7 +27,7 @@ func resourceAwsMacieMemberAccountAssociation() *schema.Resource {
 }
 
 func resourceAwsMacieMemberAccountAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).macieconn
+	conn := meta.(*AWSClient).macieconn()
 
 	memberAccountId := d.Get("member_account_id").(string)
 	req := &macie.AssociateMemberAccountInput{
@@ -45,7 +45,7 @@ func resourceAwsMacieMemberAccountAssociationCreate(d *schema.ResourceData, meta
 }
 
 func resourceAwsMacieMemberAccountAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).macieconn
+	conn := meta.(*AWSClient).macieconn()
 
 	req := &macie.ListMemberAccountsInput{}
 
@@ -74,7 +74,7 @@ func resourceAwsMacieMemberAccountAssociationRead(d *schema.ResourceData, meta i
 }
 
 func resourceAwsMacieMemberAccountAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).macieconn
+	conn := meta.(*AWSClient).macieconn()
 
 	log.Printf("[DEBUG] Deleting Macie member account association: %s", d.Id())
 
@@ -49,7 +49,7 @@ func TestAccAWSMacieMemberAccountAssociation_self(t *testing.T) {
 }
 
 func testAccCheckAWSMacieMemberAccountAssociationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).macieconn
+	conn := testAccProvider.Meta().(*AWSClient).macieconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_macie_member_account_association" {
@@ -82,7 +82,7 @@ func testAccCheckAWSMacieMemberAccountAssociationDestroy(s *terraform.State) err
 
 func testAccCheckAWSMacieMemberAccountAssociationExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).macieconn
+		conn := testAccProvider.Meta().(*AWSClient).macieconn()
 
 		rs, ok := s.RootModule().Resources[name]
 		if !ok {
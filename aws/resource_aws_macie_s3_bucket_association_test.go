@@ -68,7 +68,7 @@ func TestAccAWSMacieS3BucketAssociation_accountIdAndPrefix(t *testing.T) {
 }
 
 func testAccCheckAWSMacieS3BucketAssociationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).macieconn
+	conn := testAccProvider.Meta().(*AWSClient).macieconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_macie_s3_bucket_association" {
@@ -105,7 +105,7 @@ func testAccCheckAWSMacieS3BucketAssociationDestroy(s *terraform.State) error {
 
 func testAccCheckAWSMacieS3BucketAssociationExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).macieconn
+		conn := testAccProvider.Meta().(*AWSClient).macieconn()
 
 		rs, ok := s.RootModule().Resources[name]
 		if !ok {
@@ -72,7 +72,7 @@ func resourceAwsMediaPackageChannel() *schema.Resource {
 }
 
 func resourceAwsMediaPackageChannelCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).mediapackageconn
+	conn := meta.(*AWSClient).mediapackageconn()
 
 	input := &mediapackage.CreateChannelInput{
 		Id:          aws.String(d.Get("channel_id").(string)),
@@ -93,7 +93,7 @@ func resourceAwsMediaPackageChannelCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsMediaPackageChannelRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).mediapackageconn
+	conn := meta.(*AWSClient).mediapackageconn()
 
 	input := &mediapackage.DescribeChannelInput{
 		Id: aws.String(d.Id()),
@@ -118,7 +118,7 @@ func resourceAwsMediaPackageChannelRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsMediaPackageChannelUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).mediapackageconn
+	conn := meta.(*AWSClient).mediapackageconn()
 
 	input := &mediapackage.UpdateChannelInput{
 		Id:          aws.String(d.Id()),
@@ -138,7 +138,7 @@ func resourceAwsMediaPackageChannelUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsMediaPackageChannelDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).mediapackageconn
+	conn := meta.(*AWSClient).mediapackageconn()
 
 	input := &mediapackage.DeleteChannelInput{
 		Id: aws.String(d.Id()),
@@ -40,7 +40,7 @@ func resourceAwsMediaStoreContainer() *schema.Resource {
 }
 
 func resourceAwsMediaStoreContainerCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).mediastoreconn
+	conn := meta.(*AWSClient).mediastoreconn()
 
 	input := &mediastore.CreateContainerInput{
 		ContainerName: aws.String(d.Get("name").(string)),
@@ -69,7 +69,7 @@ func resourceAwsMediaStoreContainerCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsMediaStoreContainerRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).mediastoreconn
+	conn := meta.(*AWSClient).mediastoreconn()
 
 	input := &mediastore.DescribeContainerInput{
 		ContainerName: aws.String(d.Id()),
@@ -85,7 +85,7 @@ func resourceAwsMediaStoreContainerRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsMediaStoreContainerDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).mediastoreconn
+	conn := meta.(*AWSClient).mediastoreconn()
 
 	input := &mediastore.DeleteContainerInput{
 		ContainerName: aws.String(d.Id()),
@@ -60,7 +60,7 @@ func TestAccAWSMediaStoreContainerPolicy_import(t *testing.T) {
 }
 
 func testAccCheckAwsMediaStoreContainerPolicyDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).mediastoreconn
+	conn := testAccProvider.Meta().(*AWSClient).mediastoreconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_media_store_container_policy" {
@@ -97,7 +97,7 @@ func testAccCheckAwsMediaStoreContainerPolicyExists(name string) resource.TestCh
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).mediastoreconn
+		conn := testAccProvider.Meta().(*AWSClient).mediastoreconn()
 
 		input := &mediastore.GetContainerPolicyInput{
 			ContainerName: aws.String(rs.Primary.ID),
@@ -48,7 +48,7 @@ func TestAccAWSMediaStoreContainer_import(t *testing.T) {
 }
 
 func testAccCheckAwsMediaStoreContainerDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).mediastoreconn
+	conn := testAccProvider.Meta().(*AWSClient).mediastoreconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_media_store_container" {
@@ -81,7 +81,7 @@ func testAccCheckAwsMediaStoreContainerExists(name string) resource.TestCheckFun
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).mediastoreconn
+		conn := testAccProvider.Meta().(*AWSClient).mediastoreconn()
 
 		input := &mediastore.DescribeContainerInput{
 			ContainerName: aws.String(rs.Primary.ID),
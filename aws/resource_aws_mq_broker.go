@@ -209,7 +209,7 @@ func resourceAwsMqBroker() *schema.Resource {
 }
 
 func resourceAwsMqBrokerCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).mqconn
+	conn := meta.(*AWSClient).mqconn()
 
 	name := d.Get("broker_name").(string)
 	requestId := resource.PrefixedUniqueId(fmt.Sprintf("tf-%s", name))
@@ -278,7 +278,7 @@ func resourceAwsMqBrokerCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsMqBrokerRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).mqconn
+	conn := meta.(*AWSClient).mqconn()
 
 	log.Printf("[INFO] Reading MQ Broker: %s", d.Id())
 	out, err := conn.DescribeBroker(&mq.DescribeBrokerInput{
@@ -350,7 +350,7 @@ func resourceAwsMqBrokerRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsMqBrokerUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).mqconn
+	conn := meta.(*AWSClient).mqconn()
 
 	if d.HasChange("configuration") || d.HasChange("logs") {
 		_, err := conn.UpdateBroker(&mq.UpdateBrokerRequest{
@@ -412,7 +412,7 @@ func resourceAwsMqBrokerUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsMqBrokerDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).mqconn
+	conn := meta.(*AWSClient).mqconn()
 
 	log.Printf("[INFO] Deleting MQ Broker: %s", d.Id())
 	_, err := conn.DeleteBroker(&mq.DeleteBrokerInput{
@@ -75,7 +75,7 @@ func testSweepMqBrokers(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).mqconn
+	conn := client.(*AWSClient).mqconn()
 
 	resp, err := conn.ListBrokers(&mq.ListBrokersInput{
 		MaxResults: aws.Int64(100),
@@ -601,7 +601,7 @@ func TestAccAWSMqBroker_updateTags(t *testing.T) {
 }
 
 func testAccCheckAwsMqBrokerDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).mqconn
+	conn := testAccProvider.Meta().(*AWSClient).mqconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_mq_broker" {
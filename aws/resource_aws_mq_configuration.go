@@ -73,7 +73,7 @@ func resourceAwsMqConfiguration() *schema.Resource {
 }
 
 func resourceAwsMqConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).mqconn
+	conn := meta.(*AWSClient).mqconn()
 
 	input := mq.CreateConfigurationRequest{
 		EngineType:    aws.String(d.Get("engine_type").(string)),
@@ -98,7 +98,7 @@ func resourceAwsMqConfigurationCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsMqConfigurationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).mqconn
+	conn := meta.(*AWSClient).mqconn()
 
 	log.Printf("[INFO] Reading MQ Configuration %s", d.Id())
 	out, err := conn.DescribeConfiguration(&mq.DescribeConfigurationInput{
@@ -139,7 +139,7 @@ func resourceAwsMqConfigurationRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsMqConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).mqconn
+	conn := meta.(*AWSClient).mqconn()
 
 	rawData := d.Get("data").(string)
 	data := base64.StdEncoding.EncodeToString([]byte(rawData))
@@ -109,7 +109,7 @@ func TestAccAWSMqConfiguration_updateTags(t *testing.T) {
 }
 
 func testAccCheckAwsMqConfigurationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).mqconn
+	conn := testAccProvider.Meta().(*AWSClient).mqconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_mq_configuration" {
@@ -0,0 +1,368 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kafka"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsMskCluster() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMskClusterCreate,
+		Read:   resourceAwsMskClusterRead,
+		Delete: resourceAwsMskClusterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(120 * time.Minute),
+			Delete: schema.DefaultTimeout(120 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bootstrap_brokers": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"broker_node_group_info": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"az_distribution": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Default:  kafka.BrokerAZDistributionDefault,
+							ValidateFunc: validation.StringInSlice([]string{
+								kafka.BrokerAZDistributionDefault,
+							}, false),
+						},
+						"client_subnets": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"ebs_volume_size": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(1, 16384),
+						},
+						"instance_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"security_groups": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+					},
+				},
+			},
+			"cluster_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"current_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"encryption_info": {
+				Type:             schema.TypeList,
+				Optional:         true,
+				ForceNew:         true,
+				MaxItems:         1,
+				DiffSuppressFunc: suppressMissingOptionalConfigurationBlock,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"encryption_at_rest_kms_key_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validateArn,
+						},
+					},
+				},
+			},
+			"enhanced_monitoring": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  kafka.EnhancedMonitoringDefault,
+				ValidateFunc: validation.StringInSlice([]string{
+					kafka.EnhancedMonitoringDefault,
+					kafka.EnhancedMonitoringPerBroker,
+					kafka.EnhancedMonitoringPerTopicPerBroker,
+				}, false),
+			},
+			"kafka_version": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"number_of_broker_nodes": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"zookeeper_connect_string": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsMskClusterCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kafkaconn()
+
+	input := &kafka.CreateClusterInput{
+		BrokerNodeGroupInfo: expandMskClusterBrokerNodeGroupInfo(d.Get("broker_node_group_info").([]interface{})),
+		ClusterName:         aws.String(d.Get("cluster_name").(string)),
+		EncryptionInfo:      expandMskClusterEncryptionInfo(d.Get("encryption_info").([]interface{})),
+		EnhancedMonitoring:  aws.String(d.Get("enhanced_monitoring").(string)),
+		KafkaVersion:        aws.String(d.Get("kafka_version").(string)),
+		NumberOfBrokerNodes: aws.Int64(int64(d.Get("number_of_broker_nodes").(int))),
+	}
+
+	log.Printf("[DEBUG] Creating MSK Cluster: %s", input)
+	output, err := conn.CreateCluster(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating MSK Cluster (%s): %s", d.Get("cluster_name").(string), err)
+	}
+
+	d.SetId(aws.StringValue(output.ClusterArn))
+
+	if err := waitForMskClusterCreation(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for MSK Cluster (%s) creation: %s", d.Id(), err)
+	}
+
+	return resourceAwsMskClusterRead(d, meta)
+}
+
+func resourceAwsMskClusterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kafkaconn()
+
+	output, err := conn.DescribeCluster(&kafka.DescribeClusterInput{
+		ClusterArn: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, kafka.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] MSK Cluster (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error describing MSK Cluster (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.ClusterInfo == nil {
+		return fmt.Errorf("error describing MSK Cluster (%s): empty response", d.Id())
+	}
+
+	cluster := output.ClusterInfo
+
+	brokerOutput, err := conn.GetBootstrapBrokers(&kafka.GetBootstrapBrokersInput{
+		ClusterArn: cluster.ClusterArn,
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading MSK Cluster (%s) bootstrap brokers: %s", d.Id(), err)
+	}
+
+	d.Set("arn", cluster.ClusterArn)
+	d.Set("bootstrap_brokers", brokerOutput.BootstrapBrokerString)
+
+	if err := d.Set("broker_node_group_info", flattenMskClusterBrokerNodeGroupInfo(cluster.BrokerNodeGroupInfo)); err != nil {
+		return fmt.Errorf("error setting broker_node_group_info: %s", err)
+	}
+
+	d.Set("cluster_name", cluster.ClusterName)
+	d.Set("current_version", cluster.CurrentVersion)
+
+	if err := d.Set("encryption_info", flattenMskClusterEncryptionInfo(cluster.EncryptionInfo)); err != nil {
+		return fmt.Errorf("error setting encryption_info: %s", err)
+	}
+
+	d.Set("enhanced_monitoring", cluster.EnhancedMonitoring)
+
+	if cluster.CurrentBrokerSoftwareInfo != nil {
+		d.Set("kafka_version", cluster.CurrentBrokerSoftwareInfo.KafkaVersion)
+	}
+
+	d.Set("number_of_broker_nodes", cluster.NumberOfBrokerNodes)
+	d.Set("zookeeper_connect_string", cluster.ZookeeperConnectString)
+
+	return nil
+}
+
+func resourceAwsMskClusterDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kafkaconn()
+
+	log.Printf("[DEBUG] Deleting MSK Cluster: %s", d.Id())
+	_, err := conn.DeleteCluster(&kafka.DeleteClusterInput{
+		ClusterArn: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, kafka.ErrCodeNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting MSK Cluster (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForMskClusterDeletion(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for MSK Cluster (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandMskClusterBrokerNodeGroupInfo(l []interface{}) *kafka.BrokerNodeGroupInfo {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &kafka.BrokerNodeGroupInfo{
+		BrokerAZDistribution: aws.String(m["az_distribution"].(string)),
+		ClientSubnets:        expandStringSet(m["client_subnets"].(*schema.Set)),
+		InstanceType:         aws.String(m["instance_type"].(string)),
+		SecurityGroups:       expandStringSet(m["security_groups"].(*schema.Set)),
+		StorageInfo: &kafka.StorageInfo{
+			EbsStorageInfo: &kafka.EBSStorageInfo{
+				VolumeSize: aws.Int64(int64(m["ebs_volume_size"].(int))),
+			},
+		},
+	}
+}
+
+func expandMskClusterEncryptionInfo(l []interface{}) *kafka.EncryptionInfo {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	v, ok := m["encryption_at_rest_kms_key_arn"].(string)
+
+	if !ok || v == "" {
+		return nil
+	}
+
+	return &kafka.EncryptionInfo{
+		EncryptionAtRest: &kafka.EncryptionAtRest{
+			DataVolumeKMSKeyId: aws.String(v),
+		},
+	}
+}
+
+func flattenMskClusterBrokerNodeGroupInfo(brokerNodeGroupInfo *kafka.BrokerNodeGroupInfo) []interface{} {
+	if brokerNodeGroupInfo == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"az_distribution": aws.StringValue(brokerNodeGroupInfo.BrokerAZDistribution),
+		"client_subnets":  flattenStringSet(brokerNodeGroupInfo.ClientSubnets),
+		"instance_type":   aws.StringValue(brokerNodeGroupInfo.InstanceType),
+		"security_groups": flattenStringSet(brokerNodeGroupInfo.SecurityGroups),
+	}
+
+	if brokerNodeGroupInfo.StorageInfo != nil && brokerNodeGroupInfo.StorageInfo.EbsStorageInfo != nil {
+		m["ebs_volume_size"] = int(aws.Int64Value(brokerNodeGroupInfo.StorageInfo.EbsStorageInfo.VolumeSize))
+	}
+
+	return []interface{}{m}
+}
+
+func flattenMskClusterEncryptionInfo(encryptionInfo *kafka.EncryptionInfo) []interface{} {
+	if encryptionInfo == nil || encryptionInfo.EncryptionAtRest == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"encryption_at_rest_kms_key_arn": aws.StringValue(encryptionInfo.EncryptionAtRest.DataVolumeKMSKeyId),
+	}
+
+	return []interface{}{m}
+}
+
+func refreshMskClusterState(conn *kafka.Kafka, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.DescribeCluster(&kafka.DescribeClusterInput{
+			ClusterArn: aws.String(arn),
+		})
+
+		if isAWSErr(err, kafka.ErrCodeNotFoundException, "") {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil || output.ClusterInfo == nil {
+			return nil, "", nil
+		}
+
+		return output.ClusterInfo, aws.StringValue(output.ClusterInfo.State), nil
+	}
+}
+
+func waitForMskClusterCreation(conn *kafka.Kafka, arn string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kafka.ClusterStateCreating},
+		Target:  []string{kafka.ClusterStateActive},
+		Refresh: refreshMskClusterState(conn, arn),
+		Timeout: timeout,
+		Delay:   1 * time.Minute,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForMskClusterDeletion(conn *kafka.Kafka, arn string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kafka.ClusterStateActive, kafka.ClusterStateDeleting, kafka.ClusterStateFailed},
+		Target:  []string{},
+		Refresh: refreshMskClusterState(conn, arn),
+		Timeout: timeout,
+		Delay:   1 * time.Minute,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
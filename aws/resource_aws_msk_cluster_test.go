@@ -0,0 +1,387 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kafka"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_msk_cluster", &resource.Sweeper{
+		Name: "aws_msk_cluster",
+		F:    testSweepMskClusters,
+	})
+}
+
+func testSweepMskClusters(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).kafkaconn()
+	input := &kafka.ListClustersInput{}
+
+	for {
+		output, err := conn.ListClusters(input)
+
+		if testSweepSkipSweepError(err) {
+			log.Printf("[WARN] Skipping MSK Cluster sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing MSK Clusters: %s", err)
+		}
+
+		for _, cluster := range output.ClusterInfoList {
+			arn := aws.StringValue(cluster.ClusterArn)
+
+			log.Printf("[INFO] Deleting MSK Cluster: %s", arn)
+			_, err := conn.DeleteCluster(&kafka.DeleteClusterInput{
+				ClusterArn: aws.String(arn),
+			})
+
+			if err != nil {
+				log.Printf("[ERROR] Error deleting MSK Cluster (%s): %s", arn, err)
+				continue
+			}
+
+			if err := waitForMskClusterDeletion(conn, arn, 120*time.Minute); err != nil {
+				log.Printf("[ERROR] Error waiting for MSK Cluster (%s) deletion: %s", arn, err)
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return nil
+}
+
+func TestAccAWSMskCluster_basic(t *testing.T) {
+	var cluster kafka.ClusterInfo
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_msk_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMskClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMskClusterConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMskClusterExists(resourceName, &cluster),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "kafka", regexp.MustCompile(`cluster/.+`)),
+					resource.TestMatchResourceAttr(resourceName, "bootstrap_brokers", regexp.MustCompile(`^(([-\w]+\.){1,}[\w]+:\d+,){2,}([-\w]+\.){1,}[\w]+:\d+$`)),
+					resource.TestCheckResourceAttr(resourceName, "broker_node_group_info.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "broker_node_group_info.0.az_distribution", kafka.BrokerAZDistributionDefault),
+					resource.TestCheckResourceAttr(resourceName, "broker_node_group_info.0.client_subnets.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "broker_node_group_info.0.ebs_volume_size", "10"),
+					resource.TestCheckResourceAttr(resourceName, "broker_node_group_info.0.instance_type", "kafka.m5.large"),
+					resource.TestCheckResourceAttr(resourceName, "broker_node_group_info.0.security_groups.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cluster_name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "current_version"),
+					resource.TestCheckResourceAttr(resourceName, "encryption_info.#", "1"),
+					testAccMatchResourceAttrRegionalARN(resourceName, "encryption_info.0.encryption_at_rest_kms_key_arn", "kms", regexp.MustCompile(`key/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "enhanced_monitoring", kafka.EnhancedMonitoringDefault),
+					resource.TestCheckResourceAttr(resourceName, "kafka_version", "1.1.1"),
+					resource.TestCheckResourceAttr(resourceName, "number_of_broker_nodes", "3"),
+					resource.TestMatchResourceAttr(resourceName, "zookeeper_connect_string", regexp.MustCompile(`^\d+\.\d+\.\d+\.\d+:\d+,\d+\.\d+\.\d+\.\d+:\d+,\d+\.\d+\.\d+\.\d+:\d+$`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSMskCluster_disappears(t *testing.T) {
+	var cluster kafka.ClusterInfo
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_msk_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMskClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMskClusterConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMskClusterExists(resourceName, &cluster),
+					testAccCheckMskClusterDisappears(&cluster),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSMskCluster_EncryptionInfo(t *testing.T) {
+	var cluster kafka.ClusterInfo
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_msk_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMskClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMskClusterConfig_EncryptionInfo(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMskClusterExists(resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "encryption_info.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "encryption_info.0.encryption_at_rest_kms_key_arn", "aws_kms_key.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSMskCluster_EnhancedMonitoring(t *testing.T) {
+	var cluster1, cluster2 kafka.ClusterInfo
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_msk_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMskClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMskClusterConfig_EnhancedMonitoring(rName, kafka.EnhancedMonitoringPerBroker),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMskClusterExists(resourceName, &cluster1),
+					resource.TestCheckResourceAttr(resourceName, "enhanced_monitoring", kafka.EnhancedMonitoringPerBroker),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMskClusterConfig_EnhancedMonitoring(rName, kafka.EnhancedMonitoringPerTopicPerBroker),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMskClusterExists(resourceName, &cluster2),
+					testAccCheckMskClusterRecreated(&cluster1, &cluster2),
+					resource.TestCheckResourceAttr(resourceName, "enhanced_monitoring", kafka.EnhancedMonitoringPerTopicPerBroker),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMskClusterDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).kafkaconn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_msk_cluster" {
+			continue
+		}
+
+		_, err := conn.DescribeCluster(&kafka.DescribeClusterInput{
+			ClusterArn: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, kafka.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("MSK Cluster (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckMskClusterExists(resourceName string, cluster *kafka.ClusterInfo) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MSK Cluster ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).kafkaconn()
+
+		output, err := conn.DescribeCluster(&kafka.DescribeClusterInput{
+			ClusterArn: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || output.ClusterInfo == nil {
+			return fmt.Errorf("MSK Cluster (%s) not found", rs.Primary.ID)
+		}
+
+		*cluster = *output.ClusterInfo
+
+		return nil
+	}
+}
+
+func testAccCheckMskClusterDisappears(cluster *kafka.ClusterInfo) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).kafkaconn()
+
+		_, err := conn.DeleteCluster(&kafka.DeleteClusterInput{
+			ClusterArn: cluster.ClusterArn,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		return waitForMskClusterDeletion(conn, aws.StringValue(cluster.ClusterArn), 120*time.Minute)
+	}
+}
+
+func testAccCheckMskClusterRecreated(i, j *kafka.ClusterInfo) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(i.ClusterArn) == aws.StringValue(j.ClusterArn) {
+			return fmt.Errorf("MSK Cluster (%s) was not recreated", aws.StringValue(i.ClusterArn))
+		}
+
+		return nil
+	}
+}
+
+func testAccMskClusterConfigBase() string {
+	return `
+data "aws_availability_zones" "available" {
+  state = "available"
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "192.168.0.0/22"
+
+  tags = {
+    Name = "tf-acc-test-msk-cluster"
+  }
+}
+
+resource "aws_subnet" "test1" {
+  vpc_id            = "${aws_vpc.test.id}"
+  cidr_block        = "192.168.0.0/24"
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+
+  tags = {
+    Name = "tf-acc-test-msk-cluster-test1"
+  }
+}
+
+resource "aws_subnet" "test2" {
+  vpc_id            = "${aws_vpc.test.id}"
+  cidr_block        = "192.168.1.0/24"
+  availability_zone = "${data.aws_availability_zones.available.names[1]}"
+
+  tags = {
+    Name = "tf-acc-test-msk-cluster-test2"
+  }
+}
+
+resource "aws_subnet" "test3" {
+  vpc_id            = "${aws_vpc.test.id}"
+  cidr_block        = "192.168.2.0/24"
+  availability_zone = "${data.aws_availability_zones.available.names[2]}"
+
+  tags = {
+    Name = "tf-acc-test-msk-cluster-test3"
+  }
+}
+
+resource "aws_security_group" "test" {
+  vpc_id = "${aws_vpc.test.id}"
+}
+`
+}
+
+func testAccMskClusterConfig_basic(rName string) string {
+	return testAccMskClusterConfigBase() + fmt.Sprintf(`
+resource "aws_msk_cluster" "test" {
+  cluster_name           = %[1]q
+  kafka_version          = "1.1.1"
+  number_of_broker_nodes = 3
+
+  broker_node_group_info {
+    client_subnets  = ["${aws_subnet.test1.id}", "${aws_subnet.test2.id}", "${aws_subnet.test3.id}"]
+    ebs_volume_size = 10
+    instance_type   = "kafka.m5.large"
+    security_groups = ["${aws_security_group.test.id}"]
+  }
+}
+`, rName)
+}
+
+func testAccMskClusterConfig_EncryptionInfo(rName string) string {
+	return testAccMskClusterConfigBase() + fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description = %[1]q
+}
+
+resource "aws_msk_cluster" "test" {
+  cluster_name           = %[1]q
+  kafka_version          = "1.1.1"
+  number_of_broker_nodes = 3
+
+  broker_node_group_info {
+    client_subnets  = ["${aws_subnet.test1.id}", "${aws_subnet.test2.id}", "${aws_subnet.test3.id}"]
+    ebs_volume_size = 10
+    instance_type   = "kafka.m5.large"
+    security_groups = ["${aws_security_group.test.id}"]
+  }
+
+  encryption_info {
+    encryption_at_rest_kms_key_arn = "${aws_kms_key.test.arn}"
+  }
+}
+`, rName)
+}
+
+func testAccMskClusterConfig_EnhancedMonitoring(rName, enhancedMonitoring string) string {
+	return testAccMskClusterConfigBase() + fmt.Sprintf(`
+resource "aws_msk_cluster" "test" {
+  cluster_name           = %[1]q
+  enhanced_monitoring    = %[2]q
+  kafka_version          = "1.1.1"
+  number_of_broker_nodes = 3
+
+  broker_node_group_info {
+    client_subnets  = ["${aws_subnet.test1.id}", "${aws_subnet.test2.id}", "${aws_subnet.test3.id}"]
+    ebs_volume_size = 10
+    instance_type   = "kafka.m5.large"
+    security_groups = ["${aws_security_group.test.id}"]
+  }
+}
+`, rName, enhancedMonitoring)
+}
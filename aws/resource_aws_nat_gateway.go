@@ -57,7 +57,7 @@ func resourceAwsNatGateway() *schema.Resource {
 }
 
 func resourceAwsNatGatewayCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	// Create the NAT Gateway
 	createOpts := &ec2.CreateNatGatewayInput{
@@ -94,7 +94,7 @@ func resourceAwsNatGatewayCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsNatGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	// Refresh the NAT Gateway state
 	ngRaw, state, err := NGStateRefreshFunc(conn, d.Id())()
@@ -132,7 +132,7 @@ func resourceAwsNatGatewayRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsNatGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	// Turn on partial mode
 	d.Partial(true)
@@ -147,7 +147,7 @@ func resourceAwsNatGatewayUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsNatGatewayDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	deleteOpts := &ec2.DeleteNatGatewayInput{
 		NatGatewayId: aws.String(d.Id()),
 	}
@@ -236,7 +236,7 @@ func resourceAwsNeptuneCluster() *schema.Resource {
 }
 
 func resourceAwsNeptuneClusterCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).neptuneconn
+	conn := meta.(*AWSClient).neptuneconn()
 	tags := tagsFromMapNeptune(d.Get("tags").(map[string]interface{}))
 
 	// Check if any of the parameters that require a cluster modification after creation are set
@@ -392,7 +392,7 @@ func resourceAwsNeptuneClusterCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsNeptuneClusterRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).neptuneconn
+	conn := meta.(*AWSClient).neptuneconn()
 
 	resp, err := conn.DescribeDBClusters(&neptune.DescribeDBClustersInput{
 		DBClusterIdentifier: aws.String(d.Id()),
@@ -425,7 +425,7 @@ func resourceAwsNeptuneClusterRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func flattenAwsNeptuneClusterResource(d *schema.ResourceData, meta interface{}, dbc *neptune.DBCluster) error {
-	conn := meta.(*AWSClient).neptuneconn
+	conn := meta.(*AWSClient).neptuneconn()
 
 	if err := d.Set("availability_zones", aws.StringValueSlice(dbc.AvailabilityZones)); err != nil {
 		return fmt.Errorf("Error saving AvailabilityZones to state for Neptune Cluster (%s): %s", d.Id(), err)
@@ -485,7 +485,7 @@ func flattenAwsNeptuneClusterResource(d *schema.ResourceData, meta interface{},
 }
 
 func resourceAwsNeptuneClusterUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).neptuneconn
+	conn := meta.(*AWSClient).neptuneconn()
 	requestUpdate := false
 
 	req := &neptune.ModifyDBClusterInput{
@@ -603,7 +603,7 @@ func resourceAwsNeptuneClusterUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsNeptuneClusterDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).neptuneconn
+	conn := meta.(*AWSClient).neptuneconn()
 	log.Printf("[DEBUG] Destroying Neptune Cluster (%s)", d.Id())
 
 	deleteOpts := neptune.DeleteDBClusterInput{
@@ -661,7 +661,7 @@ func resourceAwsNeptuneClusterDelete(d *schema.ResourceData, meta interface{}) e
 func resourceAwsNeptuneClusterStateRefreshFunc(
 	d *schema.ResourceData, meta interface{}) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		conn := meta.(*AWSClient).neptuneconn
+		conn := meta.(*AWSClient).neptuneconn()
 
 		resp, err := conn.DescribeDBClusters(&neptune.DescribeDBClustersInput{
 			DBClusterIdentifier: aws.String(d.Id()),
@@ -186,7 +186,7 @@ func resourceAwsNeptuneClusterInstance() *schema.Resource {
 }
 
 func resourceAwsNeptuneClusterInstanceCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).neptuneconn
+	conn := meta.(*AWSClient).neptuneconn()
 	tags := tagsFromMapNeptune(d.Get("tags").(map[string]interface{}))
 
 	createOpts := &neptune.CreateDBInstanceInput{
@@ -272,7 +272,7 @@ func resourceAwsNeptuneClusterInstanceCreate(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsNeptuneClusterInstanceRead(d *schema.ResourceData, meta interface{}) error {
-	db, err := resourceAwsNeptuneInstanceRetrieve(d.Id(), meta.(*AWSClient).neptuneconn)
+	db, err := resourceAwsNeptuneInstanceRetrieve(d.Id(), meta.(*AWSClient).neptuneconn())
 	if err != nil {
 		return fmt.Errorf("Error on retrieving Neptune Cluster Instance (%s): %s", d.Id(), err)
 	}
@@ -287,7 +287,7 @@ func resourceAwsNeptuneClusterInstanceRead(d *schema.ResourceData, meta interfac
 		return fmt.Errorf("Cluster identifier is missing from instance (%s)", d.Id())
 	}
 
-	conn := meta.(*AWSClient).neptuneconn
+	conn := meta.(*AWSClient).neptuneconn()
 	resp, err := conn.DescribeDBClusters(&neptune.DescribeDBClustersInput{
 		DBClusterIdentifier: db.DBClusterIdentifier,
 	})
@@ -352,7 +352,7 @@ func resourceAwsNeptuneClusterInstanceRead(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsNeptuneClusterInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).neptuneconn
+	conn := meta.(*AWSClient).neptuneconn()
 	requestUpdate := false
 
 	req := &neptune.ModifyDBInstanceInput{
@@ -436,7 +436,7 @@ func resourceAwsNeptuneClusterInstanceUpdate(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsNeptuneClusterInstanceDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).neptuneconn
+	conn := meta.(*AWSClient).neptuneconn()
 
 	log.Printf("[DEBUG] Neptune Cluster Instance destroy: %v", d.Id())
 
@@ -179,7 +179,7 @@ func testAccCheckAWSNeptuneClusterInstanceExists(n string, v *neptune.DBInstance
 			return fmt.Errorf("No Neptune Instance ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).neptuneconn
+		conn := testAccProvider.Meta().(*AWSClient).neptuneconn()
 		resp, err := conn.DescribeDBInstances(&neptune.DescribeDBInstancesInput{
 			DBInstanceIdentifier: aws.String(rs.Primary.ID),
 		})
@@ -88,7 +88,7 @@ func resourceAwsNeptuneClusterParameterGroup() *schema.Resource {
 }
 
 func resourceAwsNeptuneClusterParameterGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).neptuneconn
+	conn := meta.(*AWSClient).neptuneconn()
 	tags := tagsFromMapNeptune(d.Get("tags").(map[string]interface{}))
 
 	var groupName string
@@ -122,7 +122,7 @@ func resourceAwsNeptuneClusterParameterGroupCreate(d *schema.ResourceData, meta
 }
 
 func resourceAwsNeptuneClusterParameterGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).neptuneconn
+	conn := meta.(*AWSClient).neptuneconn()
 
 	describeOpts := neptune.DescribeDBClusterParameterGroupsInput{
 		DBClusterParameterGroupName: aws.String(d.Id()),
@@ -181,7 +181,7 @@ func resourceAwsNeptuneClusterParameterGroupRead(d *schema.ResourceData, meta in
 }
 
 func resourceAwsNeptuneClusterParameterGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).neptuneconn
+	conn := meta.(*AWSClient).neptuneconn()
 
 	d.Partial(true)
 
@@ -241,7 +241,7 @@ func resourceAwsNeptuneClusterParameterGroupUpdate(d *schema.ResourceData, meta
 }
 
 func resourceAwsNeptuneClusterParameterGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).neptuneconn
+	conn := meta.(*AWSClient).neptuneconn()
 
 	input := neptune.DeleteDBClusterParameterGroupInput{
 		DBClusterParameterGroupName: aws.String(d.Id()),
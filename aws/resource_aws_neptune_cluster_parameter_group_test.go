@@ -213,7 +213,7 @@ func TestAccAWSNeptuneClusterParameterGroup_Tags(t *testing.T) {
 }
 
 func testAccCheckAWSNeptuneClusterParameterGroupDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).neptuneconn
+	conn := testAccProvider.Meta().(*AWSClient).neptuneconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_neptune_cluster_parameter_group" {
@@ -269,7 +269,7 @@ func testAccCheckAWSNeptuneClusterParameterGroupExists(n string, v *neptune.DBCl
 			return errors.New("No Neptune Cluster Parameter Group ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).neptuneconn
+		conn := testAccProvider.Meta().(*AWSClient).neptuneconn()
 
 		opts := neptune.DescribeDBClusterParameterGroupsInput{
 			DBClusterParameterGroupName: aws.String(rs.Primary.ID),
@@ -303,7 +303,7 @@ func testAccCheckAWSNeptuneClusterDestroy(s *terraform.State) error {
 }
 
 func testAccCheckAWSNeptuneClusterDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
-	conn := provider.Meta().(*AWSClient).neptuneconn
+	conn := provider.Meta().(*AWSClient).neptuneconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_neptune_cluster" {
@@ -353,7 +353,7 @@ func testAccCheckAWSNeptuneClusterExistsWithProvider(n string, v *neptune.DBClus
 		}
 
 		provider := providerF()
-		conn := provider.Meta().(*AWSClient).neptuneconn
+		conn := provider.Meta().(*AWSClient).neptuneconn()
 		resp, err := conn.DescribeDBClusters(&neptune.DescribeDBClustersInput{
 			DBClusterIdentifier: aws.String(rs.Primary.ID),
 		})
@@ -384,7 +384,7 @@ func testAccCheckAWSNeptuneClusterSnapshot(rInt int) resource.TestCheckFunc {
 			snapshot_identifier := fmt.Sprintf("tf-acctest-neptunecluster-snapshot-%d", rInt)
 
 			awsClient := testAccProvider.Meta().(*AWSClient)
-			conn := awsClient.neptuneconn
+			conn := awsClient.neptuneconn()
 
 			log.Printf("[INFO] Deleting the Snapshot %s", snapshot_identifier)
 			_, snapDeleteErr := conn.DeleteDBClusterSnapshot(
@@ -83,7 +83,7 @@ func resourceAwsNeptuneEventSubscription() *schema.Resource {
 }
 
 func resourceAwsNeptuneEventSubscriptionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).neptuneconn
+	conn := meta.(*AWSClient).neptuneconn()
 
 	if v, ok := d.GetOk("name"); ok {
 		d.Set("name", v.(string))
@@ -154,7 +154,7 @@ func resourceAwsNeptuneEventSubscriptionCreate(d *schema.ResourceData, meta inte
 }
 
 func resourceAwsNeptuneEventSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).neptuneconn
+	conn := meta.(*AWSClient).neptuneconn()
 
 	sub, err := resourceAwsNeptuneEventSubscriptionRetrieve(d.Id(), conn)
 	if err != nil {
@@ -197,7 +197,7 @@ func resourceAwsNeptuneEventSubscriptionRead(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsNeptuneEventSubscriptionUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).neptuneconn
+	conn := meta.(*AWSClient).neptuneconn()
 
 	d.Partial(true)
 	requestUpdate := false
@@ -315,7 +315,7 @@ func resourceAwsNeptuneEventSubscriptionUpdate(d *schema.ResourceData, meta inte
 }
 
 func resourceAwsNeptuneEventSubscriptionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).neptuneconn
+	conn := meta.(*AWSClient).neptuneconn()
 	deleteOpts := neptune.DeleteEventSubscriptionInput{
 		SubscriptionName: aws.String(d.Id()),
 	}
@@ -82,7 +82,7 @@ func resourceAwsNeptuneParameterGroup() *schema.Resource {
 }
 
 func resourceAwsNeptuneParameterGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).neptuneconn
+	conn := meta.(*AWSClient).neptuneconn()
 
 	createOpts := neptune.CreateDBParameterGroupInput{
 		DBParameterGroupName:   aws.String(d.Get("name").(string)),
@@ -110,7 +110,7 @@ func resourceAwsNeptuneParameterGroupCreate(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsNeptuneParameterGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).neptuneconn
+	conn := meta.(*AWSClient).neptuneconn()
 
 	describeOpts := neptune.DescribeDBParameterGroupsInput{
 		DBParameterGroupName: aws.String(d.Id()),
@@ -176,7 +176,7 @@ func resourceAwsNeptuneParameterGroupRead(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsNeptuneParameterGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).neptuneconn
+	conn := meta.(*AWSClient).neptuneconn()
 
 	d.Partial(true)
 
@@ -256,7 +256,7 @@ func resourceAwsNeptuneParameterGroupUpdate(d *schema.ResourceData, meta interfa
 		d.SetPartial("parameter")
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		err := setTagsNeptune(conn, d, d.Get("arn").(string))
 		if err != nil {
 			return fmt.Errorf("error setting Neptune Parameter Group %q tags: %s", d.Id(), err)
@@ -270,7 +270,7 @@ func resourceAwsNeptuneParameterGroupUpdate(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsNeptuneParameterGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).neptuneconn
+	conn := meta.(*AWSClient).neptuneconn()
 
 	return resource.Retry(3*time.Minute, func() *resource.RetryError {
 		deleteOpts := neptune.DeleteDBParameterGroupInput{
@@ -161,7 +161,7 @@ func TestAccAWSNeptuneParameterGroup_Tags(t *testing.T) {
 }
 
 func testAccCheckAWSNeptuneParameterGroupDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).neptuneconn
+	conn := testAccProvider.Meta().(*AWSClient).neptuneconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_neptune_parameter_group" {
@@ -215,7 +215,7 @@ func testAccCheckAWSNeptuneParameterGroupExists(n string, v *neptune.DBParameter
 			return fmt.Errorf("No Neptune Parameter Group ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).neptuneconn
+		conn := testAccProvider.Meta().(*AWSClient).neptuneconn()
 
 		opts := neptune.DescribeDBParameterGroupsInput{
 			DBParameterGroupName: aws.String(rs.Primary.ID),
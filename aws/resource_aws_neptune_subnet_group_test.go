@@ -133,7 +133,7 @@ func TestAccAWSNeptuneSubnetGroup_updateDescription(t *testing.T) {
 }
 
 func testAccCheckNeptuneSubnetGroupDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).neptuneconn
+	conn := testAccProvider.Meta().(*AWSClient).neptuneconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_neptune_subnet_group" {
@@ -175,7 +175,7 @@ func testAccCheckNeptuneSubnetGroupExists(n string, v *neptune.DBSubnetGroup) re
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).neptuneconn
+		conn := testAccProvider.Meta().(*AWSClient).neptuneconn()
 		resp, err := conn.DescribeDBSubnetGroups(
 			&neptune.DescribeDBSubnetGroupsInput{DBSubnetGroupName: aws.String(rs.Primary.ID)})
 		if err != nil {
@@ -97,7 +97,7 @@ func resourceAwsNetworkAclRule() *schema.Resource {
 }
 
 func resourceAwsNetworkAclRuleCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	protocol := d.Get("protocol").(string)
 	p, protocolErr := strconv.Atoi(protocol)
@@ -230,7 +230,7 @@ func resourceAwsNetworkAclRuleRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsNetworkAclRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	params := &ec2.DeleteNetworkAclEntryInput{
 		NetworkAclId: aws.String(d.Get("network_acl_id").(string)),
@@ -248,7 +248,7 @@ func resourceAwsNetworkAclRuleDelete(d *schema.ResourceData, meta interface{}) e
 }
 
 func findNetworkAclRule(d *schema.ResourceData, meta interface{}) (*ec2.NetworkAclEntry, error) {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	filters := make([]*ec2.Filter, 0, 2)
 	ruleNumberFilter := &ec2.Filter{
@@ -251,7 +251,7 @@ func resourceAwsOpsworksApplicationValidate(d *schema.ResourceData) error {
 }
 
 func resourceAwsOpsworksApplicationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).opsworksconn
+	client := meta.(*AWSClient).opsworksconn()
 
 	req := &opsworks.DescribeAppsInput{
 		AppIds: []*string{
@@ -290,7 +290,7 @@ func resourceAwsOpsworksApplicationRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsOpsworksApplicationCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).opsworksconn
+	client := meta.(*AWSClient).opsworksconn()
 
 	err := resourceAwsOpsworksApplicationValidate(d)
 	if err != nil {
@@ -339,7 +339,7 @@ func resourceAwsOpsworksApplicationCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsOpsworksApplicationUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).opsworksconn
+	client := meta.(*AWSClient).opsworksconn()
 
 	err := resourceAwsOpsworksApplicationValidate(d)
 	if err != nil {
@@ -383,7 +383,7 @@ func resourceAwsOpsworksApplicationUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsOpsworksApplicationDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).opsworksconn
+	client := meta.(*AWSClient).opsworksconn()
 
 	req := &opsworks.DeleteAppInput{
 		AppId: aws.String(d.Id()),
@@ -152,7 +152,7 @@ func testAccCheckAWSOpsworksApplicationExists(
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).opsworksconn
+		conn := testAccProvider.Meta().(*AWSClient).opsworksconn()
 
 		params := &opsworks.DescribeAppsInput{
 			AppIds: []*string{&rs.Primary.ID},
@@ -283,7 +283,7 @@ func testAccCheckAWSOpsworksUpdateAppAttributes(
 }
 
 func testAccCheckAwsOpsworksApplicationDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*AWSClient).opsworksconn
+	client := testAccProvider.Meta().(*AWSClient).opsworksconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_opsworks_application" {
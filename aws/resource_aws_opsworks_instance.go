@@ -476,7 +476,7 @@ func resourceAwsOpsworksInstanceValidate(d *schema.ResourceData) error {
 }
 
 func resourceAwsOpsworksInstanceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).opsworksconn
+	client := meta.(*AWSClient).opsworksconn()
 
 	req := &opsworks.DescribeInstancesInput{
 		InstanceIds: []*string{
@@ -590,7 +590,7 @@ func resourceAwsOpsworksInstanceRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsOpsworksInstanceCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).opsworksconn
+	client := meta.(*AWSClient).opsworksconn()
 
 	err := resourceAwsOpsworksInstanceValidate(d)
 	if err != nil {
@@ -753,7 +753,7 @@ func resourceAwsOpsworksInstanceCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsOpsworksInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).opsworksconn
+	client := meta.(*AWSClient).opsworksconn()
 
 	err := resourceAwsOpsworksInstanceValidate(d)
 	if err != nil {
@@ -834,7 +834,7 @@ func resourceAwsOpsworksInstanceUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsOpsworksInstanceDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).opsworksconn
+	client := meta.(*AWSClient).opsworksconn()
 
 	if v, ok := d.GetOk("status"); ok && v.(string) != "stopped" {
 		err := stopOpsworksInstance(d, meta, d.Timeout(schema.TimeoutDelete))
@@ -866,7 +866,7 @@ func resourceAwsOpsworksInstanceImport(
 }
 
 func startOpsworksInstance(d *schema.ResourceData, meta interface{}, wait bool, timeout time.Duration) error {
-	client := meta.(*AWSClient).opsworksconn
+	client := meta.(*AWSClient).opsworksconn()
 
 	instanceId := d.Id()
 
@@ -904,7 +904,7 @@ func startOpsworksInstance(d *schema.ResourceData, meta interface{}, wait bool,
 }
 
 func stopOpsworksInstance(d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
-	client := meta.(*AWSClient).opsworksconn
+	client := meta.(*AWSClient).opsworksconn()
 
 	instanceId := d.Id()
 
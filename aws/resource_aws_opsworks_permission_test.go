@@ -104,7 +104,7 @@ func testAccCheckAWSOpsworksPermissionExists(
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).opsworksconn
+		conn := testAccProvider.Meta().(*AWSClient).opsworksconn()
 
 		params := &opsworks.DescribePermissionsInput{
 			StackId:    aws.String(rs.Primary.Attributes["stack_id"]),
@@ -146,7 +146,7 @@ func testAccCheckAWSOpsworksCreatePermissionAttributes(
 }
 
 func testAccCheckAwsOpsworksPermissionDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*AWSClient).opsworksconn
+	client := testAccProvider.Meta().(*AWSClient).opsworksconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_opsworks_permission" {
@@ -49,7 +49,7 @@ func TestAccAWSOpsworksRailsAppLayer(t *testing.T) {
 }
 
 func testAccCheckAwsOpsworksRailsAppLayerDestroy(s *terraform.State) error {
-	opsworksconn := testAccProvider.Meta().(*AWSClient).opsworksconn
+	opsworksconn := testAccProvider.Meta().(*AWSClient).opsworksconn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_opsworks_rails_app_layer" {
 			continue
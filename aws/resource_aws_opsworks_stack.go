@@ -266,7 +266,7 @@ func resourceAwsOpsworksSetStackCustomCookbooksSource(d *schema.ResourceData, v
 }
 
 func resourceAwsOpsworksStackRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).opsworksconn
+	client := meta.(*AWSClient).opsworksconn()
 	var conErr error
 	if v := d.Get("stack_endpoint").(string); v != "" {
 		client, conErr = opsworksConnForRegion(v, meta)
@@ -375,7 +375,7 @@ func resourceAwsOpsworksStackRead(d *schema.ResourceData, meta interface{}) erro
 //  - https://github.com/hashicorp/terraform/pull/12688
 //  - https://github.com/hashicorp/terraform/issues/12842
 func opsworksConnForRegion(region string, meta interface{}) (*opsworks.OpsWorks, error) {
-	originalConn := meta.(*AWSClient).opsworksconn
+	originalConn := meta.(*AWSClient).opsworksconn()
 
 	// Regions are the same, no need to reconfigure
 	if originalConn.Config.Region != nil && *originalConn.Config.Region == region {
@@ -398,7 +398,7 @@ func opsworksConnForRegion(region string, meta interface{}) (*opsworks.OpsWorks,
 }
 
 func resourceAwsOpsworksStackCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).opsworksconn
+	client := meta.(*AWSClient).opsworksconn()
 
 	err := resourceAwsOpsworksStackValidate(d)
 	if err != nil {
@@ -482,7 +482,7 @@ func resourceAwsOpsworksStackCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsOpsworksStackUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).opsworksconn
+	client := meta.(*AWSClient).opsworksconn()
 	var conErr error
 	if v := d.Get("stack_endpoint").(string); v != "" {
 		client, conErr = opsworksConnForRegion(v, meta)
@@ -561,7 +561,7 @@ func resourceAwsOpsworksStackUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsOpsworksStackDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).opsworksconn
+	client := meta.(*AWSClient).opsworksconn()
 	var conErr error
 	if v := d.Get("stack_endpoint").(string); v != "" {
 		client, conErr = opsworksConnForRegion(v, meta)
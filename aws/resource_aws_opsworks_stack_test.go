@@ -484,7 +484,7 @@ func testAccCheckAWSOpsworksStackExists(
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).opsworksconn
+		conn := testAccProvider.Meta().(*AWSClient).opsworksconn()
 
 		params := &opsworks.DescribeStacksInput{
 			StackIds: []*string{aws.String(rs.Primary.ID)},
@@ -601,7 +601,7 @@ func testAccCheckAWSOpsworksUpdateStackAttributes(
 }
 
 func testAccCheckAwsOpsworksStackDestroy(s *terraform.State) error {
-	opsworksconn := testAccProvider.Meta().(*AWSClient).opsworksconn
+	opsworksconn := testAccProvider.Meta().(*AWSClient).opsworksconn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_opsworks_stack" {
 			continue
@@ -44,7 +44,7 @@ func resourceAwsOpsworksUserProfile() *schema.Resource {
 }
 
 func resourceAwsOpsworksUserProfileRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).opsworksconn
+	client := meta.(*AWSClient).opsworksconn()
 
 	req := &opsworks.DescribeUserProfilesInput{
 		IamUserArns: []*string{
@@ -78,7 +78,7 @@ func resourceAwsOpsworksUserProfileRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsOpsworksUserProfileCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).opsworksconn
+	client := meta.(*AWSClient).opsworksconn()
 
 	req := &opsworks.CreateUserProfileInput{
 		AllowSelfManagement: aws.Bool(d.Get("allow_self_management").(bool)),
@@ -98,7 +98,7 @@ func resourceAwsOpsworksUserProfileCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsOpsworksUserProfileUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).opsworksconn
+	client := meta.(*AWSClient).opsworksconn()
 
 	req := &opsworks.UpdateUserProfileInput{
 		AllowSelfManagement: aws.Bool(d.Get("allow_self_management").(bool)),
@@ -118,7 +118,7 @@ func resourceAwsOpsworksUserProfileUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsOpsworksUserProfileDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).opsworksconn
+	client := meta.(*AWSClient).opsworksconn()
 
 	req := &opsworks.DeleteUserProfileInput{
 		IamUserArn: aws.String(d.Id()),
@@ -68,7 +68,7 @@ func resourceAwsOrganizationsAccount() *schema.Resource {
 }
 
 func resourceAwsOrganizationsAccountCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).organizationsconn
+	conn := meta.(*AWSClient).organizationsconn()
 
 	// Create the account
 	createOpts := &organizations.CreateAccountInput{
@@ -134,7 +134,7 @@ func resourceAwsOrganizationsAccountCreate(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsOrganizationsAccountRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).organizationsconn
+	conn := meta.(*AWSClient).organizationsconn()
 	describeOpts := &organizations.DescribeAccountInput{
 		AccountId: aws.String(d.Id()),
 	}
@@ -165,7 +165,7 @@ func resourceAwsOrganizationsAccountRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsOrganizationsAccountDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).organizationsconn
+	conn := meta.(*AWSClient).organizationsconn()
 
 	input := &organizations.RemoveAccountFromOrganizationInput{
 		AccountId: aws.String(d.Id()),
@@ -57,7 +57,7 @@ func resourceAwsOrganizationsOrganization() *schema.Resource {
 }
 
 func resourceAwsOrganizationsOrganizationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).organizationsconn
+	conn := meta.(*AWSClient).organizationsconn()
 
 	createOpts := &organizations.CreateOrganizationInput{
 		FeatureSet: aws.String(d.Get("feature_set").(string)),
@@ -91,7 +91,7 @@ func resourceAwsOrganizationsOrganizationCreate(d *schema.ResourceData, meta int
 }
 
 func resourceAwsOrganizationsOrganizationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).organizationsconn
+	conn := meta.(*AWSClient).organizationsconn()
 
 	log.Printf("[INFO] Reading Organization: %s", d.Id())
 	org, err := conn.DescribeOrganization(&organizations.DescribeOrganizationInput{})
@@ -136,7 +136,7 @@ func resourceAwsOrganizationsOrganizationRead(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsOrganizationsOrganizationUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).organizationsconn
+	conn := meta.(*AWSClient).organizationsconn()
 
 	if d.HasChange("aws_service_access_principals") {
 		oldRaw, newRaw := d.GetChange("aws_service_access_principals")
@@ -176,7 +176,7 @@ func resourceAwsOrganizationsOrganizationUpdate(d *schema.ResourceData, meta int
 }
 
 func resourceAwsOrganizationsOrganizationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).organizationsconn
+	conn := meta.(*AWSClient).organizationsconn()
 
 	log.Printf("[INFO] Deleting Organization: %s", d.Id())
 
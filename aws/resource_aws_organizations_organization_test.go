@@ -109,7 +109,7 @@ func testAccAwsOrganizationsOrganization_FeatureSet(t *testing.T) {
 }
 
 func testAccCheckAwsOrganizationsOrganizationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).organizationsconn
+	conn := testAccProvider.Meta().(*AWSClient).organizationsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_organizations_organization" {
@@ -147,7 +147,7 @@ func testAccCheckAwsOrganizationsOrganizationExists(n string, a *organizations.O
 			return fmt.Errorf("Organization ID not set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).organizationsconn
+		conn := testAccProvider.Meta().(*AWSClient).organizationsconn()
 		params := &organizations.DescribeOrganizationInput{}
 
 		resp, err := conn.DescribeOrganization(params)
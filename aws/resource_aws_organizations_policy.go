@@ -55,7 +55,7 @@ func resourceAwsOrganizationsPolicy() *schema.Resource {
 }
 
 func resourceAwsOrganizationsPolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).organizationsconn
+	conn := meta.(*AWSClient).organizationsconn()
 
 	// Description is required:
 	// InvalidParameter: 1 validation error(s) found.
@@ -96,7 +96,7 @@ func resourceAwsOrganizationsPolicyCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsOrganizationsPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).organizationsconn
+	conn := meta.(*AWSClient).organizationsconn()
 
 	input := &organizations.DescribePolicyInput{
 		PolicyId: aws.String(d.Id()),
@@ -128,7 +128,7 @@ func resourceAwsOrganizationsPolicyRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsOrganizationsPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).organizationsconn
+	conn := meta.(*AWSClient).organizationsconn()
 
 	input := &organizations.UpdatePolicyInput{
 		PolicyId: aws.String(d.Id()),
@@ -156,7 +156,7 @@ func resourceAwsOrganizationsPolicyUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsOrganizationsPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).organizationsconn
+	conn := meta.(*AWSClient).organizationsconn()
 
 	input := &organizations.DeletePolicyInput{
 		PolicyId: aws.String(d.Id()),
@@ -37,7 +37,7 @@ func resourceAwsOrganizationsPolicyAttachment() *schema.Resource {
 }
 
 func resourceAwsOrganizationsPolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).organizationsconn
+	conn := meta.(*AWSClient).organizationsconn()
 
 	policyID := d.Get("policy_id").(string)
 	targetID := d.Get("target_id").(string)
@@ -74,7 +74,7 @@ func resourceAwsOrganizationsPolicyAttachmentCreate(d *schema.ResourceData, meta
 }
 
 func resourceAwsOrganizationsPolicyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).organizationsconn
+	conn := meta.(*AWSClient).organizationsconn()
 
 	targetID, policyID, err := decodeAwsOrganizationsPolicyAttachmentID(d.Id())
 	if err != nil {
@@ -119,7 +119,7 @@ func resourceAwsOrganizationsPolicyAttachmentRead(d *schema.ResourceData, meta i
 }
 
 func resourceAwsOrganizationsPolicyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).organizationsconn
+	conn := meta.(*AWSClient).organizationsconn()
 
 	targetID, policyID, err := decodeAwsOrganizationsPolicyAttachmentID(d.Id())
 	if err != nil {
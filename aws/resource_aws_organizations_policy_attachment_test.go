@@ -39,7 +39,7 @@ func TestAccAwsOrganizationsPolicyAttachment_account(t *testing.T) {
 }
 
 func testAccCheckAwsOrganizationsPolicyAttachmentDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).organizationsconn
+	conn := testAccProvider.Meta().(*AWSClient).organizationsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_organizations_policy_attachment" {
@@ -93,7 +93,7 @@ func testAccCheckAwsOrganizationsPolicyAttachmentExists(resourceName string) res
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).organizationsconn
+		conn := testAccProvider.Meta().(*AWSClient).organizationsconn()
 
 		targetID, policyID, err := decodeAwsOrganizationsPolicyAttachmentID(rs.Primary.ID)
 		if err != nil {
@@ -85,7 +85,7 @@ func TestAccAwsOrganizationsPolicy_description(t *testing.T) {
 }
 
 func testAccCheckAwsOrganizationsPolicyDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).organizationsconn
+	conn := testAccProvider.Meta().(*AWSClient).organizationsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_organizations_policy" {
@@ -122,7 +122,7 @@ func testAccCheckAwsOrganizationsPolicyExists(resourceName string, policy *organ
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).organizationsconn
+		conn := testAccProvider.Meta().(*AWSClient).organizationsconn()
 		input := &organizations.DescribePolicyInput{
 			PolicyId: &rs.Primary.ID,
 		}
@@ -93,7 +93,7 @@ func testAccCheckAWSPinpointADMChannelExists(n string, channel *pinpoint.ADMChan
 			return fmt.Errorf("No Pinpoint ADM channel with that Application ID exists")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).pinpointconn
+		conn := testAccProvider.Meta().(*AWSClient).pinpointconn()
 
 		// Check if the ADM Channel exists
 		params := &pinpoint.GetAdmChannelInput{
@@ -130,7 +130,7 @@ resource "aws_pinpoint_adm_channel" "channel" {
 }
 
 func testAccCheckAWSPinpointADMChannelDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).pinpointconn
+	conn := testAccProvider.Meta().(*AWSClient).pinpointconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_pinpoint_adm_channel" {
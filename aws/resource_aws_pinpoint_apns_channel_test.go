@@ -175,7 +175,7 @@ func testAccCheckAWSPinpointAPNSChannelExists(n string, channel *pinpoint.APNSCh
 			return fmt.Errorf("No Pinpoint APNs Channel with that Application ID exists")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).pinpointconn
+		conn := testAccProvider.Meta().(*AWSClient).pinpointconn()
 
 		// Check if the app exists
 		params := &pinpoint.GetApnsChannelInput{
@@ -232,7 +232,7 @@ resource "aws_pinpoint_apns_channel" "test_apns_channel" {
 }
 
 func testAccCheckAWSPinpointAPNSChannelDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).pinpointconn
+	conn := testAccProvider.Meta().(*AWSClient).pinpointconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_pinpoint_apns_channel" {
@@ -175,7 +175,7 @@ func testAccCheckAWSPinpointAPNSSandboxChannelExists(n string, channel *pinpoint
 			return fmt.Errorf("No Pinpoint APNs Channel with that Application ID exists")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).pinpointconn
+		conn := testAccProvider.Meta().(*AWSClient).pinpointconn()
 
 		// Check if the app exists
 		params := &pinpoint.GetApnsSandboxChannelInput{
@@ -232,7 +232,7 @@ resource "aws_pinpoint_apns_sandbox_channel" "test_channel" {
 }
 
 func testAccCheckAWSPinpointAPNSSandboxChannelDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).pinpointconn
+	conn := testAccProvider.Meta().(*AWSClient).pinpointconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_pinpoint_apns_sandbox_channel" {
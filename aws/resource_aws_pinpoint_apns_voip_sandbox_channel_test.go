@@ -175,7 +175,7 @@ func testAccCheckAWSPinpointAPNSVoipSandboxChannelExists(n string, channel *pinp
 			return fmt.Errorf("No Pinpoint APNs Voip Sandbox Channel with that Application ID exists")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).pinpointconn
+		conn := testAccProvider.Meta().(*AWSClient).pinpointconn()
 
 		// Check if the app exists
 		params := &pinpoint.GetApnsVoipSandboxChannelInput{
@@ -232,7 +232,7 @@ resource "aws_pinpoint_apns_voip_sandbox_channel" "test_channel" {
 }
 
 func testAccCheckAWSPinpointAPNSVoipSandboxChannelDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).pinpointconn
+	conn := testAccProvider.Meta().(*AWSClient).pinpointconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_pinpoint_apns_voip_sandbox_channel" {
@@ -133,7 +133,7 @@ func resourceAwsPinpointApp() *schema.Resource {
 }
 
 func resourceAwsPinpointAppCreate(d *schema.ResourceData, meta interface{}) error {
-	pinpointconn := meta.(*AWSClient).pinpointconn
+	pinpointconn := meta.(*AWSClient).pinpointconn()
 
 	var name string
 	if v, ok := d.GetOk("name"); ok {
@@ -163,7 +163,7 @@ func resourceAwsPinpointAppCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsPinpointAppUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).pinpointconn
+	conn := meta.(*AWSClient).pinpointconn()
 
 	appSettings := &pinpoint.WriteApplicationSettingsRequest{}
 
@@ -197,7 +197,7 @@ func resourceAwsPinpointAppUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsPinpointAppRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).pinpointconn
+	conn := meta.(*AWSClient).pinpointconn()
 
 	log.Printf("[INFO] Reading Pinpoint App Attributes for %s", d.Id())
 
@@ -244,7 +244,7 @@ func resourceAwsPinpointAppRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsPinpointAppDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).pinpointconn
+	conn := meta.(*AWSClient).pinpointconn()
 
 	log.Printf("[DEBUG] Pinpoint Delete App: %s", d.Id())
 	_, err := conn.DeleteApp(&pinpoint.DeleteAppInput{
@@ -147,7 +147,7 @@ func testAccCheckAWSPinpointAppExists(n string, application *pinpoint.Applicatio
 			return fmt.Errorf("No Pinpoint app with that ID exists")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).pinpointconn
+		conn := testAccProvider.Meta().(*AWSClient).pinpointconn()
 
 		// Check if the app exists
 		params := &pinpoint.GetAppInput{
@@ -262,7 +262,7 @@ resource "aws_pinpoint_app" "test_app" {
 `
 
 func testAccCheckAWSPinpointAppDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).pinpointconn
+	conn := testAccProvider.Meta().(*AWSClient).pinpointconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_pinpoint_app" {
@@ -62,7 +62,7 @@ func testAccCheckAWSPinpointEmailChannelExists(n string, channel *pinpoint.Email
 			return fmt.Errorf("No Pinpoint Email Channel with that application ID exists")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).pinpointconn
+		conn := testAccProvider.Meta().(*AWSClient).pinpointconn()
 
 		// Check if the app exists
 		params := &pinpoint.GetEmailChannelInput{
@@ -197,7 +197,7 @@ EOF
 `
 
 func testAccCheckAWSPinpointEmailChannelDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).pinpointconn
+	conn := testAccProvider.Meta().(*AWSClient).pinpointconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_pinpoint_email_channel" {
@@ -58,7 +58,7 @@ func testAccCheckAWSPinpointEventStreamExists(n string, stream *pinpoint.EventSt
 			return fmt.Errorf("No Pinpoint event stream with that ID exists")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).pinpointconn
+		conn := testAccProvider.Meta().(*AWSClient).pinpointconn()
 
 		// Check if the app exists
 		params := &pinpoint.GetEventStreamInput{
@@ -191,7 +191,7 @@ EOF
 `
 
 func testAccCheckAWSPinpointEventStreamDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).pinpointconn
+	conn := testAccProvider.Meta().(*AWSClient).pinpointconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_pinpoint_event_stream" {
@@ -37,7 +37,7 @@ func resourceAwsPlacementGroup() *schema.Resource {
 }
 
 func resourceAwsPlacementGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	name := d.Get("name").(string)
 	input := ec2.CreatePlacementGroupInput{
@@ -86,7 +86,7 @@ func resourceAwsPlacementGroupCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsPlacementGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	input := ec2.DescribePlacementGroupsInput{
 		GroupNames: []*string{aws.String(d.Id())},
 	}
@@ -105,7 +105,7 @@ func resourceAwsPlacementGroupRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsPlacementGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	log.Printf("[DEBUG] Deleting EC2 Placement Group %q", d.Id())
 	_, err := conn.DeletePlacementGroup(&ec2.DeletePlacementGroupInput{
@@ -47,7 +47,7 @@ func TestAccAWSProxyProtocolPolicy_basic(t *testing.T) {
 }
 
 func testAccCheckProxyProtocolPolicyDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).elbconn
+	conn := testAccProvider.Meta().(*AWSClient).elbconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_placement_group" {
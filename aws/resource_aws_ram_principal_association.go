@@ -42,7 +42,7 @@ func resourceAwsRamPrincipalAssociation() *schema.Resource {
 }
 
 func resourceAwsRamPrincipalAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ramconn
+	conn := meta.(*AWSClient).ramconn()
 
 	resourceShareArn := d.Get("resource_share_arn").(string)
 	principal := d.Get("principal").(string)
@@ -74,7 +74,7 @@ func resourceAwsRamPrincipalAssociationCreate(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsRamPrincipalAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ramconn
+	conn := meta.(*AWSClient).ramconn()
 
 	resourceShareArn, principal, err := resourceAwsRamPrincipalAssociationParseId(d.Id())
 	if err != nil {
@@ -106,7 +106,7 @@ func resourceAwsRamPrincipalAssociationRead(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsRamPrincipalAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ramconn
+	conn := meta.(*AWSClient).ramconn()
 
 	resourceShareArn, principal, err := resourceAwsRamPrincipalAssociationParseId(d.Id())
 	if err != nil {
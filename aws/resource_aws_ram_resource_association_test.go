@@ -61,7 +61,7 @@ func TestAccAwsRamResourceAssociation_disappears(t *testing.T) {
 
 func testAccCheckAwsRamResourceAssociationDisappears(resourceShareAssociation *ram.ResourceShareAssociation) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).ramconn
+		conn := testAccProvider.Meta().(*AWSClient).ramconn()
 
 		input := &ram.DisassociateResourceShareInput{
 			ResourceArns:     []*string{resourceShareAssociation.AssociatedEntity},
@@ -79,7 +79,7 @@ func testAccCheckAwsRamResourceAssociationDisappears(resourceShareAssociation *r
 
 func testAccCheckAwsRamResourceAssociationExists(resourceName string, association *ram.ResourceShareAssociation) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).ramconn
+		conn := testAccProvider.Meta().(*AWSClient).ramconn()
 
 		rs, ok := s.RootModule().Resources[resourceName]
 
@@ -114,7 +114,7 @@ func testAccCheckAwsRamResourceAssociationExists(resourceName string, associatio
 }
 
 func testAccCheckAwsRamResourceAssociationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ramconn
+	conn := testAccProvider.Meta().(*AWSClient).ramconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ram_resource_association" {
@@ -51,7 +51,7 @@ func resourceAwsRamResourceShare() *schema.Resource {
 }
 
 func resourceAwsRamResourceShareCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ramconn
+	conn := meta.(*AWSClient).ramconn()
 
 	request := &ram.CreateResourceShareInput{
 		Name:                    aws.String(d.Get("name").(string)),
@@ -87,7 +87,7 @@ func resourceAwsRamResourceShareCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsRamResourceShareRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ramconn
+	conn := meta.(*AWSClient).ramconn()
 
 	request := &ram.GetResourceSharesInput{
 		ResourceShareArns: []*string{aws.String(d.Id())},
@@ -130,7 +130,7 @@ func resourceAwsRamResourceShareRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsRamResourceShareUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ramconn
+	conn := meta.(*AWSClient).ramconn()
 
 	d.Partial(true)
 
@@ -156,9 +156,9 @@ func resourceAwsRamResourceShareUpdate(d *schema.ResourceData, meta interface{})
 		d.SetPartial("allow_external_principals")
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		// Reset all tags to empty set
-		oraw, nraw := d.GetChange("tags")
+		oraw, nraw := getTagsChange(d)
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		c, r := diffTagsRAM(tagsFromMapRAM(o), tagsFromMapRAM(n))
@@ -193,7 +193,7 @@ func resourceAwsRamResourceShareUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsRamResourceShareDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ramconn
+	conn := meta.(*AWSClient).ramconn()
 
 	deleteResourceShareInput := &ram.DeleteResourceShareInput{
 		ResourceShareArn: aws.String(d.Id()),
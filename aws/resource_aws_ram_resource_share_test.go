@@ -155,7 +155,7 @@ func TestAccAwsRamResourceShare_Tags(t *testing.T) {
 
 func testAccCheckAwsRamResourceShareExists(resourceName string, resourceShare *ram.ResourceShare) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).ramconn
+		conn := testAccProvider.Meta().(*AWSClient).ramconn()
 
 		rs, ok := s.RootModule().Resources[resourceName]
 		if !ok {
@@ -191,7 +191,7 @@ func testAccCheckAwsRamResourceShareExists(resourceName string, resourceShare *r
 }
 
 func testAccCheckAwsRamResourceShareDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ramconn
+	conn := testAccProvider.Meta().(*AWSClient).ramconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ram_resource_share" {
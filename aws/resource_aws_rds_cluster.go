@@ -404,7 +404,7 @@ func resourceAwsRdsClusterImport(
 }
 
 func resourceAwsRDSClusterCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).rdsconn
+	conn := meta.(*AWSClient).rdsconn()
 	tags := tagsFromMapRDS(d.Get("tags").(map[string]interface{}))
 
 	// Some API calls (e.g. RestoreDBClusterFromSnapshot do not support all
@@ -885,7 +885,7 @@ func resourceAwsRDSClusterCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsRDSClusterRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).rdsconn
+	conn := meta.(*AWSClient).rdsconn()
 
 	input := &rds.DescribeDBClustersInput{
 		DBClusterIdentifier: aws.String(d.Id()),
@@ -1018,7 +1018,7 @@ func resourceAwsRDSClusterRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsRDSClusterUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).rdsconn
+	conn := meta.(*AWSClient).rdsconn()
 	requestUpdate := false
 
 	req := &rds.ModifyDBClusterInput{
@@ -1176,7 +1176,7 @@ func resourceAwsRDSClusterUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		if err := setTagsRDS(conn, d, d.Get("arn").(string)); err != nil {
 			return err
 		} else {
@@ -1188,7 +1188,7 @@ func resourceAwsRDSClusterUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsRDSClusterDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).rdsconn
+	conn := meta.(*AWSClient).rdsconn()
 	log.Printf("[DEBUG] Destroying RDS Cluster (%s)", d.Id())
 
 	// Automatically remove from global cluster to bypass this error on deletion:
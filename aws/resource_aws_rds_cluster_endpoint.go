@@ -75,7 +75,7 @@ func resourceAwsRDSClusterEndpoint() *schema.Resource {
 }
 
 func resourceAwsRDSClusterEndpointCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).rdsconn
+	conn := meta.(*AWSClient).rdsconn()
 
 	clusterId := d.Get("cluster_identifier").(string)
 	endpointId := d.Get("cluster_endpoint_identifier").(string)
@@ -110,7 +110,7 @@ func resourceAwsRDSClusterEndpointCreate(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsRDSClusterEndpointRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).rdsconn
+	conn := meta.(*AWSClient).rdsconn()
 
 	input := &rds.DescribeDBClusterEndpointsInput{
 		DBClusterEndpointIdentifier: aws.String(d.Id()),
@@ -158,7 +158,7 @@ func resourceAwsRDSClusterEndpointRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsRDSClusterEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).rdsconn
+	conn := meta.(*AWSClient).rdsconn()
 	input := &rds.ModifyDBClusterEndpointInput{
 		DBClusterEndpointIdentifier: aws.String(d.Id()),
 	}
@@ -188,7 +188,7 @@ func resourceAwsRDSClusterEndpointUpdate(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsRDSClusterEndpointDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).rdsconn
+	conn := meta.(*AWSClient).rdsconn()
 	input := &rds.DeleteDBClusterEndpointInput{
 		DBClusterEndpointIdentifier: aws.String(d.Id()),
 	}
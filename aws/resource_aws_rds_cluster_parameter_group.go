@@ -87,7 +87,7 @@ func resourceAwsRDSClusterParameterGroup() *schema.Resource {
 }
 
 func resourceAwsRDSClusterParameterGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	rdsconn := meta.(*AWSClient).rdsconn
+	rdsconn := meta.(*AWSClient).rdsconn()
 	tags := tagsFromMapRDS(d.Get("tags").(map[string]interface{}))
 
 	var groupName string
@@ -122,7 +122,7 @@ func resourceAwsRDSClusterParameterGroupCreate(d *schema.ResourceData, meta inte
 }
 
 func resourceAwsRDSClusterParameterGroupRead(d *schema.ResourceData, meta interface{}) error {
-	rdsconn := meta.(*AWSClient).rdsconn
+	rdsconn := meta.(*AWSClient).rdsconn()
 
 	describeOpts := rds.DescribeDBClusterParameterGroupsInput{
 		DBClusterParameterGroupName: aws.String(d.Id()),
@@ -180,7 +180,7 @@ func resourceAwsRDSClusterParameterGroupRead(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsRDSClusterParameterGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	rdsconn := meta.(*AWSClient).rdsconn
+	rdsconn := meta.(*AWSClient).rdsconn()
 
 	d.Partial(true)
 
@@ -254,7 +254,7 @@ func resourceAwsRDSClusterParameterGroupDelete(d *schema.ResourceData, meta inte
 func resourceAwsRDSClusterParameterGroupDeleteRefreshFunc(
 	d *schema.ResourceData,
 	meta interface{}) resource.StateRefreshFunc {
-	rdsconn := meta.(*AWSClient).rdsconn
+	rdsconn := meta.(*AWSClient).rdsconn()
 
 	return func() (interface{}, string, error) {
 
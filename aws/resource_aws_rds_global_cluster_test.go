@@ -233,7 +233,7 @@ func testAccCheckAWSRdsGlobalClusterExists(resourceName string, globalCluster *r
 			return fmt.Errorf("No RDS Global Cluster ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).rdsconn
+		conn := testAccProvider.Meta().(*AWSClient).rdsconn()
 
 		cluster, err := rdsDescribeGlobalCluster(conn, rs.Primary.ID)
 
@@ -256,7 +256,7 @@ func testAccCheckAWSRdsGlobalClusterExists(resourceName string, globalCluster *r
 }
 
 func testAccCheckAWSRdsGlobalClusterDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).rdsconn
+	conn := testAccProvider.Meta().(*AWSClient).rdsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_rds_global_cluster" {
@@ -285,7 +285,7 @@ func testAccCheckAWSRdsGlobalClusterDestroy(s *terraform.State) error {
 
 func testAccCheckAWSRdsGlobalClusterDisappears(globalCluster *rds.GlobalCluster) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).rdsconn
+		conn := testAccProvider.Meta().(*AWSClient).rdsconn()
 
 		input := &rds.DeleteGlobalClusterInput{
 			GlobalClusterIdentifier: globalCluster.GlobalClusterIdentifier,
@@ -320,7 +320,7 @@ func resourceAwsRedshiftClusterImport(
 }
 
 func resourceAwsRedshiftClusterCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).redshiftconn
+	conn := meta.(*AWSClient).redshiftconn()
 	tags := tagsFromMapRedshift(d.Get("tags").(map[string]interface{}))
 
 	if v, ok := d.GetOk("snapshot_identifier"); ok {
@@ -507,7 +507,7 @@ func resourceAwsRedshiftClusterCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsRedshiftClusterRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).redshiftconn
+	conn := meta.(*AWSClient).redshiftconn()
 
 	log.Printf("[INFO] Reading Redshift Cluster Information: %s", d.Id())
 	resp, err := conn.DescribeClusters(&redshift.DescribeClustersInput{
@@ -616,7 +616,7 @@ func resourceAwsRedshiftClusterRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsRedshiftClusterUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).redshiftconn
+	conn := meta.(*AWSClient).redshiftconn()
 	d.Partial(true)
 
 	arn := arn.ARN{
@@ -853,7 +853,7 @@ func enableRedshiftSnapshotCopy(id string, scList []interface{}, conn *redshift.
 }
 
 func resourceAwsRedshiftClusterDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).redshiftconn
+	conn := meta.(*AWSClient).redshiftconn()
 	log.Printf("[DEBUG] Destroying Redshift Cluster (%s)", d.Id())
 
 	deleteOpts := redshift.DeleteClusterInput{
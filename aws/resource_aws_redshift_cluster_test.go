@@ -27,7 +27,7 @@ func testSweepRedshiftClusters(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).redshiftconn
+	conn := client.(*AWSClient).redshiftconn()
 
 	err = conn.DescribeClustersPages(&redshift.DescribeClustersInput{}, func(resp *redshift.DescribeClustersOutput, isLast bool) bool {
 		if len(resp.Clusters) == 0 {
@@ -594,7 +594,7 @@ func testAccCheckAWSRedshiftClusterDestroy(s *terraform.State) error {
 		}
 
 		// Try to find the Group
-		conn := testAccProvider.Meta().(*AWSClient).redshiftconn
+		conn := testAccProvider.Meta().(*AWSClient).redshiftconn()
 		var err error
 		resp, err := conn.DescribeClusters(
 			&redshift.DescribeClustersInput{
@@ -631,7 +631,7 @@ func testAccCheckAWSRedshiftClusterSnapshot(rInt int) resource.TestCheckFunc {
 			var err error
 
 			// Try and delete the snapshot before we check for the cluster not found
-			conn := testAccProvider.Meta().(*AWSClient).redshiftconn
+			conn := testAccProvider.Meta().(*AWSClient).redshiftconn()
 
 			snapshot_identifier := fmt.Sprintf("tf-acctest-snapshot-%d", rInt)
 
@@ -683,7 +683,7 @@ func testAccCheckAWSRedshiftClusterExists(n string, v *redshift.Cluster) resourc
 			return fmt.Errorf("No Redshift Cluster Instance ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).redshiftconn
+		conn := testAccProvider.Meta().(*AWSClient).redshiftconn()
 		resp, err := conn.DescribeClusters(&redshift.DescribeClustersInput{
 			ClusterIdentifier: aws.String(rs.Primary.ID),
 		})
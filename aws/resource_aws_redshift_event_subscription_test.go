@@ -184,7 +184,7 @@ func testAccCheckAWSRedshiftEventSubscriptionExists(n string, v *redshift.EventS
 			return fmt.Errorf("No Redshift Event Subscription is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).redshiftconn
+		conn := testAccProvider.Meta().(*AWSClient).redshiftconn()
 
 		opts := redshift.DescribeEventSubscriptionsInput{
 			SubscriptionName: aws.String(rs.Primary.ID),
@@ -207,7 +207,7 @@ func testAccCheckAWSRedshiftEventSubscriptionExists(n string, v *redshift.EventS
 }
 
 func testAccCheckAWSRedshiftEventSubscriptionDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).redshiftconn
+	conn := testAccProvider.Meta().(*AWSClient).redshiftconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_redshift_event_subscription" {
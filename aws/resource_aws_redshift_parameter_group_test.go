@@ -137,7 +137,7 @@ func TestResourceAWSRedshiftParameterGroupNameValidation(t *testing.T) {
 }
 
 func testAccCheckAWSRedshiftParameterGroupDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).redshiftconn
+	conn := testAccProvider.Meta().(*AWSClient).redshiftconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_redshift_parameter_group" {
@@ -181,7 +181,7 @@ func testAccCheckAWSRedshiftParameterGroupExists(n string, v *redshift.ClusterPa
 			return fmt.Errorf("No Redshift Parameter Group ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).redshiftconn
+		conn := testAccProvider.Meta().(*AWSClient).redshiftconn()
 
 		opts := redshift.DescribeClusterParameterGroupsInput{
 			ParameterGroupName: aws.String(rs.Primary.ID),
@@ -34,7 +34,7 @@ func TestAccAWSRedshiftSnapshotCopyGrant_Basic(t *testing.T) {
 }
 
 func testAccCheckAWSRedshiftSnapshotCopyGrantDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).redshiftconn
+	conn := testAccProvider.Meta().(*AWSClient).redshiftconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_redshift_snapshot_copy_grant" {
@@ -60,7 +60,7 @@ func testAccCheckAWSRedshiftSnapshotCopyGrantExists(name string) resource.TestCh
 		}
 
 		// retrieve the client from the test provider
-		conn := testAccProvider.Meta().(*AWSClient).redshiftconn
+		conn := testAccProvider.Meta().(*AWSClient).redshiftconn()
 
 		input := redshift.DescribeSnapshotCopyGrantsInput{
 			MaxRecords:            aws.Int64(int64(100)),
@@ -75,7 +75,7 @@ func extractResourceGroupResourceQuery(resourceQueryList []interface{}) *resourc
 }
 
 func resourceAwsResourceGroupsGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).resourcegroupsconn
+	conn := meta.(*AWSClient).resourcegroupsconn()
 
 	input := resourcegroups.CreateGroupInput{
 		Description:   aws.String(d.Get("description").(string)),
@@ -94,7 +94,7 @@ func resourceAwsResourceGroupsGroupCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsResourceGroupsGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).resourcegroupsconn
+	conn := meta.(*AWSClient).resourcegroupsconn()
 
 	g, err := conn.GetGroup(&resourcegroups.GetGroupInput{
 		GroupName: aws.String(d.Id()),
@@ -133,7 +133,7 @@ func resourceAwsResourceGroupsGroupRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsResourceGroupsGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).resourcegroupsconn
+	conn := meta.(*AWSClient).resourcegroupsconn()
 
 	if d.HasChange("description") {
 		input := resourcegroups.UpdateGroupInput{
@@ -163,7 +163,7 @@ func resourceAwsResourceGroupsGroupUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsResourceGroupsGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).resourcegroupsconn
+	conn := meta.(*AWSClient).resourcegroupsconn()
 
 	input := resourcegroups.DeleteGroupInput{
 		GroupName: aws.String(d.Id()),
@@ -86,7 +86,7 @@ func testAccCheckAWSResourceGroupExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No resource group name is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).resourcegroupsconn
+		conn := testAccProvider.Meta().(*AWSClient).resourcegroupsconn()
 
 		_, err := conn.GetGroup(&resourcegroups.GetGroupInput{
 			GroupName: aws.String(rs.Primary.ID),
@@ -102,7 +102,7 @@ func testAccCheckAWSResourceGroupDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).resourcegroupsconn
+		conn := testAccProvider.Meta().(*AWSClient).resourcegroupsconn()
 		resp, err := conn.GetGroup(&resourcegroups.GetGroupInput{
 			GroupName: aws.String(rs.Primary.ID),
 		})
@@ -134,7 +134,7 @@ func resourceAwsRoute() *schema.Resource {
 }
 
 func resourceAwsRouteCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	var numTargets int
 	var setTarget string
 	allowedTargets := []string{
@@ -301,7 +301,7 @@ func resourceAwsRouteCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsRouteRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	routeTableId := d.Get("route_table_id").(string)
 
 	destinationCidrBlock := d.Get("destination_cidr_block").(string)
@@ -336,7 +336,7 @@ func resourceAwsRouteSetResourceData(d *schema.ResourceData, route *ec2.Route) {
 }
 
 func resourceAwsRouteUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	var numTargets int
 	var setTarget string
 
@@ -427,7 +427,7 @@ func resourceAwsRouteUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsRouteDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	deleteOpts := &ec2.DeleteRouteInput{
 		RouteTableId: aws.String(d.Get("route_table_id").(string)),
@@ -466,7 +466,7 @@ func resourceAwsRouteDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsRouteExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	routeTableId := d.Get("route_table_id").(string)
 
 	findOpts := &ec2.DescribeRouteTablesInput{
@@ -78,7 +78,7 @@ func TestAccAWSRoute53DelegationSet_withZones(t *testing.T) {
 }
 
 func testAccCheckRoute53DelegationSetDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).r53conn
+	conn := testAccProvider.Meta().(*AWSClient).r53conn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_route53_delegation_set" {
 			continue
@@ -94,7 +94,7 @@ func testAccCheckRoute53DelegationSetDestroy(s *terraform.State) error {
 
 func testAccCheckRoute53DelegationSetExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).r53conn
+		conn := testAccProvider.Meta().(*AWSClient).r53conn()
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
 			return fmt.Errorf("Not found: %s", n)
@@ -123,7 +123,7 @@ func testAccCheckRoute53DelegationSetExists(n string) resource.TestCheckFunc {
 
 func testAccCheckRoute53NameServersMatch(delegationSetName, zoneName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).r53conn
+		conn := testAccProvider.Meta().(*AWSClient).r53conn()
 
 		delegationSetLocal, ok := s.RootModule().Resources[delegationSetName]
 		if !ok {
@@ -137,7 +137,7 @@ func resourceAwsRoute53HealthCheck() *schema.Resource {
 }
 
 func resourceAwsRoute53HealthCheckUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).r53conn
+	conn := meta.(*AWSClient).r53conn()
 
 	updateHealthCheck := &route53.UpdateHealthCheckInput{
 		HealthCheckId: aws.String(d.Id()),
@@ -209,7 +209,7 @@ func resourceAwsRoute53HealthCheckUpdate(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsRoute53HealthCheckCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).r53conn
+	conn := meta.(*AWSClient).r53conn()
 
 	healthConfig := &route53.HealthCheckConfig{
 		Type: aws.String(d.Get("type").(string)),
@@ -315,7 +315,7 @@ func resourceAwsRoute53HealthCheckCreate(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsRoute53HealthCheckRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).r53conn
+	conn := meta.(*AWSClient).r53conn()
 
 	read, err := conn.GetHealthCheck(&route53.GetHealthCheckInput{HealthCheckId: aws.String(d.Id())})
 	if err != nil {
@@ -382,7 +382,7 @@ func resourceAwsRoute53HealthCheckRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsRoute53HealthCheckDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).r53conn
+	conn := meta.(*AWSClient).r53conn()
 
 	log.Printf("[DEBUG] Deleteing Route53 health check: %s", d.Id())
 	_, err := conn.DeleteHealthCheck(&route53.DeleteHealthCheckInput{HealthCheckId: aws.String(d.Id())})
@@ -37,7 +37,7 @@ func resourceAwsRoute53QueryLog() *schema.Resource {
 }
 
 func resourceAwsRoute53QueryLogCreate(d *schema.ResourceData, meta interface{}) error {
-	r53 := meta.(*AWSClient).r53conn
+	r53 := meta.(*AWSClient).r53conn()
 
 	input := &route53.CreateQueryLoggingConfigInput{
 		CloudWatchLogsLogGroupArn: aws.String(d.Get("cloudwatch_log_group_arn").(string)),
@@ -57,7 +57,7 @@ func resourceAwsRoute53QueryLogCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsRoute53QueryLogRead(d *schema.ResourceData, meta interface{}) error {
-	r53 := meta.(*AWSClient).r53conn
+	r53 := meta.(*AWSClient).r53conn()
 
 	input := &route53.GetQueryLoggingConfigInput{
 		Id: aws.String(d.Id()),
@@ -76,7 +76,7 @@ func resourceAwsRoute53QueryLogRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsRoute53QueryLogDelete(d *schema.ResourceData, meta interface{}) error {
-	r53 := meta.(*AWSClient).r53conn
+	r53 := meta.(*AWSClient).r53conn()
 
 	input := &route53.DeleteQueryLoggingConfigInput{
 		Id: aws.String(d.Id()),
@@ -260,7 +260,7 @@ func resourceAwsRoute53RecordUpdate(d *schema.ResourceData, meta interface{}) er
 
 	// Otherwise we delete the existing record and create a new record within
 	// a transactional change
-	conn := meta.(*AWSClient).r53conn
+	conn := meta.(*AWSClient).r53conn()
 	zone := cleanZoneID(d.Get("zone_id").(string))
 
 	var err error
@@ -370,7 +370,7 @@ func resourceAwsRoute53RecordUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsRoute53RecordCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).r53conn
+	conn := meta.(*AWSClient).r53conn()
 	zone := cleanZoneID(d.Get("zone_id").(string))
 
 	var err error
@@ -606,7 +606,7 @@ func resourceAwsRoute53RecordRead(d *schema.ResourceData, meta interface{}) erro
 // If there are other errors, it returns nil a nil recordset and passes on the
 // error.
 func findRecord(d *schema.ResourceData, meta interface{}) (*route53.ResourceRecordSet, error) {
-	conn := meta.(*AWSClient).r53conn
+	conn := meta.(*AWSClient).r53conn()
 	// Scan for a
 	zone := cleanZoneID(d.Get("zone_id").(string))
 
@@ -697,7 +697,7 @@ func findRecord(d *schema.ResourceData, meta interface{}) (*route53.ResourceReco
 }
 
 func resourceAwsRoute53RecordDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).r53conn
+	conn := meta.(*AWSClient).r53conn()
 	// Get the records
 	rec, err := findRecord(d, meta)
 	if err != nil {
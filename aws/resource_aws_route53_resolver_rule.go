@@ -109,7 +109,7 @@ func resourceAwsRoute53ResolverRule() *schema.Resource {
 }
 
 func resourceAwsRoute53ResolverRuleCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53resolverconn
+	conn := meta.(*AWSClient).route53resolverconn()
 
 	req := &route53resolver.CreateResolverRuleInput{
 		CreatorRequestId: aws.String(resource.PrefixedUniqueId("tf-r53-resolver-rule-")),
@@ -148,7 +148,7 @@ func resourceAwsRoute53ResolverRuleCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsRoute53ResolverRuleRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53resolverconn
+	conn := meta.(*AWSClient).route53resolverconn()
 
 	ruleRaw, state, err := route53ResolverRuleRefresh(conn, d.Id())()
 	if err != nil {
@@ -181,7 +181,7 @@ func resourceAwsRoute53ResolverRuleRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsRoute53ResolverRuleUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53resolverconn
+	conn := meta.(*AWSClient).route53resolverconn()
 
 	d.Partial(true)
 	if d.HasChange("name") || d.HasChange("resolver_endpoint_id") || d.HasChange("target_ip") {
@@ -227,7 +227,7 @@ func resourceAwsRoute53ResolverRuleUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsRoute53ResolverRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53resolverconn
+	conn := meta.(*AWSClient).route53resolverconn()
 
 	log.Printf("[DEBUG] Deleting Route53 Resolver rule: %s", d.Id())
 	_, err := conn.DeleteResolverRule(&route53resolver.DeleteResolverRuleInput{
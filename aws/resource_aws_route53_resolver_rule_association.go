@@ -57,7 +57,7 @@ func resourceAwsRoute53ResolverRuleAssociation() *schema.Resource {
 }
 
 func resourceAwsRoute53ResolverRuleAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53resolverconn
+	conn := meta.(*AWSClient).route53resolverconn()
 
 	req := &route53resolver.AssociateResolverRuleInput{
 		ResolverRuleId: aws.String(d.Get("resolver_rule_id").(string)),
@@ -86,7 +86,7 @@ func resourceAwsRoute53ResolverRuleAssociationCreate(d *schema.ResourceData, met
 }
 
 func resourceAwsRoute53ResolverRuleAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53resolverconn
+	conn := meta.(*AWSClient).route53resolverconn()
 
 	assocRaw, state, err := route53ResolverRuleAssociationRefresh(conn, d.Id())()
 	if err != nil {
@@ -108,7 +108,7 @@ func resourceAwsRoute53ResolverRuleAssociationRead(d *schema.ResourceData, meta
 }
 
 func resourceAwsRoute53ResolverRuleAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53resolverconn
+	conn := meta.(*AWSClient).route53resolverconn()
 
 	log.Printf("[DEBUG] Deleting Route53 Resolver rule association: %s", d.Id())
 	_, err := conn.DisassociateResolverRule(&route53resolver.DisassociateResolverRuleInput{
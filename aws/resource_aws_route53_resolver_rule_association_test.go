@@ -43,7 +43,7 @@ func TestAccAwsRoute53ResolverRuleAssociation_basic(t *testing.T) {
 }
 
 func testAccCheckRoute53ResolverRuleAssociationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).route53resolverconn
+	conn := testAccProvider.Meta().(*AWSClient).route53resolverconn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_route53_resolver_rule_association" {
 			continue
@@ -76,7 +76,7 @@ func testAccCheckRoute53ResolverRuleAssociationExists(n string, assn *route53res
 			return fmt.Errorf("No Route 53 Resolver rule association ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).route53resolverconn
+		conn := testAccProvider.Meta().(*AWSClient).route53resolverconn()
 		resp, err := conn.GetResolverRuleAssociation(&route53resolver.GetResolverRuleAssociationInput{
 			ResolverRuleAssociationId: aws.String(rs.Primary.ID),
 		})
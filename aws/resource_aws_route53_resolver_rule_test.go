@@ -256,7 +256,7 @@ func testAccCheckRoute53ResolverRulesDifferent(before, after *route53resolver.Re
 }
 
 func testAccCheckRoute53ResolverRuleDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).route53resolverconn
+	conn := testAccProvider.Meta().(*AWSClient).route53resolverconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_route53_resolver_rule" {
@@ -290,7 +290,7 @@ func testAccCheckRoute53ResolverRuleExists(n string, rule *route53resolver.Resol
 			return fmt.Errorf("No Route 53 Resolver rule ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).route53resolverconn
+		conn := testAccProvider.Meta().(*AWSClient).route53resolverconn()
 		res, err := conn.GetResolverRule(&route53resolver.GetResolverRuleInput{
 			ResolverRuleId: aws.String(rs.Primary.ID),
 		})
@@ -108,7 +108,7 @@ func resourceAwsRoute53Zone() *schema.Resource {
 }
 
 func resourceAwsRoute53ZoneCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).r53conn
+	conn := meta.(*AWSClient).r53conn()
 	region := meta.(*AWSClient).region
 
 	input := &route53.CreateHostedZoneInput{
@@ -164,7 +164,7 @@ func resourceAwsRoute53ZoneCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsRoute53ZoneRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).r53conn
+	conn := meta.(*AWSClient).r53conn()
 
 	input := &route53.GetHostedZoneInput{
 		Id: aws.String(d.Id()),
@@ -250,7 +250,7 @@ func resourceAwsRoute53ZoneRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsRoute53ZoneUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).r53conn
+	conn := meta.(*AWSClient).r53conn()
 	region := meta.(*AWSClient).region
 
 	d.Partial(true)
@@ -270,7 +270,7 @@ func resourceAwsRoute53ZoneUpdate(d *schema.ResourceData, meta interface{}) erro
 		d.SetPartial("comment")
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		if err := setTagsR53(conn, d, route53.TagResourceTypeHostedzone); err != nil {
 			return err
 		}
@@ -319,7 +319,7 @@ func resourceAwsRoute53ZoneUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsRoute53ZoneDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).r53conn
+	conn := meta.(*AWSClient).r53conn()
 
 	if d.Get("force_destroy").(bool) {
 		if err := deleteAllRecordsInHostedZoneId(d.Id(), d.Get("name").(string), conn); err != nil {
@@ -46,7 +46,7 @@ func resourceAwsRoute53ZoneAssociation() *schema.Resource {
 }
 
 func resourceAwsRoute53ZoneAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	r53 := meta.(*AWSClient).r53conn
+	r53 := meta.(*AWSClient).r53conn()
 
 	req := &route53.AssociateVPCWithHostedZoneInput{
 		HostedZoneId: aws.String(d.Get("zone_id").(string)),
@@ -93,7 +93,7 @@ func resourceAwsRoute53ZoneAssociationCreate(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsRoute53ZoneAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).r53conn
+	conn := meta.(*AWSClient).r53conn()
 
 	zoneID, vpcID, err := resourceAwsRoute53ZoneAssociationParseId(d.Id())
 
@@ -127,7 +127,7 @@ func resourceAwsRoute53ZoneAssociationRead(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsRoute53ZoneAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).r53conn
+	conn := meta.(*AWSClient).r53conn()
 
 	zoneID, vpcID, err := resourceAwsRoute53ZoneAssociationParseId(d.Id())
 
@@ -144,7 +144,7 @@ func testAccCheckRoute53ZoneAssociationDestroy(s *terraform.State) error {
 }
 
 func testAccCheckRoute53ZoneAssociationDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
-	conn := provider.Meta().(*AWSClient).r53conn
+	conn := provider.Meta().(*AWSClient).r53conn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_route53_zone_association" {
 			continue
@@ -195,7 +195,7 @@ func testAccCheckRoute53ZoneAssociationExistsWithProvider(n string, vpc *route53
 		}
 
 		provider := providerF()
-		conn := provider.Meta().(*AWSClient).r53conn
+		conn := provider.Meta().(*AWSClient).r53conn()
 
 		associationVPC, err := route53GetZoneAssociation(conn, zoneID, vpcID)
 
@@ -215,7 +215,7 @@ func testAccCheckRoute53ZoneAssociationExistsWithProvider(n string, vpc *route53
 
 func testAccCheckRoute53ZoneAssociationDisappears(zone *route53.GetHostedZoneOutput, vpc *route53.VPC) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).r53conn
+		conn := testAccProvider.Meta().(*AWSClient).r53conn()
 
 		input := &route53.DisassociateVPCFromHostedZoneInput{
 			HostedZoneId: zone.HostedZone.Id,
@@ -414,7 +414,7 @@ func testAccCheckRoute53ZoneDestroy(s *terraform.State) error {
 }
 
 func testAccCheckRoute53ZoneDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
-	conn := provider.Meta().(*AWSClient).r53conn
+	conn := provider.Meta().(*AWSClient).r53conn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_route53_zone" {
 			continue
@@ -435,7 +435,7 @@ func testAccCreateRandomRoute53RecordsInZoneId(zone *route53.GetHostedZoneOutput
 func testAccCreateRandomRoute53RecordsInZoneIdWithProvider(providerF func() *schema.Provider, zone *route53.GetHostedZoneOutput, recordsCount int) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		provider := providerF()
-		conn := provider.Meta().(*AWSClient).r53conn
+		conn := provider.Meta().(*AWSClient).r53conn()
 
 		var changes []*route53.Change
 		if recordsCount > 100 {
@@ -489,7 +489,7 @@ func testAccCheckRoute53ZoneExistsWithProvider(n string, zone *route53.GetHosted
 		}
 
 		provider := providerF()
-		conn := provider.Meta().(*AWSClient).r53conn
+		conn := provider.Meta().(*AWSClient).r53conn()
 		resp, err := conn.GetHostedZone(&route53.GetHostedZoneInput{Id: aws.String(rs.Primary.ID)})
 		if err != nil {
 			return fmt.Errorf("Hosted zone err: %v", err)
@@ -523,7 +523,7 @@ func testAccCheckRoute53ZoneExistsWithProvider(n string, zone *route53.GetHosted
 
 func testAccCheckRoute53ZoneDisappears(zone *route53.GetHostedZoneOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).r53conn
+		conn := testAccProvider.Meta().(*AWSClient).r53conn()
 
 		input := &route53.DeleteHostedZoneInput{
 			Id: zone.HostedZone.Id,
@@ -558,7 +558,7 @@ func testAccCheckRoute53ZoneAssociatesWithVpc(n string, zone *route53.GetHostedZ
 
 func testAccLoadTagsR53(zone *route53.GetHostedZoneOutput, td *route53.ResourceTagSet) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).r53conn
+		conn := testAccProvider.Meta().(*AWSClient).r53conn()
 
 		zone := cleanZoneID(*zone.HostedZone.Id)
 		req := &route53.ListTagsForResourceInput{
@@ -418,7 +418,7 @@ func testAccCheckAWSRouteExists(n string, res *ec2.Route) resource.TestCheckFunc
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 		r, err := resourceAwsRouteFindRoute(
 			conn,
 			rs.Primary.Attributes["route_table_id"],
@@ -446,7 +446,7 @@ func testAccCheckAWSRouteDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 		route, err := resourceAwsRouteFindRoute(
 			conn,
 			rs.Primary.Attributes["route_table_id"],
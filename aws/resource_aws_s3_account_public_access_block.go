@@ -54,7 +54,7 @@ func resourceAwsS3AccountPublicAccessBlock() *schema.Resource {
 }
 
 func resourceAwsS3AccountPublicAccessBlockCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3controlconn
+	conn := meta.(*AWSClient).s3controlconn()
 
 	accountID := meta.(*AWSClient).accountid
 	if v, ok := d.GetOk("account_id"); ok {
@@ -83,7 +83,7 @@ func resourceAwsS3AccountPublicAccessBlockCreate(d *schema.ResourceData, meta in
 }
 
 func resourceAwsS3AccountPublicAccessBlockRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3controlconn
+	conn := meta.(*AWSClient).s3controlconn()
 
 	input := &s3control.GetPublicAccessBlockInput{
 		AccountId: aws.String(d.Id()),
@@ -130,7 +130,7 @@ func resourceAwsS3AccountPublicAccessBlockRead(d *schema.ResourceData, meta inte
 }
 
 func resourceAwsS3AccountPublicAccessBlockUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3controlconn
+	conn := meta.(*AWSClient).s3controlconn()
 
 	input := &s3control.PutPublicAccessBlockInput{
 		AccountId: aws.String(d.Id()),
@@ -162,7 +162,7 @@ func resourceAwsS3AccountPublicAccessBlockUpdate(d *schema.ResourceData, meta in
 }
 
 func resourceAwsS3AccountPublicAccessBlockDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3controlconn
+	conn := meta.(*AWSClient).s3controlconn()
 
 	input := &s3control.DeletePublicAccessBlockInput{
 		AccountId: aws.String(d.Id()),
@@ -45,7 +45,7 @@ func testAccAWSS3AccountPublicAccessBlock_basic(t *testing.T) {
 	var configuration1 s3control.PublicAccessBlockConfiguration
 	resourceName := "aws_s3_account_public_access_block.test"
 
-	resource.Test(t, resource.TestCase{
+	testAccCassetteTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckAWSS3AccountPublicAccessBlockDestroy,
@@ -74,7 +74,7 @@ func testAccAWSS3AccountPublicAccessBlock_disappears(t *testing.T) {
 	var configuration1 s3control.PublicAccessBlockConfiguration
 	resourceName := "aws_s3_account_public_access_block.test"
 
-	resource.Test(t, resource.TestCase{
+	testAccCassetteTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckAWSS3AccountPublicAccessBlockDestroy,
@@ -95,7 +95,7 @@ func testAccAWSS3AccountPublicAccessBlock_AccountId(t *testing.T) {
 	var configuration1 s3control.PublicAccessBlockConfiguration
 	resourceName := "aws_s3_account_public_access_block.test"
 
-	resource.Test(t, resource.TestCase{
+	testAccCassetteTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckAWSS3AccountPublicAccessBlockDestroy,
@@ -120,7 +120,7 @@ func testAccAWSS3AccountPublicAccessBlock_BlockPublicAcls(t *testing.T) {
 	var configuration1, configuration2, configuration3 s3control.PublicAccessBlockConfiguration
 	resourceName := "aws_s3_account_public_access_block.test"
 
-	resource.Test(t, resource.TestCase{
+	testAccCassetteTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckAWSS3AccountPublicAccessBlockDestroy,
@@ -159,7 +159,7 @@ func testAccAWSS3AccountPublicAccessBlock_BlockPublicPolicy(t *testing.T) {
 	var configuration1, configuration2, configuration3 s3control.PublicAccessBlockConfiguration
 	resourceName := "aws_s3_account_public_access_block.test"
 
-	resource.Test(t, resource.TestCase{
+	testAccCassetteTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckAWSS3AccountPublicAccessBlockDestroy,
@@ -198,7 +198,7 @@ func testAccAWSS3AccountPublicAccessBlock_IgnorePublicAcls(t *testing.T) {
 	var configuration1, configuration2, configuration3 s3control.PublicAccessBlockConfiguration
 	resourceName := "aws_s3_account_public_access_block.test"
 
-	resource.Test(t, resource.TestCase{
+	testAccCassetteTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckAWSS3AccountPublicAccessBlockDestroy,
@@ -237,7 +237,7 @@ func testAccAWSS3AccountPublicAccessBlock_RestrictPublicBuckets(t *testing.T) {
 	var configuration1, configuration2, configuration3 s3control.PublicAccessBlockConfiguration
 	resourceName := "aws_s3_account_public_access_block.test"
 
-	resource.Test(t, resource.TestCase{
+	testAccCassetteTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckAWSS3AccountPublicAccessBlockDestroy,
@@ -283,7 +283,7 @@ func testAccCheckAWSS3AccountPublicAccessBlockExists(resourceName string, config
 			return fmt.Errorf("No S3 Account Public Access Block ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).s3controlconn
+		conn := testAccProvider.Meta().(*AWSClient).s3controlconn()
 
 		input := &s3control.GetPublicAccessBlockInput{
 			AccountId: aws.String(rs.Primary.ID),
@@ -321,7 +321,7 @@ func testAccCheckAWSS3AccountPublicAccessBlockExists(resourceName string, config
 }
 
 func testAccCheckAWSS3AccountPublicAccessBlockDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).s3controlconn
+	conn := testAccProvider.Meta().(*AWSClient).s3controlconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_s3_account_public_access_block" {
@@ -357,7 +357,7 @@ func testAccCheckAWSS3AccountPublicAccessBlockDestroy(s *terraform.State) error
 
 func testAccCheckAWSS3AccountPublicAccessBlockDisappears() resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).s3controlconn
+		conn := testAccProvider.Meta().(*AWSClient).s3controlconn()
 		accountID := testAccProvider.Meta().(*AWSClient).accountid
 
 		deleteInput := &s3control.DeletePublicAccessBlockInput{
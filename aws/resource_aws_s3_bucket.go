@@ -598,7 +598,7 @@ func resourceAwsS3Bucket() *schema.Resource {
 }
 
 func resourceAwsS3BucketCreate(d *schema.ResourceData, meta interface{}) error {
-	s3conn := meta.(*AWSClient).s3conn
+	s3conn := meta.(*AWSClient).s3conn()
 
 	// Get the bucket and acl
 	var bucket string
@@ -673,8 +673,8 @@ func resourceAwsS3BucketCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsS3BucketUpdate(d *schema.ResourceData, meta interface{}) error {
-	s3conn := meta.(*AWSClient).s3conn
-	if err := setTagsS3(s3conn, d); err != nil {
+	s3conn := meta.(*AWSClient).s3conn()
+	if err := setTagsS3(s3conn, d, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return fmt.Errorf("%q: %s", d.Get("bucket").(string), err)
 	}
 
@@ -685,7 +685,7 @@ func resourceAwsS3BucketUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if d.HasChange("cors_rule") {
-		if err := resourceAwsS3BucketCorsUpdate(s3conn, d); err != nil {
+		if err := resourceAwsS3BucketCorsPut(s3conn, d.Get("bucket").(string), d.Get("cors_rule").([]interface{})); err != nil {
 			return err
 		}
 	}
@@ -697,7 +697,7 @@ func resourceAwsS3BucketUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if d.HasChange("versioning") {
-		if err := resourceAwsS3BucketVersioningUpdate(s3conn, d); err != nil {
+		if err := resourceAwsS3BucketVersioningPut(s3conn, d.Get("bucket").(string), d.Get("versioning").([]interface{})); err != nil {
 			return err
 		}
 	}
@@ -708,37 +708,55 @@ func resourceAwsS3BucketUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if d.HasChange("logging") {
-		if err := resourceAwsS3BucketLoggingUpdate(s3conn, d); err != nil {
+		if err := resourceAwsS3BucketLoggingPut(s3conn, d.Get("bucket").(string), d.Get("logging").(*schema.Set).List()); err != nil {
 			return err
 		}
 	}
 
 	if d.HasChange("lifecycle_rule") {
-		if err := resourceAwsS3BucketLifecycleUpdate(s3conn, d); err != nil {
+		if err := resourceAwsS3BucketLifecyclePut(s3conn, d.Get("bucket").(string), d.Get("lifecycle_rule").([]interface{})); err != nil {
 			return err
 		}
 	}
 
 	if d.HasChange("acceleration_status") {
-		if err := resourceAwsS3BucketAccelerationUpdate(s3conn, d); err != nil {
+		if err := resourceAwsS3BucketAccelerationPut(s3conn, d.Get("bucket").(string), d.Get("acceleration_status").(string)); err != nil {
 			return err
 		}
 	}
 
 	if d.HasChange("request_payer") {
-		if err := resourceAwsS3BucketRequestPayerUpdate(s3conn, d); err != nil {
+		if err := resourceAwsS3BucketRequestPayerPut(s3conn, d.Get("bucket").(string), d.Get("request_payer").(string)); err != nil {
 			return err
 		}
 	}
 
 	if d.HasChange("replication_configuration") {
-		if err := resourceAwsS3BucketReplicationConfigurationUpdate(s3conn, d); err != nil {
+		replicationConfiguration := d.Get("replication_configuration").([]interface{})
+
+		if len(replicationConfiguration) > 0 {
+			hasVersioning := false
+			// Validate that bucket versioning is enabled
+			if versioning, ok := d.GetOk("versioning"); ok {
+				v := versioning.([]interface{})
+
+				if v[0].(map[string]interface{})["enabled"].(bool) {
+					hasVersioning = true
+				}
+			}
+
+			if !hasVersioning {
+				return fmt.Errorf("versioning must be enabled to allow S3 bucket replication")
+			}
+		}
+
+		if err := resourceAwsS3BucketReplicationConfigurationPut(s3conn, d.Get("bucket").(string), replicationConfiguration); err != nil {
 			return err
 		}
 	}
 
 	if d.HasChange("server_side_encryption_configuration") {
-		if err := resourceAwsS3BucketServerSideEncryptionConfigurationUpdate(s3conn, d); err != nil {
+		if err := resourceAwsS3BucketServerSideEncryptionConfigurationPut(s3conn, d.Get("bucket").(string), d.Get("server_side_encryption_configuration").([]interface{})); err != nil {
 			return err
 		}
 	}
@@ -753,7 +771,7 @@ func resourceAwsS3BucketUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
-	s3conn := meta.(*AWSClient).s3conn
+	s3conn := meta.(*AWSClient).s3conn()
 
 	var err error
 
@@ -817,22 +835,8 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	corsRules := make([]map[string]interface{}, 0)
-	if cors, ok := corsResponse.(*s3.GetBucketCorsOutput); ok && len(cors.CORSRules) > 0 {
-		corsRules = make([]map[string]interface{}, 0, len(cors.CORSRules))
-		for _, ruleObject := range cors.CORSRules {
-			rule := make(map[string]interface{})
-			rule["allowed_headers"] = flattenStringList(ruleObject.AllowedHeaders)
-			rule["allowed_methods"] = flattenStringList(ruleObject.AllowedMethods)
-			rule["allowed_origins"] = flattenStringList(ruleObject.AllowedOrigins)
-			// Both the "ExposeHeaders" and "MaxAgeSeconds" might not be set.
-			if ruleObject.AllowedOrigins != nil {
-				rule["expose_headers"] = flattenStringList(ruleObject.ExposeHeaders)
-			}
-			if ruleObject.MaxAgeSeconds != nil {
-				rule["max_age_seconds"] = int(*ruleObject.MaxAgeSeconds)
-			}
-			corsRules = append(corsRules, rule)
-		}
+	if cors, ok := corsResponse.(*s3.GetBucketCorsOutput); ok {
+		corsRules = flattenAwsS3BucketCorsRules(cors.CORSRules)
 	}
 	if err := d.Set("cors_rule", corsRules); err != nil {
 		return fmt.Errorf("error setting cors_rule: %s", err)
@@ -850,48 +854,9 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 
 	websites := make([]map[string]interface{}, 0, 1)
 	if ws, ok := wsResponse.(*s3.GetBucketWebsiteOutput); ok {
-		w := make(map[string]interface{})
-
-		if v := ws.IndexDocument; v != nil {
-			w["index_document"] = *v.Suffix
-		}
-
-		if v := ws.ErrorDocument; v != nil {
-			w["error_document"] = *v.Key
-		}
-
-		if v := ws.RedirectAllRequestsTo; v != nil {
-			if v.Protocol == nil {
-				w["redirect_all_requests_to"] = *v.HostName
-			} else {
-				var host string
-				var path string
-				var query string
-				parsedHostName, err := url.Parse(*v.HostName)
-				if err == nil {
-					host = parsedHostName.Host
-					path = parsedHostName.Path
-					query = parsedHostName.RawQuery
-				} else {
-					host = *v.HostName
-					path = ""
-				}
-
-				w["redirect_all_requests_to"] = (&url.URL{
-					Host:     host,
-					Path:     path,
-					Scheme:   *v.Protocol,
-					RawQuery: query,
-				}).String()
-			}
-		}
-
-		if v := ws.RoutingRules; v != nil {
-			rr, err := normalizeRoutingRules(v)
-			if err != nil {
-				return fmt.Errorf("Error while marshaling routing rules: %s", err)
-			}
-			w["routing_rules"] = rr
+		w, err := flattenAwsS3BucketWebsite(ws)
+		if err != nil {
+			return err
 		}
 
 		// We have special handling for the website configuration,
@@ -917,19 +882,7 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 
 	vcl := make([]map[string]interface{}, 0, 1)
 	if versioning, ok := versioningResponse.(*s3.GetBucketVersioningOutput); ok {
-		vc := make(map[string]interface{})
-		if versioning.Status != nil && *versioning.Status == s3.BucketVersioningStatusEnabled {
-			vc["enabled"] = true
-		} else {
-			vc["enabled"] = false
-		}
-
-		if versioning.MFADelete != nil && *versioning.MFADelete == s3.MFADeleteEnabled {
-			vc["mfa_delete"] = true
-		} else {
-			vc["mfa_delete"] = false
-		}
-		vcl = append(vcl, vc)
+		vcl = append(vcl, flattenAwsS3BucketVersioning(versioning))
 	}
 	if err := d.Set("versioning", vcl); err != nil {
 		return fmt.Errorf("error setting versioning: %s", err)
@@ -980,15 +933,7 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 
 	lcl := make([]map[string]interface{}, 0, 1)
 	if logging, ok := loggingResponse.(*s3.GetBucketLoggingOutput); ok && logging.LoggingEnabled != nil {
-		v := logging.LoggingEnabled
-		lc := make(map[string]interface{})
-		if *v.TargetBucket != "" {
-			lc["target_bucket"] = *v.TargetBucket
-		}
-		if *v.TargetPrefix != "" {
-			lc["target_prefix"] = *v.TargetPrefix
-		}
-		lcl = append(lcl, lc)
+		lcl = append(lcl, flattenAwsS3BucketLogging(logging.LoggingEnabled))
 	}
 	if err := d.Set("logging", lcl); err != nil {
 		return fmt.Errorf("error setting logging: %s", err)
@@ -1006,113 +951,8 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	lifecycleRules := make([]map[string]interface{}, 0)
-	if lifecycle, ok := lifecycleResponse.(*s3.GetBucketLifecycleConfigurationOutput); ok && len(lifecycle.Rules) > 0 {
-		lifecycleRules = make([]map[string]interface{}, 0, len(lifecycle.Rules))
-
-		for _, lifecycleRule := range lifecycle.Rules {
-			rule := make(map[string]interface{})
-
-			// ID
-			if lifecycleRule.ID != nil && *lifecycleRule.ID != "" {
-				rule["id"] = *lifecycleRule.ID
-			}
-			filter := lifecycleRule.Filter
-			if filter != nil {
-				if filter.And != nil {
-					// Prefix
-					if filter.And.Prefix != nil && *filter.And.Prefix != "" {
-						rule["prefix"] = *filter.And.Prefix
-					}
-					// Tag
-					if len(filter.And.Tags) > 0 {
-						rule["tags"] = tagsToMapS3(filter.And.Tags)
-					}
-				} else {
-					// Prefix
-					if filter.Prefix != nil && *filter.Prefix != "" {
-						rule["prefix"] = *filter.Prefix
-					}
-				}
-			} else {
-				if lifecycleRule.Prefix != nil {
-					rule["prefix"] = *lifecycleRule.Prefix
-				}
-			}
-
-			// Enabled
-			if lifecycleRule.Status != nil {
-				if *lifecycleRule.Status == s3.ExpirationStatusEnabled {
-					rule["enabled"] = true
-				} else {
-					rule["enabled"] = false
-				}
-			}
-
-			// AbortIncompleteMultipartUploadDays
-			if lifecycleRule.AbortIncompleteMultipartUpload != nil {
-				if lifecycleRule.AbortIncompleteMultipartUpload.DaysAfterInitiation != nil {
-					rule["abort_incomplete_multipart_upload_days"] = int(*lifecycleRule.AbortIncompleteMultipartUpload.DaysAfterInitiation)
-				}
-			}
-
-			// expiration
-			if lifecycleRule.Expiration != nil {
-				e := make(map[string]interface{})
-				if lifecycleRule.Expiration.Date != nil {
-					e["date"] = (*lifecycleRule.Expiration.Date).Format("2006-01-02")
-				}
-				if lifecycleRule.Expiration.Days != nil {
-					e["days"] = int(*lifecycleRule.Expiration.Days)
-				}
-				if lifecycleRule.Expiration.ExpiredObjectDeleteMarker != nil {
-					e["expired_object_delete_marker"] = *lifecycleRule.Expiration.ExpiredObjectDeleteMarker
-				}
-				rule["expiration"] = schema.NewSet(expirationHash, []interface{}{e})
-			}
-			// noncurrent_version_expiration
-			if lifecycleRule.NoncurrentVersionExpiration != nil {
-				e := make(map[string]interface{})
-				if lifecycleRule.NoncurrentVersionExpiration.NoncurrentDays != nil {
-					e["days"] = int(*lifecycleRule.NoncurrentVersionExpiration.NoncurrentDays)
-				}
-				rule["noncurrent_version_expiration"] = schema.NewSet(expirationHash, []interface{}{e})
-			}
-			//// transition
-			if len(lifecycleRule.Transitions) > 0 {
-				transitions := make([]interface{}, 0, len(lifecycleRule.Transitions))
-				for _, v := range lifecycleRule.Transitions {
-					t := make(map[string]interface{})
-					if v.Date != nil {
-						t["date"] = (*v.Date).Format("2006-01-02")
-					}
-					if v.Days != nil {
-						t["days"] = int(*v.Days)
-					}
-					if v.StorageClass != nil {
-						t["storage_class"] = *v.StorageClass
-					}
-					transitions = append(transitions, t)
-				}
-				rule["transition"] = schema.NewSet(transitionHash, transitions)
-			}
-			// noncurrent_version_transition
-			if len(lifecycleRule.NoncurrentVersionTransitions) > 0 {
-				transitions := make([]interface{}, 0, len(lifecycleRule.NoncurrentVersionTransitions))
-				for _, v := range lifecycleRule.NoncurrentVersionTransitions {
-					t := make(map[string]interface{})
-					if v.NoncurrentDays != nil {
-						t["days"] = int(*v.NoncurrentDays)
-					}
-					if v.StorageClass != nil {
-						t["storage_class"] = *v.StorageClass
-					}
-					transitions = append(transitions, t)
-				}
-				rule["noncurrent_version_transition"] = schema.NewSet(transitionHash, transitions)
-			}
-
-			lifecycleRules = append(lifecycleRules, rule)
-		}
+	if lifecycle, ok := lifecycleResponse.(*s3.GetBucketLifecycleConfigurationOutput); ok {
+		lifecycleRules = flattenAwsS3BucketLifecycleRules(lifecycle.Rules)
 	}
 	if err := d.Set("lifecycle_rule", lifecycleRules); err != nil {
 		return fmt.Errorf("error setting lifecycle_rule: %s", err)
@@ -1236,7 +1076,7 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsS3BucketDelete(d *schema.ResourceData, meta interface{}) error {
-	s3conn := meta.(*AWSClient).s3conn
+	s3conn := meta.(*AWSClient).s3conn()
 
 	log.Printf("[DEBUG] S3 Delete Bucket: %s", d.Id())
 	_, err := s3conn.DeleteBucket(&s3.DeleteBucketInput{
@@ -1352,10 +1192,7 @@ func resourceAwsS3BucketPolicyUpdate(s3conn *s3.S3, d *schema.ResourceData) erro
 	return nil
 }
 
-func resourceAwsS3BucketCorsUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
-	bucket := d.Get("bucket").(string)
-	rawCors := d.Get("cors_rule").([]interface{})
-
+func resourceAwsS3BucketCorsPut(s3conn *s3.S3, bucket string, rawCors []interface{}) error {
 	if len(rawCors) == 0 {
 		// Delete CORS
 		log.Printf("[DEBUG] S3 bucket: %s, delete CORS", bucket)
@@ -1431,12 +1268,10 @@ func resourceAwsS3BucketWebsiteUpdate(s3conn *s3.S3, d *schema.ResourceData) err
 	} else {
 		w = make(map[string]interface{})
 	}
-	return resourceAwsS3BucketWebsitePut(s3conn, d, w)
+	return resourceAwsS3BucketWebsitePut(s3conn, d.Get("bucket").(string), w)
 }
 
-func resourceAwsS3BucketWebsitePut(s3conn *s3.S3, d *schema.ResourceData, website map[string]interface{}) error {
-	bucket := d.Get("bucket").(string)
-
+func resourceAwsS3BucketWebsitePut(s3conn *s3.S3, bucket string, website map[string]interface{}) error {
 	var indexDocument, errorDocument, redirectAllRequestsTo, routingRules string
 	if v, ok := website["index_document"]; ok {
 		indexDocument = v.(string)
@@ -1534,8 +1369,12 @@ func websiteEndpoint(s3conn *s3.S3, d *schema.ResourceData) (*S3Website, error)
 		return nil, nil
 	}
 
-	bucket := d.Get("bucket").(string)
+	return bucketWebsiteEndpoint(s3conn, d.Get("bucket").(string))
+}
 
+// bucketWebsiteEndpoint returns the website endpoint of the bucket, in the
+// region of the bucket.
+func bucketWebsiteEndpoint(s3conn *s3.S3, bucket string) (*S3Website, error) {
 	// Lookup the region for this bucket
 
 	locationResponse, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
@@ -1631,9 +1470,7 @@ func resourceAwsS3BucketAclUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
 	return nil
 }
 
-func resourceAwsS3BucketVersioningUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
-	v := d.Get("versioning").([]interface{})
-	bucket := d.Get("bucket").(string)
+func resourceAwsS3BucketVersioningPut(s3conn *s3.S3, bucket string, v []interface{}) error {
 	vc := &s3.VersioningConfiguration{}
 
 	if len(v) > 0 {
@@ -1671,9 +1508,7 @@ func resourceAwsS3BucketVersioningUpdate(s3conn *s3.S3, d *schema.ResourceData)
 	return nil
 }
 
-func resourceAwsS3BucketLoggingUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
-	logging := d.Get("logging").(*schema.Set).List()
-	bucket := d.Get("bucket").(string)
+func resourceAwsS3BucketLoggingPut(s3conn *s3.S3, bucket string, logging []interface{}) error {
 	loggingStatus := &s3.BucketLoggingStatus{}
 
 	if len(logging) > 0 {
@@ -1706,10 +1541,7 @@ func resourceAwsS3BucketLoggingUpdate(s3conn *s3.S3, d *schema.ResourceData) err
 	return nil
 }
 
-func resourceAwsS3BucketAccelerationUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
-	bucket := d.Get("bucket").(string)
-	enableAcceleration := d.Get("acceleration_status").(string)
-
+func resourceAwsS3BucketAccelerationPut(s3conn *s3.S3, bucket, enableAcceleration string) error {
 	i := &s3.PutBucketAccelerateConfigurationInput{
 		Bucket: aws.String(bucket),
 		AccelerateConfiguration: &s3.AccelerateConfiguration{
@@ -1728,10 +1560,7 @@ func resourceAwsS3BucketAccelerationUpdate(s3conn *s3.S3, d *schema.ResourceData
 	return nil
 }
 
-func resourceAwsS3BucketRequestPayerUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
-	bucket := d.Get("bucket").(string)
-	payer := d.Get("request_payer").(string)
-
+func resourceAwsS3BucketRequestPayerPut(s3conn *s3.S3, bucket, payer string) error {
 	i := &s3.PutBucketRequestPaymentInput{
 		Bucket: aws.String(bucket),
 		RequestPaymentConfiguration: &s3.RequestPaymentConfiguration{
@@ -1750,9 +1579,7 @@ func resourceAwsS3BucketRequestPayerUpdate(s3conn *s3.S3, d *schema.ResourceData
 	return nil
 }
 
-func resourceAwsS3BucketServerSideEncryptionConfigurationUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
-	bucket := d.Get("bucket").(string)
-	serverSideEncryptionConfiguration := d.Get("server_side_encryption_configuration").([]interface{})
+func resourceAwsS3BucketServerSideEncryptionConfigurationPut(s3conn *s3.S3, bucket string, serverSideEncryptionConfiguration []interface{}) error {
 	if len(serverSideEncryptionConfiguration) == 0 {
 		log.Printf("[DEBUG] Delete server side encryption configuration: %#v", serverSideEncryptionConfiguration)
 		i := &s3.DeleteBucketEncryptionInput{
@@ -1829,10 +1656,7 @@ func resourceAwsS3BucketObjectLockConfigurationUpdate(s3conn *s3.S3, d *schema.R
 	return nil
 }
 
-func resourceAwsS3BucketReplicationConfigurationUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
-	bucket := d.Get("bucket").(string)
-	replicationConfiguration := d.Get("replication_configuration").([]interface{})
-
+func resourceAwsS3BucketReplicationConfigurationPut(s3conn *s3.S3, bucket string, replicationConfiguration []interface{}) error {
 	if len(replicationConfiguration) == 0 {
 		i := &s3.DeleteBucketReplicationInput{
 			Bucket: aws.String(bucket),
@@ -1850,20 +1674,6 @@ func resourceAwsS3BucketReplicationConfigurationUpdate(s3conn *s3.S3, d *schema.
 		return nil
 	}
 
-	hasVersioning := false
-	// Validate that bucket versioning is enabled
-	if versioning, ok := d.GetOk("versioning"); ok {
-		v := versioning.([]interface{})
-
-		if v[0].(map[string]interface{})["enabled"].(bool) {
-			hasVersioning = true
-		}
-	}
-
-	if !hasVersioning {
-		return fmt.Errorf("versioning must be enabled to allow S3 bucket replication")
-	}
-
 	c := replicationConfiguration[0].(map[string]interface{})
 
 	rc := &s3.ReplicationConfiguration{}
@@ -1980,11 +1790,7 @@ func resourceAwsS3BucketReplicationConfigurationUpdate(s3conn *s3.S3, d *schema.
 	return nil
 }
 
-func resourceAwsS3BucketLifecycleUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
-	bucket := d.Get("bucket").(string)
-
-	lifecycleRules := d.Get("lifecycle_rule").([]interface{})
-
+func resourceAwsS3BucketLifecyclePut(s3conn *s3.S3, bucket string, lifecycleRules []interface{}) error {
 	if len(lifecycleRules) == 0 {
 		i := &s3.DeleteBucketLifecycleInput{
 			Bucket: aws.String(bucket),
@@ -2004,7 +1810,7 @@ func resourceAwsS3BucketLifecycleUpdate(s3conn *s3.S3, d *schema.ResourceData) e
 
 	rules := make([]*s3.LifecycleRule, 0, len(lifecycleRules))
 
-	for i, lifecycleRule := range lifecycleRules {
+	for _, lifecycleRule := range lifecycleRules {
 		r := lifecycleRule.(map[string]interface{})
 
 		rule := &s3.LifecycleRule{}
@@ -2044,7 +1850,7 @@ func resourceAwsS3BucketLifecycleUpdate(s3conn *s3.S3, d *schema.ResourceData) e
 		}
 
 		// Expiration
-		expiration := d.Get(fmt.Sprintf("lifecycle_rule.%d.expiration", i)).(*schema.Set).List()
+		expiration := r["expiration"].(*schema.Set).List()
 		if len(expiration) > 0 {
 			e := expiration[0].(map[string]interface{})
 			i := &s3.LifecycleExpiration{}
@@ -2064,7 +1870,7 @@ func resourceAwsS3BucketLifecycleUpdate(s3conn *s3.S3, d *schema.ResourceData) e
 		}
 
 		// NoncurrentVersionExpiration
-		nc_expiration := d.Get(fmt.Sprintf("lifecycle_rule.%d.noncurrent_version_expiration", i)).(*schema.Set).List()
+		nc_expiration := r["noncurrent_version_expiration"].(*schema.Set).List()
 		if len(nc_expiration) > 0 {
 			e := nc_expiration[0].(map[string]interface{})
 
@@ -2076,7 +1882,7 @@ func resourceAwsS3BucketLifecycleUpdate(s3conn *s3.S3, d *schema.ResourceData) e
 		}
 
 		// Transitions
-		transitions := d.Get(fmt.Sprintf("lifecycle_rule.%d.transition", i)).(*schema.Set).List()
+		transitions := r["transition"].(*schema.Set).List()
 		if len(transitions) > 0 {
 			rule.Transitions = make([]*s3.Transition, 0, len(transitions))
 			for _, transition := range transitions {
@@ -2099,7 +1905,7 @@ func resourceAwsS3BucketLifecycleUpdate(s3conn *s3.S3, d *schema.ResourceData) e
 			}
 		}
 		// NoncurrentVersionTransitions
-		nc_transitions := d.Get(fmt.Sprintf("lifecycle_rule.%d.noncurrent_version_transition", i)).(*schema.Set).List()
+		nc_transitions := r["noncurrent_version_transition"].(*schema.Set).List()
 		if len(nc_transitions) > 0 {
 			rule.NoncurrentVersionTransitions = make([]*s3.NoncurrentVersionTransition, 0, len(nc_transitions))
 			for _, transition := range nc_transitions {
@@ -2136,6 +1942,210 @@ func resourceAwsS3BucketLifecycleUpdate(s3conn *s3.S3, d *schema.ResourceData) e
 	return nil
 }
 
+func flattenAwsS3BucketCorsRules(rules []*s3.CORSRule) []map[string]interface{} {
+	corsRules := make([]map[string]interface{}, 0, len(rules))
+	for _, ruleObject := range rules {
+		rule := make(map[string]interface{})
+		rule["allowed_headers"] = flattenStringList(ruleObject.AllowedHeaders)
+		rule["allowed_methods"] = flattenStringList(ruleObject.AllowedMethods)
+		rule["allowed_origins"] = flattenStringList(ruleObject.AllowedOrigins)
+		// Both the "ExposeHeaders" and "MaxAgeSeconds" might not be set.
+		if ruleObject.AllowedOrigins != nil {
+			rule["expose_headers"] = flattenStringList(ruleObject.ExposeHeaders)
+		}
+		if ruleObject.MaxAgeSeconds != nil {
+			rule["max_age_seconds"] = int(*ruleObject.MaxAgeSeconds)
+		}
+		corsRules = append(corsRules, rule)
+	}
+	return corsRules
+}
+
+func flattenAwsS3BucketWebsite(ws *s3.GetBucketWebsiteOutput) (map[string]interface{}, error) {
+	w := make(map[string]interface{})
+
+	if v := ws.IndexDocument; v != nil {
+		w["index_document"] = *v.Suffix
+	}
+
+	if v := ws.ErrorDocument; v != nil {
+		w["error_document"] = *v.Key
+	}
+
+	if v := ws.RedirectAllRequestsTo; v != nil {
+		if v.Protocol == nil {
+			w["redirect_all_requests_to"] = *v.HostName
+		} else {
+			var host string
+			var path string
+			var query string
+			parsedHostName, err := url.Parse(*v.HostName)
+			if err == nil {
+				host = parsedHostName.Host
+				path = parsedHostName.Path
+				query = parsedHostName.RawQuery
+			} else {
+				host = *v.HostName
+				path = ""
+			}
+
+			w["redirect_all_requests_to"] = (&url.URL{
+				Host:     host,
+				Path:     path,
+				Scheme:   *v.Protocol,
+				RawQuery: query,
+			}).String()
+		}
+	}
+
+	if v := ws.RoutingRules; v != nil {
+		rr, err := normalizeRoutingRules(v)
+		if err != nil {
+			return nil, fmt.Errorf("Error while marshaling routing rules: %s", err)
+		}
+		w["routing_rules"] = rr
+	}
+
+	return w, nil
+}
+
+func flattenAwsS3BucketVersioning(versioning *s3.GetBucketVersioningOutput) map[string]interface{} {
+	vc := make(map[string]interface{})
+	if versioning.Status != nil && *versioning.Status == s3.BucketVersioningStatusEnabled {
+		vc["enabled"] = true
+	} else {
+		vc["enabled"] = false
+	}
+
+	if versioning.MFADelete != nil && *versioning.MFADelete == s3.MFADeleteEnabled {
+		vc["mfa_delete"] = true
+	} else {
+		vc["mfa_delete"] = false
+	}
+	return vc
+}
+
+func flattenAwsS3BucketLogging(v *s3.LoggingEnabled) map[string]interface{} {
+	lc := make(map[string]interface{})
+	if *v.TargetBucket != "" {
+		lc["target_bucket"] = *v.TargetBucket
+	}
+	if *v.TargetPrefix != "" {
+		lc["target_prefix"] = *v.TargetPrefix
+	}
+	return lc
+}
+
+func flattenAwsS3BucketLifecycleRules(rules []*s3.LifecycleRule) []map[string]interface{} {
+	lifecycleRules := make([]map[string]interface{}, 0, len(rules))
+
+	for _, lifecycleRule := range rules {
+		rule := make(map[string]interface{})
+
+		// ID
+		if lifecycleRule.ID != nil && *lifecycleRule.ID != "" {
+			rule["id"] = *lifecycleRule.ID
+		}
+		filter := lifecycleRule.Filter
+		if filter != nil {
+			if filter.And != nil {
+				// Prefix
+				if filter.And.Prefix != nil && *filter.And.Prefix != "" {
+					rule["prefix"] = *filter.And.Prefix
+				}
+				// Tag
+				if len(filter.And.Tags) > 0 {
+					rule["tags"] = tagsToMapS3(filter.And.Tags)
+				}
+			} else {
+				// Prefix
+				if filter.Prefix != nil && *filter.Prefix != "" {
+					rule["prefix"] = *filter.Prefix
+				}
+			}
+		} else {
+			if lifecycleRule.Prefix != nil {
+				rule["prefix"] = *lifecycleRule.Prefix
+			}
+		}
+
+		// Enabled
+		if lifecycleRule.Status != nil {
+			if *lifecycleRule.Status == s3.ExpirationStatusEnabled {
+				rule["enabled"] = true
+			} else {
+				rule["enabled"] = false
+			}
+		}
+
+		// AbortIncompleteMultipartUploadDays
+		if lifecycleRule.AbortIncompleteMultipartUpload != nil {
+			if lifecycleRule.AbortIncompleteMultipartUpload.DaysAfterInitiation != nil {
+				rule["abort_incomplete_multipart_upload_days"] = int(*lifecycleRule.AbortIncompleteMultipartUpload.DaysAfterInitiation)
+			}
+		}
+
+		// expiration
+		if lifecycleRule.Expiration != nil {
+			e := make(map[string]interface{})
+			if lifecycleRule.Expiration.Date != nil {
+				e["date"] = (*lifecycleRule.Expiration.Date).Format("2006-01-02")
+			}
+			if lifecycleRule.Expiration.Days != nil {
+				e["days"] = int(*lifecycleRule.Expiration.Days)
+			}
+			if lifecycleRule.Expiration.ExpiredObjectDeleteMarker != nil {
+				e["expired_object_delete_marker"] = *lifecycleRule.Expiration.ExpiredObjectDeleteMarker
+			}
+			rule["expiration"] = schema.NewSet(expirationHash, []interface{}{e})
+		}
+		// noncurrent_version_expiration
+		if lifecycleRule.NoncurrentVersionExpiration != nil {
+			e := make(map[string]interface{})
+			if lifecycleRule.NoncurrentVersionExpiration.NoncurrentDays != nil {
+				e["days"] = int(*lifecycleRule.NoncurrentVersionExpiration.NoncurrentDays)
+			}
+			rule["noncurrent_version_expiration"] = schema.NewSet(expirationHash, []interface{}{e})
+		}
+		//// transition
+		if len(lifecycleRule.Transitions) > 0 {
+			transitions := make([]interface{}, 0, len(lifecycleRule.Transitions))
+			for _, v := range lifecycleRule.Transitions {
+				t := make(map[string]interface{})
+				if v.Date != nil {
+					t["date"] = (*v.Date).Format("2006-01-02")
+				}
+				if v.Days != nil {
+					t["days"] = int(*v.Days)
+				}
+				if v.StorageClass != nil {
+					t["storage_class"] = *v.StorageClass
+				}
+				transitions = append(transitions, t)
+			}
+			rule["transition"] = schema.NewSet(transitionHash, transitions)
+		}
+		// noncurrent_version_transition
+		if len(lifecycleRule.NoncurrentVersionTransitions) > 0 {
+			transitions := make([]interface{}, 0, len(lifecycleRule.NoncurrentVersionTransitions))
+			for _, v := range lifecycleRule.NoncurrentVersionTransitions {
+				t := make(map[string]interface{})
+				if v.NoncurrentDays != nil {
+					t["days"] = int(*v.NoncurrentDays)
+				}
+				if v.StorageClass != nil {
+					t["storage_class"] = *v.StorageClass
+				}
+				transitions = append(transitions, t)
+			}
+			rule["noncurrent_version_transition"] = schema.NewSet(transitionHash, transitions)
+		}
+
+		lifecycleRules = append(lifecycleRules, rule)
+	}
+	return lifecycleRules
+}
+
 func flattenAwsS3ServerSideEncryptionConfiguration(c *s3.ServerSideEncryptionConfiguration) []map[string]interface{} {
 	var encryptionConfiguration []map[string]interface{}
 	rules := make([]interface{}, 0, len(c.Rules))
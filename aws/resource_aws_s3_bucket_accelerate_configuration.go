@@ -0,0 +1,103 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsS3BucketAccelerateConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketAccelerateConfigurationCreate,
+		Read:   resourceAwsS3BucketAccelerateConfigurationRead,
+		Update: resourceAwsS3BucketAccelerateConfigurationUpdate,
+		Delete: resourceAwsS3BucketAccelerateConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					s3.BucketAccelerateStatusEnabled,
+					s3.BucketAccelerateStatusSuspended,
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketAccelerateConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId(d.Get("bucket").(string))
+
+	return resourceAwsS3BucketAccelerateConfigurationUpdate(d, meta)
+}
+
+func resourceAwsS3BucketAccelerateConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn()
+
+	accelerate, err := s3conn.GetBucketAccelerateConfiguration(&s3.GetBucketAccelerateConfigurationInput{
+		Bucket: aws.String(d.Id()),
+	})
+	if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+		log.Printf("[WARN] S3 Bucket (%s) not found, removing acceleration configuration from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error getting S3 Bucket (%s) acceleration configuration: %s", d.Id(), err)
+	}
+
+	if aws.StringValue(accelerate.Status) == "" {
+		log.Printf("[WARN] S3 Bucket (%s) acceleration configuration not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("bucket", d.Id())
+	d.Set("status", accelerate.Status)
+
+	return nil
+}
+
+func resourceAwsS3BucketAccelerateConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn()
+
+	if err := resourceAwsS3BucketAccelerationPut(s3conn, d.Id(), d.Get("status").(string)); err != nil {
+		return err
+	}
+
+	return resourceAwsS3BucketAccelerateConfigurationRead(d, meta)
+}
+
+func resourceAwsS3BucketAccelerateConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn()
+
+	// Transfer acceleration cannot be removed, only suspended.
+	_, err := s3conn.PutBucketAccelerateConfiguration(&s3.PutBucketAccelerateConfigurationInput{
+		Bucket: aws.String(d.Id()),
+		AccelerateConfiguration: &s3.AccelerateConfiguration{
+			Status: aws.String(s3.BucketAccelerateStatusSuspended),
+		},
+	})
+	if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error suspending S3 Bucket (%s) acceleration: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,495 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/fakeaws"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+func TestExpandS3AnalyticsFilter(t *testing.T) {
+	testCases := []struct {
+		Config                    map[string]interface{}
+		ExpectedS3AnalyticsFilter *s3.AnalyticsFilter
+	}{
+		{
+			Config:                    map[string]interface{}{},
+			ExpectedS3AnalyticsFilter: nil,
+		},
+		{
+			Config: map[string]interface{}{
+				"prefix": "prefix/",
+			},
+			ExpectedS3AnalyticsFilter: &s3.AnalyticsFilter{
+				Prefix: aws.String("prefix/"),
+			},
+		},
+		{
+			Config: map[string]interface{}{
+				"prefix": "prefix/",
+				"tags": map[string]interface{}{
+					"tag1key": "tag1value",
+				},
+			},
+			ExpectedS3AnalyticsFilter: &s3.AnalyticsFilter{
+				And: &s3.AnalyticsAndOperator{
+					Prefix: aws.String("prefix/"),
+					Tags: []*s3.Tag{
+						{
+							Key:   aws.String("tag1key"),
+							Value: aws.String("tag1value"),
+						},
+					},
+				},
+			},
+		},
+		{
+			Config: map[string]interface{}{
+				"tags": map[string]interface{}{
+					"tag1key": "tag1value",
+				},
+			},
+			ExpectedS3AnalyticsFilter: &s3.AnalyticsFilter{
+				Tag: &s3.Tag{
+					Key:   aws.String("tag1key"),
+					Value: aws.String("tag1value"),
+				},
+			},
+		},
+		{
+			Config: map[string]interface{}{
+				"tags": map[string]interface{}{
+					"tag1key": "tag1value",
+					"tag2key": "tag2value",
+				},
+			},
+			ExpectedS3AnalyticsFilter: &s3.AnalyticsFilter{
+				And: &s3.AnalyticsAndOperator{
+					Tags: []*s3.Tag{
+						{
+							Key:   aws.String("tag1key"),
+							Value: aws.String("tag1value"),
+						},
+						{
+							Key:   aws.String("tag2key"),
+							Value: aws.String("tag2value"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		value := expandS3AnalyticsFilter(tc.Config)
+
+		// Sort tags by key for consistency
+		if value != nil && value.And != nil && value.And.Tags != nil {
+			sort.Slice(value.And.Tags, func(i, j int) bool {
+				return *value.And.Tags[i].Key < *value.And.Tags[j].Key
+			})
+		}
+
+		if !reflect.DeepEqual(value, tc.ExpectedS3AnalyticsFilter) {
+			t.Fatalf("Case #%d: Given:\n%s\n\nExpected:\n%s", i, value, tc.ExpectedS3AnalyticsFilter)
+		}
+	}
+}
+
+func TestFlattenS3AnalyticsFilter(t *testing.T) {
+	testCases := []struct {
+		S3AnalyticsFilter *s3.AnalyticsFilter
+		Expected          []map[string]interface{}
+	}{
+		{
+			S3AnalyticsFilter: nil,
+			Expected:          nil,
+		},
+		{
+			S3AnalyticsFilter: &s3.AnalyticsFilter{},
+			Expected:          []map[string]interface{}{},
+		},
+		{
+			S3AnalyticsFilter: &s3.AnalyticsFilter{
+				Prefix: aws.String("prefix/"),
+			},
+			Expected: []map[string]interface{}{
+				{
+					"prefix": "prefix/",
+				},
+			},
+		},
+		{
+			S3AnalyticsFilter: &s3.AnalyticsFilter{
+				And: &s3.AnalyticsAndOperator{
+					Prefix: aws.String("prefix/"),
+					Tags: []*s3.Tag{
+						{
+							Key:   aws.String("tag1key"),
+							Value: aws.String("tag1value"),
+						},
+					},
+				},
+			},
+			Expected: []map[string]interface{}{
+				{
+					"prefix": "prefix/",
+					"tags": map[string]string{
+						"tag1key": "tag1value",
+					},
+				},
+			},
+		},
+		{
+			S3AnalyticsFilter: &s3.AnalyticsFilter{
+				Tag: &s3.Tag{
+					Key:   aws.String("tag1key"),
+					Value: aws.String("tag1value"),
+				},
+			},
+			Expected: []map[string]interface{}{
+				{
+					"tags": map[string]string{
+						"tag1key": "tag1value",
+					},
+				},
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		value := flattenS3AnalyticsFilter(tc.S3AnalyticsFilter)
+
+		if !reflect.DeepEqual(value, tc.Expected) {
+			t.Fatalf("Case #%d: Given:\n%#v\n\nExpected:\n%#v", i, value, tc.Expected)
+		}
+	}
+}
+
+func TestAccAWSS3BucketAnalyticsConfiguration_basic(t *testing.T) {
+	var conf s3.AnalyticsConfiguration
+	rInt := acctest.RandInt()
+	resourceName := "aws_s3_bucket_analytics_configuration.test"
+
+	bucketName := fmt.Sprintf("tf-acc-%d", rInt)
+	analyticsName := t.Name()
+
+	testAccCassetteParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketAnalyticsConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketAnalyticsConfiguration(bucketName, analyticsName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketAnalyticsConfigurationExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "bucket", bucketName),
+					resource.TestCheckResourceAttr(resourceName, "name", analyticsName),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "storage_class_analysis.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketAnalyticsConfiguration_WithFilter(t *testing.T) {
+	var conf s3.AnalyticsConfiguration
+	rInt := acctest.RandInt()
+	resourceName := "aws_s3_bucket_analytics_configuration.test"
+
+	bucketName := fmt.Sprintf("tf-acc-%d", rInt)
+	analyticsName := t.Name()
+
+	testAccCassetteParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketAnalyticsConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketAnalyticsConfigurationWithFilterPrefix(bucketName, analyticsName, "prefix/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketAnalyticsConfigurationExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.prefix", "prefix/"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSS3BucketAnalyticsConfigurationWithFilterPrefixAndTags(bucketName, analyticsName, "prefix/", "tag1", "tag2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketAnalyticsConfigurationExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.prefix", "prefix/"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.tags.tag1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.tags.tag2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSS3BucketAnalyticsConfiguration(bucketName, analyticsName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketAnalyticsConfigurationExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketAnalyticsConfiguration_WithStorageClassAnalysis(t *testing.T) {
+	var conf s3.AnalyticsConfiguration
+	rInt := acctest.RandInt()
+	resourceName := "aws_s3_bucket_analytics_configuration.test"
+
+	bucketName := fmt.Sprintf("tf-acc-%d", rInt)
+	analyticsName := t.Name()
+
+	testAccCassetteParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketAnalyticsConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketAnalyticsConfigurationWithStorageClassAnalysis(bucketName, analyticsName, "analytics/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketAnalyticsConfigurationExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "storage_class_analysis.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_class_analysis.0.data_export.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_class_analysis.0.data_export.0.output_schema_version", "V_1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_class_analysis.0.data_export.0.destination.0.s3_bucket_destination.0.format", "CSV"),
+					resource.TestCheckResourceAttrPair(resourceName, "storage_class_analysis.0.data_export.0.destination.0.s3_bucket_destination.0.bucket_arn", "aws_s3_bucket.destination", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "storage_class_analysis.0.data_export.0.destination.0.s3_bucket_destination.0.prefix", "analytics/"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSS3BucketAnalyticsConfigurationWithStorageClassAnalysis(bucketName, analyticsName, "reports/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketAnalyticsConfigurationExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "storage_class_analysis.0.data_export.0.destination.0.s3_bucket_destination.0.prefix", "reports/"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceAwsS3BucketAnalyticsConfiguration_fakeAws(t *testing.T) {
+	server := fakeaws.New()
+	defer server.Close()
+
+	var conf s3.AnalyticsConfiguration
+	rInt := acctest.RandInt()
+	resourceName := "aws_s3_bucket_analytics_configuration.test"
+
+	bucketName := fmt.Sprintf("tf-acc-%d", rInt)
+	analyticsName := "EntireBucket"
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketAnalyticsConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFakeAwsProviderConfig(server.URL) + testAccAWSS3BucketAnalyticsConfigurationWithStorageClassAnalysis(bucketName, analyticsName, "analytics/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketAnalyticsConfigurationExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.prefix", "documents/"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_class_analysis.0.data_export.0.output_schema_version", "V_1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_class_analysis.0.data_export.0.destination.0.s3_bucket_destination.0.format", "CSV"),
+					resource.TestCheckResourceAttr(resourceName, "storage_class_analysis.0.data_export.0.destination.0.s3_bucket_destination.0.prefix", "analytics/"),
+				),
+			},
+			{
+				Config:            testAccFakeAwsProviderConfig(server.URL),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFakeAwsProviderConfig(server.URL) + testAccAWSS3BucketAnalyticsConfigurationWithFilterPrefixAndTags(bucketName, analyticsName, "prefix/", "tag1", "tag2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketAnalyticsConfigurationExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.prefix", "prefix/"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "storage_class_analysis.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSS3BucketAnalyticsConfigurationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).s3conn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3_bucket_analytics_configuration" {
+			continue
+		}
+
+		bucket, name, err := resourceAwsS3BucketAnalyticsConfigurationParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		output, err := conn.GetBucketAnalyticsConfiguration(&s3.GetBucketAnalyticsConfigurationInput{
+			Bucket: aws.String(bucket),
+			Id:     aws.String(name),
+		})
+		if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") || isAWSErr(err, "NoSuchConfiguration", "The specified configuration does not exist.") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if output.AnalyticsConfiguration != nil {
+			return fmt.Errorf("S3 bucket analytics configuration exists: %v", output)
+		}
+	}
+	return nil
+}
+
+func testAccCheckAWSS3BucketAnalyticsConfigurationExists(n string, res *s3.AnalyticsConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 bucket analytics configuration ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).s3conn()
+		bucket, name, err := resourceAwsS3BucketAnalyticsConfigurationParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		input := &s3.GetBucketAnalyticsConfigurationInput{
+			Bucket: aws.String(bucket),
+			Id:     aws.String(name),
+		}
+		log.Printf("[DEBUG] Reading S3 bucket analytics configuration: %s", input)
+		output, err := conn.GetBucketAnalyticsConfiguration(input)
+		if err != nil {
+			return err
+		}
+
+		*res = *output.AnalyticsConfiguration
+
+		return nil
+	}
+}
+
+func testAccAWSS3BucketAnalyticsConfigurationBucket(name string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "bucket" {
+  bucket = "%s"
+}
+`, name)
+}
+
+func testAccAWSS3BucketAnalyticsConfiguration(bucketName, analyticsName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "aws_s3_bucket_analytics_configuration" "test" {
+  bucket = "${aws_s3_bucket.bucket.id}"
+  name   = "%s"
+}
+`, testAccAWSS3BucketAnalyticsConfigurationBucket(bucketName), analyticsName)
+}
+
+func testAccAWSS3BucketAnalyticsConfigurationWithFilterPrefix(bucketName, analyticsName, prefix string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "aws_s3_bucket_analytics_configuration" "test" {
+  bucket = "${aws_s3_bucket.bucket.id}"
+  name   = "%s"
+
+  filter {
+    prefix = "%s"
+  }
+}
+`, testAccAWSS3BucketAnalyticsConfigurationBucket(bucketName), analyticsName, prefix)
+}
+
+func testAccAWSS3BucketAnalyticsConfigurationWithFilterPrefixAndTags(bucketName, analyticsName, prefix, tag1, tag2 string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "aws_s3_bucket_analytics_configuration" "test" {
+  bucket = "${aws_s3_bucket.bucket.id}"
+  name   = "%s"
+
+  filter {
+    prefix = "%s"
+
+    tags = {
+      "%s" = "value1"
+      "%s" = "value2"
+    }
+  }
+}
+`, testAccAWSS3BucketAnalyticsConfigurationBucket(bucketName), analyticsName, prefix, tag1, tag2)
+}
+
+func testAccAWSS3BucketAnalyticsConfigurationWithStorageClassAnalysis(bucketName, analyticsName, exportPrefix string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "aws_s3_bucket" "destination" {
+  bucket = "%s-destination"
+}
+
+resource "aws_s3_bucket_analytics_configuration" "test" {
+  bucket = "${aws_s3_bucket.bucket.id}"
+  name   = "%s"
+
+  filter {
+    prefix = "documents/"
+
+    tags = {
+      priority = "high"
+    }
+  }
+
+  storage_class_analysis {
+    data_export {
+      destination {
+        s3_bucket_destination {
+          bucket_arn = "${aws_s3_bucket.destination.arn}"
+          prefix     = "%s"
+        }
+      }
+    }
+  }
+}
+`, testAccAWSS3BucketAnalyticsConfigurationBucket(bucketName), bucketName, analyticsName, exportPrefix)
+}
@@ -0,0 +1,91 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsS3BucketCorsConfiguration() *schema.Resource {
+	corsRule := resourceAwsS3Bucket().Schema["cors_rule"]
+	corsRule.Optional = false
+	corsRule.Required = true
+
+	return &schema.Resource{
+		Create: resourceAwsS3BucketCorsConfigurationCreate,
+		Read:   resourceAwsS3BucketCorsConfigurationRead,
+		Update: resourceAwsS3BucketCorsConfigurationUpdate,
+		Delete: resourceAwsS3BucketCorsConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"cors_rule": corsRule,
+		},
+	}
+}
+
+func resourceAwsS3BucketCorsConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId(d.Get("bucket").(string))
+
+	return resourceAwsS3BucketCorsConfigurationUpdate(d, meta)
+}
+
+func resourceAwsS3BucketCorsConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn()
+
+	cors, err := s3conn.GetBucketCors(&s3.GetBucketCorsInput{
+		Bucket: aws.String(d.Id()),
+	})
+	if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") || isAWSErr(err, "NoSuchCORSConfiguration", "") {
+		log.Printf("[WARN] S3 Bucket (%s) CORS configuration not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error getting S3 Bucket (%s) CORS configuration: %s", d.Id(), err)
+	}
+
+	d.Set("bucket", d.Id())
+	if err := d.Set("cors_rule", flattenAwsS3BucketCorsRules(cors.CORSRules)); err != nil {
+		return fmt.Errorf("error setting cors_rule: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketCorsConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn()
+
+	if err := resourceAwsS3BucketCorsPut(s3conn, d.Id(), d.Get("cors_rule").([]interface{})); err != nil {
+		return err
+	}
+
+	return resourceAwsS3BucketCorsConfigurationRead(d, meta)
+}
+
+func resourceAwsS3BucketCorsConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn()
+
+	_, err := s3conn.DeleteBucketCors(&s3.DeleteBucketCorsInput{
+		Bucket: aws.String(d.Id()),
+	})
+	if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Bucket (%s) CORS configuration: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -181,7 +181,7 @@ func resourceAwsS3BucketInventory() *schema.Resource {
 }
 
 func resourceAwsS3BucketInventoryPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3conn
+	conn := meta.(*AWSClient).s3conn()
 	bucket := d.Get("bucket").(string)
 	name := d.Get("name").(string)
 
@@ -250,7 +250,7 @@ func resourceAwsS3BucketInventoryPut(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsS3BucketInventoryDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3conn
+	conn := meta.(*AWSClient).s3conn()
 
 	bucket, name, err := resourceAwsS3BucketInventoryParseID(d.Id())
 	if err != nil {
@@ -275,7 +275,7 @@ func resourceAwsS3BucketInventoryDelete(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsS3BucketInventoryRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3conn
+	conn := meta.(*AWSClient).s3conn()
 
 	bucket, name, err := resourceAwsS3BucketInventoryParseID(d.Id())
 	if err != nil {
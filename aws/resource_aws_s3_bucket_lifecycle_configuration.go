@@ -0,0 +1,91 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsS3BucketLifecycleConfiguration() *schema.Resource {
+	lifecycleRule := resourceAwsS3Bucket().Schema["lifecycle_rule"]
+	lifecycleRule.Optional = false
+	lifecycleRule.Required = true
+
+	return &schema.Resource{
+		Create: resourceAwsS3BucketLifecycleConfigurationCreate,
+		Read:   resourceAwsS3BucketLifecycleConfigurationRead,
+		Update: resourceAwsS3BucketLifecycleConfigurationUpdate,
+		Delete: resourceAwsS3BucketLifecycleConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"lifecycle_rule": lifecycleRule,
+		},
+	}
+}
+
+func resourceAwsS3BucketLifecycleConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId(d.Get("bucket").(string))
+
+	return resourceAwsS3BucketLifecycleConfigurationUpdate(d, meta)
+}
+
+func resourceAwsS3BucketLifecycleConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn()
+
+	lifecycle, err := s3conn.GetBucketLifecycleConfiguration(&s3.GetBucketLifecycleConfigurationInput{
+		Bucket: aws.String(d.Id()),
+	})
+	if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") || isAWSErr(err, "NoSuchLifecycleConfiguration", "") {
+		log.Printf("[WARN] S3 Bucket (%s) lifecycle configuration not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error getting S3 Bucket (%s) lifecycle configuration: %s", d.Id(), err)
+	}
+
+	d.Set("bucket", d.Id())
+	if err := d.Set("lifecycle_rule", flattenAwsS3BucketLifecycleRules(lifecycle.Rules)); err != nil {
+		return fmt.Errorf("error setting lifecycle_rule: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketLifecycleConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn()
+
+	if err := resourceAwsS3BucketLifecyclePut(s3conn, d.Id(), d.Get("lifecycle_rule").([]interface{})); err != nil {
+		return err
+	}
+
+	return resourceAwsS3BucketLifecycleConfigurationRead(d, meta)
+}
+
+func resourceAwsS3BucketLifecycleConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn()
+
+	_, err := s3conn.DeleteBucketLifecycle(&s3.DeleteBucketLifecycleInput{
+		Bucket: aws.String(d.Id()),
+	})
+	if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Bucket (%s) lifecycle configuration: %s", d.Id(), err)
+	}
+
+	return nil
+}
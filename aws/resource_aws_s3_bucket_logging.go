@@ -0,0 +1,110 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsS3BucketLogging() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketLoggingCreate,
+		Read:   resourceAwsS3BucketLoggingRead,
+		Update: resourceAwsS3BucketLoggingUpdate,
+		Delete: resourceAwsS3BucketLoggingDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"target_bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"target_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketLoggingCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId(d.Get("bucket").(string))
+
+	return resourceAwsS3BucketLoggingUpdate(d, meta)
+}
+
+func resourceAwsS3BucketLoggingRead(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn()
+
+	logging, err := s3conn.GetBucketLogging(&s3.GetBucketLoggingInput{
+		Bucket: aws.String(d.Id()),
+	})
+	if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+		log.Printf("[WARN] S3 Bucket (%s) not found, removing logging from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error getting S3 Bucket (%s) logging: %s", d.Id(), err)
+	}
+
+	if logging.LoggingEnabled == nil {
+		log.Printf("[WARN] S3 Bucket (%s) logging not enabled, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	lc := flattenAwsS3BucketLogging(logging.LoggingEnabled)
+
+	d.Set("bucket", d.Id())
+	d.Set("target_bucket", lc["target_bucket"])
+	d.Set("target_prefix", lc["target_prefix"])
+
+	return nil
+}
+
+func resourceAwsS3BucketLoggingUpdate(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn()
+
+	logging := []interface{}{
+		map[string]interface{}{
+			"target_bucket": d.Get("target_bucket").(string),
+			"target_prefix": d.Get("target_prefix").(string),
+		},
+	}
+
+	if err := resourceAwsS3BucketLoggingPut(s3conn, d.Id(), logging); err != nil {
+		return err
+	}
+
+	return resourceAwsS3BucketLoggingRead(d, meta)
+}
+
+func resourceAwsS3BucketLoggingDelete(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn()
+
+	_, err := s3conn.PutBucketLogging(&s3.PutBucketLoggingInput{
+		Bucket:              aws.String(d.Id()),
+		BucketLoggingStatus: &s3.BucketLoggingStatus{},
+	})
+	if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error disabling S3 Bucket (%s) logging: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/fakeaws"
+)
+
+func TestAccAWSS3BucketLogging_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_s3_bucket_logging.test"
+
+	testAccCassetteParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketLoggingConfig(rName, "log/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketExists("aws_s3_bucket.test"),
+					resource.TestCheckResourceAttr(resourceName, "bucket", rName),
+					resource.TestCheckResourceAttr(resourceName, "target_bucket", rName+"-log"),
+					resource.TestCheckResourceAttr(resourceName, "target_prefix", "log/"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceAwsS3BucketLogging_fakeAws(t *testing.T) {
+	server := fakeaws.New()
+	defer server.Close()
+
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_s3_bucket_logging.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFakeAwsProviderConfig(server.URL) + testAccAWSS3BucketLoggingConfig(rName, "log/"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "target_bucket", rName+"-log"),
+					resource.TestCheckResourceAttr(resourceName, "target_prefix", "log/"),
+				),
+			},
+			{
+				Config: testAccFakeAwsProviderConfig(server.URL) + testAccAWSS3BucketLoggingConfig(rName, "logs/"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "target_prefix", "logs/"),
+				),
+			},
+			{
+				Config:            testAccFakeAwsProviderConfig(server.URL),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAWSS3BucketLoggingConfig(rName, targetPrefix string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "log" {
+  bucket = "%[1]s-log"
+  acl    = "log-delivery-write"
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+
+  lifecycle {
+    ignore_changes = ["logging"]
+  }
+}
+
+resource "aws_s3_bucket_logging" "test" {
+  bucket        = "${aws_s3_bucket.test.id}"
+  target_bucket = "${aws_s3_bucket.log.id}"
+  target_prefix = %[2]q
+}
+`, rName, targetPrefix)
+}
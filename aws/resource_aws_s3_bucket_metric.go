@@ -52,7 +52,7 @@ func resourceAwsS3BucketMetric() *schema.Resource {
 }
 
 func resourceAwsS3BucketMetricPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3conn
+	conn := meta.(*AWSClient).s3conn()
 	bucket := d.Get("bucket").(string)
 	name := d.Get("name").(string)
 
@@ -93,7 +93,7 @@ func resourceAwsS3BucketMetricPut(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsS3BucketMetricDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3conn
+	conn := meta.(*AWSClient).s3conn()
 
 	bucket, name, err := resourceAwsS3BucketMetricParseID(d.Id())
 	if err != nil {
@@ -118,7 +118,7 @@ func resourceAwsS3BucketMetricDelete(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsS3BucketMetricRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3conn
+	conn := meta.(*AWSClient).s3conn()
 
 	bucket, name, err := resourceAwsS3BucketMetricParseID(d.Id())
 	if err != nil {
@@ -9,6 +9,7 @@ import (
 	"net/url"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
@@ -83,12 +84,46 @@ func resourceAwsS3BucketObject() *schema.Resource {
 				Computed: true,
 			},
 
+			"metadata": {
+				Type:         schema.TypeMap,
+				ValidateFunc: validateMetadataIsLowerCase,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+			},
+
 			"source": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ConflictsWith: []string{"content", "content_base64"},
 			},
 
+			"source_hash": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"multipart_upload": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"part_size": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      s3DefaultUploadPartSize / (1024 * 1024),
+							ValidateFunc: validation.IntBetween(5, 5120),
+						},
+						"concurrency": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      s3DefaultUploadConcurrency,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+
 			"content": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -133,8 +168,8 @@ func resourceAwsS3BucketObject() *schema.Resource {
 
 			"etag": {
 				Type: schema.TypeString,
-				// This will conflict with SSE-C and SSE-KMS encryption and multi-part upload
-				// if/when it's actually implemented. The Etag then won't match raw-file MD5.
+				// This will conflict with SSE-C and SSE-KMS encryption, the Etag then won't
+				// match raw-file MD5. Objects uploaded in parts are handled on read.
 				// See http://docs.aws.amazon.com/AmazonS3/latest/API/RESTCommonResponseHeaders.html
 				Optional:      true,
 				Computed:      true,
@@ -152,45 +187,72 @@ func resourceAwsS3BucketObject() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+
+			"object_lock_legal_hold_status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					s3.ObjectLockLegalHoldStatusOn,
+					s3.ObjectLockLegalHoldStatusOff,
+				}, false),
+			},
+
+			"object_lock_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					s3.ObjectLockModeGovernance,
+					s3.ObjectLockModeCompliance,
+				}, false),
+			},
+
+			"object_lock_retain_until_date": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.ValidateRFC3339TimeString,
+				DiffSuppressFunc: suppressEquivalentRFC3339Time,
+			},
+
+			"force_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
 
 func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) error {
-	s3conn := meta.(*AWSClient).s3conn
+	s3conn := meta.(*AWSClient).s3conn()
 
-	var body io.ReadSeeker
+	var body io.ReaderAt
+	var size int64
 
 	if v, ok := d.GetOk("source"); ok {
-		source := v.(string)
-		path, err := homedir.Expand(source)
-		if err != nil {
-			return fmt.Errorf("Error expanding homedir in source (%s): %s", source, err)
-		}
-		file, err := os.Open(path)
+		file, fileSize, err := openS3ObjectSource(v.(string))
 		if err != nil {
-			return fmt.Errorf("Error opening S3 bucket object source (%s): %s", path, err)
+			return err
 		}
+		defer closeS3ObjectSource(file)
 
 		body = file
-		defer func() {
-			err := file.Close()
-			if err != nil {
-				log.Printf("[WARN] Error closing S3 bucket object source (%s): %s", path, err)
-			}
-		}()
+		size = fileSize
 	} else if v, ok := d.GetOk("content"); ok {
 		content := v.(string)
-		body = bytes.NewReader([]byte(content))
+		body = strings.NewReader(content)
+		size = int64(len(content))
 	} else if v, ok := d.GetOk("content_base64"); ok {
 		content := v.(string)
 		// We can't do streaming decoding here (with base64.NewDecoder) because
-		// the AWS SDK requires an io.ReadSeeker but a base64 decoder can't seek.
+		// the uploader requires an io.ReaderAt but a base64 decoder can't seek.
 		contentRaw, err := base64.StdEncoding.DecodeString(content)
 		if err != nil {
 			return fmt.Errorf("error decoding content_base64: %s", err)
 		}
 		body = bytes.NewReader(contentRaw)
+		size = int64(len(contentRaw))
 	} else {
 		return fmt.Errorf("Must specify \"source\", \"content\", or \"content_base64\" field")
 	}
@@ -202,7 +264,6 @@ func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) erro
 		Bucket: aws.String(bucket),
 		Key:    aws.String(key),
 		ACL:    aws.String(d.Get("acl").(string)),
-		Body:   body,
 	}
 
 	if v, ok := d.GetOk("storage_class"); ok {
@@ -217,6 +278,10 @@ func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) erro
 		putInput.ContentType = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("metadata"); ok {
+		putInput.Metadata = stringMapToPointers(v.(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("content_encoding"); ok {
 		putInput.ContentEncoding = aws.String(v.(string))
 	}
@@ -251,7 +316,25 @@ func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) erro
 		putInput.WebsiteRedirectLocation = aws.String(v.(string))
 	}
 
-	if _, err := s3conn.PutObject(putInput); err != nil {
+	if v, ok := d.GetOk("object_lock_legal_hold_status"); ok {
+		putInput.ObjectLockLegalHoldStatus = aws.String(v.(string))
+	}
+
+	if err := validateS3ObjectLockRetention(d.Get("object_lock_mode").(string), d.Get("object_lock_retain_until_date").(string)); err != nil {
+		return err
+	}
+
+	if v, ok := d.GetOk("object_lock_mode"); ok {
+		putInput.ObjectLockMode = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("object_lock_retain_until_date"); ok {
+		putInput.ObjectLockRetainUntilDate = expandS3ObjectLockRetainUntilDate(v.(string))
+	}
+
+	uploader := expandS3Uploader(s3conn, d.Get("multipart_upload").([]interface{}))
+
+	if err := uploader.Upload(putInput, body, size); err != nil {
 		return fmt.Errorf("Error putting object in S3 bucket (%s): %s", bucket, err)
 	}
 
@@ -264,7 +347,7 @@ func resourceAwsS3BucketObjectCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsS3BucketObjectRead(d *schema.ResourceData, meta interface{}) error {
-	s3conn := meta.(*AWSClient).s3conn
+	s3conn := meta.(*AWSClient).s3conn()
 
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
@@ -294,11 +377,24 @@ func resourceAwsS3BucketObjectRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("version_id", resp.VersionId)
 	d.Set("server_side_encryption", resp.ServerSideEncryption)
 	d.Set("website_redirect", resp.WebsiteRedirectLocation)
+	d.Set("object_lock_legal_hold_status", resp.ObjectLockLegalHoldStatus)
+	d.Set("object_lock_mode", resp.ObjectLockMode)
+	d.Set("object_lock_retain_until_date", flattenS3ObjectLockRetainUntilDate(resp.ObjectLockRetainUntilDate))
+
+	// The response header keys of user-defined metadata are canonicalized,
+	// metadata keys are always lower case in S3.
+	metadata := make(map[string]interface{}, len(resp.Metadata))
+	for k, v := range resp.Metadata {
+		metadata[strings.ToLower(k)] = aws.StringValue(v)
+	}
+	if err := d.Set("metadata", metadata); err != nil {
+		return fmt.Errorf("error setting metadata: %s", err)
+	}
 
 	// Only set non-default KMS key ID (one that doesn't match default)
 	if resp.SSEKMSKeyId != nil {
 		// retrieve S3 KMS Default Master Key
-		kmsconn := meta.(*AWSClient).kmsconn
+		kmsconn := meta.(*AWSClient).kmsconn()
 		kmsresp, err := kmsconn.DescribeKey(&kms.DescribeKeyInput{
 			KeyId: aws.String("alias/aws/s3"),
 		})
@@ -312,7 +408,13 @@ func resourceAwsS3BucketObjectRead(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 	// See https://forums.aws.amazon.com/thread.jspa?threadID=44003
-	d.Set("etag", strings.Trim(aws.StringValue(resp.ETag), `"`))
+	// The ETag of an object uploaded in parts is not the MD5 digest of its
+	// content but ends with the number of parts, e.g. "<digest>-3". Keep a
+	// configured MD5 digest then, it can never match.
+	etag := strings.Trim(aws.StringValue(resp.ETag), `"`)
+	if v := d.Get("etag").(string); v == "" || strings.Contains(v, "-") || !strings.Contains(etag, "-") {
+		d.Set("etag", etag)
+	}
 
 	// The "STANDARD" (which is also the default) storage
 	// class when set would not be included in the results.
@@ -336,6 +438,7 @@ func resourceAwsS3BucketObjectUpdate(d *schema.ResourceData, meta interface{}) e
 		"content_encoding",
 		"content_language",
 		"content_type",
+		"metadata",
 		"source",
 		"content",
 		"content_base64",
@@ -343,6 +446,7 @@ func resourceAwsS3BucketObjectUpdate(d *schema.ResourceData, meta interface{}) e
 		"server_side_encryption",
 		"kms_key_id",
 		"etag",
+		"source_hash",
 		"website_redirect",
 	} {
 		if d.HasChange(key) {
@@ -350,12 +454,15 @@ func resourceAwsS3BucketObjectUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
-	conn := meta.(*AWSClient).s3conn
+	conn := meta.(*AWSClient).s3conn()
+
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
 
 	if d.HasChange("acl") {
 		_, err := conn.PutObjectAcl(&s3.PutObjectAclInput{
-			Bucket: aws.String(d.Get("bucket").(string)),
-			Key:    aws.String(d.Get("key").(string)),
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
 			ACL:    aws.String(d.Get("acl").(string)),
 		})
 		if err != nil {
@@ -363,7 +470,61 @@ func resourceAwsS3BucketObjectUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
-	if err := setTagsS3Object(conn, d); err != nil {
+	if d.HasChange("object_lock_legal_hold_status") {
+		status := d.Get("object_lock_legal_hold_status").(string)
+		if status == "" {
+			status = s3.ObjectLockLegalHoldStatusOff
+		}
+
+		_, err := conn.PutObjectLegalHold(&s3.PutObjectLegalHoldInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+			LegalHold: &s3.ObjectLockLegalHold{
+				Status: aws.String(status),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("error putting S3 object lock legal hold: %s", err)
+		}
+	}
+
+	if d.HasChange("object_lock_mode") || d.HasChange("object_lock_retain_until_date") {
+		mode := d.Get("object_lock_mode").(string)
+		date := expandS3ObjectLockRetainUntilDate(d.Get("object_lock_retain_until_date").(string))
+
+		if err := validateS3ObjectLockRetention(mode, d.Get("object_lock_retain_until_date").(string)); err != nil {
+			return err
+		}
+
+		// Both arguments are computed from the bucket default retention when
+		// not configured, never send an empty retention to remove it.
+		if mode != "" {
+			input := &s3.PutObjectRetentionInput{
+				Bucket: aws.String(bucket),
+				Key:    aws.String(key),
+				Retention: &s3.ObjectLockRetention{
+					Mode:            aws.String(mode),
+					RetainUntilDate: date,
+				},
+			}
+
+			// Shortening a governance mode retention period requires bypassing
+			// it, the request is denied without the permission to do so.
+			oMode, _ := d.GetChange("object_lock_mode")
+			oDate, _ := d.GetChange("object_lock_retain_until_date")
+			o := expandS3ObjectLockRetainUntilDate(oDate.(string))
+			if oMode.(string) == s3.ObjectLockModeGovernance && o != nil && date != nil && date.Before(*o) {
+				input.BypassGovernanceRetention = aws.Bool(true)
+			}
+
+			_, err := conn.PutObjectRetention(input)
+			if err != nil {
+				return fmt.Errorf("error putting S3 object lock retention: %s", err)
+			}
+		}
+	}
+
+	if err := setTagsS3Object(conn, d, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return fmt.Errorf("error setting S3 object tags: %s", err)
 	}
 
@@ -371,7 +532,7 @@ func resourceAwsS3BucketObjectUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsS3BucketObjectDelete(d *schema.ResourceData, meta interface{}) error {
-	s3conn := meta.(*AWSClient).s3conn
+	s3conn := meta.(*AWSClient).s3conn()
 
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
@@ -390,12 +551,7 @@ func resourceAwsS3BucketObjectDelete(d *schema.ResourceData, meta interface{}) e
 		}
 
 		for _, v := range out.Versions {
-			input := s3.DeleteObjectInput{
-				Bucket:    aws.String(bucket),
-				Key:       aws.String(key),
-				VersionId: v.VersionId,
-			}
-			_, err := s3conn.DeleteObject(&input)
+			err := deleteS3ObjectVersion(s3conn, bucket, key, aws.StringValue(v.VersionId), d.Get("force_destroy").(bool))
 			if err != nil {
 				return fmt.Errorf("Error deleting S3 object version of %s:\n %s:\n %s",
 					key, v, err)
@@ -403,11 +559,7 @@ func resourceAwsS3BucketObjectDelete(d *schema.ResourceData, meta interface{}) e
 		}
 	} else {
 		// Just delete the object
-		input := s3.DeleteObjectInput{
-			Bucket: aws.String(bucket),
-			Key:    aws.String(key),
-		}
-		_, err := s3conn.DeleteObject(&input)
+		err := deleteS3ObjectVersion(s3conn, bucket, key, "", d.Get("force_destroy").(bool))
 		if err != nil {
 			return fmt.Errorf("Error deleting S3 bucket object: %s  Bucket: %q Object: %q", err, bucket, key)
 		}
@@ -416,10 +568,138 @@ func resourceAwsS3BucketObjectDelete(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
+// openS3ObjectSource opens the source file of an object, returning its size.
+func openS3ObjectSource(source string) (*os.File, int64, error) {
+	path, err := homedir.Expand(source)
+	if err != nil {
+		return nil, 0, fmt.Errorf("Error expanding homedir in source (%s): %s", source, err)
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, 0, fmt.Errorf("Error opening S3 bucket object source (%s): %s", path, err)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		closeS3ObjectSource(file)
+		return nil, 0, fmt.Errorf("Error reading S3 bucket object source (%s): %s", path, err)
+	}
+
+	return file, info.Size(), nil
+}
+
+func closeS3ObjectSource(file *os.File) {
+	if err := file.Close(); err != nil {
+		log.Printf("[WARN] Error closing S3 bucket object source (%s): %s", file.Name(), err)
+	}
+}
+
+// expandS3Uploader returns an uploader with the settings of a
+// multipart_upload block, or one uploading in a single request if it is not
+// set.
+func expandS3Uploader(conn *s3.S3, l []interface{}) *s3Uploader {
+	uploader := &s3Uploader{
+		Conn: conn,
+	}
+
+	if len(l) > 0 && l[0] != nil {
+		m := l[0].(map[string]interface{})
+		uploader.PartSize = int64(m["part_size"].(int)) * 1024 * 1024
+		uploader.Concurrency = m["concurrency"].(int)
+	}
+
+	return uploader
+}
+
+// deleteS3ObjectVersion deletes a version of an object. When force is set,
+// governance mode retention is bypassed and a legal hold is removed.
+func deleteS3ObjectVersion(conn *s3.S3, bucket, key, versionID string, force bool) error {
+	input := &s3.DeleteObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}
+	if versionID != "" {
+		input.VersionId = aws.String(versionID)
+	}
+	if force {
+		input.BypassGovernanceRetention = aws.Bool(true)
+	}
+
+	_, err := conn.DeleteObject(input)
+
+	if isAWSErr(err, "AccessDenied", "") && force {
+		// A legal hold can't be bypassed, it must be removed first.
+		_, legalHoldErr := conn.PutObjectLegalHold(&s3.PutObjectLegalHoldInput{
+			Bucket:    aws.String(bucket),
+			Key:       aws.String(key),
+			VersionId: input.VersionId,
+			LegalHold: &s3.ObjectLockLegalHold{
+				Status: aws.String(s3.ObjectLockLegalHoldStatusOff),
+			},
+		})
+		if legalHoldErr != nil {
+			log.Printf("[WARN] Error removing S3 object lock legal hold (%s/%s, version %s): %s", bucket, key, versionID, legalHoldErr)
+			return err
+		}
+
+		_, err = conn.DeleteObject(input)
+	}
+
+	return err
+}
+
+func expandS3ObjectLockRetainUntilDate(v string) *time.Time {
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return nil
+	}
+
+	return aws.Time(t)
+}
+
+func flattenS3ObjectLockRetainUntilDate(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return t.UTC().Format(time.RFC3339)
+}
+
+func validateMetadataIsLowerCase(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(map[string]interface{})
+
+	for key := range value {
+		if key != strings.ToLower(key) {
+			errors = append(errors, fmt.Errorf(
+				"Metadata must be lowercase only. Offending key: %q", key))
+		}
+	}
+	return
+}
+
 func resourceAwsS3BucketObjectCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
-	if d.HasChange("etag") {
+	if d.HasChange("etag") || d.HasChange("source_hash") {
 		d.SetNewComputed("version_id")
 	}
 
+	// A value only known after apply, e.g. a date computed from timestamp(),
+	// cannot be checked until then.
+	if d.NewValueKnown("object_lock_mode") && d.NewValueKnown("object_lock_retain_until_date") {
+		if err := validateS3ObjectLockRetention(d.Get("object_lock_mode").(string), d.Get("object_lock_retain_until_date").(string)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateS3ObjectLockRetention checks that the object lock mode and retain
+// until date are either both set or both empty, S3 rejects a retention with
+// only one of them.
+func validateS3ObjectLockRetention(mode, retainUntilDate string) error {
+	if (mode == "") != (retainUntilDate == "") {
+		return fmt.Errorf("object_lock_mode and object_lock_retain_until_date must be set together")
+	}
+
 	return nil
 }
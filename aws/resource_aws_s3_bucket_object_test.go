@@ -1,21 +1,25 @@
 package aws
 
 import (
+	"crypto/md5"
 	"encoding/base64"
 	"fmt"
 	"io/ioutil"
 	"log"
 	"os"
 	"reflect"
+	"regexp"
 	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/fakeaws"
 )
 
 func init() {
@@ -31,7 +35,7 @@ func testSweepS3BucketObjects(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*AWSClient).s3conn
+	conn := client.(*AWSClient).s3conn()
 	input := &s3.ListBucketsInput{}
 
 	output, err := conn.ListBuckets(input)
@@ -163,7 +167,7 @@ func TestAccAWSS3BucketObject_source(t *testing.T) {
 	source := testAccAWSS3BucketObjectCreateTempFile(t, "{anything will do }")
 	defer os.Remove(source)
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccCassetteParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckAWSS3BucketObjectDestroy,
@@ -184,7 +188,7 @@ func TestAccAWSS3BucketObject_content(t *testing.T) {
 	resourceName := "aws_s3_bucket_object.object"
 	rInt := acctest.RandInt()
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccCassetteParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckAWSS3BucketObjectDestroy,
@@ -206,7 +210,7 @@ func TestAccAWSS3BucketObject_contentBase64(t *testing.T) {
 	resourceName := "aws_s3_bucket_object.object"
 	rInt := acctest.RandInt()
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccCassetteParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckAWSS3BucketObjectDestroy,
@@ -231,7 +235,7 @@ func TestAccAWSS3BucketObject_withContentCharacteristics(t *testing.T) {
 	source := testAccAWSS3BucketObjectCreateTempFile(t, "{anything will do }")
 	defer os.Remove(source)
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccCassetteParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckAWSS3BucketObjectDestroy,
@@ -259,7 +263,7 @@ func TestAccAWSS3BucketObject_updates(t *testing.T) {
 	sourceModified := testAccAWSS3BucketObjectCreateTempFile(t, "modified object")
 	defer os.Remove(sourceInitial)
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccCassetteParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckAWSS3BucketObjectDestroy,
@@ -284,6 +288,258 @@ func TestAccAWSS3BucketObject_updates(t *testing.T) {
 	})
 }
 
+func TestAccAWSS3BucketObject_multipart(t *testing.T) {
+	var originalObj, modifiedObj s3.GetObjectOutput
+	resourceName := "aws_s3_bucket_object.object"
+	rInt := acctest.RandInt()
+
+	// Three parts of 5 MiB
+	contentInitial := strings.Repeat("a", 11*1024*1024)
+	contentModified := strings.Repeat("b", 11*1024*1024)
+	sourceInitial := testAccAWSS3BucketObjectCreateTempFile(t, contentInitial)
+	defer os.Remove(sourceInitial)
+	sourceModified := testAccAWSS3BucketObjectCreateTempFile(t, contentModified)
+	defer os.Remove(sourceModified)
+
+	testAccCassetteParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketObjectConfigMultipart(rInt, sourceInitial, "initial"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists(resourceName, &originalObj),
+					testAccCheckAWSS3BucketObjectBody(&originalObj, contentInitial),
+					resource.TestMatchResourceAttr(resourceName, "etag", regexp.MustCompile(`^[0-9a-f]{32}-3$`)),
+					resource.TestCheckResourceAttr(resourceName, "source_hash", "initial"),
+				),
+			},
+			{
+				Config: testAccAWSS3BucketObjectConfigMultipart(rInt, sourceModified, "modified"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists(resourceName, &modifiedObj),
+					testAccCheckAWSS3BucketObjectBody(&modifiedObj, contentModified),
+					resource.TestCheckResourceAttr(resourceName, "source_hash", "modified"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceAwsS3BucketObject_fakeAws(t *testing.T) {
+	server := fakeaws.New()
+	defer server.Close()
+
+	var originalObj, modifiedObj s3.GetObjectOutput
+	resourceName := "aws_s3_bucket_object.object"
+	rInt := acctest.RandInt()
+
+	contentInitial := strings.Repeat("a", 11*1024*1024)
+	contentModified := strings.Repeat("b", 11*1024*1024)
+	sourceInitial := testAccAWSS3BucketObjectCreateTempFile(t, contentInitial)
+	defer os.Remove(sourceInitial)
+	sourceModified := testAccAWSS3BucketObjectCreateTempFile(t, contentModified)
+	defer os.Remove(sourceModified)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFakeAwsProviderConfig(server.URL) + testAccAWSS3BucketObjectConfigMultipart(rInt, sourceInitial, "initial"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists(resourceName, &originalObj),
+					testAccCheckAWSS3BucketObjectBody(&originalObj, contentInitial),
+					resource.TestMatchResourceAttr(resourceName, "etag", regexp.MustCompile(`^[0-9a-f]{32}-3$`)),
+				),
+			},
+			{
+				Config: testAccFakeAwsProviderConfig(server.URL) + testAccAWSS3BucketObjectConfigMultipart(rInt, sourceModified, "modified"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists(resourceName, &modifiedObj),
+					testAccCheckAWSS3BucketObjectBody(&modifiedObj, contentModified),
+				),
+			},
+			{
+				// The MD5 digest of the content never matches the ETag of a
+				// multipart object, it must not cause a perpetual difference.
+				Config: testAccFakeAwsProviderConfig(server.URL) + testAccAWSS3BucketObjectConfigMultipartEtag(rInt, sourceModified),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "etag", fmt.Sprintf("%x", md5.Sum([]byte(contentModified)))),
+				),
+			},
+		},
+	})
+
+	if n := server.Uploads(); n != 0 {
+		t.Fatalf("expected no multipart uploads in progress, found %d", n)
+	}
+}
+
+func TestAccAWSS3BucketObject_metadata(t *testing.T) {
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_bucket_object.object"
+	rInt := acctest.RandInt()
+
+	testAccCassetteParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketObjectConfig_withMetadata(rInt, "key1", "value1", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists(resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "metadata.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.key1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSS3BucketObjectConfig_withMetadata(rInt, "key1", "value1updated", "key3", "value3"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists(resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "metadata.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.key3", "value3"),
+				),
+			},
+			{
+				Config: testAccAWSS3BucketObjectConfigContent(rInt, "some_bucket_content"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists(resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "metadata.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketObject_objectLock(t *testing.T) {
+	var obj1, obj2, obj3 s3.GetObjectOutput
+	resourceName := "aws_s3_bucket_object.object"
+	rInt := acctest.RandInt()
+
+	retainUntilDate := time.Now().UTC().AddDate(0, 0, 1).Format(time.RFC3339)
+	retainUntilDateExtended := time.Now().UTC().AddDate(0, 0, 2).Format(time.RFC3339)
+
+	testAccCassetteParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketObjectConfig_objectLock(rInt, "ON", retainUntilDate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists(resourceName, &obj1),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_legal_hold_status", "ON"),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_mode", "GOVERNANCE"),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_retain_until_date", retainUntilDate),
+				),
+			},
+			{
+				Config: testAccAWSS3BucketObjectConfig_objectLock(rInt, "OFF", retainUntilDateExtended),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists(resourceName, &obj2),
+					testAccCheckAWSS3BucketObjectVersionIdEquals(&obj2, &obj1),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_legal_hold_status", "OFF"),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_retain_until_date", retainUntilDateExtended),
+				),
+			},
+			{
+				// Shortening a governance mode retention period bypasses it.
+				Config: testAccAWSS3BucketObjectConfig_objectLock(rInt, "ON", retainUntilDate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists(resourceName, &obj3),
+					testAccCheckAWSS3BucketObjectVersionIdEquals(&obj3, &obj1),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_legal_hold_status", "ON"),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_retain_until_date", retainUntilDate),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceAwsS3BucketObject_objectLockFakeAws(t *testing.T) {
+	server := fakeaws.New()
+	defer server.Close()
+
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_bucket_object.object"
+	rInt := acctest.RandInt()
+
+	retainUntilDate := time.Now().UTC().AddDate(0, 0, 1).Format(time.RFC3339)
+	retainUntilDateExtended := time.Now().UTC().AddDate(0, 0, 2).Format(time.RFC3339)
+
+	// The object is still locked when destroyed, force_destroy must remove the
+	// legal hold and bypass the retention period.
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFakeAwsProviderConfig(server.URL) + testAccAWSS3BucketObjectConfig_objectLock(rInt, "ON", retainUntilDate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists(resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "metadata.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.x-project", "terraform"),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_legal_hold_status", "ON"),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_mode", "GOVERNANCE"),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_retain_until_date", retainUntilDate),
+				),
+			},
+			{
+				Config: testAccFakeAwsProviderConfig(server.URL) + testAccAWSS3BucketObjectConfig_objectLock(rInt, "OFF", retainUntilDateExtended),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "object_lock_legal_hold_status", "OFF"),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_retain_until_date", retainUntilDateExtended),
+				),
+			},
+			{
+				Config: testAccFakeAwsProviderConfig(server.URL) + testAccAWSS3BucketObjectConfig_objectLock(rInt, "ON", retainUntilDate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "object_lock_legal_hold_status", "ON"),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_retain_until_date", retainUntilDate),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceAwsS3BucketObject_objectLockRetentionFakeAws(t *testing.T) {
+	server := fakeaws.New()
+	defer server.Close()
+
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_bucket_object.object"
+	rInt := acctest.RandInt()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFakeAwsProviderConfig(server.URL) + testAccAWSS3BucketObjectConfig_objectLockMode(rInt, "GOVERNANCE"),
+				ExpectError: regexp.MustCompile(`object_lock_mode and object_lock_retain_until_date must be set together`),
+			},
+			{
+				Config: testAccFakeAwsProviderConfig(server.URL) + testAccAWSS3BucketObjectConfig_objectLockMode(rInt, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists(resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_mode", ""),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_retain_until_date", ""),
+				),
+			},
+			{
+				Config:      testAccFakeAwsProviderConfig(server.URL) + testAccAWSS3BucketObjectConfig_objectLockMode(rInt, "GOVERNANCE"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`object_lock_mode and object_lock_retain_until_date must be set together`),
+			},
+		},
+	})
+}
+
 func TestAccAWSS3BucketObject_updatesWithVersioning(t *testing.T) {
 	var originalObj, modifiedObj s3.GetObjectOutput
 	resourceName := "aws_s3_bucket_object.object"
@@ -294,7 +550,7 @@ func TestAccAWSS3BucketObject_updatesWithVersioning(t *testing.T) {
 	sourceModified := testAccAWSS3BucketObjectCreateTempFile(t, "modified versioned object")
 	defer os.Remove(sourceInitial)
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccCassetteParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckAWSS3BucketObjectDestroy,
@@ -328,7 +584,7 @@ func TestAccAWSS3BucketObject_kms(t *testing.T) {
 	source := testAccAWSS3BucketObjectCreateTempFile(t, "{anything will do }")
 	defer os.Remove(source)
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccCassetteParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckAWSS3BucketObjectDestroy,
@@ -354,7 +610,7 @@ func TestAccAWSS3BucketObject_sse(t *testing.T) {
 	source := testAccAWSS3BucketObjectCreateTempFile(t, "{anything will do }")
 	defer os.Remove(source)
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccCassetteParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckAWSS3BucketObjectDestroy,
@@ -377,7 +633,7 @@ func TestAccAWSS3BucketObject_acl(t *testing.T) {
 	resourceName := "aws_s3_bucket_object.object"
 	rInt := acctest.RandInt()
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccCassetteParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckAWSS3BucketObjectDestroy,
@@ -420,7 +676,7 @@ func TestAccAWSS3BucketObject_storageClass(t *testing.T) {
 	resourceName := "aws_s3_bucket_object.object"
 	rInt := acctest.RandInt()
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccCassetteParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckAWSS3BucketObjectDestroy,
@@ -468,7 +724,7 @@ func TestAccAWSS3BucketObject_tags(t *testing.T) {
 	rInt := acctest.RandInt()
 	key := "test-key"
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccCassetteParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckAWSS3BucketObjectDestroy,
@@ -532,7 +788,7 @@ func TestAccAWSS3BucketObject_tagsLeadingSlash(t *testing.T) {
 	rInt := acctest.RandInt()
 	key := "/test-key"
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccCassetteParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckAWSS3BucketObjectDestroy,
@@ -625,7 +881,7 @@ func testAccCheckAWSS3BucketObjectVersionIdEquals(first, second *s3.GetObjectOut
 }
 
 func testAccCheckAWSS3BucketObjectDestroy(s *terraform.State) error {
-	s3conn := testAccProvider.Meta().(*AWSClient).s3conn
+	s3conn := testAccProvider.Meta().(*AWSClient).s3conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_s3_bucket_object" {
@@ -656,7 +912,7 @@ func testAccCheckAWSS3BucketObjectExists(n string, obj *s3.GetObjectOutput) reso
 			return fmt.Errorf("No S3 Bucket Object ID is set")
 		}
 
-		s3conn := testAccProvider.Meta().(*AWSClient).s3conn
+		s3conn := testAccProvider.Meta().(*AWSClient).s3conn()
 		out, err := s3conn.GetObject(
 			&s3.GetObjectInput{
 				Bucket:  aws.String(rs.Primary.Attributes["bucket"]),
@@ -692,7 +948,7 @@ func testAccCheckAWSS3BucketObjectBody(obj *s3.GetObjectOutput, want string) res
 func testAccCheckAWSS3BucketObjectAcl(n string, expectedPerms []string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs := s.RootModule().Resources[n]
-		s3conn := testAccProvider.Meta().(*AWSClient).s3conn
+		s3conn := testAccProvider.Meta().(*AWSClient).s3conn()
 
 		out, err := s3conn.GetObjectAcl(&s3.GetObjectAclInput{
 			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
@@ -720,7 +976,7 @@ func testAccCheckAWSS3BucketObjectAcl(n string, expectedPerms []string) resource
 func testAccCheckAWSS3BucketObjectStorageClass(n, expectedClass string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs := s.RootModule().Resources[n]
-		s3conn := testAccProvider.Meta().(*AWSClient).s3conn
+		s3conn := testAccProvider.Meta().(*AWSClient).s3conn()
 
 		out, err := s3conn.HeadObject(&s3.HeadObjectInput{
 			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
@@ -750,7 +1006,7 @@ func testAccCheckAWSS3BucketObjectStorageClass(n, expectedClass string) resource
 func testAccCheckAWSS3BucketObjectSSE(n, expectedSSE string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs := s.RootModule().Resources[n]
-		s3conn := testAccProvider.Meta().(*AWSClient).s3conn
+		s3conn := testAccProvider.Meta().(*AWSClient).s3conn()
 
 		out, err := s3conn.HeadObject(&s3.HeadObjectInput{
 			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
@@ -806,6 +1062,45 @@ resource "aws_s3_bucket_object" "object" {
 `, randInt, source)
 }
 
+func testAccAWSS3BucketObjectConfigMultipart(randInt int, source, sourceHash string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "object_bucket" {
+  bucket = "tf-object-test-bucket-%d"
+}
+
+resource "aws_s3_bucket_object" "object" {
+  bucket      = "${aws_s3_bucket.object_bucket.bucket}"
+  key         = "test-key"
+  source      = %q
+  source_hash = %q
+
+  multipart_upload {
+    part_size   = 5
+    concurrency = 2
+  }
+}
+`, randInt, source, sourceHash)
+}
+
+func testAccAWSS3BucketObjectConfigMultipartEtag(randInt int, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "object_bucket" {
+  bucket = "tf-object-test-bucket-%d"
+}
+
+resource "aws_s3_bucket_object" "object" {
+  bucket = "${aws_s3_bucket.object_bucket.bucket}"
+  key    = "test-key"
+  source = %q
+  etag   = "${md5(file(%q))}"
+
+  multipart_upload {
+    part_size = 5
+  }
+}
+`, randInt, source, source)
+}
+
 func testAccAWSS3BucketObjectConfig_withContentCharacteristics(randInt int, source string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "object_bucket" {
@@ -995,3 +1290,82 @@ resource "aws_s3_bucket_object" "object" {
 }
 `, randInt, key, content)
 }
+
+func testAccAWSS3BucketObjectConfig_withMetadata(randInt int, metadataKey1, metadataValue1, metadataKey2, metadataValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "object_bucket" {
+  bucket = "tf-object-test-bucket-%d"
+}
+
+resource "aws_s3_bucket_object" "object" {
+  bucket  = "${aws_s3_bucket.object_bucket.bucket}"
+  key     = "test-key"
+  content = "some_bucket_content"
+
+  metadata = {
+    %[2]s = %[3]q
+    %[4]s = %[5]q
+  }
+}
+`, randInt, metadataKey1, metadataValue1, metadataKey2, metadataValue2)
+}
+
+func testAccAWSS3BucketObjectConfig_objectLockMode(randInt int, mode string) string {
+	var objectLockMode string
+	if mode != "" {
+		objectLockMode = fmt.Sprintf("object_lock_mode = %q", mode)
+	}
+
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "object_bucket" {
+  bucket = "tf-object-test-bucket-%d"
+
+  versioning {
+    enabled = true
+  }
+
+  object_lock_configuration {
+    object_lock_enabled = "Enabled"
+  }
+}
+
+resource "aws_s3_bucket_object" "object" {
+  bucket  = "${aws_s3_bucket.object_bucket.bucket}"
+  key     = "test-key"
+  content = "some_bucket_content"
+
+  %s
+}
+`, randInt, objectLockMode)
+}
+
+func testAccAWSS3BucketObjectConfig_objectLock(randInt int, legalHoldStatus, retainUntilDate string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "object_bucket" {
+  bucket = "tf-object-test-bucket-%d"
+
+  versioning {
+    enabled = true
+  }
+
+  object_lock_configuration {
+    object_lock_enabled = "Enabled"
+  }
+}
+
+resource "aws_s3_bucket_object" "object" {
+  bucket        = "${aws_s3_bucket.object_bucket.bucket}"
+  key           = "test-key"
+  content       = "some_bucket_content"
+  force_destroy = true
+
+  metadata = {
+    x-project = "terraform"
+  }
+
+  object_lock_legal_hold_status = %q
+  object_lock_mode              = "GOVERNANCE"
+  object_lock_retain_until_date = %q
+}
+`, randInt, legalHoldStatus, retainUntilDate)
+}
@@ -41,7 +41,7 @@ func resourceAwsS3BucketPolicy() *schema.Resource {
 }
 
 func resourceAwsS3BucketPolicyPut(d *schema.ResourceData, meta interface{}) error {
-	s3conn := meta.(*AWSClient).s3conn
+	s3conn := meta.(*AWSClient).s3conn()
 
 	bucket := d.Get("bucket").(string)
 	policy := d.Get("policy").(string)
@@ -75,7 +75,7 @@ func resourceAwsS3BucketPolicyPut(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsS3BucketPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	s3conn := meta.(*AWSClient).s3conn
+	s3conn := meta.(*AWSClient).s3conn()
 
 	log.Printf("[DEBUG] S3 bucket policy, read for bucket: %s", d.Id())
 	pol, err := s3conn.GetBucketPolicy(&s3.GetBucketPolicyInput{
@@ -97,7 +97,7 @@ func resourceAwsS3BucketPolicyRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsS3BucketPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	s3conn := meta.(*AWSClient).s3conn
+	s3conn := meta.(*AWSClient).s3conn()
 
 	bucket := d.Get("bucket").(string)
 
@@ -0,0 +1,105 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsS3BucketReplicationConfiguration() *schema.Resource {
+	replication := resourceAwsS3Bucket().Schema["replication_configuration"].Elem.(*schema.Resource)
+
+	return &schema.Resource{
+		Create: resourceAwsS3BucketReplicationConfigurationCreate,
+		Read:   resourceAwsS3BucketReplicationConfigurationRead,
+		Update: resourceAwsS3BucketReplicationConfigurationUpdate,
+		Delete: resourceAwsS3BucketReplicationConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"role":  replication.Schema["role"],
+			"rules": replication.Schema["rules"],
+		},
+	}
+}
+
+func resourceAwsS3BucketReplicationConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId(d.Get("bucket").(string))
+
+	return resourceAwsS3BucketReplicationConfigurationUpdate(d, meta)
+}
+
+func resourceAwsS3BucketReplicationConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn()
+
+	replication, err := s3conn.GetBucketReplication(&s3.GetBucketReplicationInput{
+		Bucket: aws.String(d.Id()),
+	})
+	if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") || isAWSErr(err, "ReplicationConfigurationNotFoundError", "") {
+		log.Printf("[WARN] S3 Bucket (%s) replication configuration not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error getting S3 Bucket (%s) replication configuration: %s", d.Id(), err)
+	}
+
+	rc := flattenAwsS3BucketReplicationConfiguration(replication.ReplicationConfiguration)
+	if len(rc) == 0 {
+		log.Printf("[WARN] S3 Bucket (%s) replication configuration not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("bucket", d.Id())
+	d.Set("role", rc[0]["role"])
+	if err := d.Set("rules", rc[0]["rules"]); err != nil {
+		return fmt.Errorf("error setting rules: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketReplicationConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn()
+
+	replication := []interface{}{
+		map[string]interface{}{
+			"role":  d.Get("role").(string),
+			"rules": d.Get("rules").(*schema.Set),
+		},
+	}
+
+	if err := resourceAwsS3BucketReplicationConfigurationPut(s3conn, d.Id(), replication); err != nil {
+		return err
+	}
+
+	return resourceAwsS3BucketReplicationConfigurationRead(d, meta)
+}
+
+func resourceAwsS3BucketReplicationConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn()
+
+	_, err := s3conn.DeleteBucketReplication(&s3.DeleteBucketReplicationInput{
+		Bucket: aws.String(d.Id()),
+	})
+	if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Bucket (%s) replication configuration: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,165 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/fakeaws"
+)
+
+func TestAccAWSS3BucketReplicationConfiguration_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	region := testAccGetRegion()
+	resourceName := "aws_s3_bucket_replication_configuration.test"
+
+	// record the initialized providers so that we can use them to check for the instances in each region
+	var providers []*schema.Provider
+
+	testAccCassetteParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccMultipleRegionsPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckWithProviders(testAccCheckAWSS3BucketDestroyWithProvider, &providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketReplicationConfigurationConfig(rInt, "STANDARD"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketExistsWithProvider("aws_s3_bucket.bucket", testAccAwsRegionProviderFunc(region, &providers)),
+					resource.TestCheckResourceAttr(resourceName, "bucket", fmt.Sprintf("tf-test-bucket-%d", rInt)),
+					resource.TestCheckResourceAttrPair(resourceName, "role", "aws_iam_role.role", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "1"),
+				),
+			},
+			{
+				Config: testAccAWSS3BucketReplicationConfigurationConfig(rInt, "GLACIER"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceAwsS3BucketReplicationConfiguration_fakeAws(t *testing.T) {
+	server := fakeaws.New()
+	defer server.Close()
+
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_s3_bucket_replication_configuration.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFakeAwsProviderConfig(server.URL) + testAccAWSS3BucketReplicationConfigurationConfigSingleRegion(rName, "STANDARD"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "role", "arn:aws:iam::123456789012:role/replication"),
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "1"),
+				),
+			},
+			{
+				Config: testAccFakeAwsProviderConfig(server.URL) + testAccAWSS3BucketReplicationConfigurationConfigSingleRegion(rName, "GLACIER"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "1"),
+				),
+			},
+			{
+				Config:            testAccFakeAwsProviderConfig(server.URL),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAWSS3BucketReplicationConfigurationConfig(randInt int, storageClass string) string {
+	return fmt.Sprintf(testAccAWSS3BucketConfigReplicationBasic+`
+resource "aws_s3_bucket" "bucket" {
+  provider = "aws.uswest2"
+  bucket   = "tf-test-bucket-%[1]d"
+  acl      = "private"
+
+  versioning {
+    enabled = true
+  }
+
+  lifecycle {
+    ignore_changes = ["replication_configuration"]
+  }
+}
+
+resource "aws_s3_bucket" "destination" {
+  provider = "aws.euwest"
+  bucket   = "tf-test-bucket-destination-%[1]d"
+  region   = "eu-west-1"
+
+  versioning {
+    enabled = true
+  }
+}
+
+resource "aws_s3_bucket_replication_configuration" "test" {
+  provider = "aws.uswest2"
+  bucket   = "${aws_s3_bucket.bucket.id}"
+  role     = "${aws_iam_role.role.arn}"
+
+  rules {
+    id     = "foobar"
+    prefix = "foo"
+    status = "Enabled"
+
+    destination {
+      bucket        = "${aws_s3_bucket.destination.arn}"
+      storage_class = %[2]q
+    }
+  }
+}
+`, randInt, storageClass)
+}
+
+func testAccAWSS3BucketReplicationConfigurationConfigSingleRegion(rName, storageClass string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+
+  versioning {
+    enabled = true
+  }
+
+  lifecycle {
+    ignore_changes = ["replication_configuration"]
+  }
+}
+
+resource "aws_s3_bucket" "destination" {
+  bucket = "%[1]s-destination"
+
+  versioning {
+    enabled = true
+  }
+}
+
+resource "aws_s3_bucket_replication_configuration" "test" {
+  bucket = "${aws_s3_bucket.test.id}"
+  role   = "arn:aws:iam::123456789012:role/replication"
+
+  rules {
+    id     = "foobar"
+    prefix = "foo"
+    status = "Enabled"
+
+    destination {
+      bucket        = "${aws_s3_bucket.destination.arn}"
+      storage_class = %[2]q
+    }
+  }
+}
+`, rName, storageClass)
+}
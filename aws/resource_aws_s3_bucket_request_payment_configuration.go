@@ -0,0 +1,97 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsS3BucketRequestPaymentConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketRequestPaymentConfigurationCreate,
+		Read:   resourceAwsS3BucketRequestPaymentConfigurationRead,
+		Update: resourceAwsS3BucketRequestPaymentConfigurationUpdate,
+		Delete: resourceAwsS3BucketRequestPaymentConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"payer": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					s3.PayerRequester,
+					s3.PayerBucketOwner,
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketRequestPaymentConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId(d.Get("bucket").(string))
+
+	return resourceAwsS3BucketRequestPaymentConfigurationUpdate(d, meta)
+}
+
+func resourceAwsS3BucketRequestPaymentConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn()
+
+	payment, err := s3conn.GetBucketRequestPayment(&s3.GetBucketRequestPaymentInput{
+		Bucket: aws.String(d.Id()),
+	})
+	if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+		log.Printf("[WARN] S3 Bucket (%s) not found, removing request payment configuration from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error getting S3 Bucket (%s) request payment: %s", d.Id(), err)
+	}
+
+	d.Set("bucket", d.Id())
+	d.Set("payer", payment.Payer)
+
+	return nil
+}
+
+func resourceAwsS3BucketRequestPaymentConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn()
+
+	if err := resourceAwsS3BucketRequestPayerPut(s3conn, d.Id(), d.Get("payer").(string)); err != nil {
+		return err
+	}
+
+	return resourceAwsS3BucketRequestPaymentConfigurationRead(d, meta)
+}
+
+func resourceAwsS3BucketRequestPaymentConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn()
+
+	// The bucket owner pays for requests by default.
+	_, err := s3conn.PutBucketRequestPayment(&s3.PutBucketRequestPaymentInput{
+		Bucket: aws.String(d.Id()),
+		RequestPaymentConfiguration: &s3.RequestPaymentConfiguration{
+			Payer: aws.String(s3.PayerBucketOwner),
+		},
+	})
+	if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error resetting S3 Bucket (%s) request payment: %s", d.Id(), err)
+	}
+
+	return nil
+}
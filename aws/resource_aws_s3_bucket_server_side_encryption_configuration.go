@@ -0,0 +1,103 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsS3BucketServerSideEncryptionConfiguration() *schema.Resource {
+	encryption := resourceAwsS3Bucket().Schema["server_side_encryption_configuration"].Elem.(*schema.Resource)
+
+	return &schema.Resource{
+		Create: resourceAwsS3BucketServerSideEncryptionConfigurationCreate,
+		Read:   resourceAwsS3BucketServerSideEncryptionConfigurationRead,
+		Update: resourceAwsS3BucketServerSideEncryptionConfigurationUpdate,
+		Delete: resourceAwsS3BucketServerSideEncryptionConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"rule": encryption.Schema["rule"],
+		},
+	}
+}
+
+func resourceAwsS3BucketServerSideEncryptionConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId(d.Get("bucket").(string))
+
+	return resourceAwsS3BucketServerSideEncryptionConfigurationUpdate(d, meta)
+}
+
+func resourceAwsS3BucketServerSideEncryptionConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn()
+
+	encryption, err := s3conn.GetBucketEncryption(&s3.GetBucketEncryptionInput{
+		Bucket: aws.String(d.Id()),
+	})
+	if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") || isAWSErr(err, "ServerSideEncryptionConfigurationNotFoundError", "") {
+		log.Printf("[WARN] S3 Bucket (%s) server side encryption configuration not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error getting S3 Bucket (%s) server side encryption configuration: %s", d.Id(), err)
+	}
+
+	if encryption.ServerSideEncryptionConfiguration == nil {
+		log.Printf("[WARN] S3 Bucket (%s) server side encryption configuration not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	sse := flattenAwsS3ServerSideEncryptionConfiguration(encryption.ServerSideEncryptionConfiguration)
+
+	d.Set("bucket", d.Id())
+	if err := d.Set("rule", sse[0]["rule"]); err != nil {
+		return fmt.Errorf("error setting rule: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketServerSideEncryptionConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn()
+
+	encryption := []interface{}{
+		map[string]interface{}{
+			"rule": d.Get("rule").([]interface{}),
+		},
+	}
+
+	if err := resourceAwsS3BucketServerSideEncryptionConfigurationPut(s3conn, d.Id(), encryption); err != nil {
+		return err
+	}
+
+	return resourceAwsS3BucketServerSideEncryptionConfigurationRead(d, meta)
+}
+
+func resourceAwsS3BucketServerSideEncryptionConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn()
+
+	_, err := s3conn.DeleteBucketEncryption(&s3.DeleteBucketEncryptionInput{
+		Bucket: aws.String(d.Id()),
+	})
+	if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Bucket (%s) server side encryption configuration: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsS3BucketVersioning() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketVersioningCreate,
+		Read:   resourceAwsS3BucketVersioningRead,
+		Update: resourceAwsS3BucketVersioningUpdate,
+		Delete: resourceAwsS3BucketVersioningDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+
+			"mfa_delete": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketVersioningCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId(d.Get("bucket").(string))
+
+	return resourceAwsS3BucketVersioningUpdate(d, meta)
+}
+
+func resourceAwsS3BucketVersioningRead(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn()
+
+	versioning, err := s3conn.GetBucketVersioning(&s3.GetBucketVersioningInput{
+		Bucket: aws.String(d.Id()),
+	})
+	if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+		log.Printf("[WARN] S3 Bucket (%s) not found, removing versioning from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error getting S3 Bucket (%s) versioning: %s", d.Id(), err)
+	}
+
+	vc := flattenAwsS3BucketVersioning(versioning)
+
+	d.Set("bucket", d.Id())
+	d.Set("enabled", vc["enabled"])
+	d.Set("mfa_delete", vc["mfa_delete"])
+
+	return nil
+}
+
+func resourceAwsS3BucketVersioningUpdate(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn()
+
+	versioning := []interface{}{
+		map[string]interface{}{
+			"enabled":    d.Get("enabled").(bool),
+			"mfa_delete": d.Get("mfa_delete").(bool),
+		},
+	}
+
+	if err := resourceAwsS3BucketVersioningPut(s3conn, d.Id(), versioning); err != nil {
+		return err
+	}
+
+	return resourceAwsS3BucketVersioningRead(d, meta)
+}
+
+func resourceAwsS3BucketVersioningDelete(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn()
+
+	// Versioning cannot be disabled once enabled, only suspended.
+	_, err := s3conn.PutBucketVersioning(&s3.PutBucketVersioningInput{
+		Bucket: aws.String(d.Id()),
+		VersioningConfiguration: &s3.VersioningConfiguration{
+			Status: aws.String(s3.BucketVersioningStatusSuspended),
+		},
+	})
+	if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error suspending S3 Bucket (%s) versioning: %s", d.Id(), err)
+	}
+
+	return nil
+}
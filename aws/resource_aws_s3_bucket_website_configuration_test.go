@@ -0,0 +1,117 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/fakeaws"
+)
+
+func TestAccAWSS3BucketWebsiteConfiguration_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_s3_bucket_website_configuration.test"
+
+	testAccCassetteParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketWebsiteConfigurationConfig(rName, "error.html"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketExists("aws_s3_bucket.test"),
+					resource.TestCheckResourceAttr(resourceName, "bucket", rName),
+					resource.TestCheckResourceAttr(resourceName, "index_document", "index.html"),
+					resource.TestCheckResourceAttr(resourceName, "error_document", "error.html"),
+					resource.TestCheckResourceAttrSet(resourceName, "website_endpoint"),
+					resource.TestCheckResourceAttrSet(resourceName, "website_domain"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSS3BucketWebsiteConfigurationConfigRedirect(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "index_document", ""),
+					resource.TestCheckResourceAttr(resourceName, "redirect_all_requests_to", "https://www.example.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceAwsS3BucketWebsiteConfiguration_fakeAws(t *testing.T) {
+	server := fakeaws.New()
+	defer server.Close()
+
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_s3_bucket_website_configuration.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFakeAwsProviderConfig(server.URL) + testAccAWSS3BucketWebsiteConfigurationConfig(rName, "error.html"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "index_document", "index.html"),
+					resource.TestCheckResourceAttr(resourceName, "error_document", "error.html"),
+					resource.TestCheckResourceAttrSet(resourceName, "website_endpoint"),
+				),
+			},
+			{
+				Config: testAccFakeAwsProviderConfig(server.URL) + testAccAWSS3BucketWebsiteConfigurationConfigRedirect(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "index_document", ""),
+					resource.TestCheckResourceAttr(resourceName, "redirect_all_requests_to", "https://www.example.com"),
+				),
+			},
+			{
+				Config:            testAccFakeAwsProviderConfig(server.URL),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAWSS3BucketWebsiteConfigurationConfig(rName, errorDocument string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+
+  lifecycle {
+    ignore_changes = ["website"]
+  }
+}
+
+resource "aws_s3_bucket_website_configuration" "test" {
+  bucket         = "${aws_s3_bucket.test.id}"
+  index_document = "index.html"
+  error_document = %[2]q
+}
+`, rName, errorDocument)
+}
+
+func testAccAWSS3BucketWebsiteConfigurationConfigRedirect(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+
+  lifecycle {
+    ignore_changes = ["website"]
+  }
+}
+
+resource "aws_s3_bucket_website_configuration" "test" {
+  bucket                   = "${aws_s3_bucket.test.id}"
+  redirect_all_requests_to = "https://www.example.com"
+}
+`, rName)
+}
@@ -0,0 +1,553 @@
+package aws
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/mitchellh/go-homedir"
+)
+
+// s3DeleteObjectsBatchSize is the maximum number of keys of a DeleteObjects
+// request.
+const s3DeleteObjectsBatchSize = 1000
+
+func resourceAwsS3Directory() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3DirectoryCreate,
+		Read:   resourceAwsS3DirectoryRead,
+		Update: resourceAwsS3DirectoryUpdate,
+		Delete: resourceAwsS3DirectoryDelete,
+
+		CustomizeDiff: resourceAwsS3DirectoryCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"source": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"acl": {
+				Type:     schema.TypeString,
+				Default:  s3.ObjectCannedACLPrivate,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					s3.ObjectCannedACLPrivate,
+					s3.ObjectCannedACLPublicRead,
+					s3.ObjectCannedACLPublicReadWrite,
+					s3.ObjectCannedACLAuthenticatedRead,
+					s3.ObjectCannedACLAwsExecRead,
+					s3.ObjectCannedACLBucketOwnerRead,
+					s3.ObjectCannedACLBucketOwnerFullControl,
+				}, false),
+			},
+
+			"cache_control": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pattern": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateS3DirectoryPattern,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"content_types": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"storage_class": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					s3.ObjectStorageClassStandard,
+					s3.ObjectStorageClassReducedRedundancy,
+					s3.ObjectStorageClassGlacier,
+					s3.ObjectStorageClassStandardIa,
+					s3.ObjectStorageClassOnezoneIa,
+					s3.ObjectStorageClassIntelligentTiering,
+				}, false),
+			},
+
+			"multipart_upload": resourceAwsS3BucketObject().Schema["multipart_upload"],
+
+			"files": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"etags": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceAwsS3DirectoryCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId(fmt.Sprintf("%s/%s", d.Get("bucket").(string), d.Get("prefix").(string)))
+
+	return resourceAwsS3DirectoryUpdate(d, meta)
+}
+
+func resourceAwsS3DirectoryRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn()
+
+	bucket := d.Get("bucket").(string)
+	prefix := d.Get("prefix").(string)
+
+	remoteETags, err := listS3DirectoryETags(conn, bucket, prefix)
+	if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+		log.Printf("[WARN] S3 Bucket (%s) not found, removing S3 directory (%s) from state", bucket, d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error listing S3 directory (%s) objects: %s", d.Id(), err)
+	}
+
+	etags := d.Get("etags").(map[string]interface{})
+	files := make(map[string]interface{})
+	newETags := make(map[string]interface{})
+
+	for file, hash := range d.Get("files").(map[string]interface{}) {
+		etag, ok := remoteETags[prefix+file]
+		if !ok {
+			log.Printf("[WARN] S3 directory (%s) object %q not found", d.Id(), file)
+			continue
+		}
+
+		// The ETag of an object is only an MD5 digest of its content for
+		// plaintext objects uploaded in a single part. Changes made outside of
+		// Terraform are detected from the ETag returned after the upload.
+		if v, ok := etags[file]; ok && v.(string) != etag {
+			log.Printf("[WARN] S3 directory (%s) object %q has been modified", d.Id(), file)
+			hash = etag
+		}
+
+		files[file] = hash
+		newETags[file] = etag
+	}
+
+	if err := d.Set("files", files); err != nil {
+		return fmt.Errorf("error setting files: %s", err)
+	}
+
+	if err := d.Set("etags", newETags); err != nil {
+		return fmt.Errorf("error setting etags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3DirectoryUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn()
+
+	bucket := d.Get("bucket").(string)
+	prefix := d.Get("prefix").(string)
+
+	source, err := homedir.Expand(d.Get("source").(string))
+	if err != nil {
+		return fmt.Errorf("error expanding homedir in source (%s): %s", d.Get("source").(string), err)
+	}
+
+	local, err := s3DirectoryFileHashes(source)
+	if err != nil {
+		return err
+	}
+
+	// The files and ETags of the objects in S3, updated as objects are
+	// uploaded and deleted so that a failed update is retried where it stopped.
+	o, _ := d.GetChange("files")
+	files := make(map[string]interface{})
+	for file, hash := range o.(map[string]interface{}) {
+		files[file] = hash
+	}
+	oETags, _ := d.GetChange("etags")
+	etags := make(map[string]interface{})
+	for file, etag := range oETags.(map[string]interface{}) {
+		etags[file] = etag
+	}
+
+	setFailedState := func() error {
+		// Only the objects are saved if the update fails, so that changes to
+		// their settings are applied to all of them again.
+		if !d.IsNewResource() {
+			d.Partial(true)
+			d.SetPartial("files")
+			d.SetPartial("etags")
+		}
+
+		if err := d.Set("files", files); err != nil {
+			return fmt.Errorf("error setting files: %s", err)
+		}
+		if err := d.Set("etags", etags); err != nil {
+			return fmt.Errorf("error setting etags: %s", err)
+		}
+		return nil
+	}
+
+	// Changes to the settings of the objects require all of them to be
+	// uploaded again.
+	uploadAll := false
+	for _, key := range []string{"acl", "cache_control", "content_types", "storage_class"} {
+		if d.HasChange(key) {
+			uploadAll = true
+		}
+	}
+
+	uploader := expandS3Uploader(conn, d.Get("multipart_upload").([]interface{}))
+
+	for _, file := range s3DirectorySortedFiles(local) {
+		hash := local[file].(string)
+		if v, ok := files[file]; ok && v.(string) == hash && !uploadAll {
+			continue
+		}
+
+		log.Printf("[DEBUG] Uploading S3 directory (%s) object %q", d.Id(), file)
+		if err := resourceAwsS3DirectoryPutFile(d, uploader, source, prefix, file); err != nil {
+			if err := setFailedState(); err != nil {
+				log.Printf("[WARN] %s", err)
+			}
+			return fmt.Errorf("error uploading S3 directory (%s) object %q: %s", d.Id(), file, err)
+		}
+
+		files[file] = hash
+		// The ETag is read back after the upload.
+		delete(etags, file)
+	}
+
+	var removed []string
+	for _, file := range s3DirectorySortedFiles(files) {
+		if _, ok := local[file]; !ok {
+			removed = append(removed, file)
+		}
+	}
+
+	if len(removed) > 0 {
+		log.Printf("[DEBUG] Deleting S3 directory (%s) objects: %v", d.Id(), removed)
+		deleted, err := deleteS3DirectoryObjects(conn, bucket, prefix, removed)
+		for _, file := range deleted {
+			delete(files, file)
+			delete(etags, file)
+		}
+		if err != nil {
+			if err := setFailedState(); err != nil {
+				log.Printf("[WARN] %s", err)
+			}
+			return fmt.Errorf("error deleting S3 directory (%s) objects: %s", d.Id(), err)
+		}
+	}
+
+	if err := d.Set("files", files); err != nil {
+		return fmt.Errorf("error setting files: %s", err)
+	}
+	if err := d.Set("etags", etags); err != nil {
+		return fmt.Errorf("error setting etags: %s", err)
+	}
+
+	return resourceAwsS3DirectoryRead(d, meta)
+}
+
+func resourceAwsS3DirectoryDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn()
+
+	bucket := d.Get("bucket").(string)
+	prefix := d.Get("prefix").(string)
+
+	_, err := deleteS3DirectoryObjects(conn, bucket, prefix, s3DirectorySortedFiles(d.Get("files").(map[string]interface{})))
+	if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting S3 directory (%s) objects: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3DirectoryCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("source") {
+		if err := d.SetNewComputed("files"); err != nil {
+			return err
+		}
+		return d.SetNewComputed("etags")
+	}
+
+	source, err := homedir.Expand(d.Get("source").(string))
+	if err != nil {
+		return fmt.Errorf("error expanding homedir in source (%s): %s", d.Get("source").(string), err)
+	}
+
+	local, err := s3DirectoryFileHashes(source)
+	if err != nil {
+		return err
+	}
+
+	if reflect.DeepEqual(local, d.Get("files").(map[string]interface{})) {
+		return nil
+	}
+
+	// Each file whose content differs from the uploaded object shows in the
+	// plan as a change of its hash.
+	if err := d.SetNew("files", local); err != nil {
+		return err
+	}
+	return d.SetNewComputed("etags")
+}
+
+// resourceAwsS3DirectoryPutFile uploads a file of the source directory, by
+// slash separated path relative to it, under the prefix with the object
+// settings of the resource.
+func resourceAwsS3DirectoryPutFile(d *schema.ResourceData, uploader *s3Uploader, source, prefix, file string) error {
+	key := prefix + file
+
+	body, size, err := openS3ObjectSource(filepath.Join(source, filepath.FromSlash(file)))
+	if err != nil {
+		return err
+	}
+	defer closeS3ObjectSource(body)
+
+	input := &s3.PutObjectInput{
+		Bucket: aws.String(d.Get("bucket").(string)),
+		Key:    aws.String(key),
+		ACL:    aws.String(d.Get("acl").(string)),
+	}
+
+	if v, ok := d.GetOk("storage_class"); ok {
+		input.StorageClass = aws.String(v.(string))
+	}
+
+	if v := s3DirectoryContentType(file, d.Get("content_types").(map[string]interface{})); v != "" {
+		input.ContentType = aws.String(v)
+	}
+
+	// Patterns are matched against the path relative to the source, not the
+	// key, so that they do not depend on the prefix.
+	if v := s3DirectoryCacheControl(file, d.Get("cache_control").([]interface{})); v != "" {
+		input.CacheControl = aws.String(v)
+	}
+
+	return uploader.Upload(input, body, size)
+}
+
+// s3DirectoryFileHashes returns the hex encoded MD5 digest of each regular
+// file in the directory tree, by slash separated path relative to it.
+func s3DirectoryFileHashes(dir string) (map[string]interface{}, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, fmt.Errorf("error reading S3 directory source (%s): %s", dir, err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("S3 directory source (%s) is not a directory", dir)
+	}
+
+	hashes := make(map[string]interface{})
+
+	err = filepath.Walk(dir, func(filename string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, filename)
+		if err != nil {
+			return err
+		}
+
+		file, err := os.Open(filename)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		hash := md5.New()
+		if _, err := io.Copy(hash, file); err != nil {
+			return err
+		}
+
+		hashes[filepath.ToSlash(rel)] = hex.EncodeToString(hash.Sum(nil))
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reading S3 directory source (%s): %s", dir, err)
+	}
+
+	return hashes, nil
+}
+
+// s3DirectoryContentType returns the content type of a key from its
+// extension, content_types taking precedence over the known MIME types.
+func s3DirectoryContentType(key string, contentTypes map[string]interface{}) string {
+	ext := path.Ext(key)
+	if ext == "" {
+		return ""
+	}
+
+	if v, ok := contentTypes[strings.TrimPrefix(ext, ".")]; ok {
+		return v.(string)
+	}
+
+	return mime.TypeByExtension(ext)
+}
+
+// s3DirectoryCacheControl returns the value of the first cache_control block
+// whose pattern matches the slash separated path of a file relative to the
+// source. Patterns without a slash are matched against the last element of
+// the path, others against the whole path.
+func s3DirectoryCacheControl(file string, l []interface{}) string {
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		m := raw.(map[string]interface{})
+		pattern := m["pattern"].(string)
+
+		name := file
+		if !strings.Contains(pattern, "/") {
+			name = path.Base(file)
+		}
+
+		if ok, _ := path.Match(pattern, name); ok {
+			return m["value"].(string)
+		}
+	}
+
+	return ""
+}
+
+func validateS3DirectoryPattern(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if _, err := path.Match(value, ""); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid pattern %q: %s", k, value, err))
+	}
+	return
+}
+
+// listS3DirectoryETags returns the ETag of each object under the prefix, by
+// key.
+func listS3DirectoryETags(conn *s3.S3, bucket, prefix string) (map[string]string, error) {
+	etags := make(map[string]string)
+
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+	if prefix != "" {
+		input.Prefix = aws.String(prefix)
+	}
+
+	for {
+		output, err := conn.ListObjectsV2(input)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, object := range output.Contents {
+			etags[aws.StringValue(object.Key)] = strings.Trim(aws.StringValue(object.ETag), `"`)
+		}
+
+		if !aws.BoolValue(output.IsTruncated) {
+			break
+		}
+		input.ContinuationToken = output.NextContinuationToken
+	}
+
+	return etags, nil
+}
+
+// deleteS3DirectoryObjects deletes the objects of the files under the prefix,
+// returning the files whose objects were deleted.
+func deleteS3DirectoryObjects(conn *s3.S3, bucket, prefix string, files []string) ([]string, error) {
+	var deleted []string
+
+	for len(files) > 0 {
+		batch := files
+		if len(batch) > s3DeleteObjectsBatchSize {
+			batch = batch[:s3DeleteObjectsBatchSize]
+		}
+		files = files[len(batch):]
+
+		objects := make([]*s3.ObjectIdentifier, 0, len(batch))
+		for _, file := range batch {
+			objects = append(objects, &s3.ObjectIdentifier{Key: aws.String(prefix + file)})
+		}
+
+		output, err := conn.DeleteObjects(&s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &s3.Delete{
+				Objects: objects,
+				Quiet:   aws.Bool(true),
+			},
+		})
+		if err != nil {
+			return deleted, err
+		}
+
+		failed := make(map[string]bool)
+		for _, e := range output.Errors {
+			failed[strings.TrimPrefix(aws.StringValue(e.Key), prefix)] = true
+		}
+		for _, file := range batch {
+			if !failed[file] {
+				deleted = append(deleted, file)
+			}
+		}
+
+		if len(output.Errors) > 0 {
+			e := output.Errors[0]
+			return deleted, fmt.Errorf("error deleting %q: %s: %s", aws.StringValue(e.Key), aws.StringValue(e.Code), aws.StringValue(e.Message))
+		}
+	}
+
+	return deleted, nil
+}
+
+func s3DirectorySortedFiles(m map[string]interface{}) []string {
+	files := make([]string, 0, len(m))
+	for file := range m {
+		files = append(files, file)
+	}
+
+	sort.Strings(files)
+	return files
+}
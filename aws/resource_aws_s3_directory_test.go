@@ -0,0 +1,314 @@
+package aws
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/fakeaws"
+)
+
+func TestAccAWSS3Directory_basic(t *testing.T) {
+	resourceName := "aws_s3_directory.test"
+	rInt := acctest.RandInt()
+	source := testAccAWSS3DirectoryCreateTempDir(t)
+	defer os.RemoveAll(source)
+
+	testAccCassetteParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3DirectoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3DirectoryConfig(rInt, source),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "files.index.html", "4b214a5d71afee6f818a599f43db3e56"),
+					testAccCheckAWSS3DirectoryObject(resourceName, "site/index.html", "text/html; charset=utf-8", "no-cache", "<h1>Hello</h1>"),
+					testAccCheckAWSS3DirectoryObject(resourceName, "site/css/main.css", "text/css; charset=utf-8", "max-age=604800", "h1 { color: red; }"),
+					testAccCheckAWSS3DirectoryObject(resourceName, "site/robots.txt", "text/plain", "", "User-agent: *"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccAWSS3DirectoryWriteFile(t, source, "index.html", "<h1>Hello, World</h1>")
+					testAccAWSS3DirectoryWriteFile(t, source, "js/main.js", "alert(1);")
+					if err := os.Remove(filepath.Join(source, "robots.txt")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccAWSS3DirectoryConfig(rInt, source),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", "3"),
+					resource.TestCheckNoResourceAttr(resourceName, "files.robots.txt"),
+					testAccCheckAWSS3DirectoryObject(resourceName, "site/index.html", "text/html; charset=utf-8", "no-cache", "<h1>Hello, World</h1>"),
+					testAccCheckAWSS3DirectoryObject(resourceName, "site/js/main.js", "text/javascript", "max-age=86400", "alert(1);"),
+					testAccCheckAWSS3DirectoryObjectNotExists(resourceName, "site/robots.txt"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceAwsS3Directory_fakeAws(t *testing.T) {
+	server := fakeaws.New()
+	defer server.Close()
+
+	resourceName := "aws_s3_directory.test"
+	rInt := acctest.RandInt()
+	source := testAccAWSS3DirectoryCreateTempDir(t)
+	defer os.RemoveAll(source)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3DirectoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFakeAwsProviderConfig(server.URL) + testAccAWSS3DirectoryConfig(rInt, source),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "files.index.html", "4b214a5d71afee6f818a599f43db3e56"),
+					resource.TestCheckResourceAttr(resourceName, "etags.%", "3"),
+					testAccCheckAWSS3DirectoryObject(resourceName, "site/index.html", "text/html; charset=utf-8", "no-cache", "<h1>Hello</h1>"),
+					testAccCheckAWSS3DirectoryObject(resourceName, "site/css/main.css", "text/css; charset=utf-8", "max-age=604800", "h1 { color: red; }"),
+					testAccCheckAWSS3DirectoryObject(resourceName, "site/robots.txt", "text/plain", "", "User-agent: *"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccAWSS3DirectoryWriteFile(t, source, "index.html", "<h1>Hello, World</h1>")
+					testAccAWSS3DirectoryWriteFile(t, source, "js/main.js", "alert(1);")
+					if err := os.Remove(filepath.Join(source, "robots.txt")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccFakeAwsProviderConfig(server.URL) + testAccAWSS3DirectoryConfig(rInt, source),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", "3"),
+					resource.TestCheckNoResourceAttr(resourceName, "files.robots.txt"),
+					testAccCheckAWSS3DirectoryObject(resourceName, "site/index.html", "text/html; charset=utf-8", "no-cache", "<h1>Hello, World</h1>"),
+					testAccCheckAWSS3DirectoryObject(resourceName, "site/js/main.js", "text/javascript", "max-age=86400", "alert(1);"),
+					testAccCheckAWSS3DirectoryObjectNotExists(resourceName, "site/robots.txt"),
+				),
+			},
+			{
+				// Objects modified outside of Terraform are uploaded again.
+				PreConfig: func() {
+					conn := testAccProvider.Meta().(*AWSClient).s3conn()
+					_, err := conn.PutObject(&s3.PutObjectInput{
+						Bucket: aws.String(fmt.Sprintf("tf-test-bucket-%d", rInt)),
+						Key:    aws.String("site/index.html"),
+						Body:   strings.NewReader("<h1>Modified</h1>"),
+					})
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccFakeAwsProviderConfig(server.URL) + testAccAWSS3DirectoryConfig(rInt, source),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3DirectoryObject(resourceName, "site/index.html", "text/html; charset=utf-8", "no-cache", "<h1>Hello, World</h1>"),
+				),
+			},
+		},
+	})
+}
+
+func TestS3DirectoryCacheControl(t *testing.T) {
+	cacheControl := []interface{}{
+		map[string]interface{}{"pattern": "*.html", "value": "no-cache"},
+		map[string]interface{}{"pattern": "assets/*", "value": "max-age=31536000"},
+		map[string]interface{}{"pattern": "*", "value": "max-age=86400"},
+	}
+
+	cases := map[string]string{
+		"index.html":          "no-cache",
+		"docs/index.html":     "no-cache",
+		"assets/logo.png":     "max-age=31536000",
+		"assets/css/main.css": "max-age=86400",
+		"robots.txt":          "max-age=86400",
+	}
+
+	for key, expected := range cases {
+		if got := s3DirectoryCacheControl(key, cacheControl); got != expected {
+			t.Errorf("%s: expected %q, got %q", key, expected, got)
+		}
+	}
+
+	if got := s3DirectoryCacheControl("index.html", nil); got != "" {
+		t.Errorf("expected no cache control, got %q", got)
+	}
+}
+
+func TestS3DirectoryContentType(t *testing.T) {
+	contentTypes := map[string]interface{}{
+		"md": "text/markdown",
+	}
+
+	cases := map[string]string{
+		"index.html":  "text/html; charset=utf-8",
+		"README.md":   "text/markdown",
+		"img/a.png":   "image/png",
+		"LICENSE":     "",
+		"archive.zzz": "",
+	}
+
+	for key, expected := range cases {
+		if got := s3DirectoryContentType(key, contentTypes); got != expected {
+			t.Errorf("%s: expected %q, got %q", key, expected, got)
+		}
+	}
+}
+
+func testAccCheckAWSS3DirectoryDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).s3conn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3_directory" {
+			continue
+		}
+
+		for k := range rs.Primary.Attributes {
+			if !strings.HasPrefix(k, "files.") || k == "files.%" {
+				continue
+			}
+
+			key := rs.Primary.Attributes["prefix"] + strings.TrimPrefix(k, "files.")
+			_, err := conn.HeadObject(&s3.HeadObjectInput{
+				Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+				Key:    aws.String(key),
+			})
+			if err == nil {
+				return fmt.Errorf("S3 directory object %q still exists", key)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSS3DirectoryObject(n, key, contentType, cacheControl, body string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).s3conn()
+		out, err := conn.GetObject(&s3.GetObjectInput{
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Key:    aws.String(key),
+		})
+		if err != nil {
+			return fmt.Errorf("S3 directory object %q not found: %s", key, err)
+		}
+		defer out.Body.Close()
+
+		b, err := ioutil.ReadAll(out.Body)
+		if err != nil {
+			return fmt.Errorf("error reading S3 directory object %q: %s", key, err)
+		}
+
+		if got := string(b); got != body {
+			return fmt.Errorf("S3 directory object %q: expected body %q, got %q", key, body, got)
+		}
+		if got := aws.StringValue(out.ContentType); got != contentType {
+			return fmt.Errorf("S3 directory object %q: expected content type %q, got %q", key, contentType, got)
+		}
+		if got := aws.StringValue(out.CacheControl); got != cacheControl {
+			return fmt.Errorf("S3 directory object %q: expected cache control %q, got %q", key, cacheControl, got)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSS3DirectoryObjectNotExists(n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).s3conn()
+		_, err := conn.HeadObject(&s3.HeadObjectInput{
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Key:    aws.String(key),
+		})
+		if err == nil {
+			return fmt.Errorf("S3 directory object %q still exists", key)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSS3DirectoryCreateTempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "tf-acc-s3-dir")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testAccAWSS3DirectoryWriteFile(t, dir, "index.html", "<h1>Hello</h1>")
+	testAccAWSS3DirectoryWriteFile(t, dir, "css/main.css", "h1 { color: red; }")
+	testAccAWSS3DirectoryWriteFile(t, dir, "robots.txt", "User-agent: *")
+
+	return dir
+}
+
+func testAccAWSS3DirectoryWriteFile(t *testing.T, dir, name, content string) {
+	filename := filepath.Join(dir, filepath.FromSlash(name))
+
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filename, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccAWSS3DirectoryConfig(randInt int, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "bucket" {
+  bucket = "tf-test-bucket-%d"
+}
+
+resource "aws_s3_directory" "test" {
+  bucket = "${aws_s3_bucket.bucket.bucket}"
+  prefix = "site/"
+  source = %q
+
+  content_types = {
+    js  = "text/javascript"
+    txt = "text/plain"
+  }
+
+  cache_control {
+    pattern = "*.html"
+    value   = "no-cache"
+  }
+
+  cache_control {
+    pattern = "css/*"
+    value   = "max-age=604800"
+  }
+
+  cache_control {
+    pattern = "*.css"
+    value   = "max-age=86400"
+  }
+
+  cache_control {
+    pattern = "*.js"
+    value   = "max-age=86400"
+  }
+}
+`, randInt, source)
+}
@@ -0,0 +1,610 @@
+package aws
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsS3ObjectCopy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3ObjectCopyCreate,
+		Read:   resourceAwsS3ObjectCopyRead,
+		Update: resourceAwsS3ObjectCopyUpdate,
+		Delete: resourceAwsS3ObjectCopyDelete,
+
+		CustomizeDiff: resourceAwsS3ObjectCopyCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"source": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateS3ObjectCopySource,
+			},
+
+			"source_version_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"source_etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"acl": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"grant"},
+				ValidateFunc: validation.StringInSlice([]string{
+					s3.ObjectCannedACLPrivate,
+					s3.ObjectCannedACLPublicRead,
+					s3.ObjectCannedACLPublicReadWrite,
+					s3.ObjectCannedACLAuthenticatedRead,
+					s3.ObjectCannedACLAwsExecRead,
+					s3.ObjectCannedACLBucketOwnerRead,
+					s3.ObjectCannedACLBucketOwnerFullControl,
+				}, false),
+			},
+
+			"grant": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ConflictsWith: []string{"acl"},
+				Set:           resourceAwsS3ObjectCopyGrantHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								s3.TypeCanonicalUser,
+								s3.TypeAmazonCustomerByEmail,
+								s3.TypeGroup,
+							}, false),
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"email": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"uri": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"permissions": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Set:      schema.HashString,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									s3.PermissionFullControl,
+									s3.PermissionRead,
+									s3.PermissionReadAcp,
+									s3.PermissionWriteAcp,
+								}, false),
+							},
+						},
+					},
+				},
+			},
+
+			"metadata_directive": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  s3.MetadataDirectiveCopy,
+				ValidateFunc: validation.StringInSlice([]string{
+					s3.MetadataDirectiveCopy,
+					s3.MetadataDirectiveReplace,
+				}, false),
+			},
+
+			"tagging_directive": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  s3.TaggingDirectiveCopy,
+				ValidateFunc: validation.StringInSlice([]string{
+					s3.TaggingDirectiveCopy,
+					s3.TaggingDirectiveReplace,
+				}, false),
+			},
+
+			"cache_control": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"content_disposition": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"content_encoding": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"content_language": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"content_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"metadata": {
+				Type:         schema.TypeMap,
+				ValidateFunc: validateMetadataIsLowerCase,
+				Optional:     true,
+				Computed:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+			},
+
+			"storage_class": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					s3.ObjectStorageClassStandard,
+					s3.ObjectStorageClassReducedRedundancy,
+					s3.ObjectStorageClassGlacier,
+					s3.ObjectStorageClassStandardIa,
+					s3.ObjectStorageClassOnezoneIa,
+					s3.ObjectStorageClassIntelligentTiering,
+				}, false),
+			},
+
+			"server_side_encryption": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					s3.ServerSideEncryptionAes256,
+					s3.ServerSideEncryptionAwsKms,
+				}, false),
+			},
+
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateArn,
+			},
+
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"version_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceAwsS3ObjectCopyCreate(d *schema.ResourceData, meta interface{}) error {
+	return resourceAwsS3ObjectCopyCopy(d, meta)
+}
+
+// resourceAwsS3ObjectCopyCopy copies the source object to the bucket and key
+// of the resource.
+func resourceAwsS3ObjectCopyCopy(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn()
+
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+	source := d.Get("source").(string)
+	sourceVersionID := d.Get("source_version_id").(string)
+
+	sourceBucket, sourceKey, err := parseS3ObjectCopySource(source)
+	if err != nil {
+		return err
+	}
+
+	head, err := headS3ObjectCopySource(conn, sourceBucket, sourceKey, sourceVersionID)
+	if err != nil {
+		return fmt.Errorf("error reading S3 object copy source (%s): %s", source, err)
+	}
+
+	input := &s3.CopyObjectInput{
+		Bucket:            aws.String(bucket),
+		Key:               aws.String(key),
+		CopySource:        aws.String(s3CopySource(sourceBucket, sourceKey, sourceVersionID)),
+		MetadataDirective: aws.String(d.Get("metadata_directive").(string)),
+		TaggingDirective:  aws.String(d.Get("tagging_directive").(string)),
+	}
+
+	if v, ok := d.GetOk("acl"); ok {
+		input.ACL = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("grant"); ok {
+		grants := expandS3ObjectCopyGrants(v.(*schema.Set).List())
+		input.GrantFullControl = grants[s3.PermissionFullControl]
+		input.GrantRead = grants[s3.PermissionRead]
+		input.GrantReadACP = grants[s3.PermissionReadAcp]
+		input.GrantWriteACP = grants[s3.PermissionWriteAcp]
+	}
+
+	if aws.StringValue(input.MetadataDirective) == s3.MetadataDirectiveReplace {
+		if v, ok := d.GetOk("cache_control"); ok {
+			input.CacheControl = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("content_disposition"); ok {
+			input.ContentDisposition = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("content_encoding"); ok {
+			input.ContentEncoding = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("content_language"); ok {
+			input.ContentLanguage = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("content_type"); ok {
+			input.ContentType = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("metadata"); ok {
+			input.Metadata = stringMapToPointers(v.(map[string]interface{}))
+		}
+	}
+
+	if aws.StringValue(input.TaggingDirective) == s3.TaggingDirectiveReplace {
+		if v, ok := d.GetOk("tags"); ok {
+			// The tag-set must be encoded as URL Query parameters.
+			values := url.Values{}
+			for k, v := range v.(map[string]interface{}) {
+				values.Add(k, v.(string))
+			}
+			input.Tagging = aws.String(values.Encode())
+		}
+	}
+
+	if v, ok := d.GetOk("storage_class"); ok {
+		input.StorageClass = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("server_side_encryption"); ok {
+		input.ServerSideEncryption = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.SSEKMSKeyId = aws.String(v.(string))
+		input.ServerSideEncryption = aws.String(s3.ServerSideEncryptionAwsKms)
+	}
+
+	copier := &s3Copier{
+		Conn:     conn,
+		PartSize: s3DefaultCopyPartSize,
+	}
+
+	log.Printf("[DEBUG] Copying S3 object: %s", input)
+	if _, _, err := copier.Copy(input, head); err != nil {
+		return fmt.Errorf("error copying S3 object (%s) to %s/%s: %s", source, bucket, key, err)
+	}
+
+	d.SetId(bucket + "/" + key)
+	d.Set("source_etag", strings.Trim(aws.StringValue(head.ETag), `"`))
+
+	return resourceAwsS3ObjectCopyRead(d, meta)
+}
+
+func resourceAwsS3ObjectCopyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn()
+
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+
+	resp, err := conn.HeadObject(&s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+
+	if err != nil {
+		if awsErr, ok := err.(awserr.RequestFailure); ok && awsErr.StatusCode() == 404 {
+			log.Printf("[WARN] S3 object copy (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading S3 object copy (%s): %s", d.Id(), err)
+	}
+
+	d.Set("cache_control", resp.CacheControl)
+	d.Set("content_disposition", resp.ContentDisposition)
+	d.Set("content_encoding", resp.ContentEncoding)
+	d.Set("content_language", resp.ContentLanguage)
+	d.Set("content_type", resp.ContentType)
+	d.Set("etag", strings.Trim(aws.StringValue(resp.ETag), `"`))
+	d.Set("server_side_encryption", resp.ServerSideEncryption)
+	d.Set("version_id", resp.VersionId)
+
+	metadata := make(map[string]interface{}, len(resp.Metadata))
+	for k, v := range resp.Metadata {
+		metadata[strings.ToLower(k)] = aws.StringValue(v)
+	}
+	if err := d.Set("metadata", metadata); err != nil {
+		return fmt.Errorf("error setting metadata: %s", err)
+	}
+
+	// Only set non-default KMS key ID (one that doesn't match default)
+	if resp.SSEKMSKeyId != nil {
+		kmsconn := meta.(*AWSClient).kmsconn()
+		kmsresp, err := kmsconn.DescribeKey(&kms.DescribeKeyInput{
+			KeyId: aws.String("alias/aws/s3"),
+		})
+		if err != nil {
+			return fmt.Errorf("Failed to describe default S3 KMS key (alias/aws/s3): %s", err)
+		}
+
+		if aws.StringValue(resp.SSEKMSKeyId) != aws.StringValue(kmsresp.KeyMetadata.Arn) {
+			d.Set("kms_key_id", resp.SSEKMSKeyId)
+		}
+	}
+
+	// The "STANDARD" (which is also the default) storage
+	// class when set would not be included in the results.
+	d.Set("storage_class", s3.StorageClassStandard)
+	if resp.StorageClass != nil {
+		d.Set("storage_class", resp.StorageClass)
+	}
+
+	if err := getTagsS3Object(conn, d); err != nil {
+		return fmt.Errorf("error getting S3 object copy (%s) tags: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3ObjectCopyUpdate(d *schema.ResourceData, meta interface{}) error {
+	// Changes to any of these attributes require the object to be copied again.
+	for _, key := range []string{
+		"source",
+		"source_version_id",
+		"source_etag",
+		"metadata_directive",
+		"tagging_directive",
+		"cache_control",
+		"content_disposition",
+		"content_encoding",
+		"content_language",
+		"content_type",
+		"metadata",
+		"storage_class",
+		"server_side_encryption",
+		"kms_key_id",
+	} {
+		if d.HasChange(key) {
+			return resourceAwsS3ObjectCopyCopy(d, meta)
+		}
+	}
+
+	conn := meta.(*AWSClient).s3conn()
+
+	if d.HasChange("acl") || d.HasChange("grant") {
+		input := &s3.PutObjectAclInput{
+			Bucket: aws.String(d.Get("bucket").(string)),
+			Key:    aws.String(d.Get("key").(string)),
+		}
+
+		if v, ok := d.GetOk("grant"); ok {
+			grants := expandS3ObjectCopyGrants(v.(*schema.Set).List())
+			input.GrantFullControl = grants[s3.PermissionFullControl]
+			input.GrantRead = grants[s3.PermissionRead]
+			input.GrantReadACP = grants[s3.PermissionReadAcp]
+			input.GrantWriteACP = grants[s3.PermissionWriteAcp]
+		} else if v, ok := d.GetOk("acl"); ok {
+			input.ACL = aws.String(v.(string))
+		} else {
+			input.ACL = aws.String(s3.ObjectCannedACLPrivate)
+		}
+
+		if _, err := conn.PutObjectAcl(input); err != nil {
+			return fmt.Errorf("error putting S3 object copy (%s) ACL: %s", d.Id(), err)
+		}
+	}
+
+	if err := setTagsS3Object(conn, d, meta.(*AWSClient).ignoreTagsConfig); err != nil {
+		return fmt.Errorf("error setting S3 object copy (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsS3ObjectCopyRead(d, meta)
+}
+
+func resourceAwsS3ObjectCopyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn()
+
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+
+	err := deleteS3ObjectVersion(conn, bucket, key, d.Get("version_id").(string), false)
+
+	if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") || isAWSErr(err, s3.ErrCodeNoSuchKey, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 object copy (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3ObjectCopyCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	// The copy keeps the metadata and tags of the source object with the COPY
+	// directives, configured values would never be applied.
+	if d.Get("metadata_directive").(string) == s3.MetadataDirectiveCopy {
+		for _, key := range []string{
+			"cache_control",
+			"content_disposition",
+			"content_encoding",
+			"content_language",
+			"content_type",
+			"metadata",
+		} {
+			if d.HasChange(key) {
+				return fmt.Errorf("%s can only be set with metadata_directive = %q", key, s3.MetadataDirectiveReplace)
+			}
+		}
+	}
+
+	if d.Id() == "" {
+		if d.Get("tagging_directive").(string) == s3.TaggingDirectiveCopy && d.HasChange("tags") {
+			return fmt.Errorf("tags can only be set on creation with tagging_directive = %q", s3.TaggingDirectiveReplace)
+		}
+
+		return nil
+	}
+
+	if d.HasChange("source") || d.HasChange("source_version_id") {
+		d.SetNewComputed("source_etag")
+		d.SetNewComputed("etag")
+		d.SetNewComputed("version_id")
+		return nil
+	}
+
+	// Copy the source object again when it has changed since the last copy.
+	sourceBucket, sourceKey, err := parseS3ObjectCopySource(d.Get("source").(string))
+	if err != nil {
+		return err
+	}
+
+	head, err := headS3ObjectCopySource(meta.(*AWSClient).s3conn(), sourceBucket, sourceKey, d.Get("source_version_id").(string))
+	if awsErr, ok := err.(awserr.RequestFailure); ok && awsErr.StatusCode() == 404 {
+		log.Printf("[WARN] S3 object copy (%s) source not found", d.Id())
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading S3 object copy source (%s): %s", d.Get("source").(string), err)
+	}
+
+	if etag := strings.Trim(aws.StringValue(head.ETag), `"`); etag != d.Get("source_etag").(string) {
+		log.Printf("[DEBUG] S3 object copy (%s) source changed, copying it again", d.Id())
+		d.SetNew("source_etag", etag)
+		d.SetNewComputed("etag")
+		d.SetNewComputed("version_id")
+	}
+
+	return nil
+}
+
+func headS3ObjectCopySource(conn *s3.S3, bucket, key, versionID string) (*s3.HeadObjectOutput, error) {
+	input := &s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}
+	if versionID != "" {
+		input.VersionId = aws.String(versionID)
+	}
+
+	return conn.HeadObject(input)
+}
+
+// expandS3ObjectCopyGrants returns the values of the x-amz-grant-* headers,
+// keyed by permission.
+func expandS3ObjectCopyGrants(l []interface{}) map[string]*string {
+	grantees := make(map[string][]string)
+
+	for _, raw := range l {
+		m := raw.(map[string]interface{})
+
+		var grantee string
+		switch m["type"].(string) {
+		case s3.TypeCanonicalUser:
+			grantee = fmt.Sprintf("id=%q", m["id"].(string))
+		case s3.TypeAmazonCustomerByEmail:
+			grantee = fmt.Sprintf("emailAddress=%q", m["email"].(string))
+		case s3.TypeGroup:
+			grantee = fmt.Sprintf("uri=%q", m["uri"].(string))
+		}
+
+		for _, permission := range m["permissions"].(*schema.Set).List() {
+			grantees[permission.(string)] = append(grantees[permission.(string)], grantee)
+		}
+	}
+
+	grants := make(map[string]*string, len(grantees))
+	for permission, v := range grantees {
+		grants[permission] = aws.String(strings.Join(v, ", "))
+	}
+
+	return grants
+}
+
+func resourceAwsS3ObjectCopyGrantHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+
+	buf.WriteString(fmt.Sprintf("%s-", m["type"].(string)))
+	if v, ok := m["id"]; ok {
+		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
+	}
+	if v, ok := m["email"]; ok {
+		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
+	}
+	if v, ok := m["uri"]; ok {
+		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
+	}
+	if v, ok := m["permissions"]; ok {
+		buf.WriteString(fmt.Sprintf("%v-", v.(*schema.Set).List()))
+	}
+
+	return hashcode.String(buf.String())
+}
+
+// parseS3ObjectCopySource returns the bucket and key of a "bucket/key"
+// copy source.
+func parseS3ObjectCopySource(source string) (string, string, error) {
+	parts := strings.SplitN(strings.TrimPrefix(source, "/"), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid copy source (%s), expected BUCKET/KEY", source)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func validateS3ObjectCopySource(v interface{}, k string) (ws []string, errors []error) {
+	if _, _, err := parseS3ObjectCopySource(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q: %s", k, err))
+	}
+	return
+}
@@ -0,0 +1,290 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/fakeaws"
+)
+
+func TestAccAWSS3ObjectCopy_basic(t *testing.T) {
+	resourceName := "aws_s3_object_copy.test"
+	rInt := acctest.RandInt()
+
+	testAccCassetteParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3ObjectCopyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3ObjectCopyConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ObjectCopyExists(resourceName, "initial"),
+					resource.TestCheckResourceAttr(resourceName, "content_type", "text/plain"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.origin", "source"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "Value1"),
+				),
+			},
+			{
+				// The source object is copied again when it changes.
+				PreConfig: func() { testAccAWSS3ObjectCopyModifySource(t, rInt) },
+				Config:    testAccAWSS3ObjectCopyConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ObjectCopyExists(resourceName, "modified"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.origin", "source"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSS3ObjectCopy_replace(t *testing.T) {
+	resourceName := "aws_s3_object_copy.test"
+	rInt := acctest.RandInt()
+
+	testAccCassetteParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3ObjectCopyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3ObjectCopyConfigReplace(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ObjectCopyExists(resourceName, "initial"),
+					resource.TestCheckResourceAttr(resourceName, "content_type", "application/json"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.origin", "copy"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key2", "Value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceAwsS3ObjectCopy_fakeAws(t *testing.T) {
+	server := fakeaws.New()
+	defer server.Close()
+
+	resourceName := "aws_s3_object_copy.test"
+	rInt := acctest.RandInt()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3ObjectCopyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFakeAwsProviderConfig(server.URL) + testAccAWSS3ObjectCopyConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ObjectCopyExists(resourceName, "initial"),
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("tf-object-copy-destination-%d/copy", rInt)),
+					resource.TestCheckResourceAttr(resourceName, "content_type", "text/plain"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.origin", "source"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "Value1"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_etag", "aws_s3_bucket_object.source", "etag"),
+				),
+			},
+			{
+				Config: testAccFakeAwsProviderConfig(server.URL) + testAccAWSS3ObjectCopyConfigReplace(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ObjectCopyExists(resourceName, "initial"),
+					resource.TestCheckResourceAttr(resourceName, "content_type", "application/json"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.origin", "copy"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key2", "Value2"),
+				),
+			},
+			{
+				// The source object is copied again when it changes.
+				PreConfig: func() { testAccAWSS3ObjectCopyModifySource(t, rInt) },
+				Config:    testAccFakeAwsProviderConfig(server.URL) + testAccAWSS3ObjectCopyConfigReplace(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ObjectCopyExists(resourceName, "modified"),
+					resource.TestCheckResourceAttr(resourceName, "content_type", "application/json"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceAwsS3ObjectCopy_copyDirectives(t *testing.T) {
+	server := fakeaws.New()
+	defer server.Close()
+
+	rInt := acctest.RandInt()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3ObjectCopyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFakeAwsProviderConfig(server.URL) + testAccAWSS3ObjectCopyConfigMetadataDirectiveCopy(rInt),
+				ExpectError: regexp.MustCompile(`content_type can only be set with metadata_directive = "REPLACE"`),
+			},
+			{
+				Config:      testAccFakeAwsProviderConfig(server.URL) + testAccAWSS3ObjectCopyConfigTaggingDirectiveCopy(rInt),
+				ExpectError: regexp.MustCompile(`tags can only be set on creation with tagging_directive = "REPLACE"`),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSS3ObjectCopyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).s3conn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3_object_copy" {
+			continue
+		}
+
+		_, err := conn.HeadObject(&s3.HeadObjectInput{
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Key:    aws.String(rs.Primary.Attributes["key"]),
+		})
+		if err == nil {
+			return fmt.Errorf("S3 object copy %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSS3ObjectCopyExists(n, body string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).s3conn()
+		out, err := conn.GetObject(&s3.GetObjectInput{
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Key:    aws.String(rs.Primary.Attributes["key"]),
+		})
+		if err != nil {
+			return fmt.Errorf("S3 object copy %s not found: %s", rs.Primary.ID, err)
+		}
+
+		return testAccCheckAWSS3BucketObjectBody(out, body)(s)
+	}
+}
+
+// testAccAWSS3ObjectCopyModifySource changes the content of the source
+// object outside of Terraform, keeping its configured attributes.
+func testAccAWSS3ObjectCopyModifySource(t *testing.T, randInt int) {
+	conn := testAccProvider.Meta().(*AWSClient).s3conn()
+	_, err := conn.PutObject(&s3.PutObjectInput{
+		Bucket:      aws.String(fmt.Sprintf("tf-object-copy-source-%d", randInt)),
+		Key:         aws.String("source"),
+		Body:        strings.NewReader("modified"),
+		ContentType: aws.String("text/plain"),
+		Metadata:    map[string]*string{"origin": aws.String("source")},
+		Tagging:     aws.String("Key1=Value1"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccAWSS3ObjectCopyConfigSource(randInt int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "source" {
+  bucket = "tf-object-copy-source-%[1]d"
+}
+
+resource "aws_s3_bucket" "destination" {
+  bucket = "tf-object-copy-destination-%[1]d"
+}
+
+resource "aws_s3_bucket_object" "source" {
+  bucket       = "${aws_s3_bucket.source.bucket}"
+  key          = "source"
+  content      = "initial"
+  content_type = "text/plain"
+
+  metadata = {
+    origin = "source"
+  }
+
+  tags = {
+    Key1 = "Value1"
+  }
+}
+`, randInt)
+}
+
+func testAccAWSS3ObjectCopyConfig(randInt int) string {
+	return testAccAWSS3ObjectCopyConfigSource(randInt) + `
+resource "aws_s3_object_copy" "test" {
+  bucket = "${aws_s3_bucket.destination.bucket}"
+  key    = "copy"
+  source = "${aws_s3_bucket.source.bucket}/${aws_s3_bucket_object.source.key}"
+
+  depends_on = ["aws_s3_bucket_object.source"]
+}
+`
+}
+
+func testAccAWSS3ObjectCopyConfigReplace(randInt int) string {
+	return testAccAWSS3ObjectCopyConfigSource(randInt) + `
+resource "aws_s3_object_copy" "test" {
+  bucket = "${aws_s3_bucket.destination.bucket}"
+  key    = "copy"
+  source = "${aws_s3_bucket.source.bucket}/${aws_s3_bucket_object.source.key}"
+
+  metadata_directive = "REPLACE"
+  content_type       = "application/json"
+
+  metadata = {
+    origin = "copy"
+  }
+
+  tagging_directive = "REPLACE"
+
+  tags = {
+    Key2 = "Value2"
+  }
+
+  depends_on = ["aws_s3_bucket_object.source"]
+}
+`
+}
+
+func testAccAWSS3ObjectCopyConfigMetadataDirectiveCopy(randInt int) string {
+	return testAccAWSS3ObjectCopyConfigSource(randInt) + `
+resource "aws_s3_object_copy" "test" {
+  bucket = "${aws_s3_bucket.destination.bucket}"
+  key    = "copy"
+  source = "${aws_s3_bucket.source.bucket}/${aws_s3_bucket_object.source.key}"
+
+  content_type = "application/json"
+
+  depends_on = ["aws_s3_bucket_object.source"]
+}
+`
+}
+
+func testAccAWSS3ObjectCopyConfigTaggingDirectiveCopy(randInt int) string {
+	return testAccAWSS3ObjectCopyConfigSource(randInt) + `
+resource "aws_s3_object_copy" "test" {
+  bucket = "${aws_s3_bucket.destination.bucket}"
+  key    = "copy"
+  source = "${aws_s3_bucket.source.bucket}/${aws_s3_bucket_object.source.key}"
+
+  tags = {
+    Key2 = "Value2"
+  }
+
+  depends_on = ["aws_s3_bucket_object.source"]
+}
+`
+}
@@ -29,7 +29,7 @@ func testSweepSagemakerModels(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).sagemakerconn
+	conn := client.(*AWSClient).sagemakerconn()
 
 	req := &sagemaker.ListModelsInput{}
 	resp, err := conn.ListModels(req)
@@ -278,7 +278,7 @@ func TestAccAWSSagemakerModel_networkIsolation(t *testing.T) {
 }
 
 func testAccCheckSagemakerModelDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_sagemaker_model" {
@@ -319,7 +319,7 @@ func testAccCheckSagemakerModelExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No sagmaker model ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn()
 		DescribeModelOpts := &sagemaker.DescribeModelInput{
 			ModelName: aws.String(rs.Primary.ID),
 		}
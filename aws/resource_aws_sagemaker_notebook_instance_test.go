@@ -137,7 +137,7 @@ func TestAccAWSSagemakerNotebookInstance_disappears(t *testing.T) {
 }
 
 func testAccCheckAWSSagemakerNotebookInstanceDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_sagemaker_notebook_instance" {
@@ -171,7 +171,7 @@ func testAccCheckAWSSagemakerNotebookInstanceExists(n string, notebook *sagemake
 			return fmt.Errorf("No sagmaker Notebook Instance ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn()
 		opts := &sagemaker.DescribeNotebookInstanceInput{
 			NotebookInstanceName: aws.String(rs.Primary.ID),
 		}
@@ -188,7 +188,7 @@ func testAccCheckAWSSagemakerNotebookInstanceExists(n string, notebook *sagemake
 
 func testAccCheckAWSSagemakerNotebookInstanceDisappears(instance *sagemaker.DescribeNotebookInstanceOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn()
 
 		if *instance.NotebookInstanceStatus != sagemaker.NotebookInstanceStatusFailed && *instance.NotebookInstanceStatus != sagemaker.NotebookInstanceStatusStopped {
 			if err := stopSagemakerNotebookInstance(conn, *instance.NotebookInstanceName); err != nil {
@@ -234,7 +234,7 @@ func testAccCheckAWSSagemakerNotebookInstanceName(notebook *sagemaker.DescribeNo
 
 func testAccCheckAWSSagemakerNotebookInstanceTags(notebook *sagemaker.DescribeNotebookInstanceOutput, key string, value string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn()
 
 		ts, err := conn.ListTags(&sagemaker.ListTagsInput{
 			ResourceArn: notebook.NotebookInstanceArn,
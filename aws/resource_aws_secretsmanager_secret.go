@@ -101,7 +101,7 @@ func resourceAwsSecretsManagerSecret() *schema.Resource {
 }
 
 func resourceAwsSecretsManagerSecretCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).secretsmanagerconn
+	conn := meta.(*AWSClient).secretsmanagerconn()
 
 	var secretName string
 	if v, ok := d.GetOk("name"); ok {
@@ -197,7 +197,7 @@ func resourceAwsSecretsManagerSecretCreate(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsSecretsManagerSecretRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).secretsmanagerconn
+	conn := meta.(*AWSClient).secretsmanagerconn()
 
 	input := &secretsmanager.DescribeSecretInput{
 		SecretId: aws.String(d.Id()),
@@ -256,7 +256,7 @@ func resourceAwsSecretsManagerSecretRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsSecretsManagerSecretUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).secretsmanagerconn
+	conn := meta.(*AWSClient).secretsmanagerconn()
 
 	if d.HasChange("description") || d.HasChange("kms_key_id") {
 		input := &secretsmanager.UpdateSecretInput{
@@ -340,8 +340,8 @@ func resourceAwsSecretsManagerSecretUpdate(d *schema.ResourceData, meta interfac
 		}
 	}
 
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if hasTagsChange(d) {
+		oraw, nraw := getTagsChange(d)
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := diffTagsSecretsManager(tagsFromMapSecretsManager(o), tagsFromMapSecretsManager(n))
@@ -377,7 +377,7 @@ func resourceAwsSecretsManagerSecretUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsSecretsManagerSecretDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).secretsmanagerconn
+	conn := meta.(*AWSClient).secretsmanagerconn()
 
 	input := &secretsmanager.DeleteSecretInput{
 		SecretId: aws.String(d.Id()),
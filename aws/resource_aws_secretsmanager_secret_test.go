@@ -26,7 +26,7 @@ func testSweepSecretsManagerSecrets(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).secretsmanagerconn
+	conn := client.(*AWSClient).secretsmanagerconn()
 
 	err = conn.ListSecretsPages(&secretsmanager.ListSecretsInput{}, func(page *secretsmanager.ListSecretsOutput, isLast bool) bool {
 		if len(page.SecretList) == 0 {
@@ -412,7 +412,7 @@ func TestAccAwsSecretsManagerSecret_policy(t *testing.T) {
 }
 
 func testAccCheckAwsSecretsManagerSecretDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).secretsmanagerconn
+	conn := testAccProvider.Meta().(*AWSClient).secretsmanagerconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_secretsmanager_secret" {
@@ -448,7 +448,7 @@ func testAccCheckAwsSecretsManagerSecretExists(resourceName string, secret *secr
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).secretsmanagerconn
+		conn := testAccProvider.Meta().(*AWSClient).secretsmanagerconn()
 		input := &secretsmanager.DescribeSecretInput{
 			SecretId: aws.String(rs.Primary.ID),
 		}
@@ -476,7 +476,7 @@ func testAccCheckAwsSecretsManagerSecretHasPolicy(name string, expectedPolicyTex
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).secretsmanagerconn
+		conn := testAccProvider.Meta().(*AWSClient).secretsmanagerconn()
 		input := &secretsmanager.GetResourcePolicyInput{
 			SecretId: aws.String(rs.Primary.ID),
 		}
@@ -61,7 +61,7 @@ func resourceAwsSecretsManagerSecretVersion() *schema.Resource {
 }
 
 func resourceAwsSecretsManagerSecretVersionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).secretsmanagerconn
+	conn := meta.(*AWSClient).secretsmanagerconn()
 	secretID := d.Get("secret_id").(string)
 
 	input := &secretsmanager.PutSecretValueInput{
@@ -103,7 +103,7 @@ func resourceAwsSecretsManagerSecretVersionCreate(d *schema.ResourceData, meta i
 }
 
 func resourceAwsSecretsManagerSecretVersionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).secretsmanagerconn
+	conn := meta.(*AWSClient).secretsmanagerconn()
 
 	secretID, versionID, err := decodeSecretsManagerSecretVersionID(d.Id())
 	if err != nil {
@@ -145,7 +145,7 @@ func resourceAwsSecretsManagerSecretVersionRead(d *schema.ResourceData, meta int
 }
 
 func resourceAwsSecretsManagerSecretVersionUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).secretsmanagerconn
+	conn := meta.(*AWSClient).secretsmanagerconn()
 
 	secretID, versionID, err := decodeSecretsManagerSecretVersionID(d.Id())
 	if err != nil {
@@ -194,7 +194,7 @@ func resourceAwsSecretsManagerSecretVersionUpdate(d *schema.ResourceData, meta i
 }
 
 func resourceAwsSecretsManagerSecretVersionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).secretsmanagerconn
+	conn := meta.(*AWSClient).secretsmanagerconn()
 
 	secretID, versionID, err := decodeSecretsManagerSecretVersionID(d.Id())
 	if err != nil {
@@ -230,7 +230,7 @@ func resourceAwsSecurityGroup() *schema.Resource {
 }
 
 func resourceAwsSecurityGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	securityGroupOpts := &ec2.CreateSecurityGroupInput{}
 
@@ -338,7 +338,7 @@ func resourceAwsSecurityGroupCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	var sgRaw interface{}
 	var err error
@@ -398,7 +398,7 @@ func resourceAwsSecurityGroupRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsSecurityGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	var sgRaw interface{}
 	var err error
@@ -442,7 +442,7 @@ func resourceAwsSecurityGroupUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsSecurityGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	log.Printf("[DEBUG] Security Group destroy: %v", d.Id())
 
@@ -696,91 +696,97 @@ func resourceAwsSecurityGroupUpdateRules(
 			n = new(schema.Set)
 		}
 
-		os := resourceAwsSecurityGroupExpandRules(o.(*schema.Set))
-		ns := resourceAwsSecurityGroupExpandRules(n.(*schema.Set))
+		conn := meta.(*AWSClient).ec2conn()
 
-		remove, err := expandIPPerms(group, resourceAwsSecurityGroupCollapseRules(ruleset, os.Difference(ns).List()))
-		if err != nil {
-			return err
-		}
-		add, err := expandIPPerms(group, resourceAwsSecurityGroupCollapseRules(ruleset, ns.Difference(os).List()))
-		if err != nil {
-			return err
-		}
+		return resourceAwsSecurityGroupReplaceRules(conn, group, ruleset, o.(*schema.Set), n.(*schema.Set))
+	}
+	return nil
+}
 
-		// TODO: We need to handle partial state better in the in-between
-		// in this update.
+// resourceAwsSecurityGroupReplaceRules revokes the rules of the old set that
+// are not in the new set, then authorizes the rules of the new set that are
+// not in the old set.
+func resourceAwsSecurityGroupReplaceRules(conn *ec2.EC2, group *ec2.SecurityGroup, ruleset string, o, n *schema.Set) error {
+	os := resourceAwsSecurityGroupExpandRules(o)
+	ns := resourceAwsSecurityGroupExpandRules(n)
 
-		// TODO: It'd be nicer to authorize before removing, but then we have
-		// to deal with complicated unrolling to get individual CIDR blocks
-		// to avoid authorizing already authorized sources. Removing before
-		// adding is easier here, and Terraform should be fast enough to
-		// not have service issues.
+	remove, err := expandIPPerms(group, resourceAwsSecurityGroupCollapseRules(ruleset, os.Difference(ns).List()))
+	if err != nil {
+		return err
+	}
+	add, err := expandIPPerms(group, resourceAwsSecurityGroupCollapseRules(ruleset, ns.Difference(os).List()))
+	if err != nil {
+		return err
+	}
 
-		if len(remove) > 0 || len(add) > 0 {
-			conn := meta.(*AWSClient).ec2conn
+	// TODO: We need to handle partial state better in the in-between
+	// in this update.
 
-			var err error
-			if len(remove) > 0 {
-				log.Printf("[DEBUG] Revoking security group %#v %s rule: %#v",
-					group, ruleset, remove)
+	// TODO: It'd be nicer to authorize before removing, but then we have
+	// to deal with complicated unrolling to get individual CIDR blocks
+	// to avoid authorizing already authorized sources. Removing before
+	// adding is easier here, and Terraform should be fast enough to
+	// not have service issues.
 
-				if ruleset == "egress" {
-					req := &ec2.RevokeSecurityGroupEgressInput{
-						GroupId:       group.GroupId,
-						IpPermissions: remove,
-					}
-					_, err = conn.RevokeSecurityGroupEgress(req)
-				} else {
-					req := &ec2.RevokeSecurityGroupIngressInput{
-						GroupId:       group.GroupId,
-						IpPermissions: remove,
-					}
-					if group.VpcId == nil || *group.VpcId == "" {
-						req.GroupId = nil
-						req.GroupName = group.GroupName
-					}
-					_, err = conn.RevokeSecurityGroupIngress(req)
-				}
+	if len(remove) > 0 {
+		log.Printf("[DEBUG] Revoking security group %#v %s rule: %#v",
+			group, ruleset, remove)
 
-				if err != nil {
-					return fmt.Errorf(
-						"Error revoking security group %s rules: %s",
-						ruleset, err)
-				}
+		if ruleset == "egress" {
+			req := &ec2.RevokeSecurityGroupEgressInput{
+				GroupId:       group.GroupId,
+				IpPermissions: remove,
 			}
+			_, err = conn.RevokeSecurityGroupEgress(req)
+		} else {
+			req := &ec2.RevokeSecurityGroupIngressInput{
+				GroupId:       group.GroupId,
+				IpPermissions: remove,
+			}
+			if group.VpcId == nil || *group.VpcId == "" {
+				req.GroupId = nil
+				req.GroupName = group.GroupName
+			}
+			_, err = conn.RevokeSecurityGroupIngress(req)
+		}
 
-			if len(add) > 0 {
-				log.Printf("[DEBUG] Authorizing security group %#v %s rule: %#v",
-					group, ruleset, add)
-				// Authorize the new rules
-				if ruleset == "egress" {
-					req := &ec2.AuthorizeSecurityGroupEgressInput{
-						GroupId:       group.GroupId,
-						IpPermissions: add,
-					}
-					_, err = conn.AuthorizeSecurityGroupEgress(req)
-				} else {
-					req := &ec2.AuthorizeSecurityGroupIngressInput{
-						GroupId:       group.GroupId,
-						IpPermissions: add,
-					}
-					if group.VpcId == nil || *group.VpcId == "" {
-						req.GroupId = nil
-						req.GroupName = group.GroupName
-					}
-
-					_, err = conn.AuthorizeSecurityGroupIngress(req)
-				}
+		if err != nil {
+			return fmt.Errorf(
+				"Error revoking security group %s rules: %s",
+				ruleset, err)
+		}
+	}
 
-				if err != nil {
-					return fmt.Errorf(
-						"Error authorizing security group %s rules: %s",
-						ruleset, err)
-				}
+	if len(add) > 0 {
+		log.Printf("[DEBUG] Authorizing security group %#v %s rule: %#v",
+			group, ruleset, add)
+		// Authorize the new rules
+		if ruleset == "egress" {
+			req := &ec2.AuthorizeSecurityGroupEgressInput{
+				GroupId:       group.GroupId,
+				IpPermissions: add,
+			}
+			_, err = conn.AuthorizeSecurityGroupEgress(req)
+		} else {
+			req := &ec2.AuthorizeSecurityGroupIngressInput{
+				GroupId:       group.GroupId,
+				IpPermissions: add,
+			}
+			if group.VpcId == nil || *group.VpcId == "" {
+				req.GroupId = nil
+				req.GroupName = group.GroupName
 			}
+
+			_, err = conn.AuthorizeSecurityGroupIngress(req)
+		}
+
+		if err != nil {
+			return fmt.Errorf(
+				"Error authorizing security group %s rules: %s",
+				ruleset, err)
 		}
 	}
+
 	return nil
 }
 
@@ -145,7 +145,7 @@ func resourceAwsSecurityGroupRule() *schema.Resource {
 }
 
 func resourceAwsSecurityGroupRuleCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	sg_id := d.Get("security_group_id").(string)
 
 	awsMutexKV.Lock(sg_id)
@@ -262,7 +262,7 @@ information and instructions for recovery. Error message: %s`, sg_id, awsErr.Mes
 }
 
 func resourceAwsSecurityGroupRuleRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	sg_id := d.Get("security_group_id").(string)
 	sg, err := findResourceSecurityGroup(conn, sg_id)
 	if _, notFound := err.(securityGroupNotFound); notFound {
@@ -327,7 +327,7 @@ func resourceAwsSecurityGroupRuleRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsSecurityGroupRuleUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	if d.HasChange("description") {
 		if err := resourceSecurityGroupRuleDescriptionUpdate(conn, d); err != nil {
@@ -339,7 +339,7 @@ func resourceAwsSecurityGroupRuleUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsSecurityGroupRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	sg_id := d.Get("security_group_id").(string)
 
 	awsMutexKV.Lock(sg_id)
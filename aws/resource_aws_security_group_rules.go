@@ -0,0 +1,327 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsSecurityGroupRules() *schema.Resource {
+	// reuse the aws_security_group rule schemas, so that rules are hashed,
+	// expanded and collapsed the same way
+	sg := resourceAwsSecurityGroup()
+
+	// We want exclusive management of the rules here, so we do not allow them
+	// to be computed. Instead, an empty config will enforce just that; removal
+	// of the rules
+	ingress := sg.Schema["ingress"]
+	ingress.Computed = false
+	egress := sg.Schema["egress"]
+	egress.Computed = false
+
+	return &schema.Resource{
+		Create: resourceAwsSecurityGroupRulesCreate,
+		Read:   resourceAwsSecurityGroupRulesRead,
+		Update: resourceAwsSecurityGroupRulesUpdate,
+		Delete: resourceAwsSecurityGroupRulesDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceAwsSecurityGroupRulesCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"security_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"ingress": ingress,
+
+			"egress": egress,
+
+			"unmanaged_rules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceAwsSecurityGroupRulesCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn()
+	sgID := d.Get("security_group_id").(string)
+
+	awsMutexKV.Lock(sgID)
+	defer awsMutexKV.Unlock(sgID)
+
+	group, err := findResourceSecurityGroup(conn, sgID)
+	if err != nil {
+		return fmt.Errorf("Error reading Security Group (%s): %s", sgID, err)
+	}
+
+	isVPC := aws.StringValue(group.VpcId) != ""
+	egress := d.Get("egress").(*schema.Set)
+
+	if !isVPC && egress.Len() > 0 {
+		return fmt.Errorf("Security Group (%s) is an EC2-Classic security group, egress rules are not supported", sgID)
+	}
+
+	// Replace all the existing rules of the group, including the default
+	// egress rule of VPC security groups, with the declared ones.
+	remoteIngress := resourceAwsSecurityGroupRulesSet(resourceAwsSecurityGroupIPPermGather(sgID, group.IpPermissions, group.OwnerId))
+	if err := resourceAwsSecurityGroupReplaceRules(conn, group, "ingress", remoteIngress, d.Get("ingress").(*schema.Set)); err != nil {
+		return err
+	}
+
+	if isVPC {
+		remoteEgress := resourceAwsSecurityGroupRulesSet(resourceAwsSecurityGroupIPPermGather(sgID, group.IpPermissionsEgress, group.OwnerId))
+		if err := resourceAwsSecurityGroupReplaceRules(conn, group, "egress", remoteEgress, egress); err != nil {
+			return err
+		}
+	}
+
+	d.SetId(sgID)
+
+	return resourceAwsSecurityGroupRulesRead(d, meta)
+}
+
+func resourceAwsSecurityGroupRulesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn()
+
+	group, err := findResourceSecurityGroup(conn, d.Id())
+	if _, ok := err.(securityGroupNotFound); ok {
+		log.Printf("[WARN] Security Group (%s) not found, removing rules from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error reading Security Group (%s): %s", d.Id(), err)
+	}
+
+	// Drift is only reported for rules already managed by this resource, and
+	// not when importing the rules of a group.
+	reportDrift := !d.IsNewResource() && d.Get("security_group_id").(string) != ""
+
+	d.Set("security_group_id", group.GroupId)
+
+	rulesets := map[string][]*ec2.IpPermission{
+		"ingress": group.IpPermissions,
+	}
+	if aws.StringValue(group.VpcId) != "" {
+		rulesets["egress"] = group.IpPermissionsEgress
+	}
+
+	unmanagedRules := make([]interface{}, 0)
+
+	for _, ruleset := range []string{"ingress", "egress"} {
+		permissions, ok := rulesets[ruleset]
+		if !ok {
+			continue
+		}
+
+		remoteRules := resourceAwsSecurityGroupIPPermGather(d.Id(), permissions, group.OwnerId)
+		localRules := d.Get(ruleset).(*schema.Set)
+
+		if reportDrift {
+			unmanagedRules = append(unmanagedRules, resourceAwsSecurityGroupRulesDrift(d.Id(), ruleset, localRules, resourceAwsSecurityGroupRulesSet(remoteRules))...)
+		}
+
+		// Loop through the local state of rules, doing a match against the
+		// remote rules so that the rules keep the shape they are configured
+		// with.
+		rules := matchRules(ruleset, localRules.List(), remoteRules)
+
+		if err := d.Set(ruleset, rules); err != nil {
+			return fmt.Errorf("error setting %s: %s", ruleset, err)
+		}
+	}
+
+	if err := d.Set("unmanaged_rules", unmanagedRules); err != nil {
+		return fmt.Errorf("error setting unmanaged_rules: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsSecurityGroupRulesUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn()
+	sgID := d.Id()
+
+	awsMutexKV.Lock(sgID)
+	defer awsMutexKV.Unlock(sgID)
+
+	group, err := findResourceSecurityGroup(conn, sgID)
+	if err != nil {
+		return fmt.Errorf("Error reading Security Group (%s): %s", sgID, err)
+	}
+
+	for _, ruleset := range []string{"ingress", "egress"} {
+		if !d.HasChange(ruleset) {
+			continue
+		}
+
+		o, n := d.GetChange(ruleset)
+
+		if ruleset == "egress" && aws.StringValue(group.VpcId) == "" && n.(*schema.Set).Len() > 0 {
+			return fmt.Errorf("Security Group (%s) is an EC2-Classic security group, egress rules are not supported", sgID)
+		}
+
+		if err := resourceAwsSecurityGroupReplaceRules(conn, group, ruleset, o.(*schema.Set), n.(*schema.Set)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsSecurityGroupRulesRead(d, meta)
+}
+
+func resourceAwsSecurityGroupRulesDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn()
+	sgID := d.Id()
+
+	awsMutexKV.Lock(sgID)
+	defer awsMutexKV.Unlock(sgID)
+
+	group, err := findResourceSecurityGroup(conn, sgID)
+	if _, ok := err.(securityGroupNotFound); ok {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error reading Security Group (%s): %s", sgID, err)
+	}
+
+	for _, ruleset := range []string{"ingress", "egress"} {
+		rules := d.Get(ruleset).(*schema.Set)
+		if rules.Len() == 0 {
+			continue
+		}
+
+		if err := resourceAwsSecurityGroupReplaceRules(conn, group, ruleset, rules, schema.NewSet(resourceAwsSecurityGroupRuleHash, nil)); err != nil {
+			if isAWSErr(err, "InvalidPermission.NotFound", "") {
+				continue
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resourceAwsSecurityGroupRulesSet converts rules gathered with
+// resourceAwsSecurityGroupIPPermGather to a set of rules as they are found in
+// the ingress and egress attributes.
+func resourceAwsSecurityGroupRulesSet(rules []map[string]interface{}) *schema.Set {
+	set := schema.NewSet(resourceAwsSecurityGroupRuleHash, nil)
+
+	for _, r := range rules {
+		rule := map[string]interface{}{
+			"from_port":   int(r["from_port"].(int64)),
+			"to_port":     int(r["to_port"].(int64)),
+			"protocol":    r["protocol"].(string),
+			"self":        false,
+			"description": "",
+		}
+
+		if v, ok := r["self"]; ok {
+			rule["self"] = v.(bool)
+		}
+		if v, ok := r["description"]; ok {
+			rule["description"] = v.(string)
+		}
+
+		for _, key := range []string{"cidr_blocks", "ipv6_cidr_blocks", "prefix_list_ids"} {
+			if v, ok := r[key]; ok {
+				var list []interface{}
+				for _, s := range v.([]string) {
+					list = append(list, s)
+				}
+				rule[key] = list
+			}
+		}
+
+		if v, ok := r["security_groups"]; ok {
+			rule["security_groups"] = v
+		}
+
+		set.Add(rule)
+	}
+
+	return set
+}
+
+func resourceAwsSecurityGroupRulesCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	// The rules not managed by Terraform are revoked on apply, list each of
+	// them as removed in the plan.
+	if len(d.Get("unmanaged_rules").([]interface{})) > 0 {
+		return d.SetNew("unmanaged_rules", []interface{}{})
+	}
+
+	return nil
+}
+
+// resourceAwsSecurityGroupRulesDrift logs, one rule at a time, the
+// differences between the rules in state and the rules of the group. It
+// returns the sorted descriptions of the rules of the group missing from
+// state, prefixed with the ruleset, e.g. "ingress tcp 22-22 0.0.0.0/0".
+func resourceAwsSecurityGroupRulesDrift(sgID, ruleset string, local, remote *schema.Set) []interface{} {
+	ls := resourceAwsSecurityGroupExpandRules(local)
+	rs := resourceAwsSecurityGroupExpandRules(remote)
+
+	for _, rule := range ls.Difference(rs).List() {
+		log.Printf("[WARN] Security Group (%s) %s rule %s not found, it will be authorized again",
+			sgID, ruleset, resourceAwsSecurityGroupRulesString(rule.(map[string]interface{})))
+	}
+
+	var unmanaged []string
+	for _, rule := range rs.Difference(ls).List() {
+		s := resourceAwsSecurityGroupRulesString(rule.(map[string]interface{}))
+		log.Printf("[WARN] Security Group (%s) %s rule %s is not managed by Terraform, it will be revoked", sgID, ruleset, s)
+		unmanaged = append(unmanaged, fmt.Sprintf("%s %s", ruleset, s))
+	}
+	sort.Strings(unmanaged)
+
+	rules := make([]interface{}, len(unmanaged))
+	for i, s := range unmanaged {
+		rules[i] = s
+	}
+
+	return rules
+}
+
+// resourceAwsSecurityGroupRulesString returns a description of a rule
+// expanded with resourceAwsSecurityGroupExpandRules, e.g.
+// "tcp 80-80 10.0.0.0/8".
+func resourceAwsSecurityGroupRulesString(rule map[string]interface{}) string {
+	var sources []string
+
+	if v, ok := rule["self"]; ok && v.(bool) {
+		sources = append(sources, "self")
+	}
+	for _, key := range []string{"cidr_blocks", "ipv6_cidr_blocks", "prefix_list_ids"} {
+		if v, ok := rule[key]; ok {
+			for _, s := range v.([]interface{}) {
+				sources = append(sources, s.(string))
+			}
+		}
+	}
+	if v, ok := rule["security_groups"]; ok {
+		for _, s := range v.(*schema.Set).List() {
+			sources = append(sources, s.(string))
+		}
+	}
+	sort.Strings(sources)
+
+	s := fmt.Sprintf("%s %d-%d %s", protocolForValue(rule["protocol"].(string)), rule["from_port"].(int), rule["to_port"].(int), strings.Join(sources, ","))
+	if v, ok := rule["description"]; ok && v.(string) != "" {
+		s += fmt.Sprintf(" (%s)", v.(string))
+	}
+
+	return s
+}
@@ -0,0 +1,403 @@
+package aws
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/fakeaws"
+)
+
+func TestResourceAwsSecurityGroupRulesSet(t *testing.T) {
+	groups := schema.NewSet(schema.HashString, []interface{}{"sg-11111111"})
+
+	remote := []map[string]interface{}{
+		{
+			"protocol":    "tcp",
+			"from_port":   int64(80),
+			"to_port":     int64(80),
+			"cidr_blocks": []string{"10.0.0.0/16", "10.1.0.0/16"},
+		},
+		{
+			"protocol":        "-1",
+			"from_port":       int64(0),
+			"to_port":         int64(0),
+			"self":            true,
+			"security_groups": groups,
+			"description":     "internal",
+		},
+	}
+
+	set := resourceAwsSecurityGroupRulesSet(remote)
+	if set.Len() != 2 {
+		t.Fatalf("expected 2 rules, got %d", set.Len())
+	}
+
+	local := []interface{}{
+		map[string]interface{}{
+			"protocol":    "tcp",
+			"from_port":   80,
+			"to_port":     80,
+			"self":        false,
+			"description": "",
+			"cidr_blocks": []interface{}{"10.1.0.0/16", "10.0.0.0/16"},
+		},
+		map[string]interface{}{
+			"protocol":        "-1",
+			"from_port":       0,
+			"to_port":         0,
+			"self":            true,
+			"description":     "internal",
+			"security_groups": schema.NewSet(schema.HashString, []interface{}{"sg-11111111"}),
+		},
+	}
+
+	for _, rule := range local {
+		if !set.Contains(rule) {
+			t.Errorf("expected rule %#v in set, got: %#v", rule, set.List())
+		}
+	}
+
+	expanded := resourceAwsSecurityGroupExpandRules(set)
+	if expanded.Len() != 4 {
+		t.Fatalf("expected 4 expanded rules, got %d", expanded.Len())
+	}
+
+	var descriptions []string
+	for _, rule := range expanded.List() {
+		descriptions = append(descriptions, resourceAwsSecurityGroupRulesString(rule.(map[string]interface{})))
+	}
+
+	for _, expected := range []string{"tcp 80-80 10.0.0.0/16", "tcp 80-80 10.1.0.0/16", "-1 0-0 self (internal)", "-1 0-0 sg-11111111 (internal)"} {
+		found := false
+		for _, d := range descriptions {
+			if d == expected {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("expected rule %q, got: %q", expected, descriptions)
+		}
+	}
+}
+
+func TestResourceAwsSecurityGroupRulesDrift(t *testing.T) {
+	local := resourceAwsSecurityGroupRulesSet([]map[string]interface{}{
+		{
+			"protocol":    "tcp",
+			"from_port":   int64(80),
+			"to_port":     int64(80),
+			"cidr_blocks": []string{"10.0.0.0/16", "10.1.0.0/16"},
+		},
+	})
+
+	remote := resourceAwsSecurityGroupRulesSet([]map[string]interface{}{
+		{
+			"protocol":    "tcp",
+			"from_port":   int64(80),
+			"to_port":     int64(80),
+			"cidr_blocks": []string{"10.0.0.0/16", "192.168.0.0/24"},
+		},
+		{
+			"protocol":    "tcp",
+			"from_port":   int64(22),
+			"to_port":     int64(22),
+			"cidr_blocks": []string{"0.0.0.0/0"},
+		},
+	})
+
+	expected := []interface{}{"ingress tcp 22-22 0.0.0.0/0", "ingress tcp 80-80 192.168.0.0/24"}
+	if got := resourceAwsSecurityGroupRulesDrift("sg-11111111", "ingress", local, remote); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected unmanaged rules %q, got %q", expected, got)
+	}
+
+	if got := resourceAwsSecurityGroupRulesDrift("sg-11111111", "ingress", local, local); len(got) != 0 {
+		t.Errorf("expected no unmanaged rules, got %q", got)
+	}
+}
+
+func TestAccAWSSecurityGroupRules_basic(t *testing.T) {
+	var group ec2.SecurityGroup
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_security_group_rules.test"
+
+	testAccCassetteParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSecurityGroupRulesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecurityGroupRulesConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityGroupExists("aws_security_group.test", &group),
+					testAccCheckAWSSecurityGroupRulesCount(&group, 3, 1),
+					resource.TestCheckResourceAttrPair(resourceName, "security_group_id", "aws_security_group.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "ingress.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "egress.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSecurityGroupRules_update(t *testing.T) {
+	var group ec2.SecurityGroup
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_security_group_rules.test"
+
+	testAccCassetteParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSecurityGroupRulesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecurityGroupRulesConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityGroupExists("aws_security_group.test", &group),
+					testAccCheckAWSSecurityGroupRulesCount(&group, 3, 1),
+				),
+			},
+			{
+				Config: testAccAWSSecurityGroupRulesConfigUpdated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityGroupExists("aws_security_group.test", &group),
+					testAccCheckAWSSecurityGroupRulesCount(&group, 1, 0),
+					resource.TestCheckResourceAttr(resourceName, "ingress.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "egress.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "unmanaged_rules.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSecurityGroupRules_revokesUndeclared(t *testing.T) {
+	var group ec2.SecurityGroup
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	testAccCassetteParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSecurityGroupRulesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecurityGroupRulesConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityGroupExists("aws_security_group.test", &group),
+					testAccCheckAWSSecurityGroupRulesAuthorizeIngress(&group),
+					testAccCheckAWSSecurityGroupRulesCount(&group, 4, 1),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccAWSSecurityGroupRulesConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityGroupExists("aws_security_group.test", &group),
+					testAccCheckAWSSecurityGroupRulesCount(&group, 3, 1),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceAwsSecurityGroupRules_fakeAws(t *testing.T) {
+	server := fakeaws.New()
+	defer server.Close()
+
+	var group ec2.SecurityGroup
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_security_group_rules.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSecurityGroupRulesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFakeAwsProviderConfig(server.URL) + testAccAWSSecurityGroupRulesConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityGroupExists("aws_security_group.test", &group),
+					testAccCheckAWSSecurityGroupRulesCount(&group, 3, 1),
+					resource.TestCheckResourceAttr(resourceName, "ingress.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "egress.#", "1"),
+				),
+			},
+			{
+				Config: testAccFakeAwsProviderConfig(server.URL) + testAccAWSSecurityGroupRulesConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityGroupRulesAuthorizeIngress(&group),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccFakeAwsProviderConfig(server.URL) + testAccAWSSecurityGroupRulesConfigUpdated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityGroupExists("aws_security_group.test", &group),
+					testAccCheckAWSSecurityGroupRulesCount(&group, 1, 0),
+					resource.TestCheckResourceAttr(resourceName, "ingress.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "egress.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "unmanaged_rules.#", "0"),
+				),
+			},
+			{
+				Config:            testAccFakeAwsProviderConfig(server.URL),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSecurityGroupRulesDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_security_group_rules" {
+			continue
+		}
+
+		group, err := findResourceSecurityGroup(conn, rs.Primary.ID)
+		if _, ok := err.(securityGroupNotFound); ok {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if len(group.IpPermissions) > 0 || len(group.IpPermissionsEgress) > 0 {
+			return fmt.Errorf("Security Group (%s) still has rules", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+// testAccCheckAWSSecurityGroupRulesCount checks the number of individual
+// sources and destinations of the ingress and egress rules of the group.
+func testAccCheckAWSSecurityGroupRulesCount(group *ec2.SecurityGroup, ingress, egress int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
+
+		g, err := findResourceSecurityGroup(conn, aws.StringValue(group.GroupId))
+		if err != nil {
+			return err
+		}
+
+		count := func(permissions []*ec2.IpPermission) int {
+			n := 0
+			for _, p := range permissions {
+				n += len(p.IpRanges) + len(p.Ipv6Ranges) + len(p.PrefixListIds) + len(p.UserIdGroupPairs)
+			}
+			return n
+		}
+
+		if n := count(g.IpPermissions); n != ingress {
+			return fmt.Errorf("expected %d ingress rules, got %d: %s", ingress, n, g.IpPermissions)
+		}
+
+		if n := count(g.IpPermissionsEgress); n != egress {
+			return fmt.Errorf("expected %d egress rules, got %d: %s", egress, n, g.IpPermissionsEgress)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckAWSSecurityGroupRulesAuthorizeIngress authorizes an ingress
+// rule outside of Terraform.
+func testAccCheckAWSSecurityGroupRulesAuthorizeIngress(group *ec2.SecurityGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
+
+		_, err := conn.AuthorizeSecurityGroupIngress(&ec2.AuthorizeSecurityGroupIngressInput{
+			GroupId: group.GroupId,
+			IpPermissions: []*ec2.IpPermission{
+				{
+					FromPort:   aws.Int64(22),
+					ToPort:     aws.Int64(22),
+					IpProtocol: aws.String("tcp"),
+					IpRanges: []*ec2.IpRange{
+						{
+							CidrIp: aws.String("192.168.0.0/24"),
+						},
+					},
+				},
+			},
+		})
+
+		return err
+	}
+}
+
+func testAccAWSSecurityGroupRulesConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = "${aws_vpc.test.id}"
+}
+`, rName)
+}
+
+func testAccAWSSecurityGroupRulesConfig(rName string) string {
+	return testAccAWSSecurityGroupRulesConfigBase(rName) + `
+resource "aws_security_group_rules" "test" {
+  security_group_id = "${aws_security_group.test.id}"
+
+  ingress {
+    protocol    = "tcp"
+    from_port   = 80
+    to_port     = 80
+    cidr_blocks = ["10.0.0.0/16", "10.1.0.0/16"]
+  }
+
+  ingress {
+    protocol  = "-1"
+    from_port = 0
+    to_port   = 0
+    self      = true
+  }
+
+  egress {
+    protocol    = "-1"
+    from_port   = 0
+    to_port     = 0
+    cidr_blocks = ["0.0.0.0/0"]
+  }
+}
+`
+}
+
+func testAccAWSSecurityGroupRulesConfigUpdated(rName string) string {
+	return testAccAWSSecurityGroupRulesConfigBase(rName) + `
+resource "aws_security_group_rules" "test" {
+  security_group_id = "${aws_security_group.test.id}"
+
+  ingress {
+    protocol    = "tcp"
+    from_port   = 443
+    to_port     = 443
+    cidr_blocks = ["10.0.0.0/16"]
+    description = "HTTPS"
+  }
+}
+`
+}
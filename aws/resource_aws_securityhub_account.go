@@ -22,7 +22,7 @@ func resourceAwsSecurityHubAccount() *schema.Resource {
 }
 
 func resourceAwsSecurityHubAccountCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).securityhubconn
+	conn := meta.(*AWSClient).securityhubconn()
 	log.Print("[DEBUG] Enabling Security Hub for account")
 
 	_, err := conn.EnableSecurityHub(&securityhub.EnableSecurityHubInput{})
@@ -37,7 +37,7 @@ func resourceAwsSecurityHubAccountCreate(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsSecurityHubAccountRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).securityhubconn
+	conn := meta.(*AWSClient).securityhubconn()
 
 	log.Printf("[DEBUG] Checking if Security Hub is enabled")
 	_, err := conn.GetEnabledStandards(&securityhub.GetEnabledStandardsInput{})
@@ -55,7 +55,7 @@ func resourceAwsSecurityHubAccountRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsSecurityHubAccountDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).securityhubconn
+	conn := meta.(*AWSClient).securityhubconn()
 	log.Print("[DEBUG] Disabling Security Hub for account")
 
 	_, err := conn.DisableSecurityHub(&securityhub.DisableSecurityHubInput{})
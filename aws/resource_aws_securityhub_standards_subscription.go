@@ -30,7 +30,7 @@ func resourceAwsSecurityHubStandardsSubscription() *schema.Resource {
 }
 
 func resourceAwsSecurityHubStandardsSubscriptionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).securityhubconn
+	conn := meta.(*AWSClient).securityhubconn()
 	log.Printf("[DEBUG] Enabling Security Hub standard %s", d.Get("standards_arn"))
 
 	resp, err := conn.BatchEnableStandards(&securityhub.BatchEnableStandardsInput{
@@ -53,7 +53,7 @@ func resourceAwsSecurityHubStandardsSubscriptionCreate(d *schema.ResourceData, m
 }
 
 func resourceAwsSecurityHubStandardsSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).securityhubconn
+	conn := meta.(*AWSClient).securityhubconn()
 
 	log.Printf("[DEBUG] Reading Security Hub standard %s", d.Id())
 	resp, err := conn.GetEnabledStandards(&securityhub.GetEnabledStandardsInput{
@@ -78,7 +78,7 @@ func resourceAwsSecurityHubStandardsSubscriptionRead(d *schema.ResourceData, met
 }
 
 func resourceAwsSecurityHubStandardsSubscriptionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).securityhubconn
+	conn := meta.(*AWSClient).securityhubconn()
 	log.Printf("[DEBUG] Disabling Security Hub standard %s", d.Id())
 
 	_, err := conn.BatchDisableStandards(&securityhub.BatchDisableStandardsInput{
@@ -41,7 +41,7 @@ func resourceAwsServiceDiscoveryHttpNamespace() *schema.Resource {
 }
 
 func resourceAwsServiceDiscoveryHttpNamespaceCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sdconn
+	conn := meta.(*AWSClient).sdconn()
 
 	name := d.Get("name").(string)
 
@@ -76,7 +76,7 @@ func resourceAwsServiceDiscoveryHttpNamespaceCreate(d *schema.ResourceData, meta
 }
 
 func resourceAwsServiceDiscoveryHttpNamespaceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sdconn
+	conn := meta.(*AWSClient).sdconn()
 
 	input := &servicediscovery.GetNamespaceInput{
 		Id: aws.String(d.Id()),
@@ -99,7 +99,7 @@ func resourceAwsServiceDiscoveryHttpNamespaceRead(d *schema.ResourceData, meta i
 }
 
 func resourceAwsServiceDiscoveryHttpNamespaceDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sdconn
+	conn := meta.(*AWSClient).sdconn()
 
 	input := &servicediscovery.DeleteNamespaceInput{
 		Id: aws.String(d.Id()),
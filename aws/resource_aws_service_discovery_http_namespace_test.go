@@ -64,7 +64,7 @@ func TestAccAWSServiceDiscoveryHttpNamespace_Description(t *testing.T) {
 }
 
 func testAccCheckAwsServiceDiscoveryHttpNamespaceDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).sdconn
+	conn := testAccProvider.Meta().(*AWSClient).sdconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_service_discovery_http_namespace" {
@@ -93,7 +93,7 @@ func testAccCheckAwsServiceDiscoveryHttpNamespaceExists(name string) resource.Te
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).sdconn
+		conn := testAccProvider.Meta().(*AWSClient).sdconn()
 
 		input := &servicediscovery.GetNamespaceInput{
 			Id: aws.String(rs.Primary.ID),
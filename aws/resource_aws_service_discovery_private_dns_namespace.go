@@ -44,7 +44,7 @@ func resourceAwsServiceDiscoveryPrivateDnsNamespace() *schema.Resource {
 }
 
 func resourceAwsServiceDiscoveryPrivateDnsNamespaceCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sdconn
+	conn := meta.(*AWSClient).sdconn()
 
 	name := d.Get("name").(string)
 	// The CreatorRequestId has a limit of 64 bytes
@@ -87,7 +87,7 @@ func resourceAwsServiceDiscoveryPrivateDnsNamespaceCreate(d *schema.ResourceData
 }
 
 func resourceAwsServiceDiscoveryPrivateDnsNamespaceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sdconn
+	conn := meta.(*AWSClient).sdconn()
 
 	input := &servicediscovery.GetNamespaceInput{
 		Id: aws.String(d.Id()),
@@ -111,7 +111,7 @@ func resourceAwsServiceDiscoveryPrivateDnsNamespaceRead(d *schema.ResourceData,
 }
 
 func resourceAwsServiceDiscoveryPrivateDnsNamespaceDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sdconn
+	conn := meta.(*AWSClient).sdconn()
 
 	input := &servicediscovery.DeleteNamespaceInput{
 		Id: aws.String(d.Id()),
@@ -73,7 +73,7 @@ func TestAccAWSServiceDiscoveryPrivateDnsNamespace_error_Overlap(t *testing.T) {
 }
 
 func testAccCheckAwsServiceDiscoveryPrivateDnsNamespaceDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).sdconn
+	conn := testAccProvider.Meta().(*AWSClient).sdconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_service_discovery_private_dns_namespace" {
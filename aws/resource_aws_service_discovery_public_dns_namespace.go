@@ -44,7 +44,7 @@ func resourceAwsServiceDiscoveryPublicDnsNamespace() *schema.Resource {
 }
 
 func resourceAwsServiceDiscoveryPublicDnsNamespaceCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sdconn
+	conn := meta.(*AWSClient).sdconn()
 
 	name := d.Get("name").(string)
 	// The CreatorRequestId has a limit of 64 bytes
@@ -86,7 +86,7 @@ func resourceAwsServiceDiscoveryPublicDnsNamespaceCreate(d *schema.ResourceData,
 }
 
 func resourceAwsServiceDiscoveryPublicDnsNamespaceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sdconn
+	conn := meta.(*AWSClient).sdconn()
 
 	input := &servicediscovery.GetNamespaceInput{
 		Id: aws.String(d.Id()),
@@ -111,7 +111,7 @@ func resourceAwsServiceDiscoveryPublicDnsNamespaceRead(d *schema.ResourceData, m
 }
 
 func resourceAwsServiceDiscoveryPublicDnsNamespaceDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sdconn
+	conn := meta.(*AWSClient).sdconn()
 
 	input := &servicediscovery.DeleteNamespaceInput{
 		Id: aws.String(d.Id()),
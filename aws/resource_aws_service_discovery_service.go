@@ -130,7 +130,7 @@ func resourceAwsServiceDiscoveryService() *schema.Resource {
 }
 
 func resourceAwsServiceDiscoveryServiceCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sdconn
+	conn := meta.(*AWSClient).sdconn()
 
 	input := &servicediscovery.CreateServiceInput{
 		Name:      aws.String(d.Get("name").(string)),
@@ -162,7 +162,7 @@ func resourceAwsServiceDiscoveryServiceCreate(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsServiceDiscoveryServiceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sdconn
+	conn := meta.(*AWSClient).sdconn()
 
 	input := &servicediscovery.GetServiceInput{
 		Id: aws.String(d.Id()),
@@ -189,7 +189,7 @@ func resourceAwsServiceDiscoveryServiceRead(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsServiceDiscoveryServiceUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sdconn
+	conn := meta.(*AWSClient).sdconn()
 
 	input := &servicediscovery.UpdateServiceInput{
 		Id: aws.String(d.Id()),
@@ -232,7 +232,7 @@ func resourceAwsServiceDiscoveryServiceUpdate(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsServiceDiscoveryServiceDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sdconn
+	conn := meta.(*AWSClient).sdconn()
 
 	input := &servicediscovery.DeleteServiceInput{
 		Id: aws.String(d.Id()),
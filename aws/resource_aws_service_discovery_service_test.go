@@ -100,7 +100,7 @@ func TestAccAWSServiceDiscoveryService_import(t *testing.T) {
 }
 
 func testAccCheckAwsServiceDiscoveryServiceDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).sdconn
+	conn := testAccProvider.Meta().(*AWSClient).sdconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_service_discovery_service" {
@@ -129,7 +129,7 @@ func testAccCheckAwsServiceDiscoveryServiceExists(name string) resource.TestChec
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).sdconn
+		conn := testAccProvider.Meta().(*AWSClient).sdconn()
 
 		input := &servicediscovery.GetServiceInput{
 			Id: aws.String(rs.Primary.ID),
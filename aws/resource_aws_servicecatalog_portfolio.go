@@ -59,7 +59,7 @@ func resourceAwsServiceCatalogPortfolio() *schema.Resource {
 	}
 }
 func resourceAwsServiceCatalogPortfolioCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).scconn
+	conn := meta.(*AWSClient).scconn()
 	input := servicecatalog.CreatePortfolioInput{
 		AcceptLanguage: aws.String("en"),
 	}
@@ -100,7 +100,7 @@ func resourceAwsServiceCatalogPortfolioCreate(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsServiceCatalogPortfolioRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).scconn
+	conn := meta.(*AWSClient).scconn()
 	input := servicecatalog.DescribePortfolioInput{
 		AcceptLanguage: aws.String("en"),
 	}
@@ -133,7 +133,7 @@ func resourceAwsServiceCatalogPortfolioRead(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsServiceCatalogPortfolioUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).scconn
+	conn := meta.(*AWSClient).scconn()
 	input := servicecatalog.UpdatePortfolioInput{
 		AcceptLanguage: aws.String("en"),
 		Id:             aws.String(d.Id()),
@@ -159,8 +159,8 @@ func resourceAwsServiceCatalogPortfolioUpdate(d *schema.ResourceData, meta inter
 		input.ProviderName = aws.String(v.(string))
 	}
 
-	if d.HasChange("tags") {
-		currentTags, requiredTags := d.GetChange("tags")
+	if hasTagsChange(d) {
+		currentTags, requiredTags := getTagsChange(d)
 		log.Printf("[DEBUG] Current Tags: %#v", currentTags)
 		log.Printf("[DEBUG] Required Tags: %#v", requiredTags)
 
@@ -212,7 +212,7 @@ func tagUpdates(requriedTags, currentTags map[string]interface{}) ([]*servicecat
 }
 
 func resourceAwsServiceCatalogPortfolioDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).scconn
+	conn := meta.(*AWSClient).scconn()
 	input := servicecatalog.DeletePortfolioInput{}
 	input.Id = aws.String(d.Id())
 
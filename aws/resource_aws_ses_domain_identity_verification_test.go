@@ -91,7 +91,7 @@ func testAccCheckAwsSesDomainIdentityVerificationPassed(n string) resource.TestC
 
 		domain := rs.Primary.ID
 		awsClient := testAccProvider.Meta().(*AWSClient)
-		conn := awsClient.sesConn
+		conn := awsClient.sesConn()
 
 		params := &ses.GetIdentityVerificationAttributesInput{
 			Identities: []*string{
@@ -50,7 +50,7 @@ func resourceAwsSesNotificationTopic() *schema.Resource {
 }
 
 func resourceAwsSesNotificationTopicSet(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sesConn
+	conn := meta.(*AWSClient).sesConn()
 	notification := d.Get("notification_type").(string)
 	identity := d.Get("identity").(string)
 
@@ -75,7 +75,7 @@ func resourceAwsSesNotificationTopicSet(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsSesNotificationTopicRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sesConn
+	conn := meta.(*AWSClient).sesConn()
 
 	identity, notificationType, err := decodeSesIdentityNotificationTopicId(d.Id())
 	if err != nil {
@@ -120,7 +120,7 @@ func resourceAwsSesNotificationTopicRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsSesNotificationTopicDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sesConn
+	conn := meta.(*AWSClient).sesConn()
 
 	identity, notificationType, err := decodeSesIdentityNotificationTopicId(d.Id())
 	if err != nil {
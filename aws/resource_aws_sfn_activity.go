@@ -41,7 +41,7 @@ func resourceAwsSfnActivity() *schema.Resource {
 }
 
 func resourceAwsSfnActivityCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sfnconn
+	conn := meta.(*AWSClient).sfnconn()
 	log.Print("[DEBUG] Creating Step Function Activity")
 
 	params := &sfn.CreateActivityInput{
@@ -70,10 +70,10 @@ func resourceAwsSfnActivityCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsSfnActivityUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sfnconn
+	conn := meta.(*AWSClient).sfnconn()
 
-	if d.HasChange("tags") {
-		oldTagsRaw, newTagsRaw := d.GetChange("tags")
+	if hasTagsChange(d) {
+		oldTagsRaw, newTagsRaw := getTagsChange(d)
 		oldTagsMap := oldTagsRaw.(map[string]interface{})
 		newTagsMap := newTagsRaw.(map[string]interface{})
 		createTags, removeTags := diffTagsSfn(tagsFromMapSfn(oldTagsMap), tagsFromMapSfn(newTagsMap))
@@ -112,7 +112,7 @@ func resourceAwsSfnActivityUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsSfnActivityRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sfnconn
+	conn := meta.(*AWSClient).sfnconn()
 	log.Printf("[DEBUG] Reading Step Function Activity: %s", d.Id())
 
 	sm, err := conn.DescribeActivity(&sfn.DescribeActivityInput{
@@ -150,7 +150,7 @@ func resourceAwsSfnActivityRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsSfnActivityDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sfnconn
+	conn := meta.(*AWSClient).sfnconn()
 	log.Printf("[DEBUG] Deleting Step Functions Activity: %s", d.Id())
 
 	return resource.Retry(5*time.Minute, func() *resource.RetryError {
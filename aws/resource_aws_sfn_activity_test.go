@@ -103,7 +103,7 @@ func testAccCheckAWSSfnActivityExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No Step Function ID set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).sfnconn
+		conn := testAccProvider.Meta().(*AWSClient).sfnconn()
 
 		_, err := conn.DescribeActivity(&sfn.DescribeActivityInput{
 			ActivityArn: aws.String(rs.Primary.ID),
@@ -114,7 +114,7 @@ func testAccCheckAWSSfnActivityExists(n string) resource.TestCheckFunc {
 }
 
 func testAccCheckAWSSfnActivityDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).sfnconn
+	conn := testAccProvider.Meta().(*AWSClient).sfnconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_sfn_activity" {
@@ -58,7 +58,7 @@ func resourceAwsSfnStateMachine() *schema.Resource {
 }
 
 func resourceAwsSfnStateMachineCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sfnconn
+	conn := meta.(*AWSClient).sfnconn()
 	log.Print("[DEBUG] Creating Step Function State Machine")
 
 	params := &sfn.CreateStateMachineInput{
@@ -108,7 +108,7 @@ func resourceAwsSfnStateMachineCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsSfnStateMachineRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sfnconn
+	conn := meta.(*AWSClient).sfnconn()
 	log.Printf("[DEBUG] Reading Step Function State Machine: %s", d.Id())
 
 	sm, err := conn.DescribeStateMachine(&sfn.DescribeStateMachineInput{
@@ -149,7 +149,7 @@ func resourceAwsSfnStateMachineRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsSfnStateMachineUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sfnconn
+	conn := meta.(*AWSClient).sfnconn()
 
 	params := &sfn.UpdateStateMachineInput{
 		StateMachineArn: aws.String(d.Id()),
@@ -168,8 +168,8 @@ func resourceAwsSfnStateMachineUpdate(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
-	if d.HasChange("tags") {
-		oldTagsRaw, newTagsRaw := d.GetChange("tags")
+	if hasTagsChange(d) {
+		oldTagsRaw, newTagsRaw := getTagsChange(d)
 		oldTagsMap := oldTagsRaw.(map[string]interface{})
 		newTagsMap := newTagsRaw.(map[string]interface{})
 		createTags, removeTags := diffTagsSfn(tagsFromMapSfn(oldTagsMap), tagsFromMapSfn(newTagsMap))
@@ -208,7 +208,7 @@ func resourceAwsSfnStateMachineUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsSfnStateMachineDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sfnconn
+	conn := meta.(*AWSClient).sfnconn()
 	log.Printf("[DEBUG] Deleting Step Function State Machine: %s", d.Id())
 
 	return resource.Retry(5*time.Minute, func() *resource.RetryError {
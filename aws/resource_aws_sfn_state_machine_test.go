@@ -96,7 +96,7 @@ func testAccCheckAWSSfnExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No Step Function ID set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).sfnconn
+		conn := testAccProvider.Meta().(*AWSClient).sfnconn()
 
 		_, err := conn.DescribeStateMachine(&sfn.DescribeStateMachineInput{
 			StateMachineArn: aws.String(rs.Primary.ID),
@@ -107,7 +107,7 @@ func testAccCheckAWSSfnExists(n string) resource.TestCheckFunc {
 }
 
 func testAccCheckAWSSfnStateMachineDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).sfnconn
+	conn := testAccProvider.Meta().(*AWSClient).sfnconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_sfn_state_machine" {
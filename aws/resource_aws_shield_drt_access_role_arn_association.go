@@ -0,0 +1,119 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsShieldDrtAccessRoleArnAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsShieldDrtAccessRoleArnAssociationCreate,
+		Read:   resourceAwsShieldDrtAccessRoleArnAssociationRead,
+		Update: resourceAwsShieldDrtAccessRoleArnAssociationUpdate,
+		Delete: resourceAwsShieldDrtAccessRoleArnAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+		},
+	}
+}
+
+func resourceAwsShieldDrtAccessRoleArnAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	roleARN := d.Get("role_arn").(string)
+
+	if err := associateShieldDrtRole(meta.(*AWSClient).shieldconn(), roleARN); err != nil {
+		return err
+	}
+
+	// There is a single DRT role per Shield Advanced subscription. The role
+	// belongs to the account of the subscription, whose ID is taken from the
+	// role ARN when the provider skips requesting it.
+	accountID := meta.(*AWSClient).accountid
+	if accountID == "" {
+		parsedARN, err := arn.Parse(roleARN)
+		if err != nil {
+			return fmt.Errorf("error parsing Shield DRT role ARN (%s): %s", roleARN, err)
+		}
+		accountID = parsedARN.AccountID
+	}
+	d.SetId(accountID)
+
+	return resourceAwsShieldDrtAccessRoleArnAssociationRead(d, meta)
+}
+
+func resourceAwsShieldDrtAccessRoleArnAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).shieldconn()
+
+	output, err := conn.DescribeDRTAccess(&shield.DescribeDRTAccessInput{})
+
+	if isAWSErr(err, shield.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Shield DRT Role Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Shield DRT Role Association (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || aws.StringValue(output.RoleArn) == "" {
+		log.Printf("[WARN] Shield DRT Role Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("role_arn", output.RoleArn)
+
+	return nil
+}
+
+func resourceAwsShieldDrtAccessRoleArnAssociationUpdate(d *schema.ResourceData, meta interface{}) error {
+	// Associating a new role replaces the existing association.
+	if err := associateShieldDrtRole(meta.(*AWSClient).shieldconn(), d.Get("role_arn").(string)); err != nil {
+		return err
+	}
+
+	return resourceAwsShieldDrtAccessRoleArnAssociationRead(d, meta)
+}
+
+func resourceAwsShieldDrtAccessRoleArnAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).shieldconn()
+
+	log.Printf("[DEBUG] Deleting Shield DRT Role Association: %s", d.Id())
+	_, err := conn.DisassociateDRTRole(&shield.DisassociateDRTRoleInput{})
+
+	if isAWSErr(err, shield.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Shield DRT Role Association (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func associateShieldDrtRole(conn *shield.Shield, roleArn string) error {
+	input := &shield.AssociateDRTRoleInput{
+		RoleArn: aws.String(roleArn),
+	}
+
+	log.Printf("[DEBUG] Associating Shield DRT Role: %s", input)
+	if _, err := conn.AssociateDRTRole(input); err != nil {
+		return fmt.Errorf("error associating Shield DRT Role (%s): %s", roleArn, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// There is a single DRT role association per account, so these tests cannot
+// run in parallel.
+func TestAccAWSShieldDrtAccessRoleArnAssociation_basic(t *testing.T) {
+	rName1 := acctest.RandomWithPrefix("tf-acc-test")
+	rName2 := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_shield_drt_access_role_arn_association.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSShield(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSShieldDrtAccessRoleArnAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccShieldDrtAccessRoleArnAssociationConfig(rName1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSShieldDrtAccessRoleArnAssociationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccShieldDrtAccessRoleArnAssociationConfig(rName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSShieldDrtAccessRoleArnAssociationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSShieldDrtAccessRoleArnAssociationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).shieldconn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_shield_drt_access_role_arn_association" {
+			continue
+		}
+
+		output, err := conn.DescribeDRTAccess(&shield.DescribeDRTAccessInput{})
+
+		if isAWSErr(err, shield.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(output.RoleArn) != "" {
+			return fmt.Errorf("Shield DRT Role Association (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSShieldDrtAccessRoleArnAssociationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).shieldconn()
+
+		output, err := conn.DescribeDRTAccess(&shield.DescribeDRTAccessInput{})
+
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(output.RoleArn) != rs.Primary.Attributes["role_arn"] {
+			return fmt.Errorf("Shield DRT Role Association (%s) has role %q, expected %q", rs.Primary.ID, aws.StringValue(output.RoleArn), rs.Primary.Attributes["role_arn"])
+		}
+
+		return nil
+	}
+}
+
+func testAccShieldDrtAccessRoleArnAssociationConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "drt.shield.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AWSShieldDRTAccessPolicy"
+  role       = "${aws_iam_role.test.name}"
+}
+
+resource "aws_shield_drt_access_role_arn_association" "test" {
+  role_arn = "${aws_iam_role.test.arn}"
+
+  depends_on = ["aws_iam_role_policy_attachment.test"]
+}
+`, rName)
+}
@@ -0,0 +1,223 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_shield_protection", &resource.Sweeper{
+		Name: "aws_shield_protection",
+		F:    testSweepShieldProtections,
+	})
+}
+
+func testSweepShieldProtections(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).shieldconn()
+	input := &shield.ListProtectionsInput{}
+
+	for {
+		output, err := conn.ListProtections(input)
+
+		if testSweepSkipSweepError(err) || isAWSErr(err, shield.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] Skipping Shield Protection sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing Shield Protections: %s", err)
+		}
+
+		for _, protection := range output.Protections {
+			id := aws.StringValue(protection.Id)
+
+			log.Printf("[INFO] Deleting Shield Protection: %s", id)
+			_, err := conn.DeleteProtection(&shield.DeleteProtectionInput{
+				ProtectionId: aws.String(id),
+			})
+
+			if err != nil {
+				log.Printf("[ERROR] Error deleting Shield Protection (%s): %s", id, err)
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return nil
+}
+
+func TestAccAWSShieldProtection_basic(t *testing.T) {
+	var protection shield.Protection
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_shield_protection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSShield(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSShieldProtectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccShieldProtectionConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSShieldProtectionExists(resourceName, &protection),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					testAccMatchResourceAttrRegionalARN(resourceName, "resource_arn", "ec2", regexp.MustCompile(`eip-allocation/eipalloc-.+`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSShieldProtection_disappears(t *testing.T) {
+	var protection shield.Protection
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_shield_protection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSShield(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSShieldProtectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccShieldProtectionConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSShieldProtectionExists(resourceName, &protection),
+					testAccCheckAWSShieldProtectionDisappears(&protection),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccPreCheckAWSShield(t *testing.T) {
+	conn := testAccProvider.Meta().(*AWSClient).shieldconn()
+
+	output, err := conn.GetSubscriptionState(&shield.GetSubscriptionStateInput{})
+
+	if testAccPreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+
+	if aws.StringValue(output.SubscriptionState) != shield.SubscriptionStateActive {
+		t.Skip("skipping acceptance testing: Shield Advanced subscription is not active")
+	}
+}
+
+func testAccCheckAWSShieldProtectionDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).shieldconn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_shield_protection" {
+			continue
+		}
+
+		_, err := conn.DescribeProtection(&shield.DescribeProtectionInput{
+			ProtectionId: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, shield.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Shield Protection (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSShieldProtectionExists(resourceName string, protection *shield.Protection) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Shield Protection ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).shieldconn()
+
+		output, err := conn.DescribeProtection(&shield.DescribeProtectionInput{
+			ProtectionId: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || output.Protection == nil {
+			return fmt.Errorf("Shield Protection (%s) not found", rs.Primary.ID)
+		}
+
+		*protection = *output.Protection
+
+		return nil
+	}
+}
+
+func testAccCheckAWSShieldProtectionDisappears(protection *shield.Protection) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).shieldconn()
+
+		_, err := conn.DeleteProtection(&shield.DeleteProtectionInput{
+			ProtectionId: protection.Id,
+		})
+
+		return err
+	}
+}
+
+func testAccShieldProtectionConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_eip" "test" {
+  vpc = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_shield_protection" "test" {
+  name         = %[1]q
+  resource_arn = "arn:${data.aws_partition.current.partition}:ec2:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:eip-allocation/${aws_eip.test.id}"
+}
+`, rName)
+}
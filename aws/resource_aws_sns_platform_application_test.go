@@ -370,7 +370,7 @@ func testAccCheckAwsSnsPlatformApplicationExists(name string) resource.TestCheck
 			return fmt.Errorf("missing ID: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).snsconn
+		conn := testAccProvider.Meta().(*AWSClient).snsconn()
 
 		input := &sns.GetPlatformApplicationAttributesInput{
 			PlatformApplicationArn: aws.String(rs.Primary.ID),
@@ -384,7 +384,7 @@ func testAccCheckAwsSnsPlatformApplicationExists(name string) resource.TestCheck
 }
 
 func testAccCheckAWSSNSPlatformApplicationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).snsconn
+	conn := testAccProvider.Meta().(*AWSClient).snsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_sns_platform_application" {
@@ -112,7 +112,7 @@ func testAccCheckAWSSNSSMSPrefsDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).snsconn
+		conn := testAccProvider.Meta().(*AWSClient).snsconn()
 		attrs, err := conn.GetSMSAttributes(&sns.GetSMSAttributesInput{})
 		if err != nil {
 			return fmt.Errorf("error getting SMS attributes: %s", err)
@@ -149,7 +149,7 @@ func resourceAwsSnsTopic() *schema.Resource {
 }
 
 func resourceAwsSnsTopicCreate(d *schema.ResourceData, meta interface{}) error {
-	snsconn := meta.(*AWSClient).snsconn
+	snsconn := meta.(*AWSClient).snsconn()
 
 	var name string
 	if v, ok := d.GetOk("name"); ok {
@@ -177,7 +177,7 @@ func resourceAwsSnsTopicCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsSnsTopicUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).snsconn
+	conn := meta.(*AWSClient).snsconn()
 
 	for terraformAttrName, snsAttrName := range SNSAttributeMap {
 		if d.HasChange(terraformAttrName) {
@@ -193,7 +193,7 @@ func resourceAwsSnsTopicUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsSnsTopicRead(d *schema.ResourceData, meta interface{}) error {
-	snsconn := meta.(*AWSClient).snsconn
+	snsconn := meta.(*AWSClient).snsconn()
 
 	log.Printf("[DEBUG] Reading SNS Topic Attributes for %s", d.Id())
 	attributeOutput, err := snsconn.GetTopicAttributes(&sns.GetTopicAttributesInput{
@@ -235,7 +235,7 @@ func resourceAwsSnsTopicRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsSnsTopicDelete(d *schema.ResourceData, meta interface{}) error {
-	snsconn := meta.(*AWSClient).snsconn
+	snsconn := meta.(*AWSClient).snsconn()
 
 	log.Printf("[DEBUG] SNS Delete Topic: %s", d.Id())
 	_, err := snsconn.DeleteTopic(&sns.DeleteTopicInput{
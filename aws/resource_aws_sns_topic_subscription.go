@@ -98,7 +98,7 @@ func resourceAwsSnsTopicSubscription() *schema.Resource {
 }
 
 func resourceAwsSnsTopicSubscriptionCreate(d *schema.ResourceData, meta interface{}) error {
-	snsconn := meta.(*AWSClient).snsconn
+	snsconn := meta.(*AWSClient).snsconn()
 
 	output, err := subscribeToSNSTopic(d, snsconn)
 
@@ -121,7 +121,7 @@ func resourceAwsSnsTopicSubscriptionCreate(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsSnsTopicSubscriptionUpdate(d *schema.ResourceData, meta interface{}) error {
-	snsconn := meta.(*AWSClient).snsconn
+	snsconn := meta.(*AWSClient).snsconn()
 
 	if d.HasChange("raw_message_delivery") {
 		if err := snsSubscriptionAttributeUpdate(snsconn, d.Id(), "RawMessageDelivery", fmt.Sprintf("%t", d.Get("raw_message_delivery").(bool))); err != nil {
@@ -152,7 +152,7 @@ func resourceAwsSnsTopicSubscriptionUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsSnsTopicSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
-	snsconn := meta.(*AWSClient).snsconn
+	snsconn := meta.(*AWSClient).snsconn()
 
 	log.Printf("[DEBUG] Loading subscription %s", d.Id())
 
@@ -191,7 +191,7 @@ func resourceAwsSnsTopicSubscriptionRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsSnsTopicSubscriptionDelete(d *schema.ResourceData, meta interface{}) error {
-	snsconn := meta.(*AWSClient).snsconn
+	snsconn := meta.(*AWSClient).snsconn()
 
 	log.Printf("[DEBUG] SNS delete topic subscription: %s", d.Id())
 	_, err := snsconn.Unsubscribe(&sns.UnsubscribeInput{
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/jen20/awspolicyequivalence"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/fakeaws"
 )
 
 func TestAccAWSSNSTopic_importBasic(t *testing.T) {
@@ -55,6 +56,44 @@ func TestAccAWSSNSTopic_basic(t *testing.T) {
 	})
 }
 
+func TestResourceAwsSnsTopic_fakeAws(t *testing.T) {
+	server := fakeaws.New()
+	defer server.Close()
+
+	attributes := make(map[string]string)
+	rName := acctest.RandString(10)
+	resourceName := "aws_sns_topic.test_topic"
+	expectedPolicy := `{"http":{"defaultHealthyRetryPolicy": {"minDelayTarget": 20,"maxDelayTarget": 20,"numMaxDelayRetries": 0,"numRetries": 3,"numNoDelayRetries": 0,"numMinDelayRetries": 0,"backoffFunction": "linear"},"disableSubscriptionOverrides": false}}`
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSNSTopicDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFakeAwsProviderConfig(server.URL) + testAccAWSSNSTopicConfig_withName(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSNSTopicExists(resourceName, attributes),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("terraform-test-topic-%s", rName)),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:sns:[^:]+:\d{12}:terraform-test-topic-.+$`)),
+				),
+			},
+			{
+				Config: testAccFakeAwsProviderConfig(server.URL) + testAccAWSSNSTopicConfig_withDeliveryPolicy(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSNSTopicExists(resourceName, attributes),
+					testAccCheckAWSNSTopicHasDeliveryPolicy(resourceName, expectedPolicy),
+				),
+			},
+			{
+				Config:            testAccFakeAwsProviderConfig(server.URL),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAWSSNSTopic_name(t *testing.T) {
 	attributes := make(map[string]string)
 
@@ -275,7 +314,7 @@ func testAccCheckAWSNSTopicHasPolicy(n string, expectedPolicyText string) resour
 			return fmt.Errorf("No SNS topic with that ARN exists")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).snsconn
+		conn := testAccProvider.Meta().(*AWSClient).snsconn()
 
 		params := &sns.GetTopicAttributesInput{
 			TopicArn: aws.String(rs.Primary.ID),
@@ -317,7 +356,7 @@ func testAccCheckAWSNSTopicHasDeliveryPolicy(n string, expectedPolicyText string
 			return fmt.Errorf("No Queue URL specified!")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).snsconn
+		conn := testAccProvider.Meta().(*AWSClient).snsconn()
 
 		params := &sns.GetTopicAttributesInput{
 			TopicArn: aws.String(rs.Primary.ID),
@@ -347,7 +386,7 @@ func testAccCheckAWSNSTopicHasDeliveryPolicy(n string, expectedPolicyText string
 }
 
 func testAccCheckAWSSNSTopicDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).snsconn
+	conn := testAccProvider.Meta().(*AWSClient).snsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_sns_topic" {
@@ -395,7 +434,7 @@ func testAccCheckAWSSNSTopicExists(n string, attributes map[string]string) resou
 			return fmt.Errorf("No SNS topic with that ARN exists")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).snsconn
+		conn := testAccProvider.Meta().(*AWSClient).snsconn()
 
 		params := &sns.GetTopicAttributesInput{
 			TopicArn: aws.String(rs.Primary.ID),
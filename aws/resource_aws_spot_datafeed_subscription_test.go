@@ -69,7 +69,7 @@ func testAccAWSSpotDatafeedSubscription_basic(t *testing.T) {
 
 func testAccCheckAWSSpotDatafeedSubscriptionDisappears(subscription *ec2.SpotDatafeedSubscription) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		_, err := conn.DeleteSpotDatafeedSubscription(&ec2.DeleteSpotDatafeedSubscriptionInput{})
 		if err != nil {
@@ -123,7 +123,7 @@ func testAccCheckAWSSpotDatafeedSubscriptionExists(n string, subscription *ec2.S
 			return fmt.Errorf("No policy ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		resp, err := conn.DescribeSpotDatafeedSubscription(&ec2.DescribeSpotDatafeedSubscriptionInput{})
 		if err != nil {
@@ -137,7 +137,7 @@ func testAccCheckAWSSpotDatafeedSubscriptionExists(n string, subscription *ec2.S
 }
 
 func testAccCheckAWSSpotDatafeedSubscriptionDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_spot_datafeed_subscription" {
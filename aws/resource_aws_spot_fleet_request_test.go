@@ -27,7 +27,7 @@ func testSweepSpotFleetRequests(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).ec2conn
+	conn := client.(*AWSClient).ec2conn()
 
 	err = conn.DescribeSpotFleetRequestsPages(&ec2.DescribeSpotFleetRequestsInput{}, func(page *ec2.DescribeSpotFleetRequestsOutput, isLast bool) bool {
 		if len(page.SpotFleetRequestConfigs) == 0 {
@@ -591,7 +591,7 @@ func testAccCheckAWSSpotFleetRequestExists(
 			return errors.New("No Spot fleet request with that id exists")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		params := &ec2.DescribeSpotFleetRequestsInput{
 			SpotFleetRequestIds: []*string{&rs.Primary.ID},
@@ -659,7 +659,7 @@ func testAccCheckAWSSpotFleetRequest_PlacementAttributes(
 }
 
 func testAccCheckAWSSpotFleetRequestDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_spot_fleet_request" {
@@ -272,7 +272,7 @@ func testAccAWSSpotInstanceRequestTime(t *testing.T, duration string) string {
 }
 
 func testAccCheckAWSSpotInstanceRequestDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_spot_instance_request" {
@@ -352,7 +352,7 @@ func testAccCheckAWSSpotInstanceRequestExists(
 			return fmt.Errorf("No SNS subscription with that ARN exists")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		params := &ec2.DescribeSpotInstanceRequestsInput{
 			SpotInstanceRequestIds: []*string{&rs.Primary.ID},
@@ -415,7 +415,7 @@ func testAccCheckAWSSpotInstanceRequestAttributesCheckSIRWithoutSpot(
 func testAccCheckAWSSpotInstanceRequest_InstanceAttributes(
 	sir *ec2.SpotInstanceRequest, rInt int) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 		resp, err := conn.DescribeInstances(&ec2.DescribeInstancesInput{
 			InstanceIds: []*string{sir.InstanceId},
 		})
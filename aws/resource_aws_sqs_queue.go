@@ -131,7 +131,7 @@ func resourceAwsSqsQueue() *schema.Resource {
 }
 
 func resourceAwsSqsQueueCreate(d *schema.ResourceData, meta interface{}) error {
-	sqsconn := meta.(*AWSClient).sqsconn
+	sqsconn := meta.(*AWSClient).sqsconn()
 
 	var name string
 
@@ -216,7 +216,7 @@ func resourceAwsSqsQueueCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsSqsQueueUpdate(d *schema.ResourceData, meta interface{}) error {
-	sqsconn := meta.(*AWSClient).sqsconn
+	sqsconn := meta.(*AWSClient).sqsconn()
 
 	if err := setTagsSQS(sqsconn, d); err != nil {
 		return err
@@ -257,7 +257,7 @@ func resourceAwsSqsQueueUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsSqsQueueRead(d *schema.ResourceData, meta interface{}) error {
-	sqsconn := meta.(*AWSClient).sqsconn
+	sqsconn := meta.(*AWSClient).sqsconn()
 
 	attributeOutput, err := sqsconn.GetQueueAttributes(&sqs.GetQueueAttributesInput{
 		QueueUrl:       aws.String(d.Id()),
@@ -337,7 +337,7 @@ func resourceAwsSqsQueueRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsSqsQueueDelete(d *schema.ResourceData, meta interface{}) error {
-	sqsconn := meta.(*AWSClient).sqsconn
+	sqsconn := meta.(*AWSClient).sqsconn()
 
 	log.Printf("[DEBUG] SQS Delete Queue: %s", d.Id())
 	_, err := sqsconn.DeleteQueue(&sqs.DeleteQueueInput{
@@ -362,8 +362,8 @@ func extractNameFromSqsQueueUrl(queue string) (string, error) {
 }
 
 func setTagsSQS(conn *sqs.SQS, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if hasTagsChange(d) {
+		oraw, nraw := getTagsChange(d)
 		create, remove := diffTagsGeneric(oraw.(map[string]interface{}), nraw.(map[string]interface{}))
 
 		if len(remove) > 0 {
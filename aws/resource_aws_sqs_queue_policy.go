@@ -43,7 +43,7 @@ func resourceAwsSqsQueuePolicy() *schema.Resource {
 }
 
 func resourceAwsSqsQueuePolicyUpsert(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sqsconn
+	conn := meta.(*AWSClient).sqsconn()
 	policy := d.Get("policy").(string)
 	url := d.Get("queue_url").(string)
 
@@ -98,7 +98,7 @@ func resourceAwsSqsQueuePolicyUpsert(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsSqsQueuePolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sqsconn
+	conn := meta.(*AWSClient).sqsconn()
 
 	out, err := conn.GetQueueAttributes(&sqs.GetQueueAttributesInput{
 		QueueUrl:       aws.String(d.Id()),
@@ -129,7 +129,7 @@ func resourceAwsSqsQueuePolicyRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsSqsQueuePolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sqsconn
+	conn := meta.(*AWSClient).sqsconn()
 
 	log.Printf("[DEBUG] Deleting SQS Queue Policy of %s", d.Id())
 	_, err := conn.SetQueueAttributes(&sqs.SetQueueAttributesInput{
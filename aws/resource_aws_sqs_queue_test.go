@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/jen20/awspolicyequivalence"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/fakeaws"
 )
 
 func TestAccAWSSQSQueue_importBasic(t *testing.T) {
@@ -120,6 +121,52 @@ func TestAccAWSSQSQueue_basic(t *testing.T) {
 	})
 }
 
+func TestResourceAwsSqsQueue_fakeAws(t *testing.T) {
+	server := fakeaws.New()
+	defer server.Close()
+
+	var queueAttributes map[string]*string
+	queueName := fmt.Sprintf("sqs-queue-%s", acctest.RandString(10))
+	resourceName := "aws_sqs_queue.queue"
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSQSQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFakeAwsProviderConfig(server.URL) + testAccAWSSQSConfigWithTags(queueName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSQSQueueExists(resourceName, &queueAttributes),
+					testAccCheckAWSSQSQueueDefaultAttributes(&queueAttributes),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Usage", "original"),
+				),
+			},
+			{
+				Config: testAccFakeAwsProviderConfig(server.URL) + testAccAWSSQSConfigWithTagsChanged(queueName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSQSQueueExists(resourceName, &queueAttributes),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Usage", "changed"),
+				),
+			},
+			{
+				Config: testAccFakeAwsProviderConfig(server.URL) + testAccAWSSQSConfigWithOverrides(queueName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSQSQueueExists(resourceName, &queueAttributes),
+					testAccCheckAWSSQSQueueOverrideAttributes(&queueAttributes),
+				),
+			},
+			{
+				Config:            testAccFakeAwsProviderConfig(server.URL),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAWSSQSQueue_tags(t *testing.T) {
 	var queueAttributes map[string]*string
 
@@ -383,7 +430,7 @@ func TestAccAWSSQSQueue_Encryption(t *testing.T) {
 }
 
 func testAccCheckAWSSQSQueueDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).sqsconn
+	conn := testAccProvider.Meta().(*AWSClient).sqsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_sqs_queue" {
@@ -445,7 +492,7 @@ func testAccCheckAWSSQSQueueExists(resourceName string, queueAttributes *map[str
 			return fmt.Errorf("No Queue URL specified!")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).sqsconn
+		conn := testAccProvider.Meta().(*AWSClient).sqsconn()
 
 		input := &sqs.GetQueueAttributesInput{
 			QueueUrl:       aws.String(rs.Primary.ID),
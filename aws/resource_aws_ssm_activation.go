@@ -62,7 +62,7 @@ func resourceAwsSsmActivation() *schema.Resource {
 }
 
 func resourceAwsSsmActivationCreate(d *schema.ResourceData, meta interface{}) error {
-	ssmconn := meta.(*AWSClient).ssmconn
+	ssmconn := meta.(*AWSClient).ssmconn()
 
 	log.Printf("[DEBUG] SSM activation create: %s", d.Id())
 
@@ -119,7 +119,7 @@ func resourceAwsSsmActivationCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsSsmActivationRead(d *schema.ResourceData, meta interface{}) error {
-	ssmconn := meta.(*AWSClient).ssmconn
+	ssmconn := meta.(*AWSClient).ssmconn()
 
 	log.Printf("[DEBUG] Reading SSM Activation: %s", d.Id())
 
@@ -157,7 +157,7 @@ func resourceAwsSsmActivationRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsSsmActivationDelete(d *schema.ResourceData, meta interface{}) error {
-	ssmconn := meta.(*AWSClient).ssmconn
+	ssmconn := meta.(*AWSClient).ssmconn()
 
 	log.Printf("[DEBUG] Deleting SSM Activation: %s", d.Id())
 
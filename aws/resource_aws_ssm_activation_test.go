@@ -68,7 +68,7 @@ func testAccCheckAWSSSMActivationExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No SSM Activation ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ssmconn
+		conn := testAccProvider.Meta().(*AWSClient).ssmconn()
 
 		_, err := conn.DescribeActivations(&ssm.DescribeActivationsInput{
 			Filters: []*ssm.DescribeActivationsFilter{
@@ -91,7 +91,7 @@ func testAccCheckAWSSSMActivationExists(n string) resource.TestCheckFunc {
 }
 
 func testAccCheckAWSSSMActivationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ssmconn
+	conn := testAccProvider.Meta().(*AWSClient).ssmconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ssm_activation" {
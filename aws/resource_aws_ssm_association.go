@@ -116,7 +116,7 @@ func resourceAwsSsmAssociation() *schema.Resource {
 }
 
 func resourceAwsSsmAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	ssmconn := meta.(*AWSClient).ssmconn
+	ssmconn := meta.(*AWSClient).ssmconn()
 
 	log.Printf("[DEBUG] SSM association create: %s", d.Id())
 
@@ -180,7 +180,7 @@ func resourceAwsSsmAssociationCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsSsmAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	ssmconn := meta.(*AWSClient).ssmconn
+	ssmconn := meta.(*AWSClient).ssmconn()
 
 	log.Printf("[DEBUG] Reading SSM Association: %s", d.Id())
 
@@ -225,7 +225,7 @@ func resourceAwsSsmAssociationRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsSsmAssociationUpdate(d *schema.ResourceData, meta interface{}) error {
-	ssmconn := meta.(*AWSClient).ssmconn
+	ssmconn := meta.(*AWSClient).ssmconn()
 
 	log.Printf("[DEBUG] SSM Association update: %s", d.Id())
 
@@ -279,7 +279,7 @@ func resourceAwsSsmAssociationUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsSsmAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	ssmconn := meta.(*AWSClient).ssmconn
+	ssmconn := meta.(*AWSClient).ssmconn()
 
 	log.Printf("[DEBUG] Deleting SSM Association: %s", d.Id())
 
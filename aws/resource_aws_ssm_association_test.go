@@ -17,8 +17,8 @@ func TestAccAWSSSMAssociation_basic(t *testing.T) {
 	name := fmt.Sprintf("tf-acc-ssm-association-%s", acctest.RandString(10))
 
 	deleteSsmAssociaton := func() {
-		ec2conn := testAccProvider.Meta().(*AWSClient).ec2conn
-		ssmconn := testAccProvider.Meta().(*AWSClient).ssmconn
+		ec2conn := testAccProvider.Meta().(*AWSClient).ec2conn()
+		ssmconn := testAccProvider.Meta().(*AWSClient).ssmconn()
 
 		ins, err := ec2conn.DescribeInstances(&ec2.DescribeInstancesInput{
 			Filters: []*ec2.Filter{
@@ -384,7 +384,7 @@ func testAccCheckAWSSSMAssociationExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No SSM Assosciation ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ssmconn
+		conn := testAccProvider.Meta().(*AWSClient).ssmconn()
 
 		_, err := conn.DescribeAssociation(&ssm.DescribeAssociationInput{
 			AssociationId: aws.String(rs.Primary.Attributes["association_id"]),
@@ -402,7 +402,7 @@ func testAccCheckAWSSSMAssociationExists(n string) resource.TestCheckFunc {
 }
 
 func testAccCheckAWSSSMAssociationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ssmconn
+	conn := testAccProvider.Meta().(*AWSClient).ssmconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ssm_association" {
@@ -148,7 +148,7 @@ func resourceAwsSsmDocument() *schema.Resource {
 }
 
 func resourceAwsSsmDocumentCreate(d *schema.ResourceData, meta interface{}) error {
-	ssmconn := meta.(*AWSClient).ssmconn
+	ssmconn := meta.(*AWSClient).ssmconn()
 
 	log.Printf("[INFO] Creating SSM Document: %s", d.Get("name").(string))
 
@@ -191,7 +191,7 @@ func resourceAwsSsmDocumentCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsSsmDocumentRead(d *schema.ResourceData, meta interface{}) error {
-	ssmconn := meta.(*AWSClient).ssmconn
+	ssmconn := meta.(*AWSClient).ssmconn()
 
 	log.Printf("[DEBUG] Reading SSM Document: %s", d.Id())
 
@@ -290,9 +290,9 @@ func resourceAwsSsmDocumentRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsSsmDocumentUpdate(d *schema.ResourceData, meta interface{}) error {
-	ssmconn := meta.(*AWSClient).ssmconn
+	ssmconn := meta.(*AWSClient).ssmconn()
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		if err := setTagsSSM(ssmconn, d, d.Id(), ssm.ResourceTypeForTaggingDocument); err != nil {
 			return fmt.Errorf("error setting SSM Document tags: %s", err)
 		}
@@ -327,7 +327,7 @@ func resourceAwsSsmDocumentUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsSsmDocumentDelete(d *schema.ResourceData, meta interface{}) error {
-	ssmconn := meta.(*AWSClient).ssmconn
+	ssmconn := meta.(*AWSClient).ssmconn()
 
 	if err := deleteDocumentPermissions(d, meta); err != nil {
 		return err
@@ -373,7 +373,7 @@ func resourceAwsSsmDocumentDelete(d *schema.ResourceData, meta interface{}) erro
 }
 
 func setDocumentPermissions(d *schema.ResourceData, meta interface{}) error {
-	ssmconn := meta.(*AWSClient).ssmconn
+	ssmconn := meta.(*AWSClient).ssmconn()
 
 	log.Printf("[INFO] Setting permissions for document: %s", d.Id())
 
@@ -423,7 +423,7 @@ func setDocumentPermissions(d *schema.ResourceData, meta interface{}) error {
 }
 
 func getDocumentPermissions(d *schema.ResourceData, meta interface{}) (map[string]interface{}, error) {
-	ssmconn := meta.(*AWSClient).ssmconn
+	ssmconn := meta.(*AWSClient).ssmconn()
 
 	log.Printf("[INFO] Getting permissions for document: %s", d.Id())
 
@@ -465,7 +465,7 @@ func getDocumentPermissions(d *schema.ResourceData, meta interface{}) (map[strin
 }
 
 func deleteDocumentPermissions(d *schema.ResourceData, meta interface{}) error {
-	ssmconn := meta.(*AWSClient).ssmconn
+	ssmconn := meta.(*AWSClient).ssmconn()
 
 	log.Printf("[INFO] Removing permissions from document: %s", d.Id())
 
@@ -562,7 +562,7 @@ func updateAwsSSMDocument(d *schema.ResourceData, meta interface{}) error {
 
 	newDefaultVersion := d.Get("default_version").(string)
 
-	ssmconn := meta.(*AWSClient).ssmconn
+	ssmconn := meta.(*AWSClient).ssmconn()
 	updated, err := ssmconn.UpdateDocument(updateDocInput)
 
 	if isAWSErr(err, "DuplicateDocumentContent", "") {
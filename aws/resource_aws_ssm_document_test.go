@@ -334,7 +334,7 @@ func testAccCheckAWSSSMDocumentExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No SSM Document ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ssmconn
+		conn := testAccProvider.Meta().(*AWSClient).ssmconn()
 
 		_, err := conn.DescribeDocument(&ssm.DescribeDocumentInput{
 			Name: aws.String(rs.Primary.ID),
@@ -345,7 +345,7 @@ func testAccCheckAWSSSMDocumentExists(n string) resource.TestCheckFunc {
 }
 
 func testAccCheckAWSSSMDocumentDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ssmconn
+	conn := testAccProvider.Meta().(*AWSClient).ssmconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ssm_document" {
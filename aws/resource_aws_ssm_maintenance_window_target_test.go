@@ -89,7 +89,7 @@ func testAccCheckAWSSSMMaintenanceWindowTargetExists(n string) resource.TestChec
 			return fmt.Errorf("No SSM Maintenance Window Target Window ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ssmconn
+		conn := testAccProvider.Meta().(*AWSClient).ssmconn()
 
 		resp, err := conn.DescribeMaintenanceWindowTargets(&ssm.DescribeMaintenanceWindowTargetsInput{
 			WindowId: aws.String(rs.Primary.Attributes["window_id"]),
@@ -115,7 +115,7 @@ func testAccCheckAWSSSMMaintenanceWindowTargetExists(n string) resource.TestChec
 }
 
 func testAccCheckAWSSSMMaintenanceWindowTargetDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ssmconn
+	conn := testAccProvider.Meta().(*AWSClient).ssmconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ssm_maintenance_window_target" {
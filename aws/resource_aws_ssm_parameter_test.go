@@ -275,7 +275,7 @@ func testAccCheckAWSSSMParameterExists(n string, param *ssm.Parameter) resource.
 			return fmt.Errorf("No SSM Parameter ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ssmconn
+		conn := testAccProvider.Meta().(*AWSClient).ssmconn()
 
 		paramInput := &ssm.GetParametersInput{
 			Names: []*string{
@@ -301,7 +301,7 @@ func testAccCheckAWSSSMParameterExists(n string, param *ssm.Parameter) resource.
 
 func testAccCheckAWSSSMParameterDisappears(param *ssm.Parameter) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).ssmconn
+		conn := testAccProvider.Meta().(*AWSClient).ssmconn()
 
 		paramInput := &ssm.DeleteParameterInput{
 			Name: param.Name,
@@ -314,7 +314,7 @@ func testAccCheckAWSSSMParameterDisappears(param *ssm.Parameter) resource.TestCh
 }
 
 func testAccCheckAWSSSMParameterDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ssmconn
+	conn := testAccProvider.Meta().(*AWSClient).ssmconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ssm_parameter" {
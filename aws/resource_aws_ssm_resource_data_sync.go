@@ -66,7 +66,7 @@ func resourceAwsSsmResourceDataSync() *schema.Resource {
 }
 
 func resourceAwsSsmResourceDataSyncCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ssmconn
+	conn := meta.(*AWSClient).ssmconn()
 
 	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
 		input := &ssm.CreateResourceDataSyncInput{
@@ -92,7 +92,7 @@ func resourceAwsSsmResourceDataSyncCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsSsmResourceDataSyncRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ssmconn
+	conn := meta.(*AWSClient).ssmconn()
 
 	syncItem, err := findResourceDataSyncItem(conn, d.Id())
 	if err != nil {
@@ -108,7 +108,7 @@ func resourceAwsSsmResourceDataSyncRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsSsmResourceDataSyncDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ssmconn
+	conn := meta.(*AWSClient).ssmconn()
 
 	input := &ssm.DeleteResourceDataSyncInput{
 		SyncName: aws.String(d.Get("name").(string)),
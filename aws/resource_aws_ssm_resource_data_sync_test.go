@@ -71,7 +71,7 @@ func TestAccAWSSsmResourceDataSync_import(t *testing.T) {
 }
 
 func testAccCheckAWSSsmResourceDataSyncDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ssmconn
+	conn := testAccProvider.Meta().(*AWSClient).ssmconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ssm_resource_data_sync" {
@@ -129,7 +129,7 @@ func testAccCheckAWSStorageGatewayCacheExists(resourceName string) resource.Test
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).storagegatewayconn
+		conn := testAccProvider.Meta().(*AWSClient).storagegatewayconn()
 
 		gatewayARN, diskID, err := decodeStorageGatewayCacheID(rs.Primary.ID)
 		if err != nil {
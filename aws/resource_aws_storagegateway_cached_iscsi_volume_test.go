@@ -187,7 +187,7 @@ func testAccCheckAWSStorageGatewayCachedIscsiVolumeExists(resourceName string, c
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).storagegatewayconn
+		conn := testAccProvider.Meta().(*AWSClient).storagegatewayconn()
 
 		input := &storagegateway.DescribeCachediSCSIVolumesInput{
 			VolumeARNs: []*string{aws.String(rs.Primary.ID)},
@@ -210,7 +210,7 @@ func testAccCheckAWSStorageGatewayCachedIscsiVolumeExists(resourceName string, c
 }
 
 func testAccCheckAWSStorageGatewayCachedIscsiVolumeDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).storagegatewayconn
+	conn := testAccProvider.Meta().(*AWSClient).storagegatewayconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_storagegateway_cached_iscsi_volume" {
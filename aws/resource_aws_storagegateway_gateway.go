@@ -127,7 +127,7 @@ func resourceAwsStorageGatewayGateway() *schema.Resource {
 }
 
 func resourceAwsStorageGatewayGatewayCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).storagegatewayconn
+	conn := meta.(*AWSClient).storagegatewayconn()
 	region := meta.(*AWSClient).region
 
 	activationKey := d.Get("activation_key").(string)
@@ -266,7 +266,7 @@ func resourceAwsStorageGatewayGatewayCreate(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsStorageGatewayGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).storagegatewayconn
+	conn := meta.(*AWSClient).storagegatewayconn()
 
 	input := &storagegateway.DescribeGatewayInformationInput{
 		GatewayARN: aws.String(d.Id()),
@@ -362,7 +362,7 @@ func resourceAwsStorageGatewayGatewayRead(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsStorageGatewayGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).storagegatewayconn
+	conn := meta.(*AWSClient).storagegatewayconn()
 
 	if d.HasChange("gateway_name") || d.HasChange("gateway_timezone") {
 		input := &storagegateway.UpdateGatewayInformationInput{
@@ -413,7 +413,7 @@ func resourceAwsStorageGatewayGatewayUpdate(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsStorageGatewayGatewayDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).storagegatewayconn
+	conn := meta.(*AWSClient).storagegatewayconn()
 
 	input := &storagegateway.DeleteGatewayInput{
 		GatewayARN: aws.String(d.Id()),
@@ -25,7 +25,7 @@ func testSweepStorageGatewayGateways(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).storagegatewayconn
+	conn := client.(*AWSClient).storagegatewayconn()
 
 	err = conn.ListGatewaysPages(&storagegateway.ListGatewaysInput{}, func(page *storagegateway.ListGatewaysOutput, isLast bool) bool {
 		if len(page.Gateways) == 0 {
@@ -334,7 +334,7 @@ func TestAccAWSStorageGatewayGateway_SmbGuestPassword(t *testing.T) {
 }
 
 func testAccCheckAWSStorageGatewayGatewayDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).storagegatewayconn
+	conn := testAccProvider.Meta().(*AWSClient).storagegatewayconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_storagegateway_gateway" {
@@ -366,7 +366,7 @@ func testAccCheckAWSStorageGatewayGatewayExists(resourceName string, gateway *st
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).storagegatewayconn
+		conn := testAccProvider.Meta().(*AWSClient).storagegatewayconn()
 		input := &storagegateway.DescribeGatewayInformationInput{
 			GatewayARN: aws.String(rs.Primary.ID),
 		}
@@ -156,7 +156,7 @@ func resourceAwsStorageGatewayNfsFileShare() *schema.Resource {
 }
 
 func resourceAwsStorageGatewayNfsFileShareCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).storagegatewayconn
+	conn := meta.(*AWSClient).storagegatewayconn()
 
 	input := &storagegateway.CreateNFSFileShareInput{
 		ClientList:           expandStringSet(d.Get("client_list").(*schema.Set)),
@@ -203,7 +203,7 @@ func resourceAwsStorageGatewayNfsFileShareCreate(d *schema.ResourceData, meta in
 }
 
 func resourceAwsStorageGatewayNfsFileShareRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).storagegatewayconn
+	conn := meta.(*AWSClient).storagegatewayconn()
 
 	input := &storagegateway.DescribeNFSFileSharesInput{
 		FileShareARNList: []*string{aws.String(d.Id())},
@@ -257,7 +257,7 @@ func resourceAwsStorageGatewayNfsFileShareRead(d *schema.ResourceData, meta inte
 }
 
 func resourceAwsStorageGatewayNfsFileShareUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).storagegatewayconn
+	conn := meta.(*AWSClient).storagegatewayconn()
 
 	input := &storagegateway.UpdateNFSFileShareInput{
 		ClientList:           expandStringSet(d.Get("client_list").(*schema.Set)),
@@ -299,7 +299,7 @@ func resourceAwsStorageGatewayNfsFileShareUpdate(d *schema.ResourceData, meta in
 }
 
 func resourceAwsStorageGatewayNfsFileShareDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).storagegatewayconn
+	conn := meta.(*AWSClient).storagegatewayconn()
 
 	input := &storagegateway.DeleteFileShareInput{
 		FileShareARN: aws.String(d.Id()),
@@ -405,7 +405,7 @@ func TestAccAWSStorageGatewayNfsFileShare_Squash(t *testing.T) {
 }
 
 func testAccCheckAWSStorageGatewayNfsFileShareDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).storagegatewayconn
+	conn := testAccProvider.Meta().(*AWSClient).storagegatewayconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_storagegateway_nfs_file_share" {
@@ -441,7 +441,7 @@ func testAccCheckAWSStorageGatewayNfsFileShareExists(resourceName string, nfsFil
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).storagegatewayconn
+		conn := testAccProvider.Meta().(*AWSClient).storagegatewayconn()
 		input := &storagegateway.DescribeNFSFileSharesInput{
 			FileShareARNList: []*string{aws.String(rs.Primary.ID)},
 		}
@@ -128,7 +128,7 @@ func resourceAwsStorageGatewaySmbFileShare() *schema.Resource {
 }
 
 func resourceAwsStorageGatewaySmbFileShareCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).storagegatewayconn
+	conn := meta.(*AWSClient).storagegatewayconn()
 
 	input := &storagegateway.CreateSMBFileShareInput{
 		Authentication:       aws.String(d.Get("authentication").(string)),
@@ -175,7 +175,7 @@ func resourceAwsStorageGatewaySmbFileShareCreate(d *schema.ResourceData, meta in
 }
 
 func resourceAwsStorageGatewaySmbFileShareRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).storagegatewayconn
+	conn := meta.(*AWSClient).storagegatewayconn()
 
 	input := &storagegateway.DescribeSMBFileSharesInput{
 		FileShareARNList: []*string{aws.String(d.Id())},
@@ -228,7 +228,7 @@ func resourceAwsStorageGatewaySmbFileShareRead(d *schema.ResourceData, meta inte
 }
 
 func resourceAwsStorageGatewaySmbFileShareUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).storagegatewayconn
+	conn := meta.(*AWSClient).storagegatewayconn()
 
 	input := &storagegateway.UpdateSMBFileShareInput{
 		DefaultStorageClass:  aws.String(d.Get("default_storage_class").(string)),
@@ -269,7 +269,7 @@ func resourceAwsStorageGatewaySmbFileShareUpdate(d *schema.ResourceData, meta in
 }
 
 func resourceAwsStorageGatewaySmbFileShareDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).storagegatewayconn
+	conn := meta.(*AWSClient).storagegatewayconn()
 
 	input := &storagegateway.DeleteFileShareInput{
 		FileShareARN: aws.String(d.Id()),
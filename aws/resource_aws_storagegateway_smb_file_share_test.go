@@ -410,7 +410,7 @@ func TestAccAWSStorageGatewaySmbFileShare_ValidUserList(t *testing.T) {
 }
 
 func testAccCheckAWSStorageGatewaySmbFileShareDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).storagegatewayconn
+	conn := testAccProvider.Meta().(*AWSClient).storagegatewayconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_storagegateway_smb_file_share" {
@@ -446,7 +446,7 @@ func testAccCheckAWSStorageGatewaySmbFileShareExists(resourceName string, smbFil
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).storagegatewayconn
+		conn := testAccProvider.Meta().(*AWSClient).storagegatewayconn()
 		input := &storagegateway.DescribeSMBFileSharesInput{
 			FileShareARNList: []*string{aws.String(rs.Primary.ID)},
 		}
@@ -37,7 +37,7 @@ func resourceAwsStorageGatewayWorkingStorage() *schema.Resource {
 }
 
 func resourceAwsStorageGatewayWorkingStorageCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).storagegatewayconn
+	conn := meta.(*AWSClient).storagegatewayconn()
 
 	diskID := d.Get("disk_id").(string)
 	gatewayARN := d.Get("gateway_arn").(string)
@@ -59,7 +59,7 @@ func resourceAwsStorageGatewayWorkingStorageCreate(d *schema.ResourceData, meta
 }
 
 func resourceAwsStorageGatewayWorkingStorageRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).storagegatewayconn
+	conn := meta.(*AWSClient).storagegatewayconn()
 
 	gatewayARN, diskID, err := decodeStorageGatewayWorkingStorageID(d.Id())
 	if err != nil {
@@ -102,7 +102,7 @@ func testAccCheckAWSStorageGatewayWorkingStorageExists(resourceName string) reso
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).storagegatewayconn
+		conn := testAccProvider.Meta().(*AWSClient).storagegatewayconn()
 
 		gatewayARN, diskID, err := decodeStorageGatewayWorkingStorageID(rs.Primary.ID)
 		if err != nil {
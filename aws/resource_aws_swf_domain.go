@@ -57,7 +57,7 @@ func resourceAwsSwfDomain() *schema.Resource {
 }
 
 func resourceAwsSwfDomainCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).swfconn
+	conn := meta.(*AWSClient).swfconn()
 
 	var name string
 
@@ -89,7 +89,7 @@ func resourceAwsSwfDomainCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsSwfDomainRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).swfconn
+	conn := meta.(*AWSClient).swfconn()
 
 	input := &swf.DescribeDomainInput{
 		Name: aws.String(d.Id()),
@@ -119,7 +119,7 @@ func resourceAwsSwfDomainRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsSwfDomainDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).swfconn
+	conn := meta.(*AWSClient).swfconn()
 
 	input := &swf.DeprecateDomainInput{
 		Name: aws.String(d.Get("name").(string)),
@@ -74,7 +74,7 @@ func resourceAwsTransferServer() *schema.Resource {
 }
 
 func resourceAwsTransferServerCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).transferconn
+	conn := meta.(*AWSClient).transferconn()
 	tags := tagsFromMapTransfer(d.Get("tags").(map[string]interface{}))
 	createOpts := &transfer.CreateServerInput{}
 
@@ -116,7 +116,7 @@ func resourceAwsTransferServerCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsTransferServerRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).transferconn
+	conn := meta.(*AWSClient).transferconn()
 
 	descOpts := &transfer.DescribeServerInput{
 		ServerId: aws.String(d.Id()),
@@ -154,7 +154,7 @@ func resourceAwsTransferServerRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsTransferServerUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).transferconn
+	conn := meta.(*AWSClient).transferconn()
 	updateFlag := false
 	updateOpts := &transfer.UpdateServerInput{
 		ServerId: aws.String(d.Id()),
@@ -198,7 +198,7 @@ func resourceAwsTransferServerUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsTransferServerDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).transferconn
+	conn := meta.(*AWSClient).transferconn()
 
 	if d.Get("force_destroy").(bool) {
 		log.Printf("[DEBUG] Transfer Server (%s) attempting to forceDestroy", d.Id())
@@ -155,7 +155,7 @@ func testAccCheckAWSTransferServerExists(n string, res *transfer.DescribedServer
 			return fmt.Errorf("No Transfer Server ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).transferconn
+		conn := testAccProvider.Meta().(*AWSClient).transferconn()
 
 		describe, err := conn.DescribeServer(&transfer.DescribeServerInput{
 			ServerId: aws.String(rs.Primary.ID),
@@ -174,7 +174,7 @@ func testAccCheckAWSTransferServerExists(n string, res *transfer.DescribedServer
 func testAccCheckAWSTransferServerDisappears(conf *transfer.DescribedServer) resource.TestCheckFunc {
 
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).transferconn
+		conn := testAccProvider.Meta().(*AWSClient).transferconn()
 
 		params := &transfer.DeleteServerInput{
 			ServerId: conf.ServerId,
@@ -190,7 +190,7 @@ func testAccCheckAWSTransferServerDisappears(conf *transfer.DescribedServer) res
 }
 
 func testAccCheckAWSTransferServerDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).transferconn
+	conn := testAccProvider.Meta().(*AWSClient).transferconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_transfer_server" {
@@ -215,7 +215,7 @@ func testAccCheckAWSTransferServerDestroy(s *terraform.State) error {
 
 func testAccCheckAWSTransferCreateUser(describedServer *transfer.DescribedServer, getRoleOutput *iam.GetRoleOutput, userName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).transferconn
+		conn := testAccProvider.Meta().(*AWSClient).transferconn()
 
 		input := &transfer.CreateUserInput{
 			ServerId: describedServer.ServerId,
@@ -233,7 +233,7 @@ func testAccCheckAWSTransferCreateUser(describedServer *transfer.DescribedServer
 
 func testAccCheckAWSTransferCreateSshKey(describedServer *transfer.DescribedServer, userName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).transferconn
+		conn := testAccProvider.Meta().(*AWSClient).transferconn()
 
 		input := &transfer.ImportSshPublicKeyInput{
 			ServerId:         describedServer.ServerId,
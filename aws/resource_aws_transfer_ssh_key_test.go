@@ -51,7 +51,7 @@ func testAccCheckAWSTransferSshKeyExists(n string, res *transfer.SshPublicKey) r
 			return fmt.Errorf("No Transfer Ssh Public Key ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).transferconn
+		conn := testAccProvider.Meta().(*AWSClient).transferconn()
 		serverID, userName, sshKeyID, err := decodeTransferSshKeyId(rs.Primary.ID)
 		if err != nil {
 			return fmt.Errorf("error parsing Transfer SSH Public Key ID: %s", err)
@@ -78,7 +78,7 @@ func testAccCheckAWSTransferSshKeyExists(n string, res *transfer.SshPublicKey) r
 }
 
 func testAccCheckAWSTransferSshKeyDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).transferconn
+	conn := testAccProvider.Meta().(*AWSClient).transferconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_transfer_ssh_key" {
@@ -70,7 +70,7 @@ func resourceAwsTransferUser() *schema.Resource {
 }
 
 func resourceAwsTransferUserCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).transferconn
+	conn := meta.(*AWSClient).transferconn()
 	userName := d.Get("user_name").(string)
 	serverID := d.Get("server_id").(string)
 
@@ -105,7 +105,7 @@ func resourceAwsTransferUserCreate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsTransferUserRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).transferconn
+	conn := meta.(*AWSClient).transferconn()
 	serverID, userName, err := decodeTransferUserId(d.Id())
 	if err != nil {
 		return fmt.Errorf("error parsing Transfer User ID: %s", err)
@@ -142,7 +142,7 @@ func resourceAwsTransferUserRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsTransferUserUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).transferconn
+	conn := meta.(*AWSClient).transferconn()
 	updateFlag := false
 	serverID, userName, err := decodeTransferUserId(d.Id())
 	if err != nil {
@@ -189,7 +189,7 @@ func resourceAwsTransferUserUpdate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsTransferUserDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).transferconn
+	conn := meta.(*AWSClient).transferconn()
 	serverID, userName, err := decodeTransferUserId(d.Id())
 	if err != nil {
 		return fmt.Errorf("error parsing Transfer User ID: %s", err)
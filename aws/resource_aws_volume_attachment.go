@@ -53,7 +53,7 @@ func resourceAwsVolumeAttachment() *schema.Resource {
 }
 
 func resourceAwsVolumeAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	name := d.Get("device_name").(string)
 	iID := d.Get("instance_id").(string)
 	vID := d.Get("volume_id").(string)
@@ -171,7 +171,7 @@ func volumeAttachmentStateRefreshFunc(conn *ec2.EC2, name, volumeID, instanceID
 }
 
 func resourceAwsVolumeAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	request := &ec2.DescribeVolumesInput{
 		VolumeIds: []*string{aws.String(d.Get("volume_id").(string))},
@@ -210,7 +210,7 @@ func resourceAwsVolumeAttachmentUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsVolumeAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	if _, ok := d.GetOk("skip_destroy"); ok {
 		return nil
@@ -30,7 +30,7 @@ func resourceAwsVpcDhcpOptionsAssociation() *schema.Resource {
 }
 
 func resourceAwsVpcDhcpOptionsAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	log.Printf(
 		"[INFO] Creating DHCP Options association: %s => %s",
@@ -55,7 +55,7 @@ func resourceAwsVpcDhcpOptionsAssociationCreate(d *schema.ResourceData, meta int
 }
 
 func resourceAwsVpcDhcpOptionsAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	// Get the VPC that this association belongs to
 	vpcRaw, _, err := VPCStateRefreshFunc(conn, d.Get("vpc_id").(string))()
 
@@ -84,7 +84,7 @@ func resourceAwsVpcDhcpOptionsAssociationUpdate(d *schema.ResourceData, meta int
 // AWS does not provide an API to disassociate a DHCP Options set from a VPC.
 // So, we do this by setting the VPC to the default DHCP Options Set.
 func resourceAwsVpcDhcpOptionsAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	log.Printf("[INFO] Disassociating DHCP Options Set %s from VPC %s...", d.Get("dhcp_options_id"), d.Get("vpc_id"))
 	_, err := conn.AssociateDhcpOptions(&ec2.AssociateDhcpOptionsInput{
@@ -31,7 +31,7 @@ func TestAccAWSDHCPOptionsAssociation_basic(t *testing.T) {
 }
 
 func testAccCheckDHCPOptionsAssociationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_vpc_dhcp_options_association" {
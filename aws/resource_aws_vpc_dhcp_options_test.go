@@ -78,7 +78,7 @@ func TestAccAWSDHCPOptions_deleteOptions(t *testing.T) {
 }
 
 func testAccCheckDHCPOptionsDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_vpc_dhcp_options" {
@@ -126,7 +126,7 @@ func testAccCheckDHCPOptionsExists(n string, d *ec2.DhcpOptions) resource.TestCh
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 		resp, err := conn.DescribeDhcpOptions(&ec2.DescribeDhcpOptionsInput{
 			DhcpOptionsIds: []*string{
 				aws.String(rs.Primary.ID),
@@ -156,7 +156,7 @@ func testAccCheckDHCPOptionsDelete(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 		_, err := conn.DeleteDhcpOptions(&ec2.DeleteDhcpOptionsInput{
 			DhcpOptionsId: aws.String(rs.Primary.ID),
 		})
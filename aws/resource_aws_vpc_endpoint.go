@@ -137,7 +137,7 @@ func resourceAwsVpcEndpointCreate(d *schema.ResourceData, meta interface{}) erro
 		return errors.New("An Interface VPC Endpoint must always have at least one Security Group")
 	}
 
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	req := &ec2.CreateVpcEndpointInput{
 		VpcId:             aws.String(d.Get("vpc_id").(string)),
@@ -181,7 +181,7 @@ func resourceAwsVpcEndpointCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsVpcEndpointRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	vpce, state, err := vpcEndpointStateRefresh(conn, d.Id())()
 	if err != nil && state != "failed" {
@@ -205,7 +205,7 @@ func resourceAwsVpcEndpointRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsVpcEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	if _, ok := d.GetOk("auto_accept"); ok && d.Get("state").(string) == "pendingAcceptance" {
 		if err := vpcEndpointAccept(conn, d.Id(), d.Get("service_name").(string)); err != nil {
@@ -251,7 +251,7 @@ func resourceAwsVpcEndpointUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsVpcEndpointDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	log.Printf("[DEBUG] Deleting VPC Endpoint: %s", d.Id())
 	_, err := conn.DeleteVpcEndpoints(&ec2.DeleteVpcEndpointsInput{
@@ -17,7 +17,7 @@ func TestAccAWSVpcEndpointConnectionNotification_importBasic(t *testing.T) {
 	lbName := fmt.Sprintf("testaccawsnlb-basic-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
 	resourceName := "aws_vpc_endpoint_connection_notification.foo"
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccCassetteParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckVpcEndpointConnectionNotificationDestroy,
@@ -38,7 +38,7 @@ func TestAccAWSVpcEndpointConnectionNotification_importBasic(t *testing.T) {
 func TestAccAWSVpcEndpointConnectionNotification_basic(t *testing.T) {
 	lbName := fmt.Sprintf("testaccawsnlb-basic-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccCassetteParallelTest(t, resource.TestCase{
 		PreCheck:      func() { testAccPreCheck(t) },
 		IDRefreshName: "aws_vpc_endpoint_connection_notification.foo",
 		Providers:     testAccProviders,
@@ -67,7 +67,7 @@ func TestAccAWSVpcEndpointConnectionNotification_basic(t *testing.T) {
 }
 
 func testAccCheckVpcEndpointConnectionNotificationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_vpc_endpoint_connection_notification" {
@@ -105,7 +105,7 @@ func testAccCheckVpcEndpointConnectionNotificationExists(n string) resource.Test
 			return fmt.Errorf("No VPC Endpoint connection notification ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		resp, err := conn.DescribeVpcEndpointConnectionNotifications(&ec2.DescribeVpcEndpointConnectionNotificationsInput{
 			ConnectionNotificationId: aws.String(rs.Primary.ID),
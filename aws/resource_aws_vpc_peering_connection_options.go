@@ -36,7 +36,7 @@ func resourceAwsVpcPeeringConnectionOptionsCreate(d *schema.ResourceData, meta i
 }
 
 func resourceAwsVpcPeeringConnectionOptionsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	pcRaw, _, err := vpcPeeringConnectionRefreshState(conn, d.Id())()
 	if err != nil {
@@ -217,7 +217,7 @@ func TestAccAWSVpnConnection_disappears(t *testing.T) {
 
 func testAccAWSVpnConnectionDisappears(connection *ec2.VpnConnection) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		_, err := conn.DeleteVpnConnection(&ec2.DeleteVpnConnectionInput{
 			VpnConnectionId: connection.VpnConnectionId,
@@ -254,7 +254,7 @@ func testAccAWSVpnConnectionDisappears(connection *ec2.VpnConnection) resource.T
 }
 
 func testAccAwsVpnConnectionDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_vpn_connection" {
 			continue
@@ -308,7 +308,7 @@ func testAccAwsVpnConnectionExists(vpnConnectionResource string, vpnConnection *
 			return fmt.Errorf("Not found: %s", vpnConnectionResource)
 		}
 
-		ec2conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		ec2conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		resp, err := ec2conn.DescribeVpnConnections(&ec2.DescribeVpnConnectionsInput{
 			VpnConnectionIds: []*string{aws.String(connection.Primary.ID)},
@@ -65,7 +65,7 @@ func resourceAwsWafByteMatchSet() *schema.Resource {
 }
 
 func resourceAwsWafByteMatchSetCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafconn
+	conn := meta.(*AWSClient).wafconn()
 
 	log.Printf("[INFO] Creating ByteMatchSet: %s", d.Get("name").(string))
 
@@ -88,7 +88,7 @@ func resourceAwsWafByteMatchSetCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsWafByteMatchSetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafconn
+	conn := meta.(*AWSClient).wafconn()
 	log.Printf("[INFO] Reading ByteMatchSet: %s", d.Get("name").(string))
 	params := &waf.GetByteMatchSetInput{
 		ByteMatchSetId: aws.String(d.Id()),
@@ -112,7 +112,7 @@ func resourceAwsWafByteMatchSetRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsWafByteMatchSetUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafconn
+	conn := meta.(*AWSClient).wafconn()
 
 	log.Printf("[INFO] Updating ByteMatchSet: %s", d.Get("name").(string))
 
@@ -129,7 +129,7 @@ func resourceAwsWafByteMatchSetUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsWafByteMatchSetDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafconn
+	conn := meta.(*AWSClient).wafconn()
 
 	oldTuples := d.Get("byte_match_tuples").(*schema.Set).List()
 	if len(oldTuples) > 0 {
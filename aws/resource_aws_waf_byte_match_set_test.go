@@ -179,7 +179,7 @@ func TestAccAWSWafByteMatchSet_disappears(t *testing.T) {
 
 func testAccCheckAWSWafByteMatchSetDisappears(v *waf.ByteMatchSet) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).wafconn
+		conn := testAccProvider.Meta().(*AWSClient).wafconn()
 
 		wr := newWafRetryer(conn)
 		_, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
@@ -233,7 +233,7 @@ func testAccCheckAWSWafByteMatchSetExists(n string, v *waf.ByteMatchSet) resourc
 			return fmt.Errorf("No WAF ByteMatchSet ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).wafconn
+		conn := testAccProvider.Meta().(*AWSClient).wafconn()
 		resp, err := conn.GetByteMatchSet(&waf.GetByteMatchSetInput{
 			ByteMatchSetId: aws.String(rs.Primary.ID),
 		})
@@ -257,7 +257,7 @@ func testAccCheckAWSWafByteMatchSetDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).wafconn
+		conn := testAccProvider.Meta().(*AWSClient).wafconn()
 		resp, err := conn.GetByteMatchSet(
 			&waf.GetByteMatchSetInput{
 				ByteMatchSetId: aws.String(rs.Primary.ID),
@@ -172,7 +172,7 @@ func TestAccAWSWafGeoMatchSet_noConstraints(t *testing.T) {
 
 func testAccCheckAWSWafGeoMatchSetDisappears(v *waf.GeoMatchSet) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).wafconn
+		conn := testAccProvider.Meta().(*AWSClient).wafconn()
 
 		wr := newWafRetryer(conn)
 		_, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
@@ -222,7 +222,7 @@ func testAccCheckAWSWafGeoMatchSetExists(n string, v *waf.GeoMatchSet) resource.
 			return fmt.Errorf("No WAF GeoMatchSet ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).wafconn
+		conn := testAccProvider.Meta().(*AWSClient).wafconn()
 		resp, err := conn.GetGeoMatchSet(&waf.GetGeoMatchSetInput{
 			GeoMatchSetId: aws.String(rs.Primary.ID),
 		})
@@ -246,7 +246,7 @@ func testAccCheckAWSWafGeoMatchSetDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).wafconn
+		conn := testAccProvider.Meta().(*AWSClient).wafconn()
 		resp, err := conn.GetGeoMatchSet(
 			&waf.GetGeoMatchSetInput{
 				GeoMatchSetId: aws.String(rs.Primary.ID),
@@ -55,7 +55,7 @@ func resourceAwsWafIPSet() *schema.Resource {
 }
 
 func resourceAwsWafIPSetCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafconn
+	conn := meta.(*AWSClient).wafconn()
 
 	wr := newWafRetryer(conn)
 	out, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
@@ -74,7 +74,7 @@ func resourceAwsWafIPSetCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsWafIPSetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafconn
+	conn := meta.(*AWSClient).wafconn()
 
 	params := &waf.GetIPSetInput{
 		IPSetId: aws.String(d.Id()),
@@ -117,7 +117,7 @@ func resourceAwsWafIPSetRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsWafIPSetUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafconn
+	conn := meta.(*AWSClient).wafconn()
 
 	if d.HasChange("ip_set_descriptors") {
 		o, n := d.GetChange("ip_set_descriptors")
@@ -133,7 +133,7 @@ func resourceAwsWafIPSetUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsWafIPSetDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafconn
+	conn := meta.(*AWSClient).wafconn()
 
 	oldDescriptors := d.Get("ip_set_descriptors").(*schema.Set).List()
 
@@ -200,7 +200,7 @@ func TestAccAWSWafRateBasedRule_noPredicates(t *testing.T) {
 
 func testAccCheckAWSWafRateBasedRuleDisappears(v *waf.RateBasedRule) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).wafconn
+		conn := testAccProvider.Meta().(*AWSClient).wafconn()
 
 		wr := newWafRetryer(conn)
 		_, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
@@ -248,7 +248,7 @@ func testAccCheckAWSWafRateBasedRuleDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).wafconn
+		conn := testAccProvider.Meta().(*AWSClient).wafconn()
 		resp, err := conn.GetRateBasedRule(
 			&waf.GetRateBasedRuleInput{
 				RuleId: aws.String(rs.Primary.ID),
@@ -284,7 +284,7 @@ func testAccCheckAWSWafRateBasedRuleExists(n string, v *waf.RateBasedRule) resou
 			return fmt.Errorf("No WAF Rule ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).wafconn
+		conn := testAccProvider.Meta().(*AWSClient).wafconn()
 		resp, err := conn.GetRateBasedRule(&waf.GetRateBasedRuleInput{
 			RuleId: aws.String(rs.Primary.ID),
 		})
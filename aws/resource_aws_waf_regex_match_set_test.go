@@ -24,7 +24,7 @@ func testSweepWafRegexMatchSet(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).wafconn
+	conn := client.(*AWSClient).wafconn()
 
 	req := &waf.ListRegexMatchSetsInput{}
 	resp, err := conn.ListRegexMatchSets(req)
@@ -232,7 +232,7 @@ func computeWafRegexMatchSetTuple(patternSet *waf.RegexPatternSet, fieldToMatch
 
 func testAccCheckAWSWafRegexMatchSetDisappears(set *waf.RegexMatchSet) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).wafconn
+		conn := testAccProvider.Meta().(*AWSClient).wafconn()
 
 		wr := newWafRetryer(conn)
 		_, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
@@ -280,7 +280,7 @@ func testAccCheckAWSWafRegexMatchSetExists(n string, v *waf.RegexMatchSet) resou
 			return fmt.Errorf("No WAF Regex Match Set ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).wafconn
+		conn := testAccProvider.Meta().(*AWSClient).wafconn()
 		resp, err := conn.GetRegexMatchSet(&waf.GetRegexMatchSetInput{
 			RegexMatchSetId: aws.String(rs.Primary.ID),
 		})
@@ -304,7 +304,7 @@ func testAccCheckAWSWafRegexMatchSetDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).wafconn
+		conn := testAccProvider.Meta().(*AWSClient).wafconn()
 		resp, err := conn.GetRegexMatchSet(&waf.GetRegexMatchSetInput{
 			RegexMatchSetId: aws.String(rs.Primary.ID),
 		})
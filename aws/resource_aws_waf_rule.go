@@ -60,7 +60,7 @@ func resourceAwsWafRule() *schema.Resource {
 }
 
 func resourceAwsWafRuleCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafconn
+	conn := meta.(*AWSClient).wafconn()
 
 	wr := newWafRetryer(conn)
 	out, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
@@ -81,7 +81,7 @@ func resourceAwsWafRuleCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsWafRuleRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafconn
+	conn := meta.(*AWSClient).wafconn()
 
 	params := &waf.GetRuleInput{
 		RuleId: aws.String(d.Id()),
@@ -117,7 +117,7 @@ func resourceAwsWafRuleRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsWafRuleUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafconn
+	conn := meta.(*AWSClient).wafconn()
 
 	if d.HasChange("predicates") {
 		o, n := d.GetChange("predicates")
@@ -133,7 +133,7 @@ func resourceAwsWafRuleUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsWafRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafconn
+	conn := meta.(*AWSClient).wafconn()
 
 	oldPredicates := d.Get("predicates").(*schema.Set).List()
 	if len(oldPredicates) > 0 {
@@ -145,7 +145,7 @@ func resourceAwsWafWebAcl() *schema.Resource {
 }
 
 func resourceAwsWafWebAclCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafconn
+	conn := meta.(*AWSClient).wafconn()
 
 	wr := newWafRetryer(conn)
 	out, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
@@ -177,7 +177,7 @@ func resourceAwsWafWebAclCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsWafWebAclRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafconn
+	conn := meta.(*AWSClient).wafconn()
 	params := &waf.GetWebACLInput{
 		WebACLId: aws.String(d.Id()),
 	}
@@ -240,7 +240,7 @@ func resourceAwsWafWebAclRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsWafWebAclUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafconn
+	conn := meta.(*AWSClient).wafconn()
 
 	if d.HasChange("default_action") || d.HasChange("rules") {
 		o, n := d.GetChange("rules")
@@ -290,7 +290,7 @@ func resourceAwsWafWebAclUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsWafWebAclDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafconn
+	conn := meta.(*AWSClient).wafconn()
 
 	// First, need to delete all rules
 	rules := d.Get("rules").(*schema.Set).List()
@@ -103,7 +103,7 @@ func resourceAwsWafRegionalByteMatchSet() *schema.Resource {
 }
 
 func resourceAwsWafRegionalByteMatchSetCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafregionalconn
+	conn := meta.(*AWSClient).wafregionalconn()
 	region := meta.(*AWSClient).region
 
 	log.Printf("[INFO] Creating ByteMatchSet: %s", d.Get("name").(string))
@@ -128,7 +128,7 @@ func resourceAwsWafRegionalByteMatchSetCreate(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsWafRegionalByteMatchSetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafregionalconn
+	conn := meta.(*AWSClient).wafregionalconn()
 
 	log.Printf("[INFO] Reading ByteMatchSet: %s", d.Get("name").(string))
 
@@ -185,7 +185,7 @@ func flattenWafByteMatchTuplesWR(in []*waf.ByteMatchTuple) []interface{} {
 }
 
 func resourceAwsWafRegionalByteMatchSetUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafregionalconn
+	conn := meta.(*AWSClient).wafregionalconn()
 	region := meta.(*AWSClient).region
 	log.Printf("[INFO] Updating ByteMatchSet: %s", d.Get("name").(string))
 
@@ -202,7 +202,7 @@ func resourceAwsWafRegionalByteMatchSetUpdate(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsWafRegionalByteMatchSetDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafregionalconn
+	conn := meta.(*AWSClient).wafregionalconn()
 	region := meta.(*AWSClient).region
 
 	log.Printf("[INFO] Deleting ByteMatchSet: %s", d.Get("name").(string))
@@ -262,7 +262,7 @@ func TestAccAWSWafRegionalByteMatchSet_disappears(t *testing.T) {
 
 func testAccCheckAWSWafRegionalByteMatchSetDisappears(v *waf.ByteMatchSet) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn
+		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn()
 		region := testAccProvider.Meta().(*AWSClient).region
 
 		wr := newWafRegionalRetryer(conn, region)
@@ -317,7 +317,7 @@ func testAccCheckAWSWafRegionalByteMatchSetExists(n string, v *waf.ByteMatchSet)
 			return fmt.Errorf("No WAF ByteMatchSet ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn
+		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn()
 		resp, err := conn.GetByteMatchSet(&waf.GetByteMatchSetInput{
 			ByteMatchSetId: aws.String(rs.Primary.ID),
 		})
@@ -341,7 +341,7 @@ func testAccCheckAWSWafRegionalByteMatchSetDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn
+		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn()
 		resp, err := conn.GetByteMatchSet(
 			&waf.GetByteMatchSetInput{
 				ByteMatchSetId: aws.String(rs.Primary.ID),
@@ -44,7 +44,7 @@ func resourceAwsWafRegionalGeoMatchSet() *schema.Resource {
 }
 
 func resourceAwsWafRegionalGeoMatchSetCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafregionalconn
+	conn := meta.(*AWSClient).wafregionalconn()
 	region := meta.(*AWSClient).region
 
 	log.Printf("[INFO] Creating WAF Regional Geo Match Set: %s", d.Get("name").(string))
@@ -69,7 +69,7 @@ func resourceAwsWafRegionalGeoMatchSetCreate(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsWafRegionalGeoMatchSetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafregionalconn
+	conn := meta.(*AWSClient).wafregionalconn()
 	log.Printf("[INFO] Reading WAF Regional Geo Match Set: %s", d.Get("name").(string))
 	params := &waf.GetGeoMatchSetInput{
 		GeoMatchSetId: aws.String(d.Id()),
@@ -95,7 +95,7 @@ func resourceAwsWafRegionalGeoMatchSetRead(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsWafRegionalGeoMatchSetUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafregionalconn
+	conn := meta.(*AWSClient).wafregionalconn()
 	region := meta.(*AWSClient).region
 
 	if d.HasChange("geo_match_constraint") {
@@ -112,7 +112,7 @@ func resourceAwsWafRegionalGeoMatchSetUpdate(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsWafRegionalGeoMatchSetDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafregionalconn
+	conn := meta.(*AWSClient).wafregionalconn()
 	region := meta.(*AWSClient).region
 
 	oldConstraints := d.Get("geo_match_constraint").(*schema.Set).List()
@@ -181,7 +181,7 @@ func testAccCheckAWSWafGeoMatchSetIdDiffers(before, after *waf.GeoMatchSet) reso
 
 func testAccCheckAWSWafRegionalGeoMatchSetDisappears(v *waf.GeoMatchSet) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn
+		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn()
 		region := testAccProvider.Meta().(*AWSClient).region
 
 		wr := newWafRegionalRetryer(conn, region)
@@ -232,7 +232,7 @@ func testAccCheckAWSWafRegionalGeoMatchSetExists(n string, v *waf.GeoMatchSet) r
 			return fmt.Errorf("No WAF Regional Geo Match Set ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn
+		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn()
 		resp, err := conn.GetGeoMatchSet(&waf.GetGeoMatchSetInput{
 			GeoMatchSetId: aws.String(rs.Primary.ID),
 		})
@@ -256,7 +256,7 @@ func testAccCheckAWSWafRegionalGeoMatchSetDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn
+		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn()
 		resp, err := conn.GetGeoMatchSet(
 			&waf.GetGeoMatchSetInput{
 				GeoMatchSetId: aws.String(rs.Primary.ID),
@@ -50,7 +50,7 @@ func resourceAwsWafRegionalIPSet() *schema.Resource {
 }
 
 func resourceAwsWafRegionalIPSetCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafregionalconn
+	conn := meta.(*AWSClient).wafregionalconn()
 	region := meta.(*AWSClient).region
 
 	wr := newWafRegionalRetryer(conn, region)
@@ -70,7 +70,7 @@ func resourceAwsWafRegionalIPSetCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsWafRegionalIPSetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafregionalconn
+	conn := meta.(*AWSClient).wafregionalconn()
 
 	params := &waf.GetIPSetInput{
 		IPSetId: aws.String(d.Id()),
@@ -117,7 +117,7 @@ func flattenWafIpSetDescriptorWR(in []*waf.IPSetDescriptor) []interface{} {
 }
 
 func resourceAwsWafRegionalIPSetUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafregionalconn
+	conn := meta.(*AWSClient).wafregionalconn()
 	region := meta.(*AWSClient).region
 
 	if d.HasChange("ip_set_descriptor") {
@@ -133,7 +133,7 @@ func resourceAwsWafRegionalIPSetUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsWafRegionalIPSetDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafregionalconn
+	conn := meta.(*AWSClient).wafregionalconn()
 	region := meta.(*AWSClient).region
 
 	oldD := d.Get("ip_set_descriptor").(*schema.Set).List()
@@ -314,7 +314,7 @@ func TestDiffWafRegionalIpSetDescriptors(t *testing.T) {
 
 func testAccCheckAWSWafRegionalIPSetDisappears(v *waf.IPSet) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn
+		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn()
 		region := testAccProvider.Meta().(*AWSClient).region
 
 		wr := newWafRegionalRetryer(conn, region)
@@ -361,7 +361,7 @@ func testAccCheckAWSWafRegionalIPSetDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn
+		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn()
 		resp, err := conn.GetIPSet(
 			&waf.GetIPSetInput{
 				IPSetId: aws.String(rs.Primary.ID),
@@ -397,7 +397,7 @@ func testAccCheckAWSWafRegionalIPSetExists(n string, v *waf.IPSet) resource.Test
 			return fmt.Errorf("No WAF IPSet ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn
+		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn()
 		resp, err := conn.GetIPSet(&waf.GetIPSetInput{
 			IPSetId: aws.String(rs.Primary.ID),
 		})
@@ -66,7 +66,7 @@ func resourceAwsWafRegionalRateBasedRule() *schema.Resource {
 }
 
 func resourceAwsWafRegionalRateBasedRuleCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafregionalconn
+	conn := meta.(*AWSClient).wafregionalconn()
 	region := meta.(*AWSClient).region
 
 	wr := newWafRegionalRetryer(conn, region)
@@ -90,7 +90,7 @@ func resourceAwsWafRegionalRateBasedRuleCreate(d *schema.ResourceData, meta inte
 }
 
 func resourceAwsWafRegionalRateBasedRuleRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafregionalconn
+	conn := meta.(*AWSClient).wafregionalconn()
 
 	params := &waf.GetRateBasedRuleInput{
 		RuleId: aws.String(d.Id()),
@@ -127,7 +127,7 @@ func resourceAwsWafRegionalRateBasedRuleRead(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsWafRegionalRateBasedRuleUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafregionalconn
+	conn := meta.(*AWSClient).wafregionalconn()
 	region := meta.(*AWSClient).region
 
 	if d.HasChange("predicate") || d.HasChange("rate_limit") {
@@ -145,7 +145,7 @@ func resourceAwsWafRegionalRateBasedRuleUpdate(d *schema.ResourceData, meta inte
 }
 
 func resourceAwsWafRegionalRateBasedRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafregionalconn
+	conn := meta.(*AWSClient).wafregionalconn()
 	region := meta.(*AWSClient).region
 
 	oldPredicates := d.Get("predicate").(*schema.Set).List()
@@ -240,7 +240,7 @@ func testAccCheckAWSWafRateBasedRuleIdDiffers(before, after *waf.RateBasedRule)
 
 func testAccCheckAWSWafRegionalRateBasedRuleDisappears(v *waf.RateBasedRule) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn
+		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn()
 		region := testAccProvider.Meta().(*AWSClient).region
 
 		wr := newWafRegionalRetryer(conn, region)
@@ -289,7 +289,7 @@ func testAccCheckAWSWafRegionalRateBasedRuleDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn
+		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn()
 		resp, err := conn.GetRateBasedRule(
 			&waf.GetRateBasedRuleInput{
 				RuleId: aws.String(rs.Primary.ID),
@@ -323,7 +323,7 @@ func testAccCheckAWSWafRegionalRateBasedRuleExists(n string, v *waf.RateBasedRul
 			return fmt.Errorf("No WAF Rule ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn
+		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn()
 		resp, err := conn.GetRateBasedRule(&waf.GetRateBasedRuleInput{
 			RuleId: aws.String(rs.Primary.ID),
 		})
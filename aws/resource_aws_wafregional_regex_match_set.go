@@ -66,7 +66,7 @@ func resourceAwsWafRegionalRegexMatchSet() *schema.Resource {
 }
 
 func resourceAwsWafRegionalRegexMatchSetCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafregionalconn
+	conn := meta.(*AWSClient).wafregionalconn()
 	region := meta.(*AWSClient).region
 
 	log.Printf("[INFO] Creating WAF Regional Regex Match Set: %s", d.Get("name").(string))
@@ -90,7 +90,7 @@ func resourceAwsWafRegionalRegexMatchSetCreate(d *schema.ResourceData, meta inte
 }
 
 func resourceAwsWafRegionalRegexMatchSetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafregionalconn
+	conn := meta.(*AWSClient).wafregionalconn()
 	log.Printf("[INFO] Reading WAF Regional Regex Match Set: %s", d.Get("name").(string))
 	params := &waf.GetRegexMatchSetInput{
 		RegexMatchSetId: aws.String(d.Id()),
@@ -114,7 +114,7 @@ func resourceAwsWafRegionalRegexMatchSetRead(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsWafRegionalRegexMatchSetUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafregionalconn
+	conn := meta.(*AWSClient).wafregionalconn()
 	region := meta.(*AWSClient).region
 
 	log.Printf("[INFO] Updating WAF Regional Regex Match Set: %s", d.Get("name").(string))
@@ -132,7 +132,7 @@ func resourceAwsWafRegionalRegexMatchSetUpdate(d *schema.ResourceData, meta inte
 }
 
 func resourceAwsWafRegionalRegexMatchSetDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafregionalconn
+	conn := meta.(*AWSClient).wafregionalconn()
 	region := meta.(*AWSClient).region
 
 	oldTuples := d.Get("regex_match_tuple").(*schema.Set).List()
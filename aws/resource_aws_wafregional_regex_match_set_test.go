@@ -25,7 +25,7 @@ func testSweepWafRegionalRegexMatchSet(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).wafregionalconn
+	conn := client.(*AWSClient).wafregionalconn()
 
 	req := &waf.ListRegexMatchSetsInput{}
 	resp, err := conn.ListRegexMatchSets(req)
@@ -219,7 +219,7 @@ func testAccAWSWafRegionalRegexMatchSet_disappears(t *testing.T) {
 
 func testAccCheckAWSWafRegionalRegexMatchSetDisappears(set *waf.RegexMatchSet) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn
+		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn()
 		region := testAccProvider.Meta().(*AWSClient).region
 
 		wr := newWafRegionalRetryer(conn, region)
@@ -268,7 +268,7 @@ func testAccCheckAWSWafRegionalRegexMatchSetExists(n string, v *waf.RegexMatchSe
 			return fmt.Errorf("No WAF Regional Regex Match Set ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn
+		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn()
 		resp, err := conn.GetRegexMatchSet(&waf.GetRegexMatchSetInput{
 			RegexMatchSetId: aws.String(rs.Primary.ID),
 		})
@@ -292,7 +292,7 @@ func testAccCheckAWSWafRegionalRegexMatchSetDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn
+		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn()
 		resp, err := conn.GetRegexMatchSet(&waf.GetRegexMatchSetInput{
 			RegexMatchSetId: aws.String(rs.Primary.ID),
 		})
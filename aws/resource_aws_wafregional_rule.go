@@ -57,7 +57,7 @@ func resourceAwsWafRegionalRule() *schema.Resource {
 }
 
 func resourceAwsWafRegionalRuleCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafregionalconn
+	conn := meta.(*AWSClient).wafregionalconn()
 	region := meta.(*AWSClient).region
 
 	wr := newWafRegionalRetryer(conn, region)
@@ -79,7 +79,7 @@ func resourceAwsWafRegionalRuleCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsWafRegionalRuleRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafregionalconn
+	conn := meta.(*AWSClient).wafregionalconn()
 
 	params := &waf.GetRuleInput{
 		RuleId: aws.String(d.Id()),
@@ -117,7 +117,7 @@ func resourceAwsWafRegionalRuleUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsWafRegionalRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafregionalconn
+	conn := meta.(*AWSClient).wafregionalconn()
 	region := meta.(*AWSClient).region
 
 	oldPredicates := d.Get("predicate").(*schema.Set).List()
@@ -146,7 +146,7 @@ func resourceAwsWafRegionalRuleDelete(d *schema.ResourceData, meta interface{})
 }
 
 func updateWafRegionalRuleResource(id string, oldP, newP []interface{}, meta interface{}) error {
-	conn := meta.(*AWSClient).wafregionalconn
+	conn := meta.(*AWSClient).wafregionalconn()
 	region := meta.(*AWSClient).region
 
 	wr := newWafRegionalRetryer(conn, region)
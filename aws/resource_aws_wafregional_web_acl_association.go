@@ -34,7 +34,7 @@ func resourceAwsWafRegionalWebAclAssociation() *schema.Resource {
 }
 
 func resourceAwsWafRegionalWebAclAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafregionalconn
+	conn := meta.(*AWSClient).wafregionalconn()
 
 	log.Printf(
 		"[INFO] Creating WAF Regional Web ACL association: %s => %s",
@@ -70,7 +70,7 @@ func resourceAwsWafRegionalWebAclAssociationCreate(d *schema.ResourceData, meta
 }
 
 func resourceAwsWafRegionalWebAclAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafregionalconn
+	conn := meta.(*AWSClient).wafregionalconn()
 
 	webAclId, resourceArn := resourceAwsWafRegionalWebAclAssociationParseId(d.Id())
 
@@ -101,7 +101,7 @@ func resourceAwsWafRegionalWebAclAssociationRead(d *schema.ResourceData, meta in
 }
 
 func resourceAwsWafRegionalWebAclAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafregionalconn
+	conn := meta.(*AWSClient).wafregionalconn()
 
 	_, resourceArn := resourceAwsWafRegionalWebAclAssociationParseId(d.Id())
 
@@ -50,7 +50,7 @@ func testAccCheckWafRegionalWebAclAssociationDestroy(s *terraform.State) error {
 }
 
 func testAccCheckWafRegionalWebAclAssociationDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
-	conn := provider.Meta().(*AWSClient).wafregionalconn
+	conn := provider.Meta().(*AWSClient).wafregionalconn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_wafregional_web_acl_association" {
 			continue
@@ -93,7 +93,7 @@ func testAccCheckWafRegionalWebAclAssociationExistsWithProvider(s *terraform.Sta
 
 	webAclId, resourceArn := resourceAwsWafRegionalWebAclAssociationParseId(rs.Primary.ID)
 
-	conn := provider.Meta().(*AWSClient).wafregionalconn
+	conn := provider.Meta().(*AWSClient).wafregionalconn()
 	resp, err := conn.ListResourcesForWebACL(&wafregional.ListResourcesForWebACLInput{WebACLId: aws.String(webAclId)})
 	if err != nil {
 		return fmt.Errorf("List Web ACL err: %v", err)
@@ -267,7 +267,7 @@ func testAccCheckAWSWorkLinkFleetDisappears(resourceName string) resource.TestCh
 			return fmt.Errorf("No resource ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).worklinkconn
+		conn := testAccProvider.Meta().(*AWSClient).worklinkconn()
 
 		input := &worklink.DeleteFleetInput{
 			FleetArn: aws.String(rs.Primary.ID),
@@ -293,7 +293,7 @@ func testAccCheckAWSWorkLinkFleetDisappears(resourceName string) resource.TestCh
 }
 
 func testAccCheckAWSWorkLinkFleetDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).worklinkconn
+	conn := testAccProvider.Meta().(*AWSClient).worklinkconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_worklink_fleet" {
@@ -330,7 +330,7 @@ func testAccCheckAWSWorkLinkFleetExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No Worklink Fleet ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).worklinkconn
+		conn := testAccProvider.Meta().(*AWSClient).worklinkconn()
 		_, err := conn.DescribeFleetMetadata(&worklink.DescribeFleetMetadataInput{
 			FleetArn: aws.String(rs.Primary.ID),
 		})
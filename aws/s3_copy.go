@@ -0,0 +1,228 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+const (
+	// s3MaxCopyObjectSize is the maximum size of an object copied in a
+	// single CopyObject request.
+	s3MaxCopyObjectSize int64 = 5 * 1024 * 1024 * 1024
+
+	s3DefaultCopyPartSize int64 = 512 * 1024 * 1024
+)
+
+// s3Copier copies S3 objects server-side, in parts for objects larger than
+// MaxCopySize.
+type s3Copier struct {
+	Conn        *s3.S3
+	PartSize    int64
+	MaxCopySize int64
+}
+
+// Copy copies the source object described by head with the parameters of
+// input. It returns the ETag and version ID of the copy.
+func (c *s3Copier) Copy(input *s3.CopyObjectInput, head *s3.HeadObjectOutput) (string, string, error) {
+	maxCopySize := c.MaxCopySize
+	if maxCopySize <= 0 || maxCopySize > s3MaxCopyObjectSize {
+		maxCopySize = s3MaxCopyObjectSize
+	}
+
+	size := aws.Int64Value(head.ContentLength)
+	if size <= maxCopySize {
+		output, err := c.Conn.CopyObject(input)
+		if err != nil {
+			return "", "", err
+		}
+
+		return aws.StringValue(output.CopyObjectResult.ETag), aws.StringValue(output.VersionId), nil
+	}
+
+	partSize := c.PartSize
+	if partSize < s3MinUploadPartSize {
+		partSize = s3MinUploadPartSize
+	}
+	if partSize > s3MaxCopyObjectSize {
+		partSize = s3MaxCopyObjectSize
+	}
+
+	// Grow the parts so that the object fits in the maximum number of parts.
+	if size/partSize >= s3MaxUploadParts {
+		partSize = size/s3MaxUploadParts + 1
+	}
+
+	createInput, err := c.createMultipartUploadInput(input, head)
+	if err != nil {
+		return "", "", err
+	}
+
+	createOutput, err := c.Conn.CreateMultipartUpload(createInput)
+	if err != nil {
+		return "", "", fmt.Errorf("error creating multipart upload: %s", err)
+	}
+	uploadID := aws.StringValue(createOutput.UploadId)
+
+	var completeOutput *s3.CompleteMultipartUploadOutput
+	parts, err := c.copyParts(input, uploadID, size, partSize)
+	if err == nil {
+		completeOutput, err = c.Conn.CompleteMultipartUpload(&s3.CompleteMultipartUploadInput{
+			Bucket:          input.Bucket,
+			Key:             input.Key,
+			MultipartUpload: &s3.CompletedMultipartUpload{Parts: parts},
+			RequestPayer:    input.RequestPayer,
+			UploadId:        aws.String(uploadID),
+		})
+		if err != nil {
+			err = fmt.Errorf("error completing multipart upload (%s): %s", uploadID, err)
+		}
+	}
+
+	if err != nil {
+		// Parts of incomplete uploads are billed until the upload is aborted.
+		log.Printf("[DEBUG] Aborting S3 multipart upload (%s)", uploadID)
+		if _, abortErr := c.Conn.AbortMultipartUpload(&s3.AbortMultipartUploadInput{
+			Bucket:       input.Bucket,
+			Key:          input.Key,
+			RequestPayer: input.RequestPayer,
+			UploadId:     aws.String(uploadID),
+		}); abortErr != nil {
+			log.Printf("[WARN] Error aborting S3 multipart upload (%s): %s", uploadID, abortErr)
+		}
+		return "", "", err
+	}
+
+	return aws.StringValue(completeOutput.ETag), aws.StringValue(completeOutput.VersionId), nil
+}
+
+// createMultipartUploadInput returns the parameters of the multipart upload
+// of a copy. Unlike CopyObject, a multipart upload never copies the metadata
+// and tags of the source object, so they are set explicitly unless replaced.
+func (c *s3Copier) createMultipartUploadInput(input *s3.CopyObjectInput, head *s3.HeadObjectOutput) (*s3.CreateMultipartUploadInput, error) {
+	createInput := &s3.CreateMultipartUploadInput{}
+	awsutil.Copy(createInput, input)
+
+	if aws.StringValue(input.MetadataDirective) != s3.MetadataDirectiveReplace {
+		createInput.CacheControl = head.CacheControl
+		createInput.ContentDisposition = head.ContentDisposition
+		createInput.ContentEncoding = head.ContentEncoding
+		createInput.ContentLanguage = head.ContentLanguage
+		createInput.ContentType = head.ContentType
+		createInput.Metadata = head.Metadata
+		createInput.WebsiteRedirectLocation = head.WebsiteRedirectLocation
+	}
+
+	if aws.StringValue(input.TaggingDirective) != s3.TaggingDirectiveReplace {
+		bucket, key, versionID, err := parseS3CopySource(aws.StringValue(input.CopySource))
+		if err != nil {
+			return nil, err
+		}
+
+		taggingInput := &s3.GetObjectTaggingInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		}
+		if versionID != "" {
+			taggingInput.VersionId = aws.String(versionID)
+		}
+
+		output, err := c.Conn.GetObjectTagging(taggingInput)
+		if err != nil {
+			return nil, fmt.Errorf("error getting source object tags: %s", err)
+		}
+
+		createInput.Tagging = nil
+		if len(output.TagSet) > 0 {
+			values := url.Values{}
+			for _, tag := range output.TagSet {
+				values.Add(aws.StringValue(tag.Key), aws.StringValue(tag.Value))
+			}
+			createInput.Tagging = aws.String(values.Encode())
+		}
+	}
+
+	return createInput, nil
+}
+
+// copyParts copies the parts of the source object one after the other.
+// Parts are copied server-side, so that there is little to gain from
+// copying them concurrently.
+func (c *s3Copier) copyParts(input *s3.CopyObjectInput, uploadID string, size, partSize int64) ([]*s3.CompletedPart, error) {
+	var parts []*s3.CompletedPart
+
+	for partNumber := int64(1); (partNumber-1)*partSize < size; partNumber++ {
+		first := (partNumber - 1) * partSize
+		last := first + partSize - 1
+		if last >= size {
+			last = size - 1
+		}
+
+		output, err := c.Conn.UploadPartCopy(&s3.UploadPartCopyInput{
+			Bucket:                         input.Bucket,
+			CopySource:                     input.CopySource,
+			CopySourceRange:                aws.String(fmt.Sprintf("bytes=%d-%d", first, last)),
+			CopySourceSSECustomerAlgorithm: input.CopySourceSSECustomerAlgorithm,
+			CopySourceSSECustomerKey:       input.CopySourceSSECustomerKey,
+			CopySourceSSECustomerKeyMD5:    input.CopySourceSSECustomerKeyMD5,
+			Key:                            input.Key,
+			PartNumber:                     aws.Int64(partNumber),
+			RequestPayer:                   input.RequestPayer,
+			SSECustomerAlgorithm:           input.SSECustomerAlgorithm,
+			SSECustomerKey:                 input.SSECustomerKey,
+			SSECustomerKeyMD5:              input.SSECustomerKeyMD5,
+			UploadId:                       aws.String(uploadID),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error copying part %d of multipart upload (%s): %s", partNumber, uploadID, err)
+		}
+
+		parts = append(parts, &s3.CompletedPart{
+			ETag:       output.CopyPartResult.ETag,
+			PartNumber: aws.Int64(partNumber),
+		})
+	}
+
+	return parts, nil
+}
+
+// s3CopySource returns the x-amz-copy-source value of an object version.
+func s3CopySource(bucket, key, versionID string) string {
+	source := url.PathEscape(bucket + "/" + key)
+	if versionID != "" {
+		source += "?versionId=" + url.QueryEscape(versionID)
+	}
+
+	return source
+}
+
+// parseS3CopySource returns the bucket, key and version ID of an
+// x-amz-copy-source value.
+func parseS3CopySource(source string) (string, string, string, error) {
+	var versionID string
+	if i := strings.Index(source, "?"); i >= 0 {
+		query, err := url.ParseQuery(source[i+1:])
+		if err != nil {
+			return "", "", "", fmt.Errorf("invalid copy source (%s): %s", source, err)
+		}
+		versionID = query.Get("versionId")
+		source = source[:i]
+	}
+
+	path, err := url.PathUnescape(source)
+	if err != nil {
+		return "", "", "", fmt.Errorf("invalid copy source (%s): %s", source, err)
+	}
+
+	bucket, key, err := parseS3ObjectCopySource(path)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	return bucket, key, versionID, nil
+}
@@ -0,0 +1,200 @@
+package aws
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/fakeaws"
+)
+
+func TestS3Copier(t *testing.T) {
+	server := fakeaws.New()
+	defer server.Close()
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials:      credentials.NewStaticCredentials("fakeaws", "fakeaws", ""),
+		Endpoint:         aws.String(server.URL),
+		MaxRetries:       aws.Int(0),
+		Region:           aws.String("us-west-2"),
+		S3ForcePathStyle: aws.Bool(true),
+	})
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+	conn := s3.New(sess)
+
+	for _, bucket := range []string{"source", "destination"} {
+		if _, err := conn.CreateBucket(&s3.CreateBucketInput{Bucket: aws.String(bucket)}); err != nil {
+			t.Fatalf("error creating bucket: %s", err)
+		}
+	}
+
+	body := bytes.Repeat([]byte("a"), int(2*s3MinUploadPartSize+1))
+	_, err = conn.PutObject(&s3.PutObjectInput{
+		Body:        bytes.NewReader(body),
+		Bucket:      aws.String("source"),
+		ContentType: aws.String("text/plain"),
+		Key:         aws.String("dir/source object"),
+		Metadata:    map[string]*string{"origin": aws.String("source")},
+		Tagging:     aws.String("Key1=Value1"),
+	})
+	if err != nil {
+		t.Fatalf("error putting source object: %s", err)
+	}
+
+	head, err := conn.HeadObject(&s3.HeadObjectInput{Bucket: aws.String("source"), Key: aws.String("dir/source object")})
+	if err != nil {
+		t.Fatalf("error reading source object: %s", err)
+	}
+
+	copier := &s3Copier{
+		Conn:        conn,
+		PartSize:    s3MinUploadPartSize,
+		MaxCopySize: s3MinUploadPartSize,
+	}
+
+	cases := []struct {
+		Name                string
+		MaxCopySize         int64
+		MetadataDirective   string
+		ExpectedContentType string
+		ExpectedETag        string
+	}{
+		{
+			Name:                "single",
+			MaxCopySize:         s3MaxCopyObjectSize,
+			MetadataDirective:   s3.MetadataDirectiveCopy,
+			ExpectedContentType: "text/plain",
+		},
+		{
+			Name:                "multipart",
+			MaxCopySize:         s3MinUploadPartSize,
+			MetadataDirective:   s3.MetadataDirectiveCopy,
+			ExpectedContentType: "text/plain",
+			ExpectedETag:        "-3",
+		},
+		{
+			Name:                "multipart replace",
+			MaxCopySize:         s3MinUploadPartSize,
+			MetadataDirective:   s3.MetadataDirectiveReplace,
+			ExpectedContentType: "application/octet-stream",
+			ExpectedETag:        "-3",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			copier.MaxCopySize = tc.MaxCopySize
+			input := &s3.CopyObjectInput{
+				Bucket:            aws.String("destination"),
+				CopySource:        aws.String(s3CopySource("source", "dir/source object", "")),
+				Key:               aws.String(tc.Name),
+				MetadataDirective: aws.String(tc.MetadataDirective),
+			}
+			if tc.MetadataDirective == s3.MetadataDirectiveReplace {
+				input.ContentType = aws.String(tc.ExpectedContentType)
+			}
+
+			etag, _, err := copier.Copy(input, head)
+			if err != nil {
+				t.Fatalf("error copying: %s", err)
+			}
+
+			etag = strings.Trim(etag, `"`)
+			if tc.ExpectedETag == "" && strings.Contains(etag, "-") {
+				t.Fatalf("expected single part ETag, received: %s", etag)
+			}
+			if tc.ExpectedETag != "" && !strings.HasSuffix(etag, tc.ExpectedETag) {
+				t.Fatalf("expected ETag ending with %s, received: %s", tc.ExpectedETag, etag)
+			}
+
+			output, err := conn.GetObject(&s3.GetObjectInput{Bucket: aws.String("destination"), Key: aws.String(tc.Name)})
+			if err != nil {
+				t.Fatalf("error getting object: %s", err)
+			}
+			defer output.Body.Close()
+
+			received, _ := ioutil.ReadAll(output.Body)
+			if !bytes.Equal(received, body) {
+				t.Fatalf("expected %d bytes, received %d bytes", len(body), len(received))
+			}
+
+			if got := aws.StringValue(output.ContentType); got != tc.ExpectedContentType {
+				t.Fatalf("expected content type %s, received: %s", tc.ExpectedContentType, got)
+			}
+
+			tagging, err := conn.GetObjectTagging(&s3.GetObjectTaggingInput{Bucket: aws.String("destination"), Key: aws.String(tc.Name)})
+			if err != nil {
+				t.Fatalf("error getting object tags: %s", err)
+			}
+			if len(tagging.TagSet) != 1 || aws.StringValue(tagging.TagSet[0].Key) != "Key1" {
+				t.Fatalf("expected source object tags, received: %s", tagging.TagSet)
+			}
+		})
+	}
+
+	t.Run("abort", func(t *testing.T) {
+		server.Script("s3", "UploadPartCopy", fakeaws.Response{
+			StatusCode:  http.StatusInternalServerError,
+			ContentType: "application/xml",
+			Body:        "<Error><Code>InternalError</Code><Message>We encountered an internal error. Please try again.</Message></Error>",
+		})
+
+		copier.MaxCopySize = s3MinUploadPartSize
+		input := &s3.CopyObjectInput{
+			Bucket:     aws.String("destination"),
+			CopySource: aws.String(s3CopySource("source", "dir/source object", "")),
+			Key:        aws.String("abort"),
+		}
+
+		if _, _, err := copier.Copy(input, head); err == nil {
+			t.Fatal("expected error copying")
+		}
+
+		if n := server.Uploads(); n != 0 {
+			t.Fatalf("expected the multipart upload to be aborted, %d uploads in progress", n)
+		}
+
+		if _, err := conn.HeadObject(&s3.HeadObjectInput{Bucket: aws.String("destination"), Key: aws.String("abort")}); err == nil {
+			t.Fatal("expected object not to exist")
+		}
+	})
+}
+
+func TestParseS3CopySource(t *testing.T) {
+	cases := []struct {
+		Source            string
+		ExpectedBucket    string
+		ExpectedKey       string
+		ExpectedVersionID string
+	}{
+		{
+			Source:         s3CopySource("bucket", "key", ""),
+			ExpectedBucket: "bucket",
+			ExpectedKey:    "key",
+		},
+		{
+			Source:            s3CopySource("bucket", "dir/a key?:+", "3/L4kqtJlcpXroDTDmJ+rmSpXd3dIbrHY"),
+			ExpectedBucket:    "bucket",
+			ExpectedKey:       "dir/a key?:+",
+			ExpectedVersionID: "3/L4kqtJlcpXroDTDmJ+rmSpXd3dIbrHY",
+		},
+	}
+
+	for _, tc := range cases {
+		bucket, key, versionID, err := parseS3CopySource(tc.Source)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tc.Source, err)
+		}
+		if bucket != tc.ExpectedBucket || key != tc.ExpectedKey || versionID != tc.ExpectedVersionID {
+			t.Fatalf("%s: expected %s, %s, %s, received %s, %s, %s", tc.Source, tc.ExpectedBucket, tc.ExpectedKey, tc.ExpectedVersionID, bucket, key, versionID)
+		}
+	}
+}
@@ -2,23 +2,34 @@ package aws
 
 import (
 	"log"
-	"regexp"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 // setTags is a helper to set the tags for a resource. It expects the
-// tags field to be named "tags"
-func setTagsS3(conn *s3.S3, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+// tags field to be named "tags". As S3 replaces the whole tag set, tags
+// matching the provider ignore_tags configuration are read back and kept.
+func setTagsS3(conn *s3.S3, d *schema.ResourceData, ignoreConfig *keyvaluetags.IgnoreConfig) error {
+	if hasTagsChange(d) {
+		oraw, nraw := getTagsChange(d)
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := diffTagsS3(tagsFromMapS3(o), tagsFromMapS3(n))
 
+		// A new bucket cannot have externally managed tags yet
+		if !d.IsNewResource() {
+			ignored, err := getIgnoredTagsS3Bucket(conn, d.Get("bucket").(string), ignoreConfig)
+			if err != nil {
+				return err
+			}
+			create = append(create, ignored...)
+		}
+
 		// Set tags
 		if len(remove) > 0 {
 			log.Printf("[DEBUG] Removing tags: %#v", remove)
@@ -52,6 +63,21 @@ func setTagsS3(conn *s3.S3, d *schema.ResourceData) error {
 	return nil
 }
 
+// getIgnoredTagsS3Bucket returns the tags of the bucket matching the
+// provider ignore_tags configuration.
+func getIgnoredTagsS3Bucket(conn *s3.S3, bucket string, ignoreConfig *keyvaluetags.IgnoreConfig) ([]*s3.Tag, error) {
+	if ignoreConfig == nil {
+		return nil, nil
+	}
+
+	tagSet, err := getTagSetS3(conn, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	return filterIgnoredTagsS3(tagSet, ignoreConfig), nil
+}
+
 func getTagsS3Object(conn *s3.S3, d *schema.ResourceData) error {
 	resp, err := retryOnAwsCode(s3.ErrCodeNoSuchKey, func() (interface{}, error) {
 		return conn.GetObjectTagging(&s3.GetObjectTaggingInput{
@@ -70,12 +96,26 @@ func getTagsS3Object(conn *s3.S3, d *schema.ResourceData) error {
 	return nil
 }
 
-func setTagsS3Object(conn *s3.S3, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+// setTagsS3Object replaces the tag set of the object. Tags matching the
+// provider ignore_tags configuration are read back and kept.
+func setTagsS3Object(conn *s3.S3, d *schema.ResourceData, ignoreConfig *keyvaluetags.IgnoreConfig) error {
+	if hasTagsChange(d) {
+		oraw, nraw := getTagsChange(d)
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 
+		var ignored []*s3.Tag
+		if ignoreConfig != nil {
+			resp, err := conn.GetObjectTagging(&s3.GetObjectTaggingInput{
+				Bucket: aws.String(d.Get("bucket").(string)),
+				Key:    aws.String(d.Get("key").(string)),
+			})
+			if err != nil {
+				return err
+			}
+			ignored = filterIgnoredTagsS3(resp.TagSet, ignoreConfig)
+		}
+
 		// Set tags
 		if len(o) > 0 {
 			_, err := conn.DeleteObjectTagging(&s3.DeleteObjectTaggingInput{
@@ -86,12 +126,12 @@ func setTagsS3Object(conn *s3.S3, d *schema.ResourceData) error {
 				return err
 			}
 		}
-		if len(n) > 0 {
+		if len(n) > 0 || len(ignored) > 0 {
 			_, err := conn.PutObjectTagging(&s3.PutObjectTaggingInput{
 				Bucket: aws.String(d.Get("bucket").(string)),
 				Key:    aws.String(d.Get("key").(string)),
 				Tagging: &s3.Tagging{
-					TagSet: tagsFromMapS3(n),
+					TagSet: append(tagsFromMapS3(n), ignored...),
 				},
 			})
 			if err != nil {
@@ -107,51 +147,19 @@ func setTagsS3Object(conn *s3.S3, d *schema.ResourceData) error {
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
 func diffTagsS3(oldTags, newTags []*s3.Tag) ([]*s3.Tag, []*s3.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
-
-	// Build the list of what to remove
-	var remove []*s3.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
-	}
+	create, remove := keyvaluetags.S3KeyValueTags(oldTags).IgnoreConfig(configuredIgnoreTagsConfig()).Diff(keyvaluetags.S3KeyValueTags(newTags).IgnoreConfig(configuredIgnoreTagsConfig()))
 
-	return tagsFromMapS3(create), remove
+	return create.S3Tags(), remove.S3Tags()
 }
 
 // tagsFromMap returns the tags for the given map of data.
 func tagsFromMapS3(m map[string]interface{}) []*s3.Tag {
-	result := make([]*s3.Tag, 0, len(m))
-	for k, v := range m {
-		t := &s3.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredS3(t) {
-			result = append(result, t)
-		}
-	}
-
-	return result
+	return keyvaluetags.New(m).IgnoreAws().S3Tags()
 }
 
 // tagsToMap turns the list of tags into a map.
 func tagsToMapS3(ts []*s3.Tag) map[string]string {
-	result := make(map[string]string)
-	for _, t := range ts {
-		if !tagIgnoredS3(t) {
-			result[*t.Key] = *t.Value
-		}
-	}
-
-	return result
+	return keyvaluetags.S3KeyValueTags(ts).IgnoreAws().IgnoreConfig(configuredIgnoreTagsConfig()).Map()
 }
 
 // return a slice of s3 tags associated with the given s3 bucket. Essentially
@@ -173,17 +181,19 @@ func getTagSetS3(s3conn *s3.S3, bucket string) ([]*s3.Tag, error) {
 	return response.TagSet, nil
 }
 
+// filterIgnoredTagsS3 returns the tags matching the provider ignore_tags configuration.
+func filterIgnoredTagsS3(ts []*s3.Tag, ignoreConfig *keyvaluetags.IgnoreConfig) []*s3.Tag {
+	if ignoreConfig == nil {
+		return nil
+	}
+
+	tags := keyvaluetags.S3KeyValueTags(ts)
+
+	return tags.Removed(tags.IgnoreConfig(ignoreConfig)).S3Tags()
+}
+
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
 func tagIgnoredS3(t *s3.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		r, _ := regexp.MatchString(v, *t.Key)
-		if r {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+	return strings.HasPrefix(aws.StringValue(t.Key), keyvaluetags.AwsTagKeyPrefix)
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func TestDiffTagsS3(t *testing.T) {
@@ -75,3 +76,30 @@ func TestIgnoringTagsS3(t *testing.T) {
 		}
 	}
 }
+
+func TestFilterIgnoredTagsS3(t *testing.T) {
+	tags := []*s3.Tag{
+		{Key: aws.String("Name"), Value: aws.String("test")},
+		{Key: aws.String("compliance:owner"), Value: aws.String("security")},
+		{Key: aws.String("CostCenter"), Value: aws.String("1234")},
+	}
+
+	ignoreConfig := &keyvaluetags.IgnoreConfig{
+		Keys:        keyvaluetags.New([]string{"CostCenter"}),
+		KeyPrefixes: keyvaluetags.New([]string{"compliance:"}),
+	}
+
+	got := tagsToMapS3(filterIgnoredTagsS3(tags, ignoreConfig))
+	expected := map[string]string{
+		"compliance:owner": "security",
+		"CostCenter":       "1234",
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Fatalf("bad ignored tags: %#v, expected: %#v", got, expected)
+	}
+
+	if got := filterIgnoredTagsS3(tags, nil); len(got) != 0 {
+		t.Fatalf("expected no ignored tags without configuration, got: %#v", got)
+	}
+}
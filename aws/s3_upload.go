@@ -0,0 +1,182 @@
+package aws
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"sort"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+const (
+	// s3MinUploadPartSize is the minimum size of all but the last part of a
+	// multipart upload.
+	s3MinUploadPartSize int64 = 5 * 1024 * 1024
+
+	// s3MaxUploadParts is the maximum number of parts of a multipart upload.
+	s3MaxUploadParts = 10000
+
+	// s3MaxPutObjectSize is the maximum size of an object uploaded with a
+	// single PutObject request.
+	s3MaxPutObjectSize int64 = 5 * 1024 * 1024 * 1024
+
+	s3DefaultUploadPartSize    = s3MinUploadPartSize
+	s3DefaultUploadConcurrency = 5
+)
+
+// s3Uploader uploads S3 objects, in parts for bodies larger than PartSize.
+// A zero PartSize uploads all bodies with a single PutObject request, whose
+// ETag remains the MD5 digest of the content. Parts are read from the body
+// as they are sent, so that large files are never held in memory as a whole.
+type s3Uploader struct {
+	Conn        *s3.S3
+	PartSize    int64
+	Concurrency int
+}
+
+// Upload uploads the body of the given size with the parameters of input,
+// whose Body is ignored.
+func (u *s3Uploader) Upload(input *s3.PutObjectInput, body io.ReaderAt, size int64) error {
+	if u.PartSize == 0 && size > s3MaxPutObjectSize {
+		return fmt.Errorf("error uploading %d bytes in a single request, the maximum is %d bytes", size, s3MaxPutObjectSize)
+	}
+
+	partSize := u.PartSize
+	if partSize < s3MinUploadPartSize {
+		partSize = s3MinUploadPartSize
+	}
+
+	if u.PartSize == 0 || size <= partSize {
+		putInput := *input
+		putInput.Body = io.NewSectionReader(body, 0, size)
+
+		_, err := u.Conn.PutObject(&putInput)
+		return err
+	}
+
+	// Grow the parts so that the object fits in the maximum number of parts.
+	if size/partSize >= s3MaxUploadParts {
+		partSize = size/s3MaxUploadParts + 1
+	}
+
+	createInput := &s3.CreateMultipartUploadInput{}
+	awsutil.Copy(createInput, input)
+
+	createOutput, err := u.Conn.CreateMultipartUpload(createInput)
+	if err != nil {
+		return fmt.Errorf("error creating multipart upload: %s", err)
+	}
+	uploadID := aws.StringValue(createOutput.UploadId)
+
+	parts, err := u.uploadParts(input, uploadID, body, size, partSize)
+	if err == nil {
+		_, err = u.Conn.CompleteMultipartUpload(&s3.CompleteMultipartUploadInput{
+			Bucket:          input.Bucket,
+			Key:             input.Key,
+			MultipartUpload: &s3.CompletedMultipartUpload{Parts: parts},
+			RequestPayer:    input.RequestPayer,
+			UploadId:        aws.String(uploadID),
+		})
+		if err != nil {
+			err = fmt.Errorf("error completing multipart upload (%s): %s", uploadID, err)
+		}
+	}
+
+	if err != nil {
+		// Parts of incomplete uploads are billed until the upload is aborted.
+		log.Printf("[DEBUG] Aborting S3 multipart upload (%s)", uploadID)
+		if _, abortErr := u.Conn.AbortMultipartUpload(&s3.AbortMultipartUploadInput{
+			Bucket:       input.Bucket,
+			Key:          input.Key,
+			RequestPayer: input.RequestPayer,
+			UploadId:     aws.String(uploadID),
+		}); abortErr != nil {
+			log.Printf("[WARN] Error aborting S3 multipart upload (%s): %s", uploadID, abortErr)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// uploadParts uploads the parts of body concurrently, stopping at the first
+// error.
+func (u *s3Uploader) uploadParts(input *s3.PutObjectInput, uploadID string, body io.ReaderAt, size, partSize int64) ([]*s3.CompletedPart, error) {
+	concurrency := u.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	partNumbers := make(chan int64)
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+	var parts []*s3.CompletedPart
+	var uploadErr error
+
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for partNumber := range partNumbers {
+				offset := (partNumber - 1) * partSize
+				length := partSize
+				if offset+length > size {
+					length = size - offset
+				}
+
+				output, err := u.Conn.UploadPart(&s3.UploadPartInput{
+					Body:                 io.NewSectionReader(body, offset, length),
+					Bucket:               input.Bucket,
+					Key:                  input.Key,
+					PartNumber:           aws.Int64(partNumber),
+					RequestPayer:         input.RequestPayer,
+					SSECustomerAlgorithm: input.SSECustomerAlgorithm,
+					SSECustomerKey:       input.SSECustomerKey,
+					SSECustomerKeyMD5:    input.SSECustomerKeyMD5,
+					UploadId:             aws.String(uploadID),
+				})
+
+				mutex.Lock()
+				if err != nil {
+					if uploadErr == nil {
+						uploadErr = fmt.Errorf("error uploading part %d of multipart upload (%s): %s", partNumber, uploadID, err)
+					}
+				} else {
+					parts = append(parts, &s3.CompletedPart{
+						ETag:       output.ETag,
+						PartNumber: aws.Int64(partNumber),
+					})
+				}
+				mutex.Unlock()
+			}
+		}()
+	}
+
+	for partNumber := int64(1); (partNumber-1)*partSize < size; partNumber++ {
+		mutex.Lock()
+		failed := uploadErr != nil
+		mutex.Unlock()
+		if failed {
+			break
+		}
+
+		partNumbers <- partNumber
+	}
+	close(partNumbers)
+	wg.Wait()
+
+	if uploadErr != nil {
+		return nil, uploadErr
+	}
+
+	sort.Slice(parts, func(i, j int) bool {
+		return aws.Int64Value(parts[i].PartNumber) < aws.Int64Value(parts[j].PartNumber)
+	})
+
+	return parts, nil
+}
@@ -0,0 +1,138 @@
+package aws
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/fakeaws"
+)
+
+func TestS3Uploader(t *testing.T) {
+	server := fakeaws.New()
+	defer server.Close()
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials:      credentials.NewStaticCredentials("fakeaws", "fakeaws", ""),
+		Endpoint:         aws.String(server.URL),
+		MaxRetries:       aws.Int(0),
+		Region:           aws.String("us-west-2"),
+		S3ForcePathStyle: aws.Bool(true),
+	})
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+	conn := s3.New(sess)
+
+	if _, err := conn.CreateBucket(&s3.CreateBucketInput{Bucket: aws.String("test")}); err != nil {
+		t.Fatalf("error creating bucket: %s", err)
+	}
+
+	uploader := &s3Uploader{
+		Conn:        conn,
+		PartSize:    s3MinUploadPartSize,
+		Concurrency: 2,
+	}
+
+	cases := []struct {
+		Name         string
+		PartSize     int64
+		Size         int64
+		ExpectedETag string
+	}{
+		{
+			Name: "empty",
+			Size: 0,
+		},
+		{
+			Name: "single part",
+			Size: s3MinUploadPartSize,
+		},
+		{
+			Name:         "multipart",
+			PartSize:     s3MinUploadPartSize,
+			Size:         2*s3MinUploadPartSize + 1,
+			ExpectedETag: "-3",
+		},
+		{
+			Name: "single request",
+			Size: 2*s3MinUploadPartSize + 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			uploader := &s3Uploader{
+				Conn:        conn,
+				PartSize:    tc.PartSize,
+				Concurrency: 2,
+			}
+
+			body := bytes.Repeat([]byte("a"), int(tc.Size))
+			input := &s3.PutObjectInput{
+				Bucket:      aws.String("test"),
+				ContentType: aws.String("text/plain"),
+				Key:         aws.String(tc.Name),
+			}
+
+			if err := uploader.Upload(input, bytes.NewReader(body), tc.Size); err != nil {
+				t.Fatalf("error uploading: %s", err)
+			}
+
+			output, err := conn.GetObject(&s3.GetObjectInput{Bucket: aws.String("test"), Key: aws.String(tc.Name)})
+			if err != nil {
+				t.Fatalf("error getting object: %s", err)
+			}
+			defer output.Body.Close()
+
+			received, _ := ioutil.ReadAll(output.Body)
+			if !bytes.Equal(received, body) {
+				t.Fatalf("expected %d bytes, received %d bytes", len(body), len(received))
+			}
+
+			if got := aws.StringValue(output.ContentType); got != "text/plain" {
+				t.Fatalf("expected content type text/plain, received: %s", got)
+			}
+
+			etag := strings.Trim(aws.StringValue(output.ETag), `"`)
+			if tc.ExpectedETag == "" && strings.Contains(etag, "-") {
+				t.Fatalf("expected single part ETag, received: %s", etag)
+			}
+			if tc.ExpectedETag != "" && !strings.HasSuffix(etag, tc.ExpectedETag) {
+				t.Fatalf("expected ETag ending with %s, received: %s", tc.ExpectedETag, etag)
+			}
+		})
+	}
+
+	t.Run("abort", func(t *testing.T) {
+		server.Script("s3", "UploadPart", fakeaws.Response{
+			StatusCode:  http.StatusInternalServerError,
+			ContentType: "application/xml",
+			Body:        "<Error><Code>InternalError</Code><Message>We encountered an internal error. Please try again.</Message></Error>",
+		})
+
+		size := 3 * s3MinUploadPartSize
+		input := &s3.PutObjectInput{
+			Bucket: aws.String("test"),
+			Key:    aws.String("abort"),
+		}
+
+		if err := uploader.Upload(input, bytes.NewReader(make([]byte, size)), size); err == nil {
+			t.Fatal("expected error uploading")
+		}
+
+		if n := server.Uploads(); n != 0 {
+			t.Fatalf("expected the multipart upload to be aborted, %d uploads in progress", n)
+		}
+
+		if _, err := conn.HeadObject(&s3.HeadObjectInput{Bucket: aws.String("test"), Key: aws.String("abort")}); err == nil {
+			t.Fatal("expected object not to exist")
+		}
+	})
+}
@@ -1,57 +1,42 @@
 package aws
 
 import (
-	"log"
-	"regexp"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 // diffTags takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
-// be destroyed.
+// be destroyed. Elastic Beanstalk overwrites the values of existing
+// tags, so only the keys not present anymore are removed.
 func diffTagsBeanstalk(oldTags, newTags []*elasticbeanstalk.Tag) ([]*elasticbeanstalk.Tag, []*string) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
-
-	// Build the list of what to remove
-	var remove []*string
-	for _, t := range oldTags {
-		if _, ok := create[*t.Key]; !ok {
-			// Delete it!
-			remove = append(remove, t.Key)
-		}
-	}
+	oldKeyValueTags := keyvaluetags.ElasticbeanstalkKeyValueTags(oldTags).IgnoreConfig(configuredIgnoreTagsConfig())
+	newKeyValueTags := keyvaluetags.ElasticbeanstalkKeyValueTags(newTags).IgnoreConfig(configuredIgnoreTagsConfig())
 
-	return tagsFromMapBeanstalk(create), remove
+	return newKeyValueTags.ElasticbeanstalkTags(), aws.StringSlice(oldKeyValueTags.Removed(newKeyValueTags).Keys())
 }
 
 // tagsFromMap returns the tags for the given map of data.
 func tagsFromMapBeanstalk(m map[string]interface{}) []*elasticbeanstalk.Tag {
-	var result []*elasticbeanstalk.Tag
-	for k, v := range m {
-		t := &elasticbeanstalk.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredBeanstalk(t) {
-			result = append(result, t)
-		}
-	}
-
-	return result
+	return ignoreTagsBeanstalk(keyvaluetags.New(m)).ElasticbeanstalkTags()
 }
 
 // tagsToMap turns the list of tags into a map.
 func tagsToMapBeanstalk(ts []*elasticbeanstalk.Tag) map[string]string {
-	result := make(map[string]string)
-	for _, t := range ts {
-		if !tagIgnoredBeanstalk(t) {
-			result[*t.Key] = *t.Value
+	return ignoreTagsBeanstalk(keyvaluetags.ElasticbeanstalkKeyValueTags(ts)).IgnoreConfig(configuredIgnoreTagsConfig()).Map()
+}
+
+// ignoreTagsBeanstalk returns the tags without the ones managed by
+// AWS or by Elastic Beanstalk itself.
+func ignoreTagsBeanstalk(tags keyvaluetags.KeyValueTags) keyvaluetags.KeyValueTags {
+	result := make(keyvaluetags.KeyValueTags)
+
+	for k, v := range tags {
+		if !tagIgnoredBeanstalk(&elasticbeanstalk.Tag{Key: aws.String(k), Value: v}) {
+			result[k] = v
 		}
 	}
 
@@ -61,14 +46,7 @@ func tagsToMapBeanstalk(ts []*elasticbeanstalk.Tag) map[string]string {
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
 func tagIgnoredBeanstalk(t *elasticbeanstalk.Tag) bool {
-	filter := []string{"^aws:", "^elasticbeanstalk:", "Name"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		r, _ := regexp.MatchString(v, *t.Key)
-		if r {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+	key := aws.StringValue(t.Key)
+
+	return strings.HasPrefix(key, keyvaluetags.AwsTagKeyPrefix) || strings.HasPrefix(key, "elasticbeanstalk:") || strings.Contains(key, "Name")
 }
@@ -6,11 +6,12 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudfront"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func setTagsCloudFront(conn *cloudfront.CloudFront, d *schema.ResourceData, arn string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if hasTagsChange(d) {
+		oraw, nraw := getTagsChange(d)
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := diffTagsCloudFront(tagsFromMapCloudFront(o), tagsFromMapCloudFront(n))
@@ -51,48 +52,17 @@ func setTagsCloudFront(conn *cloudfront.CloudFront, d *schema.ResourceData, arn
 	return nil
 }
 func diffTagsCloudFront(oldTags, newTags *cloudfront.Tags) ([]*cloudfront.Tag, []*cloudfront.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags.Items {
-		create[*t.Key] = *t.Value
-	}
+	create, remove := keyvaluetags.CloudfrontKeyValueTags(oldTags.Items).IgnoreConfig(configuredIgnoreTagsConfig()).Diff(keyvaluetags.CloudfrontKeyValueTags(newTags.Items).IgnoreConfig(configuredIgnoreTagsConfig()))
 
-	// Build the list of what to remove
-	var remove []*cloudfront.Tag
-	for _, t := range oldTags.Items {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
-	}
-
-	createTags := tagsFromMapCloudFront(create)
-	return createTags.Items, remove
+	return create.CloudfrontTags(), remove.CloudfrontTags()
 }
 
 func tagsFromMapCloudFront(m map[string]interface{}) *cloudfront.Tags {
-	result := make([]*cloudfront.Tag, 0, len(m))
-	for k, v := range m {
-		result = append(result, &cloudfront.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		})
-	}
-
-	tags := &cloudfront.Tags{
-		Items: result,
+	return &cloudfront.Tags{
+		Items: keyvaluetags.New(m).IgnoreAws().CloudfrontTags(),
 	}
-
-	return tags
 }
 
 func tagsToMapCloudFront(ts *cloudfront.Tags) map[string]string {
-	result := make(map[string]string)
-
-	for _, t := range ts.Items {
-		result[*t.Key] = *t.Value
-	}
-
-	return result
+	return keyvaluetags.CloudfrontKeyValueTags(ts.Items).IgnoreAws().IgnoreConfig(configuredIgnoreTagsConfig()).Map()
 }
@@ -1,49 +1,21 @@
 package aws
 
 import (
-	"log"
-	"regexp"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elasticache"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 // setTags is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
 func setTagsEC(conn *elasticache.ElastiCache, d *schema.ResourceData, arn string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsEC(tagsFromMapEC(o), tagsFromMapEC(n))
+	if hasTagsChange(d) {
+		o, n := getTagsChange(d)
 
-		// Set tags
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %#v", remove)
-			k := make([]*string, len(remove))
-			for i, t := range remove {
-				k[i] = t.Key
-			}
-
-			_, err := conn.RemoveTagsFromResource(&elasticache.RemoveTagsFromResourceInput{
-				ResourceName: aws.String(arn),
-				TagKeys:      k,
-			})
-			if err != nil {
-				return err
-			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %#v", create)
-			_, err := conn.AddTagsToResource(&elasticache.AddTagsToResourceInput{
-				ResourceName: aws.String(arn),
-				Tags:         create,
-			})
-			if err != nil {
-				return err
-			}
-		}
+		return keyvaluetags.ElasticacheUpdateTags(conn, arn, o, n)
 	}
 
 	return nil
@@ -53,64 +25,23 @@ func setTagsEC(conn *elasticache.ElastiCache, d *schema.ResourceData, arn string
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
 func diffTagsEC(oldTags, newTags []*elasticache.Tag) ([]*elasticache.Tag, []*elasticache.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
+	create, remove := keyvaluetags.ElasticacheKeyValueTags(oldTags).IgnoreConfig(configuredIgnoreTagsConfig()).Diff(keyvaluetags.ElasticacheKeyValueTags(newTags).IgnoreConfig(configuredIgnoreTagsConfig()))
 
-	// Build the list of what to remove
-	var remove []*elasticache.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
-	}
-
-	return tagsFromMapEC(create), remove
+	return create.ElasticacheTags(), remove.ElasticacheTags()
 }
 
 // tagsFromMap returns the tags for the given map of data.
 func tagsFromMapEC(m map[string]interface{}) []*elasticache.Tag {
-	result := make([]*elasticache.Tag, 0, len(m))
-	for k, v := range m {
-		t := &elasticache.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredEC(t) {
-			result = append(result, t)
-		}
-	}
-
-	return result
+	return keyvaluetags.New(m).IgnoreAws().ElasticacheTags()
 }
 
 // tagsToMap turns the list of tags into a map.
 func tagsToMapEC(ts []*elasticache.Tag) map[string]string {
-	result := make(map[string]string)
-	for _, t := range ts {
-		if !tagIgnoredEC(t) {
-			result[*t.Key] = *t.Value
-		}
-	}
-
-	return result
+	return keyvaluetags.ElasticacheKeyValueTags(ts).IgnoreAws().IgnoreConfig(configuredIgnoreTagsConfig()).Map()
 }
 
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
 func tagIgnoredEC(t *elasticache.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		r, _ := regexp.MatchString(v, *t.Key)
-		if r {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+	return strings.HasPrefix(aws.StringValue(t.Key), keyvaluetags.AwsTagKeyPrefix)
 }
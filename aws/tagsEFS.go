@@ -1,48 +1,21 @@
 package aws
 
 import (
-	"log"
-	"regexp"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/efs"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 // setTags is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
 func setTagsEFS(conn *efs.EFS, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsEFS(tagsFromMapEFS(o), tagsFromMapEFS(n))
+	if hasTagsChange(d) {
+		o, n := getTagsChange(d)
 
-		// Set tags
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %#v", remove)
-			k := make([]*string, 0, len(remove))
-			for _, t := range remove {
-				k = append(k, t.Key)
-			}
-			_, err := conn.DeleteTags(&efs.DeleteTagsInput{
-				FileSystemId: aws.String(d.Id()),
-				TagKeys:      k,
-			})
-			if err != nil {
-				return err
-			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %#v", create)
-			_, err := conn.CreateTags(&efs.CreateTagsInput{
-				FileSystemId: aws.String(d.Id()),
-				Tags:         create,
-			})
-			if err != nil {
-				return err
-			}
-		}
+		return keyvaluetags.EfsUpdateTags(conn, d.Id(), o, n)
 	}
 
 	return nil
@@ -52,64 +25,23 @@ func setTagsEFS(conn *efs.EFS, d *schema.ResourceData) error {
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
 func diffTagsEFS(oldTags, newTags []*efs.Tag) ([]*efs.Tag, []*efs.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
-
-	// Build the list of what to remove
-	var remove []*efs.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
-	}
+	create, remove := keyvaluetags.EfsKeyValueTags(oldTags).IgnoreConfig(configuredIgnoreTagsConfig()).Diff(keyvaluetags.EfsKeyValueTags(newTags).IgnoreConfig(configuredIgnoreTagsConfig()))
 
-	return tagsFromMapEFS(create), remove
+	return create.EfsTags(), remove.EfsTags()
 }
 
 // tagsFromMap returns the tags for the given map of data.
 func tagsFromMapEFS(m map[string]interface{}) []*efs.Tag {
-	var result []*efs.Tag
-	for k, v := range m {
-		t := &efs.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredEFS(t) {
-			result = append(result, t)
-		}
-	}
-
-	return result
+	return keyvaluetags.New(m).IgnoreAws().EfsTags()
 }
 
 // tagsToMap turns the list of tags into a map.
 func tagsToMapEFS(ts []*efs.Tag) map[string]string {
-	result := make(map[string]string)
-	for _, t := range ts {
-		if !tagIgnoredEFS(t) {
-			result[*t.Key] = *t.Value
-		}
-	}
-
-	return result
+	return keyvaluetags.EfsKeyValueTags(ts).IgnoreAws().IgnoreConfig(configuredIgnoreTagsConfig()).Map()
 }
 
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
 func tagIgnoredEFS(t *efs.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		r, _ := regexp.MatchString(v, *t.Key)
-		if r {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+	return strings.HasPrefix(aws.StringValue(t.Key), keyvaluetags.AwsTagKeyPrefix)
 }
@@ -1,12 +1,12 @@
 package aws
 
 import (
-	"log"
-	"regexp"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/firehose"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 // getTags is a helper to get the tags for a resource. It expects the
@@ -45,38 +45,10 @@ func getTagsKinesisFirehose(conn *firehose.Firehose, d *schema.ResourceData, sn
 // setTags is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
 func setTagsKinesisFirehose(conn *firehose.Firehose, d *schema.ResourceData, sn string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsKinesisFirehose(tagsFromMapKinesisFirehose(o), tagsFromMapKinesisFirehose(n))
+	if hasTagsChange(d) {
+		o, n := getTagsChange(d)
 
-		// Set tags
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %#v", remove)
-			k := make([]*string, len(remove))
-			for i, t := range remove {
-				k[i] = t.Key
-			}
-
-			_, err := conn.UntagDeliveryStream(&firehose.UntagDeliveryStreamInput{
-				DeliveryStreamName: aws.String(sn),
-				TagKeys:            k,
-			})
-			if err != nil {
-				return err
-			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %#v", create)
-			_, err := conn.TagDeliveryStream(&firehose.TagDeliveryStreamInput{
-				DeliveryStreamName: aws.String(sn),
-				Tags:               create,
-			})
-			if err != nil {
-				return err
-			}
-		}
+		return keyvaluetags.FirehoseUpdateTags(conn, sn, o, n)
 	}
 
 	return nil
@@ -86,64 +58,23 @@ func setTagsKinesisFirehose(conn *firehose.Firehose, d *schema.ResourceData, sn
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
 func diffTagsKinesisFirehose(oldTags, newTags []*firehose.Tag) ([]*firehose.Tag, []*firehose.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
-	}
+	create, remove := keyvaluetags.FirehoseKeyValueTags(oldTags).IgnoreConfig(configuredIgnoreTagsConfig()).Diff(keyvaluetags.FirehoseKeyValueTags(newTags).IgnoreConfig(configuredIgnoreTagsConfig()))
 
-	// Build the list of what to remove
-	var remove []*firehose.Tag
-	for _, t := range oldTags {
-		old, ok := create[aws.StringValue(t.Key)]
-		if !ok || old != aws.StringValue(t.Value) {
-			// Delete it!
-			remove = append(remove, t)
-		}
-	}
-
-	return tagsFromMapKinesisFirehose(create), remove
+	return create.FirehoseTags(), remove.FirehoseTags()
 }
 
 // tagsFromMap returns the tags for the given map of data.
 func tagsFromMapKinesisFirehose(m map[string]interface{}) []*firehose.Tag {
-	result := make([]*firehose.Tag, 0, len(m))
-	for k, v := range m {
-		t := &firehose.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredKinesisFirehose(t) {
-			result = append(result, t)
-		}
-	}
-
-	return result
+	return keyvaluetags.New(m).IgnoreAws().FirehoseTags()
 }
 
 // tagsToMap turns the list of tags into a map.
 func tagsToMapKinesisFirehose(ts []*firehose.Tag) map[string]string {
-	result := make(map[string]string)
-	for _, t := range ts {
-		if !tagIgnoredKinesisFirehose(t) {
-			result[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
-		}
-	}
-
-	return result
+	return keyvaluetags.FirehoseKeyValueTags(ts).IgnoreAws().IgnoreConfig(configuredIgnoreTagsConfig()).Map()
 }
 
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
 func tagIgnoredKinesisFirehose(t *firehose.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		r, _ := regexp.MatchString(v, *t.Key)
-		if r {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+	return strings.HasPrefix(aws.StringValue(t.Key), keyvaluetags.AwsTagKeyPrefix)
 }
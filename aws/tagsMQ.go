@@ -1,11 +1,10 @@
 package aws
 
 import (
-	"log"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/mq"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 // getTags is a helper to get the tags for a resource. It expects the
@@ -28,39 +27,10 @@ func getTagsMQ(conn *mq.MQ, d *schema.ResourceData, arn string) error {
 // setTags is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
 func setTagsMQ(conn *mq.MQ, d *schema.ResourceData, arn string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsGeneric(o, n)
-
-		// Set tags
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %#v", remove)
-			keys := make([]*string, 0, len(remove))
-			for k := range remove {
-				keys = append(keys, aws.String(k))
-			}
-
-			_, err := conn.DeleteTags(&mq.DeleteTagsInput{
-				ResourceArn: aws.String(arn),
-				TagKeys:     keys,
-			})
-			if err != nil {
-				return err
-			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %#v", create)
+	if hasTagsChange(d) {
+		o, n := getTagsChange(d)
 
-			_, err := conn.CreateTags(&mq.CreateTagsInput{
-				ResourceArn: aws.String(arn),
-				Tags:        create,
-			})
-			if err != nil {
-				return err
-			}
-		}
+		return keyvaluetags.MqUpdateTags(conn, arn, o, n)
 	}
 
 	return nil
@@ -1,46 +1,16 @@
 package aws
 
 import (
-	"log"
-
-	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/mediapackage"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func setTagsMediaPackage(conn *mediapackage.MediaPackage, d *schema.ResourceData, arn string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsGeneric(o, n)
-
-		// Set tags
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %#v", remove)
-			keys := make([]*string, 0, len(remove))
-			for k := range remove {
-				keys = append(keys, aws.String(k))
-			}
+	if hasTagsChange(d) {
+		o, n := getTagsChange(d)
 
-			_, err := conn.UntagResource(&mediapackage.UntagResourceInput{
-				ResourceArn: aws.String(arn),
-				TagKeys:     keys,
-			})
-			if err != nil {
-				return err
-			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %#v", create)
-			_, err := conn.TagResource(&mediapackage.TagResourceInput{
-				ResourceArn: aws.String(arn),
-				Tags:        create,
-			})
-			if err != nil {
-				return err
-			}
-		}
+		return keyvaluetags.MediapackageUpdateTags(conn, arn, o, n)
 	}
 
 	return nil
@@ -2,49 +2,21 @@ package aws
 
 import (
 	"fmt"
-	"log"
-	"regexp"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 // setTags is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
 func setTagsRDS(conn *rds.RDS, d *schema.ResourceData, arn string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsRDS(tagsFromMapRDS(o), tagsFromMapRDS(n))
+	if hasTagsChange(d) {
+		o, n := getTagsChange(d)
 
-		// Set tags
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %s", remove)
-			k := make([]*string, len(remove))
-			for i, t := range remove {
-				k[i] = t.Key
-			}
-
-			_, err := conn.RemoveTagsFromResource(&rds.RemoveTagsFromResourceInput{
-				ResourceName: aws.String(arn),
-				TagKeys:      k,
-			})
-			if err != nil {
-				return err
-			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %s", create)
-			_, err := conn.AddTagsToResource(&rds.AddTagsToResourceInput{
-				ResourceName: aws.String(arn),
-				Tags:         create,
-			})
-			if err != nil {
-				return err
-			}
-		}
+		return keyvaluetags.RdsUpdateTags(conn, arn, o, n)
 	}
 
 	return nil
@@ -54,51 +26,19 @@ func setTagsRDS(conn *rds.RDS, d *schema.ResourceData, arn string) error {
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
 func diffTagsRDS(oldTags, newTags []*rds.Tag) ([]*rds.Tag, []*rds.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
+	create, remove := keyvaluetags.RdsKeyValueTags(oldTags).IgnoreConfig(configuredIgnoreTagsConfig()).Diff(keyvaluetags.RdsKeyValueTags(newTags).IgnoreConfig(configuredIgnoreTagsConfig()))
 
-	// Build the list of what to remove
-	var remove []*rds.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
-	}
-
-	return tagsFromMapRDS(create), remove
+	return create.RdsTags(), remove.RdsTags()
 }
 
 // tagsFromMap returns the tags for the given map of data.
 func tagsFromMapRDS(m map[string]interface{}) []*rds.Tag {
-	result := make([]*rds.Tag, 0, len(m))
-	for k, v := range m {
-		t := &rds.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredRDS(t) {
-			result = append(result, t)
-		}
-	}
-
-	return result
+	return keyvaluetags.New(m).IgnoreAws().RdsTags()
 }
 
 // tagsToMap turns the list of tags into a map.
 func tagsToMapRDS(ts []*rds.Tag) map[string]string {
-	result := make(map[string]string)
-	for _, t := range ts {
-		if !tagIgnoredRDS(t) {
-			result[*t.Key] = *t.Value
-		}
-	}
-
-	return result
+	return keyvaluetags.RdsKeyValueTags(ts).IgnoreAws().IgnoreConfig(configuredIgnoreTagsConfig()).Map()
 }
 
 func saveTagsRDS(conn *rds.RDS, d *schema.ResourceData, arn string) error {
@@ -121,14 +61,5 @@ func saveTagsRDS(conn *rds.RDS, d *schema.ResourceData, arn string) error {
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
 func tagIgnoredRDS(t *rds.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		r, _ := regexp.MatchString(v, *t.Key)
-		if r {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+	return strings.HasPrefix(aws.StringValue(t.Key), keyvaluetags.AwsTagKeyPrefix)
 }
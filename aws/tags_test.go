@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func TestDiffTags(t *testing.T) {
@@ -94,6 +95,39 @@ func TestDiffTags(t *testing.T) {
 	}
 }
 
+func TestDiffTags_ignoreConfig(t *testing.T) {
+	setConfiguredIgnoreTagsConfig(&keyvaluetags.IgnoreConfig{
+		Keys:        keyvaluetags.New([]string{"Owner"}),
+		KeyPrefixes: keyvaluetags.New([]string{"compliance:"}),
+	})
+	defer setConfiguredIgnoreTagsConfig(nil)
+
+	old := map[string]interface{}{
+		"foo":              "bar",
+		"Owner":            "team",
+		"compliance:level": "high",
+	}
+	new := map[string]interface{}{
+		"foo":   "baz",
+		"Owner": "other",
+	}
+
+	c, r := diffTags(tagsFromMap(old), tagsFromMap(new))
+
+	if expected := map[string]string{"foo": "baz"}; !reflect.DeepEqual(tagsToMap(c), expected) {
+		t.Fatalf("bad create: %#v, expected: %#v", tagsToMap(c), expected)
+	}
+
+	if expected := map[string]string{"foo": "bar"}; !reflect.DeepEqual(tagsToMap(r), expected) {
+		t.Fatalf("bad remove: %#v, expected: %#v", tagsToMap(r), expected)
+	}
+
+	got := tagsToMap(tagsFromMap(old))
+	if expected := map[string]string{"foo": "bar"}; !reflect.DeepEqual(got, expected) {
+		t.Fatalf("bad tags: %#v, expected: %#v", got, expected)
+	}
+}
+
 func TestIgnoringTags(t *testing.T) {
 	var ignoredTags []*ec2.Tag
 	ignoredTags = append(ignoredTags, &ec2.Tag{
@@ -1011,6 +1011,30 @@ func validateOnceADayWindowFormat(v interface{}, k string) (ws []string, errors
 	return
 }
 
+func validateFsxWeeklyMaintenanceStartTime(v interface{}, k string) (ws []string, errors []error) {
+	// valid time format is "d:hh24:mi", with d from 1 (Monday) to 7 (Sunday)
+	validTimeFormat := "^[1-7]:([0-1][0-9]|2[0-3]):([0-5][0-9])$"
+
+	value := v.(string)
+	if !regexp.MustCompile(validTimeFormat).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must satisfy the format of \"d:hh24:mi\".", k))
+	}
+	return
+}
+
+func validateFsxDailyAutomaticBackupStartTime(v interface{}, k string) (ws []string, errors []error) {
+	// valid time format is "hh24:mi"
+	validTimeFormat := "^([0-1][0-9]|2[0-3]):([0-5][0-9])$"
+
+	value := v.(string)
+	if !regexp.MustCompile(validTimeFormat).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must satisfy the format of \"hh24:mi\".", k))
+	}
+	return
+}
+
 // Validates that ECS Placement Constraints are set correctly
 // Takes type, and expression as strings
 func validateAwsEcsPlacementConstraint(constType, constExpr string) error {
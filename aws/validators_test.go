@@ -1058,6 +1058,73 @@ func TestValidateOnceAWeekWindowFormat(t *testing.T) {
 	}
 }
 
+func TestValidateFsxWeeklyMaintenanceStartTime(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			// day of the week as a name
+			Value:    "sun:04:00",
+			ErrCount: 1,
+		},
+		{
+			// invalid day
+			Value:    "8:04:00",
+			ErrCount: 1,
+		},
+		{
+			// invalid hour
+			Value:    "1:24:00",
+			ErrCount: 1,
+		},
+		{
+			// valid format
+			Value:    "7:23:59",
+			ErrCount: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateFsxWeeklyMaintenanceStartTime(tc.Value, "weekly_maintenance_start_time")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d validation errors, But got %d errors for \"%s\"", tc.ErrCount, len(errors), tc.Value)
+		}
+	}
+}
+
+func TestValidateFsxDailyAutomaticBackupStartTime(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			// window format
+			Value:    "04:00-05:00",
+			ErrCount: 1,
+		},
+		{
+			// invalid min
+			Value:    "04:60",
+			ErrCount: 1,
+		},
+		{
+			// valid format
+			Value:    "00:30",
+			ErrCount: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateFsxDailyAutomaticBackupStartTime(tc.Value, "daily_automatic_backup_start_time")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d validation errors, But got %d errors for \"%s\"", tc.ErrCount, len(errors), tc.Value)
+		}
+	}
+}
+
 func TestValidateOnceADayWindowFormat(t *testing.T) {
 	cases := []struct {
 		Value    string